@@ -87,8 +87,15 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 			avd.ak.SetAccount(ctx, acc)
 			acct = evmtypes.NewEmptyAccount()
 		} else if acct.IsContract() {
-			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType,
-				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
+			// account, which delegated its execution using EIP-7702 authorization, is still EOA
+			_, delegated, err := avd.evmKeeper.GetDelegation(ctx, fromAddr)
+			if err != nil {
+				return ctx, errorsmod.Wrapf(err, "failed to get delegation of sender %s", fromAddr)
+			}
+			if !delegated {
+				return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType,
+					"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
+			}
 		}
 
 		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
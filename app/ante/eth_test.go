@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"swisstronik/app/ante"
 	"swisstronik/server/config"
@@ -24,6 +25,12 @@ func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 	tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
 	tx.From = addr.Hex()
 
+	authorityKey, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	authority := crypto.PubkeyToAddress(authorityKey.PublicKey)
+	sender, privKey := tests.RandomEthAddressWithPrivateKey()
+	setCodeTx := suite.BuildTestSetCodeTx(sender, privKey, authorityKey, suite.app.EvmKeeper.ChainID(), 0)
+
 	testCases := []struct {
 		name     string
 		tx       sdk.Tx
@@ -89,6 +96,33 @@ func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 			true,
 			true,
 		},
+		{
+			"success sender with delegation",
+			tx,
+			func() {
+				_ = suite.app.EvmKeeper.SetDelegation(suite.ctx, addr, tests.RandomEthAddress())
+			},
+			true,
+			true,
+		},
+		{
+			"success set code tx",
+			setCodeTx,
+			func() {
+				_ = suite.app.EvmKeeper.SetBalance(suite.ctx, sender, big.NewInt(1000000))
+			},
+			true,
+			true,
+		},
+		{
+			"success set code tx with outdated authorization nonce",
+			setCodeTx,
+			func() {
+				_ = suite.app.EvmKeeper.SetNonce(suite.ctx, authority, 1)
+			},
+			true,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
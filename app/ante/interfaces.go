@@ -47,6 +47,7 @@ type EVMKeeper interface {
 	SetAccountCode(ctx sdk.Context, addr common.Address, code []byte) error
 	SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error
 	GetAccount(ctx sdk.Context, addr common.Address) *evmtypes.Account
+	GetDelegation(ctx sdk.Context, addr common.Address) (common.Address, bool, error)
 }
 
 type protoTxProvider interface {
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"swisstronik/app/ante"
 	"swisstronik/tests"
@@ -24,6 +25,11 @@ func (suite *AnteTestSuite) TestEthSigVerificationDecorator() {
 	err = unprotectedTx.Sign(ethtypes.HomesteadSigner{}, tests.NewTestSigner(privKey))
 	suite.Require().NoError(err)
 
+	authorityKey, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	setCodeTx := suite.BuildTestSetCodeTx(addr, privKey, authorityKey, suite.app.EvmKeeper.ChainID(), 0)
+	foreignSetCodeTx := suite.BuildTestSetCodeTx(addr, privKey, authorityKey, big.NewInt(1), 0)
+
 	testCases := []struct {
 		name                string
 		tx                  sdk.Tx
@@ -43,6 +49,8 @@ func (suite *AnteTestSuite) TestEthSigVerificationDecorator() {
 		{"successful signature verification", signedTx, false, false, true},
 		{"invalid, reject unprotected txs", unprotectedTx, false, false, false},
 		{"successful, allow unprotected txs", unprotectedTx, true, false, true},
+		{"successful set code tx signature verification", setCodeTx, false, false, true},
+		{"successful, authorization for another chain is skipped", foreignSetCodeTx, false, false, true},
 	}
 
 	for _, tc := range testCases {
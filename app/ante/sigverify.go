@@ -21,6 +21,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	evmtypes "swisstronik/x/evm/types"
@@ -57,6 +58,11 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgHandleTx)(nil))
 		}
 
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to unpack tx data")
+		}
+
 		allowUnprotectedTxs := evmParams.GetAllowUnprotectedTxs()
 		ethTx := msgEthTx.AsTransaction()
 		if !allowUnprotectedTxs && !ethTx.Protected() {
@@ -65,7 +71,16 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 				"rejected unprotected Ethereum transaction. Please EIP155 sign your transaction to protect it against replay-attacks")
 		}
 
-		sender, err := signer.Sender(ethTx)
+		var sender common.Address
+		if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+			// go-ethereum signer does not support EIP-7702 transactions
+			sender, err = setCodeTx.Sender(chainID)
+			if err == nil {
+				err = validateSetCodeAuthorizations(setCodeTx.GetAuthorizationList())
+			}
+		} else {
+			sender, err = signer.Sender(ethTx)
+		}
 		if err != nil {
 			return ctx, errorsmod.Wrapf(
 				errortypes.ErrorInvalidSigner,
@@ -80,3 +95,18 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 
 	return next(ctx, tx, simulate)
 }
+
+// validateSetCodeAuthorizations performs stateless checks of EIP-7702 authorizations. Authorizations
+// with an unrecoverable authority, another chain id or an outdated nonce do not invalidate
+// the transaction, since they are skipped during its execution
+func validateSetCodeAuthorizations(auths []evmtypes.SetCodeAuthorization) error {
+	if len(auths) == 0 {
+		return errorsmod.Wrap(evmtypes.ErrInvalidAuthorization, "authorization list cannot be empty")
+	}
+	for i, auth := range auths {
+		if err := auth.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "authorization %d", i)
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -29,7 +30,9 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 
 	"swisstronik/app"
@@ -181,6 +184,41 @@ func (suite *AnteTestSuite) BuildTestEthTx(
 	return msgHandleTx
 }
 
+// BuildTestSetCodeTx is a helper function to create a signed EIP-7702 transaction, which
+// contains single authorization of provided authority key for the given chain and nonce.
+func (suite *AnteTestSuite) BuildTestSetCodeTx(
+	from common.Address,
+	priv cryptotypes.PrivKey,
+	authorityKey *ecdsa.PrivateKey,
+	authChainID *big.Int,
+	authNonce uint64,
+) *evmtypes.MsgHandleTx {
+	auth, err := evmtypes.SignSetCodeAuthorization(evmtypes.SetCodeAuthorization{
+		ChainID: (*hexutil.Big)(authChainID),
+		Address: tests.RandomEthAddress(),
+		Nonce:   hexutil.Uint64(authNonce),
+	}, authorityKey)
+	suite.Require().NoError(err)
+
+	to := crypto.PubkeyToAddress(authorityKey.PublicKey)
+	gas := hexutil.Uint64(TestGasLimit)
+	nonce := hexutil.Uint64(suite.app.EvmKeeper.GetNonce(suite.ctx, from))
+	args := evmtypes.TransactionArgs{
+		From:                 &from,
+		To:                   &to,
+		Gas:                  &gas,
+		Nonce:                &nonce,
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(1)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1)),
+		ChainID:              (*hexutil.Big)(suite.app.EvmKeeper.ChainID()),
+		AuthorizationList:    []evmtypes.SetCodeAuthorization{auth},
+	}
+
+	msgHandleTx := args.ToTransaction()
+	suite.Require().NoError(msgHandleTx.Sign(suite.ethSigner, tests.NewTestSigner(priv)))
+	return msgHandleTx
+}
+
 // CreateTestTx is a helper function to create a tx given multiple inputs.
 func (suite *AnteTestSuite) CreateTestTx(
 	msg *evmtypes.MsgHandleTx, priv cryptotypes.PrivKey, accNum uint64, signCosmosTx bool,
@@ -121,6 +121,9 @@ import (
 	compliancemodule "swisstronik/x/compliance"
 	compliancemodulekeeper "swisstronik/x/compliance/keeper"
 	compliancemoduletypes "swisstronik/x/compliance/types"
+	"swisstronik/x/erc20"
+	erc20keeper "swisstronik/x/erc20/keeper"
+	erc20types "swisstronik/x/erc20/types"
 	"swisstronik/x/evm"
 	evmkeeper "swisstronik/x/evm/keeper"
 	evmtypes "swisstronik/x/evm/types"
@@ -188,6 +191,7 @@ var (
 		// groupmodule.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 		compliancemodule.AppModuleBasic{},
+		erc20.AppModuleBasic{},
 		// this line is used by starport scaffolding # stargate/app/moduleBasic
 	)
 
@@ -202,6 +206,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner}, // used for conversions between native coins and ERC20 tokens
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -274,6 +279,7 @@ type App struct {
 
 	VestingKeeper    vestingmodulekeeper.Keeper
 	ComplianceKeeper compliancemodulekeeper.Keeper
+	Erc20Keeper      erc20keeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// ModuleManager is the module manager
@@ -329,7 +335,7 @@ func New(
 		ibcexported.StoreKey, ibctransfertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
 		consensusparamtypes.StoreKey, group.StoreKey, icacontrollertypes.StoreKey, crisistypes.StoreKey,
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
-		vestingmoduletypes.StoreKey, compliancemoduletypes.StoreKey,
+		vestingmoduletypes.StoreKey, compliancemoduletypes.StoreKey, erc20types.StoreKey,
 		// this line is used by starport scaffolding # stargate/app/storeKey
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
@@ -477,6 +483,7 @@ func New(
 		keys[compliancemoduletypes.StoreKey],
 		keys[compliancemoduletypes.MemStoreKey],
 		app.GetSubspace(compliancemoduletypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 	complianceModule := compliancemodule.NewAppModule(appCodec, app.ComplianceKeeper)
 
@@ -486,6 +493,24 @@ func New(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper, app.ComplianceKeeper, evmSs,
 	)
+	app.EvmKeeper.SetTraceNode(cast.ToBool(appOpts.Get(srvflags.EVMTraceNode)))
+	// Hooks are executed in the order of registration after each successful ethereum transaction
+	app.EvmKeeper.SetHooks(
+		evmkeeper.NewMultiEvmHooks(
+			app.ComplianceKeeper.EVMHooks(),
+		),
+	)
+
+	// erc20 module calls token contracts through SGXVM, so it is created after EVM keeper
+	app.Erc20Keeper = *erc20keeper.NewKeeper(
+		keys[erc20types.StoreKey],
+		app.GetSubspace(erc20types.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.EvmKeeper,
+	)
+	erc20Module := erc20.NewAppModule(appCodec, app.Erc20Keeper)
 
 	// ... other modules keepers
 
@@ -511,7 +536,12 @@ func New(
 		scopedTransferKeeper,
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
-	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+	// transfer stack contains (from top to bottom):
+	// - erc20 middleware, which converts received coins to ERC20 tokens
+	// - transfer application
+	var transferStack ibcporttypes.IBCModule
+	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
 
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec, keys[icahosttypes.StoreKey],
@@ -581,6 +611,7 @@ func New(
 		app.GetSubspace(vestingmoduletypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
+		&app.StakingKeeper,
 	)
 	vestingModule := vestingmodule.NewAppModule(appCodec, app.VestingKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -591,7 +622,7 @@ func New(
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
 	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
-		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule)
 	// this line is used by starport scaffolding # ibc/app/router
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -645,6 +676,7 @@ func New(
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, evmSs),
 		vestingModule,
 		complianceModule,
+		erc20Module,
 		// this line is used by starport scaffolding # stargate/app/appModule
 	)
 
@@ -677,6 +709,7 @@ func New(
 		paramstypes.ModuleName,
 		vestingmoduletypes.ModuleName,
 		compliancemoduletypes.ModuleName,
+		erc20types.ModuleName,
 		consensusparamtypes.ModuleName,
 
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
@@ -706,6 +739,7 @@ func New(
 		upgradetypes.ModuleName,
 		vestingmoduletypes.ModuleName,
 		compliancemoduletypes.ModuleName,
+		erc20types.ModuleName,
 		consensusparamtypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	)
@@ -741,6 +775,7 @@ func New(
 		crisistypes.ModuleName,
 		vestingmoduletypes.ModuleName,
 		compliancemoduletypes.ModuleName,
+		erc20types.ModuleName,
 		consensusparamtypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	)
@@ -1048,6 +1083,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(feemarkettypes.ModuleName).WithKeyTable(feemarkettypes.ParamKeyTable())
 	paramsKeeper.Subspace(vestingmoduletypes.ModuleName)
 	paramsKeeper.Subspace(compliancemoduletypes.ModuleName)
+	paramsKeeper.Subspace(erc20types.ModuleName)
 	// this line is used by starport scaffolding # stargate/app/paramSubspace
 
 	return paramsKeeper
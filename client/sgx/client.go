@@ -0,0 +1,214 @@
+// Package sgx provides a client for Swisstronik JSON-RPC, which is compatible with go-ethereum `ethclient`.
+// Data of contract calls and transactions is encrypted using node public key of the current epoch,
+// which is obtained using `eth_getNodePublicKey`, and results of calls are decrypted by the client.
+//
+// Data of contract creation is not encrypted, since SGXVM executes it as is.
+package sgx
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/oasisprotocol/deoxysii"
+
+	"swisstronik/crypto/deoxys"
+)
+
+// EncryptionKeyLength is length of x25519 private key used to encrypt transaction data
+const EncryptionKeyLength = 32
+
+// Client wraps `ethclient.Client` and encrypts data of calls, estimations and transactions
+type Client struct {
+	*ethclient.Client
+	rpc *rpc.Client
+}
+
+// Dial connects a client to the given URL
+func Dial(rawurl string) (*Client, error) {
+	return DialContext(context.Background(), rawurl)
+}
+
+// DialContext connects a client to the given URL with context
+func DialContext(ctx context.Context, rawurl string) (*Client, error) {
+	c, err := rpc.DialContext(ctx, rawurl)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(c), nil
+}
+
+// NewClient creates a client that uses the given RPC client
+func NewClient(c *rpc.Client) *Client {
+	return &Client{
+		Client: ethclient.NewClient(c),
+		rpc:    c,
+	}
+}
+
+// NewEncryptionKey generates random key, which can be used to encrypt transaction data
+func NewEncryptionKey() ([]byte, error) {
+	key := make([]byte, EncryptionKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate encryption key: %w", err)
+	}
+	return key, nil
+}
+
+// NodePublicKey returns node public key of epoch, which contains provided block.
+// If blockNumber is nil, key of the latest block is returned.
+func (c *Client) NodePublicKey(ctx context.Context, blockNumber *big.Int) ([]byte, error) {
+	var nodePublicKey hexutil.Bytes
+	if err := c.rpc.CallContext(ctx, &nodePublicKey, "eth_getNodePublicKey", toBlockNumArg(blockNumber)); err != nil {
+		return nil, err
+	}
+	if len(nodePublicKey) != EncryptionKeyLength {
+		return nil, fmt.Errorf("wrong node public key size. Expected %d, got %d", EncryptionKeyLength, len(nodePublicKey))
+	}
+	return nodePublicKey, nil
+}
+
+// CallContract executes encrypted message call and returns decrypted result
+func (c *Client) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.call(ctx, msg, blockNumber, func(msg ethereum.CallMsg) ([]byte, error) {
+		return c.Client.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+// PendingCallContract executes encrypted message call against pending state and returns decrypted result
+func (c *Client) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	return c.call(ctx, msg, nil, func(msg ethereum.CallMsg) ([]byte, error) {
+		return c.Client.PendingCallContract(ctx, msg)
+	})
+}
+
+// EstimateGas estimates gas required by message call with encrypted data
+func (c *Client) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	if msg.To == nil || len(msg.Data) == 0 {
+		return c.Client.EstimateGas(ctx, msg)
+	}
+
+	encryptionKey, err := NewEncryptionKey()
+	if err != nil {
+		return 0, err
+	}
+	nodePublicKey, err := c.NodePublicKey(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if msg.Data, err = deoxys.EncryptECDH(encryptionKey, nodePublicKey, msg.Data); err != nil {
+		return 0, err
+	}
+	return c.Client.EstimateGas(ctx, msg)
+}
+
+// call encrypts data of message using one-time encryption key and decrypts result or revert data
+// of the call using the same key
+func (c *Client) call(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+	blockNumber *big.Int,
+	send func(ethereum.CallMsg) ([]byte, error),
+) ([]byte, error) {
+	if msg.To == nil || len(msg.Data) == 0 {
+		return send(msg)
+	}
+
+	encryptionKey, err := NewEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	nodePublicKey, err := c.NodePublicKey(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Data, err = deoxys.EncryptECDH(encryptionKey, nodePublicKey, msg.Data); err != nil {
+		return nil, err
+	}
+
+	ret, err := send(msg)
+	if err != nil {
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) {
+			return nil, newRevertError(err, dataErr, encryptionKey, nodePublicKey)
+		}
+		return nil, err
+	}
+
+	return DecryptData(encryptionKey, nodePublicKey, ret)
+}
+
+// DecryptData decrypts data, which was encrypted by node using provided encryption key.
+// Empty data is returned as is, since there is nothing to decrypt.
+func DecryptData(encryptionKey, nodePublicKey, data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	if len(data) < deoxysii.NonceSize+deoxysii.TagSize {
+		return nil, fmt.Errorf("encrypted data is too short: %d bytes", len(data))
+	}
+	return deoxys.DecryptECDH(encryptionKey, nodePublicKey, data)
+}
+
+// RevertError is returned if call was reverted. It contains decrypted revert data.
+type RevertError struct {
+	error
+	data []byte
+}
+
+// ErrorCode returns the JSON error code for a revert
+func (e *RevertError) ErrorCode() int {
+	return 3
+}
+
+// ErrorData returns the hex encoded decrypted revert data
+func (e *RevertError) ErrorData() interface{} {
+	return hexutil.Encode(e.data)
+}
+
+// Data returns decrypted revert data
+func (e *RevertError) Data() []byte {
+	return e.data
+}
+
+// newRevertError decrypts revert data of the error. If error does not contain encrypted data,
+// it is returned unchanged.
+func newRevertError(err error, dataErr rpc.DataError, encryptionKey, nodePublicKey []byte) error {
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return err
+	}
+	encryptedData, decodeErr := hexutil.Decode(hexData)
+	if decodeErr != nil || len(encryptedData) == 0 {
+		return err
+	}
+	data, decryptErr := DecryptData(encryptionKey, nodePublicKey, encryptedData)
+	if decryptErr != nil {
+		return err
+	}
+
+	// Node cannot unpack revert reason from encrypted data, therefore message is constructed again
+	revertErr := errors.New("execution reverted")
+	if reason, unpackErr := abi.UnpackRevert(data); unpackErr == nil {
+		revertErr = fmt.Errorf("execution reverted: %v", reason)
+	}
+	return &RevertError{error: revertErr, data: data}
+}
+
+func toBlockNumArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+	pending := big.NewInt(-1)
+	if number.Cmp(pending) == 0 {
+		return "pending"
+	}
+	return hexutil.EncodeBig(number)
+}
@@ -0,0 +1,172 @@
+package sgx_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"swisstronik/client/sgx"
+)
+
+var (
+	contract = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	input    = []byte{0xde, 0xad, 0xbe, 0xef}
+)
+
+// Client should be usable as a backend for contract bindings
+var _ bind.ContractBackend = (*sgx.Client)(nil)
+
+func TestNodePublicKey(t *testing.T) {
+	node := newTestNode()
+	client := sgx.NewClient(node.client())
+
+	nodePublicKey, err := client.NodePublicKey(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, node.nodePublicKey, nodePublicKey)
+
+	nodePublicKey, err = client.NodePublicKey(context.Background(), big.NewInt(10))
+	require.NoError(t, err)
+	require.Equal(t, node.nodePublicKey, nodePublicKey)
+}
+
+func TestCallContract(t *testing.T) {
+	node := newTestNode()
+	client := sgx.NewClient(node.client())
+	ctx := context.Background()
+
+	testCases := []struct {
+		name string
+		msg  ethereum.CallMsg
+		call func(ethereum.CallMsg) ([]byte, error)
+	}{
+		{
+			"call at the latest block",
+			ethereum.CallMsg{To: &contract, Data: input},
+			func(msg ethereum.CallMsg) ([]byte, error) { return client.CallContract(ctx, msg, nil) },
+		},
+		{
+			"call at provided block",
+			ethereum.CallMsg{To: &contract, Data: input},
+			func(msg ethereum.CallMsg) ([]byte, error) { return client.CallContract(ctx, msg, big.NewInt(1)) },
+		},
+		{
+			"call against pending state",
+			ethereum.CallMsg{To: &contract, Data: input},
+			func(msg ethereum.CallMsg) ([]byte, error) { return client.PendingCallContract(ctx, msg) },
+		},
+		{
+			"contract creation is not encrypted",
+			ethereum.CallMsg{Data: input},
+			func(msg ethereum.CallMsg) ([]byte, error) { return client.CallContract(ctx, msg, nil) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// test node returns input of the call
+			ret, err := tc.call(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, input, ret)
+		})
+	}
+}
+
+func TestCallContractWithoutData(t *testing.T) {
+	node := newTestNode()
+	client := sgx.NewClient(node.client())
+
+	ret, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &contract}, nil)
+	require.NoError(t, err)
+	require.Empty(t, ret)
+}
+
+func TestCallContractReverted(t *testing.T) {
+	node := newTestNode()
+	client := sgx.NewClient(node.client())
+
+	_, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &contract, Data: revertInput}, nil)
+	require.Error(t, err)
+
+	var revertErr *sgx.RevertError
+	require.True(t, errors.As(err, &revertErr))
+	require.Equal(t, "execution reverted: reverted by test node", revertErr.Error())
+	require.Equal(t, revertData("reverted by test node"), revertErr.Data())
+	require.Equal(t, 3, revertErr.ErrorCode())
+}
+
+func TestEstimateGas(t *testing.T) {
+	node := newTestNode()
+	client := sgx.NewClient(node.client())
+
+	// test node is able to decrypt data only if it was encrypted using its key
+	gas, err := client.EstimateGas(context.Background(), ethereum.CallMsg{To: &contract, Data: input})
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000+len(input)), gas)
+
+	gas, err = client.EstimateGas(context.Background(), ethereum.CallMsg{Data: input})
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000+len(input)), gas)
+}
+
+func TestEncryptTx(t *testing.T) {
+	node := newTestNode()
+	client := sgx.NewClient(node.client())
+	encryptionKey, err := sgx.NewEncryptionKey()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		tx   *ethtypes.Transaction
+	}{
+		{
+			"legacy transaction",
+			ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 100000, To: &contract, Value: big.NewInt(5), Data: input}),
+		},
+		{
+			"access list transaction",
+			ethtypes.NewTx(&ethtypes.AccessListTx{
+				ChainID: node.chainID, Nonce: 1, GasPrice: big.NewInt(10), Gas: 100000, To: &contract, Data: input,
+				AccessList: ethtypes.AccessList{{Address: contract, StorageKeys: []common.Hash{{0x01}}}},
+			}),
+		},
+		{
+			"dynamic fee transaction",
+			ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+				ChainID: node.chainID, Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 100000, To: &contract, Data: input,
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encryptedTx, err := client.EncryptTx(context.Background(), tc.tx, encryptionKey)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.tx.Type(), encryptedTx.Type())
+			require.Equal(t, tc.tx.Nonce(), encryptedTx.Nonce())
+			require.Equal(t, tc.tx.Gas(), encryptedTx.Gas())
+			require.Equal(t, tc.tx.GasFeeCap(), encryptedTx.GasFeeCap())
+			require.Equal(t, tc.tx.GasTipCap(), encryptedTx.GasTipCap())
+			require.Equal(t, tc.tx.Value(), encryptedTx.Value())
+			require.Equal(t, tc.tx.AccessList(), encryptedTx.AccessList())
+			require.NotEqual(t, input, encryptedTx.Data())
+
+			data, err := sgx.DecryptTxData(encryptionKey, node.nodePublicKey, encryptedTx.Data())
+			require.NoError(t, err)
+			require.Equal(t, input, data)
+		})
+	}
+
+	// data of contract creation is left as is
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 100000, Data: input})
+	encryptedTx, err := client.EncryptTx(context.Background(), tx, encryptionKey)
+	require.NoError(t, err)
+	require.Equal(t, input, encryptedTx.Data())
+}
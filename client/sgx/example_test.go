@@ -0,0 +1,78 @@
+package sgx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"swisstronik/client/sgx"
+)
+
+func ExampleClient_CallContract() {
+	url, stop := newTestNode().start()
+	defer stop()
+
+	client, err := sgx.Dial(url)
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+
+	// data is encrypted using node public key and result is decrypted by client
+	ret, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &contract, Data: input}, nil)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%x\n", ret)
+
+	// revert reason is available after decryption of revert data
+	_, err = client.CallContract(context.Background(), ethereum.CallMsg{To: &contract, Data: revertInput}, nil)
+	var revertErr *sgx.RevertError
+	fmt.Println(errors.As(err, &revertErr), err)
+
+	// Output:
+	// deadbeef
+	// true execution reverted: reverted by test node
+}
+
+func ExampleClient_EncryptTx() {
+	node := newTestNode()
+	url, stop := node.start()
+	defer stop()
+
+	client, err := sgx.Dial(url)
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+
+	// keep encryption key to be able to decrypt data of the transaction later
+	encryptionKey, err := sgx.NewEncryptionKey()
+	if err != nil {
+		panic(err)
+	}
+
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 0, GasPrice: big.NewInt(1), Gas: 100000, To: &contract, Data: input})
+	encryptedTx, err := client.EncryptTx(context.Background(), tx, encryptionKey)
+	if err != nil {
+		panic(err)
+	}
+	// encryptedTx should be signed and sent using client.SendTransaction
+
+	nodePublicKey, err := client.NodePublicKey(context.Background(), nil)
+	if err != nil {
+		panic(err)
+	}
+	data, err := sgx.DecryptTxData(encryptionKey, nodePublicKey, encryptedTx.Data())
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%x\n", data)
+
+	// Output:
+	// deadbeef
+}
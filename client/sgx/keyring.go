@@ -0,0 +1,73 @@
+package sgx
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"swisstronik/crypto/ethsecp256k1"
+)
+
+// encryptionKeyMessage is signed by account key to derive key for encryption of transaction data
+var encryptionKeyMessage = crypto.Keccak256([]byte("Swisstronik transaction data encryption key"))
+
+var _ Signer = (*KeyringSigner)(nil)
+
+// KeyringSigner signs transactions using eth_secp256k1 key stored in cosmos keyring
+type KeyringSigner struct {
+	keyring keyring.Keyring
+	uid     string
+	address common.Address
+}
+
+// NewKeyringSigner returns signer, which uses key with provided name from keyring
+func NewKeyringSigner(kr keyring.Keyring, uid string) (*KeyringSigner, error) {
+	record, err := kr.Key(uid)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := pubKey.(*ethsecp256k1.PubKey); !ok {
+		return nil, fmt.Errorf("key %s has unsupported type %s, expected %s", uid, pubKey.Type(), ethsecp256k1.KeyType)
+	}
+
+	return &KeyringSigner{
+		keyring: kr,
+		uid:     uid,
+		address: common.BytesToAddress(pubKey.Address()),
+	}, nil
+}
+
+// Address returns address of the account
+func (s *KeyringSigner) Address() common.Address {
+	return s.address
+}
+
+// EncryptionKey derives encryption key from signature of constant message. Since signatures are
+// deterministic, the same key is returned each time, so data of sent transactions can be decrypted later.
+func (s *KeyringSigner) EncryptionKey() ([]byte, error) {
+	signature, _, err := s.keyring.Sign(s.uid, encryptionKeyMessage)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(signature), nil
+}
+
+// SignTx signs transaction using the latest signer for provided chain id
+func (s *KeyringSigner) SignTx(tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	txHash := signer.Hash(tx)
+
+	signature, _, err := s.keyring.Sign(s.uid, txHash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, signature)
+}
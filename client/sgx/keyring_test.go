@@ -0,0 +1,142 @@
+package sgx_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"swisstronik/client/sgx"
+	ethhd "swisstronik/crypto/hd"
+	enccodec "swisstronik/encoding/codec"
+	ethermint "swisstronik/types"
+)
+
+func newTestKeyring(t *testing.T) keyring.Keyring {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	enccodec.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	kr := keyring.NewInMemory(cdc, ethhd.EthSecp256k1Option())
+	_, _, err := kr.NewMnemonic("eth", keyring.English, ethermint.BIP44HDPath, keyring.DefaultBIP39Passphrase, ethhd.EthSecp256k1)
+	require.NoError(t, err)
+	return kr
+}
+
+func TestNewKeyringSigner(t *testing.T) {
+	kr := newTestKeyring(t)
+
+	signer, err := sgx.NewKeyringSigner(kr, "eth")
+	require.NoError(t, err)
+
+	record, err := kr.Key("eth")
+	require.NoError(t, err)
+	address, err := record.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, address.Bytes(), signer.Address().Bytes())
+
+	_, err = sgx.NewKeyringSigner(kr, "unknown")
+	require.Error(t, err)
+
+	// only eth_secp256k1 keys are supported
+	_, err = kr.NewAccount("cosmos", testMnemonic(t), keyring.DefaultBIP39Passphrase, ethermint.BIP44HDPath, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = sgx.NewKeyringSigner(kr, "cosmos")
+	require.ErrorContains(t, err, "unsupported type")
+}
+
+func TestKeyringSignerEncryptionKey(t *testing.T) {
+	signer, err := sgx.NewKeyringSigner(newTestKeyring(t), "eth")
+	require.NoError(t, err)
+
+	key, err := signer.EncryptionKey()
+	require.NoError(t, err)
+	require.Len(t, key, sgx.EncryptionKeyLength)
+
+	// key is deterministic, therefore it can be used to decrypt sent transactions later
+	sameKey, err := signer.EncryptionKey()
+	require.NoError(t, err)
+	require.Equal(t, key, sameKey)
+
+	otherSigner, err := sgx.NewKeyringSigner(newTestKeyring(t), "eth")
+	require.NoError(t, err)
+	otherKey, err := otherSigner.EncryptionKey()
+	require.NoError(t, err)
+	require.NotEqual(t, key, otherKey)
+}
+
+func TestKeyringSignerSignTx(t *testing.T) {
+	signer, err := sgx.NewKeyringSigner(newTestKeyring(t), "eth")
+	require.NoError(t, err)
+
+	chainID := big.NewInt(1291)
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID: chainID, Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 100000, To: &contract, Data: input,
+	})
+
+	signedTx, err := signer.SignTx(tx, chainID)
+	require.NoError(t, err)
+
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(chainID), signedTx)
+	require.NoError(t, err)
+	require.Equal(t, signer.Address(), sender)
+}
+
+func TestSendEncryptedTransaction(t *testing.T) {
+	node := newTestNode()
+	client := sgx.NewClient(node.client())
+	signer, err := sgx.NewKeyringSigner(newTestKeyring(t), "eth")
+	require.NoError(t, err)
+
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID: node.chainID, Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 100000, To: &contract, Data: input,
+	})
+	signedTx, err := client.SendEncryptedTransaction(context.Background(), tx, signer)
+	require.NoError(t, err)
+
+	sent := node.sentTransactions()
+	require.Len(t, sent, 1)
+	require.Equal(t, signedTx.Hash(), sent[0].Hash())
+
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(node.chainID), sent[0])
+	require.NoError(t, err)
+	require.Equal(t, signer.Address(), sender)
+
+	// sender is able to decrypt data of sent transaction
+	encryptionKey, err := signer.EncryptionKey()
+	require.NoError(t, err)
+	data, err := sgx.DecryptTxData(encryptionKey, node.nodePublicKey, sent[0].Data())
+	require.NoError(t, err)
+	require.Equal(t, input, data)
+}
+
+func TestTransactOpts(t *testing.T) {
+	node := newTestNode()
+	client := sgx.NewClient(node.client())
+	signer, err := sgx.NewKeyringSigner(newTestKeyring(t), "eth")
+	require.NoError(t, err)
+
+	opts := client.TransactOpts(context.Background(), signer, node.chainID)
+	require.Equal(t, signer.Address(), opts.From)
+
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 100000, To: &contract, Data: input})
+	signedTx, err := opts.Signer(signer.Address(), tx)
+	require.NoError(t, err)
+	require.NotEqual(t, input, signedTx.Data())
+
+	_, err = opts.Signer(contract, tx)
+	require.Error(t, err)
+}
+
+func testMnemonic(t *testing.T) string {
+	kr := keyring.NewInMemory(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+	_, mnemonic, err := kr.NewMnemonic("tmp", keyring.English, ethermint.BIP44HDPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	return mnemonic
+}
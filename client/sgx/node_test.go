@@ -0,0 +1,179 @@
+package sgx_test
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"swisstronik/crypto/deoxys"
+)
+
+// revertInput is input of call, which is reverted by test node
+var revertInput = []byte("revert")
+
+// testNode imitates encryption and decryption performed by Swisstronik node
+type testNode struct {
+	chainID        *big.Int
+	nodePrivateKey []byte
+	nodePublicKey  []byte
+
+	mu           sync.Mutex
+	transactions []*ethtypes.Transaction
+}
+
+func newTestNode() *testNode {
+	var nodePrivateKey [32]byte
+	copy(nodePrivateKey[:], crypto.Keccak256([]byte("test node")))
+	nodePublicKey := deoxys.GetCurve25519PublicKey(nodePrivateKey)
+
+	return &testNode{
+		chainID:        big.NewInt(1291),
+		nodePrivateKey: nodePrivateKey[:],
+		nodePublicKey:  nodePublicKey[:],
+	}
+}
+
+// server returns JSON-RPC server, which exposes methods of test node in `eth` namespace
+func (n *testNode) server() *rpc.Server {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", &testNodeAPI{node: n}); err != nil {
+		panic(err)
+	}
+	return server
+}
+
+// client returns RPC client connected to in-process test node
+func (n *testNode) client() *rpc.Client {
+	return rpc.DialInProc(n.server())
+}
+
+// start starts HTTP server of test node and returns its URL
+func (n *testNode) start() (string, func()) {
+	server := n.server()
+	httpServer := httptest.NewServer(server)
+	return httpServer.URL, func() {
+		httpServer.Close()
+		server.Stop()
+	}
+}
+
+func (n *testNode) sentTransactions() []*ethtypes.Transaction {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.transactions
+}
+
+// decrypt decrypts data encrypted by user and returns user public key
+func (n *testNode) decrypt(data []byte) ([]byte, []byte, error) {
+	if len(data) < 32 {
+		return nil, nil, errors.New("DecryptionError")
+	}
+	userPublicKey := data[:32]
+	plaintext, err := deoxys.DecryptECDH(n.nodePrivateKey, userPublicKey, data[32:])
+	if err != nil {
+		return nil, nil, errors.New("DecryptionError")
+	}
+	return plaintext, userPublicKey, nil
+}
+
+// encrypt encrypts result of execution for user
+func (n *testNode) encrypt(userPublicKey, data []byte) []byte {
+	encrypted, err := deoxys.EncryptECDH(n.nodePrivateKey, userPublicKey, data)
+	if err != nil {
+		panic(err)
+	}
+	// strip public key of node, since it is not included into results
+	return encrypted[32:]
+}
+
+type testNodeAPI struct {
+	node *testNode
+}
+
+type testCallArgs struct {
+	From *common.Address `json:"from"`
+	To   *common.Address `json:"to"`
+	Data *hexutil.Bytes  `json:"data"`
+}
+
+type testRevertError struct {
+	data []byte
+}
+
+func (e *testRevertError) Error() string          { return "execution reverted" }
+func (e *testRevertError) ErrorCode() int         { return 3 }
+func (e *testRevertError) ErrorData() interface{} { return hexutil.Encode(e.data) }
+
+func (api *testNodeAPI) ChainId() *hexutil.Big { //nolint: revive,stylecheck
+	return (*hexutil.Big)(api.node.chainID)
+}
+
+func (api *testNodeAPI) GetNodePublicKey(blockNumber string) hexutil.Bytes {
+	return api.node.nodePublicKey
+}
+
+// Call returns input of the call, or reverts with reason "reverted by test node" if input is `revertInput`
+func (api *testNodeAPI) Call(args testCallArgs, blockNumber string) (hexutil.Bytes, error) {
+	if args.Data == nil {
+		return nil, nil
+	}
+	if args.To == nil {
+		return *args.Data, nil
+	}
+
+	input, userPublicKey, err := api.node.decrypt(*args.Data)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(input, revertInput) {
+		return nil, &testRevertError{data: api.node.encrypt(userPublicKey, revertData("reverted by test node"))}
+	}
+	return api.node.encrypt(userPublicKey, input), nil
+}
+
+// EstimateGas returns intrinsic gas of transfer increased by length of decrypted input
+func (api *testNodeAPI) EstimateGas(args testCallArgs) (hexutil.Uint64, error) {
+	if args.Data == nil {
+		return 21000, nil
+	}
+	input := []byte(*args.Data)
+	if args.To != nil {
+		var err error
+		if input, _, err = api.node.decrypt(input); err != nil {
+			return 0, err
+		}
+	}
+	return hexutil.Uint64(21000 + len(input)), nil
+}
+
+func (api *testNodeAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return common.Hash{}, err
+	}
+
+	api.node.mu.Lock()
+	defer api.node.mu.Unlock()
+	api.node.transactions = append(api.node.transactions, tx)
+	return tx.Hash(), nil
+}
+
+// revertData encodes revert reason in the same way as Solidity `revert(reason)`
+func revertData(reason string) []byte {
+	selector := crypto.Keccak256([]byte("Error(string)"))[:4]
+	offset := common.LeftPadBytes(big.NewInt(32).Bytes(), 32)
+	length := common.LeftPadBytes(big.NewInt(int64(len(reason))).Bytes(), 32)
+	padded := common.RightPadBytes([]byte(reason), (len(reason)+31)/32*32)
+
+	data := append(selector, offset...)
+	data = append(data, length...)
+	return append(data, padded...)
+}
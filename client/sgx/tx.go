@@ -0,0 +1,141 @@
+package sgx
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"swisstronik/crypto/deoxys"
+)
+
+// Signer signs transactions on behalf of account and provides key for encryption of transaction data
+type Signer interface {
+	// Address returns address of the account
+	Address() common.Address
+	// EncryptionKey returns x25519 private key, which is used to encrypt data of transactions
+	EncryptionKey() ([]byte, error)
+	// SignTx signs transaction using signer for provided chain id
+	SignTx(tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error)
+}
+
+// EncryptTx returns copy of unsigned transaction with data encrypted using node public key of the latest block
+// and provided encryption key. The same key can be used later to decrypt data of the transaction.
+// Transactions, which create contracts or have no data, are returned unchanged.
+func (c *Client) EncryptTx(ctx context.Context, tx *ethtypes.Transaction, encryptionKey []byte) (*ethtypes.Transaction, error) {
+	if tx.To() == nil || len(tx.Data()) == 0 {
+		return tx, nil
+	}
+
+	nodePublicKey, err := c.NodePublicKey(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	encryptedData, err := deoxys.EncryptECDH(encryptionKey, nodePublicKey, tx.Data())
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := withData(tx, encryptedData)
+	if err != nil {
+		return nil, err
+	}
+	return ethtypes.NewTx(txData), nil
+}
+
+// SendEncryptedTransaction encrypts data of unsigned transaction using key of signer, signs it and
+// sends it to the node. Signed transaction is returned.
+func (c *Client) SendEncryptedTransaction(ctx context.Context, tx *ethtypes.Transaction, signer Signer) (*ethtypes.Transaction, error) {
+	chainID, err := c.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := c.encryptAndSign(ctx, tx, signer, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.SendTransaction(ctx, signedTx); err != nil {
+		return nil, err
+	}
+	return signedTx, nil
+}
+
+// TransactOpts returns options for contract bindings, which encrypt data of transactions
+// using key of signer before signing them
+func (c *Client) TransactOpts(ctx context.Context, signer Signer, chainID *big.Int) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From: signer.Address(),
+		Signer: func(address common.Address, tx *ethtypes.Transaction) (*ethtypes.Transaction, error) {
+			if address != signer.Address() {
+				return nil, bind.ErrNotAuthorized
+			}
+			return c.encryptAndSign(ctx, tx, signer, chainID)
+		},
+		Context: ctx,
+	}
+}
+
+func (c *Client) encryptAndSign(ctx context.Context, tx *ethtypes.Transaction, signer Signer, chainID *big.Int) (*ethtypes.Transaction, error) {
+	encryptionKey, err := signer.EncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	encryptedTx, err := c.EncryptTx(ctx, tx, encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return signer.SignTx(encryptedTx, chainID)
+}
+
+// DecryptTxData decrypts data of transaction, which was encrypted using provided encryption key.
+// Node public key should be the key of epoch, when transaction was sent.
+func DecryptTxData(encryptionKey, nodePublicKey, data []byte) ([]byte, error) {
+	// Encrypted data is prepended with user public key
+	if len(data) < EncryptionKeyLength {
+		return nil, fmt.Errorf("encrypted data is too short: %d bytes", len(data))
+	}
+	return DecryptData(encryptionKey, nodePublicKey, data[EncryptionKeyLength:])
+}
+
+// withData returns copy of transaction data with replaced input
+func withData(tx *ethtypes.Transaction, data []byte) (ethtypes.TxData, error) {
+	switch tx.Type() {
+	case ethtypes.LegacyTxType:
+		return &ethtypes.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: tx.GasPrice(),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     data,
+		}, nil
+	case ethtypes.AccessListTxType:
+		return &ethtypes.AccessListTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasPrice:   tx.GasPrice(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       data,
+			AccessList: tx.AccessList(),
+		}, nil
+	case ethtypes.DynamicFeeTxType:
+		return &ethtypes.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       data,
+			AccessList: tx.AccessList(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported transaction type: %d", tx.Type())
+	}
+}
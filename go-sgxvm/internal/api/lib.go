@@ -210,6 +210,54 @@ func GetNodePublicKey(blockNumber uint64) (*types.NodePublicKeyResponse, error)
 	return &response, nil
 }
 
+// GetStorageAtEncrypted handles request for storage slot value of the contract.
+// Enclave checks that request was signed by contract owner and returns value
+// encrypted with shared key derived from provided user public key.
+func GetStorageAtEncrypted(
+	connector Connector,
+	contractAddress, storageKey, userPublicKey, signature []byte,
+	deploymentNonce, blockNumber uint64,
+) (*types.StorageAtEncryptedResponse, error) {
+	c := BuildConnector(connector)
+
+	// Create protobuf-encoded request
+	req := &types.FFIRequest{Req: &types.FFIRequest_StorageAtEncryptedRequest{
+		StorageAtEncryptedRequest: &types.StorageAtEncryptedRequest{
+			ContractAddress: contractAddress,
+			StorageKey:      storageKey,
+			UserPublicKey:   userPublicKey,
+			Signature:       signature,
+			DeploymentNonce: deploymentNonce,
+			BlockNumber:     blockNumber,
+		},
+	}}
+	reqBytes, err := proto.Marshal(req)
+	if err != nil {
+		log.Fatalln("Failed to encode req:", err)
+		return nil, err
+	}
+
+	// Pass request to Rust
+	d := MakeView(reqBytes)
+	defer runtime.KeepAlive(reqBytes)
+
+	errmsg := NewUnmanagedVector(nil)
+	ptr, err := C.make_pb_request(c, d, &errmsg)
+	if err != nil {
+		return &types.StorageAtEncryptedResponse{}, ErrorWithMessage(err, errmsg)
+	}
+
+	// Recover returned value
+	executionResult := CopyAndDestroyUnmanagedVector(ptr)
+	response := types.StorageAtEncryptedResponse{}
+	if err := proto.Unmarshal(executionResult, &response); err != nil {
+		log.Fatalln("Failed to decode storage value:", err)
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // DumpDCAPQuote generates DCAP quote for the enclave and writes it to the disk
 func DumpDCAPQuote(filepath string) error {
 	// Create protobuf encoded request
@@ -275,23 +323,27 @@ func Call(
 	maxFeePerGas *big.Int,
 	maxPriorityFeePerGas *big.Int,
 	txType uint8,
+	trace *types.TraceOptions,
+	authorizationList []*types.AuthorizationListItem,
 ) (*types.HandleTransactionResponse, error) {
 	// Construct mocked querier
 	c := BuildConnector(connector)
 
 	// Create protobuf-encoded transaction data
 	params := &types.SGXVMCallParams{
-		From:        from,
-		To:          to,
-		Data:        data,
-		GasLimit:    gasLimit,
-		Value:       value,
-		AccessList:  convertAccessList(accessList),
-		Commit:      commit,
-		Nonce:       nonce,
-		Unencrypted: isUnencrypted,
-		Signature:   transactionSignature,
-		TxType:      uint32(txType),
+		From:              from,
+		To:                to,
+		Data:              data,
+		GasLimit:          gasLimit,
+		Value:             value,
+		AccessList:        convertAccessList(accessList),
+		Commit:            commit,
+		Nonce:             nonce,
+		Unencrypted:       isUnencrypted,
+		Signature:         transactionSignature,
+		TxType:            uint32(txType),
+		Trace:             trace,
+		AuthorizationList: authorizationList,
 	}
 
 	if gasPrice != nil {
@@ -428,6 +480,7 @@ func Create(
 	maxFeePerGas *big.Int,
 	maxPriorityFeePerGas *big.Int,
 	txType uint8,
+	trace *types.TraceOptions,
 ) (*types.HandleTransactionResponse, error) {
 	// Construct mocked querier
 	c := BuildConnector(connector)
@@ -443,6 +496,7 @@ func Create(
 		Nonce:      nonce,
 		Signature:  transactionSignature,
 		TxType:     uint32(txType),
+		Trace:      trace,
 	}
 	if gasPrice != nil {
 		params.GasPrice = gasPrice.Bytes()
@@ -8,9 +8,9 @@ package api
 import "C"
 
 import (
+	"github.com/SigmaGmbH/librustgo/internal/refvm"
 	"github.com/SigmaGmbH/librustgo/types"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"math/rand"
 	"math/big"
 	"net"
 )
@@ -65,14 +65,22 @@ func RequestSeed(hostname string, port int) error {
 	return nil
 }
 
-// GetNodePublicKey handles request for node public key
+// GetNodePublicKey handles request for node public key. Without SGX, deterministic
+// development key of reference executor is returned
 func GetNodePublicKey(blockNumber uint64) (*types.NodePublicKeyResponse, error) {
-	key := make([]byte, 32)
-	rand.Read(key)
-	return &types.NodePublicKeyResponse{PublicKey: key}, nil
+	return refvm.GetNodePublicKey(blockNumber)
 }
 
-// Call handles incoming call to contract or transfer of value
+// GetStorageAtEncrypted handles request for storage slot value of the contract using reference executor
+func GetStorageAtEncrypted(
+	connector Connector,
+	contractAddress, storageKey, userPublicKey, signature []byte,
+	deploymentNonce, blockNumber uint64,
+) (*types.StorageAtEncryptedResponse, error) {
+	return refvm.GetStorageAtEncrypted(connector, contractAddress, storageKey, userPublicKey, signature, deploymentNonce, blockNumber)
+}
+
+// Call handles incoming call to contract or transfer of value using reference executor
 func Call(
 	connector Connector,
 	from, to, data, value []byte,
@@ -87,8 +95,10 @@ func Call(
 	maxFeePerGas *big.Int,
 	maxPriorityFeePerGas *big.Int,
 	txType uint8,
+	trace *types.TraceOptions,
+	authorizationList []*types.AuthorizationListItem,
 ) (*types.HandleTransactionResponse, error) {
-	return nil, nil
+	return refvm.Call(connector, from, to, data, value, accessList, gasLimit, gasPrice, nonce, txContext, commit, isUnencrypted, transactionSignature, maxFeePerGas, maxPriorityFeePerGas, txType, trace, authorizationList)
 }
 
 func EstimateGas(
@@ -104,10 +114,10 @@ func EstimateGas(
 	maxPriorityFeePerGas *big.Int,
 	txType uint8,
 ) (*types.HandleTransactionResponse, error) {
-	return nil, nil
+	return refvm.EstimateGas(connector, from, to, data, value, accessList, gasLimit, gasPrice, nonce, txContext, isUnencrypted, maxFeePerGas, maxPriorityFeePerGas, txType)
 }
 
-// Create handles incoming request for creation of new contract
+// Create handles incoming request for creation of new contract using reference executor
 func Create(
 	connector Connector,
 	from, data, value []byte,
@@ -121,8 +131,9 @@ func Create(
 	maxFeePerGas *big.Int,
 	maxPriorityFeePerGas *big.Int,
 	txType uint8,
+	trace *types.TraceOptions,
 ) (*types.HandleTransactionResponse, error) {
-	return nil, nil
+	return refvm.Create(connector, from, data, value, accessList, gasLimit, gasPrice, nonce, txContext, commit, transactionSignature, maxFeePerGas, maxPriorityFeePerGas, txType, trace)
 }
 
 // StartAttestationServer starts attestation server
@@ -138,6 +149,7 @@ func RemoveLatestEpoch() error {
 	return nil
 }
 
+// ListEpochs returns epochs of reference executor. Without SGX, there is the only epoch using development key
 func ListEpochs() ([]*types.EpochData, error) {
-	return nil, nil
+	return refvm.ListEpochs()
 }
@@ -0,0 +1,58 @@
+//go:build nosgx
+// +build nosgx
+
+package refvm
+
+import "github.com/ethereum/go-ethereum/common"
+
+// accessList tracks addresses and storage slots accessed during execution (EIP-2929)
+type accessList struct {
+	addresses map[common.Address]map[common.Hash]struct{}
+}
+
+func newAccessList() *accessList {
+	return &accessList{addresses: make(map[common.Address]map[common.Hash]struct{})}
+}
+
+func (al *accessList) containsAddress(addr common.Address) bool {
+	_, ok := al.addresses[addr]
+	return ok
+}
+
+func (al *accessList) contains(addr common.Address, slot common.Hash) (addressOk bool, slotOk bool) {
+	slots, ok := al.addresses[addr]
+	if !ok {
+		return false, false
+	}
+	_, slotOk = slots[slot]
+	return true, slotOk
+}
+
+// addAddress adds address to the access list and returns true if it was not present
+func (al *accessList) addAddress(addr common.Address) bool {
+	if al.containsAddress(addr) {
+		return false
+	}
+	al.addresses[addr] = make(map[common.Hash]struct{})
+	return true
+}
+
+// addSlot adds storage slot to the access list and returns whether address and slot were added
+func (al *accessList) addSlot(addr common.Address, slot common.Hash) (addrAdded bool, slotAdded bool) {
+	addrAdded = al.addAddress(addr)
+	if _, ok := al.addresses[addr][slot]; ok {
+		return addrAdded, false
+	}
+	al.addresses[addr][slot] = struct{}{}
+	return addrAdded, true
+}
+
+func (al *accessList) deleteAddress(addr common.Address) {
+	delete(al.addresses, addr)
+}
+
+func (al *accessList) deleteSlot(addr common.Address, slot common.Hash) {
+	if slots, ok := al.addresses[addr]; ok {
+		delete(slots, slot)
+	}
+}
@@ -0,0 +1,106 @@
+//go:build nosgx
+// +build nosgx
+
+package refvm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/oasisprotocol/deoxysii"
+	"golang.org/x/crypto/curve25519"
+)
+
+const (
+	// PublicKeyLength is the length of x25519 public keys of users and node
+	PublicKeyLength = 32
+
+	devNodeKeySeed = "swisstronik-reference-sgxvm-dev-node-key"
+)
+
+var (
+	// DevNodePrivateKey is deterministic x25519 private key used by reference executor instead of
+	// epoch keys sealed by the enclave. It is publicly known and must never be used outside of development.
+	DevNodePrivateKey = sha256.Sum256([]byte(devNodeKeySeed))
+	// DevNodePublicKey is x25519 public key corresponding to `DevNodePrivateKey`
+	DevNodePublicKey = mustPublicKey(DevNodePrivateKey[:])
+
+	// ErrDecryption is returned if transaction data cannot be decrypted using node key
+	ErrDecryption = errors.New("DecryptionError")
+)
+
+func mustPublicKey(privateKey []byte) []byte {
+	publicKey, err := curve25519.X25519(privateKey, curve25519.Basepoint)
+	if err != nil {
+		panic(err)
+	}
+	return publicKey
+}
+
+// deriveIOKey derives the same transaction encryption key as enclave does:
+// HMAC-SHA256 with `IOEncryptionKeyV1` salt over x25519 shared secret of user and node.
+func deriveIOKey(userPublicKey []byte) ([]byte, error) {
+	sharedSecret, err := curve25519.X25519(DevNodePrivateKey[:], userPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := hmac.New(sha256.New, []byte("IOEncryptionKeyV1"))
+	hash.Write(sharedSecret)
+	return hash.Sum(nil), nil
+}
+
+// decryptTxData decrypts transaction data in format `user public key || nonce || ad || ciphertext`.
+// Returns public key of the user, which is used to encrypt execution result.
+func decryptTxData(data []byte) (plaintext, userPublicKey []byte, err error) {
+	if len(data) < PublicKeyLength+deoxysii.NonceSize+deoxysii.TagSize {
+		return nil, nil, ErrDecryption
+	}
+
+	userPublicKey = data[:PublicKeyLength]
+	key, err := deriveIOKey(userPublicKey)
+	if err != nil {
+		return nil, nil, ErrDecryption
+	}
+
+	encrypted := data[PublicKeyLength:]
+	nonce := encrypted[:deoxysii.NonceSize]
+	ad := encrypted[deoxysii.NonceSize : deoxysii.NonceSize+deoxysii.TagSize]
+	ciphertext := encrypted[deoxysii.NonceSize+deoxysii.TagSize:]
+
+	cipher, err := deoxysii.New(key)
+	if err != nil {
+		return nil, nil, ErrDecryption
+	}
+
+	plaintext, err = cipher.Open(nil, nonce, ciphertext, ad)
+	if err != nil {
+		return nil, nil, ErrDecryption
+	}
+
+	return plaintext, userPublicKey, nil
+}
+
+// encryptResult encrypts execution result for the user in format `nonce || ad || ciphertext`.
+// Nonce is derived from the key and plaintext, so all nodes produce the same transaction result.
+// Deoxys-II is nonce-misuse resistant, so deterministic nonce reveals only equality of results.
+func encryptResult(userPublicKey, plaintext []byte) ([]byte, error) {
+	key, err := deriveIOKey(userPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceHash := hmac.New(sha256.New, key)
+	nonceHash.Write(plaintext)
+	nonce := nonceHash.Sum(nil)[:deoxysii.NonceSize]
+	ad := make([]byte, deoxysii.TagSize)
+
+	cipher, err := deoxysii.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	result := append(append([]byte{}, nonce...), ad...)
+	return cipher.Seal(result, nonce, plaintext, ad), nil
+}
@@ -0,0 +1,382 @@
+//go:build nosgx
+// +build nosgx
+
+// Package refvm contains reference implementation of SGXVM, which is used in builds without SGX.
+// It executes transactions using go-ethereum EVM and accesses state only through `Connector`,
+// using the same protobuf requests as the enclave. Transaction data is decrypted and execution
+// result is encrypted using deterministic development node key instead of sealed epoch keys.
+//
+// Reference executor does not provide confidentiality: contract storage is stored unencrypted
+// and development node key is publicly known. Precompiles specific to Swisstronik enclave are not
+// available.
+package refvm
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/SigmaGmbH/librustgo/types"
+)
+
+// ErrTracingNotSupported is returned if requested tracer is not supported by reference executor
+var ErrTracingNotSupported = errors.New("tracing is not supported by reference SGXVM executor")
+
+// errTracingNotAllowed is returned as VM error if encrypted transaction is traced by regular node
+var errTracingNotAllowed = errors.New("tracing of encrypted transactions is not allowed")
+
+// message contains transaction data passed to executor
+type message struct {
+	from        common.Address
+	to          *common.Address
+	data        []byte
+	value       *big.Int
+	accessList  ethtypes.AccessList
+	gasLimit    uint64
+	gasPrice    *big.Int
+	nonce       uint64
+	commit      bool
+	unencrypted bool
+	trace       *types.TraceOptions
+	// authorizations is the number of EIP-7702 authorizations, which were applied before execution
+	authorizations int
+}
+
+// Call handles incoming call to contract or transfer of value
+func Call(
+	connector types.Connector,
+	from, to, data, value []byte,
+	accessList ethtypes.AccessList,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	nonce uint64,
+	txContext *types.TransactionContext,
+	commit bool,
+	isUnencrypted bool,
+	transactionSignature []byte,
+	maxFeePerGas *big.Int,
+	maxPriorityFeePerGas *big.Int,
+	txType uint8,
+	trace *types.TraceOptions,
+	authorizationList []*types.AuthorizationListItem,
+) (*types.HandleTransactionResponse, error) {
+	toAddress := common.BytesToAddress(to)
+	return execute(connector, txContext, message{
+		from:           common.BytesToAddress(from),
+		to:             &toAddress,
+		data:           data,
+		value:          new(big.Int).SetBytes(value),
+		accessList:     accessList,
+		authorizations: len(authorizationList),
+		gasLimit:       gasLimit,
+		gasPrice:       gasPrice,
+		nonce:          nonce,
+		commit:         commit,
+		unencrypted:    isUnencrypted,
+		trace:          trace,
+	})
+}
+
+// Create handles incoming request for creation of new contract
+func Create(
+	connector types.Connector,
+	from, data, value []byte,
+	accessList ethtypes.AccessList,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	nonce uint64,
+	txContext *types.TransactionContext,
+	commit bool,
+	transactionSignature []byte,
+	maxFeePerGas *big.Int,
+	maxPriorityFeePerGas *big.Int,
+	txType uint8,
+	trace *types.TraceOptions,
+) (*types.HandleTransactionResponse, error) {
+	return execute(connector, txContext, message{
+		from:       common.BytesToAddress(from),
+		data:       data,
+		value:      new(big.Int).SetBytes(value),
+		accessList: accessList,
+		gasLimit:   gasLimit,
+		gasPrice:   gasPrice,
+		nonce:      nonce,
+		commit:     commit,
+		trace:      trace,
+	})
+}
+
+// EstimateGas executes call or contract creation without committing state changes.
+// Contract creation is executed if `to` is empty.
+func EstimateGas(
+	connector types.Connector,
+	from, to, data, value []byte,
+	accessList ethtypes.AccessList,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	nonce uint64,
+	txContext *types.TransactionContext,
+	isUnencrypted bool,
+	maxFeePerGas *big.Int,
+	maxPriorityFeePerGas *big.Int,
+	txType uint8,
+) (*types.HandleTransactionResponse, error) {
+	msg := message{
+		from:        common.BytesToAddress(from),
+		data:        data,
+		value:       new(big.Int).SetBytes(value),
+		accessList:  accessList,
+		gasLimit:    gasLimit,
+		gasPrice:    gasPrice,
+		nonce:       nonce,
+		unencrypted: isUnencrypted,
+	}
+	if len(to) != 0 {
+		toAddress := common.BytesToAddress(to)
+		msg.to = &toAddress
+	}
+
+	return execute(connector, txContext, msg)
+}
+
+// GetNodePublicKey returns public key of development node key. The same key is used for all blocks.
+func GetNodePublicKey(blockNumber uint64) (*types.NodePublicKeyResponse, error) {
+	return &types.NodePublicKeyResponse{PublicKey: common.CopyBytes(DevNodePublicKey)}, nil
+}
+
+// ListEpochs returns the single epoch of reference executor, which starts at genesis and uses development node key
+func ListEpochs() ([]*types.EpochData, error) {
+	return []*types.EpochData{{EpochNumber: 0, StartingBlock: 0, NodePublicKey: common.CopyBytes(DevNodePublicKey)}}, nil
+}
+
+func execute(connector types.Connector, txContext *types.TransactionContext, msg message) (*types.HandleTransactionResponse, error) {
+	tracer, err := newTracer(msg.trace, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	intrinsicGas := intrinsicGas(msg.data, msg.accessList, msg.authorizations, msg.to == nil)
+	if msg.gasLimit < intrinsicGas {
+		return &types.HandleTransactionResponse{
+			VmError: "intrinsic gas too low",
+			GasUsed: msg.gasLimit,
+		}, nil
+	}
+
+	stateDB := NewStateDB(connector)
+
+	// Data of calls to contracts is encrypted by user using node public key, unless call is unencrypted
+	var userPublicKey []byte
+	data := msg.data
+	if msg.to != nil && !msg.unencrypted && len(data) != 0 && stateDB.GetCodeSize(*msg.to) != 0 {
+		// Decrypted input can be traced only by trace nodes, in the same way as in SGXVM
+		if isTracing(msg.trace) && !msg.trace.TraceNode {
+			return &types.HandleTransactionResponse{VmError: errTracingNotAllowed.Error()}, nil
+		}
+
+		plaintext, publicKey, err := decryptTxData(data)
+		if err != nil {
+			return &types.HandleTransactionResponse{
+				VmError: err.Error(),
+				GasUsed: intrinsicGas,
+			}, nil
+		}
+		data, userPublicKey = plaintext, publicKey
+	}
+
+	chainConfig := newChainConfig(txContext.ChainId)
+	blockContext := newBlockContext(stateDB, txContext)
+	gasPrice := msg.gasPrice
+	if gasPrice == nil {
+		gasPrice = new(big.Int).SetBytes(txContext.GasPrice)
+	}
+
+	vmConfig := vm.Config{}
+	if tracer != nil {
+		vmConfig.Debug = true
+		vmConfig.Tracer = tracer
+	}
+	evm := vm.NewEVM(blockContext, vm.TxContext{Origin: msg.from, GasPrice: gasPrice}, stateDB, chainConfig, vmConfig)
+	stateDB.PrepareAccessList(msg.from, msg.to, precompiles(), msg.accessList)
+
+	var (
+		ret         []byte
+		leftoverGas uint64
+		vmErr       error
+	)
+	sender := vm.AccountRef(msg.from)
+	if msg.to == nil {
+		// Nonce of sender is increased by EVM during contract creation
+		ret, _, leftoverGas, vmErr = evm.Create(sender, data, msg.gasLimit-intrinsicGas, msg.value)
+	} else {
+		// As SGXVM does, set nonce of sender to the next one after transaction nonce.
+		// It does not change nonce, which was already increased by ante handler or
+		// by authorization of the sender (EIP-7702).
+		if stateDB.GetNonce(msg.from) < msg.nonce+1 {
+			stateDB.SetNonce(msg.from, msg.nonce+1)
+		}
+		ret, leftoverGas, vmErr = evm.Call(sender, *msg.to, data, msg.gasLimit-intrinsicGas, msg.value)
+	}
+
+	if err := stateDB.Error(); err != nil {
+		return nil, err
+	}
+
+	// Refund counter is reported as is, it is capped by the caller (EIP-3529)
+	gasUsed := msg.gasLimit - leftoverGas
+	gasRefund := stateDB.GetRefund()
+
+	// Changes made by failed execution are already reverted, except increased nonce of sender
+	if msg.commit {
+		if err := stateDB.Commit(); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &types.HandleTransactionResponse{GasUsed: gasUsed, GasRefund: gasRefund}
+	if tracer != nil {
+		response.Trace = tracer.trace()
+	}
+	if vmErr != nil {
+		response.VmError = formatVMError(vmErr)
+		// Return data is available only for reverted execution
+		if errors.Is(vmErr, vm.ErrExecutionReverted) {
+			response.Ret = ret
+		}
+		return response, nil
+	}
+
+	response.Logs = convertLogs(stateDB.Logs())
+	response.Ret = ret
+	if userPublicKey != nil && len(ret) != 0 {
+		encryptedRet, err := encryptResult(userPublicKey, ret)
+		if err != nil {
+			return nil, err
+		}
+		response.Ret = encryptedRet
+	}
+
+	return response, nil
+}
+
+// newChainConfig returns configuration with all forks up to London activated from genesis,
+// which corresponds to configuration of SGXVM
+func newChainConfig(chainID uint64) *params.ChainConfig {
+	zero := big.NewInt(0)
+	return &params.ChainConfig{
+		ChainID:             new(big.Int).SetUint64(chainID),
+		HomesteadBlock:      zero,
+		EIP150Block:         zero,
+		EIP155Block:         zero,
+		EIP158Block:         zero,
+		ByzantiumBlock:      zero,
+		ConstantinopleBlock: zero,
+		PetersburgBlock:     zero,
+		IstanbulBlock:       zero,
+		MuirGlacierBlock:    zero,
+		BerlinBlock:         zero,
+		LondonBlock:         zero,
+	}
+}
+
+func newBlockContext(stateDB *StateDB, txContext *types.TransactionContext) vm.BlockContext {
+	return vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, addr common.Address, amount *big.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+			db.SubBalance(sender, amount)
+			db.AddBalance(recipient, amount)
+		},
+		GetHash:     stateDB.blockHash,
+		Coinbase:    common.BytesToAddress(txContext.BlockCoinbase),
+		GasLimit:    txContext.BlockGasLimit,
+		BlockNumber: new(big.Int).SetUint64(txContext.BlockNumber),
+		Time:        new(big.Int).SetUint64(txContext.Timestamp),
+		Difficulty:  big.NewInt(0),
+		BaseFee:     new(big.Int).SetBytes(txContext.BlockBaseFeePerGas),
+	}
+}
+
+// precompiles returns addresses of standard precompiles available since Berlin
+func precompiles() []common.Address {
+	addresses := make([]common.Address, 0, 9)
+	for i := byte(1); i <= 9; i++ {
+		addresses = append(addresses, common.BytesToAddress([]byte{i}))
+	}
+	return addresses
+}
+
+// intrinsicGas computes gas, which is charged before execution of transaction
+func intrinsicGas(data []byte, accessList ethtypes.AccessList, authorizations int, isContractCreation bool) uint64 {
+	gas := params.TxGas
+	if isContractCreation {
+		gas = params.TxGasContractCreation
+	}
+
+	for _, b := range data {
+		if b == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+
+	gas += uint64(len(accessList)) * params.TxAccessListAddressGas
+	gas += uint64(accessList.StorageKeys()) * params.TxAccessListStorageKeyGas
+
+	// Each EIP-7702 authorization is charged as creation of new account
+	gas += uint64(authorizations) * params.CallNewAccountGas
+
+	return gas
+}
+
+// formatVMError converts EVM error to the format of SGXVM errors, which are checked by callers
+func formatVMError(err error) string {
+	var invalidOpCode *vm.ErrInvalidOpCode
+	switch {
+	case errors.Is(err, vm.ErrOutOfGas), errors.Is(err, vm.ErrCodeStoreOutOfGas), errors.Is(err, vm.ErrGasUintOverflow):
+		return "OutOfGas"
+	case errors.Is(err, vm.ErrInsufficientBalance):
+		return "OutOfFund"
+	case errors.Is(err, vm.ErrDepth):
+		return "CallTooDeep"
+	case errors.Is(err, vm.ErrContractAddressCollision):
+		return "CreateCollision"
+	case errors.Is(err, vm.ErrMaxCodeSizeExceeded):
+		return "CreateContractLimit"
+	case errors.Is(err, vm.ErrInvalidJump):
+		return "InvalidJump"
+	case errors.Is(err, vm.ErrReturnDataOutOfBounds):
+		return "OutOfOffset"
+	case errors.Is(err, vm.ErrNonceUintOverflow):
+		return "MaxNonce"
+	case errors.As(err, &invalidOpCode):
+		var opcode uint64
+		if _, scanErr := fmt.Sscanf(err.Error(), "invalid opcode: opcode %v not defined", &opcode); scanErr == nil {
+			return fmt.Sprintf("InvalidOpcode(Opcode(%d))", opcode)
+		}
+	}
+	return err.Error()
+}
+
+func convertLogs(logs []*ethtypes.Log) []*types.Log {
+	converted := make([]*types.Log, 0, len(logs))
+	for _, log := range logs {
+		topics := make([]*types.Topic, 0, len(log.Topics))
+		for _, topic := range log.Topics {
+			topics = append(topics, &types.Topic{Inner: topic.Bytes()})
+		}
+		converted = append(converted, &types.Log{
+			Address: log.Address.Bytes(),
+			Topics:  topics,
+			Data:    log.Data,
+		})
+	}
+	return converted
+}
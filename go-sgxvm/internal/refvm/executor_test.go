@@ -0,0 +1,230 @@
+//go:build nosgx
+// +build nosgx
+
+package refvm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/oasisprotocol/deoxysii"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/SigmaGmbH/librustgo/types"
+)
+
+var (
+	// counterInitCode deploys contract, which increments storage slot 0 on each call and returns new value
+	counterInitCode = hexutil.MustDecode("0x6012600c60003960126000f36000546001018060005560005260206000f3")
+	counterCode     = hexutil.MustDecode("0x6000546001018060005560005260206000f3")
+	// revertInitCode deploys contract, which always reverts
+	revertInitCode = hexutil.MustDecode("0x6005600c60003960056000f360006000fd")
+
+	sender = common.HexToAddress("0x1000000000000000000000000000000000000001")
+)
+
+func newTestConnector(t *testing.T) types.MockedConnector {
+	db := types.CreateMockedDatabase()
+	balance := common.LeftPadBytes(big.NewInt(1_000_000_000).Bytes(), 32)
+	require.NoError(t, db.InsertAccount(sender, balance, 0))
+	return types.MockedConnector{DB: &db}
+}
+
+func deploy(t *testing.T, connector types.Connector, initCode []byte) common.Address {
+	res, err := Create(connector, sender.Bytes(), initCode, nil, nil, 1_000_000, big.NewInt(0), 0, types.GetDefaultTxContext(), true, nil, nil, nil, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, res.VmError)
+	return crypto.CreateAddress(sender, 0)
+}
+
+func call(t *testing.T, connector types.Connector, to common.Address, data []byte, value *big.Int, commit, unencrypted bool) *types.HandleTransactionResponse {
+	var valueBytes []byte
+	if value != nil {
+		valueBytes = value.Bytes()
+	}
+	res, err := Call(connector, sender.Bytes(), to.Bytes(), data, valueBytes, nil, 1_000_000, big.NewInt(0), 1, types.GetDefaultTxContext(), commit, unencrypted, nil, nil, nil, 0, nil, nil)
+	require.NoError(t, err)
+	return res
+}
+
+// encryptForNode encrypts data in the same way as users encrypt transaction data
+func encryptForNode(t *testing.T, userPrivateKey, data []byte) []byte {
+	userPublicKey, err := curve25519.X25519(userPrivateKey, curve25519.Basepoint)
+	require.NoError(t, err)
+
+	cipher, err := deoxysii.New(userIOKey(t, userPrivateKey))
+	require.NoError(t, err)
+
+	nonce := make([]byte, deoxysii.NonceSize)
+	ad := make([]byte, deoxysii.TagSize)
+	result := append(append(userPublicKey, nonce...), ad...)
+	return cipher.Seal(result, nonce, data, ad)
+}
+
+func decryptFromNode(t *testing.T, userPrivateKey, data []byte) []byte {
+	cipher, err := deoxysii.New(userIOKey(t, userPrivateKey))
+	require.NoError(t, err)
+
+	nonce := data[:deoxysii.NonceSize]
+	ad := data[deoxysii.NonceSize : deoxysii.NonceSize+deoxysii.TagSize]
+	plaintext, err := cipher.Open(nil, nonce, data[deoxysii.NonceSize+deoxysii.TagSize:], ad)
+	require.NoError(t, err)
+	return plaintext
+}
+
+func userIOKey(t *testing.T, userPrivateKey []byte) []byte {
+	sharedSecret, err := curve25519.X25519(userPrivateKey, DevNodePublicKey)
+	require.NoError(t, err)
+	hash := hmac.New(sha256.New, []byte("IOEncryptionKeyV1"))
+	hash.Write(sharedSecret)
+	return hash.Sum(nil)
+}
+
+func TestCreateAndCall(t *testing.T) {
+	connector := newTestConnector(t)
+	contract := deploy(t, connector, counterInitCode)
+
+	acct, err := connector.DB.GetAccountOrEmpty(contract)
+	require.NoError(t, err)
+	require.Equal(t, counterCode, acct.Code)
+	require.Equal(t, uint64(1), acct.Nonce)
+
+	senderAcct, err := connector.DB.GetAccountOrEmpty(sender)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), senderAcct.Nonce)
+
+	res := call(t, connector, contract, nil, nil, true, true)
+	require.Empty(t, res.VmError)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), res.Ret)
+	require.NotZero(t, res.GasUsed)
+
+	// state changes are not written if execution is not committed
+	res = call(t, connector, contract, nil, nil, false, true)
+	require.Equal(t, common.BigToHash(big.NewInt(2)).Bytes(), res.Ret)
+
+	value, err := connector.DB.GetStorageCell(contract, common.Hash{}.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), value)
+}
+
+func TestEncryptedCall(t *testing.T) {
+	connector := newTestConnector(t)
+	contract := deploy(t, connector, counterInitCode)
+
+	userPrivateKey := crypto.Keccak256([]byte("user"))
+	res := call(t, connector, contract, encryptForNode(t, userPrivateKey, []byte{0x01}), nil, true, false)
+	require.Empty(t, res.VmError)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), decryptFromNode(t, userPrivateKey, res.Ret))
+
+	// data, which was not encrypted using node key, is rejected
+	res = call(t, connector, contract, []byte{0x01, 0x02}, nil, true, false)
+	require.Contains(t, res.VmError, "DecryptionError")
+	require.Empty(t, res.Ret)
+
+	// data of calls to accounts without code is not decrypted
+	res = call(t, connector, sender, []byte{0x01, 0x02}, nil, true, false)
+	require.Empty(t, res.VmError)
+}
+
+func TestValueTransfer(t *testing.T) {
+	connector := newTestConnector(t)
+	recipient := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	res := call(t, connector, recipient, nil, big.NewInt(1000), true, true)
+	require.Empty(t, res.VmError)
+	require.Equal(t, uint64(21000), res.GasUsed)
+
+	recipientAcct, err := connector.DB.GetAccountOrEmpty(recipient)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), new(big.Int).SetBytes(recipientAcct.Balance))
+
+	senderAcct, err := connector.DB.GetAccountOrEmpty(sender)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1_000_000_000-1000), new(big.Int).SetBytes(senderAcct.Balance))
+	// nonce of sender is set to the next one after transaction nonce
+	require.Equal(t, uint64(2), senderAcct.Nonce)
+
+	// transfer of value exceeding balance fails
+	res = call(t, connector, recipient, nil, big.NewInt(2_000_000_000), true, true)
+	require.Equal(t, "OutOfFund", res.VmError)
+}
+
+func TestRevert(t *testing.T) {
+	connector := newTestConnector(t)
+	contract := deploy(t, connector, revertInitCode)
+
+	res := call(t, connector, contract, nil, nil, true, true)
+	require.Equal(t, "execution reverted", res.VmError)
+}
+
+func TestDelegatedCall(t *testing.T) {
+	connector := newTestConnector(t)
+	contract := deploy(t, connector, counterInitCode)
+
+	// sender delegates execution to counter contract and its nonce is increased by authorization
+	designator := append([]byte{0xef, 0x01, 0x00}, contract.Bytes()...)
+	require.NoError(t, connector.DB.InsertContractCode(sender, designator))
+	require.NoError(t, connector.DB.InsertAccountNonce(sender, 3))
+
+	authorizationList := []*types.AuthorizationListItem{{Address: contract.Bytes(), Nonce: 2}}
+	res, err := Call(connector, sender.Bytes(), sender.Bytes(), nil, nil, nil, 1_000_000, big.NewInt(0), 1, types.GetDefaultTxContext(), true, true, nil, nil, nil, 4, nil, authorizationList)
+	require.NoError(t, err)
+	require.Empty(t, res.VmError)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), res.Ret)
+
+	// code of delegation target is executed in context of delegating account
+	value, err := connector.DB.GetStorageCell(sender, common.Hash{}.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), value)
+
+	senderAcct, err := connector.DB.GetAccountOrEmpty(sender)
+	require.NoError(t, err)
+	require.Equal(t, designator, senderAcct.Code)
+	require.Equal(t, uint64(3), senderAcct.Nonce)
+
+	// each authorization is included into intrinsic gas
+	res, err = Call(connector, sender.Bytes(), sender.Bytes(), nil, nil, nil, 21000+25000-1, big.NewInt(0), 3, types.GetDefaultTxContext(), false, true, nil, nil, nil, 4, nil, authorizationList)
+	require.NoError(t, err)
+	require.Equal(t, "intrinsic gas too low", res.VmError)
+}
+
+func TestInvalidOpcode(t *testing.T) {
+	connector := newTestConnector(t)
+
+	res, err := Create(connector, sender.Bytes(), []byte{0xa6}, nil, nil, 100_000, big.NewInt(0), 0, types.GetDefaultTxContext(), true, nil, nil, nil, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, "InvalidOpcode(Opcode(166))", res.VmError)
+	require.Equal(t, uint64(100_000), res.GasUsed)
+
+	// nonce increased by failed contract creation is committed
+	senderAcct, err := connector.DB.GetAccountOrEmpty(sender)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), senderAcct.Nonce)
+}
+
+func TestGetNodePublicKey(t *testing.T) {
+	first, err := GetNodePublicKey(0)
+	require.NoError(t, err)
+	second, err := GetNodePublicKey(100)
+	require.NoError(t, err)
+
+	require.Len(t, first.PublicKey, PublicKeyLength)
+	require.Equal(t, first.PublicKey, second.PublicKey)
+}
+
+func TestListEpochs(t *testing.T) {
+	epochs, err := ListEpochs()
+	require.NoError(t, err)
+	require.Len(t, epochs, 1)
+	require.Equal(t, uint64(0), epochs[0].StartingBlock)
+
+	publicKey, err := GetNodePublicKey(0)
+	require.NoError(t, err)
+	require.Equal(t, publicKey.PublicKey, epochs[0].NodePublicKey)
+}
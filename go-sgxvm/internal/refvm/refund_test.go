@@ -0,0 +1,82 @@
+//go:build nosgx
+// +build nosgx
+
+package refvm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SigmaGmbH/librustgo/types"
+)
+
+// Test cases of EIP-3529. Used gas does not include intrinsic gas and cost of
+// cold access to slot 0, since slot is added to access list of transaction.
+// Ref: https://eips.ethereum.org/EIPS/eip-3529#test-cases
+var eip3529TestCases = []struct {
+	code     string
+	usedGas  uint64
+	refund   uint64
+	original int64
+}{
+	{"0x60006000556000600055", 212, 0, 0},
+	{"0x60006000556001600055", 20112, 0, 0},
+	{"0x60016000556000600055", 20112, 19900, 0},
+	{"0x60016000556002600055", 20112, 0, 0},
+	{"0x60016000556001600055", 20112, 0, 0},
+	{"0x60006000556000600055", 3012, 4800, 1},
+	{"0x60006000556001600055", 3012, 2800, 1},
+	{"0x60006000556002600055", 3012, 0, 1},
+	{"0x60026000556000600055", 3012, 4800, 1},
+	{"0x60026000556003600055", 3012, 0, 1},
+	{"0x60026000556001600055", 3012, 2800, 1},
+	{"0x60026000556002600055", 3012, 0, 1},
+	{"0x60016000556000600055", 3012, 4800, 1},
+	{"0x60016000556002600055", 3012, 0, 1},
+	{"0x60016000556001600055", 212, 0, 1},
+	{"0x600160005560006000556001600055", 40118, 19900, 0},
+	{"0x600060005560016000556000600055", 5918, 7600, 1},
+}
+
+// insertContract inserts contract with provided code and original value of slot 0
+func insertContract(t *testing.T, connector types.MockedConnector, contract common.Address, code []byte, original int64) {
+	require.NoError(t, connector.DB.InsertAccount(contract, nil, 1))
+	require.NoError(t, connector.DB.InsertContractCode(contract, code))
+	if original != 0 {
+		require.NoError(t, connector.DB.InsertStorageCell(contract, common.Hash{}.Bytes(), common.BigToHash(big.NewInt(original)).Bytes()))
+	}
+}
+
+func TestGasRefundEIP3529(t *testing.T) {
+	contract := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	accessList := ethtypes.AccessList{{Address: contract, StorageKeys: []common.Hash{{}}}}
+	// intrinsic gas of the call with access list, which contains single storage key
+	const intrinsicGas = 21000 + 2400 + 1900
+
+	for _, tc := range eip3529TestCases {
+		connector := newTestConnector(t)
+		insertContract(t, connector, contract, hexutil.MustDecode(tc.code), tc.original)
+
+		res, err := Call(connector, sender.Bytes(), contract.Bytes(), nil, nil, accessList, 1_000_000, big.NewInt(0), 1, types.GetDefaultTxContext(), true, true, nil, nil, nil, 0, nil, nil)
+		require.NoError(t, err)
+		require.Empty(t, res.VmError, tc.code)
+		require.Equal(t, intrinsicGas+tc.usedGas, res.GasUsed, tc.code)
+		require.Equal(t, tc.refund, res.GasRefund, tc.code)
+	}
+}
+
+func TestGasRefundRevertedExecution(t *testing.T) {
+	contract := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	connector := newTestConnector(t)
+	// clears slot 0 and reverts
+	insertContract(t, connector, contract, hexutil.MustDecode("0x600060005560006000fd"), 1)
+
+	res := call(t, connector, contract, nil, nil, true, true)
+	require.Equal(t, "execution reverted", res.VmError)
+	require.Zero(t, res.GasRefund)
+}
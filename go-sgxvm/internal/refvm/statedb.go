@@ -0,0 +1,534 @@
+//go:build nosgx
+// +build nosgx
+
+package refvm
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/SigmaGmbH/librustgo/types"
+)
+
+// stateObject contains cached state of single account. Dirty fields are written
+// back to Cosmos side only if execution result is committed.
+type stateObject struct {
+	address common.Address
+	// existed is set if account was stored before execution
+	existed bool
+	// created is set if account was created during execution, so its stored storage is ignored
+	created bool
+
+	balance *big.Int
+	nonce   uint64
+
+	code       []byte
+	codeLoaded bool
+
+	committedStorage map[common.Hash]common.Hash
+	dirtyStorage     map[common.Hash]common.Hash
+
+	balanceDirty bool
+	nonceDirty   bool
+	codeDirty    bool
+	suicided     bool
+}
+
+// journalEntry reverts single modification of the state
+type journalEntry func()
+
+// delegationPrefix is the prefix of EIP-7702 delegation designator, which is stored
+// as code of an account, which delegated its execution to another address
+var delegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// StateDB implements `vm.StateDB` on top of `Connector`. All reads are made using the same
+// protobuf requests as SGXVM uses, modifications are cached until `Commit` is called.
+type StateDB struct {
+	connector types.Connector
+	// err contains the first error occurred during communication with Cosmos side.
+	// `vm.StateDB` methods cannot return errors, so it is checked after execution
+	err error
+
+	objects map[common.Address]*stateObject
+	journal []journalEntry
+
+	refund     uint64
+	logs       []*ethtypes.Log
+	accessList *accessList
+}
+
+var _ vm.StateDB = &StateDB{}
+
+// NewStateDB creates new StateDB, which reads and writes state using provided connector
+func NewStateDB(connector types.Connector) *StateDB {
+	return &StateDB{
+		connector:  connector,
+		objects:    make(map[common.Address]*stateObject),
+		accessList: newAccessList(),
+	}
+}
+
+// Error returns the first error occurred during communication with Cosmos side
+func (s *StateDB) Error() error {
+	return s.err
+}
+
+// Logs returns logs emitted during execution
+func (s *StateDB) Logs() []*ethtypes.Log {
+	return s.logs
+}
+
+// query sends protobuf-encoded request to Cosmos side and decodes response into provided message
+func (s *StateDB) query(request *types.CosmosRequest, response proto.Message) bool {
+	if s.err != nil {
+		return false
+	}
+
+	req, err := proto.Marshal(request)
+	if err != nil {
+		s.err = err
+		return false
+	}
+
+	res, err := s.connector.Query(req)
+	if err != nil {
+		s.err = err
+		return false
+	}
+
+	if err := proto.Unmarshal(res, response); err != nil {
+		s.err = err
+		return false
+	}
+
+	return true
+}
+
+// blockHash requests hash of the block with provided number from Cosmos side
+func (s *StateDB) blockHash(number uint64) common.Hash {
+	response := &types.QueryBlockHashResponse{}
+	if !s.query(&types.CosmosRequest{Req: &types.CosmosRequest_BlockHash{
+		BlockHash: &types.QueryBlockHash{Number: new(big.Int).SetUint64(number).Bytes()},
+	}}, response) {
+		return common.Hash{}
+	}
+	return common.BytesToHash(response.Hash)
+}
+
+func (s *StateDB) getObject(addr common.Address) *stateObject {
+	if obj, found := s.objects[addr]; found {
+		return obj
+	}
+
+	obj := &stateObject{
+		address:          addr,
+		balance:          new(big.Int),
+		committedStorage: make(map[common.Hash]common.Hash),
+		dirtyStorage:     make(map[common.Hash]common.Hash),
+	}
+
+	containsResponse := &types.QueryContainsKeyResponse{}
+	if s.query(&types.CosmosRequest{Req: &types.CosmosRequest_ContainsKey{
+		ContainsKey: &types.QueryContainsKey{Key: addr.Bytes()},
+	}}, containsResponse) {
+		obj.existed = containsResponse.Contains
+	}
+
+	accountResponse := &types.QueryGetAccountResponse{}
+	if s.query(&types.CosmosRequest{Req: &types.CosmosRequest_GetAccount{
+		GetAccount: &types.QueryGetAccount{Address: addr.Bytes()},
+	}}, accountResponse) {
+		obj.balance = new(big.Int).SetBytes(accountResponse.Balance)
+		obj.nonce = accountResponse.Nonce
+	}
+
+	s.objects[addr] = obj
+	return obj
+}
+
+func (s *StateDB) loadCode(obj *stateObject) {
+	if obj.codeLoaded {
+		return
+	}
+
+	obj.codeLoaded = true
+	if obj.created {
+		return
+	}
+
+	codeResponse := &types.QueryGetAccountCodeResponse{}
+	if s.query(&types.CosmosRequest{Req: &types.CosmosRequest_AccountCode{
+		AccountCode: &types.QueryGetAccountCode{Address: obj.address.Bytes()},
+	}}, codeResponse) {
+		obj.code = codeResponse.Code
+	}
+}
+
+// CreateAccount creates new account or resets existing one. Balance is carried over.
+func (s *StateDB) CreateAccount(addr common.Address) {
+	obj := s.getObject(addr)
+	prev := *obj
+	prevCommitted, prevDirty := obj.committedStorage, obj.dirtyStorage
+
+	obj.created = true
+	obj.nonce = 0
+	obj.code = nil
+	obj.codeLoaded = true
+	obj.committedStorage = make(map[common.Hash]common.Hash)
+	obj.dirtyStorage = make(map[common.Hash]common.Hash)
+	obj.nonceDirty = true
+	obj.codeDirty = true
+
+	s.journal = append(s.journal, func() {
+		*obj = prev
+		obj.committedStorage, obj.dirtyStorage = prevCommitted, prevDirty
+	})
+}
+
+func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
+	obj := s.getObject(addr)
+	s.setBalance(obj, new(big.Int).Sub(obj.balance, amount))
+}
+
+func (s *StateDB) AddBalance(addr common.Address, amount *big.Int) {
+	obj := s.getObject(addr)
+	s.setBalance(obj, new(big.Int).Add(obj.balance, amount))
+}
+
+func (s *StateDB) setBalance(obj *stateObject, balance *big.Int) {
+	prevBalance, prevDirty := obj.balance, obj.balanceDirty
+	s.journal = append(s.journal, func() {
+		obj.balance, obj.balanceDirty = prevBalance, prevDirty
+	})
+
+	obj.balance = balance
+	obj.balanceDirty = true
+}
+
+func (s *StateDB) GetBalance(addr common.Address) *big.Int {
+	return new(big.Int).Set(s.getObject(addr).balance)
+}
+
+func (s *StateDB) GetNonce(addr common.Address) uint64 {
+	return s.getObject(addr).nonce
+}
+
+func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
+	obj := s.getObject(addr)
+	prevNonce, prevDirty := obj.nonce, obj.nonceDirty
+	s.journal = append(s.journal, func() {
+		obj.nonce, obj.nonceDirty = prevNonce, prevDirty
+	})
+
+	obj.nonce = nonce
+	obj.nonceDirty = true
+}
+
+func (s *StateDB) GetCodeHash(addr common.Address) common.Hash {
+	obj := s.getObject(addr)
+	if s.isEmpty(obj) && !obj.existed {
+		return common.Hash{}
+	}
+
+	s.loadCode(obj)
+	return crypto.Keccak256Hash(obj.code)
+}
+
+// GetCode returns code, which is executed for provided account. If account contains
+// delegation designator (EIP-7702), code of the delegation target is returned.
+// Unlike SGXVM, go-ethereum interpreter loads code for EXTCODECOPY using the same method,
+// therefore reference VM does not return the designator itself for this opcode.
+func (s *StateDB) GetCode(addr common.Address) []byte {
+	code := s.getRawCode(addr)
+	if target, ok := parseDelegation(code); ok {
+		return s.getRawCode(target)
+	}
+	return code
+}
+
+// getRawCode returns code stored for provided account without resolving delegation
+func (s *StateDB) getRawCode(addr common.Address) []byte {
+	obj := s.getObject(addr)
+	s.loadCode(obj)
+	return obj.code
+}
+
+func (s *StateDB) SetCode(addr common.Address, code []byte) {
+	obj := s.getObject(addr)
+	s.loadCode(obj)
+
+	prevCode, prevDirty := obj.code, obj.codeDirty
+	s.journal = append(s.journal, func() {
+		obj.code, obj.codeDirty = prevCode, prevDirty
+	})
+
+	obj.code = code
+	obj.codeDirty = true
+}
+
+func (s *StateDB) GetCodeSize(addr common.Address) int {
+	return len(s.getRawCode(addr))
+}
+
+func (s *StateDB) AddRefund(gas uint64) {
+	prev := s.refund
+	s.journal = append(s.journal, func() { s.refund = prev })
+	s.refund += gas
+}
+
+func (s *StateDB) SubRefund(gas uint64) {
+	prev := s.refund
+	s.journal = append(s.journal, func() { s.refund = prev })
+	if gas > s.refund {
+		panic("refund counter below zero")
+	}
+	s.refund -= gas
+}
+
+func (s *StateDB) GetRefund() uint64 {
+	return s.refund
+}
+
+func (s *StateDB) GetCommittedState(addr common.Address, key common.Hash) common.Hash {
+	obj := s.getObject(addr)
+	if obj.created {
+		return common.Hash{}
+	}
+
+	if value, found := obj.committedStorage[key]; found {
+		return value
+	}
+
+	var value common.Hash
+	storageResponse := &types.QueryGetAccountStorageCellResponse{}
+	if s.query(&types.CosmosRequest{Req: &types.CosmosRequest_StorageCell{
+		StorageCell: &types.QueryGetAccountStorageCell{Address: addr.Bytes(), Index: key.Bytes()},
+	}}, storageResponse) {
+		value = common.BytesToHash(storageResponse.Value)
+	}
+
+	obj.committedStorage[key] = value
+	return value
+}
+
+func (s *StateDB) GetState(addr common.Address, key common.Hash) common.Hash {
+	obj := s.getObject(addr)
+	if value, found := obj.dirtyStorage[key]; found {
+		return value
+	}
+	return s.GetCommittedState(addr, key)
+}
+
+func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
+	obj := s.getObject(addr)
+	prev, wasDirty := obj.dirtyStorage[key]
+	s.journal = append(s.journal, func() {
+		if wasDirty {
+			obj.dirtyStorage[key] = prev
+		} else {
+			delete(obj.dirtyStorage, key)
+		}
+	})
+
+	obj.dirtyStorage[key] = value
+}
+
+func (s *StateDB) Suicide(addr common.Address) bool {
+	obj := s.getObject(addr)
+	if !obj.existed && !obj.created {
+		return false
+	}
+
+	prevSuicided, prevBalance, prevDirty := obj.suicided, obj.balance, obj.balanceDirty
+	s.journal = append(s.journal, func() {
+		obj.suicided, obj.balance, obj.balanceDirty = prevSuicided, prevBalance, prevDirty
+	})
+
+	obj.suicided = true
+	obj.balance = new(big.Int)
+	obj.balanceDirty = true
+	return true
+}
+
+func (s *StateDB) HasSuicided(addr common.Address) bool {
+	return s.getObject(addr).suicided
+}
+
+func (s *StateDB) Exist(addr common.Address) bool {
+	obj := s.getObject(addr)
+	return obj.existed || obj.created || obj.suicided || !s.isEmpty(obj)
+}
+
+func (s *StateDB) Empty(addr common.Address) bool {
+	return s.isEmpty(s.getObject(addr))
+}
+
+func (s *StateDB) isEmpty(obj *stateObject) bool {
+	if obj.nonce != 0 || obj.balance.Sign() != 0 {
+		return false
+	}
+	s.loadCode(obj)
+	return len(obj.code) == 0
+}
+
+func (s *StateDB) PrepareAccessList(sender common.Address, dest *common.Address, precompiles []common.Address, txAccesses ethtypes.AccessList) {
+	s.AddAddressToAccessList(sender)
+	if dest != nil {
+		s.AddAddressToAccessList(*dest)
+	}
+	for _, addr := range precompiles {
+		s.AddAddressToAccessList(addr)
+	}
+	for _, el := range txAccesses {
+		s.AddAddressToAccessList(el.Address)
+		for _, key := range el.StorageKeys {
+			s.AddSlotToAccessList(el.Address, key)
+		}
+	}
+}
+
+func (s *StateDB) AddressInAccessList(addr common.Address) bool {
+	return s.accessList.containsAddress(addr)
+}
+
+func (s *StateDB) SlotInAccessList(addr common.Address, slot common.Hash) (addressOk bool, slotOk bool) {
+	return s.accessList.contains(addr, slot)
+}
+
+func (s *StateDB) AddAddressToAccessList(addr common.Address) {
+	if s.accessList.addAddress(addr) {
+		s.journal = append(s.journal, func() { s.accessList.deleteAddress(addr) })
+	}
+}
+
+func (s *StateDB) AddSlotToAccessList(addr common.Address, slot common.Hash) {
+	addrAdded, slotAdded := s.accessList.addSlot(addr, slot)
+	if addrAdded {
+		s.journal = append(s.journal, func() { s.accessList.deleteAddress(addr) })
+	}
+	if slotAdded {
+		s.journal = append(s.journal, func() { s.accessList.deleteSlot(addr, slot) })
+	}
+}
+
+func (s *StateDB) RevertToSnapshot(id int) {
+	for i := len(s.journal) - 1; i >= id; i-- {
+		s.journal[i]()
+	}
+	s.journal = s.journal[:id]
+}
+
+func (s *StateDB) Snapshot() int {
+	return len(s.journal)
+}
+
+func (s *StateDB) AddLog(log *ethtypes.Log) {
+	prevLen := len(s.logs)
+	s.journal = append(s.journal, func() { s.logs = s.logs[:prevLen] })
+	s.logs = append(s.logs, log)
+}
+
+func (s *StateDB) AddPreimage(common.Hash, []byte) {}
+
+// ForEachStorage iterates only over storage slots modified during execution, since
+// Cosmos side does not provide storage iteration. It is used only by tracers in go-ethereum.
+func (s *StateDB) ForEachStorage(addr common.Address, cb func(common.Hash, common.Hash) bool) error {
+	obj := s.getObject(addr)
+	for key, value := range obj.dirtyStorage {
+		if !cb(key, value) {
+			break
+		}
+	}
+	return nil
+}
+
+// Commit writes all modifications to Cosmos side. Accounts are written in order of
+// their addresses to keep writes deterministic.
+func (s *StateDB) Commit() error {
+	addresses := make([]common.Address, 0, len(s.objects))
+	for addr := range s.objects {
+		addresses = append(addresses, addr)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	for _, addr := range addresses {
+		obj := s.objects[addr]
+
+		if obj.suicided {
+			if !obj.existed {
+				continue
+			}
+			s.query(&types.CosmosRequest{Req: &types.CosmosRequest_Remove{
+				Remove: &types.QueryRemove{Address: addr.Bytes()},
+			}}, &types.QueryRemoveResponse{})
+			continue
+		}
+
+		// Accounts, which were touched during execution, but are still empty, are not stored (EIP-161)
+		if !obj.existed && s.isEmpty(obj) {
+			continue
+		}
+
+		if obj.balanceDirty {
+			s.query(&types.CosmosRequest{Req: &types.CosmosRequest_InsertAccountBalance{
+				InsertAccountBalance: &types.QueryInsertAccountBalance{Address: addr.Bytes(), Balance: obj.balance.Bytes()},
+			}}, &types.QueryInsertAccountBalanceResponse{})
+		}
+
+		if obj.nonceDirty {
+			s.query(&types.CosmosRequest{Req: &types.CosmosRequest_InsertAccountNonce{
+				InsertAccountNonce: &types.QueryInsertAccountNonce{Address: addr.Bytes(), Nonce: obj.nonce},
+			}}, &types.QueryInsertAccountNonceResponse{})
+		}
+
+		if obj.codeDirty && len(obj.code) > 0 {
+			s.query(&types.CosmosRequest{Req: &types.CosmosRequest_InsertAccountCode{
+				InsertAccountCode: &types.QueryInsertAccountCode{Address: addr.Bytes(), Code: obj.code},
+			}}, &types.QueryInsertAccountCodeResponse{})
+		}
+
+		keys := make([]common.Hash, 0, len(obj.dirtyStorage))
+		for key := range obj.dirtyStorage {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+		})
+
+		for _, key := range keys {
+			value := obj.dirtyStorage[key]
+			if value == s.GetCommittedState(addr, key) {
+				continue
+			}
+
+			if value == (common.Hash{}) {
+				s.query(&types.CosmosRequest{Req: &types.CosmosRequest_RemoveStorageCell{
+					RemoveStorageCell: &types.QueryRemoveStorageCell{Address: addr.Bytes(), Index: key.Bytes()},
+				}}, &types.QueryRemoveStorageCellResponse{})
+			} else {
+				s.query(&types.CosmosRequest{Req: &types.CosmosRequest_InsertStorageCell{
+					InsertStorageCell: &types.QueryInsertStorageCell{Address: addr.Bytes(), Index: key.Bytes(), Value: value.Bytes()},
+				}}, &types.QueryInsertStorageCellResponse{})
+			}
+		}
+	}
+
+	return s.err
+}
+
+// parseDelegation returns target address of delegation designator
+func parseDelegation(code []byte) (common.Address, bool) {
+	if len(code) != len(delegationPrefix)+common.AddressLength || !bytes.HasPrefix(code, delegationPrefix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(code[len(delegationPrefix):]), true
+}
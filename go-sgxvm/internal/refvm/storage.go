@@ -0,0 +1,67 @@
+//go:build nosgx
+// +build nosgx
+
+package refvm
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/SigmaGmbH/librustgo/types"
+)
+
+// ErrNotContractOwner is returned if request to read contract storage was not signed by contract owner
+var ErrNotContractOwner = errors.New("storage request was not signed by contract owner")
+
+// GetStorageAtEncrypted returns value of contract storage slot encrypted for the user. Request should be
+// signed by deployer of the contract (checked using deploymentNonce) or by address stored in slot 0.
+func GetStorageAtEncrypted(
+	connector types.Connector,
+	contractAddress, storageKey, userPublicKey, signature []byte,
+	deploymentNonce, blockNumber uint64,
+) (*types.StorageAtEncryptedResponse, error) {
+	if len(contractAddress) != common.AddressLength || len(storageKey) != common.HashLength || len(userPublicKey) != PublicKeyLength {
+		return nil, errors.New("malformed storage request")
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, ErrNotContractOwner
+	}
+
+	contract := common.BytesToAddress(contractAddress)
+	hash := accounts.TextHash(crypto.Keccak256(contractAddress, storageKey, userPublicKey))
+
+	// signatures produced by `personal_sign` use 27 / 28 as recovery id
+	sig := common.CopyBytes(signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, ErrNotContractOwner
+	}
+	signer := crypto.PubkeyToAddress(*pubKey)
+
+	stateDB := NewStateDB(connector)
+	isDeployer := crypto.CreateAddress(signer, deploymentNonce) == contract
+	if !isDeployer && common.BytesToAddress(stateDB.GetCommittedState(contract, common.Hash{}).Bytes()) != signer {
+		return nil, ErrNotContractOwner
+	}
+
+	value := stateDB.GetCommittedState(contract, common.BytesToHash(storageKey))
+	if err := stateDB.Error(); err != nil {
+		return nil, err
+	}
+
+	encryptedValue, err := encryptResult(userPublicKey, value.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.StorageAtEncryptedResponse{
+		EncryptedValue: encryptedValue,
+		NodePublicKey:  common.CopyBytes(DevNodePublicKey),
+	}, nil
+}
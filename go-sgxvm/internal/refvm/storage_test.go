@@ -0,0 +1,80 @@
+//go:build nosgx
+// +build nosgx
+
+package refvm
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/SigmaGmbH/librustgo/types"
+)
+
+func signStorageRequest(t *testing.T, key *ecdsa.PrivateKey, contract common.Address, slot common.Hash, userPublicKey []byte) []byte {
+	hash := accounts.TextHash(crypto.Keccak256(contract.Bytes(), slot.Bytes(), userPublicKey))
+	signature, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature
+}
+
+func TestGetStorageAtEncrypted(t *testing.T) {
+	connector := newTestConnector(t)
+	contract := deploy(t, connector, counterInitCode)
+	call(t, connector, contract, nil, nil, true, true)
+
+	userPrivateKey := common.LeftPadBytes([]byte{1}, 32)
+	userPublicKey, err := curve25519.X25519(userPrivateKey, curve25519.Basepoint)
+	require.NoError(t, err)
+
+	// sender is not known to be a deployer of the contract, so any other key is not an owner
+	stranger, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signature := signStorageRequest(t, stranger, contract, common.Hash{}, userPublicKey)
+	_, err = GetStorageAtEncrypted(connector, contract.Bytes(), common.Hash{}.Bytes(), userPublicKey, signature, 0, 1)
+	require.ErrorIs(t, err, ErrNotContractOwner)
+
+	// owner is stored in slot 0
+	require.NoError(t, connector.DB.InsertStorageCell(contract, common.Hash{}.Bytes(), common.LeftPadBytes(crypto.PubkeyToAddress(stranger.PublicKey).Bytes(), 32)))
+	res, err := GetStorageAtEncrypted(connector, contract.Bytes(), common.Hash{}.Bytes(), userPublicKey, signature, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, DevNodePublicKey, res.NodePublicKey)
+	require.Equal(t, common.LeftPadBytes(crypto.PubkeyToAddress(stranger.PublicKey).Bytes(), 32), decryptFromNode(t, userPrivateKey, res.EncryptedValue))
+
+	// signature of another slot is rejected
+	_, err = GetStorageAtEncrypted(connector, contract.Bytes(), common.BigToHash(big.NewInt(1)).Bytes(), userPublicKey, signature, 0, 1)
+	require.ErrorIs(t, err, ErrNotContractOwner)
+}
+
+func TestGetStorageAtEncryptedByDeployer(t *testing.T) {
+	connector := newTestConnector(t)
+	deployer, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	deployerAddress := crypto.PubkeyToAddress(deployer.PublicKey)
+	require.NoError(t, connector.DB.InsertAccount(deployerAddress, common.LeftPadBytes(big.NewInt(1_000_000_000).Bytes(), 32), 0))
+
+	_, err = Create(connector, deployerAddress.Bytes(), counterInitCode, nil, nil, 1_000_000, big.NewInt(0), 0, types.GetDefaultTxContext(), true, nil, nil, nil, 0, nil)
+	require.NoError(t, err)
+	contract := crypto.CreateAddress(deployerAddress, 0)
+	call(t, connector, contract, nil, nil, true, true)
+
+	userPrivateKey := common.LeftPadBytes([]byte{2}, 32)
+	userPublicKey, err := curve25519.X25519(userPrivateKey, curve25519.Basepoint)
+	require.NoError(t, err)
+	signature := signStorageRequest(t, deployer, contract, common.Hash{}, userPublicKey)
+
+	res, err := GetStorageAtEncrypted(connector, contract.Bytes(), common.Hash{}.Bytes(), userPublicKey, signature, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), decryptFromNode(t, userPrivateKey, res.EncryptedValue))
+
+	// wrong deployment nonce does not match contract address
+	_, err = GetStorageAtEncrypted(connector, contract.Bytes(), common.Hash{}.Bytes(), userPublicKey, signature, 1, 1)
+	require.ErrorIs(t, err, ErrNotContractOwner)
+}
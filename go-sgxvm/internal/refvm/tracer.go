@@ -0,0 +1,213 @@
+//go:build nosgx
+// +build nosgx
+
+package refvm
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+
+	"github.com/SigmaGmbH/librustgo/types"
+)
+
+// newTracer returns EVM logger, which collects execution trace requested by provided options.
+// Returns nil if tracing was not requested.
+func newTracer(options *types.TraceOptions, msg message) (traceCollector, error) {
+	if options == nil {
+		return nil, nil
+	}
+
+	switch options.Tracer {
+	case types.TracerType_TRACER_NONE:
+		return nil, nil
+	case types.TracerType_TRACER_STRUCT:
+		config := &logger.Config{
+			EnableMemory:     options.EnableMemory,
+			DisableStack:     options.DisableStack,
+			DisableStorage:   options.DisableStorage,
+			EnableReturnData: options.EnableReturnData,
+			Limit:            int(options.Limit),
+		}
+		// Stack, memory, return data and storage may contain decrypted contract state,
+		// therefore they are collected only by trace nodes
+		if !options.TraceNode {
+			config.EnableMemory, config.DisableStack = false, true
+			config.DisableStorage, config.EnableReturnData = true, false
+		}
+		return &structTracer{StructLogger: logger.NewStructLogger(config)}, nil
+	case types.TracerType_TRACER_CALL:
+		return &callTracer{onlyTopCall: options.OnlyTopCall}, nil
+	case types.TracerType_TRACER_ACCESS_LIST:
+		to := crypto.CreateAddress(msg.from, msg.nonce)
+		if msg.to != nil {
+			to = *msg.to
+		}
+		return &accessListTracer{AccessListTracer: logger.NewAccessListTracer(nil, msg.from, to, precompiles())}, nil
+	default:
+		return nil, ErrTracingNotSupported
+	}
+}
+
+// isTracing returns true if provided options request struct or call trace
+func isTracing(options *types.TraceOptions) bool {
+	return options != nil &&
+		(options.Tracer == types.TracerType_TRACER_STRUCT || options.Tracer == types.TracerType_TRACER_CALL)
+}
+
+// traceCollector is EVM logger, which converts collected data to execution trace
+type traceCollector interface {
+	vm.EVMLogger
+	// trace returns collected execution trace
+	trace() *types.ExecutionTrace
+}
+
+// structTracer collects state of EVM after each executed opcode
+type structTracer struct {
+	*logger.StructLogger
+}
+
+func (t *structTracer) trace() *types.ExecutionTrace {
+	logs := t.StructLogs()
+	structLogs := make([]*types.TraceStructLog, len(logs))
+	for i, log := range logs {
+		structLog := &types.TraceStructLog{
+			Pc:         log.Pc,
+			Op:         log.OpName(),
+			Gas:        log.Gas,
+			GasCost:    log.GasCost,
+			Depth:      uint32(log.Depth),
+			Memory:     log.Memory,
+			ReturnData: log.ReturnData,
+			Refund:     log.RefundCounter,
+			Error:      log.ErrorString(),
+		}
+		for _, value := range log.Stack {
+			structLog.Stack = append(structLog.Stack, value.Bytes())
+		}
+		for key, value := range log.Storage {
+			structLog.Storage = append(structLog.Storage, &types.TraceStorageEntry{Key: key.Bytes(), Value: value.Bytes()})
+		}
+		// Storage is collected to the map, so it is sorted to get deterministic result
+		sort.Slice(structLog.Storage, func(i, j int) bool {
+			return bytes.Compare(structLog.Storage[i].Key, structLog.Storage[j].Key) < 0
+		})
+		structLogs[i] = structLog
+	}
+	return &types.ExecutionTrace{StructLogs: structLogs}
+}
+
+// callTracer collects tree of calls made during execution
+type callTracer struct {
+	onlyTopCall bool
+	// callstack contains frames of calls, which are currently executed. The first one is top-level call
+	callstack []*types.TraceCallFrame
+	// skipped is the number of nested calls, which are currently executed, but not collected
+	skipped int
+}
+
+func (t *callTracer) CaptureTxStart(uint64) {}
+
+func (t *callTracer) CaptureTxEnd(uint64) {}
+
+func (t *callTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	callType := vm.CALL
+	if create {
+		callType = vm.CREATE
+	}
+	t.callstack = []*types.TraceCallFrame{newCallFrame(callType, from, to, input, gas, value)}
+}
+
+func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	t.callstack[0].GasUsed = gasUsed
+	finishCallFrame(t.callstack[0], output, err)
+}
+
+func (t *callTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.onlyTopCall || t.skipped > 0 {
+		t.skipped++
+		return
+	}
+	t.callstack = append(t.callstack, newCallFrame(typ, from, to, input, gas, value))
+}
+
+func (t *callTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if t.skipped > 0 {
+		t.skipped--
+		return
+	}
+	if len(t.callstack) <= 1 {
+		return
+	}
+
+	frame := t.callstack[len(t.callstack)-1]
+	t.callstack = t.callstack[:len(t.callstack)-1]
+	frame.GasUsed = gasUsed
+	finishCallFrame(frame, output, err)
+
+	parent := t.callstack[len(t.callstack)-1]
+	parent.Calls = append(parent.Calls, frame)
+}
+
+func (t *callTracer) CaptureState(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, []byte, int, error) {
+}
+
+func (t *callTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {
+}
+
+func (t *callTracer) trace() *types.ExecutionTrace {
+	if len(t.callstack) == 0 {
+		return &types.ExecutionTrace{}
+	}
+	return &types.ExecutionTrace{CallFrame: t.callstack[0]}
+}
+
+// accessListTracer collects accounts and storage slots touched during execution.
+// Sender, recipient and precompiles are not collected, since they are always warm
+type accessListTracer struct {
+	*logger.AccessListTracer
+}
+
+func (t *accessListTracer) trace() *types.ExecutionTrace {
+	accessList := t.AccessList()
+	items := make([]*types.AccessListItem, len(accessList))
+	for i, tuple := range accessList {
+		item := &types.AccessListItem{Address: tuple.Address.Bytes()}
+		for _, slot := range tuple.StorageKeys {
+			item.StorageSlot = append(item.StorageSlot, slot.Bytes())
+		}
+		items[i] = item
+	}
+	return &types.ExecutionTrace{AccessList: items}
+}
+
+func newCallFrame(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) *types.TraceCallFrame {
+	frame := &types.TraceCallFrame{
+		Type:  typ.String(),
+		From:  from.Bytes(),
+		To:    to.Bytes(),
+		Input: common.CopyBytes(input),
+		Gas:   gas,
+	}
+	if value != nil {
+		frame.Value = value.Bytes()
+	}
+	return frame
+}
+
+func finishCallFrame(frame *types.TraceCallFrame, output []byte, err error) {
+	frame.Output = common.CopyBytes(output)
+	if err != nil {
+		frame.Error = formatVMError(err)
+		// Output is kept only for reverted calls, as it contains revert reason
+		if err != vm.ErrExecutionReverted {
+			frame.Output = nil
+		}
+	}
+}
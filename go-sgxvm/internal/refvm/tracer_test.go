@@ -0,0 +1,171 @@
+//go:build nosgx
+// +build nosgx
+
+package refvm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SigmaGmbH/librustgo/types"
+)
+
+// proxyInitCode returns init code of contract, which calls provided contract and returns the first word of its output
+func proxyInitCode(target common.Address) []byte {
+	runtime := append([]byte{0x60, 0x20, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x73}, target.Bytes()...)
+	runtime = append(runtime, 0x5a, 0xf1, 0x50, 0x60, 0x20, 0x60, 0x00, 0xf3)
+	initCode := []byte{0x60, byte(len(runtime)), 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, byte(len(runtime)), 0x60, 0x00, 0xf3}
+	return append(initCode, runtime...)
+}
+
+func traceCall(t *testing.T, connector types.Connector, to common.Address, trace *types.TraceOptions) *types.HandleTransactionResponse {
+	res, err := Call(connector, sender.Bytes(), to.Bytes(), nil, nil, nil, 1_000_000, big.NewInt(0), 1, types.GetDefaultTxContext(), false, true, nil, nil, nil, 0, trace, nil)
+	require.NoError(t, err)
+	require.Empty(t, res.VmError)
+	require.NotNil(t, res.Trace)
+	return res
+}
+
+func TestStructTracer(t *testing.T) {
+	connector := newTestConnector(t)
+	contract := deploy(t, connector, counterInitCode)
+
+	res := traceCall(t, connector, contract, &types.TraceOptions{Tracer: types.TracerType_TRACER_STRUCT, EnableMemory: true, TraceNode: true})
+	logs := res.Trace.StructLogs
+	require.Len(t, logs, 12)
+	require.Equal(t, "PUSH1", logs[0].Op)
+	require.Equal(t, uint64(0), logs[0].Pc)
+	require.Equal(t, uint32(1), logs[0].Depth)
+	require.Empty(t, logs[0].Stack)
+	require.Equal(t, "RETURN", logs[len(logs)-1].Op)
+
+	// SLOAD reads value of slot 0 and stack contains its key
+	require.Equal(t, "SLOAD", logs[1].Op)
+	require.Len(t, logs[1].Stack, 1)
+	require.Len(t, logs[1].Storage, 1)
+	require.Equal(t, common.Hash{}.Bytes(), logs[1].Storage[0].Key)
+	require.Equal(t, common.Hash{}.Bytes(), logs[1].Storage[0].Value)
+	require.Equal(t, uint64(2100), logs[1].GasCost)
+
+	// SSTORE writes the incremented value
+	var sstore *types.TraceStructLog
+	for _, log := range logs {
+		if log.Op == "SSTORE" {
+			sstore = log
+		}
+	}
+	require.NotNil(t, sstore)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), sstore.Storage[0].Value)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), logs[len(logs)-1].Memory)
+
+	// Stack and storage are not collected if disabled, number of logs is limited
+	res = traceCall(t, connector, contract, &types.TraceOptions{
+		Tracer:         types.TracerType_TRACER_STRUCT,
+		DisableStack:   true,
+		DisableStorage: true,
+		Limit:          3,
+		TraceNode:      true,
+	})
+	logs = res.Trace.StructLogs
+	require.Len(t, logs, 3)
+	require.Empty(t, logs[2].Stack)
+	require.Empty(t, logs[1].Storage)
+	require.Empty(t, logs[2].Memory)
+}
+
+func TestStructTracerRegularNode(t *testing.T) {
+	connector := newTestConnector(t)
+	contract := deploy(t, connector, counterInitCode)
+
+	// Contract state is not exposed by regular nodes, even if requested
+	res := traceCall(t, connector, contract, &types.TraceOptions{
+		Tracer:           types.TracerType_TRACER_STRUCT,
+		EnableMemory:     true,
+		EnableReturnData: true,
+	})
+	logs := res.Trace.StructLogs
+	require.Len(t, logs, 12)
+	for _, log := range logs {
+		require.Empty(t, log.Stack)
+		require.Empty(t, log.Storage)
+		require.Empty(t, log.Memory)
+		require.Empty(t, log.ReturnData)
+	}
+
+	// Encrypted transactions are traced only by trace nodes
+	res, err := Call(connector, sender.Bytes(), contract.Bytes(), []byte{0x01}, nil, nil, 1_000_000, big.NewInt(0), 1, types.GetDefaultTxContext(), false, false, nil, nil, nil, 0, &types.TraceOptions{Tracer: types.TracerType_TRACER_CALL}, nil)
+	require.NoError(t, err)
+	require.Equal(t, errTracingNotAllowed.Error(), res.VmError)
+	require.Nil(t, res.Trace)
+}
+
+func TestCallTracer(t *testing.T) {
+	connector := newTestConnector(t)
+	counter := deploy(t, connector, counterInitCode)
+	res, err := Create(connector, sender.Bytes(), proxyInitCode(counter), nil, nil, 1_000_000, big.NewInt(0), 1, types.GetDefaultTxContext(), true, nil, nil, nil, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, res.VmError)
+	proxy := crypto.CreateAddress(sender, 1)
+
+	res = traceCall(t, connector, proxy, &types.TraceOptions{Tracer: types.TracerType_TRACER_CALL})
+	frame := res.Trace.CallFrame
+	require.NotNil(t, frame)
+	require.Equal(t, "CALL", frame.Type)
+	require.Equal(t, sender.Bytes(), frame.From)
+	require.Equal(t, proxy.Bytes(), frame.To)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), frame.Output)
+	require.Equal(t, res.GasUsed-21000, frame.GasUsed)
+	require.Empty(t, frame.Error)
+
+	require.Len(t, frame.Calls, 1)
+	nested := frame.Calls[0]
+	require.Equal(t, "CALL", nested.Type)
+	require.Equal(t, proxy.Bytes(), nested.From)
+	require.Equal(t, counter.Bytes(), nested.To)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), nested.Output)
+	require.NotZero(t, nested.GasUsed)
+	require.Less(t, nested.GasUsed, frame.GasUsed)
+	require.Empty(t, nested.Calls)
+
+	// Nested calls are not collected if only top-level call is requested
+	res = traceCall(t, connector, proxy, &types.TraceOptions{Tracer: types.TracerType_TRACER_CALL, OnlyTopCall: true})
+	require.Empty(t, res.Trace.CallFrame.Calls)
+	require.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), res.Trace.CallFrame.Output)
+}
+
+func TestCallTracerRevert(t *testing.T) {
+	connector := newTestConnector(t)
+	contract := deploy(t, connector, revertInitCode)
+
+	res, err := Call(connector, sender.Bytes(), contract.Bytes(), nil, nil, nil, 1_000_000, big.NewInt(0), 1, types.GetDefaultTxContext(), false, true, nil, nil, nil, 0, &types.TraceOptions{Tracer: types.TracerType_TRACER_CALL}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "execution reverted", res.VmError)
+	require.Equal(t, "execution reverted", res.Trace.CallFrame.Error)
+}
+
+func TestAccessListTracer(t *testing.T) {
+	connector := newTestConnector(t)
+	counter := deploy(t, connector, counterInitCode)
+	res, err := Create(connector, sender.Bytes(), proxyInitCode(counter), nil, nil, 1_000_000, big.NewInt(0), 1, types.GetDefaultTxContext(), true, nil, nil, nil, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, res.VmError)
+	proxy := crypto.CreateAddress(sender, 1)
+
+	// Called contract and its storage slot are collected, sender and recipient are always warm
+	res = traceCall(t, connector, proxy, &types.TraceOptions{Tracer: types.TracerType_TRACER_ACCESS_LIST})
+	require.Len(t, res.Trace.AccessList, 1)
+	require.Equal(t, counter.Bytes(), res.Trace.AccessList[0].Address)
+	require.Equal(t, [][]byte{common.Hash{}.Bytes()}, res.Trace.AccessList[0].StorageSlot)
+}
+
+func TestTracerNotSupported(t *testing.T) {
+	connector := newTestConnector(t)
+	trace := &types.TraceOptions{Tracer: types.TracerType(100)}
+
+	_, err := Call(connector, sender.Bytes(), sender.Bytes(), nil, nil, nil, 1_000_000, big.NewInt(0), 0, types.GetDefaultTxContext(), false, true, nil, nil, nil, 0, trace, nil)
+	require.ErrorIs(t, err, ErrTracingNotSupported)
+}
@@ -90,8 +90,15 @@ type CosmosRequest_ConvertCredential = types.CosmosRequest_ConvertCredential
 type CosmosRequest_BlockHash = types.CosmosRequest_BlockHash
 
 type HandleTransactionResponse = types.HandleTransactionResponse
+type AuthorizationListItem = types.AuthorizationListItem
+type TraceOptions = types.TraceOptions
+type ExecutionTrace = types.ExecutionTrace
+type TraceStructLog = types.TraceStructLog
+type TraceCallFrame = types.TraceCallFrame
 type NodePublicKeyRequest = types.NodePublicKeyRequest
 type NodePublicKeyResponse = types.NodePublicKeyResponse
+type StorageAtEncryptedRequest = types.StorageAtEncryptedRequest
+type StorageAtEncryptedResponse = types.StorageAtEncryptedResponse
 
 // CheckNodeStatus checks if SGX requirements are met
 func CheckNodeStatus() error {
@@ -119,7 +126,7 @@ func Call(
 	maxPriorityFeePerGas *big.Int,
 	txType uint8,
 ) (*types.HandleTransactionResponse, error) {
-	executionResult, err := api.Call(querier, from, to, data, value, accessList, gasLimit, gasPrice, nonce, txContext, commit, isUnencrypted, transactionSignature, maxFeePerGas, maxPriorityFeePerGas, txType)
+	executionResult, err := api.Call(querier, from, to, data, value, accessList, gasLimit, gasPrice, nonce, txContext, commit, isUnencrypted, transactionSignature, maxFeePerGas, maxPriorityFeePerGas, txType, nil, nil)
 	if err != nil {
 		return &types.HandleTransactionResponse{}, err
 	}
@@ -163,7 +170,62 @@ func Create(
 	maxPriorityFeePerGas *big.Int,
 	txType uint8,
 ) (*types.HandleTransactionResponse, error) {
-	executionResult, err := api.Create(querier, from, data, value, accessList, gasLimit, gasPrice, nonce, txContext, commit, transactionSignature, maxFeePerGas, maxPriorityFeePerGas, txType)
+	executionResult, err := api.Create(querier, from, data, value, accessList, gasLimit, gasPrice, nonce, txContext, commit, transactionSignature, maxFeePerGas, maxPriorityFeePerGas, txType, nil)
+	if err != nil {
+		return &types.HandleTransactionResponse{}, err
+	}
+
+	return executionResult, nil
+}
+
+// CallWithTrace handles incoming transaction data to transfer value or call some contract and collects
+// execution trace using provided trace options. Collected trace is returned in `Trace` field of response.
+// If trace options are nil, transaction is executed without tracing. Authorization list is
+// provided for EIP-7702 set code transactions and should be nil for other transaction types
+func CallWithTrace(
+	querier types.Connector,
+	from, to, data, value []byte,
+	accessList ethtypes.AccessList,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	nonce uint64,
+	txContext *TransactionContext,
+	commit bool,
+	isUnencrypted bool,
+	transactionSignature []byte,
+	maxFeePerGas *big.Int,
+	maxPriorityFeePerGas *big.Int,
+	txType uint8,
+	trace *TraceOptions,
+	authorizationList []*AuthorizationListItem,
+) (*types.HandleTransactionResponse, error) {
+	executionResult, err := api.Call(querier, from, to, data, value, accessList, gasLimit, gasPrice, nonce, txContext, commit, isUnencrypted, transactionSignature, maxFeePerGas, maxPriorityFeePerGas, txType, trace, authorizationList)
+	if err != nil {
+		return &types.HandleTransactionResponse{}, err
+	}
+
+	return executionResult, nil
+}
+
+// CreateWithTrace handles incoming transaction data, creates a new smart contract and collects
+// execution trace using provided trace options. Collected trace is returned in `Trace` field of response.
+// If trace options are nil, transaction is executed without tracing
+func CreateWithTrace(
+	querier types.Connector,
+	from, data, value []byte,
+	accessList ethtypes.AccessList,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	nonce uint64,
+	txContext *TransactionContext,
+	commit bool,
+	transactionSignature []byte,
+	maxFeePerGas *big.Int,
+	maxPriorityFeePerGas *big.Int,
+	txType uint8,
+	trace *TraceOptions,
+) (*types.HandleTransactionResponse, error) {
+	executionResult, err := api.Create(querier, from, data, value, accessList, gasLimit, gasPrice, nonce, txContext, commit, transactionSignature, maxFeePerGas, maxPriorityFeePerGas, txType, trace)
 	if err != nil {
 		return &types.HandleTransactionResponse{}, err
 	}
@@ -196,6 +258,20 @@ func GetNodePublicKey(blockNumber uint64) (*types.NodePublicKeyResponse, error)
 	return result, nil
 }
 
+// GetStorageAtEncrypted handles request for storage slot value of the contract, made by contract owner.
+// Returned value is encrypted using shared key derived from provided user public key and node public key
+func GetStorageAtEncrypted(
+	querier types.Connector,
+	contractAddress, storageKey, userPublicKey, signature []byte,
+	deploymentNonce, blockNumber uint64,
+) (*types.StorageAtEncryptedResponse, error) {
+	result, err := api.GetStorageAtEncrypted(querier, contractAddress, storageKey, userPublicKey, signature, deploymentNonce, blockNumber)
+	if err != nil {
+		return &types.StorageAtEncryptedResponse{}, err
+	}
+	return result, nil
+}
+
 // Libsgx_wrapperVersion returns the version of the loaded library
 // at runtime. This can be used for debugging to verify the loaded version
 // matches the expected version.
@@ -20,6 +20,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Tracer, which collects execution trace of transaction
+type TracerType int32
+
+const (
+	// Transaction is executed without tracing
+	TracerType_TRACER_NONE TracerType = 0
+	// Struct logger, which collects state of EVM after each executed opcode
+	TracerType_TRACER_STRUCT TracerType = 1
+	// Call tracer, which collects tree of calls made during execution
+	TracerType_TRACER_CALL TracerType = 2
+	// Access list tracer, which collects accounts and storage slots touched during execution
+	TracerType_TRACER_ACCESS_LIST TracerType = 3
+)
+
+// Enum value maps for TracerType.
+var (
+	TracerType_name = map[int32]string{
+		0: "TRACER_NONE",
+		1: "TRACER_STRUCT",
+		2: "TRACER_CALL",
+		3: "TRACER_ACCESS_LIST",
+	}
+	TracerType_value = map[string]int32{
+		"TRACER_NONE":        0,
+		"TRACER_STRUCT":      1,
+		"TRACER_CALL":        2,
+		"TRACER_ACCESS_LIST": 3,
+	}
+)
+
+func (x TracerType) Enum() *TracerType {
+	p := new(TracerType)
+	*p = x
+	return p
+}
+
+func (x TracerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TracerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ffi_proto_enumTypes[0].Descriptor()
+}
+
+func (TracerType) Type() protoreflect.EnumType {
+	return &file_ffi_proto_enumTypes[0]
+}
+
+func (x TracerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TracerType.Descriptor instead.
+func (TracerType) EnumDescriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{0}
+}
+
 type AccessListItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -75,6 +132,94 @@ func (x *AccessListItem) GetAddress() []byte {
 	return nil
 }
 
+// EIP-7702 authorization of set code transaction
+type AuthorizationListItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId []byte `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Nonce   uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	YParity uint32 `protobuf:"varint,4,opt,name=yParity,proto3" json:"yParity,omitempty"`
+	R       []byte `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	S       []byte `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *AuthorizationListItem) Reset() {
+	*x = AuthorizationListItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationListItem) ProtoMessage() {}
+
+func (x *AuthorizationListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationListItem.ProtoReflect.Descriptor instead.
+func (*AuthorizationListItem) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuthorizationListItem) GetChainId() []byte {
+	if x != nil {
+		return x.ChainId
+	}
+	return nil
+}
+
+func (x *AuthorizationListItem) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *AuthorizationListItem) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *AuthorizationListItem) GetYParity() uint32 {
+	if x != nil {
+		return x.YParity
+	}
+	return 0
+}
+
+func (x *AuthorizationListItem) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *AuthorizationListItem) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
 type TransactionData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -91,7 +236,7 @@ type TransactionData struct {
 func (x *TransactionData) Reset() {
 	*x = TransactionData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[1]
+		mi := &file_ffi_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -104,7 +249,7 @@ func (x *TransactionData) String() string {
 func (*TransactionData) ProtoMessage() {}
 
 func (x *TransactionData) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[1]
+	mi := &file_ffi_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -117,7 +262,7 @@ func (x *TransactionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
 func (*TransactionData) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{1}
+	return file_ffi_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *TransactionData) GetFrom() []byte {
@@ -179,7 +324,7 @@ type TransactionContext struct {
 func (x *TransactionContext) Reset() {
 	*x = TransactionContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[2]
+		mi := &file_ffi_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -192,7 +337,7 @@ func (x *TransactionContext) String() string {
 func (*TransactionContext) ProtoMessage() {}
 
 func (x *TransactionContext) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[2]
+	mi := &file_ffi_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,7 +350,7 @@ func (x *TransactionContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionContext.ProtoReflect.Descriptor instead.
 func (*TransactionContext) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{2}
+	return file_ffi_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TransactionContext) GetChainId() uint64 {
@@ -269,7 +414,7 @@ type HandleTransactionRequest struct {
 func (x *HandleTransactionRequest) Reset() {
 	*x = HandleTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[3]
+		mi := &file_ffi_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -282,7 +427,7 @@ func (x *HandleTransactionRequest) String() string {
 func (*HandleTransactionRequest) ProtoMessage() {}
 
 func (x *HandleTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[3]
+	mi := &file_ffi_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,7 +440,7 @@ func (x *HandleTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandleTransactionRequest.ProtoReflect.Descriptor instead.
 func (*HandleTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{3}
+	return file_ffi_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *HandleTransactionRequest) GetTxData() *TransactionData {
@@ -325,14 +470,21 @@ type HandleTransactionResponse struct {
 	Ret []byte `protobuf:"bytes,3,opt,name=ret,proto3" json:"ret,omitempty"`
 	// vm error is the error returned by vm execution
 	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
-	// gas consumed by the transaction
+	// gas consumed by the transaction. If gas_refund is set, refund is not
+	// applied yet
 	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// execution trace, which is returned only if tracing was requested
+	Trace *ExecutionTrace `protobuf:"bytes,6,opt,name=trace,proto3" json:"trace,omitempty"`
+	// refund, which is not applied to gas_used yet. Cap is applied by the caller
+	// according to EIP-3529, executor may return refund already capped by the
+	// same quotient
+	GasRefund uint64 `protobuf:"varint,7,opt,name=gas_refund,json=gasRefund,proto3" json:"gas_refund,omitempty"`
 }
 
 func (x *HandleTransactionResponse) Reset() {
 	*x = HandleTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[4]
+		mi := &file_ffi_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -345,7 +497,7 @@ func (x *HandleTransactionResponse) String() string {
 func (*HandleTransactionResponse) ProtoMessage() {}
 
 func (x *HandleTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[4]
+	mi := &file_ffi_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +510,7 @@ func (x *HandleTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandleTransactionResponse.ProtoReflect.Descriptor instead.
 func (*HandleTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{4}
+	return file_ffi_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *HandleTransactionResponse) GetLogs() []*Log {
@@ -389,6 +541,20 @@ func (x *HandleTransactionResponse) GetGasUsed() uint64 {
 	return 0
 }
 
+func (x *HandleTransactionResponse) GetTrace() *ExecutionTrace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *HandleTransactionResponse) GetGasRefund() uint64 {
+	if x != nil {
+		return x.GasRefund
+	}
+	return 0
+}
+
 type HandleEstimateGasRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -401,7 +567,7 @@ type HandleEstimateGasRequest struct {
 func (x *HandleEstimateGasRequest) Reset() {
 	*x = HandleEstimateGasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[5]
+		mi := &file_ffi_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -414,7 +580,7 @@ func (x *HandleEstimateGasRequest) String() string {
 func (*HandleEstimateGasRequest) ProtoMessage() {}
 
 func (x *HandleEstimateGasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[5]
+	mi := &file_ffi_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +593,7 @@ func (x *HandleEstimateGasRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandleEstimateGasRequest.ProtoReflect.Descriptor instead.
 func (*HandleEstimateGasRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{5}
+	return file_ffi_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *HandleEstimateGasRequest) GetTxData() *TransactionData {
@@ -458,7 +624,7 @@ type HandleEstimateGasResponse struct {
 func (x *HandleEstimateGasResponse) Reset() {
 	*x = HandleEstimateGasResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[6]
+		mi := &file_ffi_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -471,7 +637,7 @@ func (x *HandleEstimateGasResponse) String() string {
 func (*HandleEstimateGasResponse) ProtoMessage() {}
 
 func (x *HandleEstimateGasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[6]
+	mi := &file_ffi_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +650,7 @@ func (x *HandleEstimateGasResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandleEstimateGasResponse.ProtoReflect.Descriptor instead.
 func (*HandleEstimateGasResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{6}
+	return file_ffi_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *HandleEstimateGasResponse) GetVmError() string {
@@ -514,7 +680,7 @@ type Topic struct {
 func (x *Topic) Reset() {
 	*x = Topic{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[7]
+		mi := &file_ffi_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -527,7 +693,7 @@ func (x *Topic) String() string {
 func (*Topic) ProtoMessage() {}
 
 func (x *Topic) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[7]
+	mi := &file_ffi_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +706,7 @@ func (x *Topic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Topic.ProtoReflect.Descriptor instead.
 func (*Topic) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{7}
+	return file_ffi_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Topic) GetInner() []byte {
@@ -569,7 +735,7 @@ type Log struct {
 func (x *Log) Reset() {
 	*x = Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[8]
+		mi := &file_ffi_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -582,7 +748,7 @@ func (x *Log) String() string {
 func (*Log) ProtoMessage() {}
 
 func (x *Log) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[8]
+	mi := &file_ffi_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +761,7 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{8}
+	return file_ffi_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Log) GetAddress() []byte {
@@ -632,7 +798,7 @@ type QueryGetAccount struct {
 func (x *QueryGetAccount) Reset() {
 	*x = QueryGetAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[9]
+		mi := &file_ffi_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +811,7 @@ func (x *QueryGetAccount) String() string {
 func (*QueryGetAccount) ProtoMessage() {}
 
 func (x *QueryGetAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[9]
+	mi := &file_ffi_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +824,7 @@ func (x *QueryGetAccount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGetAccount.ProtoReflect.Descriptor instead.
 func (*QueryGetAccount) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{9}
+	return file_ffi_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *QueryGetAccount) GetAddress() []byte {
@@ -683,7 +849,7 @@ type QueryGetAccountResponse struct {
 func (x *QueryGetAccountResponse) Reset() {
 	*x = QueryGetAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[10]
+		mi := &file_ffi_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -696,7 +862,7 @@ func (x *QueryGetAccountResponse) String() string {
 func (*QueryGetAccountResponse) ProtoMessage() {}
 
 func (x *QueryGetAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[10]
+	mi := &file_ffi_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,7 +875,7 @@ func (x *QueryGetAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGetAccountResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetAccountResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{10}
+	return file_ffi_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryGetAccountResponse) GetBalance() []byte {
@@ -738,7 +904,7 @@ type QueryInsertAccountBalance struct {
 func (x *QueryInsertAccountBalance) Reset() {
 	*x = QueryInsertAccountBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[11]
+		mi := &file_ffi_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -751,7 +917,7 @@ func (x *QueryInsertAccountBalance) String() string {
 func (*QueryInsertAccountBalance) ProtoMessage() {}
 
 func (x *QueryInsertAccountBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[11]
+	mi := &file_ffi_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,7 +930,7 @@ func (x *QueryInsertAccountBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryInsertAccountBalance.ProtoReflect.Descriptor instead.
 func (*QueryInsertAccountBalance) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{11}
+	return file_ffi_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QueryInsertAccountBalance) GetAddress() []byte {
@@ -790,7 +956,7 @@ type QueryInsertAccountBalanceResponse struct {
 func (x *QueryInsertAccountBalanceResponse) Reset() {
 	*x = QueryInsertAccountBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[12]
+		mi := &file_ffi_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -803,7 +969,7 @@ func (x *QueryInsertAccountBalanceResponse) String() string {
 func (*QueryInsertAccountBalanceResponse) ProtoMessage() {}
 
 func (x *QueryInsertAccountBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[12]
+	mi := &file_ffi_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,7 +982,7 @@ func (x *QueryInsertAccountBalanceResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use QueryInsertAccountBalanceResponse.ProtoReflect.Descriptor instead.
 func (*QueryInsertAccountBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{12}
+	return file_ffi_proto_rawDescGZIP(), []int{13}
 }
 
 type QueryInsertAccountNonce struct {
@@ -831,7 +997,7 @@ type QueryInsertAccountNonce struct {
 func (x *QueryInsertAccountNonce) Reset() {
 	*x = QueryInsertAccountNonce{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[13]
+		mi := &file_ffi_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -844,7 +1010,7 @@ func (x *QueryInsertAccountNonce) String() string {
 func (*QueryInsertAccountNonce) ProtoMessage() {}
 
 func (x *QueryInsertAccountNonce) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[13]
+	mi := &file_ffi_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1023,7 @@ func (x *QueryInsertAccountNonce) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryInsertAccountNonce.ProtoReflect.Descriptor instead.
 func (*QueryInsertAccountNonce) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{13}
+	return file_ffi_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryInsertAccountNonce) GetAddress() []byte {
@@ -883,7 +1049,7 @@ type QueryInsertAccountNonceResponse struct {
 func (x *QueryInsertAccountNonceResponse) Reset() {
 	*x = QueryInsertAccountNonceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[14]
+		mi := &file_ffi_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -896,7 +1062,7 @@ func (x *QueryInsertAccountNonceResponse) String() string {
 func (*QueryInsertAccountNonceResponse) ProtoMessage() {}
 
 func (x *QueryInsertAccountNonceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[14]
+	mi := &file_ffi_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1075,7 @@ func (x *QueryInsertAccountNonceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryInsertAccountNonceResponse.ProtoReflect.Descriptor instead.
 func (*QueryInsertAccountNonceResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{14}
+	return file_ffi_proto_rawDescGZIP(), []int{15}
 }
 
 type QueryContainsKey struct {
@@ -923,7 +1089,7 @@ type QueryContainsKey struct {
 func (x *QueryContainsKey) Reset() {
 	*x = QueryContainsKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[15]
+		mi := &file_ffi_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -936,7 +1102,7 @@ func (x *QueryContainsKey) String() string {
 func (*QueryContainsKey) ProtoMessage() {}
 
 func (x *QueryContainsKey) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[15]
+	mi := &file_ffi_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,7 +1115,7 @@ func (x *QueryContainsKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryContainsKey.ProtoReflect.Descriptor instead.
 func (*QueryContainsKey) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{15}
+	return file_ffi_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryContainsKey) GetKey() []byte {
@@ -970,7 +1136,7 @@ type QueryContainsKeyResponse struct {
 func (x *QueryContainsKeyResponse) Reset() {
 	*x = QueryContainsKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[16]
+		mi := &file_ffi_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -983,7 +1149,7 @@ func (x *QueryContainsKeyResponse) String() string {
 func (*QueryContainsKeyResponse) ProtoMessage() {}
 
 func (x *QueryContainsKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[16]
+	mi := &file_ffi_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,7 +1162,7 @@ func (x *QueryContainsKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryContainsKeyResponse.ProtoReflect.Descriptor instead.
 func (*QueryContainsKeyResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{16}
+	return file_ffi_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryContainsKeyResponse) GetContains() bool {
@@ -1018,7 +1184,7 @@ type QueryGetAccountStorageCell struct {
 func (x *QueryGetAccountStorageCell) Reset() {
 	*x = QueryGetAccountStorageCell{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[17]
+		mi := &file_ffi_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1031,7 +1197,7 @@ func (x *QueryGetAccountStorageCell) String() string {
 func (*QueryGetAccountStorageCell) ProtoMessage() {}
 
 func (x *QueryGetAccountStorageCell) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[17]
+	mi := &file_ffi_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,7 +1210,7 @@ func (x *QueryGetAccountStorageCell) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGetAccountStorageCell.ProtoReflect.Descriptor instead.
 func (*QueryGetAccountStorageCell) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{17}
+	return file_ffi_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QueryGetAccountStorageCell) GetAddress() []byte {
@@ -1072,7 +1238,7 @@ type QueryGetAccountStorageCellResponse struct {
 func (x *QueryGetAccountStorageCellResponse) Reset() {
 	*x = QueryGetAccountStorageCellResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[18]
+		mi := &file_ffi_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1085,7 +1251,7 @@ func (x *QueryGetAccountStorageCellResponse) String() string {
 func (*QueryGetAccountStorageCellResponse) ProtoMessage() {}
 
 func (x *QueryGetAccountStorageCellResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[18]
+	mi := &file_ffi_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1098,7 +1264,7 @@ func (x *QueryGetAccountStorageCellResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use QueryGetAccountStorageCellResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetAccountStorageCellResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{18}
+	return file_ffi_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryGetAccountStorageCellResponse) GetValue() []byte {
@@ -1119,7 +1285,7 @@ type QueryGetAccountCode struct {
 func (x *QueryGetAccountCode) Reset() {
 	*x = QueryGetAccountCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[19]
+		mi := &file_ffi_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1132,7 +1298,7 @@ func (x *QueryGetAccountCode) String() string {
 func (*QueryGetAccountCode) ProtoMessage() {}
 
 func (x *QueryGetAccountCode) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[19]
+	mi := &file_ffi_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1145,7 +1311,7 @@ func (x *QueryGetAccountCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGetAccountCode.ProtoReflect.Descriptor instead.
 func (*QueryGetAccountCode) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{19}
+	return file_ffi_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueryGetAccountCode) GetAddress() []byte {
@@ -1166,7 +1332,7 @@ type QueryGetAccountCodeResponse struct {
 func (x *QueryGetAccountCodeResponse) Reset() {
 	*x = QueryGetAccountCodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[20]
+		mi := &file_ffi_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1179,7 +1345,7 @@ func (x *QueryGetAccountCodeResponse) String() string {
 func (*QueryGetAccountCodeResponse) ProtoMessage() {}
 
 func (x *QueryGetAccountCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[20]
+	mi := &file_ffi_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,7 +1358,7 @@ func (x *QueryGetAccountCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGetAccountCodeResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetAccountCodeResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{20}
+	return file_ffi_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueryGetAccountCodeResponse) GetCode() []byte {
@@ -1213,7 +1379,7 @@ type QueryGetAccountCodeSize struct {
 func (x *QueryGetAccountCodeSize) Reset() {
 	*x = QueryGetAccountCodeSize{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[21]
+		mi := &file_ffi_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1226,7 +1392,7 @@ func (x *QueryGetAccountCodeSize) String() string {
 func (*QueryGetAccountCodeSize) ProtoMessage() {}
 
 func (x *QueryGetAccountCodeSize) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[21]
+	mi := &file_ffi_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,7 +1405,7 @@ func (x *QueryGetAccountCodeSize) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGetAccountCodeSize.ProtoReflect.Descriptor instead.
 func (*QueryGetAccountCodeSize) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{21}
+	return file_ffi_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueryGetAccountCodeSize) GetAddress() []byte {
@@ -1260,7 +1426,7 @@ type QueryGetAccountCodeSizeResponse struct {
 func (x *QueryGetAccountCodeSizeResponse) Reset() {
 	*x = QueryGetAccountCodeSizeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[22]
+		mi := &file_ffi_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1273,7 +1439,7 @@ func (x *QueryGetAccountCodeSizeResponse) String() string {
 func (*QueryGetAccountCodeSizeResponse) ProtoMessage() {}
 
 func (x *QueryGetAccountCodeSizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[22]
+	mi := &file_ffi_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1286,7 +1452,7 @@ func (x *QueryGetAccountCodeSizeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGetAccountCodeSizeResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetAccountCodeSizeResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{22}
+	return file_ffi_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueryGetAccountCodeSizeResponse) GetSize() uint32 {
@@ -1307,7 +1473,7 @@ type QueryGetAccountCodeHash struct {
 func (x *QueryGetAccountCodeHash) Reset() {
 	*x = QueryGetAccountCodeHash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[23]
+		mi := &file_ffi_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1320,7 +1486,7 @@ func (x *QueryGetAccountCodeHash) String() string {
 func (*QueryGetAccountCodeHash) ProtoMessage() {}
 
 func (x *QueryGetAccountCodeHash) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[23]
+	mi := &file_ffi_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,7 +1499,7 @@ func (x *QueryGetAccountCodeHash) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGetAccountCodeHash.ProtoReflect.Descriptor instead.
 func (*QueryGetAccountCodeHash) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{23}
+	return file_ffi_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *QueryGetAccountCodeHash) GetAddress() []byte {
@@ -1354,7 +1520,7 @@ type QueryGetAccountCodeHashResponse struct {
 func (x *QueryGetAccountCodeHashResponse) Reset() {
 	*x = QueryGetAccountCodeHashResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[24]
+		mi := &file_ffi_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1367,7 +1533,7 @@ func (x *QueryGetAccountCodeHashResponse) String() string {
 func (*QueryGetAccountCodeHashResponse) ProtoMessage() {}
 
 func (x *QueryGetAccountCodeHashResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[24]
+	mi := &file_ffi_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,7 +1546,7 @@ func (x *QueryGetAccountCodeHashResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGetAccountCodeHashResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetAccountCodeHashResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{24}
+	return file_ffi_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QueryGetAccountCodeHashResponse) GetHash() []byte {
@@ -1402,7 +1568,7 @@ type QueryInsertAccountCode struct {
 func (x *QueryInsertAccountCode) Reset() {
 	*x = QueryInsertAccountCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[25]
+		mi := &file_ffi_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1415,7 +1581,7 @@ func (x *QueryInsertAccountCode) String() string {
 func (*QueryInsertAccountCode) ProtoMessage() {}
 
 func (x *QueryInsertAccountCode) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[25]
+	mi := &file_ffi_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,7 +1594,7 @@ func (x *QueryInsertAccountCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryInsertAccountCode.ProtoReflect.Descriptor instead.
 func (*QueryInsertAccountCode) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{25}
+	return file_ffi_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *QueryInsertAccountCode) GetAddress() []byte {
@@ -1454,7 +1620,7 @@ type QueryInsertAccountCodeResponse struct {
 func (x *QueryInsertAccountCodeResponse) Reset() {
 	*x = QueryInsertAccountCodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[26]
+		mi := &file_ffi_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1467,7 +1633,7 @@ func (x *QueryInsertAccountCodeResponse) String() string {
 func (*QueryInsertAccountCodeResponse) ProtoMessage() {}
 
 func (x *QueryInsertAccountCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[26]
+	mi := &file_ffi_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1480,7 +1646,7 @@ func (x *QueryInsertAccountCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryInsertAccountCodeResponse.ProtoReflect.Descriptor instead.
 func (*QueryInsertAccountCodeResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{26}
+	return file_ffi_proto_rawDescGZIP(), []int{27}
 }
 
 type QueryInsertStorageCell struct {
@@ -1496,7 +1662,7 @@ type QueryInsertStorageCell struct {
 func (x *QueryInsertStorageCell) Reset() {
 	*x = QueryInsertStorageCell{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[27]
+		mi := &file_ffi_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1509,7 +1675,7 @@ func (x *QueryInsertStorageCell) String() string {
 func (*QueryInsertStorageCell) ProtoMessage() {}
 
 func (x *QueryInsertStorageCell) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[27]
+	mi := &file_ffi_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,7 +1688,7 @@ func (x *QueryInsertStorageCell) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryInsertStorageCell.ProtoReflect.Descriptor instead.
 func (*QueryInsertStorageCell) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{27}
+	return file_ffi_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryInsertStorageCell) GetAddress() []byte {
@@ -1555,7 +1721,7 @@ type QueryInsertStorageCellResponse struct {
 func (x *QueryInsertStorageCellResponse) Reset() {
 	*x = QueryInsertStorageCellResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[28]
+		mi := &file_ffi_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1568,7 +1734,7 @@ func (x *QueryInsertStorageCellResponse) String() string {
 func (*QueryInsertStorageCellResponse) ProtoMessage() {}
 
 func (x *QueryInsertStorageCellResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[28]
+	mi := &file_ffi_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1581,7 +1747,7 @@ func (x *QueryInsertStorageCellResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryInsertStorageCellResponse.ProtoReflect.Descriptor instead.
 func (*QueryInsertStorageCellResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{28}
+	return file_ffi_proto_rawDescGZIP(), []int{29}
 }
 
 type QueryRemove struct {
@@ -1595,7 +1761,7 @@ type QueryRemove struct {
 func (x *QueryRemove) Reset() {
 	*x = QueryRemove{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[29]
+		mi := &file_ffi_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1608,7 +1774,7 @@ func (x *QueryRemove) String() string {
 func (*QueryRemove) ProtoMessage() {}
 
 func (x *QueryRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[29]
+	mi := &file_ffi_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1621,7 +1787,7 @@ func (x *QueryRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRemove.ProtoReflect.Descriptor instead.
 func (*QueryRemove) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{29}
+	return file_ffi_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *QueryRemove) GetAddress() []byte {
@@ -1640,7 +1806,7 @@ type QueryRemoveResponse struct {
 func (x *QueryRemoveResponse) Reset() {
 	*x = QueryRemoveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[30]
+		mi := &file_ffi_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1653,7 +1819,7 @@ func (x *QueryRemoveResponse) String() string {
 func (*QueryRemoveResponse) ProtoMessage() {}
 
 func (x *QueryRemoveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[30]
+	mi := &file_ffi_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,7 +1832,7 @@ func (x *QueryRemoveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRemoveResponse.ProtoReflect.Descriptor instead.
 func (*QueryRemoveResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{30}
+	return file_ffi_proto_rawDescGZIP(), []int{31}
 }
 
 type QueryRemoveStorageCell struct {
@@ -1681,7 +1847,7 @@ type QueryRemoveStorageCell struct {
 func (x *QueryRemoveStorageCell) Reset() {
 	*x = QueryRemoveStorageCell{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[31]
+		mi := &file_ffi_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1694,7 +1860,7 @@ func (x *QueryRemoveStorageCell) String() string {
 func (*QueryRemoveStorageCell) ProtoMessage() {}
 
 func (x *QueryRemoveStorageCell) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[31]
+	mi := &file_ffi_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,7 +1873,7 @@ func (x *QueryRemoveStorageCell) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRemoveStorageCell.ProtoReflect.Descriptor instead.
 func (*QueryRemoveStorageCell) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{31}
+	return file_ffi_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *QueryRemoveStorageCell) GetAddress() []byte {
@@ -1733,7 +1899,7 @@ type QueryRemoveStorageCellResponse struct {
 func (x *QueryRemoveStorageCellResponse) Reset() {
 	*x = QueryRemoveStorageCellResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[32]
+		mi := &file_ffi_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1746,7 +1912,7 @@ func (x *QueryRemoveStorageCellResponse) String() string {
 func (*QueryRemoveStorageCellResponse) ProtoMessage() {}
 
 func (x *QueryRemoveStorageCellResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[32]
+	mi := &file_ffi_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1759,7 +1925,7 @@ func (x *QueryRemoveStorageCellResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRemoveStorageCellResponse.ProtoReflect.Descriptor instead.
 func (*QueryRemoveStorageCellResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{32}
+	return file_ffi_proto_rawDescGZIP(), []int{33}
 }
 
 type QueryRemoveStorage struct {
@@ -1773,7 +1939,7 @@ type QueryRemoveStorage struct {
 func (x *QueryRemoveStorage) Reset() {
 	*x = QueryRemoveStorage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[33]
+		mi := &file_ffi_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1786,7 +1952,7 @@ func (x *QueryRemoveStorage) String() string {
 func (*QueryRemoveStorage) ProtoMessage() {}
 
 func (x *QueryRemoveStorage) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[33]
+	mi := &file_ffi_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,7 +1965,7 @@ func (x *QueryRemoveStorage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRemoveStorage.ProtoReflect.Descriptor instead.
 func (*QueryRemoveStorage) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{33}
+	return file_ffi_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *QueryRemoveStorage) GetAddress() []byte {
@@ -1818,7 +1984,7 @@ type QueryRemoveStorageResponse struct {
 func (x *QueryRemoveStorageResponse) Reset() {
 	*x = QueryRemoveStorageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[34]
+		mi := &file_ffi_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1831,7 +1997,7 @@ func (x *QueryRemoveStorageResponse) String() string {
 func (*QueryRemoveStorageResponse) ProtoMessage() {}
 
 func (x *QueryRemoveStorageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[34]
+	mi := &file_ffi_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1844,7 +2010,7 @@ func (x *QueryRemoveStorageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRemoveStorageResponse.ProtoReflect.Descriptor instead.
 func (*QueryRemoveStorageResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{34}
+	return file_ffi_proto_rawDescGZIP(), []int{35}
 }
 
 type QueryBlockHash struct {
@@ -1858,7 +2024,7 @@ type QueryBlockHash struct {
 func (x *QueryBlockHash) Reset() {
 	*x = QueryBlockHash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[35]
+		mi := &file_ffi_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1871,7 +2037,7 @@ func (x *QueryBlockHash) String() string {
 func (*QueryBlockHash) ProtoMessage() {}
 
 func (x *QueryBlockHash) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[35]
+	mi := &file_ffi_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,7 +2050,7 @@ func (x *QueryBlockHash) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryBlockHash.ProtoReflect.Descriptor instead.
 func (*QueryBlockHash) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{35}
+	return file_ffi_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *QueryBlockHash) GetNumber() []byte {
@@ -1905,7 +2071,7 @@ type QueryBlockHashResponse struct {
 func (x *QueryBlockHashResponse) Reset() {
 	*x = QueryBlockHashResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[36]
+		mi := &file_ffi_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1918,7 +2084,7 @@ func (x *QueryBlockHashResponse) String() string {
 func (*QueryBlockHashResponse) ProtoMessage() {}
 
 func (x *QueryBlockHashResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[36]
+	mi := &file_ffi_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1931,7 +2097,7 @@ func (x *QueryBlockHashResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryBlockHashResponse.ProtoReflect.Descriptor instead.
 func (*QueryBlockHashResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{36}
+	return file_ffi_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *QueryBlockHashResponse) GetHash() []byte {
@@ -1945,12 +2111,15 @@ type QueryIssuanceTreeRoot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Optional block height. If not set, root of the current tree is returned
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
 }
 
 func (x *QueryIssuanceTreeRoot) Reset() {
 	*x = QueryIssuanceTreeRoot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[37]
+		mi := &file_ffi_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1963,7 +2132,7 @@ func (x *QueryIssuanceTreeRoot) String() string {
 func (*QueryIssuanceTreeRoot) ProtoMessage() {}
 
 func (x *QueryIssuanceTreeRoot) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[37]
+	mi := &file_ffi_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1976,7 +2145,14 @@ func (x *QueryIssuanceTreeRoot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryIssuanceTreeRoot.ProtoReflect.Descriptor instead.
 func (*QueryIssuanceTreeRoot) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{37}
+	return file_ffi_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QueryIssuanceTreeRoot) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
 }
 
 type QueryIssuanceTreeRootResponse struct {
@@ -1990,7 +2166,7 @@ type QueryIssuanceTreeRootResponse struct {
 func (x *QueryIssuanceTreeRootResponse) Reset() {
 	*x = QueryIssuanceTreeRootResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[38]
+		mi := &file_ffi_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2003,7 +2179,7 @@ func (x *QueryIssuanceTreeRootResponse) String() string {
 func (*QueryIssuanceTreeRootResponse) ProtoMessage() {}
 
 func (x *QueryIssuanceTreeRootResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[38]
+	mi := &file_ffi_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2016,7 +2192,7 @@ func (x *QueryIssuanceTreeRootResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryIssuanceTreeRootResponse.ProtoReflect.Descriptor instead.
 func (*QueryIssuanceTreeRootResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{38}
+	return file_ffi_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *QueryIssuanceTreeRootResponse) GetRoot() []byte {
@@ -2030,12 +2206,15 @@ type QueryRevocationTreeRoot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Optional block height. If not set, root of the current tree is returned
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
 }
 
 func (x *QueryRevocationTreeRoot) Reset() {
 	*x = QueryRevocationTreeRoot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[39]
+		mi := &file_ffi_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2048,7 +2227,7 @@ func (x *QueryRevocationTreeRoot) String() string {
 func (*QueryRevocationTreeRoot) ProtoMessage() {}
 
 func (x *QueryRevocationTreeRoot) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[39]
+	mi := &file_ffi_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2061,7 +2240,14 @@ func (x *QueryRevocationTreeRoot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRevocationTreeRoot.ProtoReflect.Descriptor instead.
 func (*QueryRevocationTreeRoot) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{39}
+	return file_ffi_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *QueryRevocationTreeRoot) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
 }
 
 type QueryRevocationTreeRootResponse struct {
@@ -2075,7 +2261,7 @@ type QueryRevocationTreeRootResponse struct {
 func (x *QueryRevocationTreeRootResponse) Reset() {
 	*x = QueryRevocationTreeRootResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[40]
+		mi := &file_ffi_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2088,7 +2274,7 @@ func (x *QueryRevocationTreeRootResponse) String() string {
 func (*QueryRevocationTreeRootResponse) ProtoMessage() {}
 
 func (x *QueryRevocationTreeRootResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[40]
+	mi := &file_ffi_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2101,7 +2287,7 @@ func (x *QueryRevocationTreeRootResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRevocationTreeRootResponse.ProtoReflect.Descriptor instead.
 func (*QueryRevocationTreeRootResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{40}
+	return file_ffi_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *QueryRevocationTreeRootResponse) GetRoot() []byte {
@@ -2131,7 +2317,7 @@ type QueryAddVerificationDetails struct {
 func (x *QueryAddVerificationDetails) Reset() {
 	*x = QueryAddVerificationDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[41]
+		mi := &file_ffi_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2144,7 +2330,7 @@ func (x *QueryAddVerificationDetails) String() string {
 func (*QueryAddVerificationDetails) ProtoMessage() {}
 
 func (x *QueryAddVerificationDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[41]
+	mi := &file_ffi_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2157,7 +2343,7 @@ func (x *QueryAddVerificationDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryAddVerificationDetails.ProtoReflect.Descriptor instead.
 func (*QueryAddVerificationDetails) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{41}
+	return file_ffi_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *QueryAddVerificationDetails) GetUserAddress() []byte {
@@ -2241,7 +2427,7 @@ type QueryAddVerificationDetailsResponse struct {
 func (x *QueryAddVerificationDetailsResponse) Reset() {
 	*x = QueryAddVerificationDetailsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[42]
+		mi := &file_ffi_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2254,7 +2440,7 @@ func (x *QueryAddVerificationDetailsResponse) String() string {
 func (*QueryAddVerificationDetailsResponse) ProtoMessage() {}
 
 func (x *QueryAddVerificationDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[42]
+	mi := &file_ffi_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2267,7 +2453,7 @@ func (x *QueryAddVerificationDetailsResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use QueryAddVerificationDetailsResponse.ProtoReflect.Descriptor instead.
 func (*QueryAddVerificationDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{42}
+	return file_ffi_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *QueryAddVerificationDetailsResponse) GetVerificationId() []byte {
@@ -2298,7 +2484,7 @@ type QueryAddVerificationDetailsV2 struct {
 func (x *QueryAddVerificationDetailsV2) Reset() {
 	*x = QueryAddVerificationDetailsV2{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[43]
+		mi := &file_ffi_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2311,7 +2497,7 @@ func (x *QueryAddVerificationDetailsV2) String() string {
 func (*QueryAddVerificationDetailsV2) ProtoMessage() {}
 
 func (x *QueryAddVerificationDetailsV2) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[43]
+	mi := &file_ffi_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2324,7 +2510,7 @@ func (x *QueryAddVerificationDetailsV2) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryAddVerificationDetailsV2.ProtoReflect.Descriptor instead.
 func (*QueryAddVerificationDetailsV2) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{43}
+	return file_ffi_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *QueryAddVerificationDetailsV2) GetUserAddress() []byte {
@@ -2415,7 +2601,7 @@ type QueryAddVerificationDetailsV2Response struct {
 func (x *QueryAddVerificationDetailsV2Response) Reset() {
 	*x = QueryAddVerificationDetailsV2Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[44]
+		mi := &file_ffi_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2428,7 +2614,7 @@ func (x *QueryAddVerificationDetailsV2Response) String() string {
 func (*QueryAddVerificationDetailsV2Response) ProtoMessage() {}
 
 func (x *QueryAddVerificationDetailsV2Response) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[44]
+	mi := &file_ffi_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2441,7 +2627,7 @@ func (x *QueryAddVerificationDetailsV2Response) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use QueryAddVerificationDetailsV2Response.ProtoReflect.Descriptor instead.
 func (*QueryAddVerificationDetailsV2Response) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{44}
+	return file_ffi_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *QueryAddVerificationDetailsV2Response) GetVerificationId() []byte {
@@ -2463,7 +2649,7 @@ type QueryRevokeVerification struct {
 func (x *QueryRevokeVerification) Reset() {
 	*x = QueryRevokeVerification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[45]
+		mi := &file_ffi_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2476,7 +2662,7 @@ func (x *QueryRevokeVerification) String() string {
 func (*QueryRevokeVerification) ProtoMessage() {}
 
 func (x *QueryRevokeVerification) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[45]
+	mi := &file_ffi_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2489,7 +2675,7 @@ func (x *QueryRevokeVerification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRevokeVerification.ProtoReflect.Descriptor instead.
 func (*QueryRevokeVerification) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{45}
+	return file_ffi_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *QueryRevokeVerification) GetVerificationId() []byte {
@@ -2515,7 +2701,7 @@ type QueryRevokeVerificationResponse struct {
 func (x *QueryRevokeVerificationResponse) Reset() {
 	*x = QueryRevokeVerificationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[46]
+		mi := &file_ffi_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2528,7 +2714,7 @@ func (x *QueryRevokeVerificationResponse) String() string {
 func (*QueryRevokeVerificationResponse) ProtoMessage() {}
 
 func (x *QueryRevokeVerificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[46]
+	mi := &file_ffi_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2541,7 +2727,7 @@ func (x *QueryRevokeVerificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRevokeVerificationResponse.ProtoReflect.Descriptor instead.
 func (*QueryRevokeVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{46}
+	return file_ffi_proto_rawDescGZIP(), []int{47}
 }
 
 type QueryHasVerification struct {
@@ -2558,7 +2744,7 @@ type QueryHasVerification struct {
 func (x *QueryHasVerification) Reset() {
 	*x = QueryHasVerification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[47]
+		mi := &file_ffi_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2571,7 +2757,7 @@ func (x *QueryHasVerification) String() string {
 func (*QueryHasVerification) ProtoMessage() {}
 
 func (x *QueryHasVerification) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[47]
+	mi := &file_ffi_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2584,7 +2770,7 @@ func (x *QueryHasVerification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryHasVerification.ProtoReflect.Descriptor instead.
 func (*QueryHasVerification) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{47}
+	return file_ffi_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *QueryHasVerification) GetUserAddress() []byte {
@@ -2626,7 +2812,7 @@ type QueryHasVerificationResponse struct {
 func (x *QueryHasVerificationResponse) Reset() {
 	*x = QueryHasVerificationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[48]
+		mi := &file_ffi_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2639,7 +2825,7 @@ func (x *QueryHasVerificationResponse) String() string {
 func (*QueryHasVerificationResponse) ProtoMessage() {}
 
 func (x *QueryHasVerificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[48]
+	mi := &file_ffi_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2652,7 +2838,7 @@ func (x *QueryHasVerificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryHasVerificationResponse.ProtoReflect.Descriptor instead.
 func (*QueryHasVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{48}
+	return file_ffi_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *QueryHasVerificationResponse) GetHasVerification() bool {
@@ -2674,7 +2860,7 @@ type QueryGetVerificationData struct {
 func (x *QueryGetVerificationData) Reset() {
 	*x = QueryGetVerificationData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[49]
+		mi := &file_ffi_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2687,7 +2873,7 @@ func (x *QueryGetVerificationData) String() string {
 func (*QueryGetVerificationData) ProtoMessage() {}
 
 func (x *QueryGetVerificationData) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[49]
+	mi := &file_ffi_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2700,7 +2886,7 @@ func (x *QueryGetVerificationData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGetVerificationData.ProtoReflect.Descriptor instead.
 func (*QueryGetVerificationData) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{49}
+	return file_ffi_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *QueryGetVerificationData) GetUserAddress() []byte {
@@ -2750,7 +2936,7 @@ type VerificationDetails struct {
 func (x *VerificationDetails) Reset() {
 	*x = VerificationDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[50]
+		mi := &file_ffi_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2763,7 +2949,7 @@ func (x *VerificationDetails) String() string {
 func (*VerificationDetails) ProtoMessage() {}
 
 func (x *VerificationDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[50]
+	mi := &file_ffi_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2776,7 +2962,7 @@ func (x *VerificationDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerificationDetails.ProtoReflect.Descriptor instead.
 func (*VerificationDetails) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{50}
+	return file_ffi_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *VerificationDetails) GetVerificationType() uint32 {
@@ -2860,7 +3046,7 @@ type QueryGetVerificationDataResponse struct {
 func (x *QueryGetVerificationDataResponse) Reset() {
 	*x = QueryGetVerificationDataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[51]
+		mi := &file_ffi_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2873,7 +3059,7 @@ func (x *QueryGetVerificationDataResponse) String() string {
 func (*QueryGetVerificationDataResponse) ProtoMessage() {}
 
 func (x *QueryGetVerificationDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[51]
+	mi := &file_ffi_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2886,7 +3072,7 @@ func (x *QueryGetVerificationDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryGetVerificationDataResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetVerificationDataResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{51}
+	return file_ffi_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *QueryGetVerificationDataResponse) GetData() []*VerificationDetails {
@@ -2909,7 +3095,7 @@ type QueryConvertCredential struct {
 func (x *QueryConvertCredential) Reset() {
 	*x = QueryConvertCredential{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[52]
+		mi := &file_ffi_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2922,7 +3108,7 @@ func (x *QueryConvertCredential) String() string {
 func (*QueryConvertCredential) ProtoMessage() {}
 
 func (x *QueryConvertCredential) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[52]
+	mi := &file_ffi_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2935,7 +3121,7 @@ func (x *QueryConvertCredential) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryConvertCredential.ProtoReflect.Descriptor instead.
 func (*QueryConvertCredential) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{52}
+	return file_ffi_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *QueryConvertCredential) GetVerificationId() []byte {
@@ -2968,7 +3154,7 @@ type QueryConvertCredentialResponse struct {
 func (x *QueryConvertCredentialResponse) Reset() {
 	*x = QueryConvertCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[53]
+		mi := &file_ffi_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2981,7 +3167,7 @@ func (x *QueryConvertCredentialResponse) String() string {
 func (*QueryConvertCredentialResponse) ProtoMessage() {}
 
 func (x *QueryConvertCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[53]
+	mi := &file_ffi_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2994,7 +3180,7 @@ func (x *QueryConvertCredentialResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryConvertCredentialResponse.ProtoReflect.Descriptor instead.
 func (*QueryConvertCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{53}
+	return file_ffi_proto_rawDescGZIP(), []int{54}
 }
 
 type CosmosRequest struct {
@@ -3032,7 +3218,7 @@ type CosmosRequest struct {
 func (x *CosmosRequest) Reset() {
 	*x = CosmosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[54]
+		mi := &file_ffi_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3045,7 +3231,7 @@ func (x *CosmosRequest) String() string {
 func (*CosmosRequest) ProtoMessage() {}
 
 func (x *CosmosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[54]
+	mi := &file_ffi_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,7 +3244,7 @@ func (x *CosmosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CosmosRequest.ProtoReflect.Descriptor instead.
 func (*CosmosRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{54}
+	return file_ffi_proto_rawDescGZIP(), []int{55}
 }
 
 func (m *CosmosRequest) GetReq() isCosmosRequest_Req {
@@ -3364,26 +3550,28 @@ type SGXVMCallParams struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From                 []byte            `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To                   []byte            `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Data                 []byte            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	GasLimit             uint64            `protobuf:"varint,4,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
-	GasPrice             []byte            `protobuf:"bytes,5,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
-	Value                []byte            `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
-	AccessList           []*AccessListItem `protobuf:"bytes,7,rep,name=accessList,proto3" json:"accessList,omitempty"`
-	Commit               bool              `protobuf:"varint,8,opt,name=commit,proto3" json:"commit,omitempty"`
-	Nonce                uint64            `protobuf:"varint,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Unencrypted          bool              `protobuf:"varint,10,opt,name=unencrypted,proto3" json:"unencrypted,omitempty"`
-	Signature            []byte            `protobuf:"bytes,11,opt,name=signature,proto3" json:"signature,omitempty"`
-	MaxPriorityFeePerGas []byte            `protobuf:"bytes,12,opt,name=maxPriorityFeePerGas,proto3" json:"maxPriorityFeePerGas,omitempty"`
-	MaxFeePerGas         []byte            `protobuf:"bytes,13,opt,name=maxFeePerGas,proto3" json:"maxFeePerGas,omitempty"`
-	TxType               uint32            `protobuf:"varint,14,opt,name=txType,proto3" json:"txType,omitempty"`
+	From                 []byte                   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   []byte                   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Data                 []byte                   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	GasLimit             uint64                   `protobuf:"varint,4,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	GasPrice             []byte                   `protobuf:"bytes,5,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	Value                []byte                   `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	AccessList           []*AccessListItem        `protobuf:"bytes,7,rep,name=accessList,proto3" json:"accessList,omitempty"`
+	Commit               bool                     `protobuf:"varint,8,opt,name=commit,proto3" json:"commit,omitempty"`
+	Nonce                uint64                   `protobuf:"varint,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Unencrypted          bool                     `protobuf:"varint,10,opt,name=unencrypted,proto3" json:"unencrypted,omitempty"`
+	Signature            []byte                   `protobuf:"bytes,11,opt,name=signature,proto3" json:"signature,omitempty"`
+	MaxPriorityFeePerGas []byte                   `protobuf:"bytes,12,opt,name=maxPriorityFeePerGas,proto3" json:"maxPriorityFeePerGas,omitempty"`
+	MaxFeePerGas         []byte                   `protobuf:"bytes,13,opt,name=maxFeePerGas,proto3" json:"maxFeePerGas,omitempty"`
+	TxType               uint32                   `protobuf:"varint,14,opt,name=txType,proto3" json:"txType,omitempty"`
+	Trace                *TraceOptions            `protobuf:"bytes,15,opt,name=trace,proto3" json:"trace,omitempty"`
+	AuthorizationList    []*AuthorizationListItem `protobuf:"bytes,16,rep,name=authorizationList,proto3" json:"authorizationList,omitempty"`
 }
 
 func (x *SGXVMCallParams) Reset() {
 	*x = SGXVMCallParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[55]
+		mi := &file_ffi_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3396,7 +3584,7 @@ func (x *SGXVMCallParams) String() string {
 func (*SGXVMCallParams) ProtoMessage() {}
 
 func (x *SGXVMCallParams) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[55]
+	mi := &file_ffi_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3409,7 +3597,7 @@ func (x *SGXVMCallParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SGXVMCallParams.ProtoReflect.Descriptor instead.
 func (*SGXVMCallParams) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{55}
+	return file_ffi_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *SGXVMCallParams) GetFrom() []byte {
@@ -3510,6 +3698,20 @@ func (x *SGXVMCallParams) GetTxType() uint32 {
 	return 0
 }
 
+func (x *SGXVMCallParams) GetTrace() *TraceOptions {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *SGXVMCallParams) GetAuthorizationList() []*AuthorizationListItem {
+	if x != nil {
+		return x.AuthorizationList
+	}
+	return nil
+}
+
 // Message with data required to execute `create` operation
 type SGXVMCreateParams struct {
 	state         protoimpl.MessageState
@@ -3528,12 +3730,13 @@ type SGXVMCreateParams struct {
 	MaxPriorityFeePerGas []byte            `protobuf:"bytes,10,opt,name=maxPriorityFeePerGas,proto3" json:"maxPriorityFeePerGas,omitempty"`
 	MaxFeePerGas         []byte            `protobuf:"bytes,11,opt,name=maxFeePerGas,proto3" json:"maxFeePerGas,omitempty"`
 	TxType               uint32            `protobuf:"varint,12,opt,name=txType,proto3" json:"txType,omitempty"`
+	Trace                *TraceOptions     `protobuf:"bytes,13,opt,name=trace,proto3" json:"trace,omitempty"`
 }
 
 func (x *SGXVMCreateParams) Reset() {
 	*x = SGXVMCreateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[56]
+		mi := &file_ffi_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3546,7 +3749,7 @@ func (x *SGXVMCreateParams) String() string {
 func (*SGXVMCreateParams) ProtoMessage() {}
 
 func (x *SGXVMCreateParams) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[56]
+	mi := &file_ffi_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3559,7 +3762,7 @@ func (x *SGXVMCreateParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SGXVMCreateParams.ProtoReflect.Descriptor instead.
 func (*SGXVMCreateParams) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{56}
+	return file_ffi_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *SGXVMCreateParams) GetFrom() []byte {
@@ -3646,6 +3849,13 @@ func (x *SGXVMCreateParams) GetTxType() uint32 {
 	return 0
 }
 
+func (x *SGXVMCreateParams) GetTrace() *TraceOptions {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
 // Message with data required to execute `estimateGas` operation
 type SGXVMEstimateGasParams struct {
 	state         protoimpl.MessageState
@@ -3671,7 +3881,7 @@ type SGXVMEstimateGasParams struct {
 func (x *SGXVMEstimateGasParams) Reset() {
 	*x = SGXVMEstimateGasParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[57]
+		mi := &file_ffi_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3684,7 +3894,7 @@ func (x *SGXVMEstimateGasParams) String() string {
 func (*SGXVMEstimateGasParams) ProtoMessage() {}
 
 func (x *SGXVMEstimateGasParams) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[57]
+	mi := &file_ffi_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3697,7 +3907,7 @@ func (x *SGXVMEstimateGasParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SGXVMEstimateGasParams.ProtoReflect.Descriptor instead.
 func (*SGXVMEstimateGasParams) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{57}
+	return file_ffi_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *SGXVMEstimateGasParams) GetFrom() []byte {
@@ -3798,33 +4008,43 @@ func (x *SGXVMEstimateGasParams) GetTxType() uint32 {
 	return 0
 }
 
-// Request to execute `call` operation
-type SGXVMCallRequest struct {
+// Options of execution tracing
+type TraceOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params  *SGXVMCallParams    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Context *TransactionContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
-}
-
-func (x *SGXVMCallRequest) Reset() {
-	*x = SGXVMCallRequest{}
+	Tracer           TracerType `protobuf:"varint,1,opt,name=tracer,proto3,enum=ffi.ffi.TracerType" json:"tracer,omitempty"`
+	DisableStack     bool       `protobuf:"varint,2,opt,name=disableStack,proto3" json:"disableStack,omitempty"`
+	DisableStorage   bool       `protobuf:"varint,3,opt,name=disableStorage,proto3" json:"disableStorage,omitempty"`
+	EnableMemory     bool       `protobuf:"varint,4,opt,name=enableMemory,proto3" json:"enableMemory,omitempty"`
+	EnableReturnData bool       `protobuf:"varint,5,opt,name=enableReturnData,proto3" json:"enableReturnData,omitempty"`
+	// maximum number of collected struct logs, zero means unlimited
+	Limit uint64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// call tracer collects only top-level call if set
+	OnlyTopCall bool `protobuf:"varint,7,opt,name=onlyTopCall,proto3" json:"onlyTopCall,omitempty"`
+	// set by trace nodes only. Otherwise, struct logs do not contain stack, memory,
+	// return data and storage, and encrypted transactions cannot be traced
+	TraceNode bool `protobuf:"varint,8,opt,name=traceNode,proto3" json:"traceNode,omitempty"`
+}
+
+func (x *TraceOptions) Reset() {
+	*x = TraceOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[58]
+		mi := &file_ffi_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SGXVMCallRequest) String() string {
+func (x *TraceOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SGXVMCallRequest) ProtoMessage() {}
+func (*TraceOptions) ProtoMessage() {}
 
-func (x *SGXVMCallRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[58]
+func (x *TraceOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3835,39 +4055,506 @@ func (x *SGXVMCallRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SGXVMCallRequest.ProtoReflect.Descriptor instead.
-func (*SGXVMCallRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use TraceOptions.ProtoReflect.Descriptor instead.
+func (*TraceOptions) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SGXVMCallRequest) GetParams() *SGXVMCallParams {
+func (x *TraceOptions) GetTracer() TracerType {
 	if x != nil {
-		return x.Params
+		return x.Tracer
 	}
-	return nil
+	return TracerType_TRACER_NONE
 }
 
-func (x *SGXVMCallRequest) GetContext() *TransactionContext {
+func (x *TraceOptions) GetDisableStack() bool {
 	if x != nil {
-		return x.Context
+		return x.DisableStack
 	}
-	return nil
+	return false
 }
 
-// Request to execute `create` operation
-type SGXVMCreateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Params  *SGXVMCreateParams  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (x *TraceOptions) GetDisableStorage() bool {
+	if x != nil {
+		return x.DisableStorage
+	}
+	return false
+}
+
+func (x *TraceOptions) GetEnableMemory() bool {
+	if x != nil {
+		return x.EnableMemory
+	}
+	return false
+}
+
+func (x *TraceOptions) GetEnableReturnData() bool {
+	if x != nil {
+		return x.EnableReturnData
+	}
+	return false
+}
+
+func (x *TraceOptions) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TraceOptions) GetOnlyTopCall() bool {
+	if x != nil {
+		return x.OnlyTopCall
+	}
+	return false
+}
+
+func (x *TraceOptions) GetTraceNode() bool {
+	if x != nil {
+		return x.TraceNode
+	}
+	return false
+}
+
+type TraceStorageEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TraceStorageEntry) Reset() {
+	*x = TraceStorageEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceStorageEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceStorageEntry) ProtoMessage() {}
+
+func (x *TraceStorageEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceStorageEntry.ProtoReflect.Descriptor instead.
+func (*TraceStorageEntry) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TraceStorageEntry) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *TraceStorageEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// State of EVM after execution of a single opcode
+type TraceStructLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pc         uint64               `protobuf:"varint,1,opt,name=pc,proto3" json:"pc,omitempty"`
+	Op         string               `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Gas        uint64               `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasCost    uint64               `protobuf:"varint,4,opt,name=gasCost,proto3" json:"gasCost,omitempty"`
+	Depth      uint32               `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	Stack      [][]byte             `protobuf:"bytes,6,rep,name=stack,proto3" json:"stack,omitempty"`
+	Memory     []byte               `protobuf:"bytes,7,opt,name=memory,proto3" json:"memory,omitempty"`
+	Storage    []*TraceStorageEntry `protobuf:"bytes,8,rep,name=storage,proto3" json:"storage,omitempty"`
+	ReturnData []byte               `protobuf:"bytes,9,opt,name=returnData,proto3" json:"returnData,omitempty"`
+	Refund     uint64               `protobuf:"varint,10,opt,name=refund,proto3" json:"refund,omitempty"`
+	Error      string               `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TraceStructLog) Reset() {
+	*x = TraceStructLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceStructLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceStructLog) ProtoMessage() {}
+
+func (x *TraceStructLog) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceStructLog.ProtoReflect.Descriptor instead.
+func (*TraceStructLog) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *TraceStructLog) GetPc() uint64 {
+	if x != nil {
+		return x.Pc
+	}
+	return 0
+}
+
+func (x *TraceStructLog) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *TraceStructLog) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *TraceStructLog) GetGasCost() uint64 {
+	if x != nil {
+		return x.GasCost
+	}
+	return 0
+}
+
+func (x *TraceStructLog) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *TraceStructLog) GetStack() [][]byte {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+func (x *TraceStructLog) GetMemory() []byte {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *TraceStructLog) GetStorage() []*TraceStorageEntry {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *TraceStructLog) GetReturnData() []byte {
+	if x != nil {
+		return x.ReturnData
+	}
+	return nil
+}
+
+func (x *TraceStructLog) GetRefund() uint64 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+func (x *TraceStructLog) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Call made during transaction execution
+type TraceCallFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CALL, STATICCALL, DELEGATECALL, CALLCODE, CREATE or CREATE2
+	Type    string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From    []byte            `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To      []byte            `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value   []byte            `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Gas     uint64            `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasUsed uint64            `protobuf:"varint,6,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Input   []byte            `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Output  []byte            `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	Error   string            `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Calls   []*TraceCallFrame `protobuf:"bytes,10,rep,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (x *TraceCallFrame) Reset() {
+	*x = TraceCallFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceCallFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceCallFrame) ProtoMessage() {}
+
+func (x *TraceCallFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceCallFrame.ProtoReflect.Descriptor instead.
+func (*TraceCallFrame) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *TraceCallFrame) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TraceCallFrame) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TraceCallFrame) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TraceCallFrame) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *TraceCallFrame) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *TraceCallFrame) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *TraceCallFrame) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *TraceCallFrame) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *TraceCallFrame) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TraceCallFrame) GetCalls() []*TraceCallFrame {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+// Execution trace collected by requested tracer
+type ExecutionTrace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StructLogs []*TraceStructLog `protobuf:"bytes,1,rep,name=structLogs,proto3" json:"structLogs,omitempty"`
+	CallFrame  *TraceCallFrame   `protobuf:"bytes,2,opt,name=callFrame,proto3" json:"callFrame,omitempty"`
+	// accounts and storage slots touched during execution
+	AccessList []*AccessListItem `protobuf:"bytes,3,rep,name=accessList,proto3" json:"accessList,omitempty"`
+}
+
+func (x *ExecutionTrace) Reset() {
+	*x = ExecutionTrace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionTrace) ProtoMessage() {}
+
+func (x *ExecutionTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionTrace.ProtoReflect.Descriptor instead.
+func (*ExecutionTrace) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ExecutionTrace) GetStructLogs() []*TraceStructLog {
+	if x != nil {
+		return x.StructLogs
+	}
+	return nil
+}
+
+func (x *ExecutionTrace) GetCallFrame() *TraceCallFrame {
+	if x != nil {
+		return x.CallFrame
+	}
+	return nil
+}
+
+func (x *ExecutionTrace) GetAccessList() []*AccessListItem {
+	if x != nil {
+		return x.AccessList
+	}
+	return nil
+}
+
+// Request to execute `call` operation
+type SGXVMCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params  *SGXVMCallParams    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Context *TransactionContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *SGXVMCallRequest) Reset() {
+	*x = SGXVMCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SGXVMCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SGXVMCallRequest) ProtoMessage() {}
+
+func (x *SGXVMCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SGXVMCallRequest.ProtoReflect.Descriptor instead.
+func (*SGXVMCallRequest) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SGXVMCallRequest) GetParams() *SGXVMCallParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *SGXVMCallRequest) GetContext() *TransactionContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// Request to execute `create` operation
+type SGXVMCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params  *SGXVMCreateParams  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 	Context *TransactionContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
 }
 
 func (x *SGXVMCreateRequest) Reset() {
 	*x = SGXVMCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[59]
+		mi := &file_ffi_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3880,7 +4567,7 @@ func (x *SGXVMCreateRequest) String() string {
 func (*SGXVMCreateRequest) ProtoMessage() {}
 
 func (x *SGXVMCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[59]
+	mi := &file_ffi_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3893,7 +4580,7 @@ func (x *SGXVMCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SGXVMCreateRequest.ProtoReflect.Descriptor instead.
 func (*SGXVMCreateRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{59}
+	return file_ffi_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *SGXVMCreateRequest) GetParams() *SGXVMCreateParams {
@@ -3923,7 +4610,7 @@ type SGXVMEstimateGasRequest struct {
 func (x *SGXVMEstimateGasRequest) Reset() {
 	*x = SGXVMEstimateGasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[60]
+		mi := &file_ffi_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3936,7 +4623,7 @@ func (x *SGXVMEstimateGasRequest) String() string {
 func (*SGXVMEstimateGasRequest) ProtoMessage() {}
 
 func (x *SGXVMEstimateGasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[60]
+	mi := &file_ffi_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3949,7 +4636,7 @@ func (x *SGXVMEstimateGasRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SGXVMEstimateGasRequest.ProtoReflect.Descriptor instead.
 func (*SGXVMEstimateGasRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{60}
+	return file_ffi_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *SGXVMEstimateGasRequest) GetParams() *SGXVMEstimateGasParams {
@@ -3978,7 +4665,7 @@ type NodePublicKeyRequest struct {
 func (x *NodePublicKeyRequest) Reset() {
 	*x = NodePublicKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[61]
+		mi := &file_ffi_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3991,7 +4678,7 @@ func (x *NodePublicKeyRequest) String() string {
 func (*NodePublicKeyRequest) ProtoMessage() {}
 
 func (x *NodePublicKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[61]
+	mi := &file_ffi_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4004,7 +4691,7 @@ func (x *NodePublicKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodePublicKeyRequest.ProtoReflect.Descriptor instead.
 func (*NodePublicKeyRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{61}
+	return file_ffi_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *NodePublicKeyRequest) GetBlockNumber() uint64 {
@@ -4026,7 +4713,7 @@ type NodePublicKeyResponse struct {
 func (x *NodePublicKeyResponse) Reset() {
 	*x = NodePublicKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[62]
+		mi := &file_ffi_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4039,7 +4726,7 @@ func (x *NodePublicKeyResponse) String() string {
 func (*NodePublicKeyResponse) ProtoMessage() {}
 
 func (x *NodePublicKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[62]
+	mi := &file_ffi_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4052,12 +4739,160 @@ func (x *NodePublicKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodePublicKeyResponse.ProtoReflect.Descriptor instead.
 func (*NodePublicKeyResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{62}
+	return file_ffi_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *NodePublicKeyResponse) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+// Request to read contract storage slot on behalf of contract owner.
+// Signature is made by contract owner over contract address, storage key
+// and user public key. Owner is either deployer of the contract (checked
+// using deploymentNonce) or address stored in the owner slot (slot 0).
+type StorageAtEncryptedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractAddress []byte `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	StorageKey      []byte `protobuf:"bytes,2,opt,name=storageKey,proto3" json:"storageKey,omitempty"`
+	UserPublicKey   []byte `protobuf:"bytes,3,opt,name=userPublicKey,proto3" json:"userPublicKey,omitempty"`
+	Signature       []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	DeploymentNonce uint64 `protobuf:"varint,5,opt,name=deploymentNonce,proto3" json:"deploymentNonce,omitempty"`
+	BlockNumber     uint64 `protobuf:"varint,6,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+}
+
+func (x *StorageAtEncryptedRequest) Reset() {
+	*x = StorageAtEncryptedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageAtEncryptedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageAtEncryptedRequest) ProtoMessage() {}
+
+func (x *StorageAtEncryptedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageAtEncryptedRequest.ProtoReflect.Descriptor instead.
+func (*StorageAtEncryptedRequest) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StorageAtEncryptedRequest) GetContractAddress() []byte {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return nil
+}
+
+func (x *StorageAtEncryptedRequest) GetStorageKey() []byte {
+	if x != nil {
+		return x.StorageKey
+	}
+	return nil
+}
+
+func (x *StorageAtEncryptedRequest) GetUserPublicKey() []byte {
+	if x != nil {
+		return x.UserPublicKey
+	}
+	return nil
+}
+
+func (x *StorageAtEncryptedRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *StorageAtEncryptedRequest) GetDeploymentNonce() uint64 {
+	if x != nil {
+		return x.DeploymentNonce
+	}
+	return 0
+}
+
+func (x *StorageAtEncryptedRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+// Response with storage slot value encrypted using shared key
+// derived from user public key and node public key
+type StorageAtEncryptedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EncryptedValue []byte `protobuf:"bytes,1,opt,name=encryptedValue,proto3" json:"encryptedValue,omitempty"`
+	NodePublicKey  []byte `protobuf:"bytes,2,opt,name=nodePublicKey,proto3" json:"nodePublicKey,omitempty"`
+}
+
+func (x *StorageAtEncryptedResponse) Reset() {
+	*x = StorageAtEncryptedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageAtEncryptedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageAtEncryptedResponse) ProtoMessage() {}
+
+func (x *StorageAtEncryptedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageAtEncryptedResponse.ProtoReflect.Descriptor instead.
+func (*StorageAtEncryptedResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *StorageAtEncryptedResponse) GetEncryptedValue() []byte {
+	if x != nil {
+		return x.EncryptedValue
+	}
+	return nil
 }
 
-func (x *NodePublicKeyResponse) GetPublicKey() []byte {
+func (x *StorageAtEncryptedResponse) GetNodePublicKey() []byte {
 	if x != nil {
-		return x.PublicKey
+		return x.NodePublicKey
 	}
 	return nil
 }
@@ -4075,7 +4910,7 @@ type EpochData struct {
 func (x *EpochData) Reset() {
 	*x = EpochData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[63]
+		mi := &file_ffi_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4088,7 +4923,7 @@ func (x *EpochData) String() string {
 func (*EpochData) ProtoMessage() {}
 
 func (x *EpochData) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[63]
+	mi := &file_ffi_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4101,7 +4936,7 @@ func (x *EpochData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochData.ProtoReflect.Descriptor instead.
 func (*EpochData) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{63}
+	return file_ffi_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *EpochData) GetEpochNumber() uint32 {
@@ -4136,7 +4971,7 @@ type ListEpochsResponse struct {
 func (x *ListEpochsResponse) Reset() {
 	*x = ListEpochsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[64]
+		mi := &file_ffi_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4149,7 +4984,7 @@ func (x *ListEpochsResponse) String() string {
 func (*ListEpochsResponse) ProtoMessage() {}
 
 func (x *ListEpochsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[64]
+	mi := &file_ffi_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4162,7 +4997,7 @@ func (x *ListEpochsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEpochsResponse.ProtoReflect.Descriptor instead.
 func (*ListEpochsResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{64}
+	return file_ffi_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *ListEpochsResponse) GetEpochs() []*EpochData {
@@ -4183,13 +5018,14 @@ type FFIRequest struct {
 	//	*FFIRequest_CreateRequest
 	//	*FFIRequest_EstimateGasRequest
 	//	*FFIRequest_PublicKeyRequest
+	//	*FFIRequest_StorageAtEncryptedRequest
 	Req isFFIRequest_Req `protobuf_oneof:"req"`
 }
 
 func (x *FFIRequest) Reset() {
 	*x = FFIRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[65]
+		mi := &file_ffi_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4202,7 +5038,7 @@ func (x *FFIRequest) String() string {
 func (*FFIRequest) ProtoMessage() {}
 
 func (x *FFIRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[65]
+	mi := &file_ffi_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4215,7 +5051,7 @@ func (x *FFIRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FFIRequest.ProtoReflect.Descriptor instead.
 func (*FFIRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{65}
+	return file_ffi_proto_rawDescGZIP(), []int{73}
 }
 
 func (m *FFIRequest) GetReq() isFFIRequest_Req {
@@ -4253,6 +5089,13 @@ func (x *FFIRequest) GetPublicKeyRequest() *NodePublicKeyRequest {
 	return nil
 }
 
+func (x *FFIRequest) GetStorageAtEncryptedRequest() *StorageAtEncryptedRequest {
+	if x, ok := x.GetReq().(*FFIRequest_StorageAtEncryptedRequest); ok {
+		return x.StorageAtEncryptedRequest
+	}
+	return nil
+}
+
 type isFFIRequest_Req interface {
 	isFFIRequest_Req()
 }
@@ -4273,6 +5116,10 @@ type FFIRequest_PublicKeyRequest struct {
 	PublicKeyRequest *NodePublicKeyRequest `protobuf:"bytes,4,opt,name=publicKeyRequest,proto3,oneof"`
 }
 
+type FFIRequest_StorageAtEncryptedRequest struct {
+	StorageAtEncryptedRequest *StorageAtEncryptedRequest `protobuf:"bytes,5,opt,name=storageAtEncryptedRequest,proto3,oneof"`
+}
+
 func (*FFIRequest_CallRequest) isFFIRequest_Req() {}
 
 func (*FFIRequest_CreateRequest) isFFIRequest_Req() {}
@@ -4281,6 +5128,8 @@ func (*FFIRequest_EstimateGasRequest) isFFIRequest_Req() {}
 
 func (*FFIRequest_PublicKeyRequest) isFFIRequest_Req() {}
 
+func (*FFIRequest_StorageAtEncryptedRequest) isFFIRequest_Req() {}
+
 var File_ffi_proto protoreflect.FileDescriptor
 
 var file_ffi_proto_rawDesc = []byte{
@@ -4290,563 +5139,695 @@ var file_ffi_proto_rawDesc = []byte{
 	0x65, 0x53, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x74, 0x6f,
 	0x72, 0x61, 0x67, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
 	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a,
-	0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x37, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x90, 0x02, 0x0a, 0x12, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67,
-	0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32,
-	0x0a, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47,
-	0x61, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x89, 0x01, 0x0a,
-	0x18, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x74, 0x78, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x69,
+	0x73, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x79, 0x50, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x79, 0x50, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x72, 0x12, 0x0c,
+	0x0a, 0x01, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x73, 0x22, 0xb4, 0x01, 0x0a,
+	0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x90, 0x02, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x16, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67,
+	0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x18, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06,
+	0x74, 0x78, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69,
 	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x0a,
-	0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x09, 0x74,
-	0x78, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x19, 0x48, 0x61, 0x6e,
-	0x64, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x4c,
-	0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d,
-	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64,
-	0x22, 0x89, 0x01, 0x0a, 0x18, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d,
-	0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a,
-	0x07, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x52, 0x09, 0x74, 0x78, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x51, 0x0a, 0x19,
-	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d, 0x5f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x22,
-	0x1d, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x5b,
-	0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x26, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52,
-	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2b, 0x0a, 0x0f, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x49, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x22, 0x4f, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65,
-	0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x22, 0x23, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73,
-	0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73,
-	0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x36, 0x0a,
-	0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x4c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
-	0x65, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x22, 0x3a, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x2f, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x09, 0x74, 0x78, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x19, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f,
+	0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x72, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x73,
+	0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67,
+	0x61, 0x73, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x18, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x09, 0x74, 0x78, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x22, 0x51, 0x0a, 0x19, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x22, 0x1d, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x5b, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x2b, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x31, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x22, 0x33, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x35, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
-	0x33, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x35, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x46, 0x0a, 0x16, 0x51,
+	0x22, 0x49, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x4f, 0x0a, 0x19, 0x51,
 	0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65,
-	0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e,
-	0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e,
-	0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x23, 0x0a, 0x21,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x49, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x21, 0x0a, 0x1f,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x24, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+	0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x36, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x4c, 0x0a,
+	0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a, 0x0a, 0x22, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x31, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x33, 0x0a, 0x17, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c,
-	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x22, 0x20, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x22, 0x35, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x33, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x35, 0x0a, 0x1f,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x22, 0x46, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a,
+	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x20, 0x0a,
+	0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x27, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x48, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x28, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x16, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f,
-	0x6f, 0x74, 0x22, 0x33, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x73, 0x75, 0x61,
-	0x6e, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f,
-	0x6f, 0x74, 0x22, 0x35, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x97, 0x03, 0x0a, 0x1b, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
-	0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69,
-	0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x2c, 0x0a, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69, 0x73, 0x73, 0x75,
-	0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a,
-	0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x22, 0xbf, 0x03, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x56, 0x32, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69,
-	0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2a,
-	0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x73,
-	0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x44, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x6f, 0x66, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x12, 0x32, 0x0a, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
-	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24,
-	0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x22, 0x4f, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
-	0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x26, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
-	0x22, 0x21, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x61, 0x73,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
-	0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a,
-	0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x73, 0x73,
-	0x75, 0x65, 0x72, 0x73, 0x22, 0x48, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x61, 0x73,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68,
-	0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62,
-	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73,
-	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d,
-	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x9b, 0x03, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x20, 0x0a, 0x1e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0x39, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x33, 0x0a, 0x1d,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x65,
+	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x74, 0x22, 0x3b, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x35,
+	0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x97, 0x03, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x2a, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69,
+	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x44, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x4d, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xbf,
+	0x03, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x56, 0x32,
+	0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
-	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x24,
-	0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e,
 	0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x0d, 0x52, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73,
 	0x74, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
 	0x0d, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x54, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a, 0x14,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x22, 0x4f, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x56,
+	0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x59, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22, 0x21, 0x0a, 0x1f,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xbe, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75,
+	0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73,
+	0x22, 0x48, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x18, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x9b,
+	0x03, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69,
+	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x32,
+	0x0a, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x20,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x26, 0x0a,
+	0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x20, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x6f, 0x6c,
-	0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x20, 0x0a, 0x1e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x0d,
-	0x0a, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3a, 0x0a, 0x0a, 0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52,
-	0x0a, 0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
-	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a, 0x08,
-	0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68,
-	0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a, 0x08,
-	0x63, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x47, 0x0a, 0x0b,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x4f, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64,
-	0x65, 0x48, 0x00, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65,
-	0x6c, 0x6c, 0x48, 0x00, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66,
-	0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x48, 0x00, 0x52,
-	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
-	0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x43, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d,
-	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x5e, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x16,
-	0x61, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48,
-	0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x0f, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x55, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x48, 0x00, 0x52, 0x13, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x58, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x65,
-	0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x14, 0x69, 0x6e,
-	0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e,
-	0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
-	0x48, 0x00, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e,
-	0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74,
-	0x48, 0x00, 0x52, 0x10, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65,
-	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x52, 0x0a, 0x12, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f,
-	0x6f, 0x74, 0x48, 0x00, 0x52, 0x12, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x64, 0x0a, 0x18, 0x61, 0x64, 0x64, 0x56,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x0d, 0x0a, 0x0d, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x67,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x48, 0x00, 0x52, 0x08,
+	0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x48, 0x00, 0x52, 0x08,
+	0x63, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65,
+	0x6c, 0x6c, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c,
+	0x6c, 0x12, 0x4f, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
+	0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x48, 0x00,
+	0x52, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x65, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x48,
+	0x00, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x43, 0x65, 0x6c, 0x6c, 0x12, 0x43, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x5e, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x16, 0x61, 0x64, 0x64, 0x56,
 	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x56, 0x32, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x66, 0x69,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x56, 0x32, 0x48, 0x00, 0x52, 0x18, 0x61, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x56, 0x32, 0x12, 0x52,
-	0x0a, 0x12, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x66, 0x69,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x12,
-	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e,
-	0x76, 0x65, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x00,
-	0x52, 0x11, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x42, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x22, 0xae, 0x03, 0x0a, 0x0f, 0x53,
-	0x47, 0x58, 0x56, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
-	0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66,
-	0x66, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75,
-	0x6e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x75, 0x6e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x6d,
-	0x61, 0x78, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72,
-	0x47, 0x61, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12,
-	0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72,
-	0x47, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x22, 0xfe, 0x02, 0x0a, 0x11,
-	0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66,
-	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x14,
-	0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x50, 0x65,
-	0x72, 0x47, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x50,
+	0x6c, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x61, 0x73, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x68, 0x61,
+	0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a,
+	0x13, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x13, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x58, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x14, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x52,
+	0x0a, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x12,
+	0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72,
+	0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x73, 0x75,
+	0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x10,
+	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x52, 0x0a, 0x12, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72,
+	0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x00,
+	0x52, 0x12, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x64, 0x0a, 0x18, 0x61, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x56, 0x32,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x56, 0x32, 0x48, 0x00,
+	0x52, 0x18, 0x61, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x56, 0x32, 0x12, 0x52, 0x0a, 0x12, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x12, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f,
+	0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x11, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x42,
+	0x05, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x22, 0xa9, 0x04, 0x0a, 0x0f, 0x53, 0x47, 0x58, 0x56, 0x4d,
+	0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x37, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d,
+	0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0xab, 0x03, 0x0a, 0x11, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x65,
+	0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x65,
+	0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6d,
+	0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x78, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x22, 0xb5, 0x03, 0x0a, 0x16, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75,
+	0x6e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x50,
 	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73,
-	0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65,
-	0x72, 0x47, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb5, 0x03, 0x0a,
-	0x16, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61,
-	0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
-	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x67,
-	0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67,
-	0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a,
-	0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x65, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x46,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
-	0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x78,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x7b, 0x0a, 0x10, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x61, 0x6c,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66,
-	0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66,
-	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x22, 0x7f, 0x0a, 0x12, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66,
-	0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x22, 0xad, 0x02, 0x0a, 0x0c, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79,
+	0x54, 0x6f, 0x70, 0x43, 0x61, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f,
+	0x6e, 0x6c, 0x79, 0x54, 0x6f, 0x70, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x3b, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa4, 0x02, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x70, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61,
+	0x73, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xfd, 0x01, 0x0a,
+	0x0e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x67, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2d, 0x0a,
+	0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0xb9, 0x01, 0x0a,
+	0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12,
+	0x37, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x0a, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12,
+	0x37, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x10, 0x53, 0x47, 0x58, 0x56,
+	0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x61, 0x6c, 0x6c,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x35,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x7f, 0x0a, 0x12, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x35, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x17, 0x53, 0x47, 0x58, 0x56, 0x4d,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58,
+	0x56, 0x4d, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x63,
 	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66,
 	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x17, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x45, 0x73, 0x74, 0x69,
-	0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37,
-	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x45, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66,
-	0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x38,
-	0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x15, 0x4e, 0x6f, 0x64, 0x65,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22,
-	0x79, 0x0a, 0x09, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24,
-	0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6e, 0x6f, 0x64,
-	0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x40, 0x0a, 0x12, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2a, 0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xb8, 0x02, 0x0a,
-	0x0a, 0x46, 0x46, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x63,
-	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d,
-	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x63,
-	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56,
-	0x4d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
-	0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x52, 0x0a, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x66,
-	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x45, 0x73, 0x74, 0x69, 0x6d,
-	0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
-	0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x42, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x69, 0x67, 0x6d, 0x61, 0x47, 0x6d, 0x62, 0x48, 0x2f,
-	0x6c, 0x69, 0x62, 0x72, 0x75, 0x73, 0x74, 0x67, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x78, 0x74, 0x22, 0x38, 0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x15,
+	0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x22, 0xf5, 0x01, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41,
+	0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x75,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x1a, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x79, 0x0a, 0x09, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0d,
+	0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x22, 0x40, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x22, 0x9c, 0x03, 0x0a, 0x0a, 0x46, 0x46, 0x49, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47,
+	0x58, 0x56, 0x4d, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x10, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x19, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x41, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x74, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x19, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x74, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x05, 0x0a, 0x03,
+	0x72, 0x65, 0x71, 0x2a, 0x59, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x43, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x52, 0x41, 0x43, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52,
+	0x55, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x43, 0x45, 0x52, 0x5f,
+	0x43, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x52, 0x41, 0x43, 0x45, 0x52,
+	0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x03, 0x42, 0x26,
+	0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x69, 0x67,
+	0x6d, 0x61, 0x47, 0x6d, 0x62, 0x48, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x75, 0x73, 0x74, 0x67, 0x6f,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4861,125 +5842,146 @@ func file_ffi_proto_rawDescGZIP() []byte {
 	return file_ffi_proto_rawDescData
 }
 
-var file_ffi_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_ffi_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ffi_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
 var file_ffi_proto_goTypes = []interface{}{
-	(*AccessListItem)(nil),                        // 0: ffi.ffi.AccessListItem
-	(*TransactionData)(nil),                       // 1: ffi.ffi.TransactionData
-	(*TransactionContext)(nil),                    // 2: ffi.ffi.TransactionContext
-	(*HandleTransactionRequest)(nil),              // 3: ffi.ffi.HandleTransactionRequest
-	(*HandleTransactionResponse)(nil),             // 4: ffi.ffi.HandleTransactionResponse
-	(*HandleEstimateGasRequest)(nil),              // 5: ffi.ffi.HandleEstimateGasRequest
-	(*HandleEstimateGasResponse)(nil),             // 6: ffi.ffi.HandleEstimateGasResponse
-	(*Topic)(nil),                                 // 7: ffi.ffi.Topic
-	(*Log)(nil),                                   // 8: ffi.ffi.Log
-	(*QueryGetAccount)(nil),                       // 9: ffi.ffi.QueryGetAccount
-	(*QueryGetAccountResponse)(nil),               // 10: ffi.ffi.QueryGetAccountResponse
-	(*QueryInsertAccountBalance)(nil),             // 11: ffi.ffi.QueryInsertAccountBalance
-	(*QueryInsertAccountBalanceResponse)(nil),     // 12: ffi.ffi.QueryInsertAccountBalanceResponse
-	(*QueryInsertAccountNonce)(nil),               // 13: ffi.ffi.QueryInsertAccountNonce
-	(*QueryInsertAccountNonceResponse)(nil),       // 14: ffi.ffi.QueryInsertAccountNonceResponse
-	(*QueryContainsKey)(nil),                      // 15: ffi.ffi.QueryContainsKey
-	(*QueryContainsKeyResponse)(nil),              // 16: ffi.ffi.QueryContainsKeyResponse
-	(*QueryGetAccountStorageCell)(nil),            // 17: ffi.ffi.QueryGetAccountStorageCell
-	(*QueryGetAccountStorageCellResponse)(nil),    // 18: ffi.ffi.QueryGetAccountStorageCellResponse
-	(*QueryGetAccountCode)(nil),                   // 19: ffi.ffi.QueryGetAccountCode
-	(*QueryGetAccountCodeResponse)(nil),           // 20: ffi.ffi.QueryGetAccountCodeResponse
-	(*QueryGetAccountCodeSize)(nil),               // 21: ffi.ffi.QueryGetAccountCodeSize
-	(*QueryGetAccountCodeSizeResponse)(nil),       // 22: ffi.ffi.QueryGetAccountCodeSizeResponse
-	(*QueryGetAccountCodeHash)(nil),               // 23: ffi.ffi.QueryGetAccountCodeHash
-	(*QueryGetAccountCodeHashResponse)(nil),       // 24: ffi.ffi.QueryGetAccountCodeHashResponse
-	(*QueryInsertAccountCode)(nil),                // 25: ffi.ffi.QueryInsertAccountCode
-	(*QueryInsertAccountCodeResponse)(nil),        // 26: ffi.ffi.QueryInsertAccountCodeResponse
-	(*QueryInsertStorageCell)(nil),                // 27: ffi.ffi.QueryInsertStorageCell
-	(*QueryInsertStorageCellResponse)(nil),        // 28: ffi.ffi.QueryInsertStorageCellResponse
-	(*QueryRemove)(nil),                           // 29: ffi.ffi.QueryRemove
-	(*QueryRemoveResponse)(nil),                   // 30: ffi.ffi.QueryRemoveResponse
-	(*QueryRemoveStorageCell)(nil),                // 31: ffi.ffi.QueryRemoveStorageCell
-	(*QueryRemoveStorageCellResponse)(nil),        // 32: ffi.ffi.QueryRemoveStorageCellResponse
-	(*QueryRemoveStorage)(nil),                    // 33: ffi.ffi.QueryRemoveStorage
-	(*QueryRemoveStorageResponse)(nil),            // 34: ffi.ffi.QueryRemoveStorageResponse
-	(*QueryBlockHash)(nil),                        // 35: ffi.ffi.QueryBlockHash
-	(*QueryBlockHashResponse)(nil),                // 36: ffi.ffi.QueryBlockHashResponse
-	(*QueryIssuanceTreeRoot)(nil),                 // 37: ffi.ffi.QueryIssuanceTreeRoot
-	(*QueryIssuanceTreeRootResponse)(nil),         // 38: ffi.ffi.QueryIssuanceTreeRootResponse
-	(*QueryRevocationTreeRoot)(nil),               // 39: ffi.ffi.QueryRevocationTreeRoot
-	(*QueryRevocationTreeRootResponse)(nil),       // 40: ffi.ffi.QueryRevocationTreeRootResponse
-	(*QueryAddVerificationDetails)(nil),           // 41: ffi.ffi.QueryAddVerificationDetails
-	(*QueryAddVerificationDetailsResponse)(nil),   // 42: ffi.ffi.QueryAddVerificationDetailsResponse
-	(*QueryAddVerificationDetailsV2)(nil),         // 43: ffi.ffi.QueryAddVerificationDetailsV2
-	(*QueryAddVerificationDetailsV2Response)(nil), // 44: ffi.ffi.QueryAddVerificationDetailsV2Response
-	(*QueryRevokeVerification)(nil),               // 45: ffi.ffi.QueryRevokeVerification
-	(*QueryRevokeVerificationResponse)(nil),       // 46: ffi.ffi.QueryRevokeVerificationResponse
-	(*QueryHasVerification)(nil),                  // 47: ffi.ffi.QueryHasVerification
-	(*QueryHasVerificationResponse)(nil),          // 48: ffi.ffi.QueryHasVerificationResponse
-	(*QueryGetVerificationData)(nil),              // 49: ffi.ffi.QueryGetVerificationData
-	(*VerificationDetails)(nil),                   // 50: ffi.ffi.VerificationDetails
-	(*QueryGetVerificationDataResponse)(nil),      // 51: ffi.ffi.QueryGetVerificationDataResponse
-	(*QueryConvertCredential)(nil),                // 52: ffi.ffi.QueryConvertCredential
-	(*QueryConvertCredentialResponse)(nil),        // 53: ffi.ffi.QueryConvertCredentialResponse
-	(*CosmosRequest)(nil),                         // 54: ffi.ffi.CosmosRequest
-	(*SGXVMCallParams)(nil),                       // 55: ffi.ffi.SGXVMCallParams
-	(*SGXVMCreateParams)(nil),                     // 56: ffi.ffi.SGXVMCreateParams
-	(*SGXVMEstimateGasParams)(nil),                // 57: ffi.ffi.SGXVMEstimateGasParams
-	(*SGXVMCallRequest)(nil),                      // 58: ffi.ffi.SGXVMCallRequest
-	(*SGXVMCreateRequest)(nil),                    // 59: ffi.ffi.SGXVMCreateRequest
-	(*SGXVMEstimateGasRequest)(nil),               // 60: ffi.ffi.SGXVMEstimateGasRequest
-	(*NodePublicKeyRequest)(nil),                  // 61: ffi.ffi.NodePublicKeyRequest
-	(*NodePublicKeyResponse)(nil),                 // 62: ffi.ffi.NodePublicKeyResponse
-	(*EpochData)(nil),                             // 63: ffi.ffi.EpochData
-	(*ListEpochsResponse)(nil),                    // 64: ffi.ffi.ListEpochsResponse
-	(*FFIRequest)(nil),                            // 65: ffi.ffi.FFIRequest
+	(TracerType)(0),                               // 0: ffi.ffi.TracerType
+	(*AccessListItem)(nil),                        // 1: ffi.ffi.AccessListItem
+	(*AuthorizationListItem)(nil),                 // 2: ffi.ffi.AuthorizationListItem
+	(*TransactionData)(nil),                       // 3: ffi.ffi.TransactionData
+	(*TransactionContext)(nil),                    // 4: ffi.ffi.TransactionContext
+	(*HandleTransactionRequest)(nil),              // 5: ffi.ffi.HandleTransactionRequest
+	(*HandleTransactionResponse)(nil),             // 6: ffi.ffi.HandleTransactionResponse
+	(*HandleEstimateGasRequest)(nil),              // 7: ffi.ffi.HandleEstimateGasRequest
+	(*HandleEstimateGasResponse)(nil),             // 8: ffi.ffi.HandleEstimateGasResponse
+	(*Topic)(nil),                                 // 9: ffi.ffi.Topic
+	(*Log)(nil),                                   // 10: ffi.ffi.Log
+	(*QueryGetAccount)(nil),                       // 11: ffi.ffi.QueryGetAccount
+	(*QueryGetAccountResponse)(nil),               // 12: ffi.ffi.QueryGetAccountResponse
+	(*QueryInsertAccountBalance)(nil),             // 13: ffi.ffi.QueryInsertAccountBalance
+	(*QueryInsertAccountBalanceResponse)(nil),     // 14: ffi.ffi.QueryInsertAccountBalanceResponse
+	(*QueryInsertAccountNonce)(nil),               // 15: ffi.ffi.QueryInsertAccountNonce
+	(*QueryInsertAccountNonceResponse)(nil),       // 16: ffi.ffi.QueryInsertAccountNonceResponse
+	(*QueryContainsKey)(nil),                      // 17: ffi.ffi.QueryContainsKey
+	(*QueryContainsKeyResponse)(nil),              // 18: ffi.ffi.QueryContainsKeyResponse
+	(*QueryGetAccountStorageCell)(nil),            // 19: ffi.ffi.QueryGetAccountStorageCell
+	(*QueryGetAccountStorageCellResponse)(nil),    // 20: ffi.ffi.QueryGetAccountStorageCellResponse
+	(*QueryGetAccountCode)(nil),                   // 21: ffi.ffi.QueryGetAccountCode
+	(*QueryGetAccountCodeResponse)(nil),           // 22: ffi.ffi.QueryGetAccountCodeResponse
+	(*QueryGetAccountCodeSize)(nil),               // 23: ffi.ffi.QueryGetAccountCodeSize
+	(*QueryGetAccountCodeSizeResponse)(nil),       // 24: ffi.ffi.QueryGetAccountCodeSizeResponse
+	(*QueryGetAccountCodeHash)(nil),               // 25: ffi.ffi.QueryGetAccountCodeHash
+	(*QueryGetAccountCodeHashResponse)(nil),       // 26: ffi.ffi.QueryGetAccountCodeHashResponse
+	(*QueryInsertAccountCode)(nil),                // 27: ffi.ffi.QueryInsertAccountCode
+	(*QueryInsertAccountCodeResponse)(nil),        // 28: ffi.ffi.QueryInsertAccountCodeResponse
+	(*QueryInsertStorageCell)(nil),                // 29: ffi.ffi.QueryInsertStorageCell
+	(*QueryInsertStorageCellResponse)(nil),        // 30: ffi.ffi.QueryInsertStorageCellResponse
+	(*QueryRemove)(nil),                           // 31: ffi.ffi.QueryRemove
+	(*QueryRemoveResponse)(nil),                   // 32: ffi.ffi.QueryRemoveResponse
+	(*QueryRemoveStorageCell)(nil),                // 33: ffi.ffi.QueryRemoveStorageCell
+	(*QueryRemoveStorageCellResponse)(nil),        // 34: ffi.ffi.QueryRemoveStorageCellResponse
+	(*QueryRemoveStorage)(nil),                    // 35: ffi.ffi.QueryRemoveStorage
+	(*QueryRemoveStorageResponse)(nil),            // 36: ffi.ffi.QueryRemoveStorageResponse
+	(*QueryBlockHash)(nil),                        // 37: ffi.ffi.QueryBlockHash
+	(*QueryBlockHashResponse)(nil),                // 38: ffi.ffi.QueryBlockHashResponse
+	(*QueryIssuanceTreeRoot)(nil),                 // 39: ffi.ffi.QueryIssuanceTreeRoot
+	(*QueryIssuanceTreeRootResponse)(nil),         // 40: ffi.ffi.QueryIssuanceTreeRootResponse
+	(*QueryRevocationTreeRoot)(nil),               // 41: ffi.ffi.QueryRevocationTreeRoot
+	(*QueryRevocationTreeRootResponse)(nil),       // 42: ffi.ffi.QueryRevocationTreeRootResponse
+	(*QueryAddVerificationDetails)(nil),           // 43: ffi.ffi.QueryAddVerificationDetails
+	(*QueryAddVerificationDetailsResponse)(nil),   // 44: ffi.ffi.QueryAddVerificationDetailsResponse
+	(*QueryAddVerificationDetailsV2)(nil),         // 45: ffi.ffi.QueryAddVerificationDetailsV2
+	(*QueryAddVerificationDetailsV2Response)(nil), // 46: ffi.ffi.QueryAddVerificationDetailsV2Response
+	(*QueryRevokeVerification)(nil),               // 47: ffi.ffi.QueryRevokeVerification
+	(*QueryRevokeVerificationResponse)(nil),       // 48: ffi.ffi.QueryRevokeVerificationResponse
+	(*QueryHasVerification)(nil),                  // 49: ffi.ffi.QueryHasVerification
+	(*QueryHasVerificationResponse)(nil),          // 50: ffi.ffi.QueryHasVerificationResponse
+	(*QueryGetVerificationData)(nil),              // 51: ffi.ffi.QueryGetVerificationData
+	(*VerificationDetails)(nil),                   // 52: ffi.ffi.VerificationDetails
+	(*QueryGetVerificationDataResponse)(nil),      // 53: ffi.ffi.QueryGetVerificationDataResponse
+	(*QueryConvertCredential)(nil),                // 54: ffi.ffi.QueryConvertCredential
+	(*QueryConvertCredentialResponse)(nil),        // 55: ffi.ffi.QueryConvertCredentialResponse
+	(*CosmosRequest)(nil),                         // 56: ffi.ffi.CosmosRequest
+	(*SGXVMCallParams)(nil),                       // 57: ffi.ffi.SGXVMCallParams
+	(*SGXVMCreateParams)(nil),                     // 58: ffi.ffi.SGXVMCreateParams
+	(*SGXVMEstimateGasParams)(nil),                // 59: ffi.ffi.SGXVMEstimateGasParams
+	(*TraceOptions)(nil),                          // 60: ffi.ffi.TraceOptions
+	(*TraceStorageEntry)(nil),                     // 61: ffi.ffi.TraceStorageEntry
+	(*TraceStructLog)(nil),                        // 62: ffi.ffi.TraceStructLog
+	(*TraceCallFrame)(nil),                        // 63: ffi.ffi.TraceCallFrame
+	(*ExecutionTrace)(nil),                        // 64: ffi.ffi.ExecutionTrace
+	(*SGXVMCallRequest)(nil),                      // 65: ffi.ffi.SGXVMCallRequest
+	(*SGXVMCreateRequest)(nil),                    // 66: ffi.ffi.SGXVMCreateRequest
+	(*SGXVMEstimateGasRequest)(nil),               // 67: ffi.ffi.SGXVMEstimateGasRequest
+	(*NodePublicKeyRequest)(nil),                  // 68: ffi.ffi.NodePublicKeyRequest
+	(*NodePublicKeyResponse)(nil),                 // 69: ffi.ffi.NodePublicKeyResponse
+	(*StorageAtEncryptedRequest)(nil),             // 70: ffi.ffi.StorageAtEncryptedRequest
+	(*StorageAtEncryptedResponse)(nil),            // 71: ffi.ffi.StorageAtEncryptedResponse
+	(*EpochData)(nil),                             // 72: ffi.ffi.EpochData
+	(*ListEpochsResponse)(nil),                    // 73: ffi.ffi.ListEpochsResponse
+	(*FFIRequest)(nil),                            // 74: ffi.ffi.FFIRequest
 }
 var file_ffi_proto_depIdxs = []int32{
-	0,  // 0: ffi.ffi.TransactionData.accessList:type_name -> ffi.ffi.AccessListItem
-	1,  // 1: ffi.ffi.HandleTransactionRequest.tx_data:type_name -> ffi.ffi.TransactionData
-	2,  // 2: ffi.ffi.HandleTransactionRequest.tx_context:type_name -> ffi.ffi.TransactionContext
-	8,  // 3: ffi.ffi.HandleTransactionResponse.logs:type_name -> ffi.ffi.Log
-	1,  // 4: ffi.ffi.HandleEstimateGasRequest.tx_data:type_name -> ffi.ffi.TransactionData
-	2,  // 5: ffi.ffi.HandleEstimateGasRequest.tx_context:type_name -> ffi.ffi.TransactionContext
-	7,  // 6: ffi.ffi.Log.topics:type_name -> ffi.ffi.Topic
-	50, // 7: ffi.ffi.QueryGetVerificationDataResponse.data:type_name -> ffi.ffi.VerificationDetails
-	9,  // 8: ffi.ffi.CosmosRequest.getAccount:type_name -> ffi.ffi.QueryGetAccount
-	15, // 9: ffi.ffi.CosmosRequest.containsKey:type_name -> ffi.ffi.QueryContainsKey
-	19, // 10: ffi.ffi.CosmosRequest.accountCode:type_name -> ffi.ffi.QueryGetAccountCode
-	23, // 11: ffi.ffi.CosmosRequest.codeHash:type_name -> ffi.ffi.QueryGetAccountCodeHash
-	21, // 12: ffi.ffi.CosmosRequest.codeSize:type_name -> ffi.ffi.QueryGetAccountCodeSize
-	17, // 13: ffi.ffi.CosmosRequest.storageCell:type_name -> ffi.ffi.QueryGetAccountStorageCell
-	25, // 14: ffi.ffi.CosmosRequest.insertAccountCode:type_name -> ffi.ffi.QueryInsertAccountCode
-	27, // 15: ffi.ffi.CosmosRequest.insertStorageCell:type_name -> ffi.ffi.QueryInsertStorageCell
-	29, // 16: ffi.ffi.CosmosRequest.remove:type_name -> ffi.ffi.QueryRemove
-	31, // 17: ffi.ffi.CosmosRequest.removeStorageCell:type_name -> ffi.ffi.QueryRemoveStorageCell
-	33, // 18: ffi.ffi.CosmosRequest.removeStorage:type_name -> ffi.ffi.QueryRemoveStorage
-	35, // 19: ffi.ffi.CosmosRequest.blockHash:type_name -> ffi.ffi.QueryBlockHash
-	41, // 20: ffi.ffi.CosmosRequest.addVerificationDetails:type_name -> ffi.ffi.QueryAddVerificationDetails
-	47, // 21: ffi.ffi.CosmosRequest.hasVerification:type_name -> ffi.ffi.QueryHasVerification
-	49, // 22: ffi.ffi.CosmosRequest.getVerificationData:type_name -> ffi.ffi.QueryGetVerificationData
-	11, // 23: ffi.ffi.CosmosRequest.insertAccountBalance:type_name -> ffi.ffi.QueryInsertAccountBalance
-	13, // 24: ffi.ffi.CosmosRequest.insertAccountNonce:type_name -> ffi.ffi.QueryInsertAccountNonce
-	37, // 25: ffi.ffi.CosmosRequest.issuanceTreeRoot:type_name -> ffi.ffi.QueryIssuanceTreeRoot
-	39, // 26: ffi.ffi.CosmosRequest.revocationTreeRoot:type_name -> ffi.ffi.QueryRevocationTreeRoot
-	43, // 27: ffi.ffi.CosmosRequest.addVerificationDetailsV2:type_name -> ffi.ffi.QueryAddVerificationDetailsV2
-	45, // 28: ffi.ffi.CosmosRequest.revokeVerification:type_name -> ffi.ffi.QueryRevokeVerification
-	52, // 29: ffi.ffi.CosmosRequest.convertCredential:type_name -> ffi.ffi.QueryConvertCredential
-	0,  // 30: ffi.ffi.SGXVMCallParams.accessList:type_name -> ffi.ffi.AccessListItem
-	0,  // 31: ffi.ffi.SGXVMCreateParams.accessList:type_name -> ffi.ffi.AccessListItem
-	0,  // 32: ffi.ffi.SGXVMEstimateGasParams.accessList:type_name -> ffi.ffi.AccessListItem
-	55, // 33: ffi.ffi.SGXVMCallRequest.params:type_name -> ffi.ffi.SGXVMCallParams
-	2,  // 34: ffi.ffi.SGXVMCallRequest.context:type_name -> ffi.ffi.TransactionContext
-	56, // 35: ffi.ffi.SGXVMCreateRequest.params:type_name -> ffi.ffi.SGXVMCreateParams
-	2,  // 36: ffi.ffi.SGXVMCreateRequest.context:type_name -> ffi.ffi.TransactionContext
-	57, // 37: ffi.ffi.SGXVMEstimateGasRequest.params:type_name -> ffi.ffi.SGXVMEstimateGasParams
-	2,  // 38: ffi.ffi.SGXVMEstimateGasRequest.context:type_name -> ffi.ffi.TransactionContext
-	63, // 39: ffi.ffi.ListEpochsResponse.epochs:type_name -> ffi.ffi.EpochData
-	58, // 40: ffi.ffi.FFIRequest.callRequest:type_name -> ffi.ffi.SGXVMCallRequest
-	59, // 41: ffi.ffi.FFIRequest.createRequest:type_name -> ffi.ffi.SGXVMCreateRequest
-	60, // 42: ffi.ffi.FFIRequest.estimateGasRequest:type_name -> ffi.ffi.SGXVMEstimateGasRequest
-	61, // 43: ffi.ffi.FFIRequest.publicKeyRequest:type_name -> ffi.ffi.NodePublicKeyRequest
-	44, // [44:44] is the sub-list for method output_type
-	44, // [44:44] is the sub-list for method input_type
-	44, // [44:44] is the sub-list for extension type_name
-	44, // [44:44] is the sub-list for extension extendee
-	0,  // [0:44] is the sub-list for field type_name
+	1,  // 0: ffi.ffi.TransactionData.accessList:type_name -> ffi.ffi.AccessListItem
+	3,  // 1: ffi.ffi.HandleTransactionRequest.tx_data:type_name -> ffi.ffi.TransactionData
+	4,  // 2: ffi.ffi.HandleTransactionRequest.tx_context:type_name -> ffi.ffi.TransactionContext
+	10, // 3: ffi.ffi.HandleTransactionResponse.logs:type_name -> ffi.ffi.Log
+	64, // 4: ffi.ffi.HandleTransactionResponse.trace:type_name -> ffi.ffi.ExecutionTrace
+	3,  // 5: ffi.ffi.HandleEstimateGasRequest.tx_data:type_name -> ffi.ffi.TransactionData
+	4,  // 6: ffi.ffi.HandleEstimateGasRequest.tx_context:type_name -> ffi.ffi.TransactionContext
+	9,  // 7: ffi.ffi.Log.topics:type_name -> ffi.ffi.Topic
+	52, // 8: ffi.ffi.QueryGetVerificationDataResponse.data:type_name -> ffi.ffi.VerificationDetails
+	11, // 9: ffi.ffi.CosmosRequest.getAccount:type_name -> ffi.ffi.QueryGetAccount
+	17, // 10: ffi.ffi.CosmosRequest.containsKey:type_name -> ffi.ffi.QueryContainsKey
+	21, // 11: ffi.ffi.CosmosRequest.accountCode:type_name -> ffi.ffi.QueryGetAccountCode
+	25, // 12: ffi.ffi.CosmosRequest.codeHash:type_name -> ffi.ffi.QueryGetAccountCodeHash
+	23, // 13: ffi.ffi.CosmosRequest.codeSize:type_name -> ffi.ffi.QueryGetAccountCodeSize
+	19, // 14: ffi.ffi.CosmosRequest.storageCell:type_name -> ffi.ffi.QueryGetAccountStorageCell
+	27, // 15: ffi.ffi.CosmosRequest.insertAccountCode:type_name -> ffi.ffi.QueryInsertAccountCode
+	29, // 16: ffi.ffi.CosmosRequest.insertStorageCell:type_name -> ffi.ffi.QueryInsertStorageCell
+	31, // 17: ffi.ffi.CosmosRequest.remove:type_name -> ffi.ffi.QueryRemove
+	33, // 18: ffi.ffi.CosmosRequest.removeStorageCell:type_name -> ffi.ffi.QueryRemoveStorageCell
+	35, // 19: ffi.ffi.CosmosRequest.removeStorage:type_name -> ffi.ffi.QueryRemoveStorage
+	37, // 20: ffi.ffi.CosmosRequest.blockHash:type_name -> ffi.ffi.QueryBlockHash
+	43, // 21: ffi.ffi.CosmosRequest.addVerificationDetails:type_name -> ffi.ffi.QueryAddVerificationDetails
+	49, // 22: ffi.ffi.CosmosRequest.hasVerification:type_name -> ffi.ffi.QueryHasVerification
+	51, // 23: ffi.ffi.CosmosRequest.getVerificationData:type_name -> ffi.ffi.QueryGetVerificationData
+	13, // 24: ffi.ffi.CosmosRequest.insertAccountBalance:type_name -> ffi.ffi.QueryInsertAccountBalance
+	15, // 25: ffi.ffi.CosmosRequest.insertAccountNonce:type_name -> ffi.ffi.QueryInsertAccountNonce
+	39, // 26: ffi.ffi.CosmosRequest.issuanceTreeRoot:type_name -> ffi.ffi.QueryIssuanceTreeRoot
+	41, // 27: ffi.ffi.CosmosRequest.revocationTreeRoot:type_name -> ffi.ffi.QueryRevocationTreeRoot
+	45, // 28: ffi.ffi.CosmosRequest.addVerificationDetailsV2:type_name -> ffi.ffi.QueryAddVerificationDetailsV2
+	47, // 29: ffi.ffi.CosmosRequest.revokeVerification:type_name -> ffi.ffi.QueryRevokeVerification
+	54, // 30: ffi.ffi.CosmosRequest.convertCredential:type_name -> ffi.ffi.QueryConvertCredential
+	1,  // 31: ffi.ffi.SGXVMCallParams.accessList:type_name -> ffi.ffi.AccessListItem
+	60, // 32: ffi.ffi.SGXVMCallParams.trace:type_name -> ffi.ffi.TraceOptions
+	2,  // 33: ffi.ffi.SGXVMCallParams.authorizationList:type_name -> ffi.ffi.AuthorizationListItem
+	1,  // 34: ffi.ffi.SGXVMCreateParams.accessList:type_name -> ffi.ffi.AccessListItem
+	60, // 35: ffi.ffi.SGXVMCreateParams.trace:type_name -> ffi.ffi.TraceOptions
+	1,  // 36: ffi.ffi.SGXVMEstimateGasParams.accessList:type_name -> ffi.ffi.AccessListItem
+	0,  // 37: ffi.ffi.TraceOptions.tracer:type_name -> ffi.ffi.TracerType
+	61, // 38: ffi.ffi.TraceStructLog.storage:type_name -> ffi.ffi.TraceStorageEntry
+	63, // 39: ffi.ffi.TraceCallFrame.calls:type_name -> ffi.ffi.TraceCallFrame
+	62, // 40: ffi.ffi.ExecutionTrace.structLogs:type_name -> ffi.ffi.TraceStructLog
+	63, // 41: ffi.ffi.ExecutionTrace.callFrame:type_name -> ffi.ffi.TraceCallFrame
+	1,  // 42: ffi.ffi.ExecutionTrace.accessList:type_name -> ffi.ffi.AccessListItem
+	57, // 43: ffi.ffi.SGXVMCallRequest.params:type_name -> ffi.ffi.SGXVMCallParams
+	4,  // 44: ffi.ffi.SGXVMCallRequest.context:type_name -> ffi.ffi.TransactionContext
+	58, // 45: ffi.ffi.SGXVMCreateRequest.params:type_name -> ffi.ffi.SGXVMCreateParams
+	4,  // 46: ffi.ffi.SGXVMCreateRequest.context:type_name -> ffi.ffi.TransactionContext
+	59, // 47: ffi.ffi.SGXVMEstimateGasRequest.params:type_name -> ffi.ffi.SGXVMEstimateGasParams
+	4,  // 48: ffi.ffi.SGXVMEstimateGasRequest.context:type_name -> ffi.ffi.TransactionContext
+	72, // 49: ffi.ffi.ListEpochsResponse.epochs:type_name -> ffi.ffi.EpochData
+	65, // 50: ffi.ffi.FFIRequest.callRequest:type_name -> ffi.ffi.SGXVMCallRequest
+	66, // 51: ffi.ffi.FFIRequest.createRequest:type_name -> ffi.ffi.SGXVMCreateRequest
+	67, // 52: ffi.ffi.FFIRequest.estimateGasRequest:type_name -> ffi.ffi.SGXVMEstimateGasRequest
+	68, // 53: ffi.ffi.FFIRequest.publicKeyRequest:type_name -> ffi.ffi.NodePublicKeyRequest
+	70, // 54: ffi.ffi.FFIRequest.storageAtEncryptedRequest:type_name -> ffi.ffi.StorageAtEncryptedRequest
+	55, // [55:55] is the sub-list for method output_type
+	55, // [55:55] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_ffi_proto_init() }
@@ -5001,7 +6003,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionData); i {
+			switch v := v.(*AuthorizationListItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5013,7 +6015,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionContext); i {
+			switch v := v.(*TransactionData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5025,7 +6027,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandleTransactionRequest); i {
+			switch v := v.(*TransactionContext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5037,7 +6039,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandleTransactionResponse); i {
+			switch v := v.(*HandleTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5049,7 +6051,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandleEstimateGasRequest); i {
+			switch v := v.(*HandleTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5061,7 +6063,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandleEstimateGasResponse); i {
+			switch v := v.(*HandleEstimateGasRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5073,7 +6075,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Topic); i {
+			switch v := v.(*HandleEstimateGasResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5085,7 +6087,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Log); i {
+			switch v := v.(*Topic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5097,7 +6099,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccount); i {
+			switch v := v.(*Log); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5109,7 +6111,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountResponse); i {
+			switch v := v.(*QueryGetAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5121,7 +6123,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertAccountBalance); i {
+			switch v := v.(*QueryGetAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5133,7 +6135,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertAccountBalanceResponse); i {
+			switch v := v.(*QueryInsertAccountBalance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5145,7 +6147,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertAccountNonce); i {
+			switch v := v.(*QueryInsertAccountBalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5157,7 +6159,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertAccountNonceResponse); i {
+			switch v := v.(*QueryInsertAccountNonce); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5169,7 +6171,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryContainsKey); i {
+			switch v := v.(*QueryInsertAccountNonceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5181,7 +6183,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryContainsKeyResponse); i {
+			switch v := v.(*QueryContainsKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5193,7 +6195,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountStorageCell); i {
+			switch v := v.(*QueryContainsKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5205,7 +6207,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountStorageCellResponse); i {
+			switch v := v.(*QueryGetAccountStorageCell); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5217,7 +6219,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountCode); i {
+			switch v := v.(*QueryGetAccountStorageCellResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5229,7 +6231,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountCodeResponse); i {
+			switch v := v.(*QueryGetAccountCode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5241,7 +6243,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountCodeSize); i {
+			switch v := v.(*QueryGetAccountCodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5253,7 +6255,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountCodeSizeResponse); i {
+			switch v := v.(*QueryGetAccountCodeSize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5265,7 +6267,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountCodeHash); i {
+			switch v := v.(*QueryGetAccountCodeSizeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5277,7 +6279,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountCodeHashResponse); i {
+			switch v := v.(*QueryGetAccountCodeHash); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5289,7 +6291,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertAccountCode); i {
+			switch v := v.(*QueryGetAccountCodeHashResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5301,7 +6303,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertAccountCodeResponse); i {
+			switch v := v.(*QueryInsertAccountCode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5313,7 +6315,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertStorageCell); i {
+			switch v := v.(*QueryInsertAccountCodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5325,7 +6327,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertStorageCellResponse); i {
+			switch v := v.(*QueryInsertStorageCell); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5337,7 +6339,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemove); i {
+			switch v := v.(*QueryInsertStorageCellResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5349,7 +6351,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemoveResponse); i {
+			switch v := v.(*QueryRemove); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5361,7 +6363,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemoveStorageCell); i {
+			switch v := v.(*QueryRemoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5373,7 +6375,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemoveStorageCellResponse); i {
+			switch v := v.(*QueryRemoveStorageCell); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5385,7 +6387,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemoveStorage); i {
+			switch v := v.(*QueryRemoveStorageCellResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5397,7 +6399,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemoveStorageResponse); i {
+			switch v := v.(*QueryRemoveStorage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5409,7 +6411,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBlockHash); i {
+			switch v := v.(*QueryRemoveStorageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5421,7 +6423,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBlockHashResponse); i {
+			switch v := v.(*QueryBlockHash); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5433,7 +6435,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryIssuanceTreeRoot); i {
+			switch v := v.(*QueryBlockHashResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5445,7 +6447,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryIssuanceTreeRootResponse); i {
+			switch v := v.(*QueryIssuanceTreeRoot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5457,7 +6459,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRevocationTreeRoot); i {
+			switch v := v.(*QueryIssuanceTreeRootResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5469,7 +6471,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRevocationTreeRootResponse); i {
+			switch v := v.(*QueryRevocationTreeRoot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5481,7 +6483,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddVerificationDetails); i {
+			switch v := v.(*QueryRevocationTreeRootResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5493,7 +6495,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddVerificationDetailsResponse); i {
+			switch v := v.(*QueryAddVerificationDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5505,7 +6507,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddVerificationDetailsV2); i {
+			switch v := v.(*QueryAddVerificationDetailsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5517,7 +6519,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddVerificationDetailsV2Response); i {
+			switch v := v.(*QueryAddVerificationDetailsV2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5529,7 +6531,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRevokeVerification); i {
+			switch v := v.(*QueryAddVerificationDetailsV2Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5541,7 +6543,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRevokeVerificationResponse); i {
+			switch v := v.(*QueryRevokeVerification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5553,7 +6555,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryHasVerification); i {
+			switch v := v.(*QueryRevokeVerificationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5565,7 +6567,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryHasVerificationResponse); i {
+			switch v := v.(*QueryHasVerification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5577,7 +6579,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetVerificationData); i {
+			switch v := v.(*QueryHasVerificationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5589,7 +6591,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerificationDetails); i {
+			switch v := v.(*QueryGetVerificationData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5601,7 +6603,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetVerificationDataResponse); i {
+			switch v := v.(*VerificationDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5613,7 +6615,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryConvertCredential); i {
+			switch v := v.(*QueryGetVerificationDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5625,7 +6627,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryConvertCredentialResponse); i {
+			switch v := v.(*QueryConvertCredential); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5637,7 +6639,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CosmosRequest); i {
+			switch v := v.(*QueryConvertCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5649,7 +6651,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SGXVMCallParams); i {
+			switch v := v.(*CosmosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5661,7 +6663,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SGXVMCreateParams); i {
+			switch v := v.(*SGXVMCallParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5673,7 +6675,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SGXVMEstimateGasParams); i {
+			switch v := v.(*SGXVMCreateParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5685,7 +6687,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SGXVMCallRequest); i {
+			switch v := v.(*SGXVMEstimateGasParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5697,7 +6699,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SGXVMCreateRequest); i {
+			switch v := v.(*TraceOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5709,7 +6711,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SGXVMEstimateGasRequest); i {
+			switch v := v.(*TraceStorageEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5721,7 +6723,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodePublicKeyRequest); i {
+			switch v := v.(*TraceStructLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5733,7 +6735,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodePublicKeyResponse); i {
+			switch v := v.(*TraceCallFrame); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5745,7 +6747,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochData); i {
+			switch v := v.(*ExecutionTrace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5757,7 +6759,7 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListEpochsResponse); i {
+			switch v := v.(*SGXVMCallRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5769,6 +6771,102 @@ func file_ffi_proto_init() {
 			}
 		}
 		file_ffi_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SGXVMCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SGXVMEstimateGasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodePublicKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodePublicKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageAtEncryptedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageAtEncryptedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEpochsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FFIRequest); i {
 			case 0:
 				return &v.state
@@ -5781,7 +6879,7 @@ func file_ffi_proto_init() {
 			}
 		}
 	}
-	file_ffi_proto_msgTypes[54].OneofWrappers = []interface{}{
+	file_ffi_proto_msgTypes[55].OneofWrappers = []interface{}{
 		(*CosmosRequest_GetAccount)(nil),
 		(*CosmosRequest_ContainsKey)(nil),
 		(*CosmosRequest_AccountCode)(nil),
@@ -5805,24 +6903,26 @@ func file_ffi_proto_init() {
 		(*CosmosRequest_RevokeVerification)(nil),
 		(*CosmosRequest_ConvertCredential)(nil),
 	}
-	file_ffi_proto_msgTypes[65].OneofWrappers = []interface{}{
+	file_ffi_proto_msgTypes[73].OneofWrappers = []interface{}{
 		(*FFIRequest_CallRequest)(nil),
 		(*FFIRequest_CreateRequest)(nil),
 		(*FFIRequest_EstimateGasRequest)(nil),
 		(*FFIRequest_PublicKeyRequest)(nil),
+		(*FFIRequest_StorageAtEncryptedRequest)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ffi_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   66,
+			NumEnums:      1,
+			NumMessages:   74,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_ffi_proto_goTypes,
 		DependencyIndexes: file_ffi_proto_depIdxs,
+		EnumInfos:         file_ffi_proto_enumTypes,
 		MessageInfos:      file_ffi_proto_msgTypes,
 	}.Build()
 	File_ffi_proto = out.File
@@ -17,6 +17,7 @@ package indexer
 
 import (
 	"fmt"
+	"sync"
 
 	rpctypes "swisstronik/rpc/types"
 
@@ -36,11 +37,15 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash       = 1
+	KeyPrefixTxIndex      = 2
+	KeyPrefixPrunedHeight = 7
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+
+	// pruneBatchSize is the max number of entries removed in a single db batch during pruning
+	pruneBatchSize = 10000
 )
 
 var _ ethermint.EVMTxIndexer = &KVIndexer{}
@@ -50,11 +55,14 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+
+	// mtx guards block range metadata, which is updated both by indexing and pruning
+	mtx sync.Mutex
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -62,9 +70,13 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Stores logs of every successful message with address and topic index entries
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
 	height := block.Header.Height
 
+	kv.mtx.Lock()
+	defer kv.mtx.Unlock()
+
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
@@ -128,8 +140,23 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+
+			if txResult.Failed {
+				continue
+			}
+			logs, err := txLogsFromEvents(result.Events, msgIndex)
+			if err != nil {
+				kv.logger.Error("Fail to parse tx logs", "err", err, "block", height, "txIndex", txIndex, "msgIndex", msgIndex)
+				continue
+			}
+			if err := saveTxLogs(kv.clientCtx.Codec, batch, height, txResult.EthTxIndex, logs); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
 		}
 	}
+	if err := saveLogIndexedRange(kv.db, batch, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
@@ -164,6 +191,9 @@ func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*ethermint.TxResult, error)
 
 // GetByBlockAndIndex finds eth tx by block number and eth tx index
 func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethermint.TxResult, error) {
+	if err := kv.checkPruned(blockNumber); err != nil {
+		return nil, err
+	}
 	bz, err := kv.db.Get(TxIndexKey(blockNumber, txIndex))
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
@@ -174,6 +204,116 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethe
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// Prune removes indexed txs and logs of blocks below retain height
+func (kv *KVIndexer) Prune(retainHeight int64) error {
+	prunedHeight, err := kv.PrunedHeight()
+	if err != nil {
+		return err
+	}
+	if retainHeight <= prunedHeight {
+		return nil
+	}
+
+	err = kv.pruneRange(TxIndexKey(0, 0), TxIndexKey(retainHeight, 0), func(key, value []byte) ([][]byte, error) {
+		return [][]byte{key, TxHashKey(common.BytesToHash(value))}, nil
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "Prune %d, txs", retainHeight)
+	}
+
+	err = kv.pruneRange(LogKey(0, 0, 0), LogKey(retainHeight, 0, 0), func(key, value []byte) ([][]byte, error) {
+		var log evmtypes.Log
+		if err := kv.clientCtx.Codec.Unmarshal(value, &log); err != nil {
+			return nil, err
+		}
+		return logKeys(key, &log), nil
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "Prune %d, logs", retainHeight)
+	}
+
+	kv.mtx.Lock()
+	defer kv.mtx.Unlock()
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set([]byte{KeyPrefixPrunedHeight}, sdk.Uint64ToBigEndian(uint64(retainHeight))); err != nil {
+		return errorsmod.Wrap(err, "set pruned height key")
+	}
+	if err := pruneLogIndexedRange(kv.db, batch, retainHeight); err != nil {
+		return errorsmod.Wrapf(err, "Prune %d", retainHeight)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "Prune %d, write batch", retainHeight)
+	}
+	return nil
+}
+
+// PrunedHeight returns the height, below which blocks were pruned, returns 0 if indexer was never pruned
+func (kv *KVIndexer) PrunedHeight() (int64, error) {
+	bz, err := kv.db.Get([]byte{KeyPrefixPrunedHeight})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "PrunedHeight")
+	}
+	if len(bz) == 0 {
+		return 0, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+// checkPruned returns ErrIndexerPruned if the block was pruned or is below the first indexed block
+func (kv *KVIndexer) checkPruned(blockNumber int64) error {
+	firstAvailable, err := ethermint.FirstAvailableBlock(kv)
+	if err != nil {
+		return err
+	}
+	if blockNumber < firstAvailable {
+		return errorsmod.Wrapf(ethermint.ErrIndexerPruned, "block %d, first available block %d", blockNumber, firstAvailable)
+	}
+	return nil
+}
+
+// pruneRange removes entries within [start, end) range and related entries returned by keysToDelete.
+// Entries are removed by several batches to limit memory usage.
+func (kv *KVIndexer) pruneRange(start, end []byte, keysToDelete func(key, value []byte) ([][]byte, error)) error {
+	for {
+		it, err := kv.db.Iterator(start, end)
+		if err != nil {
+			return err
+		}
+
+		batch := kv.db.NewBatch()
+		count := 0
+		for ; it.Valid() && count < pruneBatchSize; it.Next() {
+			keys, err := keysToDelete(it.Key(), it.Value())
+			if err != nil {
+				it.Close()
+				batch.Close()
+				return err
+			}
+			for _, key := range keys {
+				if err := batch.Delete(key); err != nil {
+					it.Close()
+					batch.Close()
+					return err
+				}
+			}
+			count++
+		}
+		it.Close()
+
+		if count == 0 {
+			return batch.Close()
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
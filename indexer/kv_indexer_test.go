@@ -9,6 +9,7 @@ import (
 	evmenc "swisstronik/encoding"
 	"swisstronik/indexer"
 	"swisstronik/tests"
+	ethermint "swisstronik/types"
 	"swisstronik/utils"
 	"swisstronik/x/evm/types"
 
@@ -185,7 +186,91 @@ func TestKVIndexer(t *testing.T) {
 	}
 }
 
+func TestKVIndexerPrune(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	indexBlocksWithLogs(t, idxer, clientCtx, 1, 5)
+
+	prunedHeight, err := idxer.PrunedHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), prunedHeight)
+
+	require.NoError(t, idxer.Prune(3))
+	// pruning below already pruned height is no-op
+	require.NoError(t, idxer.Prune(2))
+
+	prunedHeight, err = idxer.PrunedHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), prunedHeight)
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), first)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), last)
+
+	_, err = idxer.GetByBlockAndIndex(2, 0)
+	require.ErrorIs(t, err, ethermint.ErrIndexerPruned)
+	res, err := idxer.GetByBlockAndIndex(3, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.Height)
+
+	firstLog, lastLog, err := idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), firstLog)
+	require.Equal(t, int64(5), lastLog)
+
+	_, err = idxer.GetLogs(ethermint.LogFilter{FromBlock: 1, ToBlock: 5})
+	require.ErrorIs(t, err, ethermint.ErrIndexerPruned)
+	logs, err := idxer.GetLogs(ethermint.LogFilter{FromBlock: 3, ToBlock: 5, Addresses: []common.Address{logContractA}})
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+
+	// address and topic index entries of pruned logs are removed as well
+	it, err := db.Iterator([]byte{indexer.KeyPrefixLogAddress}, []byte{indexer.KeyPrefixLogTopic + 1})
+	require.NoError(t, err)
+	defer it.Close()
+	var indexEntries int
+	for ; it.Valid(); it.Next() {
+		indexEntries++
+	}
+	// every block has 2 address entries and 3 topic entries
+	require.Equal(t, 3*5, indexEntries)
+}
+
 // MakeEncodingConfig creates the EncodingConfig
 func MakeEncodingConfig() params.EncodingConfig {
 	return evmenc.MakeConfig(app.ModuleBasics)
 }
+
+func TestKVIndexerNotIndexedBlocks(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// indexer was enabled after the node had synced first blocks and was never pruned
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+	indexBlocksWithLogs(t, idxer, clientCtx, 3, 5)
+
+	prunedHeight, err := idxer.PrunedHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), prunedHeight)
+	firstAvailable, err := ethermint.FirstAvailableBlock(idxer)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), firstAvailable)
+
+	_, err = idxer.GetByBlockAndIndex(2, 0)
+	require.ErrorIs(t, err, ethermint.ErrIndexerPruned)
+	res, err := idxer.GetByBlockAndIndex(3, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.Height)
+
+	_, err = idxer.GetLogs(ethermint.LogFilter{FromBlock: 1, ToBlock: 5})
+	require.ErrorIs(t, err, ethermint.ErrIndexerPruned)
+	logs, err := idxer.GetLogs(ethermint.LogFilter{FromBlock: 3, ToBlock: 5, Addresses: []common.Address{logContractA}})
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+}
@@ -0,0 +1,385 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	ethermint "swisstronik/types"
+	evmtypes "swisstronik/x/evm/types"
+)
+
+const (
+	KeyPrefixLog        = 3
+	KeyPrefixLogAddress = 4
+	KeyPrefixLogTopic   = 5
+	KeyPrefixLogRange   = 6
+
+	// LogPositionLength is the length of log position: block number, eth tx index and log index
+	LogPositionLength = 8 + 8 + 8
+)
+
+var _ ethermint.EVMLogIndexer = &KVIndexer{}
+
+// LogIndexedRange returns the first and the last block of the latest range of blocks with indexed logs,
+// returns -1 if there are no such blocks
+func (kv *KVIndexer) LogIndexedRange() (int64, int64, error) {
+	return loadLogIndexedRange(kv.db)
+}
+
+// GetLogs returns logs matching the filter. If filter contains addresses or topics,
+// only corresponding entries of address or topic index are iterated.
+func (kv *KVIndexer) GetLogs(filter ethermint.LogFilter) ([]*ethtypes.Log, error) {
+	if err := kv.checkPruned(filter.FromBlock); err != nil {
+		return nil, err
+	}
+	if filter.FromBlock > filter.ToBlock {
+		return []*ethtypes.Log{}, nil
+	}
+
+	indexPrefixes := logIndexPrefixes(filter)
+	if len(indexPrefixes) == 0 {
+		// there is nothing to narrow down the query, so iterate all logs within block range
+		return kv.scanLogs(filter)
+	}
+
+	// collect positions from index, positions of different addresses or topics are merged and sorted
+	// to keep logs ordered by block number, tx index and log index
+	positions := make(map[string]struct{})
+	for _, prefix := range indexPrefixes {
+		it, err := kv.db.Iterator(logIndexKey(prefix, filter.FromBlock), logIndexKey(prefix, filter.ToBlock+1))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		for ; it.Valid(); it.Next() {
+			key := it.Key()
+			positions[string(key[len(key)-LogPositionLength:])] = struct{}{}
+		}
+		err = it.Error()
+		it.Close()
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+	}
+
+	sorted := make([][]byte, 0, len(positions))
+	for position := range positions {
+		sorted = append(sorted, []byte(position))
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+
+	logs := []*ethtypes.Log{}
+	for _, position := range sorted {
+		bz, err := kv.db.Get(append([]byte{KeyPrefixLog}, position...))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		if len(bz) == 0 {
+			return nil, fmt.Errorf("indexed log not found, position: %x", position)
+		}
+		if logs, err = kv.appendMatchingLog(logs, bz, filter); err != nil {
+			return nil, err
+		}
+	}
+	return logs, nil
+}
+
+// scanLogs iterates all indexed logs within block range of the filter
+func (kv *KVIndexer) scanLogs(filter ethermint.LogFilter) ([]*ethtypes.Log, error) {
+	it, err := kv.db.Iterator(LogKey(filter.FromBlock, 0, 0), LogKey(filter.ToBlock+1, 0, 0))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "GetLogs")
+	}
+	defer it.Close()
+
+	logs := []*ethtypes.Log{}
+	for ; it.Valid(); it.Next() {
+		if logs, err = kv.appendMatchingLog(logs, it.Value(), filter); err != nil {
+			return nil, err
+		}
+	}
+	return logs, it.Error()
+}
+
+// appendMatchingLog decodes stored log and appends it to the list if it matches the filter
+func (kv *KVIndexer) appendMatchingLog(logs []*ethtypes.Log, bz []byte, filter ethermint.LogFilter) ([]*ethtypes.Log, error) {
+	var log evmtypes.Log
+	if err := kv.clientCtx.Codec.Unmarshal(bz, &log); err != nil {
+		return nil, errorsmod.Wrap(err, "GetLogs")
+	}
+
+	ethLog := log.ToEthereum()
+	if !matchLog(ethLog, filter.Addresses, filter.Topics) {
+		return logs, nil
+	}
+	if filter.Limit > 0 && len(logs) >= filter.Limit {
+		return nil, fmt.Errorf("query returned more than %d results", filter.Limit)
+	}
+	return append(logs, ethLog), nil
+}
+
+// logIndexPrefixes returns prefixes of index entries, which should be iterated to find logs matching the filter.
+// Address index is preferred, otherwise the first topic position with specified topics is used.
+func logIndexPrefixes(filter ethermint.LogFilter) [][]byte {
+	prefixes := [][]byte{}
+	if len(filter.Addresses) > 0 {
+		for _, address := range filter.Addresses {
+			prefixes = append(prefixes, logAddressPrefix(address))
+		}
+		return prefixes
+	}
+
+	for position, topics := range filter.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		for _, topic := range topics {
+			prefixes = append(prefixes, logTopicPrefix(position, topic))
+		}
+		return prefixes
+	}
+	return prefixes
+}
+
+// matchLog checks if log was emitted by one of the addresses and contains requested topics
+func matchLog(log *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 && !containsAddress(addresses, log.Address) {
+		return false
+	}
+	// if the to filtered topics is greater than the amount of topics in logs, skip.
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue // empty rule set == wildcard
+		}
+		if !containsTopic(sub, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+func containsTopic(topics []common.Hash, topic common.Hash) bool {
+	for _, t := range topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// txLogsFromEvents parses logs of eth tx with provided message index from tx events
+func txLogsFromEvents(events []abci.Event, msgIndex int) ([]*evmtypes.Log, error) {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+
+		if msgIndex > 0 {
+			// not the eth tx we want
+			msgIndex--
+			continue
+		}
+
+		logs := make([]*evmtypes.Log, 0, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyTxLog {
+				continue
+			}
+
+			var log evmtypes.Log
+			if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+				return nil, err
+			}
+			logs = append(logs, &log)
+		}
+		return logs, nil
+	}
+	return nil, fmt.Errorf("eth tx logs not found for message index %d", msgIndex)
+}
+
+// saveTxLogs index logs of eth tx and its address and topic entries into the kv db batch
+func saveTxLogs(codec codec.Codec, batch dbm.Batch, height int64, ethTxIndex int32, logs []*evmtypes.Log) error {
+	for logIndex, log := range logs {
+		key := LogKey(height, ethTxIndex, uint64(logIndex))
+		if err := batch.Set(key, codec.MustMarshal(log)); err != nil {
+			return errorsmod.Wrap(err, "set log key")
+		}
+
+		// the first key is the log key itself, others are address and topic index entries
+		for _, indexKey := range logKeys(key, log)[1:] {
+			if err := batch.Set(indexKey, []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log index key")
+			}
+		}
+	}
+	return nil
+}
+
+// logKeys returns the log key and keys of its address and topic index entries
+func logKeys(key []byte, log *evmtypes.Log) [][]byte {
+	position := key[1:]
+	keys := [][]byte{key, append(logAddressPrefix(common.HexToAddress(log.Address)), position...)}
+	for i, topic := range log.Topics {
+		keys = append(keys, append(logTopicPrefix(i, common.HexToHash(topic)), position...))
+	}
+	return keys
+}
+
+// saveLogIndexedRange adds the given block to ranges of blocks with indexed logs.
+// Ranges should not contain gaps, so the block is merged with adjacent ranges and a new range is started
+// if there are no such ranges. It allows to reindex old blocks without losing already indexed ones.
+func saveLogIndexedRange(db dbm.DB, batch dbm.Batch, height int64) error {
+	ranges, err := loadLogIndexedRanges(db)
+	if err != nil {
+		return err
+	}
+
+	// ranges are sorted by the first block, so merged range can absorb several adjacent ranges in a single pass
+	first, last := height, height
+	for _, r := range ranges {
+		if r[0] > last+1 || r[1] < first-1 {
+			continue
+		}
+		if r[0] < first {
+			first = r[0]
+		}
+		if r[1] > last {
+			last = r[1]
+		}
+		if err := batch.Delete(logRangeKey(r[0])); err != nil {
+			return errorsmod.Wrap(err, "delete log range key")
+		}
+	}
+
+	if err := batch.Set(logRangeKey(first), sdk.Uint64ToBigEndian(uint64(last))); err != nil {
+		return errorsmod.Wrap(err, "set log range key")
+	}
+	return nil
+}
+
+// pruneLogIndexedRange moves the beginning of ranges of blocks with indexed logs to retain height
+func pruneLogIndexedRange(db dbm.DB, batch dbm.Batch, retainHeight int64) error {
+	ranges, err := loadLogIndexedRanges(db)
+	if err != nil {
+		return err
+	}
+	for _, r := range ranges {
+		if r[0] >= retainHeight {
+			break
+		}
+		if err := batch.Delete(logRangeKey(r[0])); err != nil {
+			return errorsmod.Wrap(err, "delete log range key")
+		}
+		if r[1] < retainHeight {
+			continue
+		}
+		if err := batch.Set(logRangeKey(retainHeight), sdk.Uint64ToBigEndian(uint64(r[1]))); err != nil {
+			return errorsmod.Wrap(err, "set log range key")
+		}
+	}
+	return nil
+}
+
+// loadLogIndexedRange returns the latest range of blocks with indexed logs, returns -1 if db is empty
+func loadLogIndexedRange(db dbm.DB) (int64, int64, error) {
+	ranges, err := loadLogIndexedRanges(db)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(ranges) == 0 {
+		return -1, -1, nil
+	}
+	latest := ranges[len(ranges)-1]
+	return latest[0], latest[1], nil
+}
+
+// loadLogIndexedRanges returns ranges of blocks with indexed logs sorted by the first block
+func loadLogIndexedRanges(db dbm.DB) ([][2]int64, error) {
+	it, err := db.Iterator([]byte{KeyPrefixLogRange}, []byte{KeyPrefixLogRange + 1})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "LoadLogIndexedRange")
+	}
+	defer it.Close()
+
+	var ranges [][2]int64
+	for ; it.Valid(); it.Next() {
+		key, value := it.Key(), it.Value()
+		if len(key) != 9 || len(value) != 8 {
+			return nil, fmt.Errorf("wrong log range length, expect: 9 and 8, got: %d and %d", len(key), len(value))
+		}
+		ranges = append(ranges, [2]int64{int64(sdk.BigEndianToUint64(key[1:])), int64(sdk.BigEndianToUint64(value))})
+	}
+	if err := it.Error(); err != nil {
+		return nil, errorsmod.Wrap(err, "LoadLogIndexedRange")
+	}
+	return ranges, nil
+}
+
+// logRangeKey returns the key for db entry: `first block of range -> last block of range`
+func logRangeKey(first int64) []byte {
+	return append([]byte{KeyPrefixLogRange}, sdk.Uint64ToBigEndian(uint64(first))...)
+}
+
+// LogKey returns the key for db entry: `(block number, eth tx index, log index) -> log`
+func LogKey(blockNumber int64, ethTxIndex int32, logIndex uint64) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(ethTxIndex))
+	bz3 := sdk.Uint64ToBigEndian(logIndex)
+	return append(append(append([]byte{KeyPrefixLog}, bz1...), bz2...), bz3...)
+}
+
+// logAddressPrefix returns prefix of db entries: `(address, block number, eth tx index, log index) -> empty`
+func logAddressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+}
+
+// logTopicPrefix returns prefix of db entries: `(topic position, topic, block number, eth tx index, log index) -> empty`
+func logTopicPrefix(position int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...)
+}
+
+// logIndexKey returns the key of address or topic index entry for the beginning of the block
+func logIndexKey(prefix []byte, blockNumber int64) []byte {
+	key := make([]byte, 0, len(prefix)+LogPositionLength)
+	key = append(key, prefix...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+	return append(key, make([]byte, 16)...)
+}
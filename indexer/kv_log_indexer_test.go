@@ -0,0 +1,207 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"swisstronik/crypto/ethsecp256k1"
+	"swisstronik/indexer"
+	"swisstronik/tests"
+	ethermint "swisstronik/types"
+	"swisstronik/utils"
+	"swisstronik/x/evm/types"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	logContractA  = common.BigToAddress(big.NewInt(1))
+	logContractB  = common.BigToAddress(big.NewInt(2))
+	transferTopic = common.BigToHash(big.NewInt(100))
+	approvalTopic = common.BigToHash(big.NewInt(200))
+	holderTopic   = common.BigToHash(big.NewInt(300))
+)
+
+// indexBlocksWithLogs indexes blocks, every of which contains a single tx with two logs:
+// transfer event of contract A and approval event of contract B
+func indexBlocksWithLogs(t *testing.T, idxer *indexer.KVIndexer, clientCtx client.Context, fromHeight, toHeight int64) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewTestSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	for height := fromHeight; height <= toHeight; height++ {
+		tx := types.NewTx(nil, uint64(height), &logContractA, big.NewInt(1000), 21000, nil, nil, nil, nil, nil, nil, nil)
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		logs := []*types.Log{
+			{Address: logContractA.Hex(), Topics: []string{transferTopic.Hex(), holderTopic.Hex()}, BlockNumber: uint64(height), TxHash: txHash.Hex(), Index: 0},
+			{Address: logContractB.Hex(), Topics: []string{approvalTopic.Hex()}, BlockNumber: uint64(height), TxHash: txHash.Hex(), Index: 1},
+		}
+		logAttrs := make([]abci.EventAttribute, len(logs))
+		for i, log := range logs {
+			bz, err := json.Marshal(log)
+			require.NoError(t, err)
+			logAttrs[i] = abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)}
+		}
+
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+		blockResult := []*abci.ResponseDeliverTx{
+			{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: "21000"},
+					}},
+					{Type: types.EventTypeTxLog, Attributes: logAttrs},
+				},
+			},
+		}
+		require.NoError(t, idxer.IndexBlock(block, blockResult))
+	}
+}
+
+func TestKVIndexerLogs(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	contractA, contractB := logContractA, logContractB
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	first, last, err := idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	require.Equal(t, int64(-1), last)
+
+	indexBlocksWithLogs(t, idxer, clientCtx, 1, 3)
+
+	first, last, err = idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(3), last)
+
+	testCases := []struct {
+		name      string
+		filter    ethermint.LogFilter
+		expBlocks []uint64
+		expPass   bool
+	}{
+		{
+			"all logs",
+			ethermint.LogFilter{FromBlock: 1, ToBlock: 3},
+			[]uint64{1, 1, 2, 2, 3, 3},
+			true,
+		},
+		{
+			"logs of contract within range",
+			ethermint.LogFilter{FromBlock: 2, ToBlock: 3, Addresses: []common.Address{contractA}},
+			[]uint64{2, 3},
+			true,
+		},
+		{
+			"logs of several contracts",
+			ethermint.LogFilter{FromBlock: 1, ToBlock: 2, Addresses: []common.Address{contractB, contractA}},
+			[]uint64{1, 1, 2, 2},
+			true,
+		},
+		{
+			"logs by topic",
+			ethermint.LogFilter{FromBlock: 1, ToBlock: 3, Topics: [][]common.Hash{{approvalTopic}}},
+			[]uint64{1, 2, 3},
+			true,
+		},
+		{
+			"logs by second topic",
+			ethermint.LogFilter{FromBlock: 1, ToBlock: 3, Topics: [][]common.Hash{{}, {holderTopic}}},
+			[]uint64{1, 2, 3},
+			true,
+		},
+		{
+			"address and topic mismatch",
+			ethermint.LogFilter{FromBlock: 1, ToBlock: 3, Addresses: []common.Address{contractB}, Topics: [][]common.Hash{{transferTopic}}},
+			[]uint64{},
+			true,
+		},
+		{
+			"logs limit exceeded",
+			ethermint.LogFilter{FromBlock: 1, ToBlock: 3, Limit: 5},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(tc.filter)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			blocks := make([]uint64, len(logs))
+			for i, log := range logs {
+				blocks[i] = log.BlockNumber
+			}
+			require.Equal(t, tc.expBlocks, blocks)
+		})
+	}
+}
+
+func TestKVIndexerLogIndexedRange(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	requireRange := func(expFirst, expLast int64) {
+		first, last, err := idxer.LogIndexedRange()
+		require.NoError(t, err)
+		require.Equal(t, expFirst, first)
+		require.Equal(t, expLast, last)
+	}
+
+	indexBlocksWithLogs(t, idxer, clientCtx, 1000, 2000)
+	requireRange(1000, 2000)
+
+	// reindexing of older blocks keeps already indexed range until the gap is filled
+	indexBlocksWithLogs(t, idxer, clientCtx, 1, 1)
+	requireRange(1000, 2000)
+	indexBlocksWithLogs(t, idxer, clientCtx, 2, 999)
+	requireRange(1, 2000)
+
+	logs, err := idxer.GetLogs(ethermint.LogFilter{FromBlock: 1, ToBlock: 2000, Addresses: []common.Address{logContractA}})
+	require.NoError(t, err)
+	require.Len(t, logs, 2000)
+
+	// skipped blocks start a new range, which is merged once skipped blocks are indexed
+	indexBlocksWithLogs(t, idxer, clientCtx, 2005, 2005)
+	requireRange(2005, 2005)
+	indexBlocksWithLogs(t, idxer, clientCtx, 2001, 2004)
+	requireRange(1, 2005)
+
+	// pruning moves the beginning of the range
+	require.NoError(t, idxer.Prune(1500))
+	requireRange(1500, 2005)
+}
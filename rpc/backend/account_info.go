@@ -139,6 +139,39 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 	return nil, errors.New("eth_getStorageAt was disabled, since storage is encrypted. Check docs at https://swisstronik.gitbook.io/swisstronik-docs/ for more information")
 }
 
+// GetStorageAtEncrypted returns the contract storage at the given address, block number, and key
+// on behalf of contract owner. Returned value is encrypted, so only owner of provided user public key can decrypt it.
+func (b *Backend) GetStorageAtEncrypted(
+	address common.Address,
+	key string,
+	userPublicKey, signature hexutil.Bytes,
+	deploymentNonce hexutil.Uint64,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) (*rpctypes.EncryptedStorageResult, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryStorageEncryptedRequest{
+		Address:         address.String(),
+		Key:             key,
+		UserPublicKey:   userPublicKey,
+		Signature:       signature,
+		DeploymentNonce: uint64(deploymentNonce),
+	}
+
+	res, err := b.queryClient.StorageEncrypted(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.EncryptedStorageResult{
+		Value:         res.Value,
+		NodePublicKey: res.NodePublicKey,
+	}, nil
+}
+
 // GetBalance returns the provided account's balance up to the provided block number.
 func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
@@ -233,6 +266,24 @@ func (b *Backend) GetNodePublicKey(blockNum rpctypes.BlockNumber) (string, error
 	return res.NodePublicKey, nil
 }
 
+// GetEpochs returns the schedule of epochs agreed by consensus
+func (b *Backend) GetEpochs() ([]rpctypes.Epoch, error) {
+	res, err := b.queryClient.Epochs(b.ctx, &evmtypes.QueryEpochsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := make([]rpctypes.Epoch, len(res.Epochs))
+	for i, epoch := range res.Epochs {
+		epochs[i] = rpctypes.Epoch{
+			EpochNumber:   hexutil.Uint64(epoch.EpochNumber),
+			StartingBlock: hexutil.Uint64(epoch.StartingBlock),
+			NodePublicKey: epoch.NodePublicKey,
+		}
+	}
+	return epochs, nil
+}
+
 func (b *Backend) GetIssuanceProof(credentialHash hexutil.Bytes) (string, error) {
 	req := &compliancetypes.QueryIssuanceProofRequest{
 		CredentialHash: credentialHash,
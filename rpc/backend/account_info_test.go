@@ -76,6 +76,72 @@ func (suite *BackendTestSuite) TestGetCode() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetStorageAtEncrypted() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	key := common.BigToHash(big.NewInt(1)).Hex()
+	userPublicKey := hexutil.Bytes(common.BigToHash(big.NewInt(2)).Bytes())
+	signature := hexutil.Bytes(make([]byte, 65))
+	encryptedValue := []byte("encrypted storage value")
+	nodePublicKey := common.BigToHash(big.NewInt(3)).Bytes()
+
+	testCases := []struct {
+		name          string
+		addr          common.Address
+		blockNrOrHash rpctypes.BlockNumberOrHash
+		registerMock  func(*evmtypes.QueryStorageEncryptedRequest)
+		expPass       bool
+	}{
+		{
+			"fail - BlockHash and BlockNumber are both nil",
+			tests.RandomEthAddress(),
+			rpctypes.BlockNumberOrHash{},
+			func(*evmtypes.QueryStorageEncryptedRequest) {},
+			false,
+		},
+		{
+			"fail - signer is not an owner of the contract",
+			tests.RandomEthAddress(),
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(req *evmtypes.QueryStorageEncryptedRequest) {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStorageEncryptedError(queryClient, req)
+			},
+			false,
+		},
+		{
+			"pass",
+			tests.RandomEthAddress(),
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(req *evmtypes.QueryStorageEncryptedRequest) {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStorageEncrypted(queryClient, req, encryptedValue, nodePublicKey)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.registerMock(&evmtypes.QueryStorageEncryptedRequest{
+				Address:         tc.addr.String(),
+				Key:             key,
+				UserPublicKey:   userPublicKey,
+				Signature:       signature,
+				DeploymentNonce: 1,
+			})
+
+			res, err := suite.backend.GetStorageAtEncrypted(tc.addr, key, userPublicKey, signature, 1, tc.blockNrOrHash)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(hexutil.Bytes(encryptedValue), res.Value)
+				suite.Require().Equal(hexutil.Bytes(nodePublicKey), res.NodePublicKey)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetProof() {
 	blockNrInvalid := rpctypes.NewBlockNumber(big.NewInt(1))
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(4))
@@ -102,9 +102,11 @@ type EVMBackend interface {
 	GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
 	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	GetStorageAtEncrypted(address common.Address, key string, userPublicKey, signature hexutil.Bytes, deploymentNonce hexutil.Uint64, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.EncryptedStorageResult, error)
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
 	GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
 	GetNodePublicKey(blockNum rpctypes.BlockNumber) (string, error)
+	GetEpochs() ([]rpctypes.Epoch, error)
 	GetIssuanceProof(credentialHash hexutil.Bytes) (string, error)
 	GetNonRevocationProof(credentialHash hexutil.Bytes) (string, error)
 	GetCredentialHash(verificationId hexutil.Bytes) (hexutil.Bytes, error)
@@ -129,22 +131,29 @@ type EVMBackend interface {
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 
+	// TxPool Info
+	TxPoolContent() (*rpctypes.TxPoolContent, error)
+
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	DoCall(args evmtypes.CallArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	CreateAccessList(args evmtypes.CallArgs, blockNr rpctypes.BlockNumber) (*rpctypes.AccessListResult, error)
+	SimulateV1(opts evmtypes.SimulateOptions, blockNr rpctypes.BlockNumber) ([]*evmtypes.SimulateBlockResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetIndexedLogs(filter ethermint.LogFilter) ([]*ethtypes.Log, bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(args evmtypes.CallArgs, blockNr rpctypes.BlockNumber, config *evmtypes.TraceConfig) (interface{}, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -14,7 +14,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 
 	"swisstronik/app"
@@ -125,6 +127,42 @@ func (suite *BackendTestSuite) buildEthereumTx() (*evmtypes.MsgHandleTx, []byte)
 	return msgHandleTx, bz
 }
 
+// buildSetCodeTx returns an example signed EIP-7702 set code transaction
+func (suite *BackendTestSuite) buildSetCodeTx() *evmtypes.MsgHandleTx {
+	authorityKey, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+
+	auth, err := evmtypes.SignSetCodeAuthorization(evmtypes.SetCodeAuthorization{
+		ChainID: (*hexutil.Big)(suite.backend.chainID),
+		Address: tests.RandomEthAddress(),
+		Nonce:   0,
+	}, authorityKey)
+	suite.Require().NoError(err)
+
+	from, priv := tests.RandomEthAddressWithPrivateKey()
+	to := crypto.PubkeyToAddress(authorityKey.PublicKey)
+	gas := hexutil.Uint64(100000)
+	nonce := hexutil.Uint64(0)
+	args := evmtypes.TransactionArgs{
+		From:                 &from,
+		To:                   &to,
+		Gas:                  &gas,
+		Nonce:                &nonce,
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(1)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1)),
+		ChainID:              (*hexutil.Big)(suite.backend.chainID),
+		AuthorizationList:    []evmtypes.SetCodeAuthorization{auth},
+	}
+
+	msgHandleTx := args.ToTransaction()
+	ethSigner := ethtypes.LatestSignerForChainID(suite.backend.chainID)
+	suite.Require().NoError(msgHandleTx.Sign(ethSigner, tests.NewTestSigner(priv)))
+
+	// A valid msg should have empty `From`
+	msgHandleTx.From = ""
+	return msgHandleTx
+}
+
 // buildFormattedBlock returns a formatted block for testing
 func (suite *BackendTestSuite) buildFormattedBlock(
 	blockRes *tmrpctypes.ResultBlockResults,
@@ -293,7 +293,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 				continue
 			}
 
-			ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
+			ethMsg.Hash = ethMsg.TxHash().Hex()
 			result = append(result, ethMsg)
 		}
 	}
@@ -402,9 +402,8 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			continue
 		}
 
-		tx := ethMsg.AsTransaction()
-		rpcTx, err := rpctypes.NewRPCTransaction(
-			tx,
+		rpcTx, err := rpctypes.NewTransactionFromMsg(
+			ethMsg,
 			common.BytesToHash(block.Hash()),
 			uint64(block.Height),
 			uint64(txIndex),
@@ -412,7 +411,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			b.chainID,
 		)
 		if err != nil {
-			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", tx.Hash().Hex(), "error", err.Error())
+			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", ethMsg.Hash, "error", err.Error())
 			continue
 		}
 		ethRPCTxs = append(ethRPCTxs, rpcTx)
@@ -119,24 +119,18 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	// RLP decode raw transaction bytes
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(data); err != nil {
+	ethereumTx := &evmtypes.MsgHandleTx{}
+	if err := ethereumTx.UnmarshalBinary(data); err != nil {
 		b.logger.Error("transaction decoding failed", "error", err.Error())
 		return common.Hash{}, err
 	}
 
 	// check the local node config in case unprotected txs are disabled
-	if !b.UnprotectedAllowed() && !tx.Protected() {
+	if !b.UnprotectedAllowed() && !ethereumTx.AsTransaction().Protected() {
 		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
 		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
 	}
 
-	ethereumTx := &evmtypes.MsgHandleTx{}
-	if err := ethereumTx.FromEthereumTx(tx); err != nil {
-		b.logger.Error("transaction converting failed", "error", err.Error())
-		return common.Hash{}, err
-	}
-
 	if b.allowUnencryptedTxs {
 		// If we received such call on backend with unencrypted transactions, we assume that
 		// tx.data was unencrypted
@@ -168,7 +162,7 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
-	txHash := ethereumTx.AsTransaction().Hash()
+	txHash := ethereumTx.TxHash()
 
 	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
 	rsp, err := syncCtx.BroadcastTx(txBytes)
@@ -375,23 +369,9 @@ func (b *Backend) DoCall(
 		Unencrypted:     b.allowUnencryptedTxs,
 	}
 
-	// From ContextWithHeight: if the provided height is 0,
-	// it will return an empty context and the gRPC query will use
-	// the latest block height for querying.
-	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
-	timeout := b.RPCEVMTimeout()
-
-	// Setup context so it may be canceled the call has completed
-	// or, in case of unmetered gas, setup a context with a timeout.
-	var cancel context.CancelFunc
-	if timeout > 0 {
-		ctx, cancel = context.WithTimeout(ctx, timeout)
-	} else {
-		ctx, cancel = context.WithCancel(ctx)
-	}
-
 	// Make sure the context is canceled when the call has completed
 	// this makes sure resources are cleaned up.
+	ctx, cancel := b.evmCallContext(blockNr)
 	defer cancel()
 
 	res, err := b.queryClient.EthCall(ctx, &req)
@@ -409,6 +389,104 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// CreateAccessList returns access list of the provided call and amount of gas used by the call
+// with this access list. Access list is collected by SGXVM during execution of the call.
+func (b *Backend) CreateAccessList(
+	args evmtypes.CallArgs, blockNr rpctypes.BlockNumber,
+) (*rpctypes.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		Unencrypted:     b.allowUnencryptedTxs,
+	}
+
+	ctx, cancel := b.evmCallContext(blockNr)
+	defer cancel()
+
+	res, err := b.queryClient.CreateAccessList(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.AccessListResult{
+		AccessList: evmtypes.AccessList(res.AccessList).ToEthAccessList(),
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
+// SimulateV1 executes batches of calls on top of the state of the provided block.
+// Each batch is simulated as a separate block and observes state changes made by previous calls.
+func (b *Backend) SimulateV1(
+	opts evmtypes.SimulateOptions, blockNr rpctypes.BlockNumber,
+) ([]*evmtypes.SimulateBlockResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.QuerySimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		Unencrypted:     b.allowUnencryptedTxs,
+	}
+
+	ctx, cancel := b.evmCallContext(blockNr)
+	defer cancel()
+
+	res, err := b.queryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*evmtypes.SimulateBlockResult
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// evmCallContext returns context of query to the EVM module, which executes call on top of the
+// provided block. Context should be canceled by the caller once call is completed.
+func (b *Backend) evmCallContext(blockNr rpctypes.BlockNumber) (context.Context, context.CancelFunc) {
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	if timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
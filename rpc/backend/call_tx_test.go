@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"google.golang.org/grpc/metadata"
 
@@ -291,6 +292,11 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 	cosmosTx, _ := ethTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
 	txBytes, _ := suite.backend.clientCtx.TxConfig.TxEncoder()(cosmosTx)
 
+	setCodeTx := suite.buildSetCodeTx()
+	setCodeBz, _ := setCodeTx.MarshalBinary()
+	setCodeCosmosTx, _ := setCodeTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	setCodeTxBytes, _ := suite.backend.clientCtx.TxConfig.TxEncoder()(setCodeCosmosTx)
+
 	testCases := []struct {
 		name         string
 		registerMock func()
@@ -358,6 +364,18 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 			common.HexToHash(ethTx.Hash),
 			true,
 		},
+		{
+			"pass - Gets the correct transaction hash of the set code transaction",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBroadcastTx(client, setCodeTxBytes)
+			},
+			setCodeBz,
+			common.HexToHash(setCodeTx.Hash),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -451,6 +469,152 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.RandomEthAddress()
+	callArgs := evmtypes.CallArgs{
+		To:      &toAddr,
+		ChainID: (*hexutil.Big)(suite.backend.chainID),
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+	request := &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()}
+
+	slot := common.HexToHash("0x01")
+	accessList := ethtypes.AccessList{{Address: toAddr, StorageKeys: []common.Hash{slot}}}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessListError(queryClient, request)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - returns access list and gas used",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessList(queryClient, request, &evmtypes.CreateAccessListResponse{
+					AccessList: evmtypes.NewAccessList(&accessList),
+					GasUsed:    23000,
+				})
+			},
+			&rpctypes.AccessListResult{AccessList: &accessList, GasUsed: 23000},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.CreateAccessList(callArgs, 1)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSimulateV1() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.RandomEthAddress()
+	opts := evmtypes.SimulateOptions{
+		BlockStateCalls: []evmtypes.SimulateBlock{{Calls: []evmtypes.CallArgs{{To: &toAddr}}}},
+	}
+	optsBz, err := json.Marshal(opts)
+	suite.Require().NoError(err)
+
+	expResults := []*evmtypes.SimulateBlockResult{
+		evmtypes.NewSimulateBlockResult(2, 100, nil),
+	}
+	expResults[0].Calls = append(expResults[0].Calls, evmtypes.NewSimulateCallResult(&evmtypes.MsgEthereumTxResponse{GasUsed: 21000, Ret: []byte{}}, nil))
+	resultsBz, err := json.Marshal(expResults)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		opts         evmtypes.SimulateOptions
+		expPass      bool
+	}{
+		{
+			"fail - empty input",
+			func() {},
+			evmtypes.SimulateOptions{},
+			false,
+		},
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			opts,
+			false,
+		},
+		{
+			"pass - returns simulated blocks",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterSimulateV1(queryClient, &evmtypes.QuerySimulateV1Request{
+					Opts:    optsBz,
+					ChainId: suite.backend.chainID.Int64(),
+				}, resultsBz)
+			},
+			opts,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			results, err := suite.backend.SimulateV1(tc.opts, 1)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, results)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
@@ -65,6 +65,20 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	queryClient.On("TraceCall", ctx, request).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	queryClient.On("TraceCall", ctx, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -152,6 +166,26 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// CreateAccessList
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, response *evmtypes.CreateAccessListResponse) {
+	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	queryClient.On("CreateAccessList", ctx, request).
+		Return(response, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	queryClient.On("CreateAccessList", ctx, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// SimulateV1
+func RegisterSimulateV1(queryClient *mocks.EVMQueryClient, request *evmtypes.QuerySimulateV1Request, data []byte) {
+	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	queryClient.On("SimulateV1", ctx, request).
+		Return(&evmtypes.QuerySimulateV1Response{Data: data}, nil)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -245,6 +279,16 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterStorageEncrypted(queryClient *mocks.EVMQueryClient, req *evmtypes.QueryStorageEncryptedRequest, value, nodePublicKey []byte) {
+	queryClient.On("StorageEncrypted", rpc.ContextWithHeight(1), req).
+		Return(&evmtypes.QueryStorageEncryptedResponse{Value: value, NodePublicKey: nodePublicKey}, nil)
+}
+
+func RegisterStorageEncryptedError(queryClient *mocks.EVMQueryClient, req *evmtypes.QueryStorageEncryptedRequest) {
+	queryClient.On("StorageEncrypted", rpc.ContextWithHeight(1), req).
+		Return(nil, errortypes.ErrUnauthorized)
+}
+
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryAccountResponse{
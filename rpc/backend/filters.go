@@ -19,6 +19,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	ethermint "swisstronik/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -44,6 +46,34 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetIndexedLogs returns logs matching the filter from the log index of the tx indexer.
+// The second return value is false if the indexer doesn't index logs or doesn't cover the
+// requested block range yet, in this case logs should be obtained from block results.
+func (b *Backend) GetIndexedLogs(filter ethermint.LogFilter) ([]*ethtypes.Log, bool, error) {
+	if err := b.checkPruned(filter.FromBlock); err != nil {
+		return nil, false, err
+	}
+
+	logIndexer, ok := b.indexer.(ethermint.EVMLogIndexer)
+	if !ok {
+		return nil, false, nil
+	}
+
+	first, last, err := logIndexer.LogIndexedRange()
+	if err != nil {
+		return nil, false, err
+	}
+	if first == -1 || filter.FromBlock < first || filter.ToBlock > last {
+		return nil, false, nil
+	}
+
+	logs, err := logIndexer.GetLogs(filter)
+	if err != nil {
+		return nil, false, err
+	}
+	return logs, true, nil
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
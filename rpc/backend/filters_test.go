@@ -2,6 +2,7 @@ package backend
 
 import (
 	"encoding/json"
+	"fmt"
 
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -9,6 +10,7 @@ import (
 
 	"swisstronik/rpc/backend/mocks"
 	ethrpc "swisstronik/rpc/types"
+	ethermint "swisstronik/types"
 	evmtypes "swisstronik/x/evm/types"
 )
 
@@ -88,6 +90,82 @@ func (suite *BackendTestSuite) TestGetLogs() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetIndexedLogs() {
+	testCases := []struct {
+		name         string
+		indexed      []int64
+		retainHeight int64
+		filter       ethermint.LogFilter
+		expIndexed   bool
+		expPass      bool
+	}{
+		{
+			"not indexed - empty indexer",
+			nil,
+			0,
+			ethermint.LogFilter{FromBlock: 1, ToBlock: 2},
+			false,
+			true,
+		},
+		{
+			"not indexed - range is not covered",
+			[]int64{1, 2},
+			0,
+			ethermint.LogFilter{FromBlock: 1, ToBlock: 3},
+			false,
+			true,
+		},
+		{
+			"indexed - range is covered",
+			[]int64{1, 2, 3},
+			0,
+			ethermint.LogFilter{FromBlock: 2, ToBlock: 3},
+			true,
+			true,
+		},
+		{
+			"indexed - range is covered after pruning",
+			[]int64{1, 2, 3},
+			2,
+			ethermint.LogFilter{FromBlock: 2, ToBlock: 3},
+			true,
+			true,
+		},
+		{
+			"fail - range is pruned",
+			[]int64{1, 2, 3},
+			2,
+			ethermint.LogFilter{FromBlock: 1, ToBlock: 3},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			for _, height := range tc.indexed {
+				err := suite.backend.indexer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: height}}, nil)
+				suite.Require().NoError(err)
+			}
+			if tc.retainHeight > 0 {
+				suite.Require().NoError(suite.backend.indexer.Prune(tc.retainHeight))
+			}
+
+			logs, indexed, err := suite.backend.GetIndexedLogs(tc.filter)
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, ethermint.ErrIndexerPruned)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expIndexed, indexed)
+			if tc.expIndexed {
+				suite.Require().Empty(logs)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestBloomStatus() {
 	testCases := []struct {
 		name         string
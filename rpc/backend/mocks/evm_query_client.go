@@ -167,6 +167,66 @@ func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosm
 	return r0, r1
 }
 
+// CreateAccessList provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) CreateAccessList(ctx context.Context, in *types.EthCallRequest, opts ...grpc.CallOption) (*types.CreateAccessListResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.CreateAccessListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.EthCallRequest, ...grpc.CallOption) *types.CreateAccessListResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CreateAccessListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.EthCallRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Epochs provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) Epochs(ctx context.Context, in *types.QueryEpochsRequest, opts ...grpc.CallOption) (*types.QueryEpochsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryEpochsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryEpochsRequest, ...grpc.CallOption) *types.QueryEpochsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryEpochsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryEpochsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EstimateGas provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) EstimateGas(ctx context.Context, in *types.EthCallRequest, opts ...grpc.CallOption) (*types.EstimateGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -257,6 +317,36 @@ func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsReque
 	return r0, r1
 }
 
+// SimulateV1 provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) SimulateV1(ctx context.Context, in *types.QuerySimulateV1Request, opts ...grpc.CallOption) (*types.QuerySimulateV1Response, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QuerySimulateV1Response
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySimulateV1Request, ...grpc.CallOption) *types.QuerySimulateV1Response); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QuerySimulateV1Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QuerySimulateV1Request, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Storage provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageRequest, opts ...grpc.CallOption) (*types.QueryStorageResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -287,6 +377,36 @@ func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageReq
 	return r0, r1
 }
 
+// StorageEncrypted provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) StorageEncrypted(ctx context.Context, in *types.QueryStorageEncryptedRequest, opts ...grpc.CallOption) (*types.QueryStorageEncryptedResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryStorageEncryptedResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageEncryptedRequest, ...grpc.CallOption) *types.QueryStorageEncryptedResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryStorageEncryptedResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStorageEncryptedRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TraceBlock provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBlockRequest, opts ...grpc.CallOption) (*types.QueryTraceBlockResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -317,6 +437,36 @@ func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBl
 	return r0, r1
 }
 
+// TraceCall provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) TraceCall(ctx context.Context, in *types.QueryTraceCallRequest, opts ...grpc.CallOption) (*types.QueryTraceCallResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryTraceCallResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) *types.QueryTraceCallResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryTraceCallResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TraceTx provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceTx(ctx context.Context, in *types.QueryTraceTxRequest, opts ...grpc.CallOption) (*types.QueryTraceTxResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -70,6 +70,8 @@ func (b *Backend) Accounts() ([]common.Address, error) {
 // - highestBlock:  block number of the highest block header this node has received from peers
 // - pulledStates:  number of state entries processed until now
 // - knownStates:   number of known state entries that still need to be pulled
+// - phase:         CometBFT synchronization phase, either state-sync or block-sync
+// - enclaveInitialized: whether enclave has epoch keys required to decrypt transactions and state
 func (b *Backend) Syncing() (interface{}, error) {
 	status, err := b.clientCtx.Client.Status(b.ctx)
 	if err != nil {
@@ -80,12 +82,15 @@ func (b *Backend) Syncing() (interface{}, error) {
 		return false, nil
 	}
 
+	syncStatus := rpctypes.NewSyncStatus(status, rpctypes.IsEnclaveInitialized())
 	return map[string]interface{}{
-		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
-		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
+		"startingBlock": syncStatus.StartingBlock,
+		"currentBlock":  syncStatus.CurrentBlock,
 		// "highestBlock":  nil, // NA
 		// "pulledStates":  nil, // NA
 		// "knownStates":   nil, // NA
+		"phase":              syncStatus.Phase,
+		"enclaveInitialized": syncStatus.EnclaveInitialized,
 	}, nil
 }
 
@@ -14,6 +14,7 @@ import (
 
 	"swisstronik/crypto/ethsecp256k1"
 	"swisstronik/rpc/backend/mocks"
+	rpctypes "swisstronik/rpc/types"
 	ethermint "swisstronik/types"
 	"swisstronik/utils"
 )
@@ -196,8 +197,10 @@ func (suite *BackendTestSuite) TestSyncing() {
 				status.SyncInfo.CatchingUp = true
 			},
 			map[string]interface{}{
-				"startingBlock": hexutil.Uint64(0),
-				"currentBlock":  hexutil.Uint64(0),
+				"startingBlock":      hexutil.Uint64(0),
+				"currentBlock":       hexutil.Uint64(0),
+				"phase":              rpctypes.SyncPhaseStateSync,
+				"enclaveInitialized": false,
 			},
 			true,
 		},
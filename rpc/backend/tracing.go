@@ -195,3 +195,45 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// TraceCall executes provided call on top of the state of the requested block with a tracer configured
+// according to the provided configuration. The return value is dependent on the requested tracer.
+func (b *Backend) TraceCall(
+	args evmtypes.CallArgs,
+	blockNr rpctypes.BlockNumber,
+	config *evmtypes.TraceConfig,
+) (interface{}, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	traceCallRequest := &evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		TraceConfig:     config,
+		Unencrypted:     b.allowUnencryptedTxs,
+	}
+
+	ctx, cancel := b.evmCallContext(blockNr)
+	defer cancel()
+
+	traceResult, err := b.queryClient.TraceCall(ctx, traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -11,11 +12,13 @@ import (
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"swisstronik/crypto/ethsecp256k1"
 	"swisstronik/indexer"
 	"swisstronik/rpc/backend/mocks"
+	"swisstronik/tests"
 	"swisstronik/utils"
 	evmtypes "swisstronik/x/evm/types"
 )
@@ -256,3 +259,80 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.RandomEthAddress()
+	callArgs := evmtypes.CallArgs{
+		To:      &toAddr,
+		ChainID: (*hexutil.Big)(suite.backend.chainID),
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+	config := &evmtypes.TraceConfig{Tracer: evmtypes.TracerCall}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - trace call error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCallError(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:        argsBz,
+					ChainId:     suite.backend.chainID.Int64(),
+					TraceConfig: config,
+				})
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - returns decoded trace",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:        argsBz,
+					ChainId:     suite.backend.chainID.Int64(),
+					TraceConfig: config,
+				})
+			},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.TraceCall(callArgs, 1, config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
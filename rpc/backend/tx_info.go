@@ -247,7 +247,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
 		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(ethMsg.TxType()),
 	}
 
 	if logs == nil {
@@ -259,13 +259,14 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
+	switch txData.(type) {
+	case *evmtypes.DynamicFeeTx, *evmtypes.SetCodeTx:
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
 			// tolerate the error for pruned node.
 			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
 		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+			receipt["effectiveGasPrice"] = hexutil.Big(*txData.EffectiveGasPrice(baseFee))
 		}
 	}
 
@@ -331,6 +332,23 @@ func (b *Backend) GetTxByEthHash(hash common.Hash) (*ethermint.TxResult, error)
 	return txResult, nil
 }
 
+// checkPruned returns ErrIndexerPruned if the block was pruned by retention policy of the custom indexer
+// or is below the first block indexed by it
+func (b *Backend) checkPruned(height int64) error {
+	if b.indexer == nil {
+		return nil
+	}
+
+	firstAvailable, err := ethermint.FirstAvailableBlock(b.indexer)
+	if err != nil {
+		return err
+	}
+	if height < firstAvailable {
+		return errorsmod.Wrapf(ethermint.ErrIndexerPruned, "block %d, first available block %d", height, firstAvailable)
+	}
+	return nil
+}
+
 // GetTxByTxIndex uses `/tx_query` to find transaction by tx index of valid ethereum txs
 func (b *Backend) GetTxByTxIndex(height int64, index uint) (*ethermint.TxResult, error) {
 	if b.indexer != nil {
@@ -379,6 +397,10 @@ func (b *Backend) queryTendermintTxIndexer(query string, txGetter func(*rpctypes
 
 // GetTransactionByBlockAndIndex is the common code shared by `GetTransactionByBlockNumberAndIndex` and `GetTransactionByBlockHashAndIndex`.
 func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
+	if err := b.checkPruned(block.Block.Height); err != nil {
+		return nil, err
+	}
+
 	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
 	if err != nil {
 		return nil, nil
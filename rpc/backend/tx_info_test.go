@@ -377,6 +377,21 @@ func (suite *BackendTestSuite) TestGetTransactionByBlockAndIndex() {
 			txFromMsg,
 			true,
 		},
+		{
+			"fail - block below the first indexed block of never pruned indexer",
+			func() {
+				db := dbm.NewMemDB()
+				suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+				txBz := suite.signAndEncodeEthTx(msgEthTx)
+				block := &types.Block{Header: types.Header{Height: 2, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+				err := suite.backend.indexer.IndexBlock(block, defaultResponseDeliverTx)
+				suite.Require().NoError(err)
+			},
+			&tmrpctypes.ResultBlock{Block: defaultBlock},
+			0,
+			nil,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
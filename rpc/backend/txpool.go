@@ -0,0 +1,93 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "swisstronik/rpc/types"
+	evmtypes "swisstronik/x/evm/types"
+)
+
+// TxPoolContent returns ethereum transactions from CometBFT mempool grouped by sender and nonce.
+// Transactions, which follow the committed account nonce without gaps, are pending, other ones are queued.
+// Transactions with nonces below the account nonce are skipped, since they will be evicted by mempool recheck.
+func (b *Backend) TxPoolContent() (*rpctypes.TxPoolContent, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	bySender := make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgHandleTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				continue
+			}
+
+			// use zero block values since it's not included in a block yet
+			rpcTx, err := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, uint64(0), uint64(0), nil, b.chainID)
+			if err != nil {
+				return nil, err
+			}
+
+			if bySender[sender] == nil {
+				bySender[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+			}
+			// mempool keeps the first received transaction for the same nonce
+			if _, found := bySender[sender][uint64(rpcTx.Nonce)]; !found {
+				bySender[sender][uint64(rpcTx.Nonce)] = rpcTx
+			}
+		}
+	}
+
+	content := &rpctypes.TxPoolContent{
+		Pending: make(map[common.Address]map[uint64]*rpctypes.RPCTransaction),
+		Queued:  make(map[common.Address]map[uint64]*rpctypes.RPCTransaction),
+	}
+	for sender, senderTxs := range bySender {
+		nonce, err := b.getAccountNonce(sender, false, 0, b.logger)
+		if err != nil {
+			return nil, err
+		}
+
+		for next := nonce; senderTxs[next] != nil; next++ {
+			if content.Pending[sender] == nil {
+				content.Pending[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+			}
+			content.Pending[sender][next] = senderTxs[next]
+		}
+
+		for txNonce, rpcTx := range senderTxs {
+			if txNonce < nonce || content.Pending[sender][txNonce] != nil {
+				continue
+			}
+			if content.Queued[sender] == nil {
+				content.Queued[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+			}
+			content.Queued[sender][txNonce] = rpcTx
+		}
+	}
+
+	return content, nil
+}
@@ -0,0 +1,77 @@
+package backend
+
+import (
+	"math/big"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"swisstronik/app"
+	"swisstronik/encoding"
+	"swisstronik/rpc/backend/mocks"
+	"swisstronik/tests"
+	"swisstronik/utils"
+	evmtypes "swisstronik/x/evm/types"
+)
+
+// signEthTxWithNonce returns encoded ethereum transaction with provided nonce, signed by provided key
+func (suite *BackendTestSuite) signEthTxWithNonce(from common.Address, signer keyring.Signer, nonce uint64) tmtypes.Tx {
+	msgHandleTx := evmtypes.NewTx(suite.backend.chainID, nonce, &common.Address{}, big.NewInt(0), 100000, big.NewInt(1), nil, nil, nil, nil, nil, nil)
+	msgHandleTx.From = from.String()
+	suite.Require().NoError(msgHandleTx.Sign(ethtypes.LatestSigner(suite.backend.ChainConfig()), signer))
+
+	tx, err := msgHandleTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	suite.Require().NoError(err)
+	txBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	return txBz
+}
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	suite.backend.clientCtx = suite.backend.clientCtx.WithInterfaceRegistry(encCfg.InterfaceRegistry)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+
+	from, priv := tests.RandomEthAddressWithPrivateKey()
+	signer := tests.NewTestSigner(priv)
+
+	// Account nonce is 1, so transaction with nonce 0 is stale, transactions 1 and 2 are pending,
+	// and transaction 4 is queued until transaction 3 arrives
+	txs := []tmtypes.Tx{
+		suite.signEthTxWithNonce(from, signer, 0),
+		suite.signEthTxWithNonce(from, signer, 2),
+		suite.signEthTxWithNonce(from, signer, 1),
+		suite.signEthTxWithNonce(from, signer, 4),
+	}
+	RegisterUnconfirmedTxs(client, nil, txs)
+
+	account := authtypes.NewBaseAccount(from.Bytes(), nil, 1, 1)
+	request := &authtypes.QueryAccountRequest{Address: sdk.AccAddress(from.Bytes()).String()}
+	requestBz, err := request.Marshal()
+	suite.Require().NoError(err)
+	RegisterABCIQueryAccount(client, requestBz, tmrpcclient.ABCIQueryOptions{Height: int64(1), Prove: false}, account)
+
+	content, err := suite.backend.TxPoolContent()
+	suite.Require().NoError(err)
+
+	suite.Require().Len(content.Pending[from], 2)
+	suite.Require().Equal(from, content.Pending[from][1].From)
+	suite.Require().NotNil(content.Pending[from][2])
+	suite.Require().Len(content.Queued[from], 1)
+	suite.Require().NotNil(content.Queued[from][4])
+}
+
+func (suite *BackendTestSuite) TestTxPoolContentError() {
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	RegisterUnconfirmedTxsError(client, nil)
+
+	_, err := suite.backend.TxPoolContent()
+	suite.Require().Error(err)
+}
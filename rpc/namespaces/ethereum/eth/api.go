@@ -75,6 +75,7 @@ type EthereumAPI interface {
 	Accounts() ([]common.Address, error)
 	GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
 	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	GetStorageAtEncrypted(address common.Address, key string, userPublicKey, signature hexutil.Bytes, deploymentNonce hexutil.Uint64, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.EncryptedStorageResult, error)
 	GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
 
@@ -83,6 +84,8 @@ type EthereumAPI interface {
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(args evmtypes.CallArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error)
+	CreateAccessList(args evmtypes.CallArgs, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccessListResult, error)
+	SimulateV1(opts evmtypes.SimulateOptions, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]*evmtypes.SimulateBlockResult, error)
 
 	// Chain Information
 	//
@@ -255,6 +258,20 @@ func (e *PublicAPI) GetStorageAt(address common.Address, key string, blockNrOrHa
 	return e.backend.GetStorageAt(address, key, blockNrOrHash)
 }
 
+// GetStorageAtEncrypted returns the contract storage at the given address, block number, and key,
+// encrypted for the contract owner. Request should be signed by the deployer of the contract or
+// by the account stored in the owner slot of the contract.
+func (e *PublicAPI) GetStorageAtEncrypted(
+	address common.Address,
+	key string,
+	userPublicKey, signature hexutil.Bytes,
+	deploymentNonce hexutil.Uint64,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) (*rpctypes.EncryptedStorageResult, error) {
+	e.logger.Debug("eth_getStorageAtEncrypted", "address", address.Hex(), "key", key, "block number or hash", blockNrOrHash)
+	return e.backend.GetStorageAtEncrypted(address, key, userPublicKey, signature, deploymentNonce, blockNrOrHash)
+}
+
 // GetCode returns the contract code at the given address and block number.
 func (e *PublicAPI) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_getCode", "address", address.Hex(), "block number or hash", blockNrOrHash)
@@ -293,12 +310,53 @@ func (e *PublicAPI) Call(args evmtypes.CallArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// CreateAccessList creates an EIP-2930 type AccessList for the given transaction.
+// BlockNrOrHash can be specified to create the accessList on top of a certain state.
+func (e *PublicAPI) CreateAccessList(
+	args evmtypes.CallArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum, err := e.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.backend.CreateAccessList(args, blockNum)
+}
+
+// SimulateV1 executes series of blocks with calls on top of the provided block. Block and state
+// overrides are applied before execution of each block. Returns results of all the simulated calls.
+func (e *PublicAPI) SimulateV1(
+	opts evmtypes.SimulateOptions,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) ([]*evmtypes.SimulateBlockResult, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		if blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash); err != nil {
+			return nil, err
+		}
+	}
+
+	return e.backend.SimulateV1(opts, blockNum)
+}
+
 // GetNodePublicKey returns x25519 based public key
 func (e *PublicAPI) GetNodePublicKey(blockNum rpctypes.BlockNumber) (string, error) {
 	e.logger.Debug("eth_getNodePublicKey", "block number", blockNum)
 	return e.backend.GetNodePublicKey(blockNum)
 }
 
+// GetEpochs returns the schedule of epochs agreed by consensus
+func (e *PublicAPI) GetEpochs() ([]rpctypes.Epoch, error) {
+	e.logger.Debug("eth_getEpochs")
+	return e.backend.GetEpochs()
+}
+
 // IssuanceProof returns issuance proof for ZK-sdi
 func (e *PublicAPI) IssuanceProof(credentialHash hexutil.Bytes) (string, error) {
 	e.logger.Debug("eth_issuanceProof", "hash", credentialHash.String())
@@ -480,9 +538,10 @@ func (e *PublicAPI) FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.Si
 	}
 
 	// Assemble the transaction and obtain rlp
-	tx := args.ToTransaction().AsTransaction()
+	msg := args.ToTransaction()
+	tx := msg.AsTransaction()
 
-	data, err := tx.MarshalBinary()
+	data, err := msg.MarshalBinary()
 	if err != nil {
 		return nil, err
 	}
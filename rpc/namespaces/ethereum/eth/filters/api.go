@@ -35,6 +35,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	ethermint "swisstronik/types"
 	evmtypes "swisstronik/x/evm/types"
 )
 
@@ -58,6 +59,7 @@ type Backend interface {
 	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
+	GetIndexedLogs(filter ethermint.LogFilter) ([]*ethtypes.Log, bool, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -188,7 +190,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 					for _, msg := range tx.GetMsgs() {
 						ethTx, ok := msg.(*evmtypes.MsgHandleTx)
 						if ok {
-							f.hashes = append(f.hashes, ethTx.AsTransaction().Hash())
+							f.hashes = append(f.hashes, ethTx.TxHash())
 						}
 					}
 				}
@@ -252,7 +254,7 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 				for _, msg := range tx.GetMsgs() {
 					ethTx, ok := msg.(*evmtypes.MsgHandleTx)
 					if ok {
-						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash())
+						_ = notifier.Notify(rpcSub.ID, ethTx.TxHash())
 					}
 				}
 			case <-rpcSub.Err():
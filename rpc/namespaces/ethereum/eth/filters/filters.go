@@ -23,6 +23,7 @@ import (
 
 	"swisstronik/rpc/backend"
 	"swisstronik/rpc/types"
+	ethermint "swisstronik/types"
 
 	"github.com/cometbft/cometbft/libs/log"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -155,6 +156,27 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*e
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
+	// log index makes wide range queries cheap, so block range limit is applied only if block results are scanned
+	if f.criteria.FromBlock.Int64() <= head {
+		to := f.criteria.ToBlock.Int64()
+		if to > head {
+			to = head
+		}
+		logs, indexed, err := f.backend.GetIndexedLogs(ethermint.LogFilter{
+			FromBlock: f.criteria.FromBlock.Int64(),
+			ToBlock:   to,
+			Addresses: f.criteria.Addresses,
+			Topics:    f.criteria.Topics,
+			Limit:     logLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if indexed {
+			return logs, nil
+		}
+	}
+
 	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
@@ -16,51 +16,133 @@
 package txpool
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"swisstronik/rpc/backend"
 	"swisstronik/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// Transactions are read from CometBFT mempool and grouped by sender and nonce into pending and queued ones.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+	for account, txs := range pool.Pending {
+		content["pending"][account.Hex()] = byNonce(txs)
+	}
+	for account, txs := range pool.Queued {
+		content["queued"][account.Hex()] = byNonce(txs)
+	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool for the provided address
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": byNonce(pool.Pending[address]),
+		"queued":  byNonce(pool.Queued[address]),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	for account, txs := range pool.Pending {
+		content["pending"][account.Hex()] = inspectTxs(txs)
+	}
+	for account, txs := range pool.Queued {
+		content["queued"][account.Hex()] = inspectTxs(txs)
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+	pool, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(countTxs(pool.Pending)),
+		"queued":  hexutil.Uint(countTxs(pool.Queued)),
+	}, nil
+}
+
+// byNonce converts transactions of a single account to the map keyed by decimal nonce
+func byNonce(txs map[uint64]*types.RPCTransaction) map[string]*types.RPCTransaction {
+	result := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprint(nonce)] = tx
+	}
+	return result
+}
+
+// inspectTxs converts transactions of a single account to summaries keyed by decimal nonce
+func inspectTxs(txs map[uint64]*types.RPCTransaction) map[string]string {
+	result := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprint(nonce)] = inspectTx(tx)
+	}
+	return result
+}
+
+// inspectTx returns transaction summary in the same format as geth
+func inspectTx(tx *types.RPCTransaction) string {
+	if tx.To != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+}
+
+func countTxs(txs map[common.Address]map[uint64]*types.RPCTransaction) int {
+	count := 0
+	for _, accountTxs := range txs {
+		count += len(accountTxs)
 	}
+	return count
 }
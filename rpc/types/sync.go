@@ -0,0 +1,77 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"github.com/SigmaGmbH/librustgo"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SyncPhase describes which kind of synchronization is performed by CometBFT
+type SyncPhase string
+
+const (
+	// SyncPhaseStateSync means that node restores application state from snapshot
+	SyncPhaseStateSync SyncPhase = "state-sync"
+	// SyncPhaseBlockSync means that node downloads and executes blocks to catch up with the network
+	SyncPhaseBlockSync SyncPhase = "block-sync"
+	// SyncPhaseSynced means that node follows the network by consensus
+	SyncPhaseSynced SyncPhase = "synced"
+)
+
+// SyncStatus is a synchronization state of the node, which is pushed by `eth_subscribe("syncing")`.
+// Besides CometBFT sync state, it reports whether enclave has epoch keys, so clients can detect
+// synced nodes, which are not able to decrypt transactions and state.
+type SyncStatus struct {
+	Syncing            bool           `json:"syncing"`
+	Phase              SyncPhase      `json:"phase"`
+	StartingBlock      hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock       hexutil.Uint64 `json:"currentBlock"`
+	EnclaveInitialized bool           `json:"enclaveInitialized"`
+}
+
+// NewSyncStatus creates SyncStatus from CometBFT node status
+func NewSyncStatus(status *tmrpctypes.ResultStatus, enclaveInitialized bool) SyncStatus {
+	phase := SyncPhaseSynced
+	if status.SyncInfo.CatchingUp {
+		phase = SyncPhaseBlockSync
+		// while snapshot is restored, there are no blocks in block store yet
+		if status.SyncInfo.LatestBlockHeight == 0 {
+			phase = SyncPhaseStateSync
+		}
+	}
+
+	return SyncStatus{
+		Syncing:            status.SyncInfo.CatchingUp,
+		Phase:              phase,
+		StartingBlock:      hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
+		CurrentBlock:       hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
+		EnclaveInitialized: enclaveInitialized,
+	}
+}
+
+// IsEnclaveInitialized returns true if enclave has sealed epoch keys.
+// Errors are reported as uninitialized enclave, since node cannot decrypt anything in this case.
+func IsEnclaveInitialized() bool {
+	initialized, err := librustgo.IsNodeInitialized()
+	return err == nil && initialized
+}
+
+// StateChanged returns true if sync status differs from the other one in anything except block numbers
+func (s SyncStatus) StateChanged(other SyncStatus) bool {
+	return s.Syncing != other.Syncing || s.Phase != other.Phase || s.EnclaveInitialized != other.EnclaveInitialized
+}
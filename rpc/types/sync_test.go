@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSyncStatus(t *testing.T) {
+	testCases := []struct {
+		msg        string
+		syncInfo   tmrpctypes.SyncInfo
+		expSyncing bool
+		expPhase   SyncPhase
+	}{
+		{
+			"synced node",
+			tmrpctypes.SyncInfo{EarliestBlockHeight: 1, LatestBlockHeight: 100},
+			false,
+			SyncPhaseSynced,
+		},
+		{
+			"node restores snapshot",
+			tmrpctypes.SyncInfo{CatchingUp: true},
+			true,
+			SyncPhaseStateSync,
+		},
+		{
+			"node downloads blocks",
+			tmrpctypes.SyncInfo{EarliestBlockHeight: 1, LatestBlockHeight: 50, CatchingUp: true},
+			true,
+			SyncPhaseBlockSync,
+		},
+	}
+
+	for _, tc := range testCases {
+		status := NewSyncStatus(&tmrpctypes.ResultStatus{SyncInfo: tc.syncInfo}, true)
+		require.Equal(t, tc.expSyncing, status.Syncing, tc.msg)
+		require.Equal(t, tc.expPhase, status.Phase, tc.msg)
+		require.Equal(t, hexutil.Uint64(tc.syncInfo.EarliestBlockHeight), status.StartingBlock, tc.msg)
+		require.Equal(t, hexutil.Uint64(tc.syncInfo.LatestBlockHeight), status.CurrentBlock, tc.msg)
+		require.True(t, status.EnclaveInitialized, tc.msg)
+	}
+}
+
+func TestSyncStatusStateChanged(t *testing.T) {
+	status := SyncStatus{Syncing: true, Phase: SyncPhaseBlockSync, CurrentBlock: 10}
+
+	newBlock := status
+	newBlock.CurrentBlock = 11
+	require.False(t, status.StateChanged(newBlock))
+
+	synced := newBlock
+	synced.Syncing, synced.Phase = false, SyncPhaseSynced
+	require.True(t, status.StateChanged(synced))
+
+	enclaveReady := status
+	enclaveReady.EnclaveInitialized = true
+	require.True(t, status.StateChanged(enclaveReady))
+}
@@ -18,6 +18,8 @@ package types
 import (
 	"math/big"
 
+	evmtypes "swisstronik/x/evm/types"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -46,25 +48,26 @@ type StorageResult struct {
 
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
-	BlockHash        *common.Hash         `json:"blockHash"`
-	BlockNumber      *hexutil.Big         `json:"blockNumber"`
-	From             common.Address       `json:"from"`
-	Gas              hexutil.Uint64       `json:"gas"`
-	GasPrice         *hexutil.Big         `json:"gasPrice"`
-	GasFeeCap        *hexutil.Big         `json:"maxFeePerGas,omitempty"`
-	GasTipCap        *hexutil.Big         `json:"maxPriorityFeePerGas,omitempty"`
-	Hash             common.Hash          `json:"hash"`
-	Input            hexutil.Bytes        `json:"input"`
-	Nonce            hexutil.Uint64       `json:"nonce"`
-	To               *common.Address      `json:"to"`
-	TransactionIndex *hexutil.Uint64      `json:"transactionIndex"`
-	Value            *hexutil.Big         `json:"value"`
-	Type             hexutil.Uint64       `json:"type"`
-	Accesses         *ethtypes.AccessList `json:"accessList,omitempty"`
-	ChainID          *hexutil.Big         `json:"chainId,omitempty"`
-	V                *hexutil.Big         `json:"v"`
-	R                *hexutil.Big         `json:"r"`
-	S                *hexutil.Big         `json:"s"`
+	BlockHash         *common.Hash                    `json:"blockHash"`
+	BlockNumber       *hexutil.Big                    `json:"blockNumber"`
+	From              common.Address                  `json:"from"`
+	Gas               hexutil.Uint64                  `json:"gas"`
+	GasPrice          *hexutil.Big                    `json:"gasPrice"`
+	GasFeeCap         *hexutil.Big                    `json:"maxFeePerGas,omitempty"`
+	GasTipCap         *hexutil.Big                    `json:"maxPriorityFeePerGas,omitempty"`
+	Hash              common.Hash                     `json:"hash"`
+	Input             hexutil.Bytes                   `json:"input"`
+	Nonce             hexutil.Uint64                  `json:"nonce"`
+	To                *common.Address                 `json:"to"`
+	TransactionIndex  *hexutil.Uint64                 `json:"transactionIndex"`
+	Value             *hexutil.Big                    `json:"value"`
+	Type              hexutil.Uint64                  `json:"type"`
+	Accesses          *ethtypes.AccessList            `json:"accessList,omitempty"`
+	AuthorizationList []evmtypes.SetCodeAuthorization `json:"authorizationList,omitempty"`
+	ChainID           *hexutil.Big                    `json:"chainId,omitempty"`
+	V                 *hexutil.Big                    `json:"v"`
+	R                 *hexutil.Big                    `json:"r"`
+	S                 *hexutil.Big                    `json:"s"`
 }
 
 // StateOverride is the collection of overridden accounts.
@@ -84,6 +87,13 @@ type OverrideAccount struct {
 	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
 }
 
+// AccessListResult represents the result of eth_createAccessList
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
 type FeeHistoryResult struct {
 	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
 	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
@@ -102,3 +112,25 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// TxPoolContent contains ethereum transactions from mempool grouped by sender and nonce
+type TxPoolContent struct {
+	// Pending transactions can be executed in the next block
+	Pending map[common.Address]map[uint64]*RPCTransaction
+	// Queued transactions are waiting for transactions with lower nonces
+	Queued map[common.Address]map[uint64]*RPCTransaction
+}
+
+// EncryptedStorageResult represents the storage value returned by eth_getStorageAtEncrypted.
+// Value is encrypted using key derived from user public key and node public key.
+type EncryptedStorageResult struct {
+	Value         hexutil.Bytes `json:"value"`
+	NodePublicKey hexutil.Bytes `json:"nodePublicKey"`
+}
+
+// Epoch defines a period of blocks, which use the same node public key for transaction encryption
+type Epoch struct {
+	EpochNumber   hexutil.Uint64 `json:"epochNumber"`
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	NodePublicKey hexutil.Bytes  `json:"nodePublicKey"`
+}
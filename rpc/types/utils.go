@@ -57,7 +57,7 @@ func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) ([]*evmtypes.MsgHan
 		if !ok {
 			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, &evmtypes.MsgHandleTx{})
 		}
-		ethTx.Hash = ethTx.AsTransaction().Hash().Hex()
+		ethTx.Hash = ethTx.TxHash().Hex()
 		ethTxs[i] = ethTx
 	}
 	return ethTxs, nil
@@ -168,8 +168,27 @@ func NewTransactionFromMsg(
 	baseFee *big.Int,
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := msg.AsTransaction()
-	return NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	result, err := NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// go-ethereum transaction cannot represent set code transaction, therefore
+	// type dependent fields are taken from the tx data
+	if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+		from, _ := setCodeTx.Sender(setCodeTx.GetChainID())
+		result.Type = hexutil.Uint64(setCodeTx.TxType())
+		result.From = from
+		result.Hash = setCodeTx.Hash()
+		result.AuthorizationList = setCodeTx.GetAuthorizationList()
+	}
+	return result, nil
 }
 
 // NewTransactionFromData returns a transaction that will serialize to the RPC
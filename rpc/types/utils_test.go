@@ -0,0 +1,62 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "swisstronik/x/evm/types"
+)
+
+func TestNewTransactionFromSetCodeMsg(t *testing.T) {
+	chainID := big.NewInt(1291)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	auth, err := evmtypes.SignSetCodeAuthorization(evmtypes.SetCodeAuthorization{
+		ChainID: (*hexutil.Big)(chainID),
+		Address: common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		Nonce:   1,
+	}, key)
+	require.NoError(t, err)
+
+	chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+	feeCap := sdkmath.NewInt(10)
+	tipCap := sdkmath.NewInt(1)
+	amount := sdkmath.ZeroInt()
+	txData := &evmtypes.SetCodeTx{
+		ChainID:        &chainIDInt,
+		GasTipCap:      &tipCap,
+		GasFeeCap:      &feeCap,
+		GasLimit:       100000,
+		Amount:         &amount,
+		To:             crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		Authorizations: evmtypes.NewAuthorizationList([]evmtypes.SetCodeAuthorization{auth}),
+	}
+	sig, err := crypto.Sign(txData.SigHash(chainID).Bytes(), key)
+	require.NoError(t, err)
+	require.NoError(t, txData.WithSignature(chainID, sig))
+
+	msg := &evmtypes.MsgHandleTx{}
+	require.NoError(t, msg.FromSetCodeTx(txData))
+
+	blockHash := common.HexToHash("0x01")
+	rpcTx, err := NewTransactionFromMsg(msg, blockHash, 1, 0, big.NewInt(2), chainID)
+	require.NoError(t, err)
+
+	require.Equal(t, hexutil.Uint64(evmtypes.SetCodeTxType), rpcTx.Type)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), rpcTx.From)
+	require.Equal(t, txData.Hash(), rpcTx.Hash)
+	require.Equal(t, []evmtypes.SetCodeAuthorization{auth}, rpcTx.AuthorizationList)
+	require.Equal(t, big.NewInt(3), rpcTx.GasPrice.ToInt())
+
+	bz, err := json.Marshal(rpcTx)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"authorizationList":[{`)
+}
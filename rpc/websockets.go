@@ -25,6 +25,7 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -687,8 +688,72 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
+// syncingPollInterval is an interval between CometBFT status checks in syncing subscription
+const syncingPollInterval = 2 * time.Second
+
 func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires CometBFT client")
+	}
+
+	done := make(chan struct{})
+	var closeOnce sync.Once
+	unsubFn := func() {
+		closeOnce.Do(func() { close(done) })
+	}
+
+	go func() {
+		ticker := time.NewTicker(syncingPollInterval)
+		defer ticker.Stop()
+
+		// first status is sent on the first tick, since subscription id should be received by client before any notification
+		var (
+			last     types.SyncStatus
+			notified bool
+		)
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				status, err := api.clientCtx.Client.Status(context.Background())
+				if err != nil {
+					api.logger.Debug("failed to query node status", "subscription-id", subID, "error", err.Error())
+					continue
+				}
+
+				syncStatus := types.NewSyncStatus(status, types.IsEnclaveInitialized())
+				if notified && !syncStatus.StateChanged(last) {
+					continue
+				}
+				last, notified = syncStatus, true
+
+				// write to ws conn
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       syncStatus,
+					},
+				}
+
+				err = wsConn.WriteJSON(res)
+				if err != nil {
+					api.logger.Error("error writing sync status, will drop peer", "error", err.Error())
+
+					try(func() {
+						if err != websocket.ErrCloseSent {
+							_ = wsConn.Close()
+						}
+					}, api.logger, "closing websocket peer sub")
+					return
+				}
+			}
+		}
+	}()
+
+	return unsubFn, nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
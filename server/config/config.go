@@ -81,6 +81,9 @@ const (
 
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultIndexerRetainBlocks represents the number of blocks kept by the custom indexer (all blocks = 0)
+	DefaultIndexerRetainBlocks = 0
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -102,6 +105,9 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// TraceNode defines if node allows tracing of encrypted transactions using debug API.
+	// Unencrypted transactions can be traced by any node.
+	TraceNode bool `mapstructure:"trace-node"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -144,6 +150,8 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// IndexerRetainBlocks defines the number of recent blocks kept by the custom indexer, 0 means all blocks are kept.
+	IndexerRetainBlocks uint64 `mapstructure:"indexer-retain-blocks"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -253,6 +261,7 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		AllowUnprotectedTxs:       DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:        DefaultMaxOpenConnections,
 		EnableIndexer:             false,
+		IndexerRetainBlocks:       DefaultIndexerRetainBlocks,
 		MetricsAddress:            DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight:  DefaultFixRevertGasRefundHeight,
 		UnsafeEthEndpointsEnabled: false, // eth_sendTransaction, eth_sign, eth_signTypedData are disabled by default to prevent stealing funds
@@ -347,6 +356,7 @@ func GetConfig(v *viper.Viper) (Config, error) {
 		EVM: EVMConfig{
 			Tracer:         v.GetString("evm.tracer"),
 			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			TraceNode:      v.GetBool("evm.trace-node"),
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                    v.GetBool("json-rpc.enable"),
@@ -366,6 +376,7 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			HTTPIdleTimeout:           v.GetDuration("json-rpc.http-idle-timeout"),
 			MaxOpenConnections:        v.GetInt("json-rpc.max-open-connections"),
 			EnableIndexer:             v.GetBool("json-rpc.enable-indexer"),
+			IndexerRetainBlocks:       v.GetUint64("json-rpc.indexer-retain-blocks"),
 			MetricsAddress:            v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight:  v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 			UnsafeEthEndpointsEnabled: v.GetBool("json-rpc.unsafe-eth-endpoints-enabled"),
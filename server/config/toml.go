@@ -31,6 +31,10 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# TraceNode defines if node allows tracing of encrypted transactions using 'debug_trace*' methods.
+# Unencrypted transactions can be traced by any node.
+trace-node = {{ .EVM.TraceNode }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -94,6 +98,10 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# IndexerRetainBlocks defines the number of recent blocks kept by the custom indexer, older blocks are pruned in background.
+# Set it according to the pruning of block results on the node. 0 means all blocks are kept.
+indexer-retain-blocks = {{ .JSONRPC.IndexerRetainBlocks }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -67,6 +67,7 @@ const (
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCIndexerRetainBlocks = "json-rpc.indexer-retain-blocks"
 	JSONRPCFeeHistoryCap       = "json-rpc.feehistory-cap"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
@@ -80,6 +81,7 @@ const (
 const (
 	EVMTracer         = "evm.tracer"
 	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTraceNode      = "evm.trace-node"
 )
 
 // TLS flags
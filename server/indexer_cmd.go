@@ -29,13 +29,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
+const (
+	flagFromHeight = "from-height"
+	flagToHeight   = "to-height"
+)
+
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|reindex]",
 		Short: "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
+		- reindex: index the blocks again from --from-height to --to-height (by default, from the earliest to the latest block in the block store).
+		  It backfills the log index of blocks, which were indexed before log indexing was introduced.
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
@@ -49,8 +56,8 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			if direction != "backward" && direction != "forward" && direction != "reindex" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward|reindex, got: %s", direction)
 			}
 
 			cfg := serverCtx.Config
@@ -123,6 +130,29 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "reindex":
+				fromHeight, err := cmd.Flags().GetInt64(flagFromHeight)
+				if err != nil {
+					return err
+				}
+				toHeight, err := cmd.Flags().GetInt64(flagToHeight)
+				if err != nil {
+					return err
+				}
+				if fromHeight == 0 {
+					fromHeight = blockStore.Base()
+				}
+				if toHeight == 0 {
+					toHeight = blockStore.Height()
+				}
+				if fromHeight < blockStore.Base() || toHeight > blockStore.Height() || fromHeight > toHeight {
+					return fmt.Errorf("invalid reindex range [%d, %d], available blocks: [%d, %d]", fromHeight, toHeight, blockStore.Base(), blockStore.Height())
+				}
+				for i := fromHeight; i <= toHeight; i++ {
+					if err := indexBlock(i); err != nil {
+						return err
+					}
+				}
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
@@ -130,5 +160,7 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().Int64(flagFromHeight, 0, "First block to reindex, defaults to the earliest block in the block store")
+	cmd.Flags().Int64(flagToHeight, 0, "Last block to reindex, defaults to the latest block in the block store")
 	return cmd
 }
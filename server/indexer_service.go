@@ -30,6 +30,9 @@ const (
 	ServiceName = "EVMIndexerService"
 
 	NewBlockWaitTimeout = 60 * time.Second
+
+	// PruneInterval is the number of indexed blocks between pruning runs
+	PruneInterval = 100
 )
 
 // EVMIndexerService indexes transactions for json-rpc service.
@@ -38,14 +41,17 @@ type EVMIndexerService struct {
 
 	txIdxr evmcommontypes.EVMTxIndexer
 	client rpcclient.Client
+	// retainBlocks is the number of recent blocks kept by indexer, 0 disables pruning
+	retainBlocks uint64
 }
 
 // NewEVMIndexerService returns a new service instance.
 func NewEVMIndexerService(
 	txIdxr evmcommontypes.EVMTxIndexer,
 	client rpcclient.Client,
+	retainBlocks uint64,
 ) *EVMIndexerService {
-	is := &EVMIndexerService{txIdxr: txIdxr, client: client}
+	is := &EVMIndexerService{txIdxr: txIdxr, client: client, retainBlocks: retainBlocks}
 	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
 	return is
 }
@@ -95,6 +101,14 @@ func (eis *EVMIndexerService) OnStart() error {
 	if lastBlock == -1 {
 		lastBlock = latestBlock
 	}
+
+	// pruning is performed in background, so it doesn't delay indexing of new blocks
+	pruneSignal := make(chan int64, 1)
+	if eis.retainBlocks > 0 {
+		go eis.pruneLoop(pruneSignal)
+	}
+	var lastPruneSignal int64
+
 	for {
 		if latestBlock <= lastBlock {
 			// nothing to index. wait for signal of new block
@@ -120,5 +134,28 @@ func (eis *EVMIndexerService) OnStart() error {
 			}
 			lastBlock = blockResult.Height
 		}
+
+		if eis.retainBlocks > 0 && lastBlock-lastPruneSignal >= PruneInterval {
+			select {
+			case pruneSignal <- lastBlock:
+				lastPruneSignal = lastBlock
+			default:
+			}
+		}
+	}
+}
+
+// pruneLoop removes indexed data of blocks, which are out of retention window
+func (eis *EVMIndexerService) pruneLoop(pruneSignal <-chan int64) {
+	for height := range pruneSignal {
+		retainHeight := height - int64(eis.retainBlocks) + 1
+		if retainHeight <= 1 {
+			continue
+		}
+		if err := eis.txIdxr.Prune(retainHeight); err != nil {
+			eis.Logger.Error("failed to prune indexer", "retain-height", retainHeight, "err", err)
+			continue
+		}
+		eis.Logger.Debug("pruned indexer", "retain-height", retainHeight)
 	}
 }
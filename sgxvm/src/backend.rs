@@ -11,7 +11,7 @@ use primitive_types::{H160, H256, U256};
 use crate::{coder, querier};
 use crate::protobuf_generated::ffi;
 use crate::storage::FFIStorage;
-use crate::types::{Storage};
+use crate::types::{Storage, DELEGATION_PREFIX};
 
 pub struct TxEnvironment {
     pub chain_id: U256,
@@ -98,6 +98,21 @@ impl<'state> Backend<'state> {
     }
 }
 
+/// Returns address of account, which code should be executed on call to provided address.
+/// If account contains EIP-7702 delegation designator, address of delegation target is returned.
+/// Only code loaded for execution follows delegation, EXTCODE* opcodes observe the designator itself
+pub fn code_address<H: RuntimeBaseBackend>(handler: &H, address: H160) -> H160 {
+    parse_delegation(&handler.code(address)).unwrap_or(address)
+}
+
+/// Returns target address of EIP-7702 delegation designator
+fn parse_delegation(code: &[u8]) -> Option<H160> {
+    if code.len() != DELEGATION_PREFIX.len() + 20 || !code.starts_with(&DELEGATION_PREFIX) {
+        return None;
+    }
+    Some(H160::from_slice(&code[DELEGATION_PREFIX.len()..]))
+}
+
 impl<'state> RuntimeEnvironment for Backend<'state> {
     fn block_hash(&self, number: U256) -> H256 {
         let encoded_request = coder::encode_query_block_hash(&number);
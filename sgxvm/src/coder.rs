@@ -153,16 +153,18 @@ pub fn encode_add_verification_details_v2_request(
     cosmos_request.write_to_bytes().unwrap()
 }
 
-pub fn encode_get_issuance_tree_root_request() -> Vec<u8> {
+pub fn encode_get_issuance_tree_root_request(block_height: u64) -> Vec<u8> {
     let mut cosmos_request = ffi::CosmosRequest::new();
-    let request = ffi::QueryIssuanceTreeRoot::new();
+    let mut request = ffi::QueryIssuanceTreeRoot::new();
+    request.set_blockHeight(block_height);
     cosmos_request.set_issuanceTreeRoot(request);
     cosmos_request.write_to_bytes().unwrap()
 }
 
-pub fn encode_get_revocation_tree_root_request() -> Vec<u8> {
+pub fn encode_get_revocation_tree_root_request(block_height: u64) -> Vec<u8> {
     let mut cosmos_request = ffi::CosmosRequest::new();
-    let request = ffi::QueryRevocationTreeRoot::new();
+    let mut request = ffi::QueryRevocationTreeRoot::new();
+    request.set_blockHeight(block_height);
     cosmos_request.set_revocationTreeRoot(request);
     cosmos_request.write_to_bytes().unwrap()
 }
@@ -261,4 +263,19 @@ pub fn encode_convert_credential(verification_id: Vec<u8>, holder_public_key: Ve
 
     cosmos_request.set_convertCredential(request);
     cosmos_request.write_to_bytes().unwrap()
-}
\ No newline at end of file
+}
+#[cfg(test)]
+mod test {
+
+    use super::*;
+
+    #[test]
+    fn test_encode_tree_root_requests_with_block_height() {
+        let request: ffi::CosmosRequest = protobuf::parse_from_bytes(&encode_get_issuance_tree_root_request(42)).unwrap();
+        assert_eq!(request.get_issuanceTreeRoot().get_blockHeight(), 42);
+
+        let request: ffi::CosmosRequest = protobuf::parse_from_bytes(&encode_get_revocation_tree_root_request(0)).unwrap();
+        assert!(request.has_revocationTreeRoot());
+        assert_eq!(request.get_revocationTreeRoot().get_blockHeight(), 0);
+    }
+}
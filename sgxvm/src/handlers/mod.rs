@@ -15,6 +15,7 @@ use crate::protobuf_generated::ffi::{
 use crate::GoQuerier;
 
 pub mod tx;
+mod storage;
 mod utils;
 
 /// Handles incoming protobuf-encoded request
@@ -47,6 +48,9 @@ pub fn handle_protobuf_request_inner(
                 },
                 FFIRequest_oneof_req::estimateGasRequest(data) => {
                     handle_evm_estimate_gas_request(querier, data)
+                },
+                FFIRequest_oneof_req::storageAtEncryptedRequest(data) => {
+                    storage::handle_storage_at_encrypted_request(querier, data)
                 }
             }
         }
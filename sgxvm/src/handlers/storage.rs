@@ -0,0 +1,117 @@
+use primitive_types::{H160, H256};
+use protobuf::Message;
+use sha3::{Digest, Keccak256};
+
+use crate::encryption::encrypt_transaction_data;
+use crate::helpers::recover_sender;
+use crate::key_manager::utils::random_nonce;
+use crate::key_manager::KeyManager;
+use crate::protobuf_generated::ffi::{StorageAtEncryptedRequest, StorageAtEncryptedResponse};
+use crate::storage::FFIStorage;
+use crate::types::Storage;
+use crate::AllocationWithResult;
+use crate::GoQuerier;
+
+/// Handles request to read contract storage slot on behalf of contract owner.
+/// Request should be signed by deployer of the contract or by address stored in the owner slot (slot 0).
+/// Returns slot value encrypted using shared key derived from user public key and node public key
+pub fn handle_storage_at_encrypted_request(
+    querier: *mut GoQuerier,
+    data: StorageAtEncryptedRequest,
+) -> AllocationWithResult {
+    if data.contractAddress.len() != 20 || data.storageKey.len() != 32 || data.userPublicKey.len() != 32 {
+        println!("Got malformed storage request");
+        return AllocationWithResult::default();
+    }
+
+    let contract = H160::from_slice(&data.contractAddress);
+    let storage = FFIStorage::new(querier, 0, data.blockNumber);
+
+    let signer = match recover_sender(&sign_hash(&data), &data.signature) {
+        Some(signer) => H160::from(signer),
+        None => {
+            println!("Cannot recover signer of storage request");
+            return AllocationWithResult::default();
+        }
+    };
+
+    let is_deployer = create_address(signer, data.deploymentNonce) == contract;
+    let is_owner = || match storage.get_account_storage_cell(&contract, &H256::zero()) {
+        Some(owner) => H160::from(owner) == signer,
+        None => false,
+    };
+    if !is_deployer && !is_owner() {
+        println!("Storage request was not signed by contract owner");
+        return AllocationWithResult::default();
+    }
+
+    let value = storage
+        .get_account_storage_cell(&contract, &H256::from_slice(&data.storageKey))
+        .unwrap_or_default();
+
+    let nonce = match random_nonce() {
+        Ok(nonce) => nonce.to_vec(),
+        Err(err) => {
+            println!("Cannot generate nonce. Reason: {:?}", err);
+            return AllocationWithResult::default();
+        }
+    };
+
+    let encrypted_value = match encrypt_transaction_data(
+        value.as_bytes().to_vec(),
+        data.userPublicKey.clone(),
+        nonce,
+        data.blockNumber,
+    ) {
+        Ok(encrypted_value) => encrypted_value,
+        Err(err) => {
+            println!("Cannot encrypt storage value. Reason: {:?}", err);
+            return AllocationWithResult::default();
+        }
+    };
+
+    let node_public_key = match KeyManager::unseal().map(|manager| manager.get_public_key(data.blockNumber)) {
+        Ok(Ok(public_key)) => public_key,
+        _ => {
+            println!("Cannot find key in Epoch Manager");
+            return AllocationWithResult::default();
+        }
+    };
+
+    let mut response = StorageAtEncryptedResponse::new();
+    response.set_encryptedValue(encrypted_value);
+    response.set_nodePublicKey(node_public_key);
+
+    let encoded_response = match response.write_to_bytes() {
+        Ok(res) => res,
+        Err(err) => {
+            println!("Cannot encode protobuf result. Reason: {:?}", err);
+            return AllocationWithResult::default();
+        }
+    };
+
+    super::allocate_inner(encoded_response)
+}
+
+/// Returns EIP-191 hash of contract address, storage key and user public key, which is signed by contract owner
+fn sign_hash(data: &StorageAtEncryptedRequest) -> H256 {
+    let message = Keccak256::new()
+        .chain_update(&data.contractAddress)
+        .chain_update(&data.storageKey)
+        .chain_update(&data.userPublicKey)
+        .finalize();
+
+    let hash = Keccak256::new()
+        .chain_update(b"\x19Ethereum Signed Message:\n32")
+        .chain_update(message)
+        .finalize();
+    H256::from_slice(hash.as_slice())
+}
+
+/// Returns address of contract created by provided deployer using provided nonce
+fn create_address(deployer: H160, nonce: u64) -> H160 {
+    let mut stream = rlp::RlpStream::new_list(2);
+    stream.append(&deployer);
+    stream.append(&nonce);
+    H160::from_slice(&Keccak256::digest(&stream.out())[12..])
+}
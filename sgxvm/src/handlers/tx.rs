@@ -9,15 +9,17 @@ use crate::encryption::{
 };
 use crate::key_manager::utils::random_nonce;
 use crate::precompiles::EVMPrecompiles;
-use crate::protobuf_generated::ffi::{HandleTransactionResponse, Log, SGXVMCallRequest, SGXVMCreateRequest, SGXVMEstimateGasRequest, Topic, TransactionContext};
+use crate::protobuf_generated::ffi::{HandleTransactionResponse, Log, SGXVMCallRequest, SGXVMCreateRequest, SGXVMEstimateGasRequest, Topic, TraceOptions, TracerType, TransactionContext};
 use crate::std::string::ToString;
-use crate::types::{ExecutionResult, GASOMETER_CONFIG};
+use crate::types::{ExecutionResult, GASOMETER_CONFIG, PER_EMPTY_ACCOUNT_COST};
 use crate::AllocationWithResult;
 use crate::GoQuerier;
 use crate::handlers::utils::{convert_logs, parse_access_list};
 use crate::backend::{TxEnvironment, Backend};
 use crate::helpers::recover_sender;
 use crate::invoker::OverlayedInvoker;
+use crate::tracing::{self, ExecutionTracer};
+use core::cell::RefCell;
 
 /// Converts raw execution result into protobuf and returns it outside of enclave
 pub fn convert_and_allocate_transaction_result(
@@ -25,8 +27,12 @@ pub fn convert_and_allocate_transaction_result(
 ) -> AllocationWithResult {
     let mut response = HandleTransactionResponse::new();
     response.set_gas_used(execution_result.gas_used);
+    response.set_gas_refund(execution_result.gas_refund);
     response.set_vm_error(execution_result.vm_error);
     response.set_ret(execution_result.data);
+    if let Some(trace) = execution_result.trace {
+        response.set_trace(trace);
+    }
 
     // Convert logs into proper format
     let converted_logs = execution_result
@@ -66,7 +72,7 @@ pub fn handle_call_request_inner(
     let tx = Transaction::from(data.clone());
     let tx_hash = tx.hash();
 
-    let params = data.params.unwrap();
+    let mut params = data.params.unwrap();
     let context = data.context.unwrap();
 
     let tx_sender = if params.signature.is_empty() || H160::from_slice(&params.from).eq(&H160::zero()) {
@@ -82,13 +88,31 @@ pub fn handle_call_request_inner(
         return ExecutionResult::from_error("Corrupted signature. Provided sender is invalid".to_string(), Vec::new(), None)
     }
 
+    // Authorizations of EIP-7702 transaction are applied by Cosmos side before execution,
+    // therefore only intrinsic gas for them is charged here
+    let authorization_gas = PER_EMPTY_ACCOUNT_COST.saturating_mul(params.authorizationList.len() as u64);
+    if params.gasLimit < authorization_gas {
+        return ExecutionResult::from_error("intrinsic gas too low".to_string(), Vec::new(), Some(params.gasLimit))
+    }
+    params.gasLimit -= authorization_gas;
+
     let should_commit = params.commit;
     let block_number = context.block_number;
+    let trace_options = params.get_trace().clone();
 
     // Check if transaction is unencrypted, handle it as regular EVM transaction
     let is_unencrypted = params.data.is_empty() || params.unencrypted;
     if is_unencrypted {
-        return run_tx(querier, context, params.into(), should_commit)
+        let mut execution_result = run_tx(querier, context, params.into(), should_commit, trace_options);
+        execution_result.gas_used += authorization_gas;
+        return execution_result
+    }
+
+    // Decrypted input of encrypted transaction can be traced only by trace nodes. Access list
+    // tracer is allowed, since it collects only touched accounts and storage keys
+    let is_tracing = matches!(trace_options.get_tracer(), TracerType::TRACER_STRUCT | TracerType::TRACER_CALL);
+    if is_tracing && !trace_options.get_traceNode() {
+        return ExecutionResult::from_error("tracing of encrypted transactions is not allowed".to_string(), Vec::new(), None)
     }
 
     // Otherwise, we should decrypt input, execute tx and encrypt output
@@ -114,7 +138,8 @@ pub fn handle_call_request_inner(
         gas_price: U256::from_big_endian(&params.gasPrice),
         access_list: parse_access_list(params.accessList),
     };
-    let mut execution_result = run_tx(querier, context, transact_args, should_commit);
+    let mut execution_result = run_tx(querier, context, transact_args, should_commit, trace_options);
+    execution_result.gas_used += authorization_gas;
 
     let nonce = match nonce.is_empty() {
         true => {
@@ -163,8 +188,9 @@ pub fn handle_create_request_inner(
     }
 
     let should_commit = params.commit;
+    let trace_options = params.get_trace().clone();
 
-    run_tx(querier, context, params.into(), should_commit)
+    run_tx(querier, context, params.into(), should_commit, trace_options)
 }
 
 pub fn handle_estimate_gas_request_inner(
@@ -187,7 +213,7 @@ pub fn handle_estimate_gas_request_inner(
                 access_list: parse_access_list(params.accessList),
             };
 
-            run_tx(querier, context, transact_args, false)
+            run_tx(querier, context, transact_args, false, TraceOptions::new())
         },
         _ => {
             // Handle `call`
@@ -205,7 +231,7 @@ pub fn handle_estimate_gas_request_inner(
                     gas_price: U256::from_big_endian(&params.gasPrice),
                     access_list: parse_access_list(params.accessList),
                 };
-                return run_tx(querier, context, transact_args, false)
+                return run_tx(querier, context, transact_args, false, TraceOptions::new())
             } else {
                 // Otherwise, we should decrypt input, execute tx and encrypt output
                 let (user_public_key, data, _) = match extract_public_key_and_data(params.data) {
@@ -231,7 +257,7 @@ pub fn handle_estimate_gas_request_inner(
                     access_list: parse_access_list(params.accessList),
                 };
 
-                run_tx(querier, context, transact_args, false)
+                run_tx(querier, context, transact_args, false, TraceOptions::new())
             }
         }
     };
@@ -257,13 +283,18 @@ fn run_tx(
     context: TransactionContext,
     args: TransactArgs,
     should_commit: bool,
+    trace_options: TraceOptions,
 ) -> ExecutionResult {
-    let gas_etable = Etable::single(evm::standard::eval_gasometer);
+    let tracer = RefCell::new(ExecutionTracer::new(trace_options));
+
+    let gas_etable = Etable::single(|machine, handler, opcode, position| {
+        tracing::eval_gasometer(&tracer, machine, handler, opcode, position)
+    });
     let exec_etable = Etable::runtime();
     let etable = (gas_etable, exec_etable);
     let precompiles = EVMPrecompiles::new(querier);
     let resolver = EtableResolver::new(&GASOMETER_CONFIG, &precompiles, &etable);
-    let invoker = OverlayedInvoker::new(&GASOMETER_CONFIG, &resolver);
+    let invoker = OverlayedInvoker::new(&GASOMETER_CONFIG, &resolver, &tracer);
 
     let storage = crate::storage::FFIStorage::new(querier, context.timestamp, context.block_number);
     let tx_environment = TxEnvironment::from(context);
@@ -273,6 +304,8 @@ fn run_tx(
     let changeset = backend.deconstruct();
 
     let used_gas = invoker.get_gas_used().map(|used_gas| used_gas.as_u64()).unwrap_or(21000);
+    let gas_refund = invoker.get_gas_refund().map(|gas_refund| gas_refund.as_u64()).unwrap_or_default();
+    let trace = tracer.borrow_mut().take().map(|tracer| tracer.into_trace());
 
     if should_commit {
         if let Err(err) = Backend::apply_changeset(&storage, &changeset) {
@@ -280,6 +313,7 @@ fn run_tx(
                 err,
                 invoker.get_return_value().unwrap_or_default(),
                 used_gas,
+                gas_refund,
             );
         }
     }
@@ -292,7 +326,9 @@ fn run_tx(
                         logs: convert_logs(changeset.logs),
                         data: retval,
                         gas_used: used_gas,
-                        vm_error: "".to_string()
+                        gas_refund,
+                        vm_error: "".to_string(),
+                        trace,
                     }
                 }
                 TransactValue::Create {succeed: _, address} => {
@@ -303,14 +339,18 @@ fn run_tx(
                             logs: convert_logs(changeset.logs),
                             data: address.to_fixed_bytes().to_vec(),
                             gas_used: used_gas,
-                            vm_error: "".to_string()
+                            gas_refund,
+                            vm_error: "".to_string(),
+                            trace,
                         }
                     } else {
                         ExecutionResult {
                             logs: convert_logs(changeset.logs),
                             data: invoker.get_return_value().unwrap_or_default(),
                             gas_used: used_gas,
-                            vm_error: "".to_string()
+                            gas_refund,
+                            vm_error: "".to_string(),
+                            trace,
                         }
                     }
 
@@ -319,7 +359,10 @@ fn run_tx(
         },
         Err(err) => {
             let error_data = invoker.get_return_value().unwrap_or_default();
-            ExecutionResult::from_exit_error(err, error_data, used_gas)
+            let mut execution_result = ExecutionResult::from_exit_error(err, error_data, used_gas, gas_refund);
+            // Trace is returned for failed transactions as well
+            execution_result.trace = trace;
+            execution_result
         }
     }
 }
\ No newline at end of file
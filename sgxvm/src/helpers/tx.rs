@@ -3,12 +3,23 @@ use protobuf::RepeatedField;
 use std::vec::Vec;
 use rlp::{RlpStream};
 use sha3::{Digest, Keccak256};
-use crate::protobuf_generated::ffi::{AccessListItem, SGXVMCallRequest, SGXVMCreateRequest};
+use crate::protobuf_generated::ffi::{AccessListItem, AuthorizationListItem, SGXVMCallRequest, SGXVMCreateRequest};
 
 enum TransactionType {
     Legacy,
     EIP2930,
     EIP1559,
+    EIP7702,
+}
+
+/// EIP-7702 authorization, which is signed by authority account
+pub struct Authorization {
+    chain_id: U256,
+    address: H160,
+    nonce: u64,
+    y_parity: u32,
+    r: U256,
+    s: U256,
 }
 
 pub struct Transaction {
@@ -28,6 +39,9 @@ pub struct Transaction {
 
     // EIP2930
     access_list: Vec<(H160, Vec<H256>)>,
+
+    // EIP7702
+    authorization_list: Vec<Authorization>,
 }
 
 impl Transaction {
@@ -109,6 +123,45 @@ impl Transaction {
         self.rlp_append_access_list(stream);
     }
 
+    fn rlp_append_authorization_list(&self, stream: &mut RlpStream) {
+        stream.begin_list(self.authorization_list.len());
+        for authorization in &self.authorization_list {
+            stream.begin_list(6);
+            stream.append(&authorization.chain_id);
+            stream.append(&authorization.address);
+            stream.append(&authorization.nonce);
+            stream.append(&authorization.y_parity);
+            stream.append(&authorization.r);
+            stream.append(&authorization.s);
+        }
+    }
+
+    fn rlp_append_eip7702(&self, stream: &mut RlpStream) {
+        stream.begin_list(10);
+
+        stream.append(&self.chain_id);
+        stream.append(&self.nonce);
+        stream.append(&self.max_priority_fee_per_gas.unwrap_or_default());
+        stream.append(&self.max_fee_per_gas.unwrap_or_default());
+        stream.append(&self.gas_limit);
+
+        // EIP-7702 transactions cannot create contracts
+        if let Some(to) = self.to {
+            stream.append(&to);
+        } else {
+            stream.append_empty_data();
+        }
+
+        stream.append(&self.value);
+        stream.append(&self.data);
+
+        // Access list
+        self.rlp_append_access_list(stream);
+
+        // Authorization list
+        self.rlp_append_authorization_list(stream);
+    }
+
     pub fn hash(&self) -> H256 {
         match self.tx_type {
             TransactionType::Legacy => {
@@ -137,6 +190,17 @@ impl Transaction {
                 let mut prefix = vec![2];
                 prefix.extend_from_slice(&encoded);
 
+                H256::from_slice(Keccak256::digest(&prefix).as_slice())
+            },
+            TransactionType::EIP7702 => {
+                let mut stream = RlpStream::new();
+                self.rlp_append_eip7702(&mut stream);
+                let encoded = stream.out();
+
+                // EIP-7702 transactions are prefixed with 0x04 before hashing
+                let mut prefix = vec![4];
+                prefix.extend_from_slice(&encoded);
+
                 H256::from_slice(Keccak256::digest(&prefix).as_slice())
             }
         }
@@ -152,6 +216,7 @@ impl From<SGXVMCallRequest> for Transaction {
             0 => TransactionType::Legacy,
             1 => TransactionType::EIP2930,
             2 => TransactionType::EIP1559,
+            4 => TransactionType::EIP7702,
             _ => TransactionType::EIP1559,
         };
 
@@ -167,6 +232,7 @@ impl From<SGXVMCallRequest> for Transaction {
             max_priority_fee_per_gas: Some(U256::from_big_endian(&params.maxPriorityFeePerGas)),
             max_fee_per_gas: Some(U256::from_big_endian(&params.maxFeePerGas)),
             access_list: parse_access_list(params.accessList),
+            authorization_list: parse_authorization_list(params.authorizationList),
         }
     }
 }
@@ -195,6 +261,7 @@ impl From<SGXVMCreateRequest> for Transaction {
             max_priority_fee_per_gas: Some(U256::from_big_endian(&params.maxPriorityFeePerGas)),
             max_fee_per_gas: Some(U256::from_big_endian(&params.maxFeePerGas)),
             access_list: parse_access_list(params.accessList),
+            authorization_list: Vec::new(),
         }
     }
 }
@@ -214,4 +281,18 @@ pub fn parse_access_list(data: RepeatedField<AccessListItem>) -> Vec<(H160, Vec<
     }
 
     access_list
-}
\ No newline at end of file
+}
+
+pub fn parse_authorization_list(data: RepeatedField<AuthorizationListItem>) -> Vec<Authorization> {
+    data.to_vec()
+        .into_iter()
+        .map(|item| Authorization {
+            chain_id: U256::from_big_endian(&item.chainId),
+            address: H160::from_slice(&item.address),
+            nonce: item.nonce,
+            y_parity: item.yParity,
+            r: U256::from_big_endian(&item.r),
+            s: U256::from_big_endian(&item.s),
+        })
+        .collect()
+}
@@ -20,6 +20,9 @@ use evm::interpreter::{
 use primitive_types::{H160, H256, U256};
 use sha3::{Digest, Keccak256};
 
+use crate::backend::code_address;
+use crate::tracing::ExecutionTracer;
+
 /// A trap that can be turned into either a call/create trap (where we push new
 /// call stack), or an interrupt (an external signal).
 pub trait IntoCallCreateTrap {
@@ -48,6 +51,7 @@ pub struct TransactInvoke {
 
 pub struct DataContainer {
     pub gas_used: U256,
+    pub gas_refund: U256,
     pub return_value: Vec<u8>,
 }
 
@@ -55,6 +59,7 @@ impl Default for DataContainer {
     fn default() -> Self {
         Self {
             gas_used: U256::from(21000),
+            gas_refund: U256::zero(),
             return_value: vec![],
         }
     }
@@ -72,18 +77,28 @@ pub struct OverlayedInvoker<'config, 'resolver, R> {
     container: RefCell<Option<DataContainer>>,
     config: &'config Config,
     resolver: &'resolver R,
+    tracer: &'resolver RefCell<Option<ExecutionTracer>>,
 }
 
 impl<'config, 'resolver, R> OverlayedInvoker<'config, 'resolver, R> {
     /// Create a new standard invoker with the given config and resolver.
-    pub fn new(config: &'config Config, resolver: &'resolver R) -> Self {
-        Self { config, resolver, container: RefCell::new(None) }
+    /// Calls are reported to the provided tracer, if it is set.
+    pub fn new(
+        config: &'config Config,
+        resolver: &'resolver R,
+        tracer: &'resolver RefCell<Option<ExecutionTracer>>,
+    ) -> Self {
+        Self { config, resolver, tracer, container: RefCell::new(None) }
     }
 
     pub fn get_gas_used(&self) -> Option<U256> {
         self.container.borrow().as_ref().map(|data| data.gas_used)
     }
 
+    pub fn get_gas_refund(&self) -> Option<U256> {
+        self.container.borrow().as_ref().map(|data| data.gas_refund)
+    }
+
     pub fn get_return_value(&self) -> Option<Vec<u8>> {
         self.container.borrow().as_ref().map(|data| data.return_value.clone())
     }
@@ -201,10 +216,14 @@ where
                         self.config,
                     )?;
 
+                    if let Some(tracer) = self.tracer.borrow_mut().as_mut() {
+                        tracer.capture_start(false, caller, address, &data, state.gas(), value);
+                    }
+
                     let machine = routines::make_enter_call_machine(
                         self.config,
                         self.resolver,
-                        address,
+                        code_address(handler, address),
                         data,
                         Some(transfer),
                         state,
@@ -237,6 +256,10 @@ where
                         self.config,
                     )?;
 
+                    if let Some(tracer) = self.tracer.borrow_mut().as_mut() {
+                        tracer.capture_start(true, caller, address, &init_code, state.gas(), value);
+                    }
+
                     let machine = routines::make_enter_create_machine(
                         self.config,
                         self.resolver,
@@ -268,6 +291,10 @@ where
         // Since retval is moved into closure, we clone it here
         let retval_copy = retval.clone();
 
+        if let Some(tracer) = self.tracer.borrow_mut().as_mut() {
+            tracer.capture_end(&retval, substate.gas(), &result);
+        }
+
         let work = || -> Result<TransactValue, ExitError> {
             match result {
                 Ok(result) => {
@@ -309,9 +336,14 @@ where
             }
         }
 
-        let used_gas = invoke.gas_limit.saturating_sub(substate.effective_gas());
+        // Refund is not applied to used gas, since it is applied by the caller according to EIP-3529.
+        // Effective gas already includes refund capped by the same quotient, so the cap applied by
+        // the caller does not change it
+        let used_gas = invoke.gas_limit.saturating_sub(substate.gas());
+        let gas_refund = substate.effective_gas().saturating_sub(substate.gas());
         *self.container.borrow_mut() = Some(DataContainer{
             gas_used: used_gas,
+            gas_refund,
             return_value: retval_copy,
         });
 
@@ -373,6 +405,7 @@ where
         };
 
         let transaction_context = machine.machine().state.as_ref().transaction_context.clone();
+        let current_address = machine.machine().state.as_ref().context.address;
 
         match trap_data {
             CallCreateTrapData::Call(call_trap_data) => {
@@ -391,15 +424,25 @@ where
                 };
 
                 let target = call_trap_data.target;
+                let input = call_trap_data.input.clone();
+                let value = call_trap_data.context.apparent_value;
 
-                Capture::Exit(routines::enter_call_substack(
+                let result = routines::enter_call_substack(
                     self.config,
                     self.resolver,
                     call_trap_data,
-                    target,
+                    code_address(handler, target),
                     substate,
                     handler,
-                ))
+                );
+
+                if result.is_ok() {
+                    if let Some(tracer) = self.tracer.borrow_mut().as_mut() {
+                        tracer.capture_enter(opcode, current_address, target, &input, gas_limit, value);
+                    }
+                }
+
+                Capture::Exit(result)
             }
             CallCreateTrapData::Create(create_trap_data) => {
                 let caller = create_trap_data.scheme.caller();
@@ -424,14 +467,25 @@ where
                     Err(err) => return Capture::Exit(Err(err)),
                 };
 
-                Capture::Exit(routines::enter_create_substack(
+                let value = create_trap_data.value;
+                let input = code.clone();
+
+                let result = routines::enter_create_substack(
                     self.config,
                     self.resolver,
                     code,
                     create_trap_data,
                     substate,
                     handler,
-                ))
+                );
+
+                if result.is_ok() {
+                    if let Some(tracer) = self.tracer.borrow_mut().as_mut() {
+                        tracer.capture_enter(opcode, caller, address, &input, gas_limit, value);
+                    }
+                }
+
+                Capture::Exit(result)
             }
         }
     }
@@ -444,6 +498,10 @@ where
         parent: &mut Self::Interpreter,
         handler: &mut H,
     ) -> Result<(), ExitError> {
+        if let Some(tracer) = self.tracer.borrow_mut().as_mut() {
+            tracer.capture_exit(&retval, substate.gas(), &result);
+        }
+
         let strategy = match &result {
             Ok(_) => MergeStrategy::Commit,
             Err(ExitError::Reverted) => MergeStrategy::Revert,
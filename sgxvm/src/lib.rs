@@ -44,6 +44,7 @@ mod querier;
 mod storage;
 mod types;
 mod invoker;
+mod tracing;
 mod helpers;
 
 #[no_mangle]
@@ -33,6 +33,10 @@ const GET_VERIFICATION_DATA_FN_SELECTOR: &str = "cc8995ec";
 const GET_REVOCATION_TREE_ROOT_FN_SELECTOR: &str = "3db94a04";
 // Selector of `getIssuanceTreeRoot` function
 const GET_ISSUANCE_TREE_ROOT_FN_SELECTOR: &str = "d0376bd2";
+// Selector of `getRevocationTreeRootAtHeight` function
+const GET_REVOCATION_TREE_ROOT_AT_HEIGHT_FN_SELECTOR: &str = "d6db03e8";
+// Selector of `getIssuanceTreeRootAtHeight` function
+const GET_ISSUANCE_TREE_ROOT_AT_HEIGHT_FN_SELECTOR: &str = "c8997920";
 const REVOKE_VERIFICATION_FN_SELECTOR: &str = "e711d86d";
 const CONVERT_CREDENTIAL_FN_SELECTOR: &str = "460c4841";
 
@@ -139,40 +143,18 @@ fn route(
                 None => (ExitError::Reverted.into(), encode(&[AbiToken::String("call to revokeVerification function to x/compliance failed".into())]))
             }
         }
-        GET_REVOCATION_TREE_ROOT_FN_SELECTOR => {
-            let encoded_request = coder::encode_get_revocation_tree_root_request();
-            match querier::make_request(querier, encoded_request) {
-                Some(result) => {
-                    let res: QueryRevocationTreeRootResponse = match protobuf::parse_from_bytes(&result) {
-                        Ok(response) => response,
-                        Err(_) => return (ExitError::Reverted.into(), encode(&[AbiToken::String("cannot decode protobuf response".into())]))
-                    };
-
-                    let value = U256::from_big_endian(&res.root);
-                    let tokens = vec![AbiToken::Uint(value)];
-
-                    let encoded_response = encode(&tokens);
-                    (ExitSucceed::Returned.into(), encoded_response.to_vec())
-                }
-                None => (ExitError::Reverted.into(), encode(&[AbiToken::String("call to getRevocationTreeRoot function to x/compliance failed".into())]))
+        GET_REVOCATION_TREE_ROOT_FN_SELECTOR => get_revocation_tree_root(querier, 0),
+        GET_REVOCATION_TREE_ROOT_AT_HEIGHT_FN_SELECTOR => {
+            match decode_block_height(&data[4..]) {
+                Ok(block_height) => get_revocation_tree_root(querier, block_height),
+                Err(err) => err,
             }
         }
-        GET_ISSUANCE_TREE_ROOT_FN_SELECTOR => {
-            let encoded_request = coder::encode_get_issuance_tree_root_request();
-            match querier::make_request(querier, encoded_request) {
-                Some(result) => {
-                    let res: QueryIssuanceTreeRootResponse = match protobuf::parse_from_bytes(&result) {
-                        Ok(response) => response,
-                        Err(_) => return (ExitError::Reverted.into(), encode(&[AbiToken::String("cannot decode protobuf response".into())]))
-                    };
-
-                    let value = U256::from_big_endian(&res.root);
-                    let tokens = vec![AbiToken::Uint(value)];
-
-                    let encoded_response = encode(&tokens);
-                    (ExitSucceed::Returned.into(), encoded_response.to_vec())
-                }
-                None => (ExitError::Reverted.into(), encode(&[AbiToken::String("call to getIssuanceTreeRoot function to x/compliance failed".into())]))
+        GET_ISSUANCE_TREE_ROOT_FN_SELECTOR => get_issuance_tree_root(querier, 0),
+        GET_ISSUANCE_TREE_ROOT_AT_HEIGHT_FN_SELECTOR => {
+            match decode_block_height(&data[4..]) {
+                Ok(block_height) => get_issuance_tree_root(querier, block_height),
+                Err(err) => err,
             }
         }
         HAS_VERIFICATION_FN_SELECTOR => {
@@ -643,6 +625,59 @@ fn route(
     }
 }
 
+/// Returns root of revocation tree at the end of provided block.
+/// If block height is zero, root of the current tree is returned.
+fn get_revocation_tree_root(querier: *mut GoQuerier, block_height: u64) -> (ExitResult, Vec<u8>) {
+    let encoded_request = coder::encode_get_revocation_tree_root_request(block_height);
+    match querier::make_request(querier, encoded_request) {
+        Some(result) => {
+            let res: QueryRevocationTreeRootResponse = match protobuf::parse_from_bytes(&result) {
+                Ok(response) => response,
+                Err(_) => return (ExitError::Reverted.into(), encode(&[AbiToken::String("cannot decode protobuf response".into())]))
+            };
+
+            let value = U256::from_big_endian(&res.root);
+            let tokens = vec![AbiToken::Uint(value)];
+
+            let encoded_response = encode(&tokens);
+            (ExitSucceed::Returned.into(), encoded_response.to_vec())
+        }
+        None => (ExitError::Reverted.into(), encode(&[AbiToken::String("call to getRevocationTreeRoot function to x/compliance failed".into())]))
+    }
+}
+
+/// Returns root of issuance tree at the end of provided block.
+/// If block height is zero, root of the current tree is returned.
+fn get_issuance_tree_root(querier: *mut GoQuerier, block_height: u64) -> (ExitResult, Vec<u8>) {
+    let encoded_request = coder::encode_get_issuance_tree_root_request(block_height);
+    match querier::make_request(querier, encoded_request) {
+        Some(result) => {
+            let res: QueryIssuanceTreeRootResponse = match protobuf::parse_from_bytes(&result) {
+                Ok(response) => response,
+                Err(_) => return (ExitError::Reverted.into(), encode(&[AbiToken::String("cannot decode protobuf response".into())]))
+            };
+
+            let value = U256::from_big_endian(&res.root);
+            let tokens = vec![AbiToken::Uint(value)];
+
+            let encoded_response = encode(&tokens);
+            (ExitSucceed::Returned.into(), encoded_response.to_vec())
+        }
+        None => (ExitError::Reverted.into(), encode(&[AbiToken::String("call to getIssuanceTreeRoot function to x/compliance failed".into())]))
+    }
+}
+
+/// Decodes block height passed as `uint256` argument
+fn decode_block_height(input: &[u8]) -> Result<u64, (ExitResult, Vec<u8>)> {
+    let decoded_params = decode_input(vec![ParamType::Uint(256)], input)
+        .map_err(|_| (ExitError::Reverted.into(), encode(&[AbiToken::String("failed to decode input parameters".into())])))?;
+
+    match decoded_params[0].clone().into_uint() {
+        Some(block_height) if block_height <= U256::from(u64::MAX) => Ok(block_height.as_u64()),
+        _ => Err((ExitError::Reverted.into(), encode(&[AbiToken::String("invalid block height".into())])))
+    }
+}
+
 fn decode_input(
     param_types: Vec<ParamType>,
     input: &[u8],
@@ -240,182 +240,167 @@ impl ::protobuf::reflect::ProtobufValue for AccessListItem {
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct TransactionData {
+pub struct AuthorizationListItem {
     // message fields
-    pub from: ::std::vec::Vec<u8>,
-    pub to: ::std::vec::Vec<u8>,
-    pub data: ::std::vec::Vec<u8>,
-    pub gasLimit: u64,
-    pub value: ::std::vec::Vec<u8>,
-    pub accessList: ::protobuf::RepeatedField<AccessListItem>,
+    pub chainId: ::std::vec::Vec<u8>,
+    pub address: ::std::vec::Vec<u8>,
+    pub nonce: u64,
+    pub yParity: u32,
+    pub r: ::std::vec::Vec<u8>,
+    pub s: ::std::vec::Vec<u8>,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
 }
 
-impl<'a> ::std::default::Default for &'a TransactionData {
-    fn default() -> &'a TransactionData {
-        <TransactionData as ::protobuf::Message>::default_instance()
+impl<'a> ::std::default::Default for &'a AuthorizationListItem {
+    fn default() -> &'a AuthorizationListItem {
+        <AuthorizationListItem as ::protobuf::Message>::default_instance()
     }
 }
 
-impl TransactionData {
-    pub fn new() -> TransactionData {
+impl AuthorizationListItem {
+    pub fn new() -> AuthorizationListItem {
         ::std::default::Default::default()
     }
 
-    // bytes from = 1;
+    // bytes chainId = 1;
 
 
-    pub fn get_from(&self) -> &[u8] {
-        &self.from
+    pub fn get_chainId(&self) -> &[u8] {
+        &self.chainId
     }
-    pub fn clear_from(&mut self) {
-        self.from.clear();
+    pub fn clear_chainId(&mut self) {
+        self.chainId.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_from(&mut self, v: ::std::vec::Vec<u8>) {
-        self.from = v;
+    pub fn set_chainId(&mut self, v: ::std::vec::Vec<u8>) {
+        self.chainId = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_from(&mut self) -> &mut ::std::vec::Vec<u8> {
-        &mut self.from
+    pub fn mut_chainId(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.chainId
     }
 
     // Take field
-    pub fn take_from(&mut self) -> ::std::vec::Vec<u8> {
-        ::std::mem::replace(&mut self.from, ::std::vec::Vec::new())
+    pub fn take_chainId(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.chainId, ::std::vec::Vec::new())
     }
 
-    // bytes to = 2;
+    // bytes address = 2;
 
 
-    pub fn get_to(&self) -> &[u8] {
-        &self.to
+    pub fn get_address(&self) -> &[u8] {
+        &self.address
     }
-    pub fn clear_to(&mut self) {
-        self.to.clear();
+    pub fn clear_address(&mut self) {
+        self.address.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_to(&mut self, v: ::std::vec::Vec<u8>) {
-        self.to = v;
+    pub fn set_address(&mut self, v: ::std::vec::Vec<u8>) {
+        self.address = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_to(&mut self) -> &mut ::std::vec::Vec<u8> {
-        &mut self.to
+    pub fn mut_address(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.address
     }
 
     // Take field
-    pub fn take_to(&mut self) -> ::std::vec::Vec<u8> {
-        ::std::mem::replace(&mut self.to, ::std::vec::Vec::new())
+    pub fn take_address(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.address, ::std::vec::Vec::new())
     }
 
-    // bytes data = 3;
+    // uint64 nonce = 3;
 
 
-    pub fn get_data(&self) -> &[u8] {
-        &self.data
+    pub fn get_nonce(&self) -> u64 {
+        self.nonce
     }
-    pub fn clear_data(&mut self) {
-        self.data.clear();
+    pub fn clear_nonce(&mut self) {
+        self.nonce = 0;
     }
 
     // Param is passed by value, moved
-    pub fn set_data(&mut self, v: ::std::vec::Vec<u8>) {
-        self.data = v;
-    }
-
-    // Mutable pointer to the field.
-    // If field is not initialized, it is initialized with default value first.
-    pub fn mut_data(&mut self) -> &mut ::std::vec::Vec<u8> {
-        &mut self.data
-    }
-
-    // Take field
-    pub fn take_data(&mut self) -> ::std::vec::Vec<u8> {
-        ::std::mem::replace(&mut self.data, ::std::vec::Vec::new())
+    pub fn set_nonce(&mut self, v: u64) {
+        self.nonce = v;
     }
 
-    // uint64 gasLimit = 4;
+    // uint32 yParity = 4;
 
 
-    pub fn get_gasLimit(&self) -> u64 {
-        self.gasLimit
+    pub fn get_yParity(&self) -> u32 {
+        self.yParity
     }
-    pub fn clear_gasLimit(&mut self) {
-        self.gasLimit = 0;
+    pub fn clear_yParity(&mut self) {
+        self.yParity = 0;
     }
 
     // Param is passed by value, moved
-    pub fn set_gasLimit(&mut self, v: u64) {
-        self.gasLimit = v;
+    pub fn set_yParity(&mut self, v: u32) {
+        self.yParity = v;
     }
 
-    // bytes value = 5;
+    // bytes r = 5;
 
 
-    pub fn get_value(&self) -> &[u8] {
-        &self.value
+    pub fn get_r(&self) -> &[u8] {
+        &self.r
     }
-    pub fn clear_value(&mut self) {
-        self.value.clear();
+    pub fn clear_r(&mut self) {
+        self.r.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_value(&mut self, v: ::std::vec::Vec<u8>) {
-        self.value = v;
+    pub fn set_r(&mut self, v: ::std::vec::Vec<u8>) {
+        self.r = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_value(&mut self) -> &mut ::std::vec::Vec<u8> {
-        &mut self.value
+    pub fn mut_r(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.r
     }
 
     // Take field
-    pub fn take_value(&mut self) -> ::std::vec::Vec<u8> {
-        ::std::mem::replace(&mut self.value, ::std::vec::Vec::new())
+    pub fn take_r(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.r, ::std::vec::Vec::new())
     }
 
-    // repeated .ffi.ffi.AccessListItem accessList = 6;
+    // bytes s = 6;
 
 
-    pub fn get_accessList(&self) -> &[AccessListItem] {
-        &self.accessList
+    pub fn get_s(&self) -> &[u8] {
+        &self.s
     }
-    pub fn clear_accessList(&mut self) {
-        self.accessList.clear();
+    pub fn clear_s(&mut self) {
+        self.s.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_accessList(&mut self, v: ::protobuf::RepeatedField<AccessListItem>) {
-        self.accessList = v;
+    pub fn set_s(&mut self, v: ::std::vec::Vec<u8>) {
+        self.s = v;
     }
 
     // Mutable pointer to the field.
-    pub fn mut_accessList(&mut self) -> &mut ::protobuf::RepeatedField<AccessListItem> {
-        &mut self.accessList
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_s(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.s
     }
 
     // Take field
-    pub fn take_accessList(&mut self) -> ::protobuf::RepeatedField<AccessListItem> {
-        ::std::mem::replace(&mut self.accessList, ::protobuf::RepeatedField::new())
+    pub fn take_s(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.s, ::std::vec::Vec::new())
     }
 }
 
-impl ::protobuf::Message for TransactionData {
+impl ::protobuf::Message for AuthorizationListItem {
     fn is_initialized(&self) -> bool {
-        for v in &self.accessList {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
         true
     }
 
@@ -424,26 +409,30 @@ impl ::protobuf::Message for TransactionData {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
-                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.from)?;
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.chainId)?;
                 },
                 2 => {
-                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.to)?;
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.address)?;
                 },
                 3 => {
-                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.data)?;
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.nonce = tmp;
                 },
                 4 => {
                     if wire_type != ::protobuf::wire_format::WireTypeVarint {
                         return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
                     }
-                    let tmp = is.read_uint64()?;
-                    self.gasLimit = tmp;
+                    let tmp = is.read_uint32()?;
+                    self.yParity = tmp;
                 },
                 5 => {
-                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.value)?;
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.r)?;
                 },
                 6 => {
-                    ::protobuf::rt::read_repeated_message_into(wire_type, is, &mut self.accessList)?;
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.s)?;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -457,51 +446,48 @@ impl ::protobuf::Message for TransactionData {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if !self.from.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(1, &self.from);
+        if !self.chainId.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(1, &self.chainId);
         }
-        if !self.to.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(2, &self.to);
+        if !self.address.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(2, &self.address);
         }
-        if !self.data.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(3, &self.data);
+        if self.nonce != 0 {
+            my_size += ::protobuf::rt::value_size(3, self.nonce, ::protobuf::wire_format::WireTypeVarint);
         }
-        if self.gasLimit != 0 {
-            my_size += ::protobuf::rt::value_size(4, self.gasLimit, ::protobuf::wire_format::WireTypeVarint);
+        if self.yParity != 0 {
+            my_size += ::protobuf::rt::value_size(4, self.yParity, ::protobuf::wire_format::WireTypeVarint);
         }
-        if !self.value.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(5, &self.value);
+        if !self.r.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(5, &self.r);
+        }
+        if !self.s.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(6, &self.s);
         }
-        for value in &self.accessList {
-            let len = value.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
-        };
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
         my_size
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
-        if !self.from.is_empty() {
-            os.write_bytes(1, &self.from)?;
+        if !self.chainId.is_empty() {
+            os.write_bytes(1, &self.chainId)?;
         }
-        if !self.to.is_empty() {
-            os.write_bytes(2, &self.to)?;
+        if !self.address.is_empty() {
+            os.write_bytes(2, &self.address)?;
         }
-        if !self.data.is_empty() {
-            os.write_bytes(3, &self.data)?;
+        if self.nonce != 0 {
+            os.write_uint64(3, self.nonce)?;
         }
-        if self.gasLimit != 0 {
-            os.write_uint64(4, self.gasLimit)?;
+        if self.yParity != 0 {
+            os.write_uint32(4, self.yParity)?;
         }
-        if !self.value.is_empty() {
-            os.write_bytes(5, &self.value)?;
+        if !self.r.is_empty() {
+            os.write_bytes(5, &self.r)?;
+        }
+        if !self.s.is_empty() {
+            os.write_bytes(6, &self.s)?;
         }
-        for v in &self.accessList {
-            os.write_tag(6, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
-        };
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
     }
@@ -532,8 +518,8 @@ impl ::protobuf::Message for TransactionData {
         Self::descriptor_static()
     }
 
-    fn new() -> TransactionData {
-        TransactionData::new()
+    fn new() -> AuthorizationListItem {
+        AuthorizationListItem::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -545,37 +531,37 @@ impl ::protobuf::Message for TransactionData {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
                 fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "from",
-                    |m: &TransactionData| { &m.from },
-                    |m: &mut TransactionData| { &mut m.from },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "to",
-                    |m: &TransactionData| { &m.to },
-                    |m: &mut TransactionData| { &mut m.to },
+                    "chainId",
+                    |m: &AuthorizationListItem| { &m.chainId },
+                    |m: &mut AuthorizationListItem| { &mut m.chainId },
                 ));
                 fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "data",
-                    |m: &TransactionData| { &m.data },
-                    |m: &mut TransactionData| { &mut m.data },
+                    "address",
+                    |m: &AuthorizationListItem| { &m.address },
+                    |m: &mut AuthorizationListItem| { &mut m.address },
                 ));
                 fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
-                    "gasLimit",
-                    |m: &TransactionData| { &m.gasLimit },
-                    |m: &mut TransactionData| { &mut m.gasLimit },
+                    "nonce",
+                    |m: &AuthorizationListItem| { &m.nonce },
+                    |m: &mut AuthorizationListItem| { &mut m.nonce },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint32>(
+                    "yParity",
+                    |m: &AuthorizationListItem| { &m.yParity },
+                    |m: &mut AuthorizationListItem| { &mut m.yParity },
                 ));
                 fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "value",
-                    |m: &TransactionData| { &m.value },
-                    |m: &mut TransactionData| { &mut m.value },
+                    "r",
+                    |m: &AuthorizationListItem| { &m.r },
+                    |m: &mut AuthorizationListItem| { &mut m.r },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<AccessListItem>>(
-                    "accessList",
-                    |m: &TransactionData| { &m.accessList },
-                    |m: &mut TransactionData| { &mut m.accessList },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "s",
+                    |m: &AuthorizationListItem| { &m.s },
+                    |m: &mut AuthorizationListItem| { &mut m.s },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<TransactionData>(
-                    "TransactionData",
+                ::protobuf::reflect::MessageDescriptor::new::<AuthorizationListItem>(
+                    "AuthorizationListItem",
                     fields,
                     file_descriptor_proto()
                 )
@@ -583,208 +569,218 @@ impl ::protobuf::Message for TransactionData {
         }
     }
 
-    fn default_instance() -> &'static TransactionData {
-        static mut instance: ::protobuf::lazy::Lazy<TransactionData> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static AuthorizationListItem {
+        static mut instance: ::protobuf::lazy::Lazy<AuthorizationListItem> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const TransactionData,
+            ptr: 0 as *const AuthorizationListItem,
         };
         unsafe {
-            instance.get(TransactionData::new)
+            instance.get(AuthorizationListItem::new)
         }
     }
 }
 
-impl ::protobuf::Clear for TransactionData {
+impl ::protobuf::Clear for AuthorizationListItem {
     fn clear(&mut self) {
-        self.from.clear();
-        self.to.clear();
-        self.data.clear();
-        self.gasLimit = 0;
-        self.value.clear();
-        self.accessList.clear();
+        self.chainId.clear();
+        self.address.clear();
+        self.nonce = 0;
+        self.yParity = 0;
+        self.r.clear();
+        self.s.clear();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for TransactionData {
+impl ::std::fmt::Debug for AuthorizationListItem {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for TransactionData {
+impl ::protobuf::reflect::ProtobufValue for AuthorizationListItem {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct TransactionContext {
+pub struct TransactionData {
     // message fields
-    pub chain_id: u64,
-    pub gas_price: ::std::vec::Vec<u8>,
-    pub timestamp: u64,
-    pub block_gas_limit: u64,
-    pub block_base_fee_per_gas: ::std::vec::Vec<u8>,
-    pub block_coinbase: ::std::vec::Vec<u8>,
-    pub block_number: u64,
+    pub from: ::std::vec::Vec<u8>,
+    pub to: ::std::vec::Vec<u8>,
+    pub data: ::std::vec::Vec<u8>,
+    pub gasLimit: u64,
+    pub value: ::std::vec::Vec<u8>,
+    pub accessList: ::protobuf::RepeatedField<AccessListItem>,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
 }
 
-impl<'a> ::std::default::Default for &'a TransactionContext {
-    fn default() -> &'a TransactionContext {
-        <TransactionContext as ::protobuf::Message>::default_instance()
+impl<'a> ::std::default::Default for &'a TransactionData {
+    fn default() -> &'a TransactionData {
+        <TransactionData as ::protobuf::Message>::default_instance()
     }
 }
 
-impl TransactionContext {
-    pub fn new() -> TransactionContext {
+impl TransactionData {
+    pub fn new() -> TransactionData {
         ::std::default::Default::default()
     }
 
-    // uint64 chain_id = 1;
+    // bytes from = 1;
 
 
-    pub fn get_chain_id(&self) -> u64 {
-        self.chain_id
+    pub fn get_from(&self) -> &[u8] {
+        &self.from
     }
-    pub fn clear_chain_id(&mut self) {
-        self.chain_id = 0;
+    pub fn clear_from(&mut self) {
+        self.from.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_chain_id(&mut self, v: u64) {
-        self.chain_id = v;
+    pub fn set_from(&mut self, v: ::std::vec::Vec<u8>) {
+        self.from = v;
     }
 
-    // bytes gas_price = 2;
-
-
-    pub fn get_gas_price(&self) -> &[u8] {
-        &self.gas_price
-    }
-    pub fn clear_gas_price(&mut self) {
-        self.gas_price.clear();
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_from(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.from
     }
 
-    // Param is passed by value, moved
-    pub fn set_gas_price(&mut self, v: ::std::vec::Vec<u8>) {
-        self.gas_price = v;
+    // Take field
+    pub fn take_from(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.from, ::std::vec::Vec::new())
+    }
+
+    // bytes to = 2;
+
+
+    pub fn get_to(&self) -> &[u8] {
+        &self.to
+    }
+    pub fn clear_to(&mut self) {
+        self.to.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_to(&mut self, v: ::std::vec::Vec<u8>) {
+        self.to = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_gas_price(&mut self) -> &mut ::std::vec::Vec<u8> {
-        &mut self.gas_price
+    pub fn mut_to(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.to
     }
 
     // Take field
-    pub fn take_gas_price(&mut self) -> ::std::vec::Vec<u8> {
-        ::std::mem::replace(&mut self.gas_price, ::std::vec::Vec::new())
+    pub fn take_to(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.to, ::std::vec::Vec::new())
     }
 
-    // uint64 timestamp = 3;
+    // bytes data = 3;
 
 
-    pub fn get_timestamp(&self) -> u64 {
-        self.timestamp
+    pub fn get_data(&self) -> &[u8] {
+        &self.data
     }
-    pub fn clear_timestamp(&mut self) {
-        self.timestamp = 0;
+    pub fn clear_data(&mut self) {
+        self.data.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_timestamp(&mut self, v: u64) {
-        self.timestamp = v;
+    pub fn set_data(&mut self, v: ::std::vec::Vec<u8>) {
+        self.data = v;
     }
 
-    // uint64 block_gas_limit = 4;
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_data(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.data
+    }
+
+    // Take field
+    pub fn take_data(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.data, ::std::vec::Vec::new())
+    }
 
+    // uint64 gasLimit = 4;
 
-    pub fn get_block_gas_limit(&self) -> u64 {
-        self.block_gas_limit
+
+    pub fn get_gasLimit(&self) -> u64 {
+        self.gasLimit
     }
-    pub fn clear_block_gas_limit(&mut self) {
-        self.block_gas_limit = 0;
+    pub fn clear_gasLimit(&mut self) {
+        self.gasLimit = 0;
     }
 
     // Param is passed by value, moved
-    pub fn set_block_gas_limit(&mut self, v: u64) {
-        self.block_gas_limit = v;
+    pub fn set_gasLimit(&mut self, v: u64) {
+        self.gasLimit = v;
     }
 
-    // bytes block_base_fee_per_gas = 5;
+    // bytes value = 5;
 
 
-    pub fn get_block_base_fee_per_gas(&self) -> &[u8] {
-        &self.block_base_fee_per_gas
+    pub fn get_value(&self) -> &[u8] {
+        &self.value
     }
-    pub fn clear_block_base_fee_per_gas(&mut self) {
-        self.block_base_fee_per_gas.clear();
+    pub fn clear_value(&mut self) {
+        self.value.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_block_base_fee_per_gas(&mut self, v: ::std::vec::Vec<u8>) {
-        self.block_base_fee_per_gas = v;
+    pub fn set_value(&mut self, v: ::std::vec::Vec<u8>) {
+        self.value = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_block_base_fee_per_gas(&mut self) -> &mut ::std::vec::Vec<u8> {
-        &mut self.block_base_fee_per_gas
+    pub fn mut_value(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.value
     }
 
     // Take field
-    pub fn take_block_base_fee_per_gas(&mut self) -> ::std::vec::Vec<u8> {
-        ::std::mem::replace(&mut self.block_base_fee_per_gas, ::std::vec::Vec::new())
+    pub fn take_value(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.value, ::std::vec::Vec::new())
     }
 
-    // bytes block_coinbase = 6;
+    // repeated .ffi.ffi.AccessListItem accessList = 6;
 
 
-    pub fn get_block_coinbase(&self) -> &[u8] {
-        &self.block_coinbase
+    pub fn get_accessList(&self) -> &[AccessListItem] {
+        &self.accessList
     }
-    pub fn clear_block_coinbase(&mut self) {
-        self.block_coinbase.clear();
+    pub fn clear_accessList(&mut self) {
+        self.accessList.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_block_coinbase(&mut self, v: ::std::vec::Vec<u8>) {
-        self.block_coinbase = v;
+    pub fn set_accessList(&mut self, v: ::protobuf::RepeatedField<AccessListItem>) {
+        self.accessList = v;
     }
 
     // Mutable pointer to the field.
-    // If field is not initialized, it is initialized with default value first.
-    pub fn mut_block_coinbase(&mut self) -> &mut ::std::vec::Vec<u8> {
-        &mut self.block_coinbase
+    pub fn mut_accessList(&mut self) -> &mut ::protobuf::RepeatedField<AccessListItem> {
+        &mut self.accessList
     }
 
     // Take field
-    pub fn take_block_coinbase(&mut self) -> ::std::vec::Vec<u8> {
-        ::std::mem::replace(&mut self.block_coinbase, ::std::vec::Vec::new())
-    }
-
-    // uint64 block_number = 7;
-
-
-    pub fn get_block_number(&self) -> u64 {
-        self.block_number
-    }
-    pub fn clear_block_number(&mut self) {
-        self.block_number = 0;
-    }
-
-    // Param is passed by value, moved
-    pub fn set_block_number(&mut self, v: u64) {
-        self.block_number = v;
+    pub fn take_accessList(&mut self) -> ::protobuf::RepeatedField<AccessListItem> {
+        ::std::mem::replace(&mut self.accessList, ::protobuf::RepeatedField::new())
     }
 }
 
-impl ::protobuf::Message for TransactionContext {
+impl ::protobuf::Message for TransactionData {
     fn is_initialized(&self) -> bool {
+        for v in &self.accessList {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
         true
     }
 
@@ -793,41 +789,26 @@ impl ::protobuf::Message for TransactionContext {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
-                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
-                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_uint64()?;
-                    self.chain_id = tmp;
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.from)?;
                 },
                 2 => {
-                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.gas_price)?;
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.to)?;
                 },
                 3 => {
-                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
-                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_uint64()?;
-                    self.timestamp = tmp;
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.data)?;
                 },
                 4 => {
                     if wire_type != ::protobuf::wire_format::WireTypeVarint {
                         return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
                     }
                     let tmp = is.read_uint64()?;
-                    self.block_gas_limit = tmp;
+                    self.gasLimit = tmp;
                 },
                 5 => {
-                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.block_base_fee_per_gas)?;
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.value)?;
                 },
                 6 => {
-                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.block_coinbase)?;
-                },
-                7 => {
-                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
-                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_uint64()?;
-                    self.block_number = tmp;
+                    ::protobuf::rt::read_repeated_message_into(wire_type, is, &mut self.accessList)?;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -841,54 +822,51 @@ impl ::protobuf::Message for TransactionContext {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if self.chain_id != 0 {
-            my_size += ::protobuf::rt::value_size(1, self.chain_id, ::protobuf::wire_format::WireTypeVarint);
-        }
-        if !self.gas_price.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(2, &self.gas_price);
-        }
-        if self.timestamp != 0 {
-            my_size += ::protobuf::rt::value_size(3, self.timestamp, ::protobuf::wire_format::WireTypeVarint);
+        if !self.from.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(1, &self.from);
         }
-        if self.block_gas_limit != 0 {
-            my_size += ::protobuf::rt::value_size(4, self.block_gas_limit, ::protobuf::wire_format::WireTypeVarint);
+        if !self.to.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(2, &self.to);
         }
-        if !self.block_base_fee_per_gas.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(5, &self.block_base_fee_per_gas);
+        if !self.data.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(3, &self.data);
         }
-        if !self.block_coinbase.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(6, &self.block_coinbase);
+        if self.gasLimit != 0 {
+            my_size += ::protobuf::rt::value_size(4, self.gasLimit, ::protobuf::wire_format::WireTypeVarint);
         }
-        if self.block_number != 0 {
-            my_size += ::protobuf::rt::value_size(7, self.block_number, ::protobuf::wire_format::WireTypeVarint);
+        if !self.value.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(5, &self.value);
         }
+        for value in &self.accessList {
+            let len = value.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        };
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
         my_size
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
-        if self.chain_id != 0 {
-            os.write_uint64(1, self.chain_id)?;
-        }
-        if !self.gas_price.is_empty() {
-            os.write_bytes(2, &self.gas_price)?;
-        }
-        if self.timestamp != 0 {
-            os.write_uint64(3, self.timestamp)?;
+        if !self.from.is_empty() {
+            os.write_bytes(1, &self.from)?;
         }
-        if self.block_gas_limit != 0 {
-            os.write_uint64(4, self.block_gas_limit)?;
+        if !self.to.is_empty() {
+            os.write_bytes(2, &self.to)?;
         }
-        if !self.block_base_fee_per_gas.is_empty() {
-            os.write_bytes(5, &self.block_base_fee_per_gas)?;
+        if !self.data.is_empty() {
+            os.write_bytes(3, &self.data)?;
         }
-        if !self.block_coinbase.is_empty() {
-            os.write_bytes(6, &self.block_coinbase)?;
+        if self.gasLimit != 0 {
+            os.write_uint64(4, self.gasLimit)?;
         }
-        if self.block_number != 0 {
-            os.write_uint64(7, self.block_number)?;
+        if !self.value.is_empty() {
+            os.write_bytes(5, &self.value)?;
         }
+        for v in &self.accessList {
+            os.write_tag(6, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        };
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
     }
@@ -919,8 +897,8 @@ impl ::protobuf::Message for TransactionContext {
         Self::descriptor_static()
     }
 
-    fn new() -> TransactionContext {
-        TransactionContext::new()
+    fn new() -> TransactionData {
+        TransactionData::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -931,43 +909,38 @@ impl ::protobuf::Message for TransactionContext {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
-                    "chain_id",
-                    |m: &TransactionContext| { &m.chain_id },
-                    |m: &mut TransactionContext| { &mut m.chain_id },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "from",
+                    |m: &TransactionData| { &m.from },
+                    |m: &mut TransactionData| { &mut m.from },
                 ));
                 fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "gas_price",
-                    |m: &TransactionContext| { &m.gas_price },
-                    |m: &mut TransactionContext| { &mut m.gas_price },
+                    "to",
+                    |m: &TransactionData| { &m.to },
+                    |m: &mut TransactionData| { &mut m.to },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
-                    "timestamp",
-                    |m: &TransactionContext| { &m.timestamp },
-                    |m: &mut TransactionContext| { &mut m.timestamp },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "data",
+                    |m: &TransactionData| { &m.data },
+                    |m: &mut TransactionData| { &mut m.data },
                 ));
                 fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
-                    "block_gas_limit",
-                    |m: &TransactionContext| { &m.block_gas_limit },
-                    |m: &mut TransactionContext| { &mut m.block_gas_limit },
+                    "gasLimit",
+                    |m: &TransactionData| { &m.gasLimit },
+                    |m: &mut TransactionData| { &mut m.gasLimit },
                 ));
                 fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "block_base_fee_per_gas",
-                    |m: &TransactionContext| { &m.block_base_fee_per_gas },
-                    |m: &mut TransactionContext| { &mut m.block_base_fee_per_gas },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "block_coinbase",
-                    |m: &TransactionContext| { &m.block_coinbase },
-                    |m: &mut TransactionContext| { &mut m.block_coinbase },
+                    "value",
+                    |m: &TransactionData| { &m.value },
+                    |m: &mut TransactionData| { &mut m.value },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
-                    "block_number",
-                    |m: &TransactionContext| { &m.block_number },
-                    |m: &mut TransactionContext| { &mut m.block_number },
+                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<AccessListItem>>(
+                    "accessList",
+                    |m: &TransactionData| { &m.accessList },
+                    |m: &mut TransactionData| { &mut m.accessList },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<TransactionContext>(
-                    "TransactionContext",
+                ::protobuf::reflect::MessageDescriptor::new::<TransactionData>(
+                    "TransactionData",
                     fields,
                     file_descriptor_proto()
                 )
@@ -975,142 +948,208 @@ impl ::protobuf::Message for TransactionContext {
         }
     }
 
-    fn default_instance() -> &'static TransactionContext {
-        static mut instance: ::protobuf::lazy::Lazy<TransactionContext> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static TransactionData {
+        static mut instance: ::protobuf::lazy::Lazy<TransactionData> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const TransactionContext,
+            ptr: 0 as *const TransactionData,
         };
         unsafe {
-            instance.get(TransactionContext::new)
+            instance.get(TransactionData::new)
         }
     }
 }
 
-impl ::protobuf::Clear for TransactionContext {
+impl ::protobuf::Clear for TransactionData {
     fn clear(&mut self) {
-        self.chain_id = 0;
-        self.gas_price.clear();
-        self.timestamp = 0;
-        self.block_gas_limit = 0;
-        self.block_base_fee_per_gas.clear();
-        self.block_coinbase.clear();
-        self.block_number = 0;
+        self.from.clear();
+        self.to.clear();
+        self.data.clear();
+        self.gasLimit = 0;
+        self.value.clear();
+        self.accessList.clear();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for TransactionContext {
+impl ::std::fmt::Debug for TransactionData {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for TransactionContext {
+impl ::protobuf::reflect::ProtobufValue for TransactionData {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct HandleTransactionRequest {
+pub struct TransactionContext {
     // message fields
-    pub tx_data: ::protobuf::SingularPtrField<TransactionData>,
-    pub tx_context: ::protobuf::SingularPtrField<TransactionContext>,
+    pub chain_id: u64,
+    pub gas_price: ::std::vec::Vec<u8>,
+    pub timestamp: u64,
+    pub block_gas_limit: u64,
+    pub block_base_fee_per_gas: ::std::vec::Vec<u8>,
+    pub block_coinbase: ::std::vec::Vec<u8>,
+    pub block_number: u64,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
 }
 
-impl<'a> ::std::default::Default for &'a HandleTransactionRequest {
-    fn default() -> &'a HandleTransactionRequest {
-        <HandleTransactionRequest as ::protobuf::Message>::default_instance()
+impl<'a> ::std::default::Default for &'a TransactionContext {
+    fn default() -> &'a TransactionContext {
+        <TransactionContext as ::protobuf::Message>::default_instance()
     }
 }
 
-impl HandleTransactionRequest {
-    pub fn new() -> HandleTransactionRequest {
+impl TransactionContext {
+    pub fn new() -> TransactionContext {
         ::std::default::Default::default()
     }
 
-    // .ffi.ffi.TransactionData tx_data = 1;
+    // uint64 chain_id = 1;
 
 
-    pub fn get_tx_data(&self) -> &TransactionData {
-        self.tx_data.as_ref().unwrap_or_else(|| TransactionData::default_instance())
+    pub fn get_chain_id(&self) -> u64 {
+        self.chain_id
     }
-    pub fn clear_tx_data(&mut self) {
-        self.tx_data.clear();
+    pub fn clear_chain_id(&mut self) {
+        self.chain_id = 0;
     }
 
-    pub fn has_tx_data(&self) -> bool {
-        self.tx_data.is_some()
+    // Param is passed by value, moved
+    pub fn set_chain_id(&mut self, v: u64) {
+        self.chain_id = v;
+    }
+
+    // bytes gas_price = 2;
+
+
+    pub fn get_gas_price(&self) -> &[u8] {
+        &self.gas_price
+    }
+    pub fn clear_gas_price(&mut self) {
+        self.gas_price.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_tx_data(&mut self, v: TransactionData) {
-        self.tx_data = ::protobuf::SingularPtrField::some(v);
+    pub fn set_gas_price(&mut self, v: ::std::vec::Vec<u8>) {
+        self.gas_price = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_tx_data(&mut self) -> &mut TransactionData {
-        if self.tx_data.is_none() {
-            self.tx_data.set_default();
-        }
-        self.tx_data.as_mut().unwrap()
+    pub fn mut_gas_price(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.gas_price
     }
 
     // Take field
-    pub fn take_tx_data(&mut self) -> TransactionData {
-        self.tx_data.take().unwrap_or_else(|| TransactionData::new())
+    pub fn take_gas_price(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.gas_price, ::std::vec::Vec::new())
     }
 
-    // .ffi.ffi.TransactionContext tx_context = 2;
+    // uint64 timestamp = 3;
 
 
-    pub fn get_tx_context(&self) -> &TransactionContext {
-        self.tx_context.as_ref().unwrap_or_else(|| TransactionContext::default_instance())
+    pub fn get_timestamp(&self) -> u64 {
+        self.timestamp
     }
-    pub fn clear_tx_context(&mut self) {
-        self.tx_context.clear();
+    pub fn clear_timestamp(&mut self) {
+        self.timestamp = 0;
     }
 
-    pub fn has_tx_context(&self) -> bool {
-        self.tx_context.is_some()
+    // Param is passed by value, moved
+    pub fn set_timestamp(&mut self, v: u64) {
+        self.timestamp = v;
+    }
+
+    // uint64 block_gas_limit = 4;
+
+
+    pub fn get_block_gas_limit(&self) -> u64 {
+        self.block_gas_limit
+    }
+    pub fn clear_block_gas_limit(&mut self) {
+        self.block_gas_limit = 0;
     }
 
     // Param is passed by value, moved
-    pub fn set_tx_context(&mut self, v: TransactionContext) {
-        self.tx_context = ::protobuf::SingularPtrField::some(v);
+    pub fn set_block_gas_limit(&mut self, v: u64) {
+        self.block_gas_limit = v;
+    }
+
+    // bytes block_base_fee_per_gas = 5;
+
+
+    pub fn get_block_base_fee_per_gas(&self) -> &[u8] {
+        &self.block_base_fee_per_gas
+    }
+    pub fn clear_block_base_fee_per_gas(&mut self) {
+        self.block_base_fee_per_gas.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_block_base_fee_per_gas(&mut self, v: ::std::vec::Vec<u8>) {
+        self.block_base_fee_per_gas = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_tx_context(&mut self) -> &mut TransactionContext {
-        if self.tx_context.is_none() {
-            self.tx_context.set_default();
-        }
-        self.tx_context.as_mut().unwrap()
+    pub fn mut_block_base_fee_per_gas(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.block_base_fee_per_gas
     }
 
     // Take field
-    pub fn take_tx_context(&mut self) -> TransactionContext {
-        self.tx_context.take().unwrap_or_else(|| TransactionContext::new())
+    pub fn take_block_base_fee_per_gas(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.block_base_fee_per_gas, ::std::vec::Vec::new())
+    }
+
+    // bytes block_coinbase = 6;
+
+
+    pub fn get_block_coinbase(&self) -> &[u8] {
+        &self.block_coinbase
+    }
+    pub fn clear_block_coinbase(&mut self) {
+        self.block_coinbase.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_block_coinbase(&mut self, v: ::std::vec::Vec<u8>) {
+        self.block_coinbase = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_block_coinbase(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.block_coinbase
+    }
+
+    // Take field
+    pub fn take_block_coinbase(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.block_coinbase, ::std::vec::Vec::new())
+    }
+
+    // uint64 block_number = 7;
+
+
+    pub fn get_block_number(&self) -> u64 {
+        self.block_number
+    }
+    pub fn clear_block_number(&mut self) {
+        self.block_number = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_block_number(&mut self, v: u64) {
+        self.block_number = v;
     }
 }
 
-impl ::protobuf::Message for HandleTransactionRequest {
+impl ::protobuf::Message for TransactionContext {
     fn is_initialized(&self) -> bool {
-        for v in &self.tx_data {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
-        for v in &self.tx_context {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
         true
     }
 
@@ -1119,10 +1158,41 @@ impl ::protobuf::Message for HandleTransactionRequest {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.tx_data)?;
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.chain_id = tmp;
                 },
                 2 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.tx_context)?;
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.gas_price)?;
+                },
+                3 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.timestamp = tmp;
+                },
+                4 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.block_gas_limit = tmp;
+                },
+                5 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.block_base_fee_per_gas)?;
+                },
+                6 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.block_coinbase)?;
+                },
+                7 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.block_number = tmp;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -1136,13 +1206,26 @@ impl ::protobuf::Message for HandleTransactionRequest {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if let Some(ref v) = self.tx_data.as_ref() {
-            let len = v.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        if self.chain_id != 0 {
+            my_size += ::protobuf::rt::value_size(1, self.chain_id, ::protobuf::wire_format::WireTypeVarint);
         }
-        if let Some(ref v) = self.tx_context.as_ref() {
-            let len = v.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        if !self.gas_price.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(2, &self.gas_price);
+        }
+        if self.timestamp != 0 {
+            my_size += ::protobuf::rt::value_size(3, self.timestamp, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if self.block_gas_limit != 0 {
+            my_size += ::protobuf::rt::value_size(4, self.block_gas_limit, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if !self.block_base_fee_per_gas.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(5, &self.block_base_fee_per_gas);
+        }
+        if !self.block_coinbase.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(6, &self.block_coinbase);
+        }
+        if self.block_number != 0 {
+            my_size += ::protobuf::rt::value_size(7, self.block_number, ::protobuf::wire_format::WireTypeVarint);
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -1150,15 +1233,26 @@ impl ::protobuf::Message for HandleTransactionRequest {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
-        if let Some(ref v) = self.tx_data.as_ref() {
-            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
+        if self.chain_id != 0 {
+            os.write_uint64(1, self.chain_id)?;
         }
-        if let Some(ref v) = self.tx_context.as_ref() {
-            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
+        if !self.gas_price.is_empty() {
+            os.write_bytes(2, &self.gas_price)?;
+        }
+        if self.timestamp != 0 {
+            os.write_uint64(3, self.timestamp)?;
+        }
+        if self.block_gas_limit != 0 {
+            os.write_uint64(4, self.block_gas_limit)?;
+        }
+        if !self.block_base_fee_per_gas.is_empty() {
+            os.write_bytes(5, &self.block_base_fee_per_gas)?;
+        }
+        if !self.block_coinbase.is_empty() {
+            os.write_bytes(6, &self.block_coinbase)?;
+        }
+        if self.block_number != 0 {
+            os.write_uint64(7, self.block_number)?;
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -1190,8 +1284,8 @@ impl ::protobuf::Message for HandleTransactionRequest {
         Self::descriptor_static()
     }
 
-    fn new() -> HandleTransactionRequest {
-        HandleTransactionRequest::new()
+    fn new() -> TransactionContext {
+        TransactionContext::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -1202,18 +1296,43 @@ impl ::protobuf::Message for HandleTransactionRequest {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionData>>(
-                    "tx_data",
-                    |m: &HandleTransactionRequest| { &m.tx_data },
-                    |m: &mut HandleTransactionRequest| { &mut m.tx_data },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "chain_id",
+                    |m: &TransactionContext| { &m.chain_id },
+                    |m: &mut TransactionContext| { &mut m.chain_id },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionContext>>(
-                    "tx_context",
-                    |m: &HandleTransactionRequest| { &m.tx_context },
-                    |m: &mut HandleTransactionRequest| { &mut m.tx_context },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "gas_price",
+                    |m: &TransactionContext| { &m.gas_price },
+                    |m: &mut TransactionContext| { &mut m.gas_price },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<HandleTransactionRequest>(
-                    "HandleTransactionRequest",
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "timestamp",
+                    |m: &TransactionContext| { &m.timestamp },
+                    |m: &mut TransactionContext| { &mut m.timestamp },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "block_gas_limit",
+                    |m: &TransactionContext| { &m.block_gas_limit },
+                    |m: &mut TransactionContext| { &mut m.block_gas_limit },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "block_base_fee_per_gas",
+                    |m: &TransactionContext| { &m.block_base_fee_per_gas },
+                    |m: &mut TransactionContext| { &mut m.block_base_fee_per_gas },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "block_coinbase",
+                    |m: &TransactionContext| { &m.block_coinbase },
+                    |m: &mut TransactionContext| { &mut m.block_coinbase },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "block_number",
+                    |m: &TransactionContext| { &m.block_number },
+                    |m: &mut TransactionContext| { &mut m.block_number },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<TransactionContext>(
+                    "TransactionContext",
                     fields,
                     file_descriptor_proto()
                 )
@@ -1221,156 +1340,138 @@ impl ::protobuf::Message for HandleTransactionRequest {
         }
     }
 
-    fn default_instance() -> &'static HandleTransactionRequest {
-        static mut instance: ::protobuf::lazy::Lazy<HandleTransactionRequest> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static TransactionContext {
+        static mut instance: ::protobuf::lazy::Lazy<TransactionContext> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const HandleTransactionRequest,
+            ptr: 0 as *const TransactionContext,
         };
         unsafe {
-            instance.get(HandleTransactionRequest::new)
+            instance.get(TransactionContext::new)
         }
     }
 }
 
-impl ::protobuf::Clear for HandleTransactionRequest {
+impl ::protobuf::Clear for TransactionContext {
     fn clear(&mut self) {
-        self.tx_data.clear();
-        self.tx_context.clear();
+        self.chain_id = 0;
+        self.gas_price.clear();
+        self.timestamp = 0;
+        self.block_gas_limit = 0;
+        self.block_base_fee_per_gas.clear();
+        self.block_coinbase.clear();
+        self.block_number = 0;
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for HandleTransactionRequest {
+impl ::std::fmt::Debug for TransactionContext {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for HandleTransactionRequest {
+impl ::protobuf::reflect::ProtobufValue for TransactionContext {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct HandleTransactionResponse {
+pub struct HandleTransactionRequest {
     // message fields
-    pub logs: ::protobuf::RepeatedField<Log>,
-    pub ret: ::std::vec::Vec<u8>,
-    pub vm_error: ::std::string::String,
-    pub gas_used: u64,
+    pub tx_data: ::protobuf::SingularPtrField<TransactionData>,
+    pub tx_context: ::protobuf::SingularPtrField<TransactionContext>,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
 }
 
-impl<'a> ::std::default::Default for &'a HandleTransactionResponse {
-    fn default() -> &'a HandleTransactionResponse {
-        <HandleTransactionResponse as ::protobuf::Message>::default_instance()
+impl<'a> ::std::default::Default for &'a HandleTransactionRequest {
+    fn default() -> &'a HandleTransactionRequest {
+        <HandleTransactionRequest as ::protobuf::Message>::default_instance()
     }
 }
 
-impl HandleTransactionResponse {
-    pub fn new() -> HandleTransactionResponse {
+impl HandleTransactionRequest {
+    pub fn new() -> HandleTransactionRequest {
         ::std::default::Default::default()
     }
 
-    // repeated .ffi.ffi.Log logs = 2;
-
-
-    pub fn get_logs(&self) -> &[Log] {
-        &self.logs
-    }
-    pub fn clear_logs(&mut self) {
-        self.logs.clear();
-    }
+    // .ffi.ffi.TransactionData tx_data = 1;
 
-    // Param is passed by value, moved
-    pub fn set_logs(&mut self, v: ::protobuf::RepeatedField<Log>) {
-        self.logs = v;
-    }
 
-    // Mutable pointer to the field.
-    pub fn mut_logs(&mut self) -> &mut ::protobuf::RepeatedField<Log> {
-        &mut self.logs
+    pub fn get_tx_data(&self) -> &TransactionData {
+        self.tx_data.as_ref().unwrap_or_else(|| TransactionData::default_instance())
     }
-
-    // Take field
-    pub fn take_logs(&mut self) -> ::protobuf::RepeatedField<Log> {
-        ::std::mem::replace(&mut self.logs, ::protobuf::RepeatedField::new())
+    pub fn clear_tx_data(&mut self) {
+        self.tx_data.clear();
     }
 
-    // bytes ret = 3;
-
-
-    pub fn get_ret(&self) -> &[u8] {
-        &self.ret
-    }
-    pub fn clear_ret(&mut self) {
-        self.ret.clear();
+    pub fn has_tx_data(&self) -> bool {
+        self.tx_data.is_some()
     }
 
     // Param is passed by value, moved
-    pub fn set_ret(&mut self, v: ::std::vec::Vec<u8>) {
-        self.ret = v;
+    pub fn set_tx_data(&mut self, v: TransactionData) {
+        self.tx_data = ::protobuf::SingularPtrField::some(v);
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_ret(&mut self) -> &mut ::std::vec::Vec<u8> {
-        &mut self.ret
+    pub fn mut_tx_data(&mut self) -> &mut TransactionData {
+        if self.tx_data.is_none() {
+            self.tx_data.set_default();
+        }
+        self.tx_data.as_mut().unwrap()
     }
 
     // Take field
-    pub fn take_ret(&mut self) -> ::std::vec::Vec<u8> {
-        ::std::mem::replace(&mut self.ret, ::std::vec::Vec::new())
+    pub fn take_tx_data(&mut self) -> TransactionData {
+        self.tx_data.take().unwrap_or_else(|| TransactionData::new())
     }
 
-    // string vm_error = 4;
+    // .ffi.ffi.TransactionContext tx_context = 2;
 
 
-    pub fn get_vm_error(&self) -> &str {
-        &self.vm_error
+    pub fn get_tx_context(&self) -> &TransactionContext {
+        self.tx_context.as_ref().unwrap_or_else(|| TransactionContext::default_instance())
     }
-    pub fn clear_vm_error(&mut self) {
-        self.vm_error.clear();
+    pub fn clear_tx_context(&mut self) {
+        self.tx_context.clear();
+    }
+
+    pub fn has_tx_context(&self) -> bool {
+        self.tx_context.is_some()
     }
 
     // Param is passed by value, moved
-    pub fn set_vm_error(&mut self, v: ::std::string::String) {
-        self.vm_error = v;
+    pub fn set_tx_context(&mut self, v: TransactionContext) {
+        self.tx_context = ::protobuf::SingularPtrField::some(v);
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_vm_error(&mut self) -> &mut ::std::string::String {
-        &mut self.vm_error
+    pub fn mut_tx_context(&mut self) -> &mut TransactionContext {
+        if self.tx_context.is_none() {
+            self.tx_context.set_default();
+        }
+        self.tx_context.as_mut().unwrap()
     }
 
     // Take field
-    pub fn take_vm_error(&mut self) -> ::std::string::String {
-        ::std::mem::replace(&mut self.vm_error, ::std::string::String::new())
-    }
-
-    // uint64 gas_used = 5;
-
-
-    pub fn get_gas_used(&self) -> u64 {
-        self.gas_used
-    }
-    pub fn clear_gas_used(&mut self) {
-        self.gas_used = 0;
-    }
-
-    // Param is passed by value, moved
-    pub fn set_gas_used(&mut self, v: u64) {
-        self.gas_used = v;
+    pub fn take_tx_context(&mut self) -> TransactionContext {
+        self.tx_context.take().unwrap_or_else(|| TransactionContext::new())
     }
 }
 
-impl ::protobuf::Message for HandleTransactionResponse {
+impl ::protobuf::Message for HandleTransactionRequest {
     fn is_initialized(&self) -> bool {
-        for v in &self.logs {
+        for v in &self.tx_data {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        for v in &self.tx_context {
             if !v.is_initialized() {
                 return false;
             }
@@ -1382,24 +1483,14 @@ impl ::protobuf::Message for HandleTransactionResponse {
         while !is.eof()? {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
-                2 => {
-                    ::protobuf::rt::read_repeated_message_into(wire_type, is, &mut self.logs)?;
+                1 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.tx_data)?;
                 },
-                3 => {
-                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.ret)?;
+                2 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.tx_context)?;
                 },
-                4 => {
-                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.vm_error)?;
-                },
-                5 => {
-                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
-                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_uint64()?;
-                    self.gas_used = tmp;
-                },
-                _ => {
-                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
                 },
             };
         }
@@ -1410,18 +1501,13 @@ impl ::protobuf::Message for HandleTransactionResponse {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        for value in &self.logs {
-            let len = value.compute_size();
+        if let Some(ref v) = self.tx_data.as_ref() {
+            let len = v.compute_size();
             my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
-        };
-        if !self.ret.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(3, &self.ret);
-        }
-        if !self.vm_error.is_empty() {
-            my_size += ::protobuf::rt::string_size(4, &self.vm_error);
         }
-        if self.gas_used != 0 {
-            my_size += ::protobuf::rt::value_size(5, self.gas_used, ::protobuf::wire_format::WireTypeVarint);
+        if let Some(ref v) = self.tx_context.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -1429,19 +1515,15 @@ impl ::protobuf::Message for HandleTransactionResponse {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
-        for v in &self.logs {
-            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+        if let Some(ref v) = self.tx_data.as_ref() {
+            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
             os.write_raw_varint32(v.get_cached_size())?;
             v.write_to_with_cached_sizes(os)?;
-        };
-        if !self.ret.is_empty() {
-            os.write_bytes(3, &self.ret)?;
         }
-        if !self.vm_error.is_empty() {
-            os.write_string(4, &self.vm_error)?;
-        }
-        if self.gas_used != 0 {
-            os.write_uint64(5, self.gas_used)?;
+        if let Some(ref v) = self.tx_context.as_ref() {
+            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -1473,8 +1555,8 @@ impl ::protobuf::Message for HandleTransactionResponse {
         Self::descriptor_static()
     }
 
-    fn new() -> HandleTransactionResponse {
-        HandleTransactionResponse::new()
+    fn new() -> HandleTransactionRequest {
+        HandleTransactionRequest::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -1485,28 +1567,18 @@ impl ::protobuf::Message for HandleTransactionResponse {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<Log>>(
-                    "logs",
-                    |m: &HandleTransactionResponse| { &m.logs },
-                    |m: &mut HandleTransactionResponse| { &mut m.logs },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "ret",
-                    |m: &HandleTransactionResponse| { &m.ret },
-                    |m: &mut HandleTransactionResponse| { &mut m.ret },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
-                    "vm_error",
-                    |m: &HandleTransactionResponse| { &m.vm_error },
-                    |m: &mut HandleTransactionResponse| { &mut m.vm_error },
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionData>>(
+                    "tx_data",
+                    |m: &HandleTransactionRequest| { &m.tx_data },
+                    |m: &mut HandleTransactionRequest| { &mut m.tx_data },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
-                    "gas_used",
-                    |m: &HandleTransactionResponse| { &m.gas_used },
-                    |m: &mut HandleTransactionResponse| { &mut m.gas_used },
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionContext>>(
+                    "tx_context",
+                    |m: &HandleTransactionRequest| { &m.tx_context },
+                    |m: &mut HandleTransactionRequest| { &mut m.tx_context },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<HandleTransactionResponse>(
-                    "HandleTransactionResponse",
+                ::protobuf::reflect::MessageDescriptor::new::<HandleTransactionRequest>(
+                    "HandleTransactionRequest",
                     fields,
                     file_descriptor_proto()
                 )
@@ -1514,135 +1586,211 @@ impl ::protobuf::Message for HandleTransactionResponse {
         }
     }
 
-    fn default_instance() -> &'static HandleTransactionResponse {
-        static mut instance: ::protobuf::lazy::Lazy<HandleTransactionResponse> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static HandleTransactionRequest {
+        static mut instance: ::protobuf::lazy::Lazy<HandleTransactionRequest> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const HandleTransactionResponse,
+            ptr: 0 as *const HandleTransactionRequest,
         };
         unsafe {
-            instance.get(HandleTransactionResponse::new)
+            instance.get(HandleTransactionRequest::new)
         }
     }
 }
 
-impl ::protobuf::Clear for HandleTransactionResponse {
+impl ::protobuf::Clear for HandleTransactionRequest {
     fn clear(&mut self) {
-        self.logs.clear();
-        self.ret.clear();
-        self.vm_error.clear();
-        self.gas_used = 0;
+        self.tx_data.clear();
+        self.tx_context.clear();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for HandleTransactionResponse {
+impl ::std::fmt::Debug for HandleTransactionRequest {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for HandleTransactionResponse {
+impl ::protobuf::reflect::ProtobufValue for HandleTransactionRequest {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct HandleEstimateGasRequest {
+pub struct HandleTransactionResponse {
     // message fields
-    pub tx_data: ::protobuf::SingularPtrField<TransactionData>,
-    pub tx_context: ::protobuf::SingularPtrField<TransactionContext>,
+    pub logs: ::protobuf::RepeatedField<Log>,
+    pub ret: ::std::vec::Vec<u8>,
+    pub vm_error: ::std::string::String,
+    pub gas_used: u64,
+    pub trace: ::protobuf::SingularPtrField<ExecutionTrace>,
+    pub gas_refund: u64,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
 }
 
-impl<'a> ::std::default::Default for &'a HandleEstimateGasRequest {
-    fn default() -> &'a HandleEstimateGasRequest {
-        <HandleEstimateGasRequest as ::protobuf::Message>::default_instance()
+impl<'a> ::std::default::Default for &'a HandleTransactionResponse {
+    fn default() -> &'a HandleTransactionResponse {
+        <HandleTransactionResponse as ::protobuf::Message>::default_instance()
     }
 }
 
-impl HandleEstimateGasRequest {
-    pub fn new() -> HandleEstimateGasRequest {
+impl HandleTransactionResponse {
+    pub fn new() -> HandleTransactionResponse {
         ::std::default::Default::default()
     }
 
-    // .ffi.ffi.TransactionData tx_data = 1;
+    // repeated .ffi.ffi.Log logs = 2;
 
 
-    pub fn get_tx_data(&self) -> &TransactionData {
-        self.tx_data.as_ref().unwrap_or_else(|| TransactionData::default_instance())
+    pub fn get_logs(&self) -> &[Log] {
+        &self.logs
     }
-    pub fn clear_tx_data(&mut self) {
-        self.tx_data.clear();
+    pub fn clear_logs(&mut self) {
+        self.logs.clear();
     }
 
-    pub fn has_tx_data(&self) -> bool {
-        self.tx_data.is_some()
+    // Param is passed by value, moved
+    pub fn set_logs(&mut self, v: ::protobuf::RepeatedField<Log>) {
+        self.logs = v;
+    }
+
+    // Mutable pointer to the field.
+    pub fn mut_logs(&mut self) -> &mut ::protobuf::RepeatedField<Log> {
+        &mut self.logs
+    }
+
+    // Take field
+    pub fn take_logs(&mut self) -> ::protobuf::RepeatedField<Log> {
+        ::std::mem::replace(&mut self.logs, ::protobuf::RepeatedField::new())
+    }
+
+    // bytes ret = 3;
+
+
+    pub fn get_ret(&self) -> &[u8] {
+        &self.ret
+    }
+    pub fn clear_ret(&mut self) {
+        self.ret.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_tx_data(&mut self, v: TransactionData) {
-        self.tx_data = ::protobuf::SingularPtrField::some(v);
+    pub fn set_ret(&mut self, v: ::std::vec::Vec<u8>) {
+        self.ret = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_tx_data(&mut self) -> &mut TransactionData {
-        if self.tx_data.is_none() {
-            self.tx_data.set_default();
-        }
-        self.tx_data.as_mut().unwrap()
+    pub fn mut_ret(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.ret
     }
 
     // Take field
-    pub fn take_tx_data(&mut self) -> TransactionData {
-        self.tx_data.take().unwrap_or_else(|| TransactionData::new())
+    pub fn take_ret(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.ret, ::std::vec::Vec::new())
     }
 
-    // .ffi.ffi.TransactionContext tx_context = 2;
+    // string vm_error = 4;
 
 
-    pub fn get_tx_context(&self) -> &TransactionContext {
-        self.tx_context.as_ref().unwrap_or_else(|| TransactionContext::default_instance())
+    pub fn get_vm_error(&self) -> &str {
+        &self.vm_error
     }
-    pub fn clear_tx_context(&mut self) {
-        self.tx_context.clear();
+    pub fn clear_vm_error(&mut self) {
+        self.vm_error.clear();
     }
 
-    pub fn has_tx_context(&self) -> bool {
-        self.tx_context.is_some()
+    // Param is passed by value, moved
+    pub fn set_vm_error(&mut self, v: ::std::string::String) {
+        self.vm_error = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_vm_error(&mut self) -> &mut ::std::string::String {
+        &mut self.vm_error
+    }
+
+    // Take field
+    pub fn take_vm_error(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.vm_error, ::std::string::String::new())
+    }
+
+    // uint64 gas_used = 5;
+
+
+    pub fn get_gas_used(&self) -> u64 {
+        self.gas_used
+    }
+    pub fn clear_gas_used(&mut self) {
+        self.gas_used = 0;
     }
 
     // Param is passed by value, moved
-    pub fn set_tx_context(&mut self, v: TransactionContext) {
-        self.tx_context = ::protobuf::SingularPtrField::some(v);
+    pub fn set_gas_used(&mut self, v: u64) {
+        self.gas_used = v;
+    }
+
+    // .ffi.ffi.ExecutionTrace trace = 6;
+
+
+    pub fn get_trace(&self) -> &ExecutionTrace {
+        self.trace.as_ref().unwrap_or_else(|| ExecutionTrace::default_instance())
+    }
+    pub fn clear_trace(&mut self) {
+        self.trace.clear();
+    }
+
+    pub fn has_trace(&self) -> bool {
+        self.trace.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_trace(&mut self, v: ExecutionTrace) {
+        self.trace = ::protobuf::SingularPtrField::some(v);
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_tx_context(&mut self) -> &mut TransactionContext {
-        if self.tx_context.is_none() {
-            self.tx_context.set_default();
+    pub fn mut_trace(&mut self) -> &mut ExecutionTrace {
+        if self.trace.is_none() {
+            self.trace.set_default();
         }
-        self.tx_context.as_mut().unwrap()
+        self.trace.as_mut().unwrap()
     }
 
     // Take field
-    pub fn take_tx_context(&mut self) -> TransactionContext {
-        self.tx_context.take().unwrap_or_else(|| TransactionContext::new())
+    pub fn take_trace(&mut self) -> ExecutionTrace {
+        self.trace.take().unwrap_or_else(|| ExecutionTrace::new())
+    }
+
+    // uint64 gas_refund = 7;
+
+
+    pub fn get_gas_refund(&self) -> u64 {
+        self.gas_refund
+    }
+    pub fn clear_gas_refund(&mut self) {
+        self.gas_refund = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_gas_refund(&mut self, v: u64) {
+        self.gas_refund = v;
     }
 }
 
-impl ::protobuf::Message for HandleEstimateGasRequest {
+impl ::protobuf::Message for HandleTransactionResponse {
     fn is_initialized(&self) -> bool {
-        for v in &self.tx_data {
+        for v in &self.logs {
             if !v.is_initialized() {
                 return false;
             }
         };
-        for v in &self.tx_context {
+        for v in &self.trace {
             if !v.is_initialized() {
                 return false;
             }
@@ -1654,11 +1802,31 @@ impl ::protobuf::Message for HandleEstimateGasRequest {
         while !is.eof()? {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
-                1 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.tx_data)?;
-                },
                 2 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.tx_context)?;
+                    ::protobuf::rt::read_repeated_message_into(wire_type, is, &mut self.logs)?;
+                },
+                3 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.ret)?;
+                },
+                4 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.vm_error)?;
+                },
+                5 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.gas_used = tmp;
+                },
+                6 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.trace)?;
+                },
+                7 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.gas_refund = tmp;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -1672,30 +1840,54 @@ impl ::protobuf::Message for HandleEstimateGasRequest {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if let Some(ref v) = self.tx_data.as_ref() {
-            let len = v.compute_size();
+        for value in &self.logs {
+            let len = value.compute_size();
             my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        };
+        if !self.ret.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(3, &self.ret);
         }
-        if let Some(ref v) = self.tx_context.as_ref() {
+        if !self.vm_error.is_empty() {
+            my_size += ::protobuf::rt::string_size(4, &self.vm_error);
+        }
+        if self.gas_used != 0 {
+            my_size += ::protobuf::rt::value_size(5, self.gas_used, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if let Some(ref v) = self.trace.as_ref() {
             let len = v.compute_size();
             my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
         }
+        if self.gas_refund != 0 {
+            my_size += ::protobuf::rt::value_size(7, self.gas_refund, ::protobuf::wire_format::WireTypeVarint);
+        }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
         my_size
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
-        if let Some(ref v) = self.tx_data.as_ref() {
-            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+        for v in &self.logs {
+            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
             os.write_raw_varint32(v.get_cached_size())?;
             v.write_to_with_cached_sizes(os)?;
+        };
+        if !self.ret.is_empty() {
+            os.write_bytes(3, &self.ret)?;
         }
-        if let Some(ref v) = self.tx_context.as_ref() {
-            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+        if !self.vm_error.is_empty() {
+            os.write_string(4, &self.vm_error)?;
+        }
+        if self.gas_used != 0 {
+            os.write_uint64(5, self.gas_used)?;
+        }
+        if let Some(ref v) = self.trace.as_ref() {
+            os.write_tag(6, ::protobuf::wire_format::WireTypeLengthDelimited)?;
             os.write_raw_varint32(v.get_cached_size())?;
             v.write_to_with_cached_sizes(os)?;
         }
+        if self.gas_refund != 0 {
+            os.write_uint64(7, self.gas_refund)?;
+        }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
     }
@@ -1726,8 +1918,8 @@ impl ::protobuf::Message for HandleEstimateGasRequest {
         Self::descriptor_static()
     }
 
-    fn new() -> HandleEstimateGasRequest {
-        HandleEstimateGasRequest::new()
+    fn new() -> HandleTransactionResponse {
+        HandleTransactionResponse::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -1738,18 +1930,38 @@ impl ::protobuf::Message for HandleEstimateGasRequest {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionData>>(
-                    "tx_data",
-                    |m: &HandleEstimateGasRequest| { &m.tx_data },
-                    |m: &mut HandleEstimateGasRequest| { &mut m.tx_data },
+                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<Log>>(
+                    "logs",
+                    |m: &HandleTransactionResponse| { &m.logs },
+                    |m: &mut HandleTransactionResponse| { &mut m.logs },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionContext>>(
-                    "tx_context",
-                    |m: &HandleEstimateGasRequest| { &m.tx_context },
-                    |m: &mut HandleEstimateGasRequest| { &mut m.tx_context },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "ret",
+                    |m: &HandleTransactionResponse| { &m.ret },
+                    |m: &mut HandleTransactionResponse| { &mut m.ret },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<HandleEstimateGasRequest>(
-                    "HandleEstimateGasRequest",
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "vm_error",
+                    |m: &HandleTransactionResponse| { &m.vm_error },
+                    |m: &mut HandleTransactionResponse| { &mut m.vm_error },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "gas_used",
+                    |m: &HandleTransactionResponse| { &m.gas_used },
+                    |m: &mut HandleTransactionResponse| { &mut m.gas_used },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<ExecutionTrace>>(
+                    "trace",
+                    |m: &HandleTransactionResponse| { &m.trace },
+                    |m: &mut HandleTransactionResponse| { &mut m.trace },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "gas_refund",
+                    |m: &HandleTransactionResponse| { &m.gas_refund },
+                    |m: &mut HandleTransactionResponse| { &mut m.gas_refund },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<HandleTransactionResponse>(
+                    "HandleTransactionResponse",
                     fields,
                     file_descriptor_proto()
                 )
@@ -1757,102 +1969,141 @@ impl ::protobuf::Message for HandleEstimateGasRequest {
         }
     }
 
-    fn default_instance() -> &'static HandleEstimateGasRequest {
-        static mut instance: ::protobuf::lazy::Lazy<HandleEstimateGasRequest> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static HandleTransactionResponse {
+        static mut instance: ::protobuf::lazy::Lazy<HandleTransactionResponse> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const HandleEstimateGasRequest,
+            ptr: 0 as *const HandleTransactionResponse,
         };
         unsafe {
-            instance.get(HandleEstimateGasRequest::new)
+            instance.get(HandleTransactionResponse::new)
         }
     }
 }
 
-impl ::protobuf::Clear for HandleEstimateGasRequest {
+impl ::protobuf::Clear for HandleTransactionResponse {
     fn clear(&mut self) {
-        self.tx_data.clear();
-        self.tx_context.clear();
+        self.logs.clear();
+        self.ret.clear();
+        self.vm_error.clear();
+        self.gas_used = 0;
+        self.trace.clear();
+        self.gas_refund = 0;
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for HandleEstimateGasRequest {
+impl ::std::fmt::Debug for HandleTransactionResponse {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for HandleEstimateGasRequest {
+impl ::protobuf::reflect::ProtobufValue for HandleTransactionResponse {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct HandleEstimateGasResponse {
+pub struct HandleEstimateGasRequest {
     // message fields
-    pub vm_error: ::std::string::String,
-    pub gas_used: u64,
+    pub tx_data: ::protobuf::SingularPtrField<TransactionData>,
+    pub tx_context: ::protobuf::SingularPtrField<TransactionContext>,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
 }
 
-impl<'a> ::std::default::Default for &'a HandleEstimateGasResponse {
-    fn default() -> &'a HandleEstimateGasResponse {
-        <HandleEstimateGasResponse as ::protobuf::Message>::default_instance()
+impl<'a> ::std::default::Default for &'a HandleEstimateGasRequest {
+    fn default() -> &'a HandleEstimateGasRequest {
+        <HandleEstimateGasRequest as ::protobuf::Message>::default_instance()
     }
 }
 
-impl HandleEstimateGasResponse {
-    pub fn new() -> HandleEstimateGasResponse {
+impl HandleEstimateGasRequest {
+    pub fn new() -> HandleEstimateGasRequest {
         ::std::default::Default::default()
     }
 
-    // string vm_error = 1;
+    // .ffi.ffi.TransactionData tx_data = 1;
 
 
-    pub fn get_vm_error(&self) -> &str {
-        &self.vm_error
+    pub fn get_tx_data(&self) -> &TransactionData {
+        self.tx_data.as_ref().unwrap_or_else(|| TransactionData::default_instance())
     }
-    pub fn clear_vm_error(&mut self) {
-        self.vm_error.clear();
+    pub fn clear_tx_data(&mut self) {
+        self.tx_data.clear();
+    }
+
+    pub fn has_tx_data(&self) -> bool {
+        self.tx_data.is_some()
     }
 
     // Param is passed by value, moved
-    pub fn set_vm_error(&mut self, v: ::std::string::String) {
-        self.vm_error = v;
+    pub fn set_tx_data(&mut self, v: TransactionData) {
+        self.tx_data = ::protobuf::SingularPtrField::some(v);
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_vm_error(&mut self) -> &mut ::std::string::String {
-        &mut self.vm_error
+    pub fn mut_tx_data(&mut self) -> &mut TransactionData {
+        if self.tx_data.is_none() {
+            self.tx_data.set_default();
+        }
+        self.tx_data.as_mut().unwrap()
     }
 
     // Take field
-    pub fn take_vm_error(&mut self) -> ::std::string::String {
-        ::std::mem::replace(&mut self.vm_error, ::std::string::String::new())
+    pub fn take_tx_data(&mut self) -> TransactionData {
+        self.tx_data.take().unwrap_or_else(|| TransactionData::new())
     }
 
-    // uint64 gas_used = 2;
+    // .ffi.ffi.TransactionContext tx_context = 2;
 
 
-    pub fn get_gas_used(&self) -> u64 {
-        self.gas_used
+    pub fn get_tx_context(&self) -> &TransactionContext {
+        self.tx_context.as_ref().unwrap_or_else(|| TransactionContext::default_instance())
     }
-    pub fn clear_gas_used(&mut self) {
-        self.gas_used = 0;
+    pub fn clear_tx_context(&mut self) {
+        self.tx_context.clear();
+    }
+
+    pub fn has_tx_context(&self) -> bool {
+        self.tx_context.is_some()
     }
 
     // Param is passed by value, moved
-    pub fn set_gas_used(&mut self, v: u64) {
-        self.gas_used = v;
+    pub fn set_tx_context(&mut self, v: TransactionContext) {
+        self.tx_context = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_tx_context(&mut self) -> &mut TransactionContext {
+        if self.tx_context.is_none() {
+            self.tx_context.set_default();
+        }
+        self.tx_context.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_tx_context(&mut self) -> TransactionContext {
+        self.tx_context.take().unwrap_or_else(|| TransactionContext::new())
     }
 }
 
-impl ::protobuf::Message for HandleEstimateGasResponse {
+impl ::protobuf::Message for HandleEstimateGasRequest {
     fn is_initialized(&self) -> bool {
+        for v in &self.tx_data {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        for v in &self.tx_context {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
         true
     }
 
@@ -1861,14 +2112,10 @@ impl ::protobuf::Message for HandleEstimateGasResponse {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
-                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.vm_error)?;
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.tx_data)?;
                 },
                 2 => {
-                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
-                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_uint64()?;
-                    self.gas_used = tmp;
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.tx_context)?;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -1882,11 +2129,13 @@ impl ::protobuf::Message for HandleEstimateGasResponse {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if !self.vm_error.is_empty() {
-            my_size += ::protobuf::rt::string_size(1, &self.vm_error);
+        if let Some(ref v) = self.tx_data.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
         }
-        if self.gas_used != 0 {
-            my_size += ::protobuf::rt::value_size(2, self.gas_used, ::protobuf::wire_format::WireTypeVarint);
+        if let Some(ref v) = self.tx_context.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -1894,11 +2143,15 @@ impl ::protobuf::Message for HandleEstimateGasResponse {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
-        if !self.vm_error.is_empty() {
-            os.write_string(1, &self.vm_error)?;
+        if let Some(ref v) = self.tx_data.as_ref() {
+            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
         }
-        if self.gas_used != 0 {
-            os.write_uint64(2, self.gas_used)?;
+        if let Some(ref v) = self.tx_context.as_ref() {
+            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -1930,8 +2183,8 @@ impl ::protobuf::Message for HandleEstimateGasResponse {
         Self::descriptor_static()
     }
 
-    fn new() -> HandleEstimateGasResponse {
-        HandleEstimateGasResponse::new()
+    fn new() -> HandleEstimateGasRequest {
+        HandleEstimateGasRequest::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -1942,18 +2195,18 @@ impl ::protobuf::Message for HandleEstimateGasResponse {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
-                    "vm_error",
-                    |m: &HandleEstimateGasResponse| { &m.vm_error },
-                    |m: &mut HandleEstimateGasResponse| { &mut m.vm_error },
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionData>>(
+                    "tx_data",
+                    |m: &HandleEstimateGasRequest| { &m.tx_data },
+                    |m: &mut HandleEstimateGasRequest| { &mut m.tx_data },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
-                    "gas_used",
-                    |m: &HandleEstimateGasResponse| { &m.gas_used },
-                    |m: &mut HandleEstimateGasResponse| { &mut m.gas_used },
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionContext>>(
+                    "tx_context",
+                    |m: &HandleEstimateGasRequest| { &m.tx_context },
+                    |m: &mut HandleEstimateGasRequest| { &mut m.tx_context },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<HandleEstimateGasResponse>(
-                    "HandleEstimateGasResponse",
+                ::protobuf::reflect::MessageDescriptor::new::<HandleEstimateGasRequest>(
+                    "HandleEstimateGasRequest",
                     fields,
                     file_descriptor_proto()
                 )
@@ -1961,49 +2214,253 @@ impl ::protobuf::Message for HandleEstimateGasResponse {
         }
     }
 
-    fn default_instance() -> &'static HandleEstimateGasResponse {
-        static mut instance: ::protobuf::lazy::Lazy<HandleEstimateGasResponse> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static HandleEstimateGasRequest {
+        static mut instance: ::protobuf::lazy::Lazy<HandleEstimateGasRequest> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const HandleEstimateGasResponse,
+            ptr: 0 as *const HandleEstimateGasRequest,
         };
         unsafe {
-            instance.get(HandleEstimateGasResponse::new)
+            instance.get(HandleEstimateGasRequest::new)
         }
     }
 }
 
-impl ::protobuf::Clear for HandleEstimateGasResponse {
+impl ::protobuf::Clear for HandleEstimateGasRequest {
     fn clear(&mut self) {
-        self.vm_error.clear();
-        self.gas_used = 0;
+        self.tx_data.clear();
+        self.tx_context.clear();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for HandleEstimateGasResponse {
+impl ::std::fmt::Debug for HandleEstimateGasRequest {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for HandleEstimateGasResponse {
+impl ::protobuf::reflect::ProtobufValue for HandleEstimateGasRequest {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct Topic {
+pub struct HandleEstimateGasResponse {
     // message fields
-    pub inner: ::std::vec::Vec<u8>,
+    pub vm_error: ::std::string::String,
+    pub gas_used: u64,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
 }
 
-impl<'a> ::std::default::Default for &'a Topic {
-    fn default() -> &'a Topic {
-        <Topic as ::protobuf::Message>::default_instance()
+impl<'a> ::std::default::Default for &'a HandleEstimateGasResponse {
+    fn default() -> &'a HandleEstimateGasResponse {
+        <HandleEstimateGasResponse as ::protobuf::Message>::default_instance()
+    }
+}
+
+impl HandleEstimateGasResponse {
+    pub fn new() -> HandleEstimateGasResponse {
+        ::std::default::Default::default()
+    }
+
+    // string vm_error = 1;
+
+
+    pub fn get_vm_error(&self) -> &str {
+        &self.vm_error
+    }
+    pub fn clear_vm_error(&mut self) {
+        self.vm_error.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_vm_error(&mut self, v: ::std::string::String) {
+        self.vm_error = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_vm_error(&mut self) -> &mut ::std::string::String {
+        &mut self.vm_error
+    }
+
+    // Take field
+    pub fn take_vm_error(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.vm_error, ::std::string::String::new())
+    }
+
+    // uint64 gas_used = 2;
+
+
+    pub fn get_gas_used(&self) -> u64 {
+        self.gas_used
+    }
+    pub fn clear_gas_used(&mut self) {
+        self.gas_used = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_gas_used(&mut self, v: u64) {
+        self.gas_used = v;
+    }
+}
+
+impl ::protobuf::Message for HandleEstimateGasResponse {
+    fn is_initialized(&self) -> bool {
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.vm_error)?;
+                },
+                2 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.gas_used = tmp;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if !self.vm_error.is_empty() {
+            my_size += ::protobuf::rt::string_size(1, &self.vm_error);
+        }
+        if self.gas_used != 0 {
+            my_size += ::protobuf::rt::value_size(2, self.gas_used, ::protobuf::wire_format::WireTypeVarint);
+        }
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        if !self.vm_error.is_empty() {
+            os.write_string(1, &self.vm_error)?;
+        }
+        if self.gas_used != 0 {
+            os.write_uint64(2, self.gas_used)?;
+        }
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &dyn (::std::any::Any) {
+        self as &dyn (::std::any::Any)
+    }
+    fn as_any_mut(&mut self) -> &mut dyn (::std::any::Any) {
+        self as &mut dyn (::std::any::Any)
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<dyn (::std::any::Any)> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> HandleEstimateGasResponse {
+        HandleEstimateGasResponse::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "vm_error",
+                    |m: &HandleEstimateGasResponse| { &m.vm_error },
+                    |m: &mut HandleEstimateGasResponse| { &mut m.vm_error },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "gas_used",
+                    |m: &HandleEstimateGasResponse| { &m.gas_used },
+                    |m: &mut HandleEstimateGasResponse| { &mut m.gas_used },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<HandleEstimateGasResponse>(
+                    "HandleEstimateGasResponse",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static HandleEstimateGasResponse {
+        static mut instance: ::protobuf::lazy::Lazy<HandleEstimateGasResponse> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const HandleEstimateGasResponse,
+        };
+        unsafe {
+            instance.get(HandleEstimateGasResponse::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for HandleEstimateGasResponse {
+    fn clear(&mut self) {
+        self.vm_error.clear();
+        self.gas_used = 0;
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for HandleEstimateGasResponse {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for HandleEstimateGasResponse {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct Topic {
+    // message fields
+    pub inner: ::std::vec::Vec<u8>,
+    // special fields
+    pub unknown_fields: ::protobuf::UnknownFields,
+    pub cached_size: ::protobuf::CachedSize,
+}
+
+impl<'a> ::std::default::Default for &'a Topic {
+    fn default() -> &'a Topic {
+        <Topic as ::protobuf::Message>::default_instance()
     }
 }
 
@@ -7162,6 +7619,8 @@ impl ::protobuf::reflect::ProtobufValue for QueryBlockHashResponse {
 
 #[derive(PartialEq,Clone,Default)]
 pub struct QueryIssuanceTreeRoot {
+    // message fields
+    pub blockHeight: u64,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
@@ -7177,6 +7636,21 @@ impl QueryIssuanceTreeRoot {
     pub fn new() -> QueryIssuanceTreeRoot {
         ::std::default::Default::default()
     }
+
+    // uint64 blockHeight = 1;
+
+
+    pub fn get_blockHeight(&self) -> u64 {
+        self.blockHeight
+    }
+    pub fn clear_blockHeight(&mut self) {
+        self.blockHeight = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_blockHeight(&mut self, v: u64) {
+        self.blockHeight = v;
+    }
 }
 
 impl ::protobuf::Message for QueryIssuanceTreeRoot {
@@ -7188,6 +7662,13 @@ impl ::protobuf::Message for QueryIssuanceTreeRoot {
         while !is.eof()? {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
+                1 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.blockHeight = tmp;
+                },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
                 },
@@ -7200,12 +7681,18 @@ impl ::protobuf::Message for QueryIssuanceTreeRoot {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
+        if self.blockHeight != 0 {
+            my_size += ::protobuf::rt::value_size(1, self.blockHeight, ::protobuf::wire_format::WireTypeVarint);
+        }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
         my_size
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        if self.blockHeight != 0 {
+            os.write_uint64(1, self.blockHeight)?;
+        }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
     }
@@ -7247,7 +7734,12 @@ impl ::protobuf::Message for QueryIssuanceTreeRoot {
         };
         unsafe {
             descriptor.get(|| {
-                let fields = ::std::vec::Vec::new();
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "blockHeight",
+                    |m: &QueryIssuanceTreeRoot| { &m.blockHeight },
+                    |m: &mut QueryIssuanceTreeRoot| { &mut m.blockHeight },
+                ));
                 ::protobuf::reflect::MessageDescriptor::new::<QueryIssuanceTreeRoot>(
                     "QueryIssuanceTreeRoot",
                     fields,
@@ -7270,6 +7762,7 @@ impl ::protobuf::Message for QueryIssuanceTreeRoot {
 
 impl ::protobuf::Clear for QueryIssuanceTreeRoot {
     fn clear(&mut self) {
+        self.blockHeight = 0;
         self.unknown_fields.clear();
     }
 }
@@ -7457,6 +7950,8 @@ impl ::protobuf::reflect::ProtobufValue for QueryIssuanceTreeRootResponse {
 
 #[derive(PartialEq,Clone,Default)]
 pub struct QueryRevocationTreeRoot {
+    // message fields
+    pub blockHeight: u64,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
@@ -7472,6 +7967,21 @@ impl QueryRevocationTreeRoot {
     pub fn new() -> QueryRevocationTreeRoot {
         ::std::default::Default::default()
     }
+
+    // uint64 blockHeight = 1;
+
+
+    pub fn get_blockHeight(&self) -> u64 {
+        self.blockHeight
+    }
+    pub fn clear_blockHeight(&mut self) {
+        self.blockHeight = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_blockHeight(&mut self, v: u64) {
+        self.blockHeight = v;
+    }
 }
 
 impl ::protobuf::Message for QueryRevocationTreeRoot {
@@ -7483,6 +7993,13 @@ impl ::protobuf::Message for QueryRevocationTreeRoot {
         while !is.eof()? {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
+                1 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.blockHeight = tmp;
+                },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
                 },
@@ -7495,12 +8012,18 @@ impl ::protobuf::Message for QueryRevocationTreeRoot {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
+        if self.blockHeight != 0 {
+            my_size += ::protobuf::rt::value_size(1, self.blockHeight, ::protobuf::wire_format::WireTypeVarint);
+        }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
         my_size
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        if self.blockHeight != 0 {
+            os.write_uint64(1, self.blockHeight)?;
+        }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
     }
@@ -7542,7 +8065,12 @@ impl ::protobuf::Message for QueryRevocationTreeRoot {
         };
         unsafe {
             descriptor.get(|| {
-                let fields = ::std::vec::Vec::new();
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "blockHeight",
+                    |m: &QueryRevocationTreeRoot| { &m.blockHeight },
+                    |m: &mut QueryRevocationTreeRoot| { &mut m.blockHeight },
+                ));
                 ::protobuf::reflect::MessageDescriptor::new::<QueryRevocationTreeRoot>(
                     "QueryRevocationTreeRoot",
                     fields,
@@ -7565,6 +8093,7 @@ impl ::protobuf::Message for QueryRevocationTreeRoot {
 
 impl ::protobuf::Clear for QueryRevocationTreeRoot {
     fn clear(&mut self) {
+        self.blockHeight = 0;
         self.unknown_fields.clear();
     }
 }
@@ -13061,6 +13590,8 @@ pub struct SGXVMCallParams {
     pub maxPriorityFeePerGas: ::std::vec::Vec<u8>,
     pub maxFeePerGas: ::std::vec::Vec<u8>,
     pub txType: u32,
+    pub trace: ::protobuf::SingularPtrField<TraceOptions>,
+    pub authorizationList: ::protobuf::RepeatedField<AuthorizationListItem>,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
@@ -13384,15 +13915,83 @@ impl SGXVMCallParams {
     pub fn set_txType(&mut self, v: u32) {
         self.txType = v;
     }
-}
 
-impl ::protobuf::Message for SGXVMCallParams {
-    fn is_initialized(&self) -> bool {
-        for v in &self.accessList {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
+    // .ffi.ffi.TraceOptions trace = 15;
+
+
+    pub fn get_trace(&self) -> &TraceOptions {
+        self.trace.as_ref().unwrap_or_else(|| TraceOptions::default_instance())
+    }
+    pub fn clear_trace(&mut self) {
+        self.trace.clear();
+    }
+
+    pub fn has_trace(&self) -> bool {
+        self.trace.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_trace(&mut self, v: TraceOptions) {
+        self.trace = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_trace(&mut self) -> &mut TraceOptions {
+        if self.trace.is_none() {
+            self.trace.set_default();
+        }
+        self.trace.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_trace(&mut self) -> TraceOptions {
+        self.trace.take().unwrap_or_else(|| TraceOptions::new())
+    }
+
+    // repeated .ffi.ffi.AuthorizationListItem authorizationList = 16;
+
+
+    pub fn get_authorizationList(&self) -> &[AuthorizationListItem] {
+        &self.authorizationList
+    }
+    pub fn clear_authorizationList(&mut self) {
+        self.authorizationList.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_authorizationList(&mut self, v: ::protobuf::RepeatedField<AuthorizationListItem>) {
+        self.authorizationList = v;
+    }
+
+    // Mutable pointer to the field.
+    pub fn mut_authorizationList(&mut self) -> &mut ::protobuf::RepeatedField<AuthorizationListItem> {
+        &mut self.authorizationList
+    }
+
+    // Take field
+    pub fn take_authorizationList(&mut self) -> ::protobuf::RepeatedField<AuthorizationListItem> {
+        ::std::mem::replace(&mut self.authorizationList, ::protobuf::RepeatedField::new())
+    }
+}
+
+impl ::protobuf::Message for SGXVMCallParams {
+    fn is_initialized(&self) -> bool {
+        for v in &self.accessList {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        for v in &self.trace {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        for v in &self.authorizationList {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
         true
     }
 
@@ -13462,6 +14061,12 @@ impl ::protobuf::Message for SGXVMCallParams {
                     let tmp = is.read_uint32()?;
                     self.txType = tmp;
                 },
+                15 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.trace)?;
+                },
+                16 => {
+                    ::protobuf::rt::read_repeated_message_into(wire_type, is, &mut self.authorizationList)?;
+                },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
                 },
@@ -13517,6 +14122,14 @@ impl ::protobuf::Message for SGXVMCallParams {
         if self.txType != 0 {
             my_size += ::protobuf::rt::value_size(14, self.txType, ::protobuf::wire_format::WireTypeVarint);
         }
+        if let Some(ref v) = self.trace.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        }
+        for value in &self.authorizationList {
+            let len = value.compute_size();
+            my_size += 2 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        };
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
         my_size
@@ -13567,6 +14180,16 @@ impl ::protobuf::Message for SGXVMCallParams {
         if self.txType != 0 {
             os.write_uint32(14, self.txType)?;
         }
+        if let Some(ref v) = self.trace.as_ref() {
+            os.write_tag(15, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        }
+        for v in &self.authorizationList {
+            os.write_tag(16, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        };
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
     }
@@ -13679,6 +14302,16 @@ impl ::protobuf::Message for SGXVMCallParams {
                     |m: &SGXVMCallParams| { &m.txType },
                     |m: &mut SGXVMCallParams| { &mut m.txType },
                 ));
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TraceOptions>>(
+                    "trace",
+                    |m: &SGXVMCallParams| { &m.trace },
+                    |m: &mut SGXVMCallParams| { &mut m.trace },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<AuthorizationListItem>>(
+                    "authorizationList",
+                    |m: &SGXVMCallParams| { &m.authorizationList },
+                    |m: &mut SGXVMCallParams| { &mut m.authorizationList },
+                ));
                 ::protobuf::reflect::MessageDescriptor::new::<SGXVMCallParams>(
                     "SGXVMCallParams",
                     fields,
@@ -13715,6 +14348,8 @@ impl ::protobuf::Clear for SGXVMCallParams {
         self.maxPriorityFeePerGas.clear();
         self.maxFeePerGas.clear();
         self.txType = 0;
+        self.trace.clear();
+        self.authorizationList.clear();
         self.unknown_fields.clear();
     }
 }
@@ -13746,6 +14381,7 @@ pub struct SGXVMCreateParams {
     pub maxPriorityFeePerGas: ::std::vec::Vec<u8>,
     pub maxFeePerGas: ::std::vec::Vec<u8>,
     pub txType: u32,
+    pub trace: ::protobuf::SingularPtrField<TraceOptions>,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
@@ -14028,6 +14664,39 @@ impl SGXVMCreateParams {
     pub fn set_txType(&mut self, v: u32) {
         self.txType = v;
     }
+
+    // .ffi.ffi.TraceOptions trace = 13;
+
+
+    pub fn get_trace(&self) -> &TraceOptions {
+        self.trace.as_ref().unwrap_or_else(|| TraceOptions::default_instance())
+    }
+    pub fn clear_trace(&mut self) {
+        self.trace.clear();
+    }
+
+    pub fn has_trace(&self) -> bool {
+        self.trace.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_trace(&mut self, v: TraceOptions) {
+        self.trace = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_trace(&mut self) -> &mut TraceOptions {
+        if self.trace.is_none() {
+            self.trace.set_default();
+        }
+        self.trace.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_trace(&mut self) -> TraceOptions {
+        self.trace.take().unwrap_or_else(|| TraceOptions::new())
+    }
 }
 
 impl ::protobuf::Message for SGXVMCreateParams {
@@ -14037,6 +14706,11 @@ impl ::protobuf::Message for SGXVMCreateParams {
                 return false;
             }
         };
+        for v in &self.trace {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
         true
     }
 
@@ -14096,6 +14770,9 @@ impl ::protobuf::Message for SGXVMCreateParams {
                     let tmp = is.read_uint32()?;
                     self.txType = tmp;
                 },
+                13 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.trace)?;
+                },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
                 },
@@ -14145,6 +14822,10 @@ impl ::protobuf::Message for SGXVMCreateParams {
         if self.txType != 0 {
             my_size += ::protobuf::rt::value_size(12, self.txType, ::protobuf::wire_format::WireTypeVarint);
         }
+        if let Some(ref v) = self.trace.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
         my_size
@@ -14189,6 +14870,11 @@ impl ::protobuf::Message for SGXVMCreateParams {
         if self.txType != 0 {
             os.write_uint32(12, self.txType)?;
         }
+        if let Some(ref v) = self.trace.as_ref() {
+            os.write_tag(13, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
     }
@@ -14291,6 +14977,11 @@ impl ::protobuf::Message for SGXVMCreateParams {
                     |m: &SGXVMCreateParams| { &m.txType },
                     |m: &mut SGXVMCreateParams| { &mut m.txType },
                 ));
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TraceOptions>>(
+                    "trace",
+                    |m: &SGXVMCreateParams| { &m.trace },
+                    |m: &mut SGXVMCreateParams| { &mut m.trace },
+                ));
                 ::protobuf::reflect::MessageDescriptor::new::<SGXVMCreateParams>(
                     "SGXVMCreateParams",
                     fields,
@@ -14325,6 +15016,7 @@ impl ::protobuf::Clear for SGXVMCreateParams {
         self.maxPriorityFeePerGas.clear();
         self.maxFeePerGas.clear();
         self.txType = 0;
+        self.trace.clear();
         self.unknown_fields.clear();
     }
 }
@@ -14752,12 +15444,2631 @@ impl ::protobuf::Message for SGXVMEstimateGasParams {
                 13 => {
                     ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.maxFeePerGas)?;
                 },
-                14 => {
-                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
-                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_uint32()?;
-                    self.txType = tmp;
+                14 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint32()?;
+                    self.txType = tmp;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if !self.from.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(1, &self.from);
+        }
+        if !self.to.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(2, &self.to);
+        }
+        if !self.data.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(3, &self.data);
+        }
+        if self.gasLimit != 0 {
+            my_size += ::protobuf::rt::value_size(4, self.gasLimit, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if !self.gasPrice.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(5, &self.gasPrice);
+        }
+        if !self.value.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(6, &self.value);
+        }
+        for value in &self.accessList {
+            let len = value.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        };
+        if self.commit != false {
+            my_size += 2;
+        }
+        if self.nonce != 0 {
+            my_size += ::protobuf::rt::value_size(9, self.nonce, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if self.unencrypted != false {
+            my_size += 2;
+        }
+        if !self.signature.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(11, &self.signature);
+        }
+        if !self.maxPriorityFeePerGas.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(12, &self.maxPriorityFeePerGas);
+        }
+        if !self.maxFeePerGas.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(13, &self.maxFeePerGas);
+        }
+        if self.txType != 0 {
+            my_size += ::protobuf::rt::value_size(14, self.txType, ::protobuf::wire_format::WireTypeVarint);
+        }
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        if !self.from.is_empty() {
+            os.write_bytes(1, &self.from)?;
+        }
+        if !self.to.is_empty() {
+            os.write_bytes(2, &self.to)?;
+        }
+        if !self.data.is_empty() {
+            os.write_bytes(3, &self.data)?;
+        }
+        if self.gasLimit != 0 {
+            os.write_uint64(4, self.gasLimit)?;
+        }
+        if !self.gasPrice.is_empty() {
+            os.write_bytes(5, &self.gasPrice)?;
+        }
+        if !self.value.is_empty() {
+            os.write_bytes(6, &self.value)?;
+        }
+        for v in &self.accessList {
+            os.write_tag(7, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        };
+        if self.commit != false {
+            os.write_bool(8, self.commit)?;
+        }
+        if self.nonce != 0 {
+            os.write_uint64(9, self.nonce)?;
+        }
+        if self.unencrypted != false {
+            os.write_bool(10, self.unencrypted)?;
+        }
+        if !self.signature.is_empty() {
+            os.write_bytes(11, &self.signature)?;
+        }
+        if !self.maxPriorityFeePerGas.is_empty() {
+            os.write_bytes(12, &self.maxPriorityFeePerGas)?;
+        }
+        if !self.maxFeePerGas.is_empty() {
+            os.write_bytes(13, &self.maxFeePerGas)?;
+        }
+        if self.txType != 0 {
+            os.write_uint32(14, self.txType)?;
+        }
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &dyn (::std::any::Any) {
+        self as &dyn (::std::any::Any)
+    }
+    fn as_any_mut(&mut self) -> &mut dyn (::std::any::Any) {
+        self as &mut dyn (::std::any::Any)
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<dyn (::std::any::Any)> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> SGXVMEstimateGasParams {
+        SGXVMEstimateGasParams::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "from",
+                    |m: &SGXVMEstimateGasParams| { &m.from },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.from },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "to",
+                    |m: &SGXVMEstimateGasParams| { &m.to },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.to },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "data",
+                    |m: &SGXVMEstimateGasParams| { &m.data },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.data },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "gasLimit",
+                    |m: &SGXVMEstimateGasParams| { &m.gasLimit },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.gasLimit },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "gasPrice",
+                    |m: &SGXVMEstimateGasParams| { &m.gasPrice },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.gasPrice },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "value",
+                    |m: &SGXVMEstimateGasParams| { &m.value },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.value },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<AccessListItem>>(
+                    "accessList",
+                    |m: &SGXVMEstimateGasParams| { &m.accessList },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.accessList },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
+                    "commit",
+                    |m: &SGXVMEstimateGasParams| { &m.commit },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.commit },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "nonce",
+                    |m: &SGXVMEstimateGasParams| { &m.nonce },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.nonce },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
+                    "unencrypted",
+                    |m: &SGXVMEstimateGasParams| { &m.unencrypted },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.unencrypted },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "signature",
+                    |m: &SGXVMEstimateGasParams| { &m.signature },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.signature },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "maxPriorityFeePerGas",
+                    |m: &SGXVMEstimateGasParams| { &m.maxPriorityFeePerGas },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.maxPriorityFeePerGas },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "maxFeePerGas",
+                    |m: &SGXVMEstimateGasParams| { &m.maxFeePerGas },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.maxFeePerGas },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint32>(
+                    "txType",
+                    |m: &SGXVMEstimateGasParams| { &m.txType },
+                    |m: &mut SGXVMEstimateGasParams| { &mut m.txType },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<SGXVMEstimateGasParams>(
+                    "SGXVMEstimateGasParams",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static SGXVMEstimateGasParams {
+        static mut instance: ::protobuf::lazy::Lazy<SGXVMEstimateGasParams> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const SGXVMEstimateGasParams,
+        };
+        unsafe {
+            instance.get(SGXVMEstimateGasParams::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for SGXVMEstimateGasParams {
+    fn clear(&mut self) {
+        self.from.clear();
+        self.to.clear();
+        self.data.clear();
+        self.gasLimit = 0;
+        self.gasPrice.clear();
+        self.value.clear();
+        self.accessList.clear();
+        self.commit = false;
+        self.nonce = 0;
+        self.unencrypted = false;
+        self.signature.clear();
+        self.maxPriorityFeePerGas.clear();
+        self.maxFeePerGas.clear();
+        self.txType = 0;
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for SGXVMEstimateGasParams {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for SGXVMEstimateGasParams {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct TraceOptions {
+    // message fields
+    pub tracer: TracerType,
+    pub disableStack: bool,
+    pub disableStorage: bool,
+    pub enableMemory: bool,
+    pub enableReturnData: bool,
+    pub limit: u64,
+    pub onlyTopCall: bool,
+    pub traceNode: bool,
+    // special fields
+    pub unknown_fields: ::protobuf::UnknownFields,
+    pub cached_size: ::protobuf::CachedSize,
+}
+
+impl<'a> ::std::default::Default for &'a TraceOptions {
+    fn default() -> &'a TraceOptions {
+        <TraceOptions as ::protobuf::Message>::default_instance()
+    }
+}
+
+impl TraceOptions {
+    pub fn new() -> TraceOptions {
+        ::std::default::Default::default()
+    }
+
+    // .ffi.ffi.TracerType tracer = 1;
+
+
+    pub fn get_tracer(&self) -> TracerType {
+        self.tracer
+    }
+    pub fn clear_tracer(&mut self) {
+        self.tracer = TracerType::TRACER_NONE;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_tracer(&mut self, v: TracerType) {
+        self.tracer = v;
+    }
+
+    // bool disableStack = 2;
+
+
+    pub fn get_disableStack(&self) -> bool {
+        self.disableStack
+    }
+    pub fn clear_disableStack(&mut self) {
+        self.disableStack = false;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_disableStack(&mut self, v: bool) {
+        self.disableStack = v;
+    }
+
+    // bool disableStorage = 3;
+
+
+    pub fn get_disableStorage(&self) -> bool {
+        self.disableStorage
+    }
+    pub fn clear_disableStorage(&mut self) {
+        self.disableStorage = false;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_disableStorage(&mut self, v: bool) {
+        self.disableStorage = v;
+    }
+
+    // bool enableMemory = 4;
+
+
+    pub fn get_enableMemory(&self) -> bool {
+        self.enableMemory
+    }
+    pub fn clear_enableMemory(&mut self) {
+        self.enableMemory = false;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_enableMemory(&mut self, v: bool) {
+        self.enableMemory = v;
+    }
+
+    // bool enableReturnData = 5;
+
+
+    pub fn get_enableReturnData(&self) -> bool {
+        self.enableReturnData
+    }
+    pub fn clear_enableReturnData(&mut self) {
+        self.enableReturnData = false;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_enableReturnData(&mut self, v: bool) {
+        self.enableReturnData = v;
+    }
+
+    // uint64 limit = 6;
+
+
+    pub fn get_limit(&self) -> u64 {
+        self.limit
+    }
+    pub fn clear_limit(&mut self) {
+        self.limit = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_limit(&mut self, v: u64) {
+        self.limit = v;
+    }
+
+    // bool onlyTopCall = 7;
+
+
+    pub fn get_onlyTopCall(&self) -> bool {
+        self.onlyTopCall
+    }
+    pub fn clear_onlyTopCall(&mut self) {
+        self.onlyTopCall = false;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_onlyTopCall(&mut self, v: bool) {
+        self.onlyTopCall = v;
+    }
+
+    // bool traceNode = 8;
+
+
+    pub fn get_traceNode(&self) -> bool {
+        self.traceNode
+    }
+    pub fn clear_traceNode(&mut self) {
+        self.traceNode = false;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_traceNode(&mut self, v: bool) {
+        self.traceNode = v;
+    }
+}
+
+impl ::protobuf::Message for TraceOptions {
+    fn is_initialized(&self) -> bool {
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    ::protobuf::rt::read_proto3_enum_with_unknown_fields_into(wire_type, is, &mut self.tracer, 1, &mut self.unknown_fields)?
+                },
+                2 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_bool()?;
+                    self.disableStack = tmp;
+                },
+                3 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_bool()?;
+                    self.disableStorage = tmp;
+                },
+                4 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_bool()?;
+                    self.enableMemory = tmp;
+                },
+                5 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_bool()?;
+                    self.enableReturnData = tmp;
+                },
+                6 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.limit = tmp;
+                },
+                7 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_bool()?;
+                    self.onlyTopCall = tmp;
+                },
+                8 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_bool()?;
+                    self.traceNode = tmp;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if self.tracer != TracerType::TRACER_NONE {
+            my_size += ::protobuf::rt::enum_size(1, self.tracer);
+        }
+        if self.disableStack != false {
+            my_size += 2;
+        }
+        if self.disableStorage != false {
+            my_size += 2;
+        }
+        if self.enableMemory != false {
+            my_size += 2;
+        }
+        if self.enableReturnData != false {
+            my_size += 2;
+        }
+        if self.limit != 0 {
+            my_size += ::protobuf::rt::value_size(6, self.limit, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if self.onlyTopCall != false {
+            my_size += 2;
+        }
+        if self.traceNode != false {
+            my_size += 2;
+        }
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        if self.tracer != TracerType::TRACER_NONE {
+            os.write_enum(1, self.tracer.value())?;
+        }
+        if self.disableStack != false {
+            os.write_bool(2, self.disableStack)?;
+        }
+        if self.disableStorage != false {
+            os.write_bool(3, self.disableStorage)?;
+        }
+        if self.enableMemory != false {
+            os.write_bool(4, self.enableMemory)?;
+        }
+        if self.enableReturnData != false {
+            os.write_bool(5, self.enableReturnData)?;
+        }
+        if self.limit != 0 {
+            os.write_uint64(6, self.limit)?;
+        }
+        if self.onlyTopCall != false {
+            os.write_bool(7, self.onlyTopCall)?;
+        }
+        if self.traceNode != false {
+            os.write_bool(8, self.traceNode)?;
+        }
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &dyn (::std::any::Any) {
+        self as &dyn (::std::any::Any)
+    }
+    fn as_any_mut(&mut self) -> &mut dyn (::std::any::Any) {
+        self as &mut dyn (::std::any::Any)
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<dyn (::std::any::Any)> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> TraceOptions {
+        TraceOptions::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeEnum<TracerType>>(
+                    "tracer",
+                    |m: &TraceOptions| { &m.tracer },
+                    |m: &mut TraceOptions| { &mut m.tracer },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
+                    "disableStack",
+                    |m: &TraceOptions| { &m.disableStack },
+                    |m: &mut TraceOptions| { &mut m.disableStack },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
+                    "disableStorage",
+                    |m: &TraceOptions| { &m.disableStorage },
+                    |m: &mut TraceOptions| { &mut m.disableStorage },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
+                    "enableMemory",
+                    |m: &TraceOptions| { &m.enableMemory },
+                    |m: &mut TraceOptions| { &mut m.enableMemory },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
+                    "enableReturnData",
+                    |m: &TraceOptions| { &m.enableReturnData },
+                    |m: &mut TraceOptions| { &mut m.enableReturnData },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "limit",
+                    |m: &TraceOptions| { &m.limit },
+                    |m: &mut TraceOptions| { &mut m.limit },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
+                    "onlyTopCall",
+                    |m: &TraceOptions| { &m.onlyTopCall },
+                    |m: &mut TraceOptions| { &mut m.onlyTopCall },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
+                    "traceNode",
+                    |m: &TraceOptions| { &m.traceNode },
+                    |m: &mut TraceOptions| { &mut m.traceNode },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<TraceOptions>(
+                    "TraceOptions",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static TraceOptions {
+        static mut instance: ::protobuf::lazy::Lazy<TraceOptions> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const TraceOptions,
+        };
+        unsafe {
+            instance.get(TraceOptions::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for TraceOptions {
+    fn clear(&mut self) {
+        self.tracer = TracerType::TRACER_NONE;
+        self.disableStack = false;
+        self.disableStorage = false;
+        self.enableMemory = false;
+        self.enableReturnData = false;
+        self.limit = 0;
+        self.onlyTopCall = false;
+        self.traceNode = false;
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for TraceOptions {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for TraceOptions {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct TraceStorageEntry {
+    // message fields
+    pub key: ::std::vec::Vec<u8>,
+    pub value: ::std::vec::Vec<u8>,
+    // special fields
+    pub unknown_fields: ::protobuf::UnknownFields,
+    pub cached_size: ::protobuf::CachedSize,
+}
+
+impl<'a> ::std::default::Default for &'a TraceStorageEntry {
+    fn default() -> &'a TraceStorageEntry {
+        <TraceStorageEntry as ::protobuf::Message>::default_instance()
+    }
+}
+
+impl TraceStorageEntry {
+    pub fn new() -> TraceStorageEntry {
+        ::std::default::Default::default()
+    }
+
+    // bytes key = 1;
+
+
+    pub fn get_key(&self) -> &[u8] {
+        &self.key
+    }
+    pub fn clear_key(&mut self) {
+        self.key.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_key(&mut self, v: ::std::vec::Vec<u8>) {
+        self.key = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_key(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.key
+    }
+
+    // Take field
+    pub fn take_key(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.key, ::std::vec::Vec::new())
+    }
+
+    // bytes value = 2;
+
+
+    pub fn get_value(&self) -> &[u8] {
+        &self.value
+    }
+    pub fn clear_value(&mut self) {
+        self.value.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_value(&mut self, v: ::std::vec::Vec<u8>) {
+        self.value = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_value(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.value
+    }
+
+    // Take field
+    pub fn take_value(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.value, ::std::vec::Vec::new())
+    }
+}
+
+impl ::protobuf::Message for TraceStorageEntry {
+    fn is_initialized(&self) -> bool {
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.key)?;
+                },
+                2 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.value)?;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if !self.key.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(1, &self.key);
+        }
+        if !self.value.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(2, &self.value);
+        }
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        if !self.key.is_empty() {
+            os.write_bytes(1, &self.key)?;
+        }
+        if !self.value.is_empty() {
+            os.write_bytes(2, &self.value)?;
+        }
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &dyn (::std::any::Any) {
+        self as &dyn (::std::any::Any)
+    }
+    fn as_any_mut(&mut self) -> &mut dyn (::std::any::Any) {
+        self as &mut dyn (::std::any::Any)
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<dyn (::std::any::Any)> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> TraceStorageEntry {
+        TraceStorageEntry::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "key",
+                    |m: &TraceStorageEntry| { &m.key },
+                    |m: &mut TraceStorageEntry| { &mut m.key },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "value",
+                    |m: &TraceStorageEntry| { &m.value },
+                    |m: &mut TraceStorageEntry| { &mut m.value },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<TraceStorageEntry>(
+                    "TraceStorageEntry",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static TraceStorageEntry {
+        static mut instance: ::protobuf::lazy::Lazy<TraceStorageEntry> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const TraceStorageEntry,
+        };
+        unsafe {
+            instance.get(TraceStorageEntry::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for TraceStorageEntry {
+    fn clear(&mut self) {
+        self.key.clear();
+        self.value.clear();
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for TraceStorageEntry {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for TraceStorageEntry {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct TraceStructLog {
+    // message fields
+    pub pc: u64,
+    pub op: ::std::string::String,
+    pub gas: u64,
+    pub gasCost: u64,
+    pub depth: u32,
+    pub stack: ::protobuf::RepeatedField<::std::vec::Vec<u8>>,
+    pub memory: ::std::vec::Vec<u8>,
+    pub storage: ::protobuf::RepeatedField<TraceStorageEntry>,
+    pub returnData: ::std::vec::Vec<u8>,
+    pub refund: u64,
+    pub error: ::std::string::String,
+    // special fields
+    pub unknown_fields: ::protobuf::UnknownFields,
+    pub cached_size: ::protobuf::CachedSize,
+}
+
+impl<'a> ::std::default::Default for &'a TraceStructLog {
+    fn default() -> &'a TraceStructLog {
+        <TraceStructLog as ::protobuf::Message>::default_instance()
+    }
+}
+
+impl TraceStructLog {
+    pub fn new() -> TraceStructLog {
+        ::std::default::Default::default()
+    }
+
+    // uint64 pc = 1;
+
+
+    pub fn get_pc(&self) -> u64 {
+        self.pc
+    }
+    pub fn clear_pc(&mut self) {
+        self.pc = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_pc(&mut self, v: u64) {
+        self.pc = v;
+    }
+
+    // string op = 2;
+
+
+    pub fn get_op(&self) -> &str {
+        &self.op
+    }
+    pub fn clear_op(&mut self) {
+        self.op.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_op(&mut self, v: ::std::string::String) {
+        self.op = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_op(&mut self) -> &mut ::std::string::String {
+        &mut self.op
+    }
+
+    // Take field
+    pub fn take_op(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.op, ::std::string::String::new())
+    }
+
+    // uint64 gas = 3;
+
+
+    pub fn get_gas(&self) -> u64 {
+        self.gas
+    }
+    pub fn clear_gas(&mut self) {
+        self.gas = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_gas(&mut self, v: u64) {
+        self.gas = v;
+    }
+
+    // uint64 gasCost = 4;
+
+
+    pub fn get_gasCost(&self) -> u64 {
+        self.gasCost
+    }
+    pub fn clear_gasCost(&mut self) {
+        self.gasCost = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_gasCost(&mut self, v: u64) {
+        self.gasCost = v;
+    }
+
+    // uint32 depth = 5;
+
+
+    pub fn get_depth(&self) -> u32 {
+        self.depth
+    }
+    pub fn clear_depth(&mut self) {
+        self.depth = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_depth(&mut self, v: u32) {
+        self.depth = v;
+    }
+
+    // repeated bytes stack = 6;
+
+
+    pub fn get_stack(&self) -> &[::std::vec::Vec<u8>] {
+        &self.stack
+    }
+    pub fn clear_stack(&mut self) {
+        self.stack.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_stack(&mut self, v: ::protobuf::RepeatedField<::std::vec::Vec<u8>>) {
+        self.stack = v;
+    }
+
+    // Mutable pointer to the field.
+    pub fn mut_stack(&mut self) -> &mut ::protobuf::RepeatedField<::std::vec::Vec<u8>> {
+        &mut self.stack
+    }
+
+    // Take field
+    pub fn take_stack(&mut self) -> ::protobuf::RepeatedField<::std::vec::Vec<u8>> {
+        ::std::mem::replace(&mut self.stack, ::protobuf::RepeatedField::new())
+    }
+
+    // bytes memory = 7;
+
+
+    pub fn get_memory(&self) -> &[u8] {
+        &self.memory
+    }
+    pub fn clear_memory(&mut self) {
+        self.memory.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_memory(&mut self, v: ::std::vec::Vec<u8>) {
+        self.memory = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_memory(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.memory
+    }
+
+    // Take field
+    pub fn take_memory(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.memory, ::std::vec::Vec::new())
+    }
+
+    // repeated .ffi.ffi.TraceStorageEntry storage = 8;
+
+
+    pub fn get_storage(&self) -> &[TraceStorageEntry] {
+        &self.storage
+    }
+    pub fn clear_storage(&mut self) {
+        self.storage.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_storage(&mut self, v: ::protobuf::RepeatedField<TraceStorageEntry>) {
+        self.storage = v;
+    }
+
+    // Mutable pointer to the field.
+    pub fn mut_storage(&mut self) -> &mut ::protobuf::RepeatedField<TraceStorageEntry> {
+        &mut self.storage
+    }
+
+    // Take field
+    pub fn take_storage(&mut self) -> ::protobuf::RepeatedField<TraceStorageEntry> {
+        ::std::mem::replace(&mut self.storage, ::protobuf::RepeatedField::new())
+    }
+
+    // bytes returnData = 9;
+
+
+    pub fn get_returnData(&self) -> &[u8] {
+        &self.returnData
+    }
+    pub fn clear_returnData(&mut self) {
+        self.returnData.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_returnData(&mut self, v: ::std::vec::Vec<u8>) {
+        self.returnData = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_returnData(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.returnData
+    }
+
+    // Take field
+    pub fn take_returnData(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.returnData, ::std::vec::Vec::new())
+    }
+
+    // uint64 refund = 10;
+
+
+    pub fn get_refund(&self) -> u64 {
+        self.refund
+    }
+    pub fn clear_refund(&mut self) {
+        self.refund = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_refund(&mut self, v: u64) {
+        self.refund = v;
+    }
+
+    // string error = 11;
+
+
+    pub fn get_error(&self) -> &str {
+        &self.error
+    }
+    pub fn clear_error(&mut self) {
+        self.error.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_error(&mut self, v: ::std::string::String) {
+        self.error = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_error(&mut self) -> &mut ::std::string::String {
+        &mut self.error
+    }
+
+    // Take field
+    pub fn take_error(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.error, ::std::string::String::new())
+    }
+}
+
+impl ::protobuf::Message for TraceStructLog {
+    fn is_initialized(&self) -> bool {
+        for v in &self.storage {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.pc = tmp;
+                },
+                2 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.op)?;
+                },
+                3 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.gas = tmp;
+                },
+                4 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.gasCost = tmp;
+                },
+                5 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint32()?;
+                    self.depth = tmp;
+                },
+                6 => {
+                    ::protobuf::rt::read_repeated_bytes_into(wire_type, is, &mut self.stack)?;
+                },
+                7 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.memory)?;
+                },
+                8 => {
+                    ::protobuf::rt::read_repeated_message_into(wire_type, is, &mut self.storage)?;
+                },
+                9 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.returnData)?;
+                },
+                10 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.refund = tmp;
+                },
+                11 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.error)?;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if self.pc != 0 {
+            my_size += ::protobuf::rt::value_size(1, self.pc, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if !self.op.is_empty() {
+            my_size += ::protobuf::rt::string_size(2, &self.op);
+        }
+        if self.gas != 0 {
+            my_size += ::protobuf::rt::value_size(3, self.gas, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if self.gasCost != 0 {
+            my_size += ::protobuf::rt::value_size(4, self.gasCost, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if self.depth != 0 {
+            my_size += ::protobuf::rt::value_size(5, self.depth, ::protobuf::wire_format::WireTypeVarint);
+        }
+        for value in &self.stack {
+            my_size += ::protobuf::rt::bytes_size(6, &value);
+        };
+        if !self.memory.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(7, &self.memory);
+        }
+        for value in &self.storage {
+            let len = value.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        };
+        if !self.returnData.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(9, &self.returnData);
+        }
+        if self.refund != 0 {
+            my_size += ::protobuf::rt::value_size(10, self.refund, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if !self.error.is_empty() {
+            my_size += ::protobuf::rt::string_size(11, &self.error);
+        }
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        if self.pc != 0 {
+            os.write_uint64(1, self.pc)?;
+        }
+        if !self.op.is_empty() {
+            os.write_string(2, &self.op)?;
+        }
+        if self.gas != 0 {
+            os.write_uint64(3, self.gas)?;
+        }
+        if self.gasCost != 0 {
+            os.write_uint64(4, self.gasCost)?;
+        }
+        if self.depth != 0 {
+            os.write_uint32(5, self.depth)?;
+        }
+        for v in &self.stack {
+            os.write_bytes(6, &v)?;
+        };
+        if !self.memory.is_empty() {
+            os.write_bytes(7, &self.memory)?;
+        }
+        for v in &self.storage {
+            os.write_tag(8, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        };
+        if !self.returnData.is_empty() {
+            os.write_bytes(9, &self.returnData)?;
+        }
+        if self.refund != 0 {
+            os.write_uint64(10, self.refund)?;
+        }
+        if !self.error.is_empty() {
+            os.write_string(11, &self.error)?;
+        }
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &dyn (::std::any::Any) {
+        self as &dyn (::std::any::Any)
+    }
+    fn as_any_mut(&mut self) -> &mut dyn (::std::any::Any) {
+        self as &mut dyn (::std::any::Any)
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<dyn (::std::any::Any)> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> TraceStructLog {
+        TraceStructLog::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "pc",
+                    |m: &TraceStructLog| { &m.pc },
+                    |m: &mut TraceStructLog| { &mut m.pc },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "op",
+                    |m: &TraceStructLog| { &m.op },
+                    |m: &mut TraceStructLog| { &mut m.op },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "gas",
+                    |m: &TraceStructLog| { &m.gas },
+                    |m: &mut TraceStructLog| { &mut m.gas },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "gasCost",
+                    |m: &TraceStructLog| { &m.gasCost },
+                    |m: &mut TraceStructLog| { &mut m.gasCost },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint32>(
+                    "depth",
+                    |m: &TraceStructLog| { &m.depth },
+                    |m: &mut TraceStructLog| { &mut m.depth },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "stack",
+                    |m: &TraceStructLog| { &m.stack },
+                    |m: &mut TraceStructLog| { &mut m.stack },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "memory",
+                    |m: &TraceStructLog| { &m.memory },
+                    |m: &mut TraceStructLog| { &mut m.memory },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TraceStorageEntry>>(
+                    "storage",
+                    |m: &TraceStructLog| { &m.storage },
+                    |m: &mut TraceStructLog| { &mut m.storage },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "returnData",
+                    |m: &TraceStructLog| { &m.returnData },
+                    |m: &mut TraceStructLog| { &mut m.returnData },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "refund",
+                    |m: &TraceStructLog| { &m.refund },
+                    |m: &mut TraceStructLog| { &mut m.refund },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "error",
+                    |m: &TraceStructLog| { &m.error },
+                    |m: &mut TraceStructLog| { &mut m.error },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<TraceStructLog>(
+                    "TraceStructLog",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static TraceStructLog {
+        static mut instance: ::protobuf::lazy::Lazy<TraceStructLog> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const TraceStructLog,
+        };
+        unsafe {
+            instance.get(TraceStructLog::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for TraceStructLog {
+    fn clear(&mut self) {
+        self.pc = 0;
+        self.op.clear();
+        self.gas = 0;
+        self.gasCost = 0;
+        self.depth = 0;
+        self.stack.clear();
+        self.memory.clear();
+        self.storage.clear();
+        self.returnData.clear();
+        self.refund = 0;
+        self.error.clear();
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for TraceStructLog {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for TraceStructLog {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct TraceCallFrame {
+    // message fields
+    pub field_type: ::std::string::String,
+    pub from: ::std::vec::Vec<u8>,
+    pub to: ::std::vec::Vec<u8>,
+    pub value: ::std::vec::Vec<u8>,
+    pub gas: u64,
+    pub gasUsed: u64,
+    pub input: ::std::vec::Vec<u8>,
+    pub output: ::std::vec::Vec<u8>,
+    pub error: ::std::string::String,
+    pub calls: ::protobuf::RepeatedField<TraceCallFrame>,
+    // special fields
+    pub unknown_fields: ::protobuf::UnknownFields,
+    pub cached_size: ::protobuf::CachedSize,
+}
+
+impl<'a> ::std::default::Default for &'a TraceCallFrame {
+    fn default() -> &'a TraceCallFrame {
+        <TraceCallFrame as ::protobuf::Message>::default_instance()
+    }
+}
+
+impl TraceCallFrame {
+    pub fn new() -> TraceCallFrame {
+        ::std::default::Default::default()
+    }
+
+    // string type = 1;
+
+
+    pub fn get_field_type(&self) -> &str {
+        &self.field_type
+    }
+    pub fn clear_field_type(&mut self) {
+        self.field_type.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_field_type(&mut self, v: ::std::string::String) {
+        self.field_type = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_field_type(&mut self) -> &mut ::std::string::String {
+        &mut self.field_type
+    }
+
+    // Take field
+    pub fn take_field_type(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.field_type, ::std::string::String::new())
+    }
+
+    // bytes from = 2;
+
+
+    pub fn get_from(&self) -> &[u8] {
+        &self.from
+    }
+    pub fn clear_from(&mut self) {
+        self.from.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_from(&mut self, v: ::std::vec::Vec<u8>) {
+        self.from = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_from(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.from
+    }
+
+    // Take field
+    pub fn take_from(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.from, ::std::vec::Vec::new())
+    }
+
+    // bytes to = 3;
+
+
+    pub fn get_to(&self) -> &[u8] {
+        &self.to
+    }
+    pub fn clear_to(&mut self) {
+        self.to.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_to(&mut self, v: ::std::vec::Vec<u8>) {
+        self.to = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_to(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.to
+    }
+
+    // Take field
+    pub fn take_to(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.to, ::std::vec::Vec::new())
+    }
+
+    // bytes value = 4;
+
+
+    pub fn get_value(&self) -> &[u8] {
+        &self.value
+    }
+    pub fn clear_value(&mut self) {
+        self.value.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_value(&mut self, v: ::std::vec::Vec<u8>) {
+        self.value = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_value(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.value
+    }
+
+    // Take field
+    pub fn take_value(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.value, ::std::vec::Vec::new())
+    }
+
+    // uint64 gas = 5;
+
+
+    pub fn get_gas(&self) -> u64 {
+        self.gas
+    }
+    pub fn clear_gas(&mut self) {
+        self.gas = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_gas(&mut self, v: u64) {
+        self.gas = v;
+    }
+
+    // uint64 gasUsed = 6;
+
+
+    pub fn get_gasUsed(&self) -> u64 {
+        self.gasUsed
+    }
+    pub fn clear_gasUsed(&mut self) {
+        self.gasUsed = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_gasUsed(&mut self, v: u64) {
+        self.gasUsed = v;
+    }
+
+    // bytes input = 7;
+
+
+    pub fn get_input(&self) -> &[u8] {
+        &self.input
+    }
+    pub fn clear_input(&mut self) {
+        self.input.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_input(&mut self, v: ::std::vec::Vec<u8>) {
+        self.input = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_input(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.input
+    }
+
+    // Take field
+    pub fn take_input(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.input, ::std::vec::Vec::new())
+    }
+
+    // bytes output = 8;
+
+
+    pub fn get_output(&self) -> &[u8] {
+        &self.output
+    }
+    pub fn clear_output(&mut self) {
+        self.output.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_output(&mut self, v: ::std::vec::Vec<u8>) {
+        self.output = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_output(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.output
+    }
+
+    // Take field
+    pub fn take_output(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.output, ::std::vec::Vec::new())
+    }
+
+    // string error = 9;
+
+
+    pub fn get_error(&self) -> &str {
+        &self.error
+    }
+    pub fn clear_error(&mut self) {
+        self.error.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_error(&mut self, v: ::std::string::String) {
+        self.error = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_error(&mut self) -> &mut ::std::string::String {
+        &mut self.error
+    }
+
+    // Take field
+    pub fn take_error(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.error, ::std::string::String::new())
+    }
+
+    // repeated .ffi.ffi.TraceCallFrame calls = 10;
+
+
+    pub fn get_calls(&self) -> &[TraceCallFrame] {
+        &self.calls
+    }
+    pub fn clear_calls(&mut self) {
+        self.calls.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_calls(&mut self, v: ::protobuf::RepeatedField<TraceCallFrame>) {
+        self.calls = v;
+    }
+
+    // Mutable pointer to the field.
+    pub fn mut_calls(&mut self) -> &mut ::protobuf::RepeatedField<TraceCallFrame> {
+        &mut self.calls
+    }
+
+    // Take field
+    pub fn take_calls(&mut self) -> ::protobuf::RepeatedField<TraceCallFrame> {
+        ::std::mem::replace(&mut self.calls, ::protobuf::RepeatedField::new())
+    }
+}
+
+impl ::protobuf::Message for TraceCallFrame {
+    fn is_initialized(&self) -> bool {
+        for v in &self.calls {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.field_type)?;
+                },
+                2 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.from)?;
+                },
+                3 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.to)?;
+                },
+                4 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.value)?;
+                },
+                5 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.gas = tmp;
+                },
+                6 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.gasUsed = tmp;
+                },
+                7 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.input)?;
+                },
+                8 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.output)?;
+                },
+                9 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.error)?;
+                },
+                10 => {
+                    ::protobuf::rt::read_repeated_message_into(wire_type, is, &mut self.calls)?;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if !self.field_type.is_empty() {
+            my_size += ::protobuf::rt::string_size(1, &self.field_type);
+        }
+        if !self.from.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(2, &self.from);
+        }
+        if !self.to.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(3, &self.to);
+        }
+        if !self.value.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(4, &self.value);
+        }
+        if self.gas != 0 {
+            my_size += ::protobuf::rt::value_size(5, self.gas, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if self.gasUsed != 0 {
+            my_size += ::protobuf::rt::value_size(6, self.gasUsed, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if !self.input.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(7, &self.input);
+        }
+        if !self.output.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(8, &self.output);
+        }
+        if !self.error.is_empty() {
+            my_size += ::protobuf::rt::string_size(9, &self.error);
+        }
+        for value in &self.calls {
+            let len = value.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        };
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        if !self.field_type.is_empty() {
+            os.write_string(1, &self.field_type)?;
+        }
+        if !self.from.is_empty() {
+            os.write_bytes(2, &self.from)?;
+        }
+        if !self.to.is_empty() {
+            os.write_bytes(3, &self.to)?;
+        }
+        if !self.value.is_empty() {
+            os.write_bytes(4, &self.value)?;
+        }
+        if self.gas != 0 {
+            os.write_uint64(5, self.gas)?;
+        }
+        if self.gasUsed != 0 {
+            os.write_uint64(6, self.gasUsed)?;
+        }
+        if !self.input.is_empty() {
+            os.write_bytes(7, &self.input)?;
+        }
+        if !self.output.is_empty() {
+            os.write_bytes(8, &self.output)?;
+        }
+        if !self.error.is_empty() {
+            os.write_string(9, &self.error)?;
+        }
+        for v in &self.calls {
+            os.write_tag(10, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        };
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &dyn (::std::any::Any) {
+        self as &dyn (::std::any::Any)
+    }
+    fn as_any_mut(&mut self) -> &mut dyn (::std::any::Any) {
+        self as &mut dyn (::std::any::Any)
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<dyn (::std::any::Any)> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> TraceCallFrame {
+        TraceCallFrame::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "type",
+                    |m: &TraceCallFrame| { &m.field_type },
+                    |m: &mut TraceCallFrame| { &mut m.field_type },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "from",
+                    |m: &TraceCallFrame| { &m.from },
+                    |m: &mut TraceCallFrame| { &mut m.from },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "to",
+                    |m: &TraceCallFrame| { &m.to },
+                    |m: &mut TraceCallFrame| { &mut m.to },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "value",
+                    |m: &TraceCallFrame| { &m.value },
+                    |m: &mut TraceCallFrame| { &mut m.value },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "gas",
+                    |m: &TraceCallFrame| { &m.gas },
+                    |m: &mut TraceCallFrame| { &mut m.gas },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "gasUsed",
+                    |m: &TraceCallFrame| { &m.gasUsed },
+                    |m: &mut TraceCallFrame| { &mut m.gasUsed },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "input",
+                    |m: &TraceCallFrame| { &m.input },
+                    |m: &mut TraceCallFrame| { &mut m.input },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "output",
+                    |m: &TraceCallFrame| { &m.output },
+                    |m: &mut TraceCallFrame| { &mut m.output },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "error",
+                    |m: &TraceCallFrame| { &m.error },
+                    |m: &mut TraceCallFrame| { &mut m.error },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TraceCallFrame>>(
+                    "calls",
+                    |m: &TraceCallFrame| { &m.calls },
+                    |m: &mut TraceCallFrame| { &mut m.calls },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<TraceCallFrame>(
+                    "TraceCallFrame",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static TraceCallFrame {
+        static mut instance: ::protobuf::lazy::Lazy<TraceCallFrame> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const TraceCallFrame,
+        };
+        unsafe {
+            instance.get(TraceCallFrame::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for TraceCallFrame {
+    fn clear(&mut self) {
+        self.field_type.clear();
+        self.from.clear();
+        self.to.clear();
+        self.value.clear();
+        self.gas = 0;
+        self.gasUsed = 0;
+        self.input.clear();
+        self.output.clear();
+        self.error.clear();
+        self.calls.clear();
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for TraceCallFrame {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for TraceCallFrame {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct ExecutionTrace {
+    // message fields
+    pub structLogs: ::protobuf::RepeatedField<TraceStructLog>,
+    pub callFrame: ::protobuf::SingularPtrField<TraceCallFrame>,
+    pub accessList: ::protobuf::RepeatedField<AccessListItem>,
+    // special fields
+    pub unknown_fields: ::protobuf::UnknownFields,
+    pub cached_size: ::protobuf::CachedSize,
+}
+
+impl<'a> ::std::default::Default for &'a ExecutionTrace {
+    fn default() -> &'a ExecutionTrace {
+        <ExecutionTrace as ::protobuf::Message>::default_instance()
+    }
+}
+
+impl ExecutionTrace {
+    pub fn new() -> ExecutionTrace {
+        ::std::default::Default::default()
+    }
+
+    // repeated .ffi.ffi.TraceStructLog structLogs = 1;
+
+
+    pub fn get_structLogs(&self) -> &[TraceStructLog] {
+        &self.structLogs
+    }
+    pub fn clear_structLogs(&mut self) {
+        self.structLogs.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_structLogs(&mut self, v: ::protobuf::RepeatedField<TraceStructLog>) {
+        self.structLogs = v;
+    }
+
+    // Mutable pointer to the field.
+    pub fn mut_structLogs(&mut self) -> &mut ::protobuf::RepeatedField<TraceStructLog> {
+        &mut self.structLogs
+    }
+
+    // Take field
+    pub fn take_structLogs(&mut self) -> ::protobuf::RepeatedField<TraceStructLog> {
+        ::std::mem::replace(&mut self.structLogs, ::protobuf::RepeatedField::new())
+    }
+
+    // .ffi.ffi.TraceCallFrame callFrame = 2;
+
+
+    pub fn get_callFrame(&self) -> &TraceCallFrame {
+        self.callFrame.as_ref().unwrap_or_else(|| TraceCallFrame::default_instance())
+    }
+    pub fn clear_callFrame(&mut self) {
+        self.callFrame.clear();
+    }
+
+    pub fn has_callFrame(&self) -> bool {
+        self.callFrame.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_callFrame(&mut self, v: TraceCallFrame) {
+        self.callFrame = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_callFrame(&mut self) -> &mut TraceCallFrame {
+        if self.callFrame.is_none() {
+            self.callFrame.set_default();
+        }
+        self.callFrame.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_callFrame(&mut self) -> TraceCallFrame {
+        self.callFrame.take().unwrap_or_else(|| TraceCallFrame::new())
+    }
+
+    // repeated .ffi.ffi.AccessListItem accessList = 3;
+
+
+    pub fn get_accessList(&self) -> &[AccessListItem] {
+        &self.accessList
+    }
+    pub fn clear_accessList(&mut self) {
+        self.accessList.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_accessList(&mut self, v: ::protobuf::RepeatedField<AccessListItem>) {
+        self.accessList = v;
+    }
+
+    // Mutable pointer to the field.
+    pub fn mut_accessList(&mut self) -> &mut ::protobuf::RepeatedField<AccessListItem> {
+        &mut self.accessList
+    }
+
+    // Take field
+    pub fn take_accessList(&mut self) -> ::protobuf::RepeatedField<AccessListItem> {
+        ::std::mem::replace(&mut self.accessList, ::protobuf::RepeatedField::new())
+    }
+}
+
+impl ::protobuf::Message for ExecutionTrace {
+    fn is_initialized(&self) -> bool {
+        for v in &self.structLogs {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        for v in &self.callFrame {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        for v in &self.accessList {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    ::protobuf::rt::read_repeated_message_into(wire_type, is, &mut self.structLogs)?;
+                },
+                2 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.callFrame)?;
+                },
+                3 => {
+                    ::protobuf::rt::read_repeated_message_into(wire_type, is, &mut self.accessList)?;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        for value in &self.structLogs {
+            let len = value.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        };
+        if let Some(ref v) = self.callFrame.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        }
+        for value in &self.accessList {
+            let len = value.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        };
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        for v in &self.structLogs {
+            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        };
+        if let Some(ref v) = self.callFrame.as_ref() {
+            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        }
+        for v in &self.accessList {
+            os.write_tag(3, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        };
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &dyn (::std::any::Any) {
+        self as &dyn (::std::any::Any)
+    }
+    fn as_any_mut(&mut self) -> &mut dyn (::std::any::Any) {
+        self as &mut dyn (::std::any::Any)
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<dyn (::std::any::Any)> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> ExecutionTrace {
+        ExecutionTrace::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TraceStructLog>>(
+                    "structLogs",
+                    |m: &ExecutionTrace| { &m.structLogs },
+                    |m: &mut ExecutionTrace| { &mut m.structLogs },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TraceCallFrame>>(
+                    "callFrame",
+                    |m: &ExecutionTrace| { &m.callFrame },
+                    |m: &mut ExecutionTrace| { &mut m.callFrame },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<AccessListItem>>(
+                    "accessList",
+                    |m: &ExecutionTrace| { &m.accessList },
+                    |m: &mut ExecutionTrace| { &mut m.accessList },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<ExecutionTrace>(
+                    "ExecutionTrace",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static ExecutionTrace {
+        static mut instance: ::protobuf::lazy::Lazy<ExecutionTrace> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ExecutionTrace,
+        };
+        unsafe {
+            instance.get(ExecutionTrace::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for ExecutionTrace {
+    fn clear(&mut self) {
+        self.structLogs.clear();
+        self.callFrame.clear();
+        self.accessList.clear();
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for ExecutionTrace {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for ExecutionTrace {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct SGXVMCallRequest {
+    // message fields
+    pub params: ::protobuf::SingularPtrField<SGXVMCallParams>,
+    pub context: ::protobuf::SingularPtrField<TransactionContext>,
+    // special fields
+    pub unknown_fields: ::protobuf::UnknownFields,
+    pub cached_size: ::protobuf::CachedSize,
+}
+
+impl<'a> ::std::default::Default for &'a SGXVMCallRequest {
+    fn default() -> &'a SGXVMCallRequest {
+        <SGXVMCallRequest as ::protobuf::Message>::default_instance()
+    }
+}
+
+impl SGXVMCallRequest {
+    pub fn new() -> SGXVMCallRequest {
+        ::std::default::Default::default()
+    }
+
+    // .ffi.ffi.SGXVMCallParams params = 1;
+
+
+    pub fn get_params(&self) -> &SGXVMCallParams {
+        self.params.as_ref().unwrap_or_else(|| SGXVMCallParams::default_instance())
+    }
+    pub fn clear_params(&mut self) {
+        self.params.clear();
+    }
+
+    pub fn has_params(&self) -> bool {
+        self.params.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_params(&mut self, v: SGXVMCallParams) {
+        self.params = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_params(&mut self) -> &mut SGXVMCallParams {
+        if self.params.is_none() {
+            self.params.set_default();
+        }
+        self.params.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_params(&mut self) -> SGXVMCallParams {
+        self.params.take().unwrap_or_else(|| SGXVMCallParams::new())
+    }
+
+    // .ffi.ffi.TransactionContext context = 2;
+
+
+    pub fn get_context(&self) -> &TransactionContext {
+        self.context.as_ref().unwrap_or_else(|| TransactionContext::default_instance())
+    }
+    pub fn clear_context(&mut self) {
+        self.context.clear();
+    }
+
+    pub fn has_context(&self) -> bool {
+        self.context.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_context(&mut self, v: TransactionContext) {
+        self.context = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_context(&mut self) -> &mut TransactionContext {
+        if self.context.is_none() {
+            self.context.set_default();
+        }
+        self.context.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_context(&mut self) -> TransactionContext {
+        self.context.take().unwrap_or_else(|| TransactionContext::new())
+    }
+}
+
+impl ::protobuf::Message for SGXVMCallRequest {
+    fn is_initialized(&self) -> bool {
+        for v in &self.params {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        for v in &self.context {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.params)?;
+                },
+                2 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.context)?;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if let Some(ref v) = self.params.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        }
+        if let Some(ref v) = self.context.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        }
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        if let Some(ref v) = self.params.as_ref() {
+            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        }
+        if let Some(ref v) = self.context.as_ref() {
+            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        }
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &dyn (::std::any::Any) {
+        self as &dyn (::std::any::Any)
+    }
+    fn as_any_mut(&mut self) -> &mut dyn (::std::any::Any) {
+        self as &mut dyn (::std::any::Any)
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<dyn (::std::any::Any)> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> SGXVMCallRequest {
+        SGXVMCallRequest::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<SGXVMCallParams>>(
+                    "params",
+                    |m: &SGXVMCallRequest| { &m.params },
+                    |m: &mut SGXVMCallRequest| { &mut m.params },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionContext>>(
+                    "context",
+                    |m: &SGXVMCallRequest| { &m.context },
+                    |m: &mut SGXVMCallRequest| { &mut m.context },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<SGXVMCallRequest>(
+                    "SGXVMCallRequest",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static SGXVMCallRequest {
+        static mut instance: ::protobuf::lazy::Lazy<SGXVMCallRequest> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const SGXVMCallRequest,
+        };
+        unsafe {
+            instance.get(SGXVMCallRequest::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for SGXVMCallRequest {
+    fn clear(&mut self) {
+        self.params.clear();
+        self.context.clear();
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for SGXVMCallRequest {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for SGXVMCallRequest {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct SGXVMCreateRequest {
+    // message fields
+    pub params: ::protobuf::SingularPtrField<SGXVMCreateParams>,
+    pub context: ::protobuf::SingularPtrField<TransactionContext>,
+    // special fields
+    pub unknown_fields: ::protobuf::UnknownFields,
+    pub cached_size: ::protobuf::CachedSize,
+}
+
+impl<'a> ::std::default::Default for &'a SGXVMCreateRequest {
+    fn default() -> &'a SGXVMCreateRequest {
+        <SGXVMCreateRequest as ::protobuf::Message>::default_instance()
+    }
+}
+
+impl SGXVMCreateRequest {
+    pub fn new() -> SGXVMCreateRequest {
+        ::std::default::Default::default()
+    }
+
+    // .ffi.ffi.SGXVMCreateParams params = 1;
+
+
+    pub fn get_params(&self) -> &SGXVMCreateParams {
+        self.params.as_ref().unwrap_or_else(|| SGXVMCreateParams::default_instance())
+    }
+    pub fn clear_params(&mut self) {
+        self.params.clear();
+    }
+
+    pub fn has_params(&self) -> bool {
+        self.params.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_params(&mut self, v: SGXVMCreateParams) {
+        self.params = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_params(&mut self) -> &mut SGXVMCreateParams {
+        if self.params.is_none() {
+            self.params.set_default();
+        }
+        self.params.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_params(&mut self) -> SGXVMCreateParams {
+        self.params.take().unwrap_or_else(|| SGXVMCreateParams::new())
+    }
+
+    // .ffi.ffi.TransactionContext context = 2;
+
+
+    pub fn get_context(&self) -> &TransactionContext {
+        self.context.as_ref().unwrap_or_else(|| TransactionContext::default_instance())
+    }
+    pub fn clear_context(&mut self) {
+        self.context.clear();
+    }
+
+    pub fn has_context(&self) -> bool {
+        self.context.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_context(&mut self, v: TransactionContext) {
+        self.context = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_context(&mut self) -> &mut TransactionContext {
+        if self.context.is_none() {
+            self.context.set_default();
+        }
+        self.context.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_context(&mut self) -> TransactionContext {
+        self.context.take().unwrap_or_else(|| TransactionContext::new())
+    }
+}
+
+impl ::protobuf::Message for SGXVMCreateRequest {
+    fn is_initialized(&self) -> bool {
+        for v in &self.params {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        for v in &self.context {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.params)?;
+                },
+                2 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.context)?;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -14771,48 +18082,13 @@ impl ::protobuf::Message for SGXVMEstimateGasParams {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if !self.from.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(1, &self.from);
-        }
-        if !self.to.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(2, &self.to);
-        }
-        if !self.data.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(3, &self.data);
-        }
-        if self.gasLimit != 0 {
-            my_size += ::protobuf::rt::value_size(4, self.gasLimit, ::protobuf::wire_format::WireTypeVarint);
-        }
-        if !self.gasPrice.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(5, &self.gasPrice);
-        }
-        if !self.value.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(6, &self.value);
-        }
-        for value in &self.accessList {
-            let len = value.compute_size();
+        if let Some(ref v) = self.params.as_ref() {
+            let len = v.compute_size();
             my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
-        };
-        if self.commit != false {
-            my_size += 2;
-        }
-        if self.nonce != 0 {
-            my_size += ::protobuf::rt::value_size(9, self.nonce, ::protobuf::wire_format::WireTypeVarint);
         }
-        if self.unencrypted != false {
-            my_size += 2;
-        }
-        if !self.signature.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(11, &self.signature);
-        }
-        if !self.maxPriorityFeePerGas.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(12, &self.maxPriorityFeePerGas);
-        }
-        if !self.maxFeePerGas.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(13, &self.maxFeePerGas);
-        }
-        if self.txType != 0 {
-            my_size += ::protobuf::rt::value_size(14, self.txType, ::protobuf::wire_format::WireTypeVarint);
+        if let Some(ref v) = self.context.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -14820,49 +18096,15 @@ impl ::protobuf::Message for SGXVMEstimateGasParams {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
-        if !self.from.is_empty() {
-            os.write_bytes(1, &self.from)?;
-        }
-        if !self.to.is_empty() {
-            os.write_bytes(2, &self.to)?;
-        }
-        if !self.data.is_empty() {
-            os.write_bytes(3, &self.data)?;
-        }
-        if self.gasLimit != 0 {
-            os.write_uint64(4, self.gasLimit)?;
-        }
-        if !self.gasPrice.is_empty() {
-            os.write_bytes(5, &self.gasPrice)?;
-        }
-        if !self.value.is_empty() {
-            os.write_bytes(6, &self.value)?;
-        }
-        for v in &self.accessList {
-            os.write_tag(7, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+        if let Some(ref v) = self.params.as_ref() {
+            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
             os.write_raw_varint32(v.get_cached_size())?;
             v.write_to_with_cached_sizes(os)?;
-        };
-        if self.commit != false {
-            os.write_bool(8, self.commit)?;
-        }
-        if self.nonce != 0 {
-            os.write_uint64(9, self.nonce)?;
-        }
-        if self.unencrypted != false {
-            os.write_bool(10, self.unencrypted)?;
         }
-        if !self.signature.is_empty() {
-            os.write_bytes(11, &self.signature)?;
-        }
-        if !self.maxPriorityFeePerGas.is_empty() {
-            os.write_bytes(12, &self.maxPriorityFeePerGas)?;
-        }
-        if !self.maxFeePerGas.is_empty() {
-            os.write_bytes(13, &self.maxFeePerGas)?;
-        }
-        if self.txType != 0 {
-            os.write_uint32(14, self.txType)?;
+        if let Some(ref v) = self.context.as_ref() {
+            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -14894,8 +18136,8 @@ impl ::protobuf::Message for SGXVMEstimateGasParams {
         Self::descriptor_static()
     }
 
-    fn new() -> SGXVMEstimateGasParams {
-        SGXVMEstimateGasParams::new()
+    fn new() -> SGXVMCreateRequest {
+        SGXVMCreateRequest::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -14906,78 +18148,18 @@ impl ::protobuf::Message for SGXVMEstimateGasParams {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "from",
-                    |m: &SGXVMEstimateGasParams| { &m.from },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.from },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "to",
-                    |m: &SGXVMEstimateGasParams| { &m.to },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.to },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "data",
-                    |m: &SGXVMEstimateGasParams| { &m.data },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.data },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
-                    "gasLimit",
-                    |m: &SGXVMEstimateGasParams| { &m.gasLimit },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.gasLimit },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "gasPrice",
-                    |m: &SGXVMEstimateGasParams| { &m.gasPrice },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.gasPrice },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "value",
-                    |m: &SGXVMEstimateGasParams| { &m.value },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.value },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<AccessListItem>>(
-                    "accessList",
-                    |m: &SGXVMEstimateGasParams| { &m.accessList },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.accessList },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
-                    "commit",
-                    |m: &SGXVMEstimateGasParams| { &m.commit },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.commit },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
-                    "nonce",
-                    |m: &SGXVMEstimateGasParams| { &m.nonce },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.nonce },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
-                    "unencrypted",
-                    |m: &SGXVMEstimateGasParams| { &m.unencrypted },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.unencrypted },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "signature",
-                    |m: &SGXVMEstimateGasParams| { &m.signature },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.signature },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "maxPriorityFeePerGas",
-                    |m: &SGXVMEstimateGasParams| { &m.maxPriorityFeePerGas },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.maxPriorityFeePerGas },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "maxFeePerGas",
-                    |m: &SGXVMEstimateGasParams| { &m.maxFeePerGas },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.maxFeePerGas },
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<SGXVMCreateParams>>(
+                    "params",
+                    |m: &SGXVMCreateRequest| { &m.params },
+                    |m: &mut SGXVMCreateRequest| { &mut m.params },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint32>(
-                    "txType",
-                    |m: &SGXVMEstimateGasParams| { &m.txType },
-                    |m: &mut SGXVMEstimateGasParams| { &mut m.txType },
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionContext>>(
+                    "context",
+                    |m: &SGXVMCreateRequest| { &m.context },
+                    |m: &mut SGXVMCreateRequest| { &mut m.context },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<SGXVMEstimateGasParams>(
-                    "SGXVMEstimateGasParams",
+                ::protobuf::reflect::MessageDescriptor::new::<SGXVMCreateRequest>(
+                    "SGXVMCreateRequest",
                     fields,
                     file_descriptor_proto()
                 )
@@ -14985,75 +18167,63 @@ impl ::protobuf::Message for SGXVMEstimateGasParams {
         }
     }
 
-    fn default_instance() -> &'static SGXVMEstimateGasParams {
-        static mut instance: ::protobuf::lazy::Lazy<SGXVMEstimateGasParams> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static SGXVMCreateRequest {
+        static mut instance: ::protobuf::lazy::Lazy<SGXVMCreateRequest> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const SGXVMEstimateGasParams,
+            ptr: 0 as *const SGXVMCreateRequest,
         };
         unsafe {
-            instance.get(SGXVMEstimateGasParams::new)
+            instance.get(SGXVMCreateRequest::new)
         }
     }
 }
 
-impl ::protobuf::Clear for SGXVMEstimateGasParams {
+impl ::protobuf::Clear for SGXVMCreateRequest {
     fn clear(&mut self) {
-        self.from.clear();
-        self.to.clear();
-        self.data.clear();
-        self.gasLimit = 0;
-        self.gasPrice.clear();
-        self.value.clear();
-        self.accessList.clear();
-        self.commit = false;
-        self.nonce = 0;
-        self.unencrypted = false;
-        self.signature.clear();
-        self.maxPriorityFeePerGas.clear();
-        self.maxFeePerGas.clear();
-        self.txType = 0;
+        self.params.clear();
+        self.context.clear();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for SGXVMEstimateGasParams {
+impl ::std::fmt::Debug for SGXVMCreateRequest {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for SGXVMEstimateGasParams {
+impl ::protobuf::reflect::ProtobufValue for SGXVMCreateRequest {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct SGXVMCallRequest {
+pub struct SGXVMEstimateGasRequest {
     // message fields
-    pub params: ::protobuf::SingularPtrField<SGXVMCallParams>,
+    pub params: ::protobuf::SingularPtrField<SGXVMEstimateGasParams>,
     pub context: ::protobuf::SingularPtrField<TransactionContext>,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
 }
 
-impl<'a> ::std::default::Default for &'a SGXVMCallRequest {
-    fn default() -> &'a SGXVMCallRequest {
-        <SGXVMCallRequest as ::protobuf::Message>::default_instance()
+impl<'a> ::std::default::Default for &'a SGXVMEstimateGasRequest {
+    fn default() -> &'a SGXVMEstimateGasRequest {
+        <SGXVMEstimateGasRequest as ::protobuf::Message>::default_instance()
     }
 }
 
-impl SGXVMCallRequest {
-    pub fn new() -> SGXVMCallRequest {
+impl SGXVMEstimateGasRequest {
+    pub fn new() -> SGXVMEstimateGasRequest {
         ::std::default::Default::default()
     }
 
-    // .ffi.ffi.SGXVMCallParams params = 1;
+    // .ffi.ffi.SGXVMEstimateGasParams params = 1;
 
 
-    pub fn get_params(&self) -> &SGXVMCallParams {
-        self.params.as_ref().unwrap_or_else(|| SGXVMCallParams::default_instance())
+    pub fn get_params(&self) -> &SGXVMEstimateGasParams {
+        self.params.as_ref().unwrap_or_else(|| SGXVMEstimateGasParams::default_instance())
     }
     pub fn clear_params(&mut self) {
         self.params.clear();
@@ -15064,13 +18234,13 @@ impl SGXVMCallRequest {
     }
 
     // Param is passed by value, moved
-    pub fn set_params(&mut self, v: SGXVMCallParams) {
+    pub fn set_params(&mut self, v: SGXVMEstimateGasParams) {
         self.params = ::protobuf::SingularPtrField::some(v);
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_params(&mut self) -> &mut SGXVMCallParams {
+    pub fn mut_params(&mut self) -> &mut SGXVMEstimateGasParams {
         if self.params.is_none() {
             self.params.set_default();
         }
@@ -15078,8 +18248,8 @@ impl SGXVMCallRequest {
     }
 
     // Take field
-    pub fn take_params(&mut self) -> SGXVMCallParams {
-        self.params.take().unwrap_or_else(|| SGXVMCallParams::new())
+    pub fn take_params(&mut self) -> SGXVMEstimateGasParams {
+        self.params.take().unwrap_or_else(|| SGXVMEstimateGasParams::new())
     }
 
     // .ffi.ffi.TransactionContext context = 2;
@@ -15116,7 +18286,7 @@ impl SGXVMCallRequest {
     }
 }
 
-impl ::protobuf::Message for SGXVMCallRequest {
+impl ::protobuf::Message for SGXVMEstimateGasRequest {
     fn is_initialized(&self) -> bool {
         for v in &self.params {
             if !v.is_initialized() {
@@ -15207,8 +18377,8 @@ impl ::protobuf::Message for SGXVMCallRequest {
         Self::descriptor_static()
     }
 
-    fn new() -> SGXVMCallRequest {
-        SGXVMCallRequest::new()
+    fn new() -> SGXVMEstimateGasRequest {
+        SGXVMEstimateGasRequest::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -15219,18 +18389,18 @@ impl ::protobuf::Message for SGXVMCallRequest {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<SGXVMCallParams>>(
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<SGXVMEstimateGasParams>>(
                     "params",
-                    |m: &SGXVMCallRequest| { &m.params },
-                    |m: &mut SGXVMCallRequest| { &mut m.params },
+                    |m: &SGXVMEstimateGasRequest| { &m.params },
+                    |m: &mut SGXVMEstimateGasRequest| { &mut m.params },
                 ));
                 fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionContext>>(
                     "context",
-                    |m: &SGXVMCallRequest| { &m.context },
-                    |m: &mut SGXVMCallRequest| { &mut m.context },
+                    |m: &SGXVMEstimateGasRequest| { &m.context },
+                    |m: &mut SGXVMEstimateGasRequest| { &mut m.context },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<SGXVMCallRequest>(
-                    "SGXVMCallRequest",
+                ::protobuf::reflect::MessageDescriptor::new::<SGXVMEstimateGasRequest>(
+                    "SGXVMEstimateGasRequest",
                     fields,
                     file_descriptor_proto()
                 )
@@ -15238,18 +18408,18 @@ impl ::protobuf::Message for SGXVMCallRequest {
         }
     }
 
-    fn default_instance() -> &'static SGXVMCallRequest {
-        static mut instance: ::protobuf::lazy::Lazy<SGXVMCallRequest> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static SGXVMEstimateGasRequest {
+        static mut instance: ::protobuf::lazy::Lazy<SGXVMEstimateGasRequest> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const SGXVMCallRequest,
+            ptr: 0 as *const SGXVMEstimateGasRequest,
         };
         unsafe {
-            instance.get(SGXVMCallRequest::new)
+            instance.get(SGXVMEstimateGasRequest::new)
         }
     }
 }
 
-impl ::protobuf::Clear for SGXVMCallRequest {
+impl ::protobuf::Clear for SGXVMEstimateGasRequest {
     fn clear(&mut self) {
         self.params.clear();
         self.context.clear();
@@ -15257,118 +18427,229 @@ impl ::protobuf::Clear for SGXVMCallRequest {
     }
 }
 
-impl ::std::fmt::Debug for SGXVMCallRequest {
+impl ::std::fmt::Debug for SGXVMEstimateGasRequest {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for SGXVMCallRequest {
+impl ::protobuf::reflect::ProtobufValue for SGXVMEstimateGasRequest {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct SGXVMCreateRequest {
+pub struct NodePublicKeyRequest {
     // message fields
-    pub params: ::protobuf::SingularPtrField<SGXVMCreateParams>,
-    pub context: ::protobuf::SingularPtrField<TransactionContext>,
+    pub blockNumber: u64,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
 }
 
-impl<'a> ::std::default::Default for &'a SGXVMCreateRequest {
-    fn default() -> &'a SGXVMCreateRequest {
-        <SGXVMCreateRequest as ::protobuf::Message>::default_instance()
+impl<'a> ::std::default::Default for &'a NodePublicKeyRequest {
+    fn default() -> &'a NodePublicKeyRequest {
+        <NodePublicKeyRequest as ::protobuf::Message>::default_instance()
     }
 }
 
-impl SGXVMCreateRequest {
-    pub fn new() -> SGXVMCreateRequest {
+impl NodePublicKeyRequest {
+    pub fn new() -> NodePublicKeyRequest {
         ::std::default::Default::default()
     }
 
-    // .ffi.ffi.SGXVMCreateParams params = 1;
+    // uint64 blockNumber = 1;
 
 
-    pub fn get_params(&self) -> &SGXVMCreateParams {
-        self.params.as_ref().unwrap_or_else(|| SGXVMCreateParams::default_instance())
+    pub fn get_blockNumber(&self) -> u64 {
+        self.blockNumber
     }
-    pub fn clear_params(&mut self) {
-        self.params.clear();
+    pub fn clear_blockNumber(&mut self) {
+        self.blockNumber = 0;
     }
 
-    pub fn has_params(&self) -> bool {
-        self.params.is_some()
+    // Param is passed by value, moved
+    pub fn set_blockNumber(&mut self, v: u64) {
+        self.blockNumber = v;
     }
+}
 
-    // Param is passed by value, moved
-    pub fn set_params(&mut self, v: SGXVMCreateParams) {
-        self.params = ::protobuf::SingularPtrField::some(v);
+impl ::protobuf::Message for NodePublicKeyRequest {
+    fn is_initialized(&self) -> bool {
+        true
     }
 
-    // Mutable pointer to the field.
-    // If field is not initialized, it is initialized with default value first.
-    pub fn mut_params(&mut self) -> &mut SGXVMCreateParams {
-        if self.params.is_none() {
-            self.params.set_default();
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.blockNumber = tmp;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
         }
-        self.params.as_mut().unwrap()
+        ::std::result::Result::Ok(())
     }
 
-    // Take field
-    pub fn take_params(&mut self) -> SGXVMCreateParams {
-        self.params.take().unwrap_or_else(|| SGXVMCreateParams::new())
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if self.blockNumber != 0 {
+            my_size += ::protobuf::rt::value_size(1, self.blockNumber, ::protobuf::wire_format::WireTypeVarint);
+        }
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
     }
 
-    // .ffi.ffi.TransactionContext context = 2;
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        if self.blockNumber != 0 {
+            os.write_uint64(1, self.blockNumber)?;
+        }
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
 
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
 
-    pub fn get_context(&self) -> &TransactionContext {
-        self.context.as_ref().unwrap_or_else(|| TransactionContext::default_instance())
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
     }
-    pub fn clear_context(&mut self) {
-        self.context.clear();
+
+    fn as_any(&self) -> &dyn (::std::any::Any) {
+        self as &dyn (::std::any::Any)
+    }
+    fn as_any_mut(&mut self) -> &mut dyn (::std::any::Any) {
+        self as &mut dyn (::std::any::Any)
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<dyn (::std::any::Any)> {
+        self
     }
 
-    pub fn has_context(&self) -> bool {
-        self.context.is_some()
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> NodePublicKeyRequest {
+        NodePublicKeyRequest::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "blockNumber",
+                    |m: &NodePublicKeyRequest| { &m.blockNumber },
+                    |m: &mut NodePublicKeyRequest| { &mut m.blockNumber },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<NodePublicKeyRequest>(
+                    "NodePublicKeyRequest",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static NodePublicKeyRequest {
+        static mut instance: ::protobuf::lazy::Lazy<NodePublicKeyRequest> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const NodePublicKeyRequest,
+        };
+        unsafe {
+            instance.get(NodePublicKeyRequest::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for NodePublicKeyRequest {
+    fn clear(&mut self) {
+        self.blockNumber = 0;
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for NodePublicKeyRequest {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for NodePublicKeyRequest {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct NodePublicKeyResponse {
+    // message fields
+    pub publicKey: ::std::vec::Vec<u8>,
+    // special fields
+    pub unknown_fields: ::protobuf::UnknownFields,
+    pub cached_size: ::protobuf::CachedSize,
+}
+
+impl<'a> ::std::default::Default for &'a NodePublicKeyResponse {
+    fn default() -> &'a NodePublicKeyResponse {
+        <NodePublicKeyResponse as ::protobuf::Message>::default_instance()
+    }
+}
+
+impl NodePublicKeyResponse {
+    pub fn new() -> NodePublicKeyResponse {
+        ::std::default::Default::default()
+    }
+
+    // bytes publicKey = 1;
+
+
+    pub fn get_publicKey(&self) -> &[u8] {
+        &self.publicKey
+    }
+    pub fn clear_publicKey(&mut self) {
+        self.publicKey.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_context(&mut self, v: TransactionContext) {
-        self.context = ::protobuf::SingularPtrField::some(v);
+    pub fn set_publicKey(&mut self, v: ::std::vec::Vec<u8>) {
+        self.publicKey = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_context(&mut self) -> &mut TransactionContext {
-        if self.context.is_none() {
-            self.context.set_default();
-        }
-        self.context.as_mut().unwrap()
+    pub fn mut_publicKey(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.publicKey
     }
 
     // Take field
-    pub fn take_context(&mut self) -> TransactionContext {
-        self.context.take().unwrap_or_else(|| TransactionContext::new())
+    pub fn take_publicKey(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.publicKey, ::std::vec::Vec::new())
     }
 }
 
-impl ::protobuf::Message for SGXVMCreateRequest {
+impl ::protobuf::Message for NodePublicKeyResponse {
     fn is_initialized(&self) -> bool {
-        for v in &self.params {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
-        for v in &self.context {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
         true
     }
 
@@ -15377,10 +18658,7 @@ impl ::protobuf::Message for SGXVMCreateRequest {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.params)?;
-                },
-                2 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.context)?;
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.publicKey)?;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -15394,13 +18672,8 @@ impl ::protobuf::Message for SGXVMCreateRequest {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if let Some(ref v) = self.params.as_ref() {
-            let len = v.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
-        }
-        if let Some(ref v) = self.context.as_ref() {
-            let len = v.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        if !self.publicKey.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(1, &self.publicKey);
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -15408,15 +18681,8 @@ impl ::protobuf::Message for SGXVMCreateRequest {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
-        if let Some(ref v) = self.params.as_ref() {
-            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
-        }
-        if let Some(ref v) = self.context.as_ref() {
-            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
+        if !self.publicKey.is_empty() {
+            os.write_bytes(1, &self.publicKey)?;
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -15448,8 +18714,8 @@ impl ::protobuf::Message for SGXVMCreateRequest {
         Self::descriptor_static()
     }
 
-    fn new() -> SGXVMCreateRequest {
-        SGXVMCreateRequest::new()
+    fn new() -> NodePublicKeyResponse {
+        NodePublicKeyResponse::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -15460,18 +18726,13 @@ impl ::protobuf::Message for SGXVMCreateRequest {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<SGXVMCreateParams>>(
-                    "params",
-                    |m: &SGXVMCreateRequest| { &m.params },
-                    |m: &mut SGXVMCreateRequest| { &mut m.params },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionContext>>(
-                    "context",
-                    |m: &SGXVMCreateRequest| { &m.context },
-                    |m: &mut SGXVMCreateRequest| { &mut m.context },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "publicKey",
+                    |m: &NodePublicKeyResponse| { &m.publicKey },
+                    |m: &mut NodePublicKeyResponse| { &mut m.publicKey },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<SGXVMCreateRequest>(
-                    "SGXVMCreateRequest",
+                ::protobuf::reflect::MessageDescriptor::new::<NodePublicKeyResponse>(
+                    "NodePublicKeyResponse",
                     fields,
                     file_descriptor_proto()
                 )
@@ -15479,299 +18740,181 @@ impl ::protobuf::Message for SGXVMCreateRequest {
         }
     }
 
-    fn default_instance() -> &'static SGXVMCreateRequest {
-        static mut instance: ::protobuf::lazy::Lazy<SGXVMCreateRequest> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static NodePublicKeyResponse {
+        static mut instance: ::protobuf::lazy::Lazy<NodePublicKeyResponse> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const SGXVMCreateRequest,
+            ptr: 0 as *const NodePublicKeyResponse,
         };
         unsafe {
-            instance.get(SGXVMCreateRequest::new)
+            instance.get(NodePublicKeyResponse::new)
         }
     }
 }
 
-impl ::protobuf::Clear for SGXVMCreateRequest {
+impl ::protobuf::Clear for NodePublicKeyResponse {
     fn clear(&mut self) {
-        self.params.clear();
-        self.context.clear();
+        self.publicKey.clear();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for SGXVMCreateRequest {
+impl ::std::fmt::Debug for NodePublicKeyResponse {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for SGXVMCreateRequest {
+impl ::protobuf::reflect::ProtobufValue for NodePublicKeyResponse {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct SGXVMEstimateGasRequest {
+pub struct StorageAtEncryptedRequest {
     // message fields
-    pub params: ::protobuf::SingularPtrField<SGXVMEstimateGasParams>,
-    pub context: ::protobuf::SingularPtrField<TransactionContext>,
+    pub contractAddress: ::std::vec::Vec<u8>,
+    pub storageKey: ::std::vec::Vec<u8>,
+    pub userPublicKey: ::std::vec::Vec<u8>,
+    pub signature: ::std::vec::Vec<u8>,
+    pub deploymentNonce: u64,
+    pub blockNumber: u64,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
 }
 
-impl<'a> ::std::default::Default for &'a SGXVMEstimateGasRequest {
-    fn default() -> &'a SGXVMEstimateGasRequest {
-        <SGXVMEstimateGasRequest as ::protobuf::Message>::default_instance()
+impl<'a> ::std::default::Default for &'a StorageAtEncryptedRequest {
+    fn default() -> &'a StorageAtEncryptedRequest {
+        <StorageAtEncryptedRequest as ::protobuf::Message>::default_instance()
     }
 }
 
-impl SGXVMEstimateGasRequest {
-    pub fn new() -> SGXVMEstimateGasRequest {
+impl StorageAtEncryptedRequest {
+    pub fn new() -> StorageAtEncryptedRequest {
         ::std::default::Default::default()
     }
 
-    // .ffi.ffi.SGXVMEstimateGasParams params = 1;
+    // bytes contractAddress = 1;
 
 
-    pub fn get_params(&self) -> &SGXVMEstimateGasParams {
-        self.params.as_ref().unwrap_or_else(|| SGXVMEstimateGasParams::default_instance())
-    }
-    pub fn clear_params(&mut self) {
-        self.params.clear();
+    pub fn get_contractAddress(&self) -> &[u8] {
+        &self.contractAddress
     }
-
-    pub fn has_params(&self) -> bool {
-        self.params.is_some()
+    pub fn clear_contractAddress(&mut self) {
+        self.contractAddress.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_params(&mut self, v: SGXVMEstimateGasParams) {
-        self.params = ::protobuf::SingularPtrField::some(v);
+    pub fn set_contractAddress(&mut self, v: ::std::vec::Vec<u8>) {
+        self.contractAddress = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_params(&mut self) -> &mut SGXVMEstimateGasParams {
-        if self.params.is_none() {
-            self.params.set_default();
-        }
-        self.params.as_mut().unwrap()
+    pub fn mut_contractAddress(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.contractAddress
     }
 
     // Take field
-    pub fn take_params(&mut self) -> SGXVMEstimateGasParams {
-        self.params.take().unwrap_or_else(|| SGXVMEstimateGasParams::new())
+    pub fn take_contractAddress(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.contractAddress, ::std::vec::Vec::new())
     }
 
-    // .ffi.ffi.TransactionContext context = 2;
+    // bytes storageKey = 2;
 
 
-    pub fn get_context(&self) -> &TransactionContext {
-        self.context.as_ref().unwrap_or_else(|| TransactionContext::default_instance())
-    }
-    pub fn clear_context(&mut self) {
-        self.context.clear();
+    pub fn get_storageKey(&self) -> &[u8] {
+        &self.storageKey
     }
-
-    pub fn has_context(&self) -> bool {
-        self.context.is_some()
+    pub fn clear_storageKey(&mut self) {
+        self.storageKey.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_context(&mut self, v: TransactionContext) {
-        self.context = ::protobuf::SingularPtrField::some(v);
+    pub fn set_storageKey(&mut self, v: ::std::vec::Vec<u8>) {
+        self.storageKey = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_context(&mut self) -> &mut TransactionContext {
-        if self.context.is_none() {
-            self.context.set_default();
-        }
-        self.context.as_mut().unwrap()
+    pub fn mut_storageKey(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.storageKey
     }
 
     // Take field
-    pub fn take_context(&mut self) -> TransactionContext {
-        self.context.take().unwrap_or_else(|| TransactionContext::new())
-    }
-}
-
-impl ::protobuf::Message for SGXVMEstimateGasRequest {
-    fn is_initialized(&self) -> bool {
-        for v in &self.params {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
-        for v in &self.context {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
-        true
+    pub fn take_storageKey(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.storageKey, ::std::vec::Vec::new())
     }
 
-    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream<'_>) -> ::protobuf::ProtobufResult<()> {
-        while !is.eof()? {
-            let (field_number, wire_type) = is.read_tag_unpack()?;
-            match field_number {
-                1 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.params)?;
-                },
-                2 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.context)?;
-                },
-                _ => {
-                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
-                },
-            };
-        }
-        ::std::result::Result::Ok(())
-    }
+    // bytes userPublicKey = 3;
 
-    // Compute sizes of nested messages
-    #[allow(unused_variables)]
-    fn compute_size(&self) -> u32 {
-        let mut my_size = 0;
-        if let Some(ref v) = self.params.as_ref() {
-            let len = v.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
-        }
-        if let Some(ref v) = self.context.as_ref() {
-            let len = v.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
-        }
-        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
-        self.cached_size.set(my_size);
-        my_size
-    }
 
-    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
-        if let Some(ref v) = self.params.as_ref() {
-            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
-        }
-        if let Some(ref v) = self.context.as_ref() {
-            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
-        }
-        os.write_unknown_fields(self.get_unknown_fields())?;
-        ::std::result::Result::Ok(())
+    pub fn get_userPublicKey(&self) -> &[u8] {
+        &self.userPublicKey
     }
-
-    fn get_cached_size(&self) -> u32 {
-        self.cached_size.get()
+    pub fn clear_userPublicKey(&mut self) {
+        self.userPublicKey.clear();
     }
 
-    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
-        &self.unknown_fields
+    // Param is passed by value, moved
+    pub fn set_userPublicKey(&mut self, v: ::std::vec::Vec<u8>) {
+        self.userPublicKey = v;
     }
 
-    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
-        &mut self.unknown_fields
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_userPublicKey(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.userPublicKey
     }
 
-    fn as_any(&self) -> &dyn (::std::any::Any) {
-        self as &dyn (::std::any::Any)
-    }
-    fn as_any_mut(&mut self) -> &mut dyn (::std::any::Any) {
-        self as &mut dyn (::std::any::Any)
-    }
-    fn into_any(self: Box<Self>) -> ::std::boxed::Box<dyn (::std::any::Any)> {
-        self
+    // Take field
+    pub fn take_userPublicKey(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.userPublicKey, ::std::vec::Vec::new())
     }
 
-    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
-        Self::descriptor_static()
-    }
+    // bytes signature = 4;
 
-    fn new() -> SGXVMEstimateGasRequest {
-        SGXVMEstimateGasRequest::new()
-    }
 
-    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
-        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
-            lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
-        };
-        unsafe {
-            descriptor.get(|| {
-                let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<SGXVMEstimateGasParams>>(
-                    "params",
-                    |m: &SGXVMEstimateGasRequest| { &m.params },
-                    |m: &mut SGXVMEstimateGasRequest| { &mut m.params },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<TransactionContext>>(
-                    "context",
-                    |m: &SGXVMEstimateGasRequest| { &m.context },
-                    |m: &mut SGXVMEstimateGasRequest| { &mut m.context },
-                ));
-                ::protobuf::reflect::MessageDescriptor::new::<SGXVMEstimateGasRequest>(
-                    "SGXVMEstimateGasRequest",
-                    fields,
-                    file_descriptor_proto()
-                )
-            })
-        }
+    pub fn get_signature(&self) -> &[u8] {
+        &self.signature
     }
-
-    fn default_instance() -> &'static SGXVMEstimateGasRequest {
-        static mut instance: ::protobuf::lazy::Lazy<SGXVMEstimateGasRequest> = ::protobuf::lazy::Lazy {
-            lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const SGXVMEstimateGasRequest,
-        };
-        unsafe {
-            instance.get(SGXVMEstimateGasRequest::new)
-        }
+    pub fn clear_signature(&mut self) {
+        self.signature.clear();
     }
-}
 
-impl ::protobuf::Clear for SGXVMEstimateGasRequest {
-    fn clear(&mut self) {
-        self.params.clear();
-        self.context.clear();
-        self.unknown_fields.clear();
+    // Param is passed by value, moved
+    pub fn set_signature(&mut self, v: ::std::vec::Vec<u8>) {
+        self.signature = v;
     }
-}
 
-impl ::std::fmt::Debug for SGXVMEstimateGasRequest {
-    fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
-        ::protobuf::text_format::fmt(self, f)
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_signature(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.signature
     }
-}
 
-impl ::protobuf::reflect::ProtobufValue for SGXVMEstimateGasRequest {
-    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
-        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    // Take field
+    pub fn take_signature(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.signature, ::std::vec::Vec::new())
     }
-}
 
-#[derive(PartialEq,Clone,Default)]
-pub struct NodePublicKeyRequest {
-    // message fields
-    pub blockNumber: u64,
-    // special fields
-    pub unknown_fields: ::protobuf::UnknownFields,
-    pub cached_size: ::protobuf::CachedSize,
-}
+    // uint64 deploymentNonce = 5;
 
-impl<'a> ::std::default::Default for &'a NodePublicKeyRequest {
-    fn default() -> &'a NodePublicKeyRequest {
-        <NodePublicKeyRequest as ::protobuf::Message>::default_instance()
+
+    pub fn get_deploymentNonce(&self) -> u64 {
+        self.deploymentNonce
+    }
+    pub fn clear_deploymentNonce(&mut self) {
+        self.deploymentNonce = 0;
     }
-}
 
-impl NodePublicKeyRequest {
-    pub fn new() -> NodePublicKeyRequest {
-        ::std::default::Default::default()
+    // Param is passed by value, moved
+    pub fn set_deploymentNonce(&mut self, v: u64) {
+        self.deploymentNonce = v;
     }
 
-    // uint64 blockNumber = 1;
+    // uint64 blockNumber = 6;
 
 
     pub fn get_blockNumber(&self) -> u64 {
@@ -15787,7 +18930,7 @@ impl NodePublicKeyRequest {
     }
 }
 
-impl ::protobuf::Message for NodePublicKeyRequest {
+impl ::protobuf::Message for StorageAtEncryptedRequest {
     fn is_initialized(&self) -> bool {
         true
     }
@@ -15797,6 +18940,25 @@ impl ::protobuf::Message for NodePublicKeyRequest {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.contractAddress)?;
+                },
+                2 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.storageKey)?;
+                },
+                3 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.userPublicKey)?;
+                },
+                4 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.signature)?;
+                },
+                5 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_uint64()?;
+                    self.deploymentNonce = tmp;
+                },
+                6 => {
                     if wire_type != ::protobuf::wire_format::WireTypeVarint {
                         return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
                     }
@@ -15815,8 +18977,23 @@ impl ::protobuf::Message for NodePublicKeyRequest {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
+        if !self.contractAddress.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(1, &self.contractAddress);
+        }
+        if !self.storageKey.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(2, &self.storageKey);
+        }
+        if !self.userPublicKey.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(3, &self.userPublicKey);
+        }
+        if !self.signature.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(4, &self.signature);
+        }
+        if self.deploymentNonce != 0 {
+            my_size += ::protobuf::rt::value_size(5, self.deploymentNonce, ::protobuf::wire_format::WireTypeVarint);
+        }
         if self.blockNumber != 0 {
-            my_size += ::protobuf::rt::value_size(1, self.blockNumber, ::protobuf::wire_format::WireTypeVarint);
+            my_size += ::protobuf::rt::value_size(6, self.blockNumber, ::protobuf::wire_format::WireTypeVarint);
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -15824,8 +19001,23 @@ impl ::protobuf::Message for NodePublicKeyRequest {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
+        if !self.contractAddress.is_empty() {
+            os.write_bytes(1, &self.contractAddress)?;
+        }
+        if !self.storageKey.is_empty() {
+            os.write_bytes(2, &self.storageKey)?;
+        }
+        if !self.userPublicKey.is_empty() {
+            os.write_bytes(3, &self.userPublicKey)?;
+        }
+        if !self.signature.is_empty() {
+            os.write_bytes(4, &self.signature)?;
+        }
+        if self.deploymentNonce != 0 {
+            os.write_uint64(5, self.deploymentNonce)?;
+        }
         if self.blockNumber != 0 {
-            os.write_uint64(1, self.blockNumber)?;
+            os.write_uint64(6, self.blockNumber)?;
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -15857,8 +19049,8 @@ impl ::protobuf::Message for NodePublicKeyRequest {
         Self::descriptor_static()
     }
 
-    fn new() -> NodePublicKeyRequest {
-        NodePublicKeyRequest::new()
+    fn new() -> StorageAtEncryptedRequest {
+        StorageAtEncryptedRequest::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -15869,13 +19061,38 @@ impl ::protobuf::Message for NodePublicKeyRequest {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "contractAddress",
+                    |m: &StorageAtEncryptedRequest| { &m.contractAddress },
+                    |m: &mut StorageAtEncryptedRequest| { &mut m.contractAddress },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "storageKey",
+                    |m: &StorageAtEncryptedRequest| { &m.storageKey },
+                    |m: &mut StorageAtEncryptedRequest| { &mut m.storageKey },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "userPublicKey",
+                    |m: &StorageAtEncryptedRequest| { &m.userPublicKey },
+                    |m: &mut StorageAtEncryptedRequest| { &mut m.userPublicKey },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "signature",
+                    |m: &StorageAtEncryptedRequest| { &m.signature },
+                    |m: &mut StorageAtEncryptedRequest| { &mut m.signature },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
+                    "deploymentNonce",
+                    |m: &StorageAtEncryptedRequest| { &m.deploymentNonce },
+                    |m: &mut StorageAtEncryptedRequest| { &mut m.deploymentNonce },
+                ));
                 fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeUint64>(
                     "blockNumber",
-                    |m: &NodePublicKeyRequest| { &m.blockNumber },
-                    |m: &mut NodePublicKeyRequest| { &mut m.blockNumber },
+                    |m: &StorageAtEncryptedRequest| { &m.blockNumber },
+                    |m: &mut StorageAtEncryptedRequest| { &mut m.blockNumber },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<NodePublicKeyRequest>(
-                    "NodePublicKeyRequest",
+                ::protobuf::reflect::MessageDescriptor::new::<StorageAtEncryptedRequest>(
+                    "StorageAtEncryptedRequest",
                     fields,
                     file_descriptor_proto()
                 )
@@ -15883,84 +19100,116 @@ impl ::protobuf::Message for NodePublicKeyRequest {
         }
     }
 
-    fn default_instance() -> &'static NodePublicKeyRequest {
-        static mut instance: ::protobuf::lazy::Lazy<NodePublicKeyRequest> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static StorageAtEncryptedRequest {
+        static mut instance: ::protobuf::lazy::Lazy<StorageAtEncryptedRequest> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const NodePublicKeyRequest,
+            ptr: 0 as *const StorageAtEncryptedRequest,
         };
         unsafe {
-            instance.get(NodePublicKeyRequest::new)
+            instance.get(StorageAtEncryptedRequest::new)
         }
     }
 }
 
-impl ::protobuf::Clear for NodePublicKeyRequest {
+impl ::protobuf::Clear for StorageAtEncryptedRequest {
     fn clear(&mut self) {
+        self.contractAddress.clear();
+        self.storageKey.clear();
+        self.userPublicKey.clear();
+        self.signature.clear();
+        self.deploymentNonce = 0;
         self.blockNumber = 0;
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for NodePublicKeyRequest {
+impl ::std::fmt::Debug for StorageAtEncryptedRequest {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for NodePublicKeyRequest {
+impl ::protobuf::reflect::ProtobufValue for StorageAtEncryptedRequest {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct NodePublicKeyResponse {
+pub struct StorageAtEncryptedResponse {
     // message fields
-    pub publicKey: ::std::vec::Vec<u8>,
+    pub encryptedValue: ::std::vec::Vec<u8>,
+    pub nodePublicKey: ::std::vec::Vec<u8>,
     // special fields
     pub unknown_fields: ::protobuf::UnknownFields,
     pub cached_size: ::protobuf::CachedSize,
 }
 
-impl<'a> ::std::default::Default for &'a NodePublicKeyResponse {
-    fn default() -> &'a NodePublicKeyResponse {
-        <NodePublicKeyResponse as ::protobuf::Message>::default_instance()
+impl<'a> ::std::default::Default for &'a StorageAtEncryptedResponse {
+    fn default() -> &'a StorageAtEncryptedResponse {
+        <StorageAtEncryptedResponse as ::protobuf::Message>::default_instance()
     }
 }
 
-impl NodePublicKeyResponse {
-    pub fn new() -> NodePublicKeyResponse {
+impl StorageAtEncryptedResponse {
+    pub fn new() -> StorageAtEncryptedResponse {
         ::std::default::Default::default()
     }
 
-    // bytes publicKey = 1;
+    // bytes encryptedValue = 1;
 
 
-    pub fn get_publicKey(&self) -> &[u8] {
-        &self.publicKey
+    pub fn get_encryptedValue(&self) -> &[u8] {
+        &self.encryptedValue
     }
-    pub fn clear_publicKey(&mut self) {
-        self.publicKey.clear();
+    pub fn clear_encryptedValue(&mut self) {
+        self.encryptedValue.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_publicKey(&mut self, v: ::std::vec::Vec<u8>) {
-        self.publicKey = v;
+    pub fn set_encryptedValue(&mut self, v: ::std::vec::Vec<u8>) {
+        self.encryptedValue = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_publicKey(&mut self) -> &mut ::std::vec::Vec<u8> {
-        &mut self.publicKey
+    pub fn mut_encryptedValue(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.encryptedValue
     }
 
     // Take field
-    pub fn take_publicKey(&mut self) -> ::std::vec::Vec<u8> {
-        ::std::mem::replace(&mut self.publicKey, ::std::vec::Vec::new())
+    pub fn take_encryptedValue(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.encryptedValue, ::std::vec::Vec::new())
+    }
+
+    // bytes nodePublicKey = 2;
+
+
+    pub fn get_nodePublicKey(&self) -> &[u8] {
+        &self.nodePublicKey
+    }
+    pub fn clear_nodePublicKey(&mut self) {
+        self.nodePublicKey.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_nodePublicKey(&mut self, v: ::std::vec::Vec<u8>) {
+        self.nodePublicKey = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_nodePublicKey(&mut self) -> &mut ::std::vec::Vec<u8> {
+        &mut self.nodePublicKey
+    }
+
+    // Take field
+    pub fn take_nodePublicKey(&mut self) -> ::std::vec::Vec<u8> {
+        ::std::mem::replace(&mut self.nodePublicKey, ::std::vec::Vec::new())
     }
 }
 
-impl ::protobuf::Message for NodePublicKeyResponse {
+impl ::protobuf::Message for StorageAtEncryptedResponse {
     fn is_initialized(&self) -> bool {
         true
     }
@@ -15970,7 +19219,10 @@ impl ::protobuf::Message for NodePublicKeyResponse {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
-                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.publicKey)?;
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.encryptedValue)?;
+                },
+                2 => {
+                    ::protobuf::rt::read_singular_proto3_bytes_into(wire_type, is, &mut self.nodePublicKey)?;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -15984,8 +19236,11 @@ impl ::protobuf::Message for NodePublicKeyResponse {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if !self.publicKey.is_empty() {
-            my_size += ::protobuf::rt::bytes_size(1, &self.publicKey);
+        if !self.encryptedValue.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(1, &self.encryptedValue);
+        }
+        if !self.nodePublicKey.is_empty() {
+            my_size += ::protobuf::rt::bytes_size(2, &self.nodePublicKey);
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -15993,8 +19248,11 @@ impl ::protobuf::Message for NodePublicKeyResponse {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream<'_>) -> ::protobuf::ProtobufResult<()> {
-        if !self.publicKey.is_empty() {
-            os.write_bytes(1, &self.publicKey)?;
+        if !self.encryptedValue.is_empty() {
+            os.write_bytes(1, &self.encryptedValue)?;
+        }
+        if !self.nodePublicKey.is_empty() {
+            os.write_bytes(2, &self.nodePublicKey)?;
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -16026,8 +19284,8 @@ impl ::protobuf::Message for NodePublicKeyResponse {
         Self::descriptor_static()
     }
 
-    fn new() -> NodePublicKeyResponse {
-        NodePublicKeyResponse::new()
+    fn new() -> StorageAtEncryptedResponse {
+        StorageAtEncryptedResponse::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -16039,12 +19297,17 @@ impl ::protobuf::Message for NodePublicKeyResponse {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
                 fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
-                    "publicKey",
-                    |m: &NodePublicKeyResponse| { &m.publicKey },
-                    |m: &mut NodePublicKeyResponse| { &mut m.publicKey },
+                    "encryptedValue",
+                    |m: &StorageAtEncryptedResponse| { &m.encryptedValue },
+                    |m: &mut StorageAtEncryptedResponse| { &mut m.encryptedValue },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<NodePublicKeyResponse>(
-                    "NodePublicKeyResponse",
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBytes>(
+                    "nodePublicKey",
+                    |m: &StorageAtEncryptedResponse| { &m.nodePublicKey },
+                    |m: &mut StorageAtEncryptedResponse| { &mut m.nodePublicKey },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<StorageAtEncryptedResponse>(
+                    "StorageAtEncryptedResponse",
                     fields,
                     file_descriptor_proto()
                 )
@@ -16052,31 +19315,32 @@ impl ::protobuf::Message for NodePublicKeyResponse {
         }
     }
 
-    fn default_instance() -> &'static NodePublicKeyResponse {
-        static mut instance: ::protobuf::lazy::Lazy<NodePublicKeyResponse> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static StorageAtEncryptedResponse {
+        static mut instance: ::protobuf::lazy::Lazy<StorageAtEncryptedResponse> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const NodePublicKeyResponse,
+            ptr: 0 as *const StorageAtEncryptedResponse,
         };
         unsafe {
-            instance.get(NodePublicKeyResponse::new)
+            instance.get(StorageAtEncryptedResponse::new)
         }
     }
 }
 
-impl ::protobuf::Clear for NodePublicKeyResponse {
+impl ::protobuf::Clear for StorageAtEncryptedResponse {
     fn clear(&mut self) {
-        self.publicKey.clear();
+        self.encryptedValue.clear();
+        self.nodePublicKey.clear();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for NodePublicKeyResponse {
+impl ::std::fmt::Debug for StorageAtEncryptedResponse {
     fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for NodePublicKeyResponse {
+impl ::protobuf::reflect::ProtobufValue for StorageAtEncryptedResponse {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
@@ -16518,6 +19782,7 @@ pub enum FFIRequest_oneof_req {
     createRequest(SGXVMCreateRequest),
     estimateGasRequest(SGXVMEstimateGasRequest),
     publicKeyRequest(NodePublicKeyRequest),
+    storageAtEncryptedRequest(StorageAtEncryptedRequest),
 }
 
 impl FFIRequest {
@@ -16720,6 +19985,55 @@ impl FFIRequest {
             NodePublicKeyRequest::new()
         }
     }
+
+    // .ffi.ffi.StorageAtEncryptedRequest storageAtEncryptedRequest = 5;
+
+
+    pub fn get_storageAtEncryptedRequest(&self) -> &StorageAtEncryptedRequest {
+        match self.req {
+            ::std::option::Option::Some(FFIRequest_oneof_req::storageAtEncryptedRequest(ref v)) => v,
+            _ => StorageAtEncryptedRequest::default_instance(),
+        }
+    }
+    pub fn clear_storageAtEncryptedRequest(&mut self) {
+        self.req = ::std::option::Option::None;
+    }
+
+    pub fn has_storageAtEncryptedRequest(&self) -> bool {
+        match self.req {
+            ::std::option::Option::Some(FFIRequest_oneof_req::storageAtEncryptedRequest(..)) => true,
+            _ => false,
+        }
+    }
+
+    // Param is passed by value, moved
+    pub fn set_storageAtEncryptedRequest(&mut self, v: StorageAtEncryptedRequest) {
+        self.req = ::std::option::Option::Some(FFIRequest_oneof_req::storageAtEncryptedRequest(v))
+    }
+
+    // Mutable pointer to the field.
+    pub fn mut_storageAtEncryptedRequest(&mut self) -> &mut StorageAtEncryptedRequest {
+        if let ::std::option::Option::Some(FFIRequest_oneof_req::storageAtEncryptedRequest(_)) = self.req {
+        } else {
+            self.req = ::std::option::Option::Some(FFIRequest_oneof_req::storageAtEncryptedRequest(StorageAtEncryptedRequest::new()));
+        }
+        match self.req {
+            ::std::option::Option::Some(FFIRequest_oneof_req::storageAtEncryptedRequest(ref mut v)) => v,
+            _ => panic!(),
+        }
+    }
+
+    // Take field
+    pub fn take_storageAtEncryptedRequest(&mut self) -> StorageAtEncryptedRequest {
+        if self.has_storageAtEncryptedRequest() {
+            match self.req.take() {
+                ::std::option::Option::Some(FFIRequest_oneof_req::storageAtEncryptedRequest(v)) => v,
+                _ => panic!(),
+            }
+        } else {
+            StorageAtEncryptedRequest::new()
+        }
+    }
 }
 
 impl ::protobuf::Message for FFIRequest {
@@ -16744,6 +20058,11 @@ impl ::protobuf::Message for FFIRequest {
                 return false;
             }
         }
+        if let Some(FFIRequest_oneof_req::storageAtEncryptedRequest(ref v)) = self.req {
+            if !v.is_initialized() {
+                return false;
+            }
+        }
         true
     }
 
@@ -16775,6 +20094,12 @@ impl ::protobuf::Message for FFIRequest {
                     }
                     self.req = ::std::option::Option::Some(FFIRequest_oneof_req::publicKeyRequest(is.read_message()?));
                 },
+                5 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeLengthDelimited {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    self.req = ::std::option::Option::Some(FFIRequest_oneof_req::storageAtEncryptedRequest(is.read_message()?));
+                },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
                 },
@@ -16805,6 +20130,10 @@ impl ::protobuf::Message for FFIRequest {
                     let len = v.compute_size();
                     my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
                 },
+                &FFIRequest_oneof_req::storageAtEncryptedRequest(ref v) => {
+                    let len = v.compute_size();
+                    my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+                },
             };
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
@@ -16835,6 +20164,11 @@ impl ::protobuf::Message for FFIRequest {
                     os.write_raw_varint32(v.get_cached_size())?;
                     v.write_to_with_cached_sizes(os)?;
                 },
+                &FFIRequest_oneof_req::storageAtEncryptedRequest(ref v) => {
+                    os.write_tag(5, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+                    os.write_raw_varint32(v.get_cached_size())?;
+                    v.write_to_with_cached_sizes(os)?;
+                },
             };
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
@@ -16899,6 +20233,11 @@ impl ::protobuf::Message for FFIRequest {
                     FFIRequest::has_publicKeyRequest,
                     FFIRequest::get_publicKeyRequest,
                 ));
+                fields.push(::protobuf::reflect::accessor::make_singular_message_accessor::<_, StorageAtEncryptedRequest>(
+                    "storageAtEncryptedRequest",
+                    FFIRequest::has_storageAtEncryptedRequest,
+                    FFIRequest::get_storageAtEncryptedRequest,
+                ));
                 ::protobuf::reflect::MessageDescriptor::new::<FFIRequest>(
                     "FFIRequest",
                     fields,
@@ -16925,6 +20264,7 @@ impl ::protobuf::Clear for FFIRequest {
         self.req = ::std::option::Option::None;
         self.req = ::std::option::Option::None;
         self.req = ::std::option::Option::None;
+        self.req = ::std::option::Option::None;
         self.unknown_fields.clear();
     }
 }
@@ -16941,73 +20281,142 @@ impl ::protobuf::reflect::ProtobufValue for FFIRequest {
     }
 }
 
+#[derive(Clone,PartialEq,Eq,Debug,Hash)]
+pub enum TracerType {
+    TRACER_NONE = 0,
+    TRACER_STRUCT = 1,
+    TRACER_CALL = 2,
+    TRACER_ACCESS_LIST = 3,
+}
+
+impl ::protobuf::ProtobufEnum for TracerType {
+    fn value(&self) -> i32 {
+        *self as i32
+    }
+
+    fn from_i32(value: i32) -> ::std::option::Option<TracerType> {
+        match value {
+            0 => ::std::option::Option::Some(TracerType::TRACER_NONE),
+            1 => ::std::option::Option::Some(TracerType::TRACER_STRUCT),
+            2 => ::std::option::Option::Some(TracerType::TRACER_CALL),
+            3 => ::std::option::Option::Some(TracerType::TRACER_ACCESS_LIST),
+            _ => ::std::option::Option::None
+        }
+    }
+
+    fn values() -> &'static [Self] {
+        static values: &'static [TracerType] = &[
+            TracerType::TRACER_NONE,
+            TracerType::TRACER_STRUCT,
+            TracerType::TRACER_CALL,
+            TracerType::TRACER_ACCESS_LIST,
+        ];
+        values
+    }
+
+    fn enum_descriptor_static() -> &'static ::protobuf::reflect::EnumDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::EnumDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::EnumDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                ::protobuf::reflect::EnumDescriptor::new("TracerType", file_descriptor_proto())
+            })
+        }
+    }
+}
+
+impl ::std::marker::Copy for TracerType {
+}
+
+impl ::std::default::Default for TracerType {
+    fn default() -> Self {
+        TracerType::TRACER_NONE
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for TracerType {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Enum(self.descriptor())
+    }
+}
+
 static file_descriptor_proto_data: &'static [u8] = b"\
     \n\x0fproto/ffi.proto\x12\x07ffi.ffi\"L\n\x0eAccessListItem\x12\x20\n\
     \x0bstorageSlot\x18\x01\x20\x03(\x0cR\x0bstorageSlot\x12\x18\n\x07addres\
-    s\x18\x02\x20\x01(\x0cR\x07address\"\xb4\x01\n\x0fTransactionData\x12\
-    \x12\n\x04from\x18\x01\x20\x01(\x0cR\x04from\x12\x0e\n\x02to\x18\x02\x20\
-    \x01(\x0cR\x02to\x12\x12\n\x04data\x18\x03\x20\x01(\x0cR\x04data\x12\x1a\
-    \n\x08gasLimit\x18\x04\x20\x01(\x04R\x08gasLimit\x12\x14\n\x05value\x18\
-    \x05\x20\x01(\x0cR\x05value\x127\n\naccessList\x18\x06\x20\x03(\x0b2\x17\
-    .ffi.ffi.AccessListItemR\naccessList\"\x90\x02\n\x12TransactionContext\
-    \x12\x19\n\x08chain_id\x18\x01\x20\x01(\x04R\x07chainId\x12\x1b\n\tgas_p\
-    rice\x18\x02\x20\x01(\x0cR\x08gasPrice\x12\x1c\n\ttimestamp\x18\x03\x20\
-    \x01(\x04R\ttimestamp\x12&\n\x0fblock_gas_limit\x18\x04\x20\x01(\x04R\rb\
-    lockGasLimit\x122\n\x16block_base_fee_per_gas\x18\x05\x20\x01(\x0cR\x12b\
-    lockBaseFeePerGas\x12%\n\x0eblock_coinbase\x18\x06\x20\x01(\x0cR\rblockC\
-    oinbase\x12!\n\x0cblock_number\x18\x07\x20\x01(\x04R\x0bblockNumber\"\
-    \x89\x01\n\x18HandleTransactionRequest\x121\n\x07tx_data\x18\x01\x20\x01\
-    (\x0b2\x18.ffi.ffi.TransactionDataR\x06txData\x12:\n\ntx_context\x18\x02\
-    \x20\x01(\x0b2\x1b.ffi.ffi.TransactionContextR\ttxContext\"\x85\x01\n\
-    \x19HandleTransactionResponse\x12\x20\n\x04logs\x18\x02\x20\x03(\x0b2\
-    \x0c.ffi.ffi.LogR\x04logs\x12\x10\n\x03ret\x18\x03\x20\x01(\x0cR\x03ret\
-    \x12\x19\n\x08vm_error\x18\x04\x20\x01(\tR\x07vmError\x12\x19\n\x08gas_u\
-    sed\x18\x05\x20\x01(\x04R\x07gasUsed\"\x89\x01\n\x18HandleEstimateGasReq\
-    uest\x121\n\x07tx_data\x18\x01\x20\x01(\x0b2\x18.ffi.ffi.TransactionData\
-    R\x06txData\x12:\n\ntx_context\x18\x02\x20\x01(\x0b2\x1b.ffi.ffi.Transac\
-    tionContextR\ttxContext\"Q\n\x19HandleEstimateGasResponse\x12\x19\n\x08v\
-    m_error\x18\x01\x20\x01(\tR\x07vmError\x12\x19\n\x08gas_used\x18\x02\x20\
-    \x01(\x04R\x07gasUsed\"\x1d\n\x05Topic\x12\x14\n\x05inner\x18\x01\x20\
-    \x01(\x0cR\x05inner\"[\n\x03Log\x12\x18\n\x07address\x18\x01\x20\x01(\
-    \x0cR\x07address\x12&\n\x06topics\x18\x02\x20\x03(\x0b2\x0e.ffi.ffi.Topi\
-    cR\x06topics\x12\x12\n\x04data\x18\x03\x20\x01(\x0cR\x04data\"+\n\x0fQue\
-    ryGetAccount\x12\x18\n\x07address\x18\x01\x20\x01(\x0cR\x07address\"I\n\
-    \x17QueryGetAccountResponse\x12\x18\n\x07balance\x18\x01\x20\x01(\x0cR\
-    \x07balance\x12\x14\n\x05nonce\x18\x02\x20\x01(\x04R\x05nonce\"O\n\x19Qu\
-    eryInsertAccountBalance\x12\x18\n\x07address\x18\x01\x20\x01(\x0cR\x07ad\
-    dress\x12\x18\n\x07balance\x18\x02\x20\x01(\x0cR\x07balance\"#\n!QueryIn\
-    sertAccountBalanceResponse\"I\n\x17QueryInsertAccountNonce\x12\x18\n\x07\
-    address\x18\x01\x20\x01(\x0cR\x07address\x12\x14\n\x05nonce\x18\x02\x20\
-    \x01(\x04R\x05nonce\"!\n\x1fQueryInsertAccountNonceResponse\"$\n\x10Quer\
-    yContainsKey\x12\x10\n\x03key\x18\x01\x20\x01(\x0cR\x03key\"6\n\x18Query\
-    ContainsKeyResponse\x12\x1a\n\x08contains\x18\x01\x20\x01(\x08R\x08conta\
-    ins\"L\n\x1aQueryGetAccountStorageCell\x12\x18\n\x07address\x18\x01\x20\
-    \x01(\x0cR\x07address\x12\x14\n\x05index\x18\x02\x20\x01(\x0cR\x05index\
-    \":\n\"QueryGetAccountStorageCellResponse\x12\x14\n\x05value\x18\x01\x20\
-    \x01(\x0cR\x05value\"/\n\x13QueryGetAccountCode\x12\x18\n\x07address\x18\
-    \x01\x20\x01(\x0cR\x07address\"1\n\x1bQueryGetAccountCodeResponse\x12\
-    \x12\n\x04code\x18\x01\x20\x01(\x0cR\x04code\"3\n\x17QueryGetAccountCode\
-    Size\x12\x18\n\x07address\x18\x01\x20\x01(\x0cR\x07address\"5\n\x1fQuery\
-    GetAccountCodeSizeResponse\x12\x12\n\x04size\x18\x01\x20\x01(\rR\x04size\
-    \"3\n\x17QueryGetAccountCodeHash\x12\x18\n\x07address\x18\x01\x20\x01(\
-    \x0cR\x07address\"5\n\x1fQueryGetAccountCodeHashResponse\x12\x12\n\x04ha\
-    sh\x18\x01\x20\x01(\x0cR\x04hash\"F\n\x16QueryInsertAccountCode\x12\x18\
-    \n\x07address\x18\x01\x20\x01(\x0cR\x07address\x12\x12\n\x04code\x18\x02\
-    \x20\x01(\x0cR\x04code\"\x20\n\x1eQueryInsertAccountCodeResponse\"^\n\
-    \x16QueryInsertStorageCell\x12\x18\n\x07address\x18\x01\x20\x01(\x0cR\
-    \x07address\x12\x14\n\x05index\x18\x02\x20\x01(\x0cR\x05index\x12\x14\n\
-    \x05value\x18\x03\x20\x01(\x0cR\x05value\"\x20\n\x1eQueryInsertStorageCe\
-    llResponse\"'\n\x0bQueryRemove\x12\x18\n\x07address\x18\x01\x20\x01(\x0c\
-    R\x07address\"\x15\n\x13QueryRemoveResponse\"H\n\x16QueryRemoveStorageCe\
-    ll\x12\x18\n\x07address\x18\x01\x20\x01(\x0cR\x07address\x12\x14\n\x05in\
-    dex\x18\x02\x20\x01(\x0cR\x05index\"\x20\n\x1eQueryRemoveStorageCellResp\
-    onse\".\n\x12QueryRemoveStorage\x12\x18\n\x07address\x18\x01\x20\x01(\
-    \x0cR\x07address\"\x1c\n\x1aQueryRemoveStorageResponse\"(\n\x0eQueryBloc\
-    kHash\x12\x16\n\x06number\x18\x01\x20\x01(\x0cR\x06number\",\n\x16QueryB\
-    lockHashResponse\x12\x12\n\x04hash\x18\x01\x20\x01(\x0cR\x04hash\"\x17\n\
-    \x15QueryIssuanceTreeRoot\"3\n\x1dQueryIssuanceTreeRootResponse\x12\x12\
-    \n\x04root\x18\x01\x20\x01(\x0cR\x04root\"\x19\n\x17QueryRevocationTreeR\
-    oot\"5\n\x1fQueryRevocationTreeRootResponse\x12\x12\n\x04root\x18\x01\
+    s\x18\x02\x20\x01(\x0cR\x07address\"\x97\x01\n\x15AuthorizationListItem\
+    \x12\x18\n\x07chainId\x18\x01\x20\x01(\x0cR\x07chainId\x12\x18\n\x07addr\
+    ess\x18\x02\x20\x01(\x0cR\x07address\x12\x14\n\x05nonce\x18\x03\x20\x01(\
+    \x04R\x05nonce\x12\x18\n\x07yParity\x18\x04\x20\x01(\rR\x07yParity\x12\
+    \x0c\n\x01r\x18\x05\x20\x01(\x0cR\x01r\x12\x0c\n\x01s\x18\x06\x20\x01(\
+    \x0cR\x01s\"\xb4\x01\n\x0fTransactionData\x12\x12\n\x04from\x18\x01\x20\
+    \x01(\x0cR\x04from\x12\x0e\n\x02to\x18\x02\x20\x01(\x0cR\x02to\x12\x12\n\
+    \x04data\x18\x03\x20\x01(\x0cR\x04data\x12\x1a\n\x08gasLimit\x18\x04\x20\
+    \x01(\x04R\x08gasLimit\x12\x14\n\x05value\x18\x05\x20\x01(\x0cR\x05value\
+    \x127\n\naccessList\x18\x06\x20\x03(\x0b2\x17.ffi.ffi.AccessListItemR\na\
+    ccessList\"\x90\x02\n\x12TransactionContext\x12\x19\n\x08chain_id\x18\
+    \x01\x20\x01(\x04R\x07chainId\x12\x1b\n\tgas_price\x18\x02\x20\x01(\x0cR\
+    \x08gasPrice\x12\x1c\n\ttimestamp\x18\x03\x20\x01(\x04R\ttimestamp\x12&\
+    \n\x0fblock_gas_limit\x18\x04\x20\x01(\x04R\rblockGasLimit\x122\n\x16blo\
+    ck_base_fee_per_gas\x18\x05\x20\x01(\x0cR\x12blockBaseFeePerGas\x12%\n\
+    \x0eblock_coinbase\x18\x06\x20\x01(\x0cR\rblockCoinbase\x12!\n\x0cblock_\
+    number\x18\x07\x20\x01(\x04R\x0bblockNumber\"\x89\x01\n\x18HandleTransac\
+    tionRequest\x121\n\x07tx_data\x18\x01\x20\x01(\x0b2\x18.ffi.ffi.Transact\
+    ionDataR\x06txData\x12:\n\ntx_context\x18\x02\x20\x01(\x0b2\x1b.ffi.ffi.\
+    TransactionContextR\ttxContext\"\xd3\x01\n\x19HandleTransactionResponse\
+    \x12\x20\n\x04logs\x18\x02\x20\x03(\x0b2\x0c.ffi.ffi.LogR\x04logs\x12\
+    \x10\n\x03ret\x18\x03\x20\x01(\x0cR\x03ret\x12\x19\n\x08vm_error\x18\x04\
+    \x20\x01(\tR\x07vmError\x12\x19\n\x08gas_used\x18\x05\x20\x01(\x04R\x07g\
+    asUsed\x12-\n\x05trace\x18\x06\x20\x01(\x0b2\x17.ffi.ffi.ExecutionTraceR\
+    \x05trace\x12\x1d\n\ngas_refund\x18\x07\x20\x01(\x04R\tgasRefund\"\x89\
+    \x01\n\x18HandleEstimateGasRequest\x121\n\x07tx_data\x18\x01\x20\x01(\
+    \x0b2\x18.ffi.ffi.TransactionDataR\x06txData\x12:\n\ntx_context\x18\x02\
+    \x20\x01(\x0b2\x1b.ffi.ffi.TransactionContextR\ttxContext\"Q\n\x19Handle\
+    EstimateGasResponse\x12\x19\n\x08vm_error\x18\x01\x20\x01(\tR\x07vmError\
+    \x12\x19\n\x08gas_used\x18\x02\x20\x01(\x04R\x07gasUsed\"\x1d\n\x05Topic\
+    \x12\x14\n\x05inner\x18\x01\x20\x01(\x0cR\x05inner\"[\n\x03Log\x12\x18\n\
+    \x07address\x18\x01\x20\x01(\x0cR\x07address\x12&\n\x06topics\x18\x02\
+    \x20\x03(\x0b2\x0e.ffi.ffi.TopicR\x06topics\x12\x12\n\x04data\x18\x03\
+    \x20\x01(\x0cR\x04data\"+\n\x0fQueryGetAccount\x12\x18\n\x07address\x18\
+    \x01\x20\x01(\x0cR\x07address\"I\n\x17QueryGetAccountResponse\x12\x18\n\
+    \x07balance\x18\x01\x20\x01(\x0cR\x07balance\x12\x14\n\x05nonce\x18\x02\
+    \x20\x01(\x04R\x05nonce\"O\n\x19QueryInsertAccountBalance\x12\x18\n\x07a\
+    ddress\x18\x01\x20\x01(\x0cR\x07address\x12\x18\n\x07balance\x18\x02\x20\
+    \x01(\x0cR\x07balance\"#\n!QueryInsertAccountBalanceResponse\"I\n\x17Que\
+    ryInsertAccountNonce\x12\x18\n\x07address\x18\x01\x20\x01(\x0cR\x07addre\
+    ss\x12\x14\n\x05nonce\x18\x02\x20\x01(\x04R\x05nonce\"!\n\x1fQueryInsert\
+    AccountNonceResponse\"$\n\x10QueryContainsKey\x12\x10\n\x03key\x18\x01\
+    \x20\x01(\x0cR\x03key\"6\n\x18QueryContainsKeyResponse\x12\x1a\n\x08cont\
+    ains\x18\x01\x20\x01(\x08R\x08contains\"L\n\x1aQueryGetAccountStorageCel\
+    l\x12\x18\n\x07address\x18\x01\x20\x01(\x0cR\x07address\x12\x14\n\x05ind\
+    ex\x18\x02\x20\x01(\x0cR\x05index\":\n\"QueryGetAccountStorageCellRespon\
+    se\x12\x14\n\x05value\x18\x01\x20\x01(\x0cR\x05value\"/\n\x13QueryGetAcc\
+    ountCode\x12\x18\n\x07address\x18\x01\x20\x01(\x0cR\x07address\"1\n\x1bQ\
+    ueryGetAccountCodeResponse\x12\x12\n\x04code\x18\x01\x20\x01(\x0cR\x04co\
+    de\"3\n\x17QueryGetAccountCodeSize\x12\x18\n\x07address\x18\x01\x20\x01(\
+    \x0cR\x07address\"5\n\x1fQueryGetAccountCodeSizeResponse\x12\x12\n\x04si\
+    ze\x18\x01\x20\x01(\rR\x04size\"3\n\x17QueryGetAccountCodeHash\x12\x18\n\
+    \x07address\x18\x01\x20\x01(\x0cR\x07address\"5\n\x1fQueryGetAccountCode\
+    HashResponse\x12\x12\n\x04hash\x18\x01\x20\x01(\x0cR\x04hash\"F\n\x16Que\
+    ryInsertAccountCode\x12\x18\n\x07address\x18\x01\x20\x01(\x0cR\x07addres\
+    s\x12\x12\n\x04code\x18\x02\x20\x01(\x0cR\x04code\"\x20\n\x1eQueryInsert\
+    AccountCodeResponse\"^\n\x16QueryInsertStorageCell\x12\x18\n\x07address\
+    \x18\x01\x20\x01(\x0cR\x07address\x12\x14\n\x05index\x18\x02\x20\x01(\
+    \x0cR\x05index\x12\x14\n\x05value\x18\x03\x20\x01(\x0cR\x05value\"\x20\n\
+    \x1eQueryInsertStorageCellResponse\"'\n\x0bQueryRemove\x12\x18\n\x07addr\
+    ess\x18\x01\x20\x01(\x0cR\x07address\"\x15\n\x13QueryRemoveResponse\"H\n\
+    \x16QueryRemoveStorageCell\x12\x18\n\x07address\x18\x01\x20\x01(\x0cR\
+    \x07address\x12\x14\n\x05index\x18\x02\x20\x01(\x0cR\x05index\"\x20\n\
+    \x1eQueryRemoveStorageCellResponse\".\n\x12QueryRemoveStorage\x12\x18\n\
+    \x07address\x18\x01\x20\x01(\x0cR\x07address\"\x1c\n\x1aQueryRemoveStora\
+    geResponse\"(\n\x0eQueryBlockHash\x12\x16\n\x06number\x18\x01\x20\x01(\
+    \x0cR\x06number\",\n\x16QueryBlockHashResponse\x12\x12\n\x04hash\x18\x01\
+    \x20\x01(\x0cR\x04hash\"9\n\x15QueryIssuanceTreeRoot\x12\x20\n\x0bblockH\
+    eight\x18\x01\x20\x01(\x04R\x0bblockHeight\"3\n\x1dQueryIssuanceTreeRoot\
+    Response\x12\x12\n\x04root\x18\x01\x20\x01(\x0cR\x04root\";\n\x17QueryRe\
+    vocationTreeRoot\x12\x20\n\x0bblockHeight\x18\x01\x20\x01(\x04R\x0bblock\
+    Height\"5\n\x1fQueryRevocationTreeRootResponse\x12\x12\n\x04root\x18\x01\
     \x20\x01(\x0cR\x04root\"\x97\x03\n\x1bQueryAddVerificationDetails\x12\
     \x20\n\x0buserAddress\x18\x01\x20\x01(\x0cR\x0buserAddress\x12$\n\rissue\
     rAddress\x18\x02\x20\x01(\x0cR\rissuerAddress\x12\x20\n\x0boriginChain\
@@ -17088,7 +20497,7 @@ static file_descriptor_proto_data: &'static [u8] = b"\
     evokeVerification\x18\x15\x20\x01(\x0b2\x20.ffi.ffi.QueryRevokeVerificat\
     ionH\0R\x12revokeVerification\x12O\n\x11convertCredential\x18\x16\x20\
     \x01(\x0b2\x1f.ffi.ffi.QueryConvertCredentialH\0R\x11convertCredentialB\
-    \x05\n\x03req\"\xae\x03\n\x0fSGXVMCallParams\x12\x12\n\x04from\x18\x01\
+    \x05\n\x03req\"\xa9\x04\n\x0fSGXVMCallParams\x12\x12\n\x04from\x18\x01\
     \x20\x01(\x0cR\x04from\x12\x0e\n\x02to\x18\x02\x20\x01(\x0cR\x02to\x12\
     \x12\n\x04data\x18\x03\x20\x01(\x0cR\x04data\x12\x1a\n\x08gasLimit\x18\
     \x04\x20\x01(\x04R\x08gasLimit\x12\x1a\n\x08gasPrice\x18\x05\x20\x01(\
@@ -17099,677 +20508,943 @@ static file_descriptor_proto_data: &'static [u8] = b"\
     \x08R\x0bunencrypted\x12\x1c\n\tsignature\x18\x0b\x20\x01(\x0cR\tsignatu\
     re\x122\n\x14maxPriorityFeePerGas\x18\x0c\x20\x01(\x0cR\x14maxPriorityFe\
     ePerGas\x12\"\n\x0cmaxFeePerGas\x18\r\x20\x01(\x0cR\x0cmaxFeePerGas\x12\
-    \x16\n\x06txType\x18\x0e\x20\x01(\rR\x06txType\"\xfe\x02\n\x11SGXVMCreat\
-    eParams\x12\x12\n\x04from\x18\x01\x20\x01(\x0cR\x04from\x12\x12\n\x04dat\
-    a\x18\x02\x20\x01(\x0cR\x04data\x12\x1a\n\x08gasLimit\x18\x03\x20\x01(\
-    \x04R\x08gasLimit\x12\x1a\n\x08gasPrice\x18\x04\x20\x01(\x0cR\x08gasPric\
-    e\x12\x14\n\x05value\x18\x05\x20\x01(\x0cR\x05value\x127\n\naccessList\
-    \x18\x06\x20\x03(\x0b2\x17.ffi.ffi.AccessListItemR\naccessList\x12\x16\n\
-    \x06commit\x18\x07\x20\x01(\x08R\x06commit\x12\x14\n\x05nonce\x18\x08\
-    \x20\x01(\x04R\x05nonce\x12\x1c\n\tsignature\x18\t\x20\x01(\x0cR\tsignat\
-    ure\x122\n\x14maxPriorityFeePerGas\x18\n\x20\x01(\x0cR\x14maxPriorityFee\
-    PerGas\x12\"\n\x0cmaxFeePerGas\x18\x0b\x20\x01(\x0cR\x0cmaxFeePerGas\x12\
-    \x16\n\x06txType\x18\x0c\x20\x01(\rR\x06txType\"\xb5\x03\n\x16SGXVMEstim\
-    ateGasParams\x12\x12\n\x04from\x18\x01\x20\x01(\x0cR\x04from\x12\x0e\n\
-    \x02to\x18\x02\x20\x01(\x0cR\x02to\x12\x12\n\x04data\x18\x03\x20\x01(\
-    \x0cR\x04data\x12\x1a\n\x08gasLimit\x18\x04\x20\x01(\x04R\x08gasLimit\
-    \x12\x1a\n\x08gasPrice\x18\x05\x20\x01(\x0cR\x08gasPrice\x12\x14\n\x05va\
-    lue\x18\x06\x20\x01(\x0cR\x05value\x127\n\naccessList\x18\x07\x20\x03(\
-    \x0b2\x17.ffi.ffi.AccessListItemR\naccessList\x12\x16\n\x06commit\x18\
-    \x08\x20\x01(\x08R\x06commit\x12\x14\n\x05nonce\x18\t\x20\x01(\x04R\x05n\
-    once\x12\x20\n\x0bunencrypted\x18\n\x20\x01(\x08R\x0bunencrypted\x12\x1c\
-    \n\tsignature\x18\x0b\x20\x01(\x0cR\tsignature\x122\n\x14maxPriorityFeeP\
-    erGas\x18\x0c\x20\x01(\x0cR\x14maxPriorityFeePerGas\x12\"\n\x0cmaxFeePer\
-    Gas\x18\r\x20\x01(\x0cR\x0cmaxFeePerGas\x12\x16\n\x06txType\x18\x0e\x20\
-    \x01(\rR\x06txType\"{\n\x10SGXVMCallRequest\x120\n\x06params\x18\x01\x20\
-    \x01(\x0b2\x18.ffi.ffi.SGXVMCallParamsR\x06params\x125\n\x07context\x18\
-    \x02\x20\x01(\x0b2\x1b.ffi.ffi.TransactionContextR\x07context\"\x7f\n\
-    \x12SGXVMCreateRequest\x122\n\x06params\x18\x01\x20\x01(\x0b2\x1a.ffi.ff\
-    i.SGXVMCreateParamsR\x06params\x125\n\x07context\x18\x02\x20\x01(\x0b2\
-    \x1b.ffi.ffi.TransactionContextR\x07context\"\x89\x01\n\x17SGXVMEstimate\
-    GasRequest\x127\n\x06params\x18\x01\x20\x01(\x0b2\x1f.ffi.ffi.SGXVMEstim\
-    ateGasParamsR\x06params\x125\n\x07context\x18\x02\x20\x01(\x0b2\x1b.ffi.\
-    ffi.TransactionContextR\x07context\"8\n\x14NodePublicKeyRequest\x12\x20\
-    \n\x0bblockNumber\x18\x01\x20\x01(\x04R\x0bblockNumber\"5\n\x15NodePubli\
-    cKeyResponse\x12\x1c\n\tpublicKey\x18\x01\x20\x01(\x0cR\tpublicKey\"y\n\
+    \x16\n\x06txType\x18\x0e\x20\x01(\rR\x06txType\x12+\n\x05trace\x18\x0f\
+    \x20\x01(\x0b2\x15.ffi.ffi.TraceOptionsR\x05trace\x12L\n\x11authorizatio\
+    nList\x18\x10\x20\x03(\x0b2\x1e.ffi.ffi.AuthorizationListItemR\x11author\
+    izationList\"\xab\x03\n\x11SGXVMCreateParams\x12\x12\n\x04from\x18\x01\
+    \x20\x01(\x0cR\x04from\x12\x12\n\x04data\x18\x02\x20\x01(\x0cR\x04data\
+    \x12\x1a\n\x08gasLimit\x18\x03\x20\x01(\x04R\x08gasLimit\x12\x1a\n\x08ga\
+    sPrice\x18\x04\x20\x01(\x0cR\x08gasPrice\x12\x14\n\x05value\x18\x05\x20\
+    \x01(\x0cR\x05value\x127\n\naccessList\x18\x06\x20\x03(\x0b2\x17.ffi.ffi\
+    .AccessListItemR\naccessList\x12\x16\n\x06commit\x18\x07\x20\x01(\x08R\
+    \x06commit\x12\x14\n\x05nonce\x18\x08\x20\x01(\x04R\x05nonce\x12\x1c\n\t\
+    signature\x18\t\x20\x01(\x0cR\tsignature\x122\n\x14maxPriorityFeePerGas\
+    \x18\n\x20\x01(\x0cR\x14maxPriorityFeePerGas\x12\"\n\x0cmaxFeePerGas\x18\
+    \x0b\x20\x01(\x0cR\x0cmaxFeePerGas\x12\x16\n\x06txType\x18\x0c\x20\x01(\
+    \rR\x06txType\x12+\n\x05trace\x18\r\x20\x01(\x0b2\x15.ffi.ffi.TraceOptio\
+    nsR\x05trace\"\xb5\x03\n\x16SGXVMEstimateGasParams\x12\x12\n\x04from\x18\
+    \x01\x20\x01(\x0cR\x04from\x12\x0e\n\x02to\x18\x02\x20\x01(\x0cR\x02to\
+    \x12\x12\n\x04data\x18\x03\x20\x01(\x0cR\x04data\x12\x1a\n\x08gasLimit\
+    \x18\x04\x20\x01(\x04R\x08gasLimit\x12\x1a\n\x08gasPrice\x18\x05\x20\x01\
+    (\x0cR\x08gasPrice\x12\x14\n\x05value\x18\x06\x20\x01(\x0cR\x05value\x12\
+    7\n\naccessList\x18\x07\x20\x03(\x0b2\x17.ffi.ffi.AccessListItemR\nacces\
+    sList\x12\x16\n\x06commit\x18\x08\x20\x01(\x08R\x06commit\x12\x14\n\x05n\
+    once\x18\t\x20\x01(\x04R\x05nonce\x12\x20\n\x0bunencrypted\x18\n\x20\x01\
+    (\x08R\x0bunencrypted\x12\x1c\n\tsignature\x18\x0b\x20\x01(\x0cR\tsignat\
+    ure\x122\n\x14maxPriorityFeePerGas\x18\x0c\x20\x01(\x0cR\x14maxPriorityF\
+    eePerGas\x12\"\n\x0cmaxFeePerGas\x18\r\x20\x01(\x0cR\x0cmaxFeePerGas\x12\
+    \x16\n\x06txType\x18\x0e\x20\x01(\rR\x06txType\"\xad\x02\n\x0cTraceOptio\
+    ns\x12+\n\x06tracer\x18\x01\x20\x01(\x0e2\x13.ffi.ffi.TracerTypeR\x06tra\
+    cer\x12\"\n\x0cdisableStack\x18\x02\x20\x01(\x08R\x0cdisableStack\x12&\n\
+    \x0edisableStorage\x18\x03\x20\x01(\x08R\x0edisableStorage\x12\"\n\x0cen\
+    ableMemory\x18\x04\x20\x01(\x08R\x0cenableMemory\x12*\n\x10enableReturnD\
+    ata\x18\x05\x20\x01(\x08R\x10enableReturnData\x12\x14\n\x05limit\x18\x06\
+    \x20\x01(\x04R\x05limit\x12\x20\n\x0bonlyTopCall\x18\x07\x20\x01(\x08R\
+    \x0bonlyTopCall\x12\x1c\n\ttraceNode\x18\x08\x20\x01(\x08R\ttraceNode\";\
+    \n\x11TraceStorageEntry\x12\x10\n\x03key\x18\x01\x20\x01(\x0cR\x03key\
+    \x12\x14\n\x05value\x18\x02\x20\x01(\x0cR\x05value\"\xa4\x02\n\x0eTraceS\
+    tructLog\x12\x0e\n\x02pc\x18\x01\x20\x01(\x04R\x02pc\x12\x0e\n\x02op\x18\
+    \x02\x20\x01(\tR\x02op\x12\x10\n\x03gas\x18\x03\x20\x01(\x04R\x03gas\x12\
+    \x18\n\x07gasCost\x18\x04\x20\x01(\x04R\x07gasCost\x12\x14\n\x05depth\
+    \x18\x05\x20\x01(\rR\x05depth\x12\x14\n\x05stack\x18\x06\x20\x03(\x0cR\
+    \x05stack\x12\x16\n\x06memory\x18\x07\x20\x01(\x0cR\x06memory\x124\n\x07\
+    storage\x18\x08\x20\x03(\x0b2\x1a.ffi.ffi.TraceStorageEntryR\x07storage\
+    \x12\x1e\n\nreturnData\x18\t\x20\x01(\x0cR\nreturnData\x12\x16\n\x06refu\
+    nd\x18\n\x20\x01(\x04R\x06refund\x12\x14\n\x05error\x18\x0b\x20\x01(\tR\
+    \x05error\"\xfd\x01\n\x0eTraceCallFrame\x12\x12\n\x04type\x18\x01\x20\
+    \x01(\tR\x04type\x12\x12\n\x04from\x18\x02\x20\x01(\x0cR\x04from\x12\x0e\
+    \n\x02to\x18\x03\x20\x01(\x0cR\x02to\x12\x14\n\x05value\x18\x04\x20\x01(\
+    \x0cR\x05value\x12\x10\n\x03gas\x18\x05\x20\x01(\x04R\x03gas\x12\x18\n\
+    \x07gasUsed\x18\x06\x20\x01(\x04R\x07gasUsed\x12\x14\n\x05input\x18\x07\
+    \x20\x01(\x0cR\x05input\x12\x16\n\x06output\x18\x08\x20\x01(\x0cR\x06out\
+    put\x12\x14\n\x05error\x18\t\x20\x01(\tR\x05error\x12-\n\x05calls\x18\n\
+    \x20\x03(\x0b2\x17.ffi.ffi.TraceCallFrameR\x05calls\"\xb9\x01\n\x0eExecu\
+    tionTrace\x127\n\nstructLogs\x18\x01\x20\x03(\x0b2\x17.ffi.ffi.TraceStru\
+    ctLogR\nstructLogs\x125\n\tcallFrame\x18\x02\x20\x01(\x0b2\x17.ffi.ffi.T\
+    raceCallFrameR\tcallFrame\x127\n\naccessList\x18\x03\x20\x03(\x0b2\x17.f\
+    fi.ffi.AccessListItemR\naccessList\"{\n\x10SGXVMCallRequest\x120\n\x06pa\
+    rams\x18\x01\x20\x01(\x0b2\x18.ffi.ffi.SGXVMCallParamsR\x06params\x125\n\
+    \x07context\x18\x02\x20\x01(\x0b2\x1b.ffi.ffi.TransactionContextR\x07con\
+    text\"\x7f\n\x12SGXVMCreateRequest\x122\n\x06params\x18\x01\x20\x01(\x0b\
+    2\x1a.ffi.ffi.SGXVMCreateParamsR\x06params\x125\n\x07context\x18\x02\x20\
+    \x01(\x0b2\x1b.ffi.ffi.TransactionContextR\x07context\"\x89\x01\n\x17SGX\
+    VMEstimateGasRequest\x127\n\x06params\x18\x01\x20\x01(\x0b2\x1f.ffi.ffi.\
+    SGXVMEstimateGasParamsR\x06params\x125\n\x07context\x18\x02\x20\x01(\x0b\
+    2\x1b.ffi.ffi.TransactionContextR\x07context\"8\n\x14NodePublicKeyReques\
+    t\x12\x20\n\x0bblockNumber\x18\x01\x20\x01(\x04R\x0bblockNumber\"5\n\x15\
+    NodePublicKeyResponse\x12\x1c\n\tpublicKey\x18\x01\x20\x01(\x0cR\tpublic\
+    Key\"\xf5\x01\n\x19StorageAtEncryptedRequest\x12(\n\x0fcontractAddress\
+    \x18\x01\x20\x01(\x0cR\x0fcontractAddress\x12\x1e\n\nstorageKey\x18\x02\
+    \x20\x01(\x0cR\nstorageKey\x12$\n\ruserPublicKey\x18\x03\x20\x01(\x0cR\r\
+    userPublicKey\x12\x1c\n\tsignature\x18\x04\x20\x01(\x0cR\tsignature\x12(\
+    \n\x0fdeploymentNonce\x18\x05\x20\x01(\x04R\x0fdeploymentNonce\x12\x20\n\
+    \x0bblockNumber\x18\x06\x20\x01(\x04R\x0bblockNumber\"j\n\x1aStorageAtEn\
+    cryptedResponse\x12&\n\x0eencryptedValue\x18\x01\x20\x01(\x0cR\x0eencryp\
+    tedValue\x12$\n\rnodePublicKey\x18\x02\x20\x01(\x0cR\rnodePublicKey\"y\n\
     \tEpochData\x12\x20\n\x0bepochNumber\x18\x01\x20\x01(\rR\x0bepochNumber\
     \x12$\n\rstartingBlock\x18\x02\x20\x01(\x04R\rstartingBlock\x12$\n\rnode\
     PublicKey\x18\x03\x20\x01(\x0cR\rnodePublicKey\"@\n\x12ListEpochsRespons\
     e\x12*\n\x06epochs\x18\x01\x20\x03(\x0b2\x12.ffi.ffi.EpochDataR\x06epoch\
-    s\"\xb8\x02\n\nFFIRequest\x12=\n\x0bcallRequest\x18\x01\x20\x01(\x0b2\
+    s\"\x9c\x03\n\nFFIRequest\x12=\n\x0bcallRequest\x18\x01\x20\x01(\x0b2\
     \x19.ffi.ffi.SGXVMCallRequestH\0R\x0bcallRequest\x12C\n\rcreateRequest\
     \x18\x02\x20\x01(\x0b2\x1b.ffi.ffi.SGXVMCreateRequestH\0R\rcreateRequest\
     \x12R\n\x12estimateGasRequest\x18\x03\x20\x01(\x0b2\x20.ffi.ffi.SGXVMEst\
     imateGasRequestH\0R\x12estimateGasRequest\x12K\n\x10publicKeyRequest\x18\
     \x04\x20\x01(\x0b2\x1d.ffi.ffi.NodePublicKeyRequestH\0R\x10publicKeyRequ\
-    estB\x05\n\x03reqB&Z$github.com/SigmaGmbH/librustgo/typesJ\xe4p\n\x07\
-    \x12\x05\0\0\xf9\x02\x01\n\x08\n\x01\x0c\x12\x03\0\0\x12\n\x08\n\x01\x02\
-    \x12\x03\x02\0\x10\n\x08\n\x01\x08\x12\x03\x04\0;\n\t\n\x02\x08\x0b\x12\
-    \x03\x04\0;\n\x1d\n\x02\x04\0\x12\x04\x08\0\x0b\x012\x11\x20General\x20r\
-    equest\n\n\n\n\x03\x04\0\x01\x12\x03\x08\x08\x16\n\x0b\n\x04\x04\0\x02\0\
-    \x12\x03\t\x02!\n\x0c\n\x05\x04\0\x02\0\x04\x12\x03\t\x02\n\n\x0c\n\x05\
-    \x04\0\x02\0\x05\x12\x03\t\x0b\x10\n\x0c\n\x05\x04\0\x02\0\x01\x12\x03\t\
-    \x11\x1c\n\x0c\n\x05\x04\0\x02\0\x03\x12\x03\t\x1f\x20\n\x0b\n\x04\x04\0\
-    \x02\x01\x12\x03\n\x02\x14\n\x0c\n\x05\x04\0\x02\x01\x05\x12\x03\n\x02\
-    \x07\n\x0c\n\x05\x04\0\x02\x01\x01\x12\x03\n\x08\x0f\n\x0c\n\x05\x04\0\
-    \x02\x01\x03\x12\x03\n\x12\x13\n\n\n\x02\x04\x01\x12\x04\r\0\x14\x01\n\n\
-    \n\x03\x04\x01\x01\x12\x03\r\x08\x17\n\x0b\n\x04\x04\x01\x02\0\x12\x03\
-    \x0e\x02\x11\n\x0c\n\x05\x04\x01\x02\0\x05\x12\x03\x0e\x02\x07\n\x0c\n\
-    \x05\x04\x01\x02\0\x01\x12\x03\x0e\x08\x0c\n\x0c\n\x05\x04\x01\x02\0\x03\
-    \x12\x03\x0e\x0f\x10\n\x0b\n\x04\x04\x01\x02\x01\x12\x03\x0f\x02\x0f\n\
-    \x0c\n\x05\x04\x01\x02\x01\x05\x12\x03\x0f\x02\x07\n\x0c\n\x05\x04\x01\
-    \x02\x01\x01\x12\x03\x0f\x08\n\n\x0c\n\x05\x04\x01\x02\x01\x03\x12\x03\
-    \x0f\r\x0e\n\x0b\n\x04\x04\x01\x02\x02\x12\x03\x10\x02\x11\n\x0c\n\x05\
-    \x04\x01\x02\x02\x05\x12\x03\x10\x02\x07\n\x0c\n\x05\x04\x01\x02\x02\x01\
-    \x12\x03\x10\x08\x0c\n\x0c\n\x05\x04\x01\x02\x02\x03\x12\x03\x10\x0f\x10\
-    \n\x0b\n\x04\x04\x01\x02\x03\x12\x03\x11\x02\x16\n\x0c\n\x05\x04\x01\x02\
-    \x03\x05\x12\x03\x11\x02\x08\n\x0c\n\x05\x04\x01\x02\x03\x01\x12\x03\x11\
-    \t\x11\n\x0c\n\x05\x04\x01\x02\x03\x03\x12\x03\x11\x14\x15\n\x0b\n\x04\
-    \x04\x01\x02\x04\x12\x03\x12\x02\x12\n\x0c\n\x05\x04\x01\x02\x04\x05\x12\
-    \x03\x12\x02\x07\n\x0c\n\x05\x04\x01\x02\x04\x01\x12\x03\x12\x08\r\n\x0c\
-    \n\x05\x04\x01\x02\x04\x03\x12\x03\x12\x10\x11\n\x0b\n\x04\x04\x01\x02\
-    \x05\x12\x03\x13\x02)\n\x0c\n\x05\x04\x01\x02\x05\x04\x12\x03\x13\x02\n\
-    \n\x0c\n\x05\x04\x01\x02\x05\x06\x12\x03\x13\x0b\x19\n\x0c\n\x05\x04\x01\
-    \x02\x05\x01\x12\x03\x13\x1a$\n\x0c\n\x05\x04\x01\x02\x05\x03\x12\x03\
-    \x13'(\n\n\n\x02\x04\x02\x12\x04\x16\0\x1e\x01\n\n\n\x03\x04\x02\x01\x12\
-    \x03\x16\x08\x1a\n\x0b\n\x04\x04\x02\x02\0\x12\x03\x17\x02\x16\n\x0c\n\
-    \x05\x04\x02\x02\0\x05\x12\x03\x17\x02\x08\n\x0c\n\x05\x04\x02\x02\0\x01\
-    \x12\x03\x17\t\x11\n\x0c\n\x05\x04\x02\x02\0\x03\x12\x03\x17\x14\x15\n\
-    \x0b\n\x04\x04\x02\x02\x01\x12\x03\x18\x02\x16\n\x0c\n\x05\x04\x02\x02\
-    \x01\x05\x12\x03\x18\x02\x07\n\x0c\n\x05\x04\x02\x02\x01\x01\x12\x03\x18\
-    \x08\x11\n\x0c\n\x05\x04\x02\x02\x01\x03\x12\x03\x18\x14\x15\n\x0b\n\x04\
-    \x04\x02\x02\x02\x12\x03\x19\x02\x17\n\x0c\n\x05\x04\x02\x02\x02\x05\x12\
-    \x03\x19\x02\x08\n\x0c\n\x05\x04\x02\x02\x02\x01\x12\x03\x19\t\x12\n\x0c\
-    \n\x05\x04\x02\x02\x02\x03\x12\x03\x19\x15\x16\n\x0b\n\x04\x04\x02\x02\
-    \x03\x12\x03\x1a\x02\x1d\n\x0c\n\x05\x04\x02\x02\x03\x05\x12\x03\x1a\x02\
-    \x08\n\x0c\n\x05\x04\x02\x02\x03\x01\x12\x03\x1a\t\x18\n\x0c\n\x05\x04\
-    \x02\x02\x03\x03\x12\x03\x1a\x1b\x1c\n\x0b\n\x04\x04\x02\x02\x04\x12\x03\
-    \x1b\x02#\n\x0c\n\x05\x04\x02\x02\x04\x05\x12\x03\x1b\x02\x07\n\x0c\n\
-    \x05\x04\x02\x02\x04\x01\x12\x03\x1b\x08\x1e\n\x0c\n\x05\x04\x02\x02\x04\
-    \x03\x12\x03\x1b!\"\n\x0b\n\x04\x04\x02\x02\x05\x12\x03\x1c\x02\x1b\n\
-    \x0c\n\x05\x04\x02\x02\x05\x05\x12\x03\x1c\x02\x07\n\x0c\n\x05\x04\x02\
-    \x02\x05\x01\x12\x03\x1c\x08\x16\n\x0c\n\x05\x04\x02\x02\x05\x03\x12\x03\
-    \x1c\x19\x1a\n\x0b\n\x04\x04\x02\x02\x06\x12\x03\x1d\x02\x1a\n\x0c\n\x05\
-    \x04\x02\x02\x06\x05\x12\x03\x1d\x02\x08\n\x0c\n\x05\x04\x02\x02\x06\x01\
-    \x12\x03\x1d\t\x15\n\x0c\n\x05\x04\x02\x02\x06\x03\x12\x03\x1d\x18\x19\n\
-    \n\n\x02\x04\x03\x12\x04\x20\0#\x01\n\n\n\x03\x04\x03\x01\x12\x03\x20\
-    \x08\x20\n\x0b\n\x04\x04\x03\x02\0\x12\x03!\x02\x1e\n\x0c\n\x05\x04\x03\
-    \x02\0\x06\x12\x03!\x02\x11\n\x0c\n\x05\x04\x03\x02\0\x01\x12\x03!\x12\
-    \x19\n\x0c\n\x05\x04\x03\x02\0\x03\x12\x03!\x1c\x1d\n\x0b\n\x04\x04\x03\
-    \x02\x01\x12\x03\"\x02$\n\x0c\n\x05\x04\x03\x02\x01\x06\x12\x03\"\x02\
-    \x14\n\x0c\n\x05\x04\x03\x02\x01\x01\x12\x03\"\x15\x1f\n\x0c\n\x05\x04\
-    \x03\x02\x01\x03\x12\x03\"\"#\n\n\n\x02\x04\x04\x12\x04%\00\x01\n\n\n\
-    \x03\x04\x04\x01\x12\x03%\x08!\nZ\n\x04\x04\x04\x02\0\x12\x03(\x02\x18\
-    \x1aM\x20logs\x20contains\x20the\x20transaction\x20hash\x20and\x20the\
-    \x20proto-compatible\x20ethereum\n\x20logs.\n\n\x0c\n\x05\x04\x04\x02\0\
-    \x04\x12\x03(\x02\n\n\x0c\n\x05\x04\x04\x02\0\x06\x12\x03(\x0b\x0e\n\x0c\
-    \n\x05\x04\x04\x02\0\x01\x12\x03(\x0f\x13\n\x0c\n\x05\x04\x04\x02\0\x03\
-    \x12\x03(\x16\x17\n\\\n\x04\x04\x04\x02\x01\x12\x03+\x02\x10\x1aO\x20ret\
-    urned\x20data\x20from\x20evm\x20function\x20(result\x20or\x20data\x20sup\
-    plied\x20with\x20revert\n\x20opcode)\n\n\x0c\n\x05\x04\x04\x02\x01\x05\
-    \x12\x03+\x02\x07\n\x0c\n\x05\x04\x04\x02\x01\x01\x12\x03+\x08\x0b\n\x0c\
-    \n\x05\x04\x04\x02\x01\x03\x12\x03+\x0e\x0f\n=\n\x04\x04\x04\x02\x02\x12\
-    \x03-\x02\x16\x1a0\x20vm\x20error\x20is\x20the\x20error\x20returned\x20b\
-    y\x20vm\x20execution\n\n\x0c\n\x05\x04\x04\x02\x02\x05\x12\x03-\x02\x08\
-    \n\x0c\n\x05\x04\x04\x02\x02\x01\x12\x03-\t\x11\n\x0c\n\x05\x04\x04\x02\
-    \x02\x03\x12\x03-\x14\x15\n.\n\x04\x04\x04\x02\x03\x12\x03/\x02\x16\x1a!\
-    \x20gas\x20consumed\x20by\x20the\x20transaction\n\n\x0c\n\x05\x04\x04\
-    \x02\x03\x05\x12\x03/\x02\x08\n\x0c\n\x05\x04\x04\x02\x03\x01\x12\x03/\t\
-    \x11\n\x0c\n\x05\x04\x04\x02\x03\x03\x12\x03/\x14\x15\n\n\n\x02\x04\x05\
-    \x12\x042\05\x01\n\n\n\x03\x04\x05\x01\x12\x032\x08\x20\n\x0b\n\x04\x04\
-    \x05\x02\0\x12\x033\x02\x1e\n\x0c\n\x05\x04\x05\x02\0\x06\x12\x033\x02\
-    \x11\n\x0c\n\x05\x04\x05\x02\0\x01\x12\x033\x12\x19\n\x0c\n\x05\x04\x05\
-    \x02\0\x03\x12\x033\x1c\x1d\n\x0b\n\x04\x04\x05\x02\x01\x12\x034\x02$\n\
-    \x0c\n\x05\x04\x05\x02\x01\x06\x12\x034\x02\x14\n\x0c\n\x05\x04\x05\x02\
-    \x01\x01\x12\x034\x15\x1f\n\x0c\n\x05\x04\x05\x02\x01\x03\x12\x034\"#\n\
-    \n\n\x02\x04\x06\x12\x046\0;\x01\n\n\n\x03\x04\x06\x01\x12\x036\x08!\n=\
-    \n\x04\x04\x06\x02\0\x12\x038\x02\x16\x1a0\x20vm\x20error\x20is\x20the\
-    \x20error\x20returned\x20by\x20vm\x20execution\n\n\x0c\n\x05\x04\x06\x02\
-    \0\x05\x12\x038\x02\x08\n\x0c\n\x05\x04\x06\x02\0\x01\x12\x038\t\x11\n\
-    \x0c\n\x05\x04\x06\x02\0\x03\x12\x038\x14\x15\n.\n\x04\x04\x06\x02\x01\
-    \x12\x03:\x02\x16\x1a!\x20gas\x20consumed\x20by\x20the\x20transaction\n\
-    \n\x0c\n\x05\x04\x06\x02\x01\x05\x12\x03:\x02\x08\n\x0c\n\x05\x04\x06\
-    \x02\x01\x01\x12\x03:\t\x11\n\x0c\n\x05\x04\x06\x02\x01\x03\x12\x03:\x14\
-    \x15\nc\n\x02\x04\x07\x12\x03?\0\"\x1aX\x20Topic\x20represents\x2032-byt\
-    e\x20words\x20that\x20is\x20used\x20to\x20describe\x20what\xe2\x80\x99s\
-    \x20going\x20on\x20in\x20an\n\x20event\n\n\n\n\x03\x04\x07\x01\x12\x03?\
-    \x08\r\n\x0b\n\x04\x04\x07\x02\0\x12\x03?\x10\x20\n\x0c\n\x05\x04\x07\
-    \x02\0\x05\x12\x03?\x10\x15\n\x0c\n\x05\x04\x07\x02\0\x01\x12\x03?\x16\
-    \x1b\n\x0c\n\x05\x04\x07\x02\0\x03\x12\x03?\x1e\x1f\n\x81\x01\n\x02\x04\
-    \x08\x12\x04D\0K\x01\x1au\x20Log\x20represents\x20an\x20protobuf\x20comp\
-    atible\x20Ethereum\x20Log\x20that\x20defines\x20a\x20contract\n\x20log\
-    \x20event.\n\x20Copied\x20from\x20`devnet/proto``\n\n\n\n\x03\x04\x08\
-    \x01\x12\x03D\x08\x0b\n?\n\x04\x04\x08\x02\0\x12\x03F\x02\x14\x1a2\x20ad\
-    dress\x20of\x20the\x20contract\x20that\x20generated\x20the\x20event\n\n\
-    \x0c\n\x05\x04\x08\x02\0\x05\x12\x03F\x02\x07\n\x0c\n\x05\x04\x08\x02\0\
-    \x01\x12\x03F\x08\x0f\n\x0c\n\x05\x04\x08\x02\0\x03\x12\x03F\x12\x13\n7\
-    \n\x04\x04\x08\x02\x01\x12\x03H\x02\x1c\x1a*\x20list\x20of\x20topics\x20\
-    provided\x20by\x20the\x20contract.\n\n\x0c\n\x05\x04\x08\x02\x01\x04\x12\
-    \x03H\x02\n\n\x0c\n\x05\x04\x08\x02\x01\x06\x12\x03H\x0b\x10\n\x0c\n\x05\
-    \x04\x08\x02\x01\x01\x12\x03H\x11\x17\n\x0c\n\x05\x04\x08\x02\x01\x03\
-    \x12\x03H\x1a\x1b\n<\n\x04\x04\x08\x02\x02\x12\x03J\x02\x11\x1a/\x20supp\
-    lied\x20by\x20the\x20contract,\x20usually\x20ABI-encoded\n\n\x0c\n\x05\
-    \x04\x08\x02\x02\x05\x12\x03J\x02\x07\n\x0c\n\x05\x04\x08\x02\x02\x01\
-    \x12\x03J\x08\x0c\n\x0c\n\x05\x04\x08\x02\x02\x03\x12\x03J\x0f\x10\nX\n\
-    \x02\x04\t\x12\x04N\0Q\x01\x1aL\x20Request\x20for\x20account\x20code\x20\
-    (smart\x20contract\x20deployed\x20behind\x20provided\x20address)\n\n\n\n\
-    \x03\x04\t\x01\x12\x03N\x08\x17\n*\n\x04\x04\t\x02\0\x12\x03P\x02\x14\
-    \x1a\x1d\x2020\x20bytes\x20of\x20account\x20address\n\n\x0c\n\x05\x04\t\
-    \x02\0\x05\x12\x03P\x02\x07\n\x0c\n\x05\x04\t\x02\0\x01\x12\x03P\x08\x0f\
-    \n\x0c\n\x05\x04\t\x02\0\x03\x12\x03P\x12\x13\n'\n\x02\x04\n\x12\x04T\0Y\
-    \x01\x1a\x1b\x20Response\x20for\x20account\x20code\n\n\n\n\x03\x04\n\x01\
-    \x12\x03T\x08\x1f\nb\n\x04\x04\n\x02\0\x12\x03W\x02\x14\x1aU\x20Since\
-    \x20both\x20fields\x20are\x20256-bit\x20unsigned\x20integer,\x20we\x20en\
-    code\x20them\x20as\n\x20big-endian\x20bytes\n\n\x0c\n\x05\x04\n\x02\0\
-    \x05\x12\x03W\x02\x07\n\x0c\n\x05\x04\n\x02\0\x01\x12\x03W\x08\x0f\n\x0c\
-    \n\x05\x04\n\x02\0\x03\x12\x03W\x12\x13\n\x0b\n\x04\x04\n\x02\x01\x12\
-    \x03X\x02\x13\n\x0c\n\x05\x04\n\x02\x01\x05\x12\x03X\x02\x08\n\x0c\n\x05\
-    \x04\n\x02\x01\x01\x12\x03X\t\x0e\n\x0c\n\x05\x04\n\x02\x01\x03\x12\x03X\
-    \x11\x12\n\n\n\x02\x04\x0b\x12\x04[\0^\x01\n\n\n\x03\x04\x0b\x01\x12\x03\
-    [\x08!\n\x0b\n\x04\x04\x0b\x02\0\x12\x03\\\x02\x14\n\x0c\n\x05\x04\x0b\
-    \x02\0\x05\x12\x03\\\x02\x07\n\x0c\n\x05\x04\x0b\x02\0\x01\x12\x03\\\x08\
-    \x0f\n\x0c\n\x05\x04\x0b\x02\0\x03\x12\x03\\\x12\x13\n\x0b\n\x04\x04\x0b\
-    \x02\x01\x12\x03]\x02\x14\n\x0c\n\x05\x04\x0b\x02\x01\x05\x12\x03]\x02\
-    \x07\n\x0c\n\x05\x04\x0b\x02\x01\x01\x12\x03]\x08\x0f\n\x0c\n\x05\x04\
-    \x0b\x02\x01\x03\x12\x03]\x12\x13\n\t\n\x02\x04\x0c\x12\x03_\0,\n\n\n\
-    \x03\x04\x0c\x01\x12\x03_\x08)\n\n\n\x02\x04\r\x12\x04a\0d\x01\n\n\n\x03\
-    \x04\r\x01\x12\x03a\x08\x1f\n\x0b\n\x04\x04\r\x02\0\x12\x03b\x02\x14\n\
-    \x0c\n\x05\x04\r\x02\0\x05\x12\x03b\x02\x07\n\x0c\n\x05\x04\r\x02\0\x01\
-    \x12\x03b\x08\x0f\n\x0c\n\x05\x04\r\x02\0\x03\x12\x03b\x12\x13\n\x0b\n\
-    \x04\x04\r\x02\x01\x12\x03c\x02\x13\n\x0c\n\x05\x04\r\x02\x01\x05\x12\
-    \x03c\x02\x08\n\x0c\n\x05\x04\r\x02\x01\x01\x12\x03c\t\x0e\n\x0c\n\x05\
-    \x04\r\x02\x01\x03\x12\x03c\x11\x12\n\t\n\x02\x04\x0e\x12\x03e\0)\n\n\n\
-    \x03\x04\x0e\x01\x12\x03e\x08'\n\t\n\x02\x04\x0f\x12\x03g\0+\n\n\n\x03\
-    \x04\x0f\x01\x12\x03g\x08\x18\n\x0b\n\x04\x04\x0f\x02\0\x12\x03g\x1b)\n\
-    \x0c\n\x05\x04\x0f\x02\0\x05\x12\x03g\x1b\x20\n\x0c\n\x05\x04\x0f\x02\0\
-    \x01\x12\x03g!$\n\x0c\n\x05\x04\x0f\x02\0\x03\x12\x03g'(\n\t\n\x02\x04\
-    \x10\x12\x03i\07\n\n\n\x03\x04\x10\x01\x12\x03i\x08\x20\n\x0b\n\x04\x04\
-    \x10\x02\0\x12\x03i#5\n\x0c\n\x05\x04\x10\x02\0\x05\x12\x03i#'\n\x0c\n\
-    \x05\x04\x10\x02\0\x01\x12\x03i(0\n\x0c\n\x05\x04\x10\x02\0\x03\x12\x03i\
-    34\n\n\n\x02\x04\x11\x12\x04k\0n\x01\n\n\n\x03\x04\x11\x01\x12\x03k\x08\
-    \"\n\x0b\n\x04\x04\x11\x02\0\x12\x03l\x02\x14\n\x0c\n\x05\x04\x11\x02\0\
-    \x05\x12\x03l\x02\x07\n\x0c\n\x05\x04\x11\x02\0\x01\x12\x03l\x08\x0f\n\
-    \x0c\n\x05\x04\x11\x02\0\x03\x12\x03l\x12\x13\n\x0b\n\x04\x04\x11\x02\
-    \x01\x12\x03m\x02\x12\n\x0c\n\x05\x04\x11\x02\x01\x05\x12\x03m\x02\x07\n\
-    \x0c\n\x05\x04\x11\x02\x01\x01\x12\x03m\x08\r\n\x0c\n\x05\x04\x11\x02\
-    \x01\x03\x12\x03m\x10\x11\n\t\n\x02\x04\x12\x12\x03p\0?\n\n\n\x03\x04\
-    \x12\x01\x12\x03p\x08*\n\x0b\n\x04\x04\x12\x02\0\x12\x03p-=\n\x0c\n\x05\
-    \x04\x12\x02\0\x05\x12\x03p-2\n\x0c\n\x05\x04\x12\x02\0\x01\x12\x03p38\n\
-    \x0c\n\x05\x04\x12\x02\0\x03\x12\x03p;<\n\t\n\x02\x04\x13\x12\x03r\02\n\
-    \n\n\x03\x04\x13\x01\x12\x03r\x08\x1b\n\x0b\n\x04\x04\x13\x02\0\x12\x03r\
-    \x1e0\n\x0c\n\x05\x04\x13\x02\0\x05\x12\x03r\x1e#\n\x0c\n\x05\x04\x13\
-    \x02\0\x01\x12\x03r$+\n\x0c\n\x05\x04\x13\x02\0\x03\x12\x03r./\n\t\n\x02\
-    \x04\x14\x12\x03t\07\n\n\n\x03\x04\x14\x01\x12\x03t\x08#\n\x0b\n\x04\x04\
-    \x14\x02\0\x12\x03t&5\n\x0c\n\x05\x04\x14\x02\0\x05\x12\x03t&+\n\x0c\n\
-    \x05\x04\x14\x02\0\x01\x12\x03t,0\n\x0c\n\x05\x04\x14\x02\0\x03\x12\x03t\
-    34\n\t\n\x02\x04\x15\x12\x03v\06\n\n\n\x03\x04\x15\x01\x12\x03v\x08\x1f\
-    \n\x0b\n\x04\x04\x15\x02\0\x12\x03v\"4\n\x0c\n\x05\x04\x15\x02\0\x05\x12\
-    \x03v\"'\n\x0c\n\x05\x04\x15\x02\0\x01\x12\x03v(/\n\x0c\n\x05\x04\x15\
-    \x02\0\x03\x12\x03v23\n\t\n\x02\x04\x16\x12\x03w\0<\n\n\n\x03\x04\x16\
-    \x01\x12\x03w\x08'\n\x0b\n\x04\x04\x16\x02\0\x12\x03w*:\n\x0c\n\x05\x04\
-    \x16\x02\0\x05\x12\x03w*0\n\x0c\n\x05\x04\x16\x02\0\x01\x12\x03w15\n\x0c\
-    \n\x05\x04\x16\x02\0\x03\x12\x03w89\n\t\n\x02\x04\x17\x12\x03y\06\n\n\n\
-    \x03\x04\x17\x01\x12\x03y\x08\x1f\n\x0b\n\x04\x04\x17\x02\0\x12\x03y\"4\
-    \n\x0c\n\x05\x04\x17\x02\0\x05\x12\x03y\"'\n\x0c\n\x05\x04\x17\x02\0\x01\
-    \x12\x03y(/\n\x0c\n\x05\x04\x17\x02\0\x03\x12\x03y23\n\t\n\x02\x04\x18\
-    \x12\x03z\0;\n\n\n\x03\x04\x18\x01\x12\x03z\x08'\n\x0b\n\x04\x04\x18\x02\
-    \0\x12\x03z*9\n\x0c\n\x05\x04\x18\x02\0\x05\x12\x03z*/\n\x0c\n\x05\x04\
-    \x18\x02\0\x01\x12\x03z04\n\x0c\n\x05\x04\x18\x02\0\x03\x12\x03z78\n\n\n\
-    \x02\x04\x19\x12\x04|\0\x7f\x01\n\n\n\x03\x04\x19\x01\x12\x03|\x08\x1e\n\
-    \x0b\n\x04\x04\x19\x02\0\x12\x03}\x02\x14\n\x0c\n\x05\x04\x19\x02\0\x05\
-    \x12\x03}\x02\x07\n\x0c\n\x05\x04\x19\x02\0\x01\x12\x03}\x08\x0f\n\x0c\n\
-    \x05\x04\x19\x02\0\x03\x12\x03}\x12\x13\n\x0b\n\x04\x04\x19\x02\x01\x12\
-    \x03~\x02\x11\n\x0c\n\x05\x04\x19\x02\x01\x05\x12\x03~\x02\x07\n\x0c\n\
-    \x05\x04\x19\x02\x01\x01\x12\x03~\x08\x0c\n\x0c\n\x05\x04\x19\x02\x01\
-    \x03\x12\x03~\x0f\x10\n\n\n\x02\x04\x1a\x12\x04\x81\x01\0)\n\x0b\n\x03\
-    \x04\x1a\x01\x12\x04\x81\x01\x08&\n\x0c\n\x02\x04\x1b\x12\x06\x83\x01\0\
-    \x87\x01\x01\n\x0b\n\x03\x04\x1b\x01\x12\x04\x83\x01\x08\x1e\n\x0c\n\x04\
-    \x04\x1b\x02\0\x12\x04\x84\x01\x02\x14\n\r\n\x05\x04\x1b\x02\0\x05\x12\
-    \x04\x84\x01\x02\x07\n\r\n\x05\x04\x1b\x02\0\x01\x12\x04\x84\x01\x08\x0f\
-    \n\r\n\x05\x04\x1b\x02\0\x03\x12\x04\x84\x01\x12\x13\n\x0c\n\x04\x04\x1b\
-    \x02\x01\x12\x04\x85\x01\x02\x12\n\r\n\x05\x04\x1b\x02\x01\x05\x12\x04\
-    \x85\x01\x02\x07\n\r\n\x05\x04\x1b\x02\x01\x01\x12\x04\x85\x01\x08\r\n\r\
-    \n\x05\x04\x1b\x02\x01\x03\x12\x04\x85\x01\x10\x11\n\x0c\n\x04\x04\x1b\
-    \x02\x02\x12\x04\x86\x01\x02\x12\n\r\n\x05\x04\x1b\x02\x02\x05\x12\x04\
-    \x86\x01\x02\x07\n\r\n\x05\x04\x1b\x02\x02\x01\x12\x04\x86\x01\x08\r\n\r\
-    \n\x05\x04\x1b\x02\x02\x03\x12\x04\x86\x01\x10\x11\n\n\n\x02\x04\x1c\x12\
-    \x04\x89\x01\0)\n\x0b\n\x03\x04\x1c\x01\x12\x04\x89\x01\x08&\n\n\n\x02\
-    \x04\x1d\x12\x04\x8b\x01\0*\n\x0b\n\x03\x04\x1d\x01\x12\x04\x8b\x01\x08\
-    \x13\n\x0c\n\x04\x04\x1d\x02\0\x12\x04\x8b\x01\x16(\n\r\n\x05\x04\x1d\
-    \x02\0\x05\x12\x04\x8b\x01\x16\x1b\n\r\n\x05\x04\x1d\x02\0\x01\x12\x04\
-    \x8b\x01\x1c#\n\r\n\x05\x04\x1d\x02\0\x03\x12\x04\x8b\x01&'\n\n\n\x02\
-    \x04\x1e\x12\x04\x8d\x01\0\x1e\n\x0b\n\x03\x04\x1e\x01\x12\x04\x8d\x01\
-    \x08\x1b\n\x0c\n\x02\x04\x1f\x12\x06\x8f\x01\0\x92\x01\x01\n\x0b\n\x03\
-    \x04\x1f\x01\x12\x04\x8f\x01\x08\x1e\n\x0c\n\x04\x04\x1f\x02\0\x12\x04\
-    \x90\x01\x02\x14\n\r\n\x05\x04\x1f\x02\0\x05\x12\x04\x90\x01\x02\x07\n\r\
-    \n\x05\x04\x1f\x02\0\x01\x12\x04\x90\x01\x08\x0f\n\r\n\x05\x04\x1f\x02\0\
-    \x03\x12\x04\x90\x01\x12\x13\n\x0c\n\x04\x04\x1f\x02\x01\x12\x04\x91\x01\
-    \x02\x12\n\r\n\x05\x04\x1f\x02\x01\x05\x12\x04\x91\x01\x02\x07\n\r\n\x05\
-    \x04\x1f\x02\x01\x01\x12\x04\x91\x01\x08\r\n\r\n\x05\x04\x1f\x02\x01\x03\
-    \x12\x04\x91\x01\x10\x11\n\n\n\x02\x04\x20\x12\x04\x94\x01\0)\n\x0b\n\
-    \x03\x04\x20\x01\x12\x04\x94\x01\x08&\n\n\n\x02\x04!\x12\x04\x96\x01\01\
-    \n\x0b\n\x03\x04!\x01\x12\x04\x96\x01\x08\x1a\n\x0c\n\x04\x04!\x02\0\x12\
-    \x04\x96\x01\x1d/\n\r\n\x05\x04!\x02\0\x05\x12\x04\x96\x01\x1d\"\n\r\n\
-    \x05\x04!\x02\0\x01\x12\x04\x96\x01#*\n\r\n\x05\x04!\x02\0\x03\x12\x04\
-    \x96\x01-.\n\n\n\x02\x04\"\x12\x04\x98\x01\0%\n\x0b\n\x03\x04\"\x01\x12\
-    \x04\x98\x01\x08\"\n\n\n\x02\x04#\x12\x04\x9a\x01\0,\n\x0b\n\x03\x04#\
-    \x01\x12\x04\x9a\x01\x08\x16\n\x0c\n\x04\x04#\x02\0\x12\x04\x9a\x01\x19*\
-    \n\r\n\x05\x04#\x02\0\x05\x12\x04\x9a\x01\x19\x1e\n\r\n\x05\x04#\x02\0\
-    \x01\x12\x04\x9a\x01\x1f%\n\r\n\x05\x04#\x02\0\x03\x12\x04\x9a\x01()\n\n\
-    \n\x02\x04$\x12\x04\x9b\x01\02\n\x0b\n\x03\x04$\x01\x12\x04\x9b\x01\x08\
-    \x1e\n\x0c\n\x04\x04$\x02\0\x12\x04\x9b\x01!0\n\r\n\x05\x04$\x02\0\x05\
-    \x12\x04\x9b\x01!&\n\r\n\x05\x04$\x02\0\x01\x12\x04\x9b\x01'+\n\r\n\x05\
-    \x04$\x02\0\x03\x12\x04\x9b\x01./\n\n\n\x02\x04%\x12\x04\x9d\x01\0\x20\n\
-    \x0b\n\x03\x04%\x01\x12\x04\x9d\x01\x08\x1d\n\x0c\n\x02\x04&\x12\x06\x9e\
-    \x01\0\xa0\x01\x01\n\x0b\n\x03\x04&\x01\x12\x04\x9e\x01\x08%\n\x0c\n\x04\
-    \x04&\x02\0\x12\x04\x9f\x01\x02\x11\n\r\n\x05\x04&\x02\0\x05\x12\x04\x9f\
-    \x01\x02\x07\n\r\n\x05\x04&\x02\0\x01\x12\x04\x9f\x01\x08\x0c\n\r\n\x05\
-    \x04&\x02\0\x03\x12\x04\x9f\x01\x0f\x10\n\n\n\x02\x04'\x12\x04\xa2\x01\0\
-    \"\n\x0b\n\x03\x04'\x01\x12\x04\xa2\x01\x08\x1f\n\x0c\n\x02\x04(\x12\x06\
-    \xa3\x01\0\xa5\x01\x01\n\x0b\n\x03\x04(\x01\x12\x04\xa3\x01\x08'\n\x0c\n\
-    \x04\x04(\x02\0\x12\x04\xa4\x01\x02\x11\n\r\n\x05\x04(\x02\0\x05\x12\x04\
-    \xa4\x01\x02\x07\n\r\n\x05\x04(\x02\0\x01\x12\x04\xa4\x01\x08\x0c\n\r\n\
-    \x05\x04(\x02\0\x03\x12\x04\xa4\x01\x0f\x10\n\x0c\n\x02\x04)\x12\x06\xa7\
-    \x01\0\xb2\x01\x01\n\x0b\n\x03\x04)\x01\x12\x04\xa7\x01\x08#\n\x0c\n\x04\
-    \x04)\x02\0\x12\x04\xa8\x01\x02\x18\n\r\n\x05\x04)\x02\0\x05\x12\x04\xa8\
-    \x01\x02\x07\n\r\n\x05\x04)\x02\0\x01\x12\x04\xa8\x01\x08\x13\n\r\n\x05\
-    \x04)\x02\0\x03\x12\x04\xa8\x01\x16\x17\n\x0c\n\x04\x04)\x02\x01\x12\x04\
-    \xa9\x01\x02\x1a\n\r\n\x05\x04)\x02\x01\x05\x12\x04\xa9\x01\x02\x07\n\r\
-    \n\x05\x04)\x02\x01\x01\x12\x04\xa9\x01\x08\x15\n\r\n\x05\x04)\x02\x01\
-    \x03\x12\x04\xa9\x01\x18\x19\n\x0c\n\x04\x04)\x02\x02\x12\x04\xaa\x01\
-    \x02\x19\n\r\n\x05\x04)\x02\x02\x05\x12\x04\xaa\x01\x02\x08\n\r\n\x05\
-    \x04)\x02\x02\x01\x12\x04\xaa\x01\t\x14\n\r\n\x05\x04)\x02\x02\x03\x12\
-    \x04\xaa\x01\x17\x18\n\x0c\n\x04\x04)\x02\x03\x12\x04\xab\x01\x02\x1e\n\
-    \r\n\x05\x04)\x02\x03\x05\x12\x04\xab\x01\x02\x08\n\r\n\x05\x04)\x02\x03\
-    \x01\x12\x04\xab\x01\t\x19\n\r\n\x05\x04)\x02\x03\x03\x12\x04\xab\x01\
-    \x1c\x1d\n\x0c\n\x04\x04)\x02\x04\x12\x04\xac\x01\x02\x1f\n\r\n\x05\x04)\
-    \x02\x04\x05\x12\x04\xac\x01\x02\x08\n\r\n\x05\x04)\x02\x04\x01\x12\x04\
-    \xac\x01\t\x1a\n\r\n\x05\x04)\x02\x04\x03\x12\x04\xac\x01\x1d\x1e\n\x0c\
-    \n\x04\x04)\x02\x05\x12\x04\xad\x01\x02!\n\r\n\x05\x04)\x02\x05\x05\x12\
-    \x04\xad\x01\x02\x08\n\r\n\x05\x04)\x02\x05\x01\x12\x04\xad\x01\t\x1c\n\
-    \r\n\x05\x04)\x02\x05\x03\x12\x04\xad\x01\x1f\x20\n\x0c\n\x04\x04)\x02\
-    \x06\x12\x04\xae\x01\x02\x16\n\r\n\x05\x04)\x02\x06\x05\x12\x04\xae\x01\
-    \x02\x07\n\r\n\x05\x04)\x02\x06\x01\x12\x04\xae\x01\x08\x11\n\r\n\x05\
-    \x04)\x02\x06\x03\x12\x04\xae\x01\x14\x15\n\x0c\n\x04\x04)\x02\x07\x12\
-    \x04\xaf\x01\x02\x14\n\r\n\x05\x04)\x02\x07\x05\x12\x04\xaf\x01\x02\x08\
-    \n\r\n\x05\x04)\x02\x07\x01\x12\x04\xaf\x01\t\x0f\n\r\n\x05\x04)\x02\x07\
-    \x03\x12\x04\xaf\x01\x12\x13\n\x0c\n\x04\x04)\x02\x08\x12\x04\xb0\x01\
-    \x02\"\n\r\n\x05\x04)\x02\x08\x05\x12\x04\xb0\x01\x02\x08\n\r\n\x05\x04)\
-    \x02\x08\x01\x12\x04\xb0\x01\t\x1d\n\r\n\x05\x04)\x02\x08\x03\x12\x04\
-    \xb0\x01\x20!\n\x0c\n\x04\x04)\x02\t\x12\x04\xb1\x01\x02\x16\n\r\n\x05\
-    \x04)\x02\t\x05\x12\x04\xb1\x01\x02\x08\n\r\n\x05\x04)\x02\t\x01\x12\x04\
-    \xb1\x01\t\x10\n\r\n\x05\x04)\x02\t\x03\x12\x04\xb1\x01\x13\x15\n\x0c\n\
-    \x02\x04*\x12\x06\xb3\x01\0\xb5\x01\x01\n\x0b\n\x03\x04*\x01\x12\x04\xb3\
-    \x01\x08+\n\x0c\n\x04\x04*\x02\0\x12\x04\xb4\x01\x02\x1b\n\r\n\x05\x04*\
-    \x02\0\x05\x12\x04\xb4\x01\x02\x07\n\r\n\x05\x04*\x02\0\x01\x12\x04\xb4\
-    \x01\x08\x16\n\r\n\x05\x04*\x02\0\x03\x12\x04\xb4\x01\x19\x1a\n\x0c\n\
-    \x02\x04+\x12\x06\xb7\x01\0\xc3\x01\x01\n\x0b\n\x03\x04+\x01\x12\x04\xb7\
-    \x01\x08%\n\x0c\n\x04\x04+\x02\0\x12\x04\xb8\x01\x02\x18\n\r\n\x05\x04+\
-    \x02\0\x05\x12\x04\xb8\x01\x02\x07\n\r\n\x05\x04+\x02\0\x01\x12\x04\xb8\
-    \x01\x08\x13\n\r\n\x05\x04+\x02\0\x03\x12\x04\xb8\x01\x16\x17\n\x0c\n\
-    \x04\x04+\x02\x01\x12\x04\xb9\x01\x02\x1a\n\r\n\x05\x04+\x02\x01\x05\x12\
-    \x04\xb9\x01\x02\x07\n\r\n\x05\x04+\x02\x01\x01\x12\x04\xb9\x01\x08\x15\
-    \n\r\n\x05\x04+\x02\x01\x03\x12\x04\xb9\x01\x18\x19\n\x0c\n\x04\x04+\x02\
-    \x02\x12\x04\xba\x01\x02\x19\n\r\n\x05\x04+\x02\x02\x05\x12\x04\xba\x01\
-    \x02\x08\n\r\n\x05\x04+\x02\x02\x01\x12\x04\xba\x01\t\x14\n\r\n\x05\x04+\
-    \x02\x02\x03\x12\x04\xba\x01\x17\x18\n\x0c\n\x04\x04+\x02\x03\x12\x04\
-    \xbb\x01\x02\x1e\n\r\n\x05\x04+\x02\x03\x05\x12\x04\xbb\x01\x02\x08\n\r\
-    \n\x05\x04+\x02\x03\x01\x12\x04\xbb\x01\t\x19\n\r\n\x05\x04+\x02\x03\x03\
-    \x12\x04\xbb\x01\x1c\x1d\n\x0c\n\x04\x04+\x02\x04\x12\x04\xbc\x01\x02\
-    \x1f\n\r\n\x05\x04+\x02\x04\x05\x12\x04\xbc\x01\x02\x08\n\r\n\x05\x04+\
-    \x02\x04\x01\x12\x04\xbc\x01\t\x1a\n\r\n\x05\x04+\x02\x04\x03\x12\x04\
-    \xbc\x01\x1d\x1e\n\x0c\n\x04\x04+\x02\x05\x12\x04\xbd\x01\x02!\n\r\n\x05\
-    \x04+\x02\x05\x05\x12\x04\xbd\x01\x02\x08\n\r\n\x05\x04+\x02\x05\x01\x12\
-    \x04\xbd\x01\t\x1c\n\r\n\x05\x04+\x02\x05\x03\x12\x04\xbd\x01\x1f\x20\n\
-    \x0c\n\x04\x04+\x02\x06\x12\x04\xbe\x01\x02\x16\n\r\n\x05\x04+\x02\x06\
-    \x05\x12\x04\xbe\x01\x02\x07\n\r\n\x05\x04+\x02\x06\x01\x12\x04\xbe\x01\
-    \x08\x11\n\r\n\x05\x04+\x02\x06\x03\x12\x04\xbe\x01\x14\x15\n\x0c\n\x04\
-    \x04+\x02\x07\x12\x04\xbf\x01\x02\x14\n\r\n\x05\x04+\x02\x07\x05\x12\x04\
-    \xbf\x01\x02\x08\n\r\n\x05\x04+\x02\x07\x01\x12\x04\xbf\x01\t\x0f\n\r\n\
-    \x05\x04+\x02\x07\x03\x12\x04\xbf\x01\x12\x13\n\x0c\n\x04\x04+\x02\x08\
-    \x12\x04\xc0\x01\x02\"\n\r\n\x05\x04+\x02\x08\x05\x12\x04\xc0\x01\x02\
-    \x08\n\r\n\x05\x04+\x02\x08\x01\x12\x04\xc0\x01\t\x1d\n\r\n\x05\x04+\x02\
-    \x08\x03\x12\x04\xc0\x01\x20!\n\x0c\n\x04\x04+\x02\t\x12\x04\xc1\x01\x02\
-    \x16\n\r\n\x05\x04+\x02\t\x05\x12\x04\xc1\x01\x02\x08\n\r\n\x05\x04+\x02\
-    \t\x01\x12\x04\xc1\x01\t\x10\n\r\n\x05\x04+\x02\t\x03\x12\x04\xc1\x01\
-    \x13\x15\n\x0c\n\x04\x04+\x02\n\x12\x04\xc2\x01\x02\x1b\n\r\n\x05\x04+\
-    \x02\n\x05\x12\x04\xc2\x01\x02\x07\n\r\n\x05\x04+\x02\n\x01\x12\x04\xc2\
-    \x01\x08\x15\n\r\n\x05\x04+\x02\n\x03\x12\x04\xc2\x01\x18\x1a\n\x0c\n\
-    \x02\x04,\x12\x06\xc4\x01\0\xc6\x01\x01\n\x0b\n\x03\x04,\x01\x12\x04\xc4\
-    \x01\x08-\n\x0c\n\x04\x04,\x02\0\x12\x04\xc5\x01\x02\x1b\n\r\n\x05\x04,\
-    \x02\0\x05\x12\x04\xc5\x01\x02\x07\n\r\n\x05\x04,\x02\0\x01\x12\x04\xc5\
-    \x01\x08\x16\n\r\n\x05\x04,\x02\0\x03\x12\x04\xc5\x01\x19\x1a\n\x0c\n\
-    \x02\x04-\x12\x06\xc8\x01\0\xcb\x01\x01\n\x0b\n\x03\x04-\x01\x12\x04\xc8\
-    \x01\x08\x1f\n\x0c\n\x04\x04-\x02\0\x12\x04\xc9\x01\x02\x1b\n\r\n\x05\
-    \x04-\x02\0\x05\x12\x04\xc9\x01\x02\x07\n\r\n\x05\x04-\x02\0\x01\x12\x04\
-    \xc9\x01\x08\x16\n\r\n\x05\x04-\x02\0\x03\x12\x04\xc9\x01\x19\x1a\n\x0c\
-    \n\x04\x04-\x02\x01\x12\x04\xca\x01\x02\x13\n\r\n\x05\x04-\x02\x01\x05\
-    \x12\x04\xca\x01\x02\x07\n\r\n\x05\x04-\x02\x01\x01\x12\x04\xca\x01\x08\
-    \x0e\n\r\n\x05\x04-\x02\x01\x03\x12\x04\xca\x01\x11\x12\n\n\n\x02\x04.\
-    \x12\x04\xcc\x01\0*\n\x0b\n\x03\x04.\x01\x12\x04\xcc\x01\x08'\n\x0c\n\
-    \x02\x04/\x12\x06\xce\x01\0\xd3\x01\x01\n\x0b\n\x03\x04/\x01\x12\x04\xce\
-    \x01\x08\x1c\n\x0c\n\x04\x04/\x02\0\x12\x04\xcf\x01\x02\x18\n\r\n\x05\
-    \x04/\x02\0\x05\x12\x04\xcf\x01\x02\x07\n\r\n\x05\x04/\x02\0\x01\x12\x04\
-    \xcf\x01\x08\x13\n\r\n\x05\x04/\x02\0\x03\x12\x04\xcf\x01\x16\x17\n\x0c\
-    \n\x04\x04/\x02\x01\x12\x04\xd0\x01\x02\x1e\n\r\n\x05\x04/\x02\x01\x05\
-    \x12\x04\xd0\x01\x02\x08\n\r\n\x05\x04/\x02\x01\x01\x12\x04\xd0\x01\t\
-    \x19\n\r\n\x05\x04/\x02\x01\x03\x12\x04\xd0\x01\x1c\x1d\n\x0c\n\x04\x04/\
-    \x02\x02\x12\x04\xd1\x01\x02!\n\r\n\x05\x04/\x02\x02\x05\x12\x04\xd1\x01\
-    \x02\x08\n\r\n\x05\x04/\x02\x02\x01\x12\x04\xd1\x01\t\x1c\n\r\n\x05\x04/\
-    \x02\x02\x03\x12\x04\xd1\x01\x1f\x20\n\x0c\n\x04\x04/\x02\x03\x12\x04\
-    \xd2\x01\x02$\n\r\n\x05\x04/\x02\x03\x04\x12\x04\xd2\x01\x02\n\n\r\n\x05\
-    \x04/\x02\x03\x05\x12\x04\xd2\x01\x0b\x10\n\r\n\x05\x04/\x02\x03\x01\x12\
-    \x04\xd2\x01\x11\x1f\n\r\n\x05\x04/\x02\x03\x03\x12\x04\xd2\x01\"#\n\x0c\
-    \n\x02\x040\x12\x06\xd4\x01\0\xd6\x01\x01\n\x0b\n\x03\x040\x01\x12\x04\
-    \xd4\x01\x08$\n\x0c\n\x04\x040\x02\0\x12\x04\xd5\x01\x02\x1b\n\r\n\x05\
-    \x040\x02\0\x05\x12\x04\xd5\x01\x02\x06\n\r\n\x05\x040\x02\0\x01\x12\x04\
-    \xd5\x01\x07\x16\n\r\n\x05\x040\x02\0\x03\x12\x04\xd5\x01\x19\x1a\n\x0c\
-    \n\x02\x041\x12\x06\xd8\x01\0\xdb\x01\x01\n\x0b\n\x03\x041\x01\x12\x04\
-    \xd8\x01\x08\x20\n\x0c\n\x04\x041\x02\0\x12\x04\xd9\x01\x02\x18\n\r\n\
-    \x05\x041\x02\0\x05\x12\x04\xd9\x01\x02\x07\n\r\n\x05\x041\x02\0\x01\x12\
-    \x04\xd9\x01\x08\x13\n\r\n\x05\x041\x02\0\x03\x12\x04\xd9\x01\x16\x17\n\
-    \x0c\n\x04\x041\x02\x01\x12\x04\xda\x01\x02\x1a\n\r\n\x05\x041\x02\x01\
-    \x05\x12\x04\xda\x01\x02\x07\n\r\n\x05\x041\x02\x01\x01\x12\x04\xda\x01\
-    \x08\x15\n\r\n\x05\x041\x02\x01\x03\x12\x04\xda\x01\x18\x19\n\xf1\x01\n\
-    \x02\x042\x12\x06\xdf\x01\0\xf4\x01\x01\x1a\xe2\x01\x20VerificationDetai\
-    ls\x20must\x20have\x20same\x20members\x20with\x20VerificationDetails\x20\
-    in\x20\"sgxvm/proto/ffi.proto\"\n\x20including\x20verification\x20type\
-    \x20and\x20verification\x20id\x20as\x20key.\n\x20But\x20the\x20member\
-    \x20types\x20can\x20be\x20different,\x20such\x20as\x20string(address)\
-    \x20to\x20bytes\n\n\x0b\n\x03\x042\x01\x12\x04\xdf\x01\x08\x1b\n!\n\x04\
-    \x042\x02\0\x12\x04\xe1\x01\x02\x1e\x1a\x13\x20Verification\x20type\n\n\
-    \r\n\x05\x042\x02\0\x05\x12\x04\xe1\x01\x02\x08\n\r\n\x05\x042\x02\0\x01\
-    \x12\x04\xe1\x01\t\x19\n\r\n\x05\x042\x02\0\x03\x12\x04\xe1\x01\x1c\x1d\
-    \n\x1f\n\x04\x042\x02\x01\x12\x04\xe3\x01\x02\x1b\x1a\x11\x20Verificatio\
-    n\x20Id\n\n\r\n\x05\x042\x02\x01\x05\x12\x04\xe3\x01\x02\x07\n\r\n\x05\
-    \x042\x02\x01\x01\x12\x04\xe3\x01\x08\x16\n\r\n\x05\x042\x02\x01\x03\x12\
-    \x04\xe3\x01\x19\x1a\n+\n\x04\x042\x02\x02\x12\x04\xe5\x01\x02\x1a\x1a\
-    \x1d\x20Verification\x20issuer\x20address\n\n\r\n\x05\x042\x02\x02\x05\
-    \x12\x04\xe5\x01\x02\x07\n\r\n\x05\x042\x02\x02\x01\x12\x04\xe5\x01\x08\
-    \x15\n\r\n\x05\x042\x02\x02\x03\x12\x04\xe5\x01\x18\x19\n6\n\x04\x042\
-    \x02\x03\x12\x04\xe7\x01\x02\x19\x1a(\x20From\x20which\x20chain\x20proof\
-    \x20was\x20transferred\n\n\r\n\x05\x042\x02\x03\x05\x12\x04\xe7\x01\x02\
-    \x08\n\r\n\x05\x042\x02\x03\x01\x12\x04\xe7\x01\t\x14\n\r\n\x05\x042\x02\
-    \x03\x03\x12\x04\xe7\x01\x17\x18\n+\n\x04\x042\x02\x04\x12\x04\xe9\x01\
-    \x02\x1f\x1a\x1d\x20Original\x20issuance\x20timestamp\n\n\r\n\x05\x042\
-    \x02\x04\x05\x12\x04\xe9\x01\x02\x08\n\r\n\x05\x042\x02\x04\x01\x12\x04\
-    \xe9\x01\t\x1a\n\r\n\x05\x042\x02\x04\x03\x12\x04\xe9\x01\x1d\x1e\n-\n\
-    \x04\x042\x02\x05\x12\x04\xeb\x01\x02!\x1a\x1f\x20Original\x20expiration\
-    \x20timestamp\n\n\r\n\x05\x042\x02\x05\x05\x12\x04\xeb\x01\x02\x08\n\r\n\
-    \x05\x042\x02\x05\x01\x12\x04\xeb\x01\t\x1c\n\r\n\x05\x042\x02\x05\x03\
-    \x12\x04\xeb\x01\x1f\x20\n.\n\x04\x042\x02\x06\x12\x04\xed\x01\x02\x19\
-    \x1a\x20\x20Original\x20proof\x20data\x20(ZK-proof)\n\n\r\n\x05\x042\x02\
-    \x06\x05\x12\x04\xed\x01\x02\x07\n\r\n\x05\x042\x02\x06\x01\x12\x04\xed\
-    \x01\x08\x14\n\r\n\x05\x042\x02\x06\x03\x12\x04\xed\x01\x17\x18\n(\n\x04\
-    \x042\x02\x07\x12\x04\xef\x01\x02\x14\x1a\x1a\x20ZK-proof\x20original\
-    \x20schema\n\n\r\n\x05\x042\x02\x07\x05\x12\x04\xef\x01\x02\x08\n\r\n\
-    \x05\x042\x02\x07\x01\x12\x04\xef\x01\t\x0f\n\r\n\x05\x042\x02\x07\x03\
-    \x12\x04\xef\x01\x12\x13\nN\n\x04\x042\x02\x08\x12\x04\xf1\x01\x02\"\x1a\
-    @\x20Verification\x20id\x20for\x20checking(KYC/KYB/AML\x20etc)\x20from\
-    \x20issuer\x20side\n\n\r\n\x05\x042\x02\x08\x05\x12\x04\xf1\x01\x02\x08\
-    \n\r\n\x05\x042\x02\x08\x01\x12\x04\xf1\x01\t\x1d\n\r\n\x05\x042\x02\x08\
-    \x03\x12\x04\xf1\x01\x20!\n\x17\n\x04\x042\x02\t\x12\x04\xf3\x01\x02\x16\
-    \x1a\t\x20Version\n\n\r\n\x05\x042\x02\t\x05\x12\x04\xf3\x01\x02\x08\n\r\
-    \n\x05\x042\x02\t\x01\x12\x04\xf3\x01\t\x10\n\r\n\x05\x042\x02\t\x03\x12\
-    \x04\xf3\x01\x13\x15\n\x0c\n\x02\x043\x12\x06\xf5\x01\0\xf7\x01\x01\n\
-    \x0b\n\x03\x043\x01\x12\x04\xf5\x01\x08(\n\x0c\n\x04\x043\x02\0\x12\x04\
-    \xf6\x01\x02(\n\r\n\x05\x043\x02\0\x04\x12\x04\xf6\x01\x02\n\n\r\n\x05\
-    \x043\x02\0\x06\x12\x04\xf6\x01\x0b\x1e\n\r\n\x05\x043\x02\0\x01\x12\x04\
-    \xf6\x01\x1f#\n\r\n\x05\x043\x02\0\x03\x12\x04\xf6\x01&'\n\x0c\n\x02\x04\
-    4\x12\x06\xf9\x01\0\xfd\x01\x01\n\x0b\n\x03\x044\x01\x12\x04\xf9\x01\x08\
-    \x1e\n\x0c\n\x04\x044\x02\0\x12\x04\xfa\x01\x02\x1b\n\r\n\x05\x044\x02\0\
-    \x05\x12\x04\xfa\x01\x02\x07\n\r\n\x05\x044\x02\0\x01\x12\x04\xfa\x01\
-    \x08\x16\n\r\n\x05\x044\x02\0\x03\x12\x04\xfa\x01\x19\x1a\n\x0c\n\x04\
-    \x044\x02\x01\x12\x04\xfb\x01\x02\x1c\n\r\n\x05\x044\x02\x01\x05\x12\x04\
-    \xfb\x01\x02\x07\n\r\n\x05\x044\x02\x01\x01\x12\x04\xfb\x01\x08\x17\n\r\
-    \n\x05\x044\x02\x01\x03\x12\x04\xfb\x01\x1a\x1b\n\x0c\n\x04\x044\x02\x02\
-    \x12\x04\xfc\x01\x02\x13\n\r\n\x05\x044\x02\x02\x05\x12\x04\xfc\x01\x02\
-    \x07\n\r\n\x05\x044\x02\x02\x01\x12\x04\xfc\x01\x08\x0e\n\r\n\x05\x044\
-    \x02\x02\x03\x12\x04\xfc\x01\x11\x12\n\n\n\x02\x045\x12\x04\xfe\x01\0)\n\
-    \x0b\n\x03\x045\x01\x12\x04\xfe\x01\x08&\n\x0c\n\x02\x046\x12\x06\x80\
-    \x02\0\x99\x02\x01\n\x0b\n\x03\x046\x01\x12\x04\x80\x02\x08\x15\n\x0e\n\
-    \x04\x046\x08\0\x12\x06\x81\x02\x02\x98\x02\x03\n\r\n\x05\x046\x08\0\x01\
-    \x12\x04\x81\x02\x08\x0b\n\x0c\n\x04\x046\x02\0\x12\x04\x82\x02\x04#\n\r\
-    \n\x05\x046\x02\0\x06\x12\x04\x82\x02\x04\x13\n\r\n\x05\x046\x02\0\x01\
-    \x12\x04\x82\x02\x14\x1e\n\r\n\x05\x046\x02\0\x03\x12\x04\x82\x02!\"\n\
-    \x0c\n\x04\x046\x02\x01\x12\x04\x83\x02\x04%\n\r\n\x05\x046\x02\x01\x06\
-    \x12\x04\x83\x02\x04\x14\n\r\n\x05\x046\x02\x01\x01\x12\x04\x83\x02\x15\
-    \x20\n\r\n\x05\x046\x02\x01\x03\x12\x04\x83\x02#$\n\x0c\n\x04\x046\x02\
-    \x02\x12\x04\x84\x02\x04(\n\r\n\x05\x046\x02\x02\x06\x12\x04\x84\x02\x04\
-    \x17\n\r\n\x05\x046\x02\x02\x01\x12\x04\x84\x02\x18#\n\r\n\x05\x046\x02\
-    \x02\x03\x12\x04\x84\x02&'\n\x0c\n\x04\x046\x02\x03\x12\x04\x85\x02\x04)\
-    \n\r\n\x05\x046\x02\x03\x06\x12\x04\x85\x02\x04\x1b\n\r\n\x05\x046\x02\
-    \x03\x01\x12\x04\x85\x02\x1c$\n\r\n\x05\x046\x02\x03\x03\x12\x04\x85\x02\
-    '(\n\x0c\n\x04\x046\x02\x04\x12\x04\x86\x02\x04)\n\r\n\x05\x046\x02\x04\
-    \x06\x12\x04\x86\x02\x04\x1b\n\r\n\x05\x046\x02\x04\x01\x12\x04\x86\x02\
-    \x1c$\n\r\n\x05\x046\x02\x04\x03\x12\x04\x86\x02'(\n\x0c\n\x04\x046\x02\
-    \x05\x12\x04\x87\x02\x04/\n\r\n\x05\x046\x02\x05\x06\x12\x04\x87\x02\x04\
-    \x1e\n\r\n\x05\x046\x02\x05\x01\x12\x04\x87\x02\x1f*\n\r\n\x05\x046\x02\
-    \x05\x03\x12\x04\x87\x02-.\n\x0c\n\x04\x046\x02\x06\x12\x04\x88\x02\x041\
-    \n\r\n\x05\x046\x02\x06\x06\x12\x04\x88\x02\x04\x1a\n\r\n\x05\x046\x02\
-    \x06\x01\x12\x04\x88\x02\x1b,\n\r\n\x05\x046\x02\x06\x03\x12\x04\x88\x02\
-    /0\n\x0c\n\x04\x046\x02\x07\x12\x04\x89\x02\x041\n\r\n\x05\x046\x02\x07\
-    \x06\x12\x04\x89\x02\x04\x1a\n\r\n\x05\x046\x02\x07\x01\x12\x04\x89\x02\
-    \x1b,\n\r\n\x05\x046\x02\x07\x03\x12\x04\x89\x02/0\n\x0c\n\x04\x046\x02\
-    \x08\x12\x04\x8a\x02\x04\x1b\n\r\n\x05\x046\x02\x08\x06\x12\x04\x8a\x02\
-    \x04\x0f\n\r\n\x05\x046\x02\x08\x01\x12\x04\x8a\x02\x10\x16\n\r\n\x05\
-    \x046\x02\x08\x03\x12\x04\x8a\x02\x19\x1a\n\x0c\n\x04\x046\x02\t\x12\x04\
-    \x8b\x02\x042\n\r\n\x05\x046\x02\t\x06\x12\x04\x8b\x02\x04\x1a\n\r\n\x05\
-    \x046\x02\t\x01\x12\x04\x8b\x02\x1b,\n\r\n\x05\x046\x02\t\x03\x12\x04\
-    \x8b\x02/1\n\x0c\n\x04\x046\x02\n\x12\x04\x8c\x02\x04*\n\r\n\x05\x046\
-    \x02\n\x06\x12\x04\x8c\x02\x04\x16\n\r\n\x05\x046\x02\n\x01\x12\x04\x8c\
-    \x02\x17$\n\r\n\x05\x046\x02\n\x03\x12\x04\x8c\x02')\n\x0c\n\x04\x046\
-    \x02\x0b\x12\x04\x8d\x02\x04\"\n\r\n\x05\x046\x02\x0b\x06\x12\x04\x8d\
-    \x02\x04\x12\n\r\n\x05\x046\x02\x0b\x01\x12\x04\x8d\x02\x13\x1c\n\r\n\
-    \x05\x046\x02\x0b\x03\x12\x04\x8d\x02\x1f!\n\x0c\n\x04\x046\x02\x0c\x12\
-    \x04\x8e\x02\x04<\n\r\n\x05\x046\x02\x0c\x06\x12\x04\x8e\x02\x04\x1f\n\r\
-    \n\x05\x046\x02\x0c\x01\x12\x04\x8e\x02\x206\n\r\n\x05\x046\x02\x0c\x03\
-    \x12\x04\x8e\x029;\n\x0c\n\x04\x046\x02\r\x12\x04\x8f\x02\x04.\n\r\n\x05\
-    \x046\x02\r\x06\x12\x04\x8f\x02\x04\x18\n\r\n\x05\x046\x02\r\x01\x12\x04\
-    \x8f\x02\x19(\n\r\n\x05\x046\x02\r\x03\x12\x04\x8f\x02+-\n\x0c\n\x04\x04\
-    6\x02\x0e\x12\x04\x90\x02\x046\n\r\n\x05\x046\x02\x0e\x06\x12\x04\x90\
-    \x02\x04\x1c\n\r\n\x05\x046\x02\x0e\x01\x12\x04\x90\x02\x1d0\n\r\n\x05\
-    \x046\x02\x0e\x03\x12\x04\x90\x0235\n\x0c\n\x04\x046\x02\x0f\x12\x04\x91\
-    \x02\x048\n\r\n\x05\x046\x02\x0f\x06\x12\x04\x91\x02\x04\x1d\n\r\n\x05\
-    \x046\x02\x0f\x01\x12\x04\x91\x02\x1e2\n\r\n\x05\x046\x02\x0f\x03\x12\
-    \x04\x91\x0257\n\x0c\n\x04\x046\x02\x10\x12\x04\x92\x02\x044\n\r\n\x05\
-    \x046\x02\x10\x06\x12\x04\x92\x02\x04\x1b\n\r\n\x05\x046\x02\x10\x01\x12\
-    \x04\x92\x02\x1c.\n\r\n\x05\x046\x02\x10\x03\x12\x04\x92\x0213\n\x0c\n\
-    \x04\x046\x02\x11\x12\x04\x93\x02\x040\n\r\n\x05\x046\x02\x11\x06\x12\
-    \x04\x93\x02\x04\x19\n\r\n\x05\x046\x02\x11\x01\x12\x04\x93\x02\x1a*\n\r\
-    \n\x05\x046\x02\x11\x03\x12\x04\x93\x02-/\n\x0c\n\x04\x046\x02\x12\x12\
-    \x04\x94\x02\x044\n\r\n\x05\x046\x02\x12\x06\x12\x04\x94\x02\x04\x1b\n\r\
-    \n\x05\x046\x02\x12\x01\x12\x04\x94\x02\x1c.\n\r\n\x05\x046\x02\x12\x03\
-    \x12\x04\x94\x0213\n\x0c\n\x04\x046\x02\x13\x12\x04\x95\x02\x04@\n\r\n\
-    \x05\x046\x02\x13\x06\x12\x04\x95\x02\x04!\n\r\n\x05\x046\x02\x13\x01\
-    \x12\x04\x95\x02\":\n\r\n\x05\x046\x02\x13\x03\x12\x04\x95\x02=?\n\x0c\n\
-    \x04\x046\x02\x14\x12\x04\x96\x02\x044\n\r\n\x05\x046\x02\x14\x06\x12\
-    \x04\x96\x02\x04\x1b\n\r\n\x05\x046\x02\x14\x01\x12\x04\x96\x02\x1c.\n\r\
-    \n\x05\x046\x02\x14\x03\x12\x04\x96\x0213\n\x0c\n\x04\x046\x02\x15\x12\
-    \x04\x97\x02\x042\n\r\n\x05\x046\x02\x15\x06\x12\x04\x97\x02\x04\x1a\n\r\
-    \n\x05\x046\x02\x15\x01\x12\x04\x97\x02\x1b,\n\r\n\x05\x046\x02\x15\x03\
-    \x12\x04\x97\x02/1\nF\n\x02\x047\x12\x06\x9c\x02\0\xab\x02\x01\x1a8\x20M\
-    essage\x20with\x20data\x20required\x20to\x20execute\x20`call`\x20operati\
-    on\n\n\x0b\n\x03\x047\x01\x12\x04\x9c\x02\x08\x17\n\x0c\n\x04\x047\x02\0\
-    \x12\x04\x9d\x02\x02\x11\n\r\n\x05\x047\x02\0\x05\x12\x04\x9d\x02\x02\
-    \x07\n\r\n\x05\x047\x02\0\x01\x12\x04\x9d\x02\x08\x0c\n\r\n\x05\x047\x02\
-    \0\x03\x12\x04\x9d\x02\x0f\x10\n\x0c\n\x04\x047\x02\x01\x12\x04\x9e\x02\
-    \x02\x0f\n\r\n\x05\x047\x02\x01\x05\x12\x04\x9e\x02\x02\x07\n\r\n\x05\
-    \x047\x02\x01\x01\x12\x04\x9e\x02\x08\n\n\r\n\x05\x047\x02\x01\x03\x12\
-    \x04\x9e\x02\r\x0e\n\x0c\n\x04\x047\x02\x02\x12\x04\x9f\x02\x02\x11\n\r\
-    \n\x05\x047\x02\x02\x05\x12\x04\x9f\x02\x02\x07\n\r\n\x05\x047\x02\x02\
-    \x01\x12\x04\x9f\x02\x08\x0c\n\r\n\x05\x047\x02\x02\x03\x12\x04\x9f\x02\
-    \x0f\x10\n\x0c\n\x04\x047\x02\x03\x12\x04\xa0\x02\x02\x16\n\r\n\x05\x047\
-    \x02\x03\x05\x12\x04\xa0\x02\x02\x08\n\r\n\x05\x047\x02\x03\x01\x12\x04\
-    \xa0\x02\t\x11\n\r\n\x05\x047\x02\x03\x03\x12\x04\xa0\x02\x14\x15\n\x0c\
-    \n\x04\x047\x02\x04\x12\x04\xa1\x02\x02\x15\n\r\n\x05\x047\x02\x04\x05\
-    \x12\x04\xa1\x02\x02\x07\n\r\n\x05\x047\x02\x04\x01\x12\x04\xa1\x02\x08\
-    \x10\n\r\n\x05\x047\x02\x04\x03\x12\x04\xa1\x02\x13\x14\n\x0c\n\x04\x047\
-    \x02\x05\x12\x04\xa2\x02\x02\x12\n\r\n\x05\x047\x02\x05\x05\x12\x04\xa2\
-    \x02\x02\x07\n\r\n\x05\x047\x02\x05\x01\x12\x04\xa2\x02\x08\r\n\r\n\x05\
-    \x047\x02\x05\x03\x12\x04\xa2\x02\x10\x11\n\x0c\n\x04\x047\x02\x06\x12\
-    \x04\xa3\x02\x02)\n\r\n\x05\x047\x02\x06\x04\x12\x04\xa3\x02\x02\n\n\r\n\
-    \x05\x047\x02\x06\x06\x12\x04\xa3\x02\x0b\x19\n\r\n\x05\x047\x02\x06\x01\
-    \x12\x04\xa3\x02\x1a$\n\r\n\x05\x047\x02\x06\x03\x12\x04\xa3\x02'(\n\x0c\
-    \n\x04\x047\x02\x07\x12\x04\xa4\x02\x02\x12\n\r\n\x05\x047\x02\x07\x05\
-    \x12\x04\xa4\x02\x02\x06\n\r\n\x05\x047\x02\x07\x01\x12\x04\xa4\x02\x07\
-    \r\n\r\n\x05\x047\x02\x07\x03\x12\x04\xa4\x02\x10\x11\n\x0c\n\x04\x047\
-    \x02\x08\x12\x04\xa5\x02\x02\x13\n\r\n\x05\x047\x02\x08\x05\x12\x04\xa5\
-    \x02\x02\x08\n\r\n\x05\x047\x02\x08\x01\x12\x04\xa5\x02\t\x0e\n\r\n\x05\
-    \x047\x02\x08\x03\x12\x04\xa5\x02\x11\x12\n\x0c\n\x04\x047\x02\t\x12\x04\
-    \xa6\x02\x02\x18\n\r\n\x05\x047\x02\t\x05\x12\x04\xa6\x02\x02\x06\n\r\n\
-    \x05\x047\x02\t\x01\x12\x04\xa6\x02\x07\x12\n\r\n\x05\x047\x02\t\x03\x12\
-    \x04\xa6\x02\x15\x17\n\x0c\n\x04\x047\x02\n\x12\x04\xa7\x02\x02\x17\n\r\
-    \n\x05\x047\x02\n\x05\x12\x04\xa7\x02\x02\x07\n\r\n\x05\x047\x02\n\x01\
-    \x12\x04\xa7\x02\x08\x11\n\r\n\x05\x047\x02\n\x03\x12\x04\xa7\x02\x14\
-    \x16\n\x0c\n\x04\x047\x02\x0b\x12\x04\xa8\x02\x02\"\n\r\n\x05\x047\x02\
-    \x0b\x05\x12\x04\xa8\x02\x02\x07\n\r\n\x05\x047\x02\x0b\x01\x12\x04\xa8\
-    \x02\x08\x1c\n\r\n\x05\x047\x02\x0b\x03\x12\x04\xa8\x02\x1f!\n\x0c\n\x04\
-    \x047\x02\x0c\x12\x04\xa9\x02\x02\x1a\n\r\n\x05\x047\x02\x0c\x05\x12\x04\
-    \xa9\x02\x02\x07\n\r\n\x05\x047\x02\x0c\x01\x12\x04\xa9\x02\x08\x14\n\r\
-    \n\x05\x047\x02\x0c\x03\x12\x04\xa9\x02\x17\x19\n\x0c\n\x04\x047\x02\r\
-    \x12\x04\xaa\x02\x02\x15\n\r\n\x05\x047\x02\r\x05\x12\x04\xaa\x02\x02\
-    \x08\n\r\n\x05\x047\x02\r\x01\x12\x04\xaa\x02\t\x0f\n\r\n\x05\x047\x02\r\
-    \x03\x12\x04\xaa\x02\x12\x14\nH\n\x02\x048\x12\x06\xae\x02\0\xbb\x02\x01\
+    est\x12b\n\x19storageAtEncryptedRequest\x18\x05\x20\x01(\x0b2\".ffi.ffi.\
+    StorageAtEncryptedRequestH\0R\x19storageAtEncryptedRequestB\x05\n\x03req\
+    *Y\n\nTracerType\x12\x0f\n\x0bTRACER_NONE\x10\0\x12\x11\n\rTRACER_STRUCT\
+    \x10\x01\x12\x0f\n\x0bTRACER_CALL\x10\x02\x12\x16\n\x12TRACER_ACCESS_LIS\
+    T\x10\x03B&Z$github.com/SigmaGmbH/librustgo/typesJ\xcf\x9c\x01\n\x07\x12\
+    \x05\0\0\xef\x03\x01\n\x08\n\x01\x0c\x12\x03\0\0\x12\n\x08\n\x01\x02\x12\
+    \x03\x02\0\x10\n\x08\n\x01\x08\x12\x03\x04\0;\n\t\n\x02\x08\x0b\x12\x03\
+    \x04\0;\n\x1d\n\x02\x04\0\x12\x04\x08\0\x0b\x012\x11\x20General\x20reque\
+    st\n\n\n\n\x03\x04\0\x01\x12\x03\x08\x08\x16\n\x0b\n\x04\x04\0\x02\0\x12\
+    \x03\t\x02!\n\x0c\n\x05\x04\0\x02\0\x04\x12\x03\t\x02\n\n\x0c\n\x05\x04\
+    \0\x02\0\x05\x12\x03\t\x0b\x10\n\x0c\n\x05\x04\0\x02\0\x01\x12\x03\t\x11\
+    \x1c\n\x0c\n\x05\x04\0\x02\0\x03\x12\x03\t\x1f\x20\n\x0b\n\x04\x04\0\x02\
+    \x01\x12\x03\n\x02\x14\n\x0c\n\x05\x04\0\x02\x01\x05\x12\x03\n\x02\x07\n\
+    \x0c\n\x05\x04\0\x02\x01\x01\x12\x03\n\x08\x0f\n\x0c\n\x05\x04\0\x02\x01\
+    \x03\x12\x03\n\x12\x13\n<\n\x02\x04\x01\x12\x04\x0e\0\x15\x01\x1a0\x20EI\
+    P-7702\x20authorization\x20of\x20set\x20code\x20transaction\n\n\n\n\x03\
+    \x04\x01\x01\x12\x03\x0e\x08\x1d\n\x0b\n\x04\x04\x01\x02\0\x12\x03\x0f\
+    \x02\x14\n\x0c\n\x05\x04\x01\x02\0\x05\x12\x03\x0f\x02\x07\n\x0c\n\x05\
+    \x04\x01\x02\0\x01\x12\x03\x0f\x08\x0f\n\x0c\n\x05\x04\x01\x02\0\x03\x12\
+    \x03\x0f\x12\x13\n\x0b\n\x04\x04\x01\x02\x01\x12\x03\x10\x02\x14\n\x0c\n\
+    \x05\x04\x01\x02\x01\x05\x12\x03\x10\x02\x07\n\x0c\n\x05\x04\x01\x02\x01\
+    \x01\x12\x03\x10\x08\x0f\n\x0c\n\x05\x04\x01\x02\x01\x03\x12\x03\x10\x12\
+    \x13\n\x0b\n\x04\x04\x01\x02\x02\x12\x03\x11\x02\x13\n\x0c\n\x05\x04\x01\
+    \x02\x02\x05\x12\x03\x11\x02\x08\n\x0c\n\x05\x04\x01\x02\x02\x01\x12\x03\
+    \x11\t\x0e\n\x0c\n\x05\x04\x01\x02\x02\x03\x12\x03\x11\x11\x12\n\x0b\n\
+    \x04\x04\x01\x02\x03\x12\x03\x12\x02\x15\n\x0c\n\x05\x04\x01\x02\x03\x05\
+    \x12\x03\x12\x02\x08\n\x0c\n\x05\x04\x01\x02\x03\x01\x12\x03\x12\t\x10\n\
+    \x0c\n\x05\x04\x01\x02\x03\x03\x12\x03\x12\x13\x14\n\x0b\n\x04\x04\x01\
+    \x02\x04\x12\x03\x13\x02\x0e\n\x0c\n\x05\x04\x01\x02\x04\x05\x12\x03\x13\
+    \x02\x07\n\x0c\n\x05\x04\x01\x02\x04\x01\x12\x03\x13\x08\t\n\x0c\n\x05\
+    \x04\x01\x02\x04\x03\x12\x03\x13\x0c\r\n\x0b\n\x04\x04\x01\x02\x05\x12\
+    \x03\x14\x02\x0e\n\x0c\n\x05\x04\x01\x02\x05\x05\x12\x03\x14\x02\x07\n\
+    \x0c\n\x05\x04\x01\x02\x05\x01\x12\x03\x14\x08\t\n\x0c\n\x05\x04\x01\x02\
+    \x05\x03\x12\x03\x14\x0c\r\n\n\n\x02\x04\x02\x12\x04\x17\0\x1e\x01\n\n\n\
+    \x03\x04\x02\x01\x12\x03\x17\x08\x17\n\x0b\n\x04\x04\x02\x02\0\x12\x03\
+    \x18\x02\x11\n\x0c\n\x05\x04\x02\x02\0\x05\x12\x03\x18\x02\x07\n\x0c\n\
+    \x05\x04\x02\x02\0\x01\x12\x03\x18\x08\x0c\n\x0c\n\x05\x04\x02\x02\0\x03\
+    \x12\x03\x18\x0f\x10\n\x0b\n\x04\x04\x02\x02\x01\x12\x03\x19\x02\x0f\n\
+    \x0c\n\x05\x04\x02\x02\x01\x05\x12\x03\x19\x02\x07\n\x0c\n\x05\x04\x02\
+    \x02\x01\x01\x12\x03\x19\x08\n\n\x0c\n\x05\x04\x02\x02\x01\x03\x12\x03\
+    \x19\r\x0e\n\x0b\n\x04\x04\x02\x02\x02\x12\x03\x1a\x02\x11\n\x0c\n\x05\
+    \x04\x02\x02\x02\x05\x12\x03\x1a\x02\x07\n\x0c\n\x05\x04\x02\x02\x02\x01\
+    \x12\x03\x1a\x08\x0c\n\x0c\n\x05\x04\x02\x02\x02\x03\x12\x03\x1a\x0f\x10\
+    \n\x0b\n\x04\x04\x02\x02\x03\x12\x03\x1b\x02\x16\n\x0c\n\x05\x04\x02\x02\
+    \x03\x05\x12\x03\x1b\x02\x08\n\x0c\n\x05\x04\x02\x02\x03\x01\x12\x03\x1b\
+    \t\x11\n\x0c\n\x05\x04\x02\x02\x03\x03\x12\x03\x1b\x14\x15\n\x0b\n\x04\
+    \x04\x02\x02\x04\x12\x03\x1c\x02\x12\n\x0c\n\x05\x04\x02\x02\x04\x05\x12\
+    \x03\x1c\x02\x07\n\x0c\n\x05\x04\x02\x02\x04\x01\x12\x03\x1c\x08\r\n\x0c\
+    \n\x05\x04\x02\x02\x04\x03\x12\x03\x1c\x10\x11\n\x0b\n\x04\x04\x02\x02\
+    \x05\x12\x03\x1d\x02)\n\x0c\n\x05\x04\x02\x02\x05\x04\x12\x03\x1d\x02\n\
+    \n\x0c\n\x05\x04\x02\x02\x05\x06\x12\x03\x1d\x0b\x19\n\x0c\n\x05\x04\x02\
+    \x02\x05\x01\x12\x03\x1d\x1a$\n\x0c\n\x05\x04\x02\x02\x05\x03\x12\x03\
+    \x1d'(\n\n\n\x02\x04\x03\x12\x04\x20\0(\x01\n\n\n\x03\x04\x03\x01\x12\
+    \x03\x20\x08\x1a\n\x0b\n\x04\x04\x03\x02\0\x12\x03!\x02\x16\n\x0c\n\x05\
+    \x04\x03\x02\0\x05\x12\x03!\x02\x08\n\x0c\n\x05\x04\x03\x02\0\x01\x12\
+    \x03!\t\x11\n\x0c\n\x05\x04\x03\x02\0\x03\x12\x03!\x14\x15\n\x0b\n\x04\
+    \x04\x03\x02\x01\x12\x03\"\x02\x16\n\x0c\n\x05\x04\x03\x02\x01\x05\x12\
+    \x03\"\x02\x07\n\x0c\n\x05\x04\x03\x02\x01\x01\x12\x03\"\x08\x11\n\x0c\n\
+    \x05\x04\x03\x02\x01\x03\x12\x03\"\x14\x15\n\x0b\n\x04\x04\x03\x02\x02\
+    \x12\x03#\x02\x17\n\x0c\n\x05\x04\x03\x02\x02\x05\x12\x03#\x02\x08\n\x0c\
+    \n\x05\x04\x03\x02\x02\x01\x12\x03#\t\x12\n\x0c\n\x05\x04\x03\x02\x02\
+    \x03\x12\x03#\x15\x16\n\x0b\n\x04\x04\x03\x02\x03\x12\x03$\x02\x1d\n\x0c\
+    \n\x05\x04\x03\x02\x03\x05\x12\x03$\x02\x08\n\x0c\n\x05\x04\x03\x02\x03\
+    \x01\x12\x03$\t\x18\n\x0c\n\x05\x04\x03\x02\x03\x03\x12\x03$\x1b\x1c\n\
+    \x0b\n\x04\x04\x03\x02\x04\x12\x03%\x02#\n\x0c\n\x05\x04\x03\x02\x04\x05\
+    \x12\x03%\x02\x07\n\x0c\n\x05\x04\x03\x02\x04\x01\x12\x03%\x08\x1e\n\x0c\
+    \n\x05\x04\x03\x02\x04\x03\x12\x03%!\"\n\x0b\n\x04\x04\x03\x02\x05\x12\
+    \x03&\x02\x1b\n\x0c\n\x05\x04\x03\x02\x05\x05\x12\x03&\x02\x07\n\x0c\n\
+    \x05\x04\x03\x02\x05\x01\x12\x03&\x08\x16\n\x0c\n\x05\x04\x03\x02\x05\
+    \x03\x12\x03&\x19\x1a\n\x0b\n\x04\x04\x03\x02\x06\x12\x03'\x02\x1a\n\x0c\
+    \n\x05\x04\x03\x02\x06\x05\x12\x03'\x02\x08\n\x0c\n\x05\x04\x03\x02\x06\
+    \x01\x12\x03'\t\x15\n\x0c\n\x05\x04\x03\x02\x06\x03\x12\x03'\x18\x19\n\n\
+    \n\x02\x04\x04\x12\x04*\0-\x01\n\n\n\x03\x04\x04\x01\x12\x03*\x08\x20\n\
+    \x0b\n\x04\x04\x04\x02\0\x12\x03+\x02\x1e\n\x0c\n\x05\x04\x04\x02\0\x06\
+    \x12\x03+\x02\x11\n\x0c\n\x05\x04\x04\x02\0\x01\x12\x03+\x12\x19\n\x0c\n\
+    \x05\x04\x04\x02\0\x03\x12\x03+\x1c\x1d\n\x0b\n\x04\x04\x04\x02\x01\x12\
+    \x03,\x02$\n\x0c\n\x05\x04\x04\x02\x01\x06\x12\x03,\x02\x14\n\x0c\n\x05\
+    \x04\x04\x02\x01\x01\x12\x03,\x15\x1f\n\x0c\n\x05\x04\x04\x02\x01\x03\
+    \x12\x03,\"#\n\n\n\x02\x04\x05\x12\x04/\0A\x01\n\n\n\x03\x04\x05\x01\x12\
+    \x03/\x08!\nZ\n\x04\x04\x05\x02\0\x12\x032\x02\x18\x1aM\x20logs\x20conta\
+    ins\x20the\x20transaction\x20hash\x20and\x20the\x20proto-compatible\x20e\
+    thereum\n\x20logs.\n\n\x0c\n\x05\x04\x05\x02\0\x04\x12\x032\x02\n\n\x0c\
+    \n\x05\x04\x05\x02\0\x06\x12\x032\x0b\x0e\n\x0c\n\x05\x04\x05\x02\0\x01\
+    \x12\x032\x0f\x13\n\x0c\n\x05\x04\x05\x02\0\x03\x12\x032\x16\x17\n\\\n\
+    \x04\x04\x05\x02\x01\x12\x035\x02\x10\x1aO\x20returned\x20data\x20from\
+    \x20evm\x20function\x20(result\x20or\x20data\x20supplied\x20with\x20reve\
+    rt\n\x20opcode)\n\n\x0c\n\x05\x04\x05\x02\x01\x05\x12\x035\x02\x07\n\x0c\
+    \n\x05\x04\x05\x02\x01\x01\x12\x035\x08\x0b\n\x0c\n\x05\x04\x05\x02\x01\
+    \x03\x12\x035\x0e\x0f\n=\n\x04\x04\x05\x02\x02\x12\x037\x02\x16\x1a0\x20\
+    vm\x20error\x20is\x20the\x20error\x20returned\x20by\x20vm\x20execution\n\
+    \n\x0c\n\x05\x04\x05\x02\x02\x05\x12\x037\x02\x08\n\x0c\n\x05\x04\x05\
+    \x02\x02\x01\x12\x037\t\x11\n\x0c\n\x05\x04\x05\x02\x02\x03\x12\x037\x14\
+    \x15\n`\n\x04\x04\x05\x02\x03\x12\x03:\x02\x16\x1aS\x20gas\x20consumed\
+    \x20by\x20the\x20transaction.\x20If\x20gas_refund\x20is\x20set,\x20refun\
+    d\x20is\x20not\n\x20applied\x20yet\n\n\x0c\n\x05\x04\x05\x02\x03\x05\x12\
+    \x03:\x02\x08\n\x0c\n\x05\x04\x05\x02\x03\x01\x12\x03:\t\x11\n\x0c\n\x05\
+    \x04\x05\x02\x03\x03\x12\x03:\x14\x15\nO\n\x04\x04\x05\x02\x04\x12\x03<\
+    \x02\x1b\x1aB\x20execution\x20trace,\x20which\x20is\x20returned\x20only\
+    \x20if\x20tracing\x20was\x20requested\n\n\x0c\n\x05\x04\x05\x02\x04\x06\
+    \x12\x03<\x02\x10\n\x0c\n\x05\x04\x05\x02\x04\x01\x12\x03<\x11\x16\n\x0c\
+    \n\x05\x04\x05\x02\x04\x03\x12\x03<\x19\x1a\n\xb2\x01\n\x04\x04\x05\x02\
+    \x05\x12\x03@\x02\x18\x1a\xa4\x01\x20refund,\x20which\x20is\x20not\x20ap\
+    plied\x20to\x20gas_used\x20yet.\x20Cap\x20is\x20applied\x20by\x20the\x20\
+    caller\n\x20according\x20to\x20EIP-3529,\x20executor\x20may\x20return\
+    \x20refund\x20already\x20capped\x20by\x20the\n\x20same\x20quotient\n\n\
+    \x0c\n\x05\x04\x05\x02\x05\x05\x12\x03@\x02\x08\n\x0c\n\x05\x04\x05\x02\
+    \x05\x01\x12\x03@\t\x13\n\x0c\n\x05\x04\x05\x02\x05\x03\x12\x03@\x16\x17\
+    \n\n\n\x02\x04\x06\x12\x04C\0F\x01\n\n\n\x03\x04\x06\x01\x12\x03C\x08\
+    \x20\n\x0b\n\x04\x04\x06\x02\0\x12\x03D\x02\x1e\n\x0c\n\x05\x04\x06\x02\
+    \0\x06\x12\x03D\x02\x11\n\x0c\n\x05\x04\x06\x02\0\x01\x12\x03D\x12\x19\n\
+    \x0c\n\x05\x04\x06\x02\0\x03\x12\x03D\x1c\x1d\n\x0b\n\x04\x04\x06\x02\
+    \x01\x12\x03E\x02$\n\x0c\n\x05\x04\x06\x02\x01\x06\x12\x03E\x02\x14\n\
+    \x0c\n\x05\x04\x06\x02\x01\x01\x12\x03E\x15\x1f\n\x0c\n\x05\x04\x06\x02\
+    \x01\x03\x12\x03E\"#\n\n\n\x02\x04\x07\x12\x04G\0L\x01\n\n\n\x03\x04\x07\
+    \x01\x12\x03G\x08!\n=\n\x04\x04\x07\x02\0\x12\x03I\x02\x16\x1a0\x20vm\
+    \x20error\x20is\x20the\x20error\x20returned\x20by\x20vm\x20execution\n\n\
+    \x0c\n\x05\x04\x07\x02\0\x05\x12\x03I\x02\x08\n\x0c\n\x05\x04\x07\x02\0\
+    \x01\x12\x03I\t\x11\n\x0c\n\x05\x04\x07\x02\0\x03\x12\x03I\x14\x15\n.\n\
+    \x04\x04\x07\x02\x01\x12\x03K\x02\x16\x1a!\x20gas\x20consumed\x20by\x20t\
+    he\x20transaction\n\n\x0c\n\x05\x04\x07\x02\x01\x05\x12\x03K\x02\x08\n\
+    \x0c\n\x05\x04\x07\x02\x01\x01\x12\x03K\t\x11\n\x0c\n\x05\x04\x07\x02\
+    \x01\x03\x12\x03K\x14\x15\nc\n\x02\x04\x08\x12\x03P\0\"\x1aX\x20Topic\
+    \x20represents\x2032-byte\x20words\x20that\x20is\x20used\x20to\x20descri\
+    be\x20what\xe2\x80\x99s\x20going\x20on\x20in\x20an\n\x20event\n\n\n\n\
+    \x03\x04\x08\x01\x12\x03P\x08\r\n\x0b\n\x04\x04\x08\x02\0\x12\x03P\x10\
+    \x20\n\x0c\n\x05\x04\x08\x02\0\x05\x12\x03P\x10\x15\n\x0c\n\x05\x04\x08\
+    \x02\0\x01\x12\x03P\x16\x1b\n\x0c\n\x05\x04\x08\x02\0\x03\x12\x03P\x1e\
+    \x1f\n\x81\x01\n\x02\x04\t\x12\x04U\0\\\x01\x1au\x20Log\x20represents\
+    \x20an\x20protobuf\x20compatible\x20Ethereum\x20Log\x20that\x20defines\
+    \x20a\x20contract\n\x20log\x20event.\n\x20Copied\x20from\x20`devnet/prot\
+    o``\n\n\n\n\x03\x04\t\x01\x12\x03U\x08\x0b\n?\n\x04\x04\t\x02\0\x12\x03W\
+    \x02\x14\x1a2\x20address\x20of\x20the\x20contract\x20that\x20generated\
+    \x20the\x20event\n\n\x0c\n\x05\x04\t\x02\0\x05\x12\x03W\x02\x07\n\x0c\n\
+    \x05\x04\t\x02\0\x01\x12\x03W\x08\x0f\n\x0c\n\x05\x04\t\x02\0\x03\x12\
+    \x03W\x12\x13\n7\n\x04\x04\t\x02\x01\x12\x03Y\x02\x1c\x1a*\x20list\x20of\
+    \x20topics\x20provided\x20by\x20the\x20contract.\n\n\x0c\n\x05\x04\t\x02\
+    \x01\x04\x12\x03Y\x02\n\n\x0c\n\x05\x04\t\x02\x01\x06\x12\x03Y\x0b\x10\n\
+    \x0c\n\x05\x04\t\x02\x01\x01\x12\x03Y\x11\x17\n\x0c\n\x05\x04\t\x02\x01\
+    \x03\x12\x03Y\x1a\x1b\n<\n\x04\x04\t\x02\x02\x12\x03[\x02\x11\x1a/\x20su\
+    pplied\x20by\x20the\x20contract,\x20usually\x20ABI-encoded\n\n\x0c\n\x05\
+    \x04\t\x02\x02\x05\x12\x03[\x02\x07\n\x0c\n\x05\x04\t\x02\x02\x01\x12\
+    \x03[\x08\x0c\n\x0c\n\x05\x04\t\x02\x02\x03\x12\x03[\x0f\x10\nX\n\x02\
+    \x04\n\x12\x04_\0b\x01\x1aL\x20Request\x20for\x20account\x20code\x20(sma\
+    rt\x20contract\x20deployed\x20behind\x20provided\x20address)\n\n\n\n\x03\
+    \x04\n\x01\x12\x03_\x08\x17\n*\n\x04\x04\n\x02\0\x12\x03a\x02\x14\x1a\
+    \x1d\x2020\x20bytes\x20of\x20account\x20address\n\n\x0c\n\x05\x04\n\x02\
+    \0\x05\x12\x03a\x02\x07\n\x0c\n\x05\x04\n\x02\0\x01\x12\x03a\x08\x0f\n\
+    \x0c\n\x05\x04\n\x02\0\x03\x12\x03a\x12\x13\n'\n\x02\x04\x0b\x12\x04e\0j\
+    \x01\x1a\x1b\x20Response\x20for\x20account\x20code\n\n\n\n\x03\x04\x0b\
+    \x01\x12\x03e\x08\x1f\nb\n\x04\x04\x0b\x02\0\x12\x03h\x02\x14\x1aU\x20Si\
+    nce\x20both\x20fields\x20are\x20256-bit\x20unsigned\x20integer,\x20we\
+    \x20encode\x20them\x20as\n\x20big-endian\x20bytes\n\n\x0c\n\x05\x04\x0b\
+    \x02\0\x05\x12\x03h\x02\x07\n\x0c\n\x05\x04\x0b\x02\0\x01\x12\x03h\x08\
+    \x0f\n\x0c\n\x05\x04\x0b\x02\0\x03\x12\x03h\x12\x13\n\x0b\n\x04\x04\x0b\
+    \x02\x01\x12\x03i\x02\x13\n\x0c\n\x05\x04\x0b\x02\x01\x05\x12\x03i\x02\
+    \x08\n\x0c\n\x05\x04\x0b\x02\x01\x01\x12\x03i\t\x0e\n\x0c\n\x05\x04\x0b\
+    \x02\x01\x03\x12\x03i\x11\x12\n\n\n\x02\x04\x0c\x12\x04l\0o\x01\n\n\n\
+    \x03\x04\x0c\x01\x12\x03l\x08!\n\x0b\n\x04\x04\x0c\x02\0\x12\x03m\x02\
+    \x14\n\x0c\n\x05\x04\x0c\x02\0\x05\x12\x03m\x02\x07\n\x0c\n\x05\x04\x0c\
+    \x02\0\x01\x12\x03m\x08\x0f\n\x0c\n\x05\x04\x0c\x02\0\x03\x12\x03m\x12\
+    \x13\n\x0b\n\x04\x04\x0c\x02\x01\x12\x03n\x02\x14\n\x0c\n\x05\x04\x0c\
+    \x02\x01\x05\x12\x03n\x02\x07\n\x0c\n\x05\x04\x0c\x02\x01\x01\x12\x03n\
+    \x08\x0f\n\x0c\n\x05\x04\x0c\x02\x01\x03\x12\x03n\x12\x13\n\t\n\x02\x04\
+    \r\x12\x03p\0,\n\n\n\x03\x04\r\x01\x12\x03p\x08)\n\n\n\x02\x04\x0e\x12\
+    \x04r\0u\x01\n\n\n\x03\x04\x0e\x01\x12\x03r\x08\x1f\n\x0b\n\x04\x04\x0e\
+    \x02\0\x12\x03s\x02\x14\n\x0c\n\x05\x04\x0e\x02\0\x05\x12\x03s\x02\x07\n\
+    \x0c\n\x05\x04\x0e\x02\0\x01\x12\x03s\x08\x0f\n\x0c\n\x05\x04\x0e\x02\0\
+    \x03\x12\x03s\x12\x13\n\x0b\n\x04\x04\x0e\x02\x01\x12\x03t\x02\x13\n\x0c\
+    \n\x05\x04\x0e\x02\x01\x05\x12\x03t\x02\x08\n\x0c\n\x05\x04\x0e\x02\x01\
+    \x01\x12\x03t\t\x0e\n\x0c\n\x05\x04\x0e\x02\x01\x03\x12\x03t\x11\x12\n\t\
+    \n\x02\x04\x0f\x12\x03v\0)\n\n\n\x03\x04\x0f\x01\x12\x03v\x08'\n\t\n\x02\
+    \x04\x10\x12\x03x\0+\n\n\n\x03\x04\x10\x01\x12\x03x\x08\x18\n\x0b\n\x04\
+    \x04\x10\x02\0\x12\x03x\x1b)\n\x0c\n\x05\x04\x10\x02\0\x05\x12\x03x\x1b\
+    \x20\n\x0c\n\x05\x04\x10\x02\0\x01\x12\x03x!$\n\x0c\n\x05\x04\x10\x02\0\
+    \x03\x12\x03x'(\n\t\n\x02\x04\x11\x12\x03z\07\n\n\n\x03\x04\x11\x01\x12\
+    \x03z\x08\x20\n\x0b\n\x04\x04\x11\x02\0\x12\x03z#5\n\x0c\n\x05\x04\x11\
+    \x02\0\x05\x12\x03z#'\n\x0c\n\x05\x04\x11\x02\0\x01\x12\x03z(0\n\x0c\n\
+    \x05\x04\x11\x02\0\x03\x12\x03z34\n\n\n\x02\x04\x12\x12\x04|\0\x7f\x01\n\
+    \n\n\x03\x04\x12\x01\x12\x03|\x08\"\n\x0b\n\x04\x04\x12\x02\0\x12\x03}\
+    \x02\x14\n\x0c\n\x05\x04\x12\x02\0\x05\x12\x03}\x02\x07\n\x0c\n\x05\x04\
+    \x12\x02\0\x01\x12\x03}\x08\x0f\n\x0c\n\x05\x04\x12\x02\0\x03\x12\x03}\
+    \x12\x13\n\x0b\n\x04\x04\x12\x02\x01\x12\x03~\x02\x12\n\x0c\n\x05\x04\
+    \x12\x02\x01\x05\x12\x03~\x02\x07\n\x0c\n\x05\x04\x12\x02\x01\x01\x12\
+    \x03~\x08\r\n\x0c\n\x05\x04\x12\x02\x01\x03\x12\x03~\x10\x11\n\n\n\x02\
+    \x04\x13\x12\x04\x81\x01\0?\n\x0b\n\x03\x04\x13\x01\x12\x04\x81\x01\x08*\
+    \n\x0c\n\x04\x04\x13\x02\0\x12\x04\x81\x01-=\n\r\n\x05\x04\x13\x02\0\x05\
+    \x12\x04\x81\x01-2\n\r\n\x05\x04\x13\x02\0\x01\x12\x04\x81\x0138\n\r\n\
+    \x05\x04\x13\x02\0\x03\x12\x04\x81\x01;<\n\n\n\x02\x04\x14\x12\x04\x83\
+    \x01\02\n\x0b\n\x03\x04\x14\x01\x12\x04\x83\x01\x08\x1b\n\x0c\n\x04\x04\
+    \x14\x02\0\x12\x04\x83\x01\x1e0\n\r\n\x05\x04\x14\x02\0\x05\x12\x04\x83\
+    \x01\x1e#\n\r\n\x05\x04\x14\x02\0\x01\x12\x04\x83\x01$+\n\r\n\x05\x04\
+    \x14\x02\0\x03\x12\x04\x83\x01./\n\n\n\x02\x04\x15\x12\x04\x85\x01\07\n\
+    \x0b\n\x03\x04\x15\x01\x12\x04\x85\x01\x08#\n\x0c\n\x04\x04\x15\x02\0\
+    \x12\x04\x85\x01&5\n\r\n\x05\x04\x15\x02\0\x05\x12\x04\x85\x01&+\n\r\n\
+    \x05\x04\x15\x02\0\x01\x12\x04\x85\x01,0\n\r\n\x05\x04\x15\x02\0\x03\x12\
+    \x04\x85\x0134\n\n\n\x02\x04\x16\x12\x04\x87\x01\06\n\x0b\n\x03\x04\x16\
+    \x01\x12\x04\x87\x01\x08\x1f\n\x0c\n\x04\x04\x16\x02\0\x12\x04\x87\x01\"\
+    4\n\r\n\x05\x04\x16\x02\0\x05\x12\x04\x87\x01\"'\n\r\n\x05\x04\x16\x02\0\
+    \x01\x12\x04\x87\x01(/\n\r\n\x05\x04\x16\x02\0\x03\x12\x04\x87\x0123\n\n\
+    \n\x02\x04\x17\x12\x04\x88\x01\0<\n\x0b\n\x03\x04\x17\x01\x12\x04\x88\
+    \x01\x08'\n\x0c\n\x04\x04\x17\x02\0\x12\x04\x88\x01*:\n\r\n\x05\x04\x17\
+    \x02\0\x05\x12\x04\x88\x01*0\n\r\n\x05\x04\x17\x02\0\x01\x12\x04\x88\x01\
+    15\n\r\n\x05\x04\x17\x02\0\x03\x12\x04\x88\x0189\n\n\n\x02\x04\x18\x12\
+    \x04\x8a\x01\06\n\x0b\n\x03\x04\x18\x01\x12\x04\x8a\x01\x08\x1f\n\x0c\n\
+    \x04\x04\x18\x02\0\x12\x04\x8a\x01\"4\n\r\n\x05\x04\x18\x02\0\x05\x12\
+    \x04\x8a\x01\"'\n\r\n\x05\x04\x18\x02\0\x01\x12\x04\x8a\x01(/\n\r\n\x05\
+    \x04\x18\x02\0\x03\x12\x04\x8a\x0123\n\n\n\x02\x04\x19\x12\x04\x8b\x01\0\
+    ;\n\x0b\n\x03\x04\x19\x01\x12\x04\x8b\x01\x08'\n\x0c\n\x04\x04\x19\x02\0\
+    \x12\x04\x8b\x01*9\n\r\n\x05\x04\x19\x02\0\x05\x12\x04\x8b\x01*/\n\r\n\
+    \x05\x04\x19\x02\0\x01\x12\x04\x8b\x0104\n\r\n\x05\x04\x19\x02\0\x03\x12\
+    \x04\x8b\x0178\n\x0c\n\x02\x04\x1a\x12\x06\x8d\x01\0\x90\x01\x01\n\x0b\n\
+    \x03\x04\x1a\x01\x12\x04\x8d\x01\x08\x1e\n\x0c\n\x04\x04\x1a\x02\0\x12\
+    \x04\x8e\x01\x02\x14\n\r\n\x05\x04\x1a\x02\0\x05\x12\x04\x8e\x01\x02\x07\
+    \n\r\n\x05\x04\x1a\x02\0\x01\x12\x04\x8e\x01\x08\x0f\n\r\n\x05\x04\x1a\
+    \x02\0\x03\x12\x04\x8e\x01\x12\x13\n\x0c\n\x04\x04\x1a\x02\x01\x12\x04\
+    \x8f\x01\x02\x11\n\r\n\x05\x04\x1a\x02\x01\x05\x12\x04\x8f\x01\x02\x07\n\
+    \r\n\x05\x04\x1a\x02\x01\x01\x12\x04\x8f\x01\x08\x0c\n\r\n\x05\x04\x1a\
+    \x02\x01\x03\x12\x04\x8f\x01\x0f\x10\n\n\n\x02\x04\x1b\x12\x04\x92\x01\0\
+    )\n\x0b\n\x03\x04\x1b\x01\x12\x04\x92\x01\x08&\n\x0c\n\x02\x04\x1c\x12\
+    \x06\x94\x01\0\x98\x01\x01\n\x0b\n\x03\x04\x1c\x01\x12\x04\x94\x01\x08\
+    \x1e\n\x0c\n\x04\x04\x1c\x02\0\x12\x04\x95\x01\x02\x14\n\r\n\x05\x04\x1c\
+    \x02\0\x05\x12\x04\x95\x01\x02\x07\n\r\n\x05\x04\x1c\x02\0\x01\x12\x04\
+    \x95\x01\x08\x0f\n\r\n\x05\x04\x1c\x02\0\x03\x12\x04\x95\x01\x12\x13\n\
+    \x0c\n\x04\x04\x1c\x02\x01\x12\x04\x96\x01\x02\x12\n\r\n\x05\x04\x1c\x02\
+    \x01\x05\x12\x04\x96\x01\x02\x07\n\r\n\x05\x04\x1c\x02\x01\x01\x12\x04\
+    \x96\x01\x08\r\n\r\n\x05\x04\x1c\x02\x01\x03\x12\x04\x96\x01\x10\x11\n\
+    \x0c\n\x04\x04\x1c\x02\x02\x12\x04\x97\x01\x02\x12\n\r\n\x05\x04\x1c\x02\
+    \x02\x05\x12\x04\x97\x01\x02\x07\n\r\n\x05\x04\x1c\x02\x02\x01\x12\x04\
+    \x97\x01\x08\r\n\r\n\x05\x04\x1c\x02\x02\x03\x12\x04\x97\x01\x10\x11\n\n\
+    \n\x02\x04\x1d\x12\x04\x9a\x01\0)\n\x0b\n\x03\x04\x1d\x01\x12\x04\x9a\
+    \x01\x08&\n\n\n\x02\x04\x1e\x12\x04\x9c\x01\0*\n\x0b\n\x03\x04\x1e\x01\
+    \x12\x04\x9c\x01\x08\x13\n\x0c\n\x04\x04\x1e\x02\0\x12\x04\x9c\x01\x16(\
+    \n\r\n\x05\x04\x1e\x02\0\x05\x12\x04\x9c\x01\x16\x1b\n\r\n\x05\x04\x1e\
+    \x02\0\x01\x12\x04\x9c\x01\x1c#\n\r\n\x05\x04\x1e\x02\0\x03\x12\x04\x9c\
+    \x01&'\n\n\n\x02\x04\x1f\x12\x04\x9e\x01\0\x1e\n\x0b\n\x03\x04\x1f\x01\
+    \x12\x04\x9e\x01\x08\x1b\n\x0c\n\x02\x04\x20\x12\x06\xa0\x01\0\xa3\x01\
+    \x01\n\x0b\n\x03\x04\x20\x01\x12\x04\xa0\x01\x08\x1e\n\x0c\n\x04\x04\x20\
+    \x02\0\x12\x04\xa1\x01\x02\x14\n\r\n\x05\x04\x20\x02\0\x05\x12\x04\xa1\
+    \x01\x02\x07\n\r\n\x05\x04\x20\x02\0\x01\x12\x04\xa1\x01\x08\x0f\n\r\n\
+    \x05\x04\x20\x02\0\x03\x12\x04\xa1\x01\x12\x13\n\x0c\n\x04\x04\x20\x02\
+    \x01\x12\x04\xa2\x01\x02\x12\n\r\n\x05\x04\x20\x02\x01\x05\x12\x04\xa2\
+    \x01\x02\x07\n\r\n\x05\x04\x20\x02\x01\x01\x12\x04\xa2\x01\x08\r\n\r\n\
+    \x05\x04\x20\x02\x01\x03\x12\x04\xa2\x01\x10\x11\n\n\n\x02\x04!\x12\x04\
+    \xa5\x01\0)\n\x0b\n\x03\x04!\x01\x12\x04\xa5\x01\x08&\n\n\n\x02\x04\"\
+    \x12\x04\xa7\x01\01\n\x0b\n\x03\x04\"\x01\x12\x04\xa7\x01\x08\x1a\n\x0c\
+    \n\x04\x04\"\x02\0\x12\x04\xa7\x01\x1d/\n\r\n\x05\x04\"\x02\0\x05\x12\
+    \x04\xa7\x01\x1d\"\n\r\n\x05\x04\"\x02\0\x01\x12\x04\xa7\x01#*\n\r\n\x05\
+    \x04\"\x02\0\x03\x12\x04\xa7\x01-.\n\n\n\x02\x04#\x12\x04\xa9\x01\0%\n\
+    \x0b\n\x03\x04#\x01\x12\x04\xa9\x01\x08\"\n\n\n\x02\x04$\x12\x04\xab\x01\
+    \0,\n\x0b\n\x03\x04$\x01\x12\x04\xab\x01\x08\x16\n\x0c\n\x04\x04$\x02\0\
+    \x12\x04\xab\x01\x19*\n\r\n\x05\x04$\x02\0\x05\x12\x04\xab\x01\x19\x1e\n\
+    \r\n\x05\x04$\x02\0\x01\x12\x04\xab\x01\x1f%\n\r\n\x05\x04$\x02\0\x03\
+    \x12\x04\xab\x01()\n\n\n\x02\x04%\x12\x04\xac\x01\02\n\x0b\n\x03\x04%\
+    \x01\x12\x04\xac\x01\x08\x1e\n\x0c\n\x04\x04%\x02\0\x12\x04\xac\x01!0\n\
+    \r\n\x05\x04%\x02\0\x05\x12\x04\xac\x01!&\n\r\n\x05\x04%\x02\0\x01\x12\
+    \x04\xac\x01'+\n\r\n\x05\x04%\x02\0\x03\x12\x04\xac\x01./\n\x0c\n\x02\
+    \x04&\x12\x06\xae\x01\0\xb1\x01\x01\n\x0b\n\x03\x04&\x01\x12\x04\xae\x01\
+    \x08\x1d\nW\n\x04\x04&\x02\0\x12\x04\xb0\x01\x02\x19\x1aI\x20Optional\
+    \x20block\x20height.\x20If\x20not\x20set,\x20root\x20of\x20the\x20curren\
+    t\x20tree\x20is\x20returned\n\n\r\n\x05\x04&\x02\0\x05\x12\x04\xb0\x01\
+    \x02\x08\n\r\n\x05\x04&\x02\0\x01\x12\x04\xb0\x01\t\x14\n\r\n\x05\x04&\
+    \x02\0\x03\x12\x04\xb0\x01\x17\x18\n\x0c\n\x02\x04'\x12\x06\xb2\x01\0\
+    \xb4\x01\x01\n\x0b\n\x03\x04'\x01\x12\x04\xb2\x01\x08%\n\x0c\n\x04\x04'\
+    \x02\0\x12\x04\xb3\x01\x02\x11\n\r\n\x05\x04'\x02\0\x05\x12\x04\xb3\x01\
+    \x02\x07\n\r\n\x05\x04'\x02\0\x01\x12\x04\xb3\x01\x08\x0c\n\r\n\x05\x04'\
+    \x02\0\x03\x12\x04\xb3\x01\x0f\x10\n\x0c\n\x02\x04(\x12\x06\xb6\x01\0\
+    \xb9\x01\x01\n\x0b\n\x03\x04(\x01\x12\x04\xb6\x01\x08\x1f\nW\n\x04\x04(\
+    \x02\0\x12\x04\xb8\x01\x02\x19\x1aI\x20Optional\x20block\x20height.\x20I\
+    f\x20not\x20set,\x20root\x20of\x20the\x20current\x20tree\x20is\x20return\
+    ed\n\n\r\n\x05\x04(\x02\0\x05\x12\x04\xb8\x01\x02\x08\n\r\n\x05\x04(\x02\
+    \0\x01\x12\x04\xb8\x01\t\x14\n\r\n\x05\x04(\x02\0\x03\x12\x04\xb8\x01\
+    \x17\x18\n\x0c\n\x02\x04)\x12\x06\xba\x01\0\xbc\x01\x01\n\x0b\n\x03\x04)\
+    \x01\x12\x04\xba\x01\x08'\n\x0c\n\x04\x04)\x02\0\x12\x04\xbb\x01\x02\x11\
+    \n\r\n\x05\x04)\x02\0\x05\x12\x04\xbb\x01\x02\x07\n\r\n\x05\x04)\x02\0\
+    \x01\x12\x04\xbb\x01\x08\x0c\n\r\n\x05\x04)\x02\0\x03\x12\x04\xbb\x01\
+    \x0f\x10\n\x0c\n\x02\x04*\x12\x06\xbe\x01\0\xc9\x01\x01\n\x0b\n\x03\x04*\
+    \x01\x12\x04\xbe\x01\x08#\n\x0c\n\x04\x04*\x02\0\x12\x04\xbf\x01\x02\x18\
+    \n\r\n\x05\x04*\x02\0\x05\x12\x04\xbf\x01\x02\x07\n\r\n\x05\x04*\x02\0\
+    \x01\x12\x04\xbf\x01\x08\x13\n\r\n\x05\x04*\x02\0\x03\x12\x04\xbf\x01\
+    \x16\x17\n\x0c\n\x04\x04*\x02\x01\x12\x04\xc0\x01\x02\x1a\n\r\n\x05\x04*\
+    \x02\x01\x05\x12\x04\xc0\x01\x02\x07\n\r\n\x05\x04*\x02\x01\x01\x12\x04\
+    \xc0\x01\x08\x15\n\r\n\x05\x04*\x02\x01\x03\x12\x04\xc0\x01\x18\x19\n\
+    \x0c\n\x04\x04*\x02\x02\x12\x04\xc1\x01\x02\x19\n\r\n\x05\x04*\x02\x02\
+    \x05\x12\x04\xc1\x01\x02\x08\n\r\n\x05\x04*\x02\x02\x01\x12\x04\xc1\x01\
+    \t\x14\n\r\n\x05\x04*\x02\x02\x03\x12\x04\xc1\x01\x17\x18\n\x0c\n\x04\
+    \x04*\x02\x03\x12\x04\xc2\x01\x02\x1e\n\r\n\x05\x04*\x02\x03\x05\x12\x04\
+    \xc2\x01\x02\x08\n\r\n\x05\x04*\x02\x03\x01\x12\x04\xc2\x01\t\x19\n\r\n\
+    \x05\x04*\x02\x03\x03\x12\x04\xc2\x01\x1c\x1d\n\x0c\n\x04\x04*\x02\x04\
+    \x12\x04\xc3\x01\x02\x1f\n\r\n\x05\x04*\x02\x04\x05\x12\x04\xc3\x01\x02\
+    \x08\n\r\n\x05\x04*\x02\x04\x01\x12\x04\xc3\x01\t\x1a\n\r\n\x05\x04*\x02\
+    \x04\x03\x12\x04\xc3\x01\x1d\x1e\n\x0c\n\x04\x04*\x02\x05\x12\x04\xc4\
+    \x01\x02!\n\r\n\x05\x04*\x02\x05\x05\x12\x04\xc4\x01\x02\x08\n\r\n\x05\
+    \x04*\x02\x05\x01\x12\x04\xc4\x01\t\x1c\n\r\n\x05\x04*\x02\x05\x03\x12\
+    \x04\xc4\x01\x1f\x20\n\x0c\n\x04\x04*\x02\x06\x12\x04\xc5\x01\x02\x16\n\
+    \r\n\x05\x04*\x02\x06\x05\x12\x04\xc5\x01\x02\x07\n\r\n\x05\x04*\x02\x06\
+    \x01\x12\x04\xc5\x01\x08\x11\n\r\n\x05\x04*\x02\x06\x03\x12\x04\xc5\x01\
+    \x14\x15\n\x0c\n\x04\x04*\x02\x07\x12\x04\xc6\x01\x02\x14\n\r\n\x05\x04*\
+    \x02\x07\x05\x12\x04\xc6\x01\x02\x08\n\r\n\x05\x04*\x02\x07\x01\x12\x04\
+    \xc6\x01\t\x0f\n\r\n\x05\x04*\x02\x07\x03\x12\x04\xc6\x01\x12\x13\n\x0c\
+    \n\x04\x04*\x02\x08\x12\x04\xc7\x01\x02\"\n\r\n\x05\x04*\x02\x08\x05\x12\
+    \x04\xc7\x01\x02\x08\n\r\n\x05\x04*\x02\x08\x01\x12\x04\xc7\x01\t\x1d\n\
+    \r\n\x05\x04*\x02\x08\x03\x12\x04\xc7\x01\x20!\n\x0c\n\x04\x04*\x02\t\
+    \x12\x04\xc8\x01\x02\x16\n\r\n\x05\x04*\x02\t\x05\x12\x04\xc8\x01\x02\
+    \x08\n\r\n\x05\x04*\x02\t\x01\x12\x04\xc8\x01\t\x10\n\r\n\x05\x04*\x02\t\
+    \x03\x12\x04\xc8\x01\x13\x15\n\x0c\n\x02\x04+\x12\x06\xca\x01\0\xcc\x01\
+    \x01\n\x0b\n\x03\x04+\x01\x12\x04\xca\x01\x08+\n\x0c\n\x04\x04+\x02\0\
+    \x12\x04\xcb\x01\x02\x1b\n\r\n\x05\x04+\x02\0\x05\x12\x04\xcb\x01\x02\
+    \x07\n\r\n\x05\x04+\x02\0\x01\x12\x04\xcb\x01\x08\x16\n\r\n\x05\x04+\x02\
+    \0\x03\x12\x04\xcb\x01\x19\x1a\n\x0c\n\x02\x04,\x12\x06\xce\x01\0\xda\
+    \x01\x01\n\x0b\n\x03\x04,\x01\x12\x04\xce\x01\x08%\n\x0c\n\x04\x04,\x02\
+    \0\x12\x04\xcf\x01\x02\x18\n\r\n\x05\x04,\x02\0\x05\x12\x04\xcf\x01\x02\
+    \x07\n\r\n\x05\x04,\x02\0\x01\x12\x04\xcf\x01\x08\x13\n\r\n\x05\x04,\x02\
+    \0\x03\x12\x04\xcf\x01\x16\x17\n\x0c\n\x04\x04,\x02\x01\x12\x04\xd0\x01\
+    \x02\x1a\n\r\n\x05\x04,\x02\x01\x05\x12\x04\xd0\x01\x02\x07\n\r\n\x05\
+    \x04,\x02\x01\x01\x12\x04\xd0\x01\x08\x15\n\r\n\x05\x04,\x02\x01\x03\x12\
+    \x04\xd0\x01\x18\x19\n\x0c\n\x04\x04,\x02\x02\x12\x04\xd1\x01\x02\x19\n\
+    \r\n\x05\x04,\x02\x02\x05\x12\x04\xd1\x01\x02\x08\n\r\n\x05\x04,\x02\x02\
+    \x01\x12\x04\xd1\x01\t\x14\n\r\n\x05\x04,\x02\x02\x03\x12\x04\xd1\x01\
+    \x17\x18\n\x0c\n\x04\x04,\x02\x03\x12\x04\xd2\x01\x02\x1e\n\r\n\x05\x04,\
+    \x02\x03\x05\x12\x04\xd2\x01\x02\x08\n\r\n\x05\x04,\x02\x03\x01\x12\x04\
+    \xd2\x01\t\x19\n\r\n\x05\x04,\x02\x03\x03\x12\x04\xd2\x01\x1c\x1d\n\x0c\
+    \n\x04\x04,\x02\x04\x12\x04\xd3\x01\x02\x1f\n\r\n\x05\x04,\x02\x04\x05\
+    \x12\x04\xd3\x01\x02\x08\n\r\n\x05\x04,\x02\x04\x01\x12\x04\xd3\x01\t\
+    \x1a\n\r\n\x05\x04,\x02\x04\x03\x12\x04\xd3\x01\x1d\x1e\n\x0c\n\x04\x04,\
+    \x02\x05\x12\x04\xd4\x01\x02!\n\r\n\x05\x04,\x02\x05\x05\x12\x04\xd4\x01\
+    \x02\x08\n\r\n\x05\x04,\x02\x05\x01\x12\x04\xd4\x01\t\x1c\n\r\n\x05\x04,\
+    \x02\x05\x03\x12\x04\xd4\x01\x1f\x20\n\x0c\n\x04\x04,\x02\x06\x12\x04\
+    \xd5\x01\x02\x16\n\r\n\x05\x04,\x02\x06\x05\x12\x04\xd5\x01\x02\x07\n\r\
+    \n\x05\x04,\x02\x06\x01\x12\x04\xd5\x01\x08\x11\n\r\n\x05\x04,\x02\x06\
+    \x03\x12\x04\xd5\x01\x14\x15\n\x0c\n\x04\x04,\x02\x07\x12\x04\xd6\x01\
+    \x02\x14\n\r\n\x05\x04,\x02\x07\x05\x12\x04\xd6\x01\x02\x08\n\r\n\x05\
+    \x04,\x02\x07\x01\x12\x04\xd6\x01\t\x0f\n\r\n\x05\x04,\x02\x07\x03\x12\
+    \x04\xd6\x01\x12\x13\n\x0c\n\x04\x04,\x02\x08\x12\x04\xd7\x01\x02\"\n\r\
+    \n\x05\x04,\x02\x08\x05\x12\x04\xd7\x01\x02\x08\n\r\n\x05\x04,\x02\x08\
+    \x01\x12\x04\xd7\x01\t\x1d\n\r\n\x05\x04,\x02\x08\x03\x12\x04\xd7\x01\
+    \x20!\n\x0c\n\x04\x04,\x02\t\x12\x04\xd8\x01\x02\x16\n\r\n\x05\x04,\x02\
+    \t\x05\x12\x04\xd8\x01\x02\x08\n\r\n\x05\x04,\x02\t\x01\x12\x04\xd8\x01\
+    \t\x10\n\r\n\x05\x04,\x02\t\x03\x12\x04\xd8\x01\x13\x15\n\x0c\n\x04\x04,\
+    \x02\n\x12\x04\xd9\x01\x02\x1b\n\r\n\x05\x04,\x02\n\x05\x12\x04\xd9\x01\
+    \x02\x07\n\r\n\x05\x04,\x02\n\x01\x12\x04\xd9\x01\x08\x15\n\r\n\x05\x04,\
+    \x02\n\x03\x12\x04\xd9\x01\x18\x1a\n\x0c\n\x02\x04-\x12\x06\xdb\x01\0\
+    \xdd\x01\x01\n\x0b\n\x03\x04-\x01\x12\x04\xdb\x01\x08-\n\x0c\n\x04\x04-\
+    \x02\0\x12\x04\xdc\x01\x02\x1b\n\r\n\x05\x04-\x02\0\x05\x12\x04\xdc\x01\
+    \x02\x07\n\r\n\x05\x04-\x02\0\x01\x12\x04\xdc\x01\x08\x16\n\r\n\x05\x04-\
+    \x02\0\x03\x12\x04\xdc\x01\x19\x1a\n\x0c\n\x02\x04.\x12\x06\xdf\x01\0\
+    \xe2\x01\x01\n\x0b\n\x03\x04.\x01\x12\x04\xdf\x01\x08\x1f\n\x0c\n\x04\
+    \x04.\x02\0\x12\x04\xe0\x01\x02\x1b\n\r\n\x05\x04.\x02\0\x05\x12\x04\xe0\
+    \x01\x02\x07\n\r\n\x05\x04.\x02\0\x01\x12\x04\xe0\x01\x08\x16\n\r\n\x05\
+    \x04.\x02\0\x03\x12\x04\xe0\x01\x19\x1a\n\x0c\n\x04\x04.\x02\x01\x12\x04\
+    \xe1\x01\x02\x13\n\r\n\x05\x04.\x02\x01\x05\x12\x04\xe1\x01\x02\x07\n\r\
+    \n\x05\x04.\x02\x01\x01\x12\x04\xe1\x01\x08\x0e\n\r\n\x05\x04.\x02\x01\
+    \x03\x12\x04\xe1\x01\x11\x12\n\n\n\x02\x04/\x12\x04\xe3\x01\0*\n\x0b\n\
+    \x03\x04/\x01\x12\x04\xe3\x01\x08'\n\x0c\n\x02\x040\x12\x06\xe5\x01\0\
+    \xea\x01\x01\n\x0b\n\x03\x040\x01\x12\x04\xe5\x01\x08\x1c\n\x0c\n\x04\
+    \x040\x02\0\x12\x04\xe6\x01\x02\x18\n\r\n\x05\x040\x02\0\x05\x12\x04\xe6\
+    \x01\x02\x07\n\r\n\x05\x040\x02\0\x01\x12\x04\xe6\x01\x08\x13\n\r\n\x05\
+    \x040\x02\0\x03\x12\x04\xe6\x01\x16\x17\n\x0c\n\x04\x040\x02\x01\x12\x04\
+    \xe7\x01\x02\x1e\n\r\n\x05\x040\x02\x01\x05\x12\x04\xe7\x01\x02\x08\n\r\
+    \n\x05\x040\x02\x01\x01\x12\x04\xe7\x01\t\x19\n\r\n\x05\x040\x02\x01\x03\
+    \x12\x04\xe7\x01\x1c\x1d\n\x0c\n\x04\x040\x02\x02\x12\x04\xe8\x01\x02!\n\
+    \r\n\x05\x040\x02\x02\x05\x12\x04\xe8\x01\x02\x08\n\r\n\x05\x040\x02\x02\
+    \x01\x12\x04\xe8\x01\t\x1c\n\r\n\x05\x040\x02\x02\x03\x12\x04\xe8\x01\
+    \x1f\x20\n\x0c\n\x04\x040\x02\x03\x12\x04\xe9\x01\x02$\n\r\n\x05\x040\
+    \x02\x03\x04\x12\x04\xe9\x01\x02\n\n\r\n\x05\x040\x02\x03\x05\x12\x04\
+    \xe9\x01\x0b\x10\n\r\n\x05\x040\x02\x03\x01\x12\x04\xe9\x01\x11\x1f\n\r\
+    \n\x05\x040\x02\x03\x03\x12\x04\xe9\x01\"#\n\x0c\n\x02\x041\x12\x06\xeb\
+    \x01\0\xed\x01\x01\n\x0b\n\x03\x041\x01\x12\x04\xeb\x01\x08$\n\x0c\n\x04\
+    \x041\x02\0\x12\x04\xec\x01\x02\x1b\n\r\n\x05\x041\x02\0\x05\x12\x04\xec\
+    \x01\x02\x06\n\r\n\x05\x041\x02\0\x01\x12\x04\xec\x01\x07\x16\n\r\n\x05\
+    \x041\x02\0\x03\x12\x04\xec\x01\x19\x1a\n\x0c\n\x02\x042\x12\x06\xef\x01\
+    \0\xf2\x01\x01\n\x0b\n\x03\x042\x01\x12\x04\xef\x01\x08\x20\n\x0c\n\x04\
+    \x042\x02\0\x12\x04\xf0\x01\x02\x18\n\r\n\x05\x042\x02\0\x05\x12\x04\xf0\
+    \x01\x02\x07\n\r\n\x05\x042\x02\0\x01\x12\x04\xf0\x01\x08\x13\n\r\n\x05\
+    \x042\x02\0\x03\x12\x04\xf0\x01\x16\x17\n\x0c\n\x04\x042\x02\x01\x12\x04\
+    \xf1\x01\x02\x1a\n\r\n\x05\x042\x02\x01\x05\x12\x04\xf1\x01\x02\x07\n\r\
+    \n\x05\x042\x02\x01\x01\x12\x04\xf1\x01\x08\x15\n\r\n\x05\x042\x02\x01\
+    \x03\x12\x04\xf1\x01\x18\x19\n\xf1\x01\n\x02\x043\x12\x06\xf6\x01\0\x8b\
+    \x02\x01\x1a\xe2\x01\x20VerificationDetails\x20must\x20have\x20same\x20m\
+    embers\x20with\x20VerificationDetails\x20in\x20\"sgxvm/proto/ffi.proto\"\
+    \n\x20including\x20verification\x20type\x20and\x20verification\x20id\x20\
+    as\x20key.\n\x20But\x20the\x20member\x20types\x20can\x20be\x20different,\
+    \x20such\x20as\x20string(address)\x20to\x20bytes\n\n\x0b\n\x03\x043\x01\
+    \x12\x04\xf6\x01\x08\x1b\n!\n\x04\x043\x02\0\x12\x04\xf8\x01\x02\x1e\x1a\
+    \x13\x20Verification\x20type\n\n\r\n\x05\x043\x02\0\x05\x12\x04\xf8\x01\
+    \x02\x08\n\r\n\x05\x043\x02\0\x01\x12\x04\xf8\x01\t\x19\n\r\n\x05\x043\
+    \x02\0\x03\x12\x04\xf8\x01\x1c\x1d\n\x1f\n\x04\x043\x02\x01\x12\x04\xfa\
+    \x01\x02\x1b\x1a\x11\x20Verification\x20Id\n\n\r\n\x05\x043\x02\x01\x05\
+    \x12\x04\xfa\x01\x02\x07\n\r\n\x05\x043\x02\x01\x01\x12\x04\xfa\x01\x08\
+    \x16\n\r\n\x05\x043\x02\x01\x03\x12\x04\xfa\x01\x19\x1a\n+\n\x04\x043\
+    \x02\x02\x12\x04\xfc\x01\x02\x1a\x1a\x1d\x20Verification\x20issuer\x20ad\
+    dress\n\n\r\n\x05\x043\x02\x02\x05\x12\x04\xfc\x01\x02\x07\n\r\n\x05\x04\
+    3\x02\x02\x01\x12\x04\xfc\x01\x08\x15\n\r\n\x05\x043\x02\x02\x03\x12\x04\
+    \xfc\x01\x18\x19\n6\n\x04\x043\x02\x03\x12\x04\xfe\x01\x02\x19\x1a(\x20F\
+    rom\x20which\x20chain\x20proof\x20was\x20transferred\n\n\r\n\x05\x043\
+    \x02\x03\x05\x12\x04\xfe\x01\x02\x08\n\r\n\x05\x043\x02\x03\x01\x12\x04\
+    \xfe\x01\t\x14\n\r\n\x05\x043\x02\x03\x03\x12\x04\xfe\x01\x17\x18\n+\n\
+    \x04\x043\x02\x04\x12\x04\x80\x02\x02\x1f\x1a\x1d\x20Original\x20issuanc\
+    e\x20timestamp\n\n\r\n\x05\x043\x02\x04\x05\x12\x04\x80\x02\x02\x08\n\r\
+    \n\x05\x043\x02\x04\x01\x12\x04\x80\x02\t\x1a\n\r\n\x05\x043\x02\x04\x03\
+    \x12\x04\x80\x02\x1d\x1e\n-\n\x04\x043\x02\x05\x12\x04\x82\x02\x02!\x1a\
+    \x1f\x20Original\x20expiration\x20timestamp\n\n\r\n\x05\x043\x02\x05\x05\
+    \x12\x04\x82\x02\x02\x08\n\r\n\x05\x043\x02\x05\x01\x12\x04\x82\x02\t\
+    \x1c\n\r\n\x05\x043\x02\x05\x03\x12\x04\x82\x02\x1f\x20\n.\n\x04\x043\
+    \x02\x06\x12\x04\x84\x02\x02\x19\x1a\x20\x20Original\x20proof\x20data\
+    \x20(ZK-proof)\n\n\r\n\x05\x043\x02\x06\x05\x12\x04\x84\x02\x02\x07\n\r\
+    \n\x05\x043\x02\x06\x01\x12\x04\x84\x02\x08\x14\n\r\n\x05\x043\x02\x06\
+    \x03\x12\x04\x84\x02\x17\x18\n(\n\x04\x043\x02\x07\x12\x04\x86\x02\x02\
+    \x14\x1a\x1a\x20ZK-proof\x20original\x20schema\n\n\r\n\x05\x043\x02\x07\
+    \x05\x12\x04\x86\x02\x02\x08\n\r\n\x05\x043\x02\x07\x01\x12\x04\x86\x02\
+    \t\x0f\n\r\n\x05\x043\x02\x07\x03\x12\x04\x86\x02\x12\x13\nN\n\x04\x043\
+    \x02\x08\x12\x04\x88\x02\x02\"\x1a@\x20Verification\x20id\x20for\x20chec\
+    king(KYC/KYB/AML\x20etc)\x20from\x20issuer\x20side\n\n\r\n\x05\x043\x02\
+    \x08\x05\x12\x04\x88\x02\x02\x08\n\r\n\x05\x043\x02\x08\x01\x12\x04\x88\
+    \x02\t\x1d\n\r\n\x05\x043\x02\x08\x03\x12\x04\x88\x02\x20!\n\x17\n\x04\
+    \x043\x02\t\x12\x04\x8a\x02\x02\x16\x1a\t\x20Version\n\n\r\n\x05\x043\
+    \x02\t\x05\x12\x04\x8a\x02\x02\x08\n\r\n\x05\x043\x02\t\x01\x12\x04\x8a\
+    \x02\t\x10\n\r\n\x05\x043\x02\t\x03\x12\x04\x8a\x02\x13\x15\n\x0c\n\x02\
+    \x044\x12\x06\x8c\x02\0\x8e\x02\x01\n\x0b\n\x03\x044\x01\x12\x04\x8c\x02\
+    \x08(\n\x0c\n\x04\x044\x02\0\x12\x04\x8d\x02\x02(\n\r\n\x05\x044\x02\0\
+    \x04\x12\x04\x8d\x02\x02\n\n\r\n\x05\x044\x02\0\x06\x12\x04\x8d\x02\x0b\
+    \x1e\n\r\n\x05\x044\x02\0\x01\x12\x04\x8d\x02\x1f#\n\r\n\x05\x044\x02\0\
+    \x03\x12\x04\x8d\x02&'\n\x0c\n\x02\x045\x12\x06\x90\x02\0\x94\x02\x01\n\
+    \x0b\n\x03\x045\x01\x12\x04\x90\x02\x08\x1e\n\x0c\n\x04\x045\x02\0\x12\
+    \x04\x91\x02\x02\x1b\n\r\n\x05\x045\x02\0\x05\x12\x04\x91\x02\x02\x07\n\
+    \r\n\x05\x045\x02\0\x01\x12\x04\x91\x02\x08\x16\n\r\n\x05\x045\x02\0\x03\
+    \x12\x04\x91\x02\x19\x1a\n\x0c\n\x04\x045\x02\x01\x12\x04\x92\x02\x02\
+    \x1c\n\r\n\x05\x045\x02\x01\x05\x12\x04\x92\x02\x02\x07\n\r\n\x05\x045\
+    \x02\x01\x01\x12\x04\x92\x02\x08\x17\n\r\n\x05\x045\x02\x01\x03\x12\x04\
+    \x92\x02\x1a\x1b\n\x0c\n\x04\x045\x02\x02\x12\x04\x93\x02\x02\x13\n\r\n\
+    \x05\x045\x02\x02\x05\x12\x04\x93\x02\x02\x07\n\r\n\x05\x045\x02\x02\x01\
+    \x12\x04\x93\x02\x08\x0e\n\r\n\x05\x045\x02\x02\x03\x12\x04\x93\x02\x11\
+    \x12\n\n\n\x02\x046\x12\x04\x95\x02\0)\n\x0b\n\x03\x046\x01\x12\x04\x95\
+    \x02\x08&\n\x0c\n\x02\x047\x12\x06\x97\x02\0\xb0\x02\x01\n\x0b\n\x03\x04\
+    7\x01\x12\x04\x97\x02\x08\x15\n\x0e\n\x04\x047\x08\0\x12\x06\x98\x02\x02\
+    \xaf\x02\x03\n\r\n\x05\x047\x08\0\x01\x12\x04\x98\x02\x08\x0b\n\x0c\n\
+    \x04\x047\x02\0\x12\x04\x99\x02\x04#\n\r\n\x05\x047\x02\0\x06\x12\x04\
+    \x99\x02\x04\x13\n\r\n\x05\x047\x02\0\x01\x12\x04\x99\x02\x14\x1e\n\r\n\
+    \x05\x047\x02\0\x03\x12\x04\x99\x02!\"\n\x0c\n\x04\x047\x02\x01\x12\x04\
+    \x9a\x02\x04%\n\r\n\x05\x047\x02\x01\x06\x12\x04\x9a\x02\x04\x14\n\r\n\
+    \x05\x047\x02\x01\x01\x12\x04\x9a\x02\x15\x20\n\r\n\x05\x047\x02\x01\x03\
+    \x12\x04\x9a\x02#$\n\x0c\n\x04\x047\x02\x02\x12\x04\x9b\x02\x04(\n\r\n\
+    \x05\x047\x02\x02\x06\x12\x04\x9b\x02\x04\x17\n\r\n\x05\x047\x02\x02\x01\
+    \x12\x04\x9b\x02\x18#\n\r\n\x05\x047\x02\x02\x03\x12\x04\x9b\x02&'\n\x0c\
+    \n\x04\x047\x02\x03\x12\x04\x9c\x02\x04)\n\r\n\x05\x047\x02\x03\x06\x12\
+    \x04\x9c\x02\x04\x1b\n\r\n\x05\x047\x02\x03\x01\x12\x04\x9c\x02\x1c$\n\r\
+    \n\x05\x047\x02\x03\x03\x12\x04\x9c\x02'(\n\x0c\n\x04\x047\x02\x04\x12\
+    \x04\x9d\x02\x04)\n\r\n\x05\x047\x02\x04\x06\x12\x04\x9d\x02\x04\x1b\n\r\
+    \n\x05\x047\x02\x04\x01\x12\x04\x9d\x02\x1c$\n\r\n\x05\x047\x02\x04\x03\
+    \x12\x04\x9d\x02'(\n\x0c\n\x04\x047\x02\x05\x12\x04\x9e\x02\x04/\n\r\n\
+    \x05\x047\x02\x05\x06\x12\x04\x9e\x02\x04\x1e\n\r\n\x05\x047\x02\x05\x01\
+    \x12\x04\x9e\x02\x1f*\n\r\n\x05\x047\x02\x05\x03\x12\x04\x9e\x02-.\n\x0c\
+    \n\x04\x047\x02\x06\x12\x04\x9f\x02\x041\n\r\n\x05\x047\x02\x06\x06\x12\
+    \x04\x9f\x02\x04\x1a\n\r\n\x05\x047\x02\x06\x01\x12\x04\x9f\x02\x1b,\n\r\
+    \n\x05\x047\x02\x06\x03\x12\x04\x9f\x02/0\n\x0c\n\x04\x047\x02\x07\x12\
+    \x04\xa0\x02\x041\n\r\n\x05\x047\x02\x07\x06\x12\x04\xa0\x02\x04\x1a\n\r\
+    \n\x05\x047\x02\x07\x01\x12\x04\xa0\x02\x1b,\n\r\n\x05\x047\x02\x07\x03\
+    \x12\x04\xa0\x02/0\n\x0c\n\x04\x047\x02\x08\x12\x04\xa1\x02\x04\x1b\n\r\
+    \n\x05\x047\x02\x08\x06\x12\x04\xa1\x02\x04\x0f\n\r\n\x05\x047\x02\x08\
+    \x01\x12\x04\xa1\x02\x10\x16\n\r\n\x05\x047\x02\x08\x03\x12\x04\xa1\x02\
+    \x19\x1a\n\x0c\n\x04\x047\x02\t\x12\x04\xa2\x02\x042\n\r\n\x05\x047\x02\
+    \t\x06\x12\x04\xa2\x02\x04\x1a\n\r\n\x05\x047\x02\t\x01\x12\x04\xa2\x02\
+    \x1b,\n\r\n\x05\x047\x02\t\x03\x12\x04\xa2\x02/1\n\x0c\n\x04\x047\x02\n\
+    \x12\x04\xa3\x02\x04*\n\r\n\x05\x047\x02\n\x06\x12\x04\xa3\x02\x04\x16\n\
+    \r\n\x05\x047\x02\n\x01\x12\x04\xa3\x02\x17$\n\r\n\x05\x047\x02\n\x03\
+    \x12\x04\xa3\x02')\n\x0c\n\x04\x047\x02\x0b\x12\x04\xa4\x02\x04\"\n\r\n\
+    \x05\x047\x02\x0b\x06\x12\x04\xa4\x02\x04\x12\n\r\n\x05\x047\x02\x0b\x01\
+    \x12\x04\xa4\x02\x13\x1c\n\r\n\x05\x047\x02\x0b\x03\x12\x04\xa4\x02\x1f!\
+    \n\x0c\n\x04\x047\x02\x0c\x12\x04\xa5\x02\x04<\n\r\n\x05\x047\x02\x0c\
+    \x06\x12\x04\xa5\x02\x04\x1f\n\r\n\x05\x047\x02\x0c\x01\x12\x04\xa5\x02\
+    \x206\n\r\n\x05\x047\x02\x0c\x03\x12\x04\xa5\x029;\n\x0c\n\x04\x047\x02\
+    \r\x12\x04\xa6\x02\x04.\n\r\n\x05\x047\x02\r\x06\x12\x04\xa6\x02\x04\x18\
+    \n\r\n\x05\x047\x02\r\x01\x12\x04\xa6\x02\x19(\n\r\n\x05\x047\x02\r\x03\
+    \x12\x04\xa6\x02+-\n\x0c\n\x04\x047\x02\x0e\x12\x04\xa7\x02\x046\n\r\n\
+    \x05\x047\x02\x0e\x06\x12\x04\xa7\x02\x04\x1c\n\r\n\x05\x047\x02\x0e\x01\
+    \x12\x04\xa7\x02\x1d0\n\r\n\x05\x047\x02\x0e\x03\x12\x04\xa7\x0235\n\x0c\
+    \n\x04\x047\x02\x0f\x12\x04\xa8\x02\x048\n\r\n\x05\x047\x02\x0f\x06\x12\
+    \x04\xa8\x02\x04\x1d\n\r\n\x05\x047\x02\x0f\x01\x12\x04\xa8\x02\x1e2\n\r\
+    \n\x05\x047\x02\x0f\x03\x12\x04\xa8\x0257\n\x0c\n\x04\x047\x02\x10\x12\
+    \x04\xa9\x02\x044\n\r\n\x05\x047\x02\x10\x06\x12\x04\xa9\x02\x04\x1b\n\r\
+    \n\x05\x047\x02\x10\x01\x12\x04\xa9\x02\x1c.\n\r\n\x05\x047\x02\x10\x03\
+    \x12\x04\xa9\x0213\n\x0c\n\x04\x047\x02\x11\x12\x04\xaa\x02\x040\n\r\n\
+    \x05\x047\x02\x11\x06\x12\x04\xaa\x02\x04\x19\n\r\n\x05\x047\x02\x11\x01\
+    \x12\x04\xaa\x02\x1a*\n\r\n\x05\x047\x02\x11\x03\x12\x04\xaa\x02-/\n\x0c\
+    \n\x04\x047\x02\x12\x12\x04\xab\x02\x044\n\r\n\x05\x047\x02\x12\x06\x12\
+    \x04\xab\x02\x04\x1b\n\r\n\x05\x047\x02\x12\x01\x12\x04\xab\x02\x1c.\n\r\
+    \n\x05\x047\x02\x12\x03\x12\x04\xab\x0213\n\x0c\n\x04\x047\x02\x13\x12\
+    \x04\xac\x02\x04@\n\r\n\x05\x047\x02\x13\x06\x12\x04\xac\x02\x04!\n\r\n\
+    \x05\x047\x02\x13\x01\x12\x04\xac\x02\":\n\r\n\x05\x047\x02\x13\x03\x12\
+    \x04\xac\x02=?\n\x0c\n\x04\x047\x02\x14\x12\x04\xad\x02\x044\n\r\n\x05\
+    \x047\x02\x14\x06\x12\x04\xad\x02\x04\x1b\n\r\n\x05\x047\x02\x14\x01\x12\
+    \x04\xad\x02\x1c.\n\r\n\x05\x047\x02\x14\x03\x12\x04\xad\x0213\n\x0c\n\
+    \x04\x047\x02\x15\x12\x04\xae\x02\x042\n\r\n\x05\x047\x02\x15\x06\x12\
+    \x04\xae\x02\x04\x1a\n\r\n\x05\x047\x02\x15\x01\x12\x04\xae\x02\x1b,\n\r\
+    \n\x05\x047\x02\x15\x03\x12\x04\xae\x02/1\nF\n\x02\x048\x12\x06\xb3\x02\
+    \0\xc4\x02\x01\x1a8\x20Message\x20with\x20data\x20required\x20to\x20exec\
+    ute\x20`call`\x20operation\n\n\x0b\n\x03\x048\x01\x12\x04\xb3\x02\x08\
+    \x17\n\x0c\n\x04\x048\x02\0\x12\x04\xb4\x02\x02\x11\n\r\n\x05\x048\x02\0\
+    \x05\x12\x04\xb4\x02\x02\x07\n\r\n\x05\x048\x02\0\x01\x12\x04\xb4\x02\
+    \x08\x0c\n\r\n\x05\x048\x02\0\x03\x12\x04\xb4\x02\x0f\x10\n\x0c\n\x04\
+    \x048\x02\x01\x12\x04\xb5\x02\x02\x0f\n\r\n\x05\x048\x02\x01\x05\x12\x04\
+    \xb5\x02\x02\x07\n\r\n\x05\x048\x02\x01\x01\x12\x04\xb5\x02\x08\n\n\r\n\
+    \x05\x048\x02\x01\x03\x12\x04\xb5\x02\r\x0e\n\x0c\n\x04\x048\x02\x02\x12\
+    \x04\xb6\x02\x02\x11\n\r\n\x05\x048\x02\x02\x05\x12\x04\xb6\x02\x02\x07\
+    \n\r\n\x05\x048\x02\x02\x01\x12\x04\xb6\x02\x08\x0c\n\r\n\x05\x048\x02\
+    \x02\x03\x12\x04\xb6\x02\x0f\x10\n\x0c\n\x04\x048\x02\x03\x12\x04\xb7\
+    \x02\x02\x16\n\r\n\x05\x048\x02\x03\x05\x12\x04\xb7\x02\x02\x08\n\r\n\
+    \x05\x048\x02\x03\x01\x12\x04\xb7\x02\t\x11\n\r\n\x05\x048\x02\x03\x03\
+    \x12\x04\xb7\x02\x14\x15\n\x0c\n\x04\x048\x02\x04\x12\x04\xb8\x02\x02\
+    \x15\n\r\n\x05\x048\x02\x04\x05\x12\x04\xb8\x02\x02\x07\n\r\n\x05\x048\
+    \x02\x04\x01\x12\x04\xb8\x02\x08\x10\n\r\n\x05\x048\x02\x04\x03\x12\x04\
+    \xb8\x02\x13\x14\n\x0c\n\x04\x048\x02\x05\x12\x04\xb9\x02\x02\x12\n\r\n\
+    \x05\x048\x02\x05\x05\x12\x04\xb9\x02\x02\x07\n\r\n\x05\x048\x02\x05\x01\
+    \x12\x04\xb9\x02\x08\r\n\r\n\x05\x048\x02\x05\x03\x12\x04\xb9\x02\x10\
+    \x11\n\x0c\n\x04\x048\x02\x06\x12\x04\xba\x02\x02)\n\r\n\x05\x048\x02\
+    \x06\x04\x12\x04\xba\x02\x02\n\n\r\n\x05\x048\x02\x06\x06\x12\x04\xba\
+    \x02\x0b\x19\n\r\n\x05\x048\x02\x06\x01\x12\x04\xba\x02\x1a$\n\r\n\x05\
+    \x048\x02\x06\x03\x12\x04\xba\x02'(\n\x0c\n\x04\x048\x02\x07\x12\x04\xbb\
+    \x02\x02\x12\n\r\n\x05\x048\x02\x07\x05\x12\x04\xbb\x02\x02\x06\n\r\n\
+    \x05\x048\x02\x07\x01\x12\x04\xbb\x02\x07\r\n\r\n\x05\x048\x02\x07\x03\
+    \x12\x04\xbb\x02\x10\x11\n\x0c\n\x04\x048\x02\x08\x12\x04\xbc\x02\x02\
+    \x13\n\r\n\x05\x048\x02\x08\x05\x12\x04\xbc\x02\x02\x08\n\r\n\x05\x048\
+    \x02\x08\x01\x12\x04\xbc\x02\t\x0e\n\r\n\x05\x048\x02\x08\x03\x12\x04\
+    \xbc\x02\x11\x12\n\x0c\n\x04\x048\x02\t\x12\x04\xbd\x02\x02\x18\n\r\n\
+    \x05\x048\x02\t\x05\x12\x04\xbd\x02\x02\x06\n\r\n\x05\x048\x02\t\x01\x12\
+    \x04\xbd\x02\x07\x12\n\r\n\x05\x048\x02\t\x03\x12\x04\xbd\x02\x15\x17\n\
+    \x0c\n\x04\x048\x02\n\x12\x04\xbe\x02\x02\x17\n\r\n\x05\x048\x02\n\x05\
+    \x12\x04\xbe\x02\x02\x07\n\r\n\x05\x048\x02\n\x01\x12\x04\xbe\x02\x08\
+    \x11\n\r\n\x05\x048\x02\n\x03\x12\x04\xbe\x02\x14\x16\n\x0c\n\x04\x048\
+    \x02\x0b\x12\x04\xbf\x02\x02\"\n\r\n\x05\x048\x02\x0b\x05\x12\x04\xbf\
+    \x02\x02\x07\n\r\n\x05\x048\x02\x0b\x01\x12\x04\xbf\x02\x08\x1c\n\r\n\
+    \x05\x048\x02\x0b\x03\x12\x04\xbf\x02\x1f!\n\x0c\n\x04\x048\x02\x0c\x12\
+    \x04\xc0\x02\x02\x1a\n\r\n\x05\x048\x02\x0c\x05\x12\x04\xc0\x02\x02\x07\
+    \n\r\n\x05\x048\x02\x0c\x01\x12\x04\xc0\x02\x08\x14\n\r\n\x05\x048\x02\
+    \x0c\x03\x12\x04\xc0\x02\x17\x19\n\x0c\n\x04\x048\x02\r\x12\x04\xc1\x02\
+    \x02\x15\n\r\n\x05\x048\x02\r\x05\x12\x04\xc1\x02\x02\x08\n\r\n\x05\x048\
+    \x02\r\x01\x12\x04\xc1\x02\t\x0f\n\r\n\x05\x048\x02\r\x03\x12\x04\xc1\
+    \x02\x12\x14\n\x0c\n\x04\x048\x02\x0e\x12\x04\xc2\x02\x02\x1a\n\r\n\x05\
+    \x048\x02\x0e\x06\x12\x04\xc2\x02\x02\x0e\n\r\n\x05\x048\x02\x0e\x01\x12\
+    \x04\xc2\x02\x0f\x14\n\r\n\x05\x048\x02\x0e\x03\x12\x04\xc2\x02\x17\x19\
+    \n\x0c\n\x04\x048\x02\x0f\x12\x04\xc3\x02\x028\n\r\n\x05\x048\x02\x0f\
+    \x04\x12\x04\xc3\x02\x02\n\n\r\n\x05\x048\x02\x0f\x06\x12\x04\xc3\x02\
+    \x0b\x20\n\r\n\x05\x048\x02\x0f\x01\x12\x04\xc3\x02!2\n\r\n\x05\x048\x02\
+    \x0f\x03\x12\x04\xc3\x0257\nH\n\x02\x049\x12\x06\xc7\x02\0\xd5\x02\x01\
     \x1a:\x20Message\x20with\x20data\x20required\x20to\x20execute\x20`create\
-    `\x20operation\n\n\x0b\n\x03\x048\x01\x12\x04\xae\x02\x08\x19\n\x0c\n\
-    \x04\x048\x02\0\x12\x04\xaf\x02\x02\x11\n\r\n\x05\x048\x02\0\x05\x12\x04\
-    \xaf\x02\x02\x07\n\r\n\x05\x048\x02\0\x01\x12\x04\xaf\x02\x08\x0c\n\r\n\
-    \x05\x048\x02\0\x03\x12\x04\xaf\x02\x0f\x10\n\x0c\n\x04\x048\x02\x01\x12\
-    \x04\xb0\x02\x02\x11\n\r\n\x05\x048\x02\x01\x05\x12\x04\xb0\x02\x02\x07\
-    \n\r\n\x05\x048\x02\x01\x01\x12\x04\xb0\x02\x08\x0c\n\r\n\x05\x048\x02\
-    \x01\x03\x12\x04\xb0\x02\x0f\x10\n\x0c\n\x04\x048\x02\x02\x12\x04\xb1\
-    \x02\x02\x16\n\r\n\x05\x048\x02\x02\x05\x12\x04\xb1\x02\x02\x08\n\r\n\
-    \x05\x048\x02\x02\x01\x12\x04\xb1\x02\t\x11\n\r\n\x05\x048\x02\x02\x03\
-    \x12\x04\xb1\x02\x14\x15\n\x0c\n\x04\x048\x02\x03\x12\x04\xb2\x02\x02\
-    \x15\n\r\n\x05\x048\x02\x03\x05\x12\x04\xb2\x02\x02\x07\n\r\n\x05\x048\
-    \x02\x03\x01\x12\x04\xb2\x02\x08\x10\n\r\n\x05\x048\x02\x03\x03\x12\x04\
-    \xb2\x02\x13\x14\n\x0c\n\x04\x048\x02\x04\x12\x04\xb3\x02\x02\x12\n\r\n\
-    \x05\x048\x02\x04\x05\x12\x04\xb3\x02\x02\x07\n\r\n\x05\x048\x02\x04\x01\
-    \x12\x04\xb3\x02\x08\r\n\r\n\x05\x048\x02\x04\x03\x12\x04\xb3\x02\x10\
-    \x11\n\x0c\n\x04\x048\x02\x05\x12\x04\xb4\x02\x02)\n\r\n\x05\x048\x02\
-    \x05\x04\x12\x04\xb4\x02\x02\n\n\r\n\x05\x048\x02\x05\x06\x12\x04\xb4\
-    \x02\x0b\x19\n\r\n\x05\x048\x02\x05\x01\x12\x04\xb4\x02\x1a$\n\r\n\x05\
-    \x048\x02\x05\x03\x12\x04\xb4\x02'(\n\x0c\n\x04\x048\x02\x06\x12\x04\xb5\
-    \x02\x02\x12\n\r\n\x05\x048\x02\x06\x05\x12\x04\xb5\x02\x02\x06\n\r\n\
-    \x05\x048\x02\x06\x01\x12\x04\xb5\x02\x07\r\n\r\n\x05\x048\x02\x06\x03\
-    \x12\x04\xb5\x02\x10\x11\n\x0c\n\x04\x048\x02\x07\x12\x04\xb6\x02\x02\
-    \x13\n\r\n\x05\x048\x02\x07\x05\x12\x04\xb6\x02\x02\x08\n\r\n\x05\x048\
-    \x02\x07\x01\x12\x04\xb6\x02\t\x0e\n\r\n\x05\x048\x02\x07\x03\x12\x04\
-    \xb6\x02\x11\x12\n\x0c\n\x04\x048\x02\x08\x12\x04\xb7\x02\x02\x16\n\r\n\
-    \x05\x048\x02\x08\x05\x12\x04\xb7\x02\x02\x07\n\r\n\x05\x048\x02\x08\x01\
-    \x12\x04\xb7\x02\x08\x11\n\r\n\x05\x048\x02\x08\x03\x12\x04\xb7\x02\x14\
-    \x15\n\x0c\n\x04\x048\x02\t\x12\x04\xb8\x02\x02\"\n\r\n\x05\x048\x02\t\
-    \x05\x12\x04\xb8\x02\x02\x07\n\r\n\x05\x048\x02\t\x01\x12\x04\xb8\x02\
-    \x08\x1c\n\r\n\x05\x048\x02\t\x03\x12\x04\xb8\x02\x1f!\n\x0c\n\x04\x048\
-    \x02\n\x12\x04\xb9\x02\x02\x1a\n\r\n\x05\x048\x02\n\x05\x12\x04\xb9\x02\
-    \x02\x07\n\r\n\x05\x048\x02\n\x01\x12\x04\xb9\x02\x08\x14\n\r\n\x05\x048\
-    \x02\n\x03\x12\x04\xb9\x02\x17\x19\n\x0c\n\x04\x048\x02\x0b\x12\x04\xba\
-    \x02\x02\x15\n\r\n\x05\x048\x02\x0b\x05\x12\x04\xba\x02\x02\x08\n\r\n\
-    \x05\x048\x02\x0b\x01\x12\x04\xba\x02\t\x0f\n\r\n\x05\x048\x02\x0b\x03\
-    \x12\x04\xba\x02\x12\x14\nM\n\x02\x049\x12\x06\xbe\x02\0\xcd\x02\x01\x1a\
-    ?\x20Message\x20with\x20data\x20required\x20to\x20execute\x20`estimateGa\
-    s`\x20operation\n\n\x0b\n\x03\x049\x01\x12\x04\xbe\x02\x08\x1e\n\x0c\n\
-    \x04\x049\x02\0\x12\x04\xbf\x02\x02\x11\n\r\n\x05\x049\x02\0\x05\x12\x04\
-    \xbf\x02\x02\x07\n\r\n\x05\x049\x02\0\x01\x12\x04\xbf\x02\x08\x0c\n\r\n\
-    \x05\x049\x02\0\x03\x12\x04\xbf\x02\x0f\x10\n\x0c\n\x04\x049\x02\x01\x12\
-    \x04\xc0\x02\x02\x0f\n\r\n\x05\x049\x02\x01\x05\x12\x04\xc0\x02\x02\x07\
-    \n\r\n\x05\x049\x02\x01\x01\x12\x04\xc0\x02\x08\n\n\r\n\x05\x049\x02\x01\
-    \x03\x12\x04\xc0\x02\r\x0e\n\x0c\n\x04\x049\x02\x02\x12\x04\xc1\x02\x02\
-    \x11\n\r\n\x05\x049\x02\x02\x05\x12\x04\xc1\x02\x02\x07\n\r\n\x05\x049\
-    \x02\x02\x01\x12\x04\xc1\x02\x08\x0c\n\r\n\x05\x049\x02\x02\x03\x12\x04\
-    \xc1\x02\x0f\x10\n\x0c\n\x04\x049\x02\x03\x12\x04\xc2\x02\x02\x16\n\r\n\
-    \x05\x049\x02\x03\x05\x12\x04\xc2\x02\x02\x08\n\r\n\x05\x049\x02\x03\x01\
-    \x12\x04\xc2\x02\t\x11\n\r\n\x05\x049\x02\x03\x03\x12\x04\xc2\x02\x14\
-    \x15\n\x0c\n\x04\x049\x02\x04\x12\x04\xc3\x02\x02\x15\n\r\n\x05\x049\x02\
-    \x04\x05\x12\x04\xc3\x02\x02\x07\n\r\n\x05\x049\x02\x04\x01\x12\x04\xc3\
-    \x02\x08\x10\n\r\n\x05\x049\x02\x04\x03\x12\x04\xc3\x02\x13\x14\n\x0c\n\
-    \x04\x049\x02\x05\x12\x04\xc4\x02\x02\x12\n\r\n\x05\x049\x02\x05\x05\x12\
-    \x04\xc4\x02\x02\x07\n\r\n\x05\x049\x02\x05\x01\x12\x04\xc4\x02\x08\r\n\
-    \r\n\x05\x049\x02\x05\x03\x12\x04\xc4\x02\x10\x11\n\x0c\n\x04\x049\x02\
-    \x06\x12\x04\xc5\x02\x02)\n\r\n\x05\x049\x02\x06\x04\x12\x04\xc5\x02\x02\
-    \n\n\r\n\x05\x049\x02\x06\x06\x12\x04\xc5\x02\x0b\x19\n\r\n\x05\x049\x02\
-    \x06\x01\x12\x04\xc5\x02\x1a$\n\r\n\x05\x049\x02\x06\x03\x12\x04\xc5\x02\
-    '(\n\x0c\n\x04\x049\x02\x07\x12\x04\xc6\x02\x02\x12\n\r\n\x05\x049\x02\
-    \x07\x05\x12\x04\xc6\x02\x02\x06\n\r\n\x05\x049\x02\x07\x01\x12\x04\xc6\
-    \x02\x07\r\n\r\n\x05\x049\x02\x07\x03\x12\x04\xc6\x02\x10\x11\n\x0c\n\
-    \x04\x049\x02\x08\x12\x04\xc7\x02\x02\x13\n\r\n\x05\x049\x02\x08\x05\x12\
-    \x04\xc7\x02\x02\x08\n\r\n\x05\x049\x02\x08\x01\x12\x04\xc7\x02\t\x0e\n\
-    \r\n\x05\x049\x02\x08\x03\x12\x04\xc7\x02\x11\x12\n\x0c\n\x04\x049\x02\t\
-    \x12\x04\xc8\x02\x02\x18\n\r\n\x05\x049\x02\t\x05\x12\x04\xc8\x02\x02\
-    \x06\n\r\n\x05\x049\x02\t\x01\x12\x04\xc8\x02\x07\x12\n\r\n\x05\x049\x02\
-    \t\x03\x12\x04\xc8\x02\x15\x17\n\x0c\n\x04\x049\x02\n\x12\x04\xc9\x02\
-    \x02\x17\n\r\n\x05\x049\x02\n\x05\x12\x04\xc9\x02\x02\x07\n\r\n\x05\x049\
-    \x02\n\x01\x12\x04\xc9\x02\x08\x11\n\r\n\x05\x049\x02\n\x03\x12\x04\xc9\
-    \x02\x14\x16\n\x0c\n\x04\x049\x02\x0b\x12\x04\xca\x02\x02\"\n\r\n\x05\
-    \x049\x02\x0b\x05\x12\x04\xca\x02\x02\x07\n\r\n\x05\x049\x02\x0b\x01\x12\
-    \x04\xca\x02\x08\x1c\n\r\n\x05\x049\x02\x0b\x03\x12\x04\xca\x02\x1f!\n\
-    \x0c\n\x04\x049\x02\x0c\x12\x04\xcb\x02\x02\x1a\n\r\n\x05\x049\x02\x0c\
-    \x05\x12\x04\xcb\x02\x02\x07\n\r\n\x05\x049\x02\x0c\x01\x12\x04\xcb\x02\
-    \x08\x14\n\r\n\x05\x049\x02\x0c\x03\x12\x04\xcb\x02\x17\x19\n\x0c\n\x04\
-    \x049\x02\r\x12\x04\xcc\x02\x02\x15\n\r\n\x05\x049\x02\r\x05\x12\x04\xcc\
-    \x02\x02\x08\n\r\n\x05\x049\x02\r\x01\x12\x04\xcc\x02\t\x0f\n\r\n\x05\
-    \x049\x02\r\x03\x12\x04\xcc\x02\x12\x14\n3\n\x02\x04:\x12\x06\xd0\x02\0\
-    \xd3\x02\x01\x1a%\x20Request\x20to\x20execute\x20`call`\x20operation\n\n\
-    \x0b\n\x03\x04:\x01\x12\x04\xd0\x02\x08\x18\n\x0c\n\x04\x04:\x02\0\x12\
-    \x04\xd1\x02\x02\x1d\n\r\n\x05\x04:\x02\0\x06\x12\x04\xd1\x02\x02\x11\n\
-    \r\n\x05\x04:\x02\0\x01\x12\x04\xd1\x02\x12\x18\n\r\n\x05\x04:\x02\0\x03\
-    \x12\x04\xd1\x02\x1b\x1c\n\x0c\n\x04\x04:\x02\x01\x12\x04\xd2\x02\x02!\n\
-    \r\n\x05\x04:\x02\x01\x06\x12\x04\xd2\x02\x02\x14\n\r\n\x05\x04:\x02\x01\
-    \x01\x12\x04\xd2\x02\x15\x1c\n\r\n\x05\x04:\x02\x01\x03\x12\x04\xd2\x02\
-    \x1f\x20\n5\n\x02\x04;\x12\x06\xd6\x02\0\xd9\x02\x01\x1a'\x20Request\x20\
-    to\x20execute\x20`create`\x20operation\n\n\x0b\n\x03\x04;\x01\x12\x04\
-    \xd6\x02\x08\x1a\n\x0c\n\x04\x04;\x02\0\x12\x04\xd7\x02\x02\x1f\n\r\n\
-    \x05\x04;\x02\0\x06\x12\x04\xd7\x02\x02\x13\n\r\n\x05\x04;\x02\0\x01\x12\
-    \x04\xd7\x02\x14\x1a\n\r\n\x05\x04;\x02\0\x03\x12\x04\xd7\x02\x1d\x1e\n\
-    \x0c\n\x04\x04;\x02\x01\x12\x04\xd8\x02\x02!\n\r\n\x05\x04;\x02\x01\x06\
-    \x12\x04\xd8\x02\x02\x14\n\r\n\x05\x04;\x02\x01\x01\x12\x04\xd8\x02\x15\
-    \x1c\n\r\n\x05\x04;\x02\x01\x03\x12\x04\xd8\x02\x1f\x20\n:\n\x02\x04<\
-    \x12\x06\xdc\x02\0\xdf\x02\x01\x1a,\x20Request\x20to\x20execute\x20`esti\
-    mateGas`\x20operation\n\n\x0b\n\x03\x04<\x01\x12\x04\xdc\x02\x08\x1f\n\
-    \x0c\n\x04\x04<\x02\0\x12\x04\xdd\x02\x02$\n\r\n\x05\x04<\x02\0\x06\x12\
-    \x04\xdd\x02\x02\x18\n\r\n\x05\x04<\x02\0\x01\x12\x04\xdd\x02\x19\x1f\n\
-    \r\n\x05\x04<\x02\0\x03\x12\x04\xdd\x02\"#\n\x0c\n\x04\x04<\x02\x01\x12\
-    \x04\xde\x02\x02!\n\r\n\x05\x04<\x02\x01\x06\x12\x04\xde\x02\x02\x14\n\r\
-    \n\x05\x04<\x02\x01\x01\x12\x04\xde\x02\x15\x1c\n\r\n\x05\x04<\x02\x01\
-    \x03\x12\x04\xde\x02\x1f\x20\n1\n\x02\x04=\x12\x06\xe2\x02\0\xe4\x02\x01\
-    \x1a#\x20Request\x20to\x20obtain\x20node\x20public\x20key\n\n\x0b\n\x03\
-    \x04=\x01\x12\x04\xe2\x02\x08\x1c\n\x0c\n\x04\x04=\x02\0\x12\x04\xe3\x02\
-    \x02\x19\n\r\n\x05\x04=\x02\0\x05\x12\x04\xe3\x02\x02\x08\n\r\n\x05\x04=\
-    \x02\0\x01\x12\x04\xe3\x02\t\x14\n\r\n\x05\x04=\x02\0\x03\x12\x04\xe3\
-    \x02\x17\x18\n+\n\x02\x04>\x12\x04\xe7\x02\06\x1a\x1f\x20Response\x20wit\
-    h\x20node\x20public\x20key\n\n\x0b\n\x03\x04>\x01\x12\x04\xe7\x02\x08\
-    \x1d\n\x0c\n\x04\x04>\x02\0\x12\x04\xe7\x02\x204\n\r\n\x05\x04>\x02\0\
-    \x05\x12\x04\xe7\x02\x20%\n\r\n\x05\x04>\x02\0\x01\x12\x04\xe7\x02&/\n\r\
-    \n\x05\x04>\x02\0\x03\x12\x04\xe7\x0223\n\x0c\n\x02\x04?\x12\x06\xe9\x02\
-    \0\xed\x02\x01\n\x0b\n\x03\x04?\x01\x12\x04\xe9\x02\x08\x11\n\x0c\n\x04\
-    \x04?\x02\0\x12\x04\xea\x02\x02\x19\n\r\n\x05\x04?\x02\0\x05\x12\x04\xea\
-    \x02\x02\x08\n\r\n\x05\x04?\x02\0\x01\x12\x04\xea\x02\t\x14\n\r\n\x05\
-    \x04?\x02\0\x03\x12\x04\xea\x02\x17\x18\n\x0c\n\x04\x04?\x02\x01\x12\x04\
-    \xeb\x02\x02\x1b\n\r\n\x05\x04?\x02\x01\x05\x12\x04\xeb\x02\x02\x08\n\r\
-    \n\x05\x04?\x02\x01\x01\x12\x04\xeb\x02\t\x16\n\r\n\x05\x04?\x02\x01\x03\
-    \x12\x04\xeb\x02\x19\x1a\n\x0c\n\x04\x04?\x02\x02\x12\x04\xec\x02\x02\
-    \x1a\n\r\n\x05\x04?\x02\x02\x05\x12\x04\xec\x02\x02\x07\n\r\n\x05\x04?\
-    \x02\x02\x01\x12\x04\xec\x02\x08\x15\n\r\n\x05\x04?\x02\x02\x03\x12\x04\
-    \xec\x02\x18\x19\n\x0c\n\x02\x04@\x12\x06\xee\x02\0\xf0\x02\x01\n\x0b\n\
-    \x03\x04@\x01\x12\x04\xee\x02\x08\x1a\n\x0c\n\x04\x04@\x02\0\x12\x04\xef\
-    \x02\x02\x20\n\r\n\x05\x04@\x02\0\x04\x12\x04\xef\x02\x02\n\n\r\n\x05\
-    \x04@\x02\0\x06\x12\x04\xef\x02\x0b\x14\n\r\n\x05\x04@\x02\0\x01\x12\x04\
-    \xef\x02\x15\x1b\n\r\n\x05\x04@\x02\0\x03\x12\x04\xef\x02\x1e\x1f\n\x0c\
-    \n\x02\x04A\x12\x06\xf2\x02\0\xf9\x02\x01\n\x0b\n\x03\x04A\x01\x12\x04\
-    \xf2\x02\x08\x12\n\x0e\n\x04\x04A\x08\0\x12\x06\xf3\x02\x02\xf8\x02\x03\
-    \n\r\n\x05\x04A\x08\0\x01\x12\x04\xf3\x02\x08\x0b\n\x0c\n\x04\x04A\x02\0\
-    \x12\x04\xf4\x02\x04%\n\r\n\x05\x04A\x02\0\x06\x12\x04\xf4\x02\x04\x14\n\
-    \r\n\x05\x04A\x02\0\x01\x12\x04\xf4\x02\x15\x20\n\r\n\x05\x04A\x02\0\x03\
-    \x12\x04\xf4\x02#$\n\x0c\n\x04\x04A\x02\x01\x12\x04\xf5\x02\x04)\n\r\n\
-    \x05\x04A\x02\x01\x06\x12\x04\xf5\x02\x04\x16\n\r\n\x05\x04A\x02\x01\x01\
-    \x12\x04\xf5\x02\x17$\n\r\n\x05\x04A\x02\x01\x03\x12\x04\xf5\x02'(\n\x0c\
-    \n\x04\x04A\x02\x02\x12\x04\xf6\x02\x043\n\r\n\x05\x04A\x02\x02\x06\x12\
-    \x04\xf6\x02\x04\x1b\n\r\n\x05\x04A\x02\x02\x01\x12\x04\xf6\x02\x1c.\n\r\
-    \n\x05\x04A\x02\x02\x03\x12\x04\xf6\x0212\n\x0c\n\x04\x04A\x02\x03\x12\
-    \x04\xf7\x02\x04.\n\r\n\x05\x04A\x02\x03\x06\x12\x04\xf7\x02\x04\x18\n\r\
-    \n\x05\x04A\x02\x03\x01\x12\x04\xf7\x02\x19)\n\r\n\x05\x04A\x02\x03\x03\
-    \x12\x04\xf7\x02,-b\x06proto3\
+    `\x20operation\n\n\x0b\n\x03\x049\x01\x12\x04\xc7\x02\x08\x19\n\x0c\n\
+    \x04\x049\x02\0\x12\x04\xc8\x02\x02\x11\n\r\n\x05\x049\x02\0\x05\x12\x04\
+    \xc8\x02\x02\x07\n\r\n\x05\x049\x02\0\x01\x12\x04\xc8\x02\x08\x0c\n\r\n\
+    \x05\x049\x02\0\x03\x12\x04\xc8\x02\x0f\x10\n\x0c\n\x04\x049\x02\x01\x12\
+    \x04\xc9\x02\x02\x11\n\r\n\x05\x049\x02\x01\x05\x12\x04\xc9\x02\x02\x07\
+    \n\r\n\x05\x049\x02\x01\x01\x12\x04\xc9\x02\x08\x0c\n\r\n\x05\x049\x02\
+    \x01\x03\x12\x04\xc9\x02\x0f\x10\n\x0c\n\x04\x049\x02\x02\x12\x04\xca\
+    \x02\x02\x16\n\r\n\x05\x049\x02\x02\x05\x12\x04\xca\x02\x02\x08\n\r\n\
+    \x05\x049\x02\x02\x01\x12\x04\xca\x02\t\x11\n\r\n\x05\x049\x02\x02\x03\
+    \x12\x04\xca\x02\x14\x15\n\x0c\n\x04\x049\x02\x03\x12\x04\xcb\x02\x02\
+    \x15\n\r\n\x05\x049\x02\x03\x05\x12\x04\xcb\x02\x02\x07\n\r\n\x05\x049\
+    \x02\x03\x01\x12\x04\xcb\x02\x08\x10\n\r\n\x05\x049\x02\x03\x03\x12\x04\
+    \xcb\x02\x13\x14\n\x0c\n\x04\x049\x02\x04\x12\x04\xcc\x02\x02\x12\n\r\n\
+    \x05\x049\x02\x04\x05\x12\x04\xcc\x02\x02\x07\n\r\n\x05\x049\x02\x04\x01\
+    \x12\x04\xcc\x02\x08\r\n\r\n\x05\x049\x02\x04\x03\x12\x04\xcc\x02\x10\
+    \x11\n\x0c\n\x04\x049\x02\x05\x12\x04\xcd\x02\x02)\n\r\n\x05\x049\x02\
+    \x05\x04\x12\x04\xcd\x02\x02\n\n\r\n\x05\x049\x02\x05\x06\x12\x04\xcd\
+    \x02\x0b\x19\n\r\n\x05\x049\x02\x05\x01\x12\x04\xcd\x02\x1a$\n\r\n\x05\
+    \x049\x02\x05\x03\x12\x04\xcd\x02'(\n\x0c\n\x04\x049\x02\x06\x12\x04\xce\
+    \x02\x02\x12\n\r\n\x05\x049\x02\x06\x05\x12\x04\xce\x02\x02\x06\n\r\n\
+    \x05\x049\x02\x06\x01\x12\x04\xce\x02\x07\r\n\r\n\x05\x049\x02\x06\x03\
+    \x12\x04\xce\x02\x10\x11\n\x0c\n\x04\x049\x02\x07\x12\x04\xcf\x02\x02\
+    \x13\n\r\n\x05\x049\x02\x07\x05\x12\x04\xcf\x02\x02\x08\n\r\n\x05\x049\
+    \x02\x07\x01\x12\x04\xcf\x02\t\x0e\n\r\n\x05\x049\x02\x07\x03\x12\x04\
+    \xcf\x02\x11\x12\n\x0c\n\x04\x049\x02\x08\x12\x04\xd0\x02\x02\x16\n\r\n\
+    \x05\x049\x02\x08\x05\x12\x04\xd0\x02\x02\x07\n\r\n\x05\x049\x02\x08\x01\
+    \x12\x04\xd0\x02\x08\x11\n\r\n\x05\x049\x02\x08\x03\x12\x04\xd0\x02\x14\
+    \x15\n\x0c\n\x04\x049\x02\t\x12\x04\xd1\x02\x02\"\n\r\n\x05\x049\x02\t\
+    \x05\x12\x04\xd1\x02\x02\x07\n\r\n\x05\x049\x02\t\x01\x12\x04\xd1\x02\
+    \x08\x1c\n\r\n\x05\x049\x02\t\x03\x12\x04\xd1\x02\x1f!\n\x0c\n\x04\x049\
+    \x02\n\x12\x04\xd2\x02\x02\x1a\n\r\n\x05\x049\x02\n\x05\x12\x04\xd2\x02\
+    \x02\x07\n\r\n\x05\x049\x02\n\x01\x12\x04\xd2\x02\x08\x14\n\r\n\x05\x049\
+    \x02\n\x03\x12\x04\xd2\x02\x17\x19\n\x0c\n\x04\x049\x02\x0b\x12\x04\xd3\
+    \x02\x02\x15\n\r\n\x05\x049\x02\x0b\x05\x12\x04\xd3\x02\x02\x08\n\r\n\
+    \x05\x049\x02\x0b\x01\x12\x04\xd3\x02\t\x0f\n\r\n\x05\x049\x02\x0b\x03\
+    \x12\x04\xd3\x02\x12\x14\n\x0c\n\x04\x049\x02\x0c\x12\x04\xd4\x02\x02\
+    \x1a\n\r\n\x05\x049\x02\x0c\x06\x12\x04\xd4\x02\x02\x0e\n\r\n\x05\x049\
+    \x02\x0c\x01\x12\x04\xd4\x02\x0f\x14\n\r\n\x05\x049\x02\x0c\x03\x12\x04\
+    \xd4\x02\x17\x19\nM\n\x02\x04:\x12\x06\xd8\x02\0\xe7\x02\x01\x1a?\x20Mes\
+    sage\x20with\x20data\x20required\x20to\x20execute\x20`estimateGas`\x20op\
+    eration\n\n\x0b\n\x03\x04:\x01\x12\x04\xd8\x02\x08\x1e\n\x0c\n\x04\x04:\
+    \x02\0\x12\x04\xd9\x02\x02\x11\n\r\n\x05\x04:\x02\0\x05\x12\x04\xd9\x02\
+    \x02\x07\n\r\n\x05\x04:\x02\0\x01\x12\x04\xd9\x02\x08\x0c\n\r\n\x05\x04:\
+    \x02\0\x03\x12\x04\xd9\x02\x0f\x10\n\x0c\n\x04\x04:\x02\x01\x12\x04\xda\
+    \x02\x02\x0f\n\r\n\x05\x04:\x02\x01\x05\x12\x04\xda\x02\x02\x07\n\r\n\
+    \x05\x04:\x02\x01\x01\x12\x04\xda\x02\x08\n\n\r\n\x05\x04:\x02\x01\x03\
+    \x12\x04\xda\x02\r\x0e\n\x0c\n\x04\x04:\x02\x02\x12\x04\xdb\x02\x02\x11\
+    \n\r\n\x05\x04:\x02\x02\x05\x12\x04\xdb\x02\x02\x07\n\r\n\x05\x04:\x02\
+    \x02\x01\x12\x04\xdb\x02\x08\x0c\n\r\n\x05\x04:\x02\x02\x03\x12\x04\xdb\
+    \x02\x0f\x10\n\x0c\n\x04\x04:\x02\x03\x12\x04\xdc\x02\x02\x16\n\r\n\x05\
+    \x04:\x02\x03\x05\x12\x04\xdc\x02\x02\x08\n\r\n\x05\x04:\x02\x03\x01\x12\
+    \x04\xdc\x02\t\x11\n\r\n\x05\x04:\x02\x03\x03\x12\x04\xdc\x02\x14\x15\n\
+    \x0c\n\x04\x04:\x02\x04\x12\x04\xdd\x02\x02\x15\n\r\n\x05\x04:\x02\x04\
+    \x05\x12\x04\xdd\x02\x02\x07\n\r\n\x05\x04:\x02\x04\x01\x12\x04\xdd\x02\
+    \x08\x10\n\r\n\x05\x04:\x02\x04\x03\x12\x04\xdd\x02\x13\x14\n\x0c\n\x04\
+    \x04:\x02\x05\x12\x04\xde\x02\x02\x12\n\r\n\x05\x04:\x02\x05\x05\x12\x04\
+    \xde\x02\x02\x07\n\r\n\x05\x04:\x02\x05\x01\x12\x04\xde\x02\x08\r\n\r\n\
+    \x05\x04:\x02\x05\x03\x12\x04\xde\x02\x10\x11\n\x0c\n\x04\x04:\x02\x06\
+    \x12\x04\xdf\x02\x02)\n\r\n\x05\x04:\x02\x06\x04\x12\x04\xdf\x02\x02\n\n\
+    \r\n\x05\x04:\x02\x06\x06\x12\x04\xdf\x02\x0b\x19\n\r\n\x05\x04:\x02\x06\
+    \x01\x12\x04\xdf\x02\x1a$\n\r\n\x05\x04:\x02\x06\x03\x12\x04\xdf\x02'(\n\
+    \x0c\n\x04\x04:\x02\x07\x12\x04\xe0\x02\x02\x12\n\r\n\x05\x04:\x02\x07\
+    \x05\x12\x04\xe0\x02\x02\x06\n\r\n\x05\x04:\x02\x07\x01\x12\x04\xe0\x02\
+    \x07\r\n\r\n\x05\x04:\x02\x07\x03\x12\x04\xe0\x02\x10\x11\n\x0c\n\x04\
+    \x04:\x02\x08\x12\x04\xe1\x02\x02\x13\n\r\n\x05\x04:\x02\x08\x05\x12\x04\
+    \xe1\x02\x02\x08\n\r\n\x05\x04:\x02\x08\x01\x12\x04\xe1\x02\t\x0e\n\r\n\
+    \x05\x04:\x02\x08\x03\x12\x04\xe1\x02\x11\x12\n\x0c\n\x04\x04:\x02\t\x12\
+    \x04\xe2\x02\x02\x18\n\r\n\x05\x04:\x02\t\x05\x12\x04\xe2\x02\x02\x06\n\
+    \r\n\x05\x04:\x02\t\x01\x12\x04\xe2\x02\x07\x12\n\r\n\x05\x04:\x02\t\x03\
+    \x12\x04\xe2\x02\x15\x17\n\x0c\n\x04\x04:\x02\n\x12\x04\xe3\x02\x02\x17\
+    \n\r\n\x05\x04:\x02\n\x05\x12\x04\xe3\x02\x02\x07\n\r\n\x05\x04:\x02\n\
+    \x01\x12\x04\xe3\x02\x08\x11\n\r\n\x05\x04:\x02\n\x03\x12\x04\xe3\x02\
+    \x14\x16\n\x0c\n\x04\x04:\x02\x0b\x12\x04\xe4\x02\x02\"\n\r\n\x05\x04:\
+    \x02\x0b\x05\x12\x04\xe4\x02\x02\x07\n\r\n\x05\x04:\x02\x0b\x01\x12\x04\
+    \xe4\x02\x08\x1c\n\r\n\x05\x04:\x02\x0b\x03\x12\x04\xe4\x02\x1f!\n\x0c\n\
+    \x04\x04:\x02\x0c\x12\x04\xe5\x02\x02\x1a\n\r\n\x05\x04:\x02\x0c\x05\x12\
+    \x04\xe5\x02\x02\x07\n\r\n\x05\x04:\x02\x0c\x01\x12\x04\xe5\x02\x08\x14\
+    \n\r\n\x05\x04:\x02\x0c\x03\x12\x04\xe5\x02\x17\x19\n\x0c\n\x04\x04:\x02\
+    \r\x12\x04\xe6\x02\x02\x15\n\r\n\x05\x04:\x02\r\x05\x12\x04\xe6\x02\x02\
+    \x08\n\r\n\x05\x04:\x02\r\x01\x12\x04\xe6\x02\t\x0f\n\r\n\x05\x04:\x02\r\
+    \x03\x12\x04\xe6\x02\x12\x14\nE\n\x02\x05\0\x12\x06\xea\x02\0\xf3\x02\
+    \x01\x1a7\x20Tracer,\x20which\x20collects\x20execution\x20trace\x20of\
+    \x20transaction\n\n\x0b\n\x03\x05\0\x01\x12\x04\xea\x02\x05\x0f\n7\n\x04\
+    \x05\0\x02\0\x12\x04\xec\x02\x02\x12\x1a)\x20Transaction\x20is\x20execut\
+    ed\x20without\x20tracing\n\n\r\n\x05\x05\0\x02\0\x01\x12\x04\xec\x02\x02\
+    \r\n\r\n\x05\x05\0\x02\0\x02\x12\x04\xec\x02\x10\x11\nU\n\x04\x05\0\x02\
+    \x01\x12\x04\xee\x02\x02\x14\x1aG\x20Struct\x20logger,\x20which\x20colle\
+    cts\x20state\x20of\x20EVM\x20after\x20each\x20executed\x20opcode\n\n\r\n\
+    \x05\x05\0\x02\x01\x01\x12\x04\xee\x02\x02\x0f\n\r\n\x05\x05\0\x02\x01\
+    \x02\x12\x04\xee\x02\x12\x13\nO\n\x04\x05\0\x02\x02\x12\x04\xf0\x02\x02\
+    \x12\x1aA\x20Call\x20tracer,\x20which\x20collects\x20tree\x20of\x20calls\
+    \x20made\x20during\x20execution\n\n\r\n\x05\x05\0\x02\x02\x01\x12\x04\
+    \xf0\x02\x02\r\n\r\n\x05\x05\0\x02\x02\x02\x12\x04\xf0\x02\x10\x11\nf\n\
+    \x04\x05\0\x02\x03\x12\x04\xf2\x02\x02\x19\x1aX\x20Access\x20list\x20tra\
+    cer,\x20which\x20collects\x20accounts\x20and\x20storage\x20slots\x20touc\
+    hed\x20during\x20execution\n\n\r\n\x05\x05\0\x02\x03\x01\x12\x04\xf2\x02\
+    \x02\x14\n\r\n\x05\x05\0\x02\x03\x02\x12\x04\xf2\x02\x17\x18\n,\n\x02\
+    \x04;\x12\x06\xf6\x02\0\x83\x03\x01\x1a\x1e\x20Options\x20of\x20executio\
+    n\x20tracing\n\n\x0b\n\x03\x04;\x01\x12\x04\xf6\x02\x08\x14\n\x0c\n\x04\
+    \x04;\x02\0\x12\x04\xf7\x02\x02\x18\n\r\n\x05\x04;\x02\0\x06\x12\x04\xf7\
+    \x02\x02\x0c\n\r\n\x05\x04;\x02\0\x01\x12\x04\xf7\x02\r\x13\n\r\n\x05\
+    \x04;\x02\0\x03\x12\x04\xf7\x02\x16\x17\n\x0c\n\x04\x04;\x02\x01\x12\x04\
+    \xf8\x02\x02\x18\n\r\n\x05\x04;\x02\x01\x05\x12\x04\xf8\x02\x02\x06\n\r\
+    \n\x05\x04;\x02\x01\x01\x12\x04\xf8\x02\x07\x13\n\r\n\x05\x04;\x02\x01\
+    \x03\x12\x04\xf8\x02\x16\x17\n\x0c\n\x04\x04;\x02\x02\x12\x04\xf9\x02\
+    \x02\x1a\n\r\n\x05\x04;\x02\x02\x05\x12\x04\xf9\x02\x02\x06\n\r\n\x05\
+    \x04;\x02\x02\x01\x12\x04\xf9\x02\x07\x15\n\r\n\x05\x04;\x02\x02\x03\x12\
+    \x04\xf9\x02\x18\x19\n\x0c\n\x04\x04;\x02\x03\x12\x04\xfa\x02\x02\x18\n\
+    \r\n\x05\x04;\x02\x03\x05\x12\x04\xfa\x02\x02\x06\n\r\n\x05\x04;\x02\x03\
+    \x01\x12\x04\xfa\x02\x07\x13\n\r\n\x05\x04;\x02\x03\x03\x12\x04\xfa\x02\
+    \x16\x17\n\x0c\n\x04\x04;\x02\x04\x12\x04\xfb\x02\x02\x1c\n\r\n\x05\x04;\
+    \x02\x04\x05\x12\x04\xfb\x02\x02\x06\n\r\n\x05\x04;\x02\x04\x01\x12\x04\
+    \xfb\x02\x07\x17\n\r\n\x05\x04;\x02\x04\x03\x12\x04\xfb\x02\x1a\x1b\nM\n\
+    \x04\x04;\x02\x05\x12\x04\xfd\x02\x02\x13\x1a?\x20maximum\x20number\x20o\
+    f\x20collected\x20struct\x20logs,\x20zero\x20means\x20unlimited\n\n\r\n\
+    \x05\x04;\x02\x05\x05\x12\x04\xfd\x02\x02\x08\n\r\n\x05\x04;\x02\x05\x01\
+    \x12\x04\xfd\x02\t\x0e\n\r\n\x05\x04;\x02\x05\x03\x12\x04\xfd\x02\x11\
+    \x12\n?\n\x04\x04;\x02\x06\x12\x04\xff\x02\x02\x17\x1a1\x20call\x20trace\
+    r\x20collects\x20only\x20top-level\x20call\x20if\x20set\n\n\r\n\x05\x04;\
+    \x02\x06\x05\x12\x04\xff\x02\x02\x06\n\r\n\x05\x04;\x02\x06\x01\x12\x04\
+    \xff\x02\x07\x12\n\r\n\x05\x04;\x02\x06\x03\x12\x04\xff\x02\x15\x16\n\
+    \xa4\x01\n\x04\x04;\x02\x07\x12\x04\x82\x03\x02\x15\x1a\x95\x01\x20set\
+    \x20by\x20trace\x20nodes\x20only.\x20Otherwise,\x20struct\x20logs\x20do\
+    \x20not\x20contain\x20stack,\x20memory,\n\x20return\x20data\x20and\x20st\
+    orage,\x20and\x20encrypted\x20transactions\x20cannot\x20be\x20traced\n\n\
+    \r\n\x05\x04;\x02\x07\x05\x12\x04\x82\x03\x02\x06\n\r\n\x05\x04;\x02\x07\
+    \x01\x12\x04\x82\x03\x07\x10\n\r\n\x05\x04;\x02\x07\x03\x12\x04\x82\x03\
+    \x13\x14\n\x0c\n\x02\x04<\x12\x06\x85\x03\0\x88\x03\x01\n\x0b\n\x03\x04<\
+    \x01\x12\x04\x85\x03\x08\x19\n\x0c\n\x04\x04<\x02\0\x12\x04\x86\x03\x02\
+    \x10\n\r\n\x05\x04<\x02\0\x05\x12\x04\x86\x03\x02\x07\n\r\n\x05\x04<\x02\
+    \0\x01\x12\x04\x86\x03\x08\x0b\n\r\n\x05\x04<\x02\0\x03\x12\x04\x86\x03\
+    \x0e\x0f\n\x0c\n\x04\x04<\x02\x01\x12\x04\x87\x03\x02\x12\n\r\n\x05\x04<\
+    \x02\x01\x05\x12\x04\x87\x03\x02\x07\n\r\n\x05\x04<\x02\x01\x01\x12\x04\
+    \x87\x03\x08\r\n\r\n\x05\x04<\x02\x01\x03\x12\x04\x87\x03\x10\x11\n?\n\
+    \x02\x04=\x12\x06\x8b\x03\0\x97\x03\x01\x1a1\x20State\x20of\x20EVM\x20af\
+    ter\x20execution\x20of\x20a\x20single\x20opcode\n\n\x0b\n\x03\x04=\x01\
+    \x12\x04\x8b\x03\x08\x16\n\x0c\n\x04\x04=\x02\0\x12\x04\x8c\x03\x02\x10\
+    \n\r\n\x05\x04=\x02\0\x05\x12\x04\x8c\x03\x02\x08\n\r\n\x05\x04=\x02\0\
+    \x01\x12\x04\x8c\x03\t\x0b\n\r\n\x05\x04=\x02\0\x03\x12\x04\x8c\x03\x0e\
+    \x0f\n\x0c\n\x04\x04=\x02\x01\x12\x04\x8d\x03\x02\x10\n\r\n\x05\x04=\x02\
+    \x01\x05\x12\x04\x8d\x03\x02\x08\n\r\n\x05\x04=\x02\x01\x01\x12\x04\x8d\
+    \x03\t\x0b\n\r\n\x05\x04=\x02\x01\x03\x12\x04\x8d\x03\x0e\x0f\n\x0c\n\
+    \x04\x04=\x02\x02\x12\x04\x8e\x03\x02\x11\n\r\n\x05\x04=\x02\x02\x05\x12\
+    \x04\x8e\x03\x02\x08\n\r\n\x05\x04=\x02\x02\x01\x12\x04\x8e\x03\t\x0c\n\
+    \r\n\x05\x04=\x02\x02\x03\x12\x04\x8e\x03\x0f\x10\n\x0c\n\x04\x04=\x02\
+    \x03\x12\x04\x8f\x03\x02\x15\n\r\n\x05\x04=\x02\x03\x05\x12\x04\x8f\x03\
+    \x02\x08\n\r\n\x05\x04=\x02\x03\x01\x12\x04\x8f\x03\t\x10\n\r\n\x05\x04=\
+    \x02\x03\x03\x12\x04\x8f\x03\x13\x14\n\x0c\n\x04\x04=\x02\x04\x12\x04\
+    \x90\x03\x02\x13\n\r\n\x05\x04=\x02\x04\x05\x12\x04\x90\x03\x02\x08\n\r\
+    \n\x05\x04=\x02\x04\x01\x12\x04\x90\x03\t\x0e\n\r\n\x05\x04=\x02\x04\x03\
+    \x12\x04\x90\x03\x11\x12\n\x0c\n\x04\x04=\x02\x05\x12\x04\x91\x03\x02\
+    \x1b\n\r\n\x05\x04=\x02\x05\x04\x12\x04\x91\x03\x02\n\n\r\n\x05\x04=\x02\
+    \x05\x05\x12\x04\x91\x03\x0b\x10\n\r\n\x05\x04=\x02\x05\x01\x12\x04\x91\
+    \x03\x11\x16\n\r\n\x05\x04=\x02\x05\x03\x12\x04\x91\x03\x19\x1a\n\x0c\n\
+    \x04\x04=\x02\x06\x12\x04\x92\x03\x02\x13\n\r\n\x05\x04=\x02\x06\x05\x12\
+    \x04\x92\x03\x02\x07\n\r\n\x05\x04=\x02\x06\x01\x12\x04\x92\x03\x08\x0e\
+    \n\r\n\x05\x04=\x02\x06\x03\x12\x04\x92\x03\x11\x12\n\x0c\n\x04\x04=\x02\
+    \x07\x12\x04\x93\x03\x02)\n\r\n\x05\x04=\x02\x07\x04\x12\x04\x93\x03\x02\
+    \n\n\r\n\x05\x04=\x02\x07\x06\x12\x04\x93\x03\x0b\x1c\n\r\n\x05\x04=\x02\
+    \x07\x01\x12\x04\x93\x03\x1d$\n\r\n\x05\x04=\x02\x07\x03\x12\x04\x93\x03\
+    '(\n\x0c\n\x04\x04=\x02\x08\x12\x04\x94\x03\x02\x17\n\r\n\x05\x04=\x02\
+    \x08\x05\x12\x04\x94\x03\x02\x07\n\r\n\x05\x04=\x02\x08\x01\x12\x04\x94\
+    \x03\x08\x12\n\r\n\x05\x04=\x02\x08\x03\x12\x04\x94\x03\x15\x16\n\x0c\n\
+    \x04\x04=\x02\t\x12\x04\x95\x03\x02\x15\n\r\n\x05\x04=\x02\t\x05\x12\x04\
+    \x95\x03\x02\x08\n\r\n\x05\x04=\x02\t\x01\x12\x04\x95\x03\t\x0f\n\r\n\
+    \x05\x04=\x02\t\x03\x12\x04\x95\x03\x12\x14\n\x0c\n\x04\x04=\x02\n\x12\
+    \x04\x96\x03\x02\x14\n\r\n\x05\x04=\x02\n\x05\x12\x04\x96\x03\x02\x08\n\
+    \r\n\x05\x04=\x02\n\x01\x12\x04\x96\x03\t\x0e\n\r\n\x05\x04=\x02\n\x03\
+    \x12\x04\x96\x03\x11\x13\n6\n\x02\x04>\x12\x06\x9a\x03\0\xa6\x03\x01\x1a\
+    (\x20Call\x20made\x20during\x20transaction\x20execution\n\n\x0b\n\x03\
+    \x04>\x01\x12\x04\x9a\x03\x08\x16\nK\n\x04\x04>\x02\0\x12\x04\x9c\x03\
+    \x02\x12\x1a=\x20CALL,\x20STATICCALL,\x20DELEGATECALL,\x20CALLCODE,\x20C\
+    REATE\x20or\x20CREATE2\n\n\r\n\x05\x04>\x02\0\x05\x12\x04\x9c\x03\x02\
+    \x08\n\r\n\x05\x04>\x02\0\x01\x12\x04\x9c\x03\t\r\n\r\n\x05\x04>\x02\0\
+    \x03\x12\x04\x9c\x03\x10\x11\n\x0c\n\x04\x04>\x02\x01\x12\x04\x9d\x03\
+    \x02\x11\n\r\n\x05\x04>\x02\x01\x05\x12\x04\x9d\x03\x02\x07\n\r\n\x05\
+    \x04>\x02\x01\x01\x12\x04\x9d\x03\x08\x0c\n\r\n\x05\x04>\x02\x01\x03\x12\
+    \x04\x9d\x03\x0f\x10\n\x0c\n\x04\x04>\x02\x02\x12\x04\x9e\x03\x02\x0f\n\
+    \r\n\x05\x04>\x02\x02\x05\x12\x04\x9e\x03\x02\x07\n\r\n\x05\x04>\x02\x02\
+    \x01\x12\x04\x9e\x03\x08\n\n\r\n\x05\x04>\x02\x02\x03\x12\x04\x9e\x03\r\
+    \x0e\n\x0c\n\x04\x04>\x02\x03\x12\x04\x9f\x03\x02\x12\n\r\n\x05\x04>\x02\
+    \x03\x05\x12\x04\x9f\x03\x02\x07\n\r\n\x05\x04>\x02\x03\x01\x12\x04\x9f\
+    \x03\x08\r\n\r\n\x05\x04>\x02\x03\x03\x12\x04\x9f\x03\x10\x11\n\x0c\n\
+    \x04\x04>\x02\x04\x12\x04\xa0\x03\x02\x11\n\r\n\x05\x04>\x02\x04\x05\x12\
+    \x04\xa0\x03\x02\x08\n\r\n\x05\x04>\x02\x04\x01\x12\x04\xa0\x03\t\x0c\n\
+    \r\n\x05\x04>\x02\x04\x03\x12\x04\xa0\x03\x0f\x10\n\x0c\n\x04\x04>\x02\
+    \x05\x12\x04\xa1\x03\x02\x15\n\r\n\x05\x04>\x02\x05\x05\x12\x04\xa1\x03\
+    \x02\x08\n\r\n\x05\x04>\x02\x05\x01\x12\x04\xa1\x03\t\x10\n\r\n\x05\x04>\
+    \x02\x05\x03\x12\x04\xa1\x03\x13\x14\n\x0c\n\x04\x04>\x02\x06\x12\x04\
+    \xa2\x03\x02\x12\n\r\n\x05\x04>\x02\x06\x05\x12\x04\xa2\x03\x02\x07\n\r\
+    \n\x05\x04>\x02\x06\x01\x12\x04\xa2\x03\x08\r\n\r\n\x05\x04>\x02\x06\x03\
+    \x12\x04\xa2\x03\x10\x11\n\x0c\n\x04\x04>\x02\x07\x12\x04\xa3\x03\x02\
+    \x13\n\r\n\x05\x04>\x02\x07\x05\x12\x04\xa3\x03\x02\x07\n\r\n\x05\x04>\
+    \x02\x07\x01\x12\x04\xa3\x03\x08\x0e\n\r\n\x05\x04>\x02\x07\x03\x12\x04\
+    \xa3\x03\x11\x12\n\x0c\n\x04\x04>\x02\x08\x12\x04\xa4\x03\x02\x13\n\r\n\
+    \x05\x04>\x02\x08\x05\x12\x04\xa4\x03\x02\x08\n\r\n\x05\x04>\x02\x08\x01\
+    \x12\x04\xa4\x03\t\x0e\n\r\n\x05\x04>\x02\x08\x03\x12\x04\xa4\x03\x11\
+    \x12\n\x0c\n\x04\x04>\x02\t\x12\x04\xa5\x03\x02%\n\r\n\x05\x04>\x02\t\
+    \x04\x12\x04\xa5\x03\x02\n\n\r\n\x05\x04>\x02\t\x06\x12\x04\xa5\x03\x0b\
+    \x19\n\r\n\x05\x04>\x02\t\x01\x12\x04\xa5\x03\x1a\x1f\n\r\n\x05\x04>\x02\
+    \t\x03\x12\x04\xa5\x03\"$\n=\n\x02\x04?\x12\x06\xa9\x03\0\xae\x03\x01\
+    \x1a/\x20Execution\x20trace\x20collected\x20by\x20requested\x20tracer\n\
+    \n\x0b\n\x03\x04?\x01\x12\x04\xa9\x03\x08\x16\n\x0c\n\x04\x04?\x02\0\x12\
+    \x04\xaa\x03\x02)\n\r\n\x05\x04?\x02\0\x04\x12\x04\xaa\x03\x02\n\n\r\n\
+    \x05\x04?\x02\0\x06\x12\x04\xaa\x03\x0b\x19\n\r\n\x05\x04?\x02\0\x01\x12\
+    \x04\xaa\x03\x1a$\n\r\n\x05\x04?\x02\0\x03\x12\x04\xaa\x03'(\n\x0c\n\x04\
+    \x04?\x02\x01\x12\x04\xab\x03\x02\x1f\n\r\n\x05\x04?\x02\x01\x06\x12\x04\
+    \xab\x03\x02\x10\n\r\n\x05\x04?\x02\x01\x01\x12\x04\xab\x03\x11\x1a\n\r\
+    \n\x05\x04?\x02\x01\x03\x12\x04\xab\x03\x1d\x1e\nC\n\x04\x04?\x02\x02\
+    \x12\x04\xad\x03\x02)\x1a5\x20accounts\x20and\x20storage\x20slots\x20tou\
+    ched\x20during\x20execution\n\n\r\n\x05\x04?\x02\x02\x04\x12\x04\xad\x03\
+    \x02\n\n\r\n\x05\x04?\x02\x02\x06\x12\x04\xad\x03\x0b\x19\n\r\n\x05\x04?\
+    \x02\x02\x01\x12\x04\xad\x03\x1a$\n\r\n\x05\x04?\x02\x02\x03\x12\x04\xad\
+    \x03'(\n3\n\x02\x04@\x12\x06\xb1\x03\0\xb4\x03\x01\x1a%\x20Request\x20to\
+    \x20execute\x20`call`\x20operation\n\n\x0b\n\x03\x04@\x01\x12\x04\xb1\
+    \x03\x08\x18\n\x0c\n\x04\x04@\x02\0\x12\x04\xb2\x03\x02\x1d\n\r\n\x05\
+    \x04@\x02\0\x06\x12\x04\xb2\x03\x02\x11\n\r\n\x05\x04@\x02\0\x01\x12\x04\
+    \xb2\x03\x12\x18\n\r\n\x05\x04@\x02\0\x03\x12\x04\xb2\x03\x1b\x1c\n\x0c\
+    \n\x04\x04@\x02\x01\x12\x04\xb3\x03\x02!\n\r\n\x05\x04@\x02\x01\x06\x12\
+    \x04\xb3\x03\x02\x14\n\r\n\x05\x04@\x02\x01\x01\x12\x04\xb3\x03\x15\x1c\
+    \n\r\n\x05\x04@\x02\x01\x03\x12\x04\xb3\x03\x1f\x20\n5\n\x02\x04A\x12\
+    \x06\xb7\x03\0\xba\x03\x01\x1a'\x20Request\x20to\x20execute\x20`create`\
+    \x20operation\n\n\x0b\n\x03\x04A\x01\x12\x04\xb7\x03\x08\x1a\n\x0c\n\x04\
+    \x04A\x02\0\x12\x04\xb8\x03\x02\x1f\n\r\n\x05\x04A\x02\0\x06\x12\x04\xb8\
+    \x03\x02\x13\n\r\n\x05\x04A\x02\0\x01\x12\x04\xb8\x03\x14\x1a\n\r\n\x05\
+    \x04A\x02\0\x03\x12\x04\xb8\x03\x1d\x1e\n\x0c\n\x04\x04A\x02\x01\x12\x04\
+    \xb9\x03\x02!\n\r\n\x05\x04A\x02\x01\x06\x12\x04\xb9\x03\x02\x14\n\r\n\
+    \x05\x04A\x02\x01\x01\x12\x04\xb9\x03\x15\x1c\n\r\n\x05\x04A\x02\x01\x03\
+    \x12\x04\xb9\x03\x1f\x20\n:\n\x02\x04B\x12\x06\xbd\x03\0\xc0\x03\x01\x1a\
+    ,\x20Request\x20to\x20execute\x20`estimateGas`\x20operation\n\n\x0b\n\
+    \x03\x04B\x01\x12\x04\xbd\x03\x08\x1f\n\x0c\n\x04\x04B\x02\0\x12\x04\xbe\
+    \x03\x02$\n\r\n\x05\x04B\x02\0\x06\x12\x04\xbe\x03\x02\x18\n\r\n\x05\x04\
+    B\x02\0\x01\x12\x04\xbe\x03\x19\x1f\n\r\n\x05\x04B\x02\0\x03\x12\x04\xbe\
+    \x03\"#\n\x0c\n\x04\x04B\x02\x01\x12\x04\xbf\x03\x02!\n\r\n\x05\x04B\x02\
+    \x01\x06\x12\x04\xbf\x03\x02\x14\n\r\n\x05\x04B\x02\x01\x01\x12\x04\xbf\
+    \x03\x15\x1c\n\r\n\x05\x04B\x02\x01\x03\x12\x04\xbf\x03\x1f\x20\n1\n\x02\
+    \x04C\x12\x06\xc3\x03\0\xc5\x03\x01\x1a#\x20Request\x20to\x20obtain\x20n\
+    ode\x20public\x20key\n\n\x0b\n\x03\x04C\x01\x12\x04\xc3\x03\x08\x1c\n\
+    \x0c\n\x04\x04C\x02\0\x12\x04\xc4\x03\x02\x19\n\r\n\x05\x04C\x02\0\x05\
+    \x12\x04\xc4\x03\x02\x08\n\r\n\x05\x04C\x02\0\x01\x12\x04\xc4\x03\t\x14\
+    \n\r\n\x05\x04C\x02\0\x03\x12\x04\xc4\x03\x17\x18\n+\n\x02\x04D\x12\x04\
+    \xc8\x03\06\x1a\x1f\x20Response\x20with\x20node\x20public\x20key\n\n\x0b\
+    \n\x03\x04D\x01\x12\x04\xc8\x03\x08\x1d\n\x0c\n\x04\x04D\x02\0\x12\x04\
+    \xc8\x03\x204\n\r\n\x05\x04D\x02\0\x05\x12\x04\xc8\x03\x20%\n\r\n\x05\
+    \x04D\x02\0\x01\x12\x04\xc8\x03&/\n\r\n\x05\x04D\x02\0\x03\x12\x04\xc8\
+    \x0323\n\xa9\x02\n\x02\x04E\x12\x06\xce\x03\0\xd5\x03\x01\x1a\x9a\x02\
+    \x20Request\x20to\x20read\x20contract\x20storage\x20slot\x20on\x20behalf\
+    \x20of\x20contract\x20owner.\n\x20Signature\x20is\x20made\x20by\x20contr\
+    act\x20owner\x20over\x20contract\x20address,\x20storage\x20key\n\x20and\
+    \x20user\x20public\x20key.\x20Owner\x20is\x20either\x20deployer\x20of\
+    \x20the\x20contract\x20(checked\n\x20using\x20deploymentNonce)\x20or\x20\
+    address\x20stored\x20in\x20the\x20owner\x20slot\x20(slot\x200).\n\n\x0b\
+    \n\x03\x04E\x01\x12\x04\xce\x03\x08!\n\x0c\n\x04\x04E\x02\0\x12\x04\xcf\
+    \x03\x02\x1c\n\r\n\x05\x04E\x02\0\x05\x12\x04\xcf\x03\x02\x07\n\r\n\x05\
+    \x04E\x02\0\x01\x12\x04\xcf\x03\x08\x17\n\r\n\x05\x04E\x02\0\x03\x12\x04\
+    \xcf\x03\x1a\x1b\n\x0c\n\x04\x04E\x02\x01\x12\x04\xd0\x03\x02\x17\n\r\n\
+    \x05\x04E\x02\x01\x05\x12\x04\xd0\x03\x02\x07\n\r\n\x05\x04E\x02\x01\x01\
+    \x12\x04\xd0\x03\x08\x12\n\r\n\x05\x04E\x02\x01\x03\x12\x04\xd0\x03\x15\
+    \x16\n\x0c\n\x04\x04E\x02\x02\x12\x04\xd1\x03\x02\x1a\n\r\n\x05\x04E\x02\
+    \x02\x05\x12\x04\xd1\x03\x02\x07\n\r\n\x05\x04E\x02\x02\x01\x12\x04\xd1\
+    \x03\x08\x15\n\r\n\x05\x04E\x02\x02\x03\x12\x04\xd1\x03\x18\x19\n\x0c\n\
+    \x04\x04E\x02\x03\x12\x04\xd2\x03\x02\x16\n\r\n\x05\x04E\x02\x03\x05\x12\
+    \x04\xd2\x03\x02\x07\n\r\n\x05\x04E\x02\x03\x01\x12\x04\xd2\x03\x08\x11\
+    \n\r\n\x05\x04E\x02\x03\x03\x12\x04\xd2\x03\x14\x15\n\x0c\n\x04\x04E\x02\
+    \x04\x12\x04\xd3\x03\x02\x1d\n\r\n\x05\x04E\x02\x04\x05\x12\x04\xd3\x03\
+    \x02\x08\n\r\n\x05\x04E\x02\x04\x01\x12\x04\xd3\x03\t\x18\n\r\n\x05\x04E\
+    \x02\x04\x03\x12\x04\xd3\x03\x1b\x1c\n\x0c\n\x04\x04E\x02\x05\x12\x04\
+    \xd4\x03\x02\x19\n\r\n\x05\x04E\x02\x05\x05\x12\x04\xd4\x03\x02\x08\n\r\
+    \n\x05\x04E\x02\x05\x01\x12\x04\xd4\x03\t\x14\n\r\n\x05\x04E\x02\x05\x03\
+    \x12\x04\xd4\x03\x17\x18\n}\n\x02\x04F\x12\x06\xd9\x03\0\xdc\x03\x01\x1a\
+    o\x20Response\x20with\x20storage\x20slot\x20value\x20encrypted\x20using\
+    \x20shared\x20key\n\x20derived\x20from\x20user\x20public\x20key\x20and\
+    \x20node\x20public\x20key\n\n\x0b\n\x03\x04F\x01\x12\x04\xd9\x03\x08\"\n\
+    \x0c\n\x04\x04F\x02\0\x12\x04\xda\x03\x02\x1b\n\r\n\x05\x04F\x02\0\x05\
+    \x12\x04\xda\x03\x02\x07\n\r\n\x05\x04F\x02\0\x01\x12\x04\xda\x03\x08\
+    \x16\n\r\n\x05\x04F\x02\0\x03\x12\x04\xda\x03\x19\x1a\n\x0c\n\x04\x04F\
+    \x02\x01\x12\x04\xdb\x03\x02\x1a\n\r\n\x05\x04F\x02\x01\x05\x12\x04\xdb\
+    \x03\x02\x07\n\r\n\x05\x04F\x02\x01\x01\x12\x04\xdb\x03\x08\x15\n\r\n\
+    \x05\x04F\x02\x01\x03\x12\x04\xdb\x03\x18\x19\n\x0c\n\x02\x04G\x12\x06\
+    \xde\x03\0\xe2\x03\x01\n\x0b\n\x03\x04G\x01\x12\x04\xde\x03\x08\x11\n\
+    \x0c\n\x04\x04G\x02\0\x12\x04\xdf\x03\x02\x19\n\r\n\x05\x04G\x02\0\x05\
+    \x12\x04\xdf\x03\x02\x08\n\r\n\x05\x04G\x02\0\x01\x12\x04\xdf\x03\t\x14\
+    \n\r\n\x05\x04G\x02\0\x03\x12\x04\xdf\x03\x17\x18\n\x0c\n\x04\x04G\x02\
+    \x01\x12\x04\xe0\x03\x02\x1b\n\r\n\x05\x04G\x02\x01\x05\x12\x04\xe0\x03\
+    \x02\x08\n\r\n\x05\x04G\x02\x01\x01\x12\x04\xe0\x03\t\x16\n\r\n\x05\x04G\
+    \x02\x01\x03\x12\x04\xe0\x03\x19\x1a\n\x0c\n\x04\x04G\x02\x02\x12\x04\
+    \xe1\x03\x02\x1a\n\r\n\x05\x04G\x02\x02\x05\x12\x04\xe1\x03\x02\x07\n\r\
+    \n\x05\x04G\x02\x02\x01\x12\x04\xe1\x03\x08\x15\n\r\n\x05\x04G\x02\x02\
+    \x03\x12\x04\xe1\x03\x18\x19\n\x0c\n\x02\x04H\x12\x06\xe3\x03\0\xe5\x03\
+    \x01\n\x0b\n\x03\x04H\x01\x12\x04\xe3\x03\x08\x1a\n\x0c\n\x04\x04H\x02\0\
+    \x12\x04\xe4\x03\x02\x20\n\r\n\x05\x04H\x02\0\x04\x12\x04\xe4\x03\x02\n\
+    \n\r\n\x05\x04H\x02\0\x06\x12\x04\xe4\x03\x0b\x14\n\r\n\x05\x04H\x02\0\
+    \x01\x12\x04\xe4\x03\x15\x1b\n\r\n\x05\x04H\x02\0\x03\x12\x04\xe4\x03\
+    \x1e\x1f\n\x0c\n\x02\x04I\x12\x06\xe7\x03\0\xef\x03\x01\n\x0b\n\x03\x04I\
+    \x01\x12\x04\xe7\x03\x08\x12\n\x0e\n\x04\x04I\x08\0\x12\x06\xe8\x03\x02\
+    \xee\x03\x03\n\r\n\x05\x04I\x08\0\x01\x12\x04\xe8\x03\x08\x0b\n\x0c\n\
+    \x04\x04I\x02\0\x12\x04\xe9\x03\x04%\n\r\n\x05\x04I\x02\0\x06\x12\x04\
+    \xe9\x03\x04\x14\n\r\n\x05\x04I\x02\0\x01\x12\x04\xe9\x03\x15\x20\n\r\n\
+    \x05\x04I\x02\0\x03\x12\x04\xe9\x03#$\n\x0c\n\x04\x04I\x02\x01\x12\x04\
+    \xea\x03\x04)\n\r\n\x05\x04I\x02\x01\x06\x12\x04\xea\x03\x04\x16\n\r\n\
+    \x05\x04I\x02\x01\x01\x12\x04\xea\x03\x17$\n\r\n\x05\x04I\x02\x01\x03\
+    \x12\x04\xea\x03'(\n\x0c\n\x04\x04I\x02\x02\x12\x04\xeb\x03\x043\n\r\n\
+    \x05\x04I\x02\x02\x06\x12\x04\xeb\x03\x04\x1b\n\r\n\x05\x04I\x02\x02\x01\
+    \x12\x04\xeb\x03\x1c.\n\r\n\x05\x04I\x02\x02\x03\x12\x04\xeb\x0312\n\x0c\
+    \n\x04\x04I\x02\x03\x12\x04\xec\x03\x04.\n\r\n\x05\x04I\x02\x03\x06\x12\
+    \x04\xec\x03\x04\x18\n\r\n\x05\x04I\x02\x03\x01\x12\x04\xec\x03\x19)\n\r\
+    \n\x05\x04I\x02\x03\x03\x12\x04\xec\x03,-\n\x0c\n\x04\x04I\x02\x04\x12\
+    \x04\xed\x03\x04<\n\r\n\x05\x04I\x02\x04\x06\x12\x04\xed\x03\x04\x1d\n\r\
+    \n\x05\x04I\x02\x04\x01\x12\x04\xed\x03\x1e7\n\r\n\x05\x04I\x02\x04\x03\
+    \x12\x04\xed\x03:;b\x06proto3\
 ";
 
 static mut file_descriptor_proto_lazy: ::protobuf::lazy::Lazy<::protobuf::descriptor::FileDescriptorProto> = ::protobuf::lazy::Lazy {
@@ -0,0 +1,441 @@
+use alloc::collections::{BTreeMap, BTreeSet};
+use core::cell::RefCell;
+use evm::interpreter::error::{CallCreateTrap, ExitError, ExitResult};
+use evm::interpreter::opcode::Opcode;
+use evm::interpreter::runtime::{GasState, RuntimeBackend, RuntimeEnvironment, RuntimeState};
+use evm::interpreter::{Control, Machine};
+use evm::standard::{GasometerState, InvokerState};
+use primitive_types::{H160, H256, U256};
+use protobuf::RepeatedField;
+use std::string::{String, ToString};
+use std::vec::Vec;
+
+use crate::protobuf_generated::ffi::{
+    AccessListItem, ExecutionTrace, TraceCallFrame, TraceOptions, TraceStorageEntry, TraceStructLog, TracerType,
+};
+
+/// Collects execution trace requested by provided trace options
+pub struct ExecutionTracer {
+    options: TraceOptions,
+    struct_logs: Vec<TraceStructLog>,
+    // Storage slots accessed by each contract. Struct logs contain all slots accessed so far
+    // by current contract, in the same way as struct logger of go-ethereum does
+    storage: BTreeMap<H160, BTreeMap<H256, H256>>,
+    depth: u32,
+    // Frames of calls, which are currently executed. The first one is top-level call
+    callstack: Vec<TraceCallFrame>,
+    // Number of nested calls, which are currently executed, but not collected
+    skipped: usize,
+    // Accounts and storage slots touched during execution
+    access_list: BTreeMap<H160, BTreeSet<H256>>,
+    // Sender and recipient are always warm, so they are not collected into access list
+    excluded: BTreeSet<H160>,
+}
+
+impl ExecutionTracer {
+    /// Creates tracer for provided options. Returns None if tracing was not requested
+    pub fn new(options: TraceOptions) -> Option<Self> {
+        if options.get_tracer() == TracerType::TRACER_NONE {
+            return None;
+        }
+
+        Some(Self {
+            options,
+            struct_logs: Vec::new(),
+            storage: BTreeMap::new(),
+            depth: 0,
+            callstack: Vec::new(),
+            skipped: 0,
+            access_list: BTreeMap::new(),
+            excluded: BTreeSet::new(),
+        })
+    }
+
+    fn is_struct_tracer(&self) -> bool {
+        self.options.get_tracer() == TracerType::TRACER_STRUCT
+    }
+
+    fn is_call_tracer(&self) -> bool {
+        self.options.get_tracer() == TracerType::TRACER_CALL
+    }
+
+    fn is_access_list_tracer(&self) -> bool {
+        self.options.get_tracer() == TracerType::TRACER_ACCESS_LIST
+    }
+
+    /// Records account or storage slot accessed by the opcode
+    fn capture_access<S: AsRef<RuntimeState>>(&mut self, machine: &Machine<S>, opcode: Opcode) {
+        let address = match opcode {
+            Opcode::SLOAD | Opcode::SSTORE => {
+                if let Ok(slot) = machine.stack.peek(0) {
+                    let address = machine.state.as_ref().context.address;
+                    if !self.excluded.contains(&address) {
+                        self.access_list.entry(address).or_default().insert(slot);
+                    }
+                }
+                return;
+            }
+            Opcode::BALANCE | Opcode::EXTCODESIZE | Opcode::EXTCODECOPY | Opcode::EXTCODEHASH | Opcode::SUICIDE => {
+                machine.stack.peek(0)
+            }
+            Opcode::CALL | Opcode::CALLCODE | Opcode::DELEGATECALL | Opcode::STATICCALL => machine.stack.peek(1),
+            _ => return,
+        };
+
+        if let Ok(address) = address {
+            let address = H160::from(address);
+            if !self.excluded.contains(&address) {
+                self.access_list.entry(address).or_default();
+            }
+        }
+    }
+
+    /// Records state of EVM before execution of the opcode. Returns false if state was not recorded
+    pub fn capture_state<S, H>(
+        &mut self,
+        machine: &Machine<S>,
+        handler: &H,
+        opcode: Opcode,
+        position: usize,
+        gas: U256,
+        refund: U256,
+    ) -> bool
+    where
+        S: AsRef<RuntimeState>,
+        H: RuntimeBackend,
+    {
+        if self.is_access_list_tracer() {
+            self.capture_access(machine, opcode);
+            return false;
+        }
+        if !self.is_struct_tracer() {
+            return false;
+        }
+        let limit = self.options.get_limit();
+        if limit != 0 && self.struct_logs.len() as u64 >= limit {
+            return false;
+        }
+
+        let mut log = TraceStructLog::new();
+        log.set_pc(position as u64);
+        log.set_op(opcode_name(opcode.0));
+        log.set_gas(gas.low_u64());
+        log.set_depth(self.depth);
+        log.set_refund(refund.low_u64());
+
+        // Stack, memory, return data and storage may contain decrypted contract state,
+        // therefore they are collected only by trace nodes
+        if !self.options.get_traceNode() {
+            self.struct_logs.push(log);
+            return true;
+        }
+
+        if !self.options.get_disableStack() {
+            let stack = machine
+                .stack
+                .data()
+                .iter()
+                .map(|value| value.as_bytes().to_vec())
+                .collect();
+            log.set_stack(RepeatedField::from_vec(stack));
+        }
+
+        if self.options.get_enableMemory() {
+            log.set_memory(machine.memory.data().clone());
+        }
+
+        if self.options.get_enableReturnData() {
+            log.set_returnData(machine.state.as_ref().retbuf.clone());
+        }
+
+        if !self.options.get_disableStorage() && (opcode == Opcode::SLOAD || opcode == Opcode::SSTORE) {
+            let address = machine.state.as_ref().context.address;
+            let slot = match opcode {
+                Opcode::SLOAD => machine
+                    .stack
+                    .peek(0)
+                    .ok()
+                    .map(|key| (key, handler.storage(address, key))),
+                _ => match (machine.stack.peek(0), machine.stack.peek(1)) {
+                    (Ok(key), Ok(value)) => Some((key, value)),
+                    _ => None,
+                },
+            };
+
+            let storage = self.storage.entry(address).or_default();
+            if let Some((key, value)) = slot {
+                storage.insert(key, value);
+            }
+
+            let entries = storage
+                .iter()
+                .map(|(key, value)| {
+                    let mut entry = TraceStorageEntry::new();
+                    entry.set_key(key.as_bytes().to_vec());
+                    entry.set_value(value.as_bytes().to_vec());
+                    entry
+                })
+                .collect();
+            log.set_storage(RepeatedField::from_vec(entries));
+        }
+
+        self.struct_logs.push(log);
+        true
+    }
+
+    /// Sets gas cost and error of the last recorded opcode
+    pub fn capture_gas_cost(&mut self, gas_cost: U256, error: Option<&ExitError>) {
+        if let Some(log) = self.struct_logs.last_mut() {
+            log.set_gasCost(gas_cost.low_u64());
+            if let Some(error) = error {
+                log.set_error(format_exit_error(error));
+            }
+        }
+    }
+
+    /// Records start of top-level call or create
+    pub fn capture_start(&mut self, create: bool, from: H160, to: H160, input: &[u8], gas: U256, value: U256) {
+        self.depth = 1;
+        self.excluded.insert(from);
+        self.excluded.insert(to);
+        if self.is_call_tracer() {
+            let call_type = if create { Opcode::CREATE } else { Opcode::CALL };
+            self.callstack = vec![new_call_frame(call_type, from, to, input, gas, value)];
+        }
+    }
+
+    /// Records end of top-level call or create. Gas used is calculated from the gas left in the substate
+    pub fn capture_end(&mut self, output: &[u8], gas_left: U256, result: &ExitResult) {
+        if let Some(frame) = self.callstack.first_mut() {
+            frame.set_gasUsed(frame.get_gas().saturating_sub(gas_left.low_u64()));
+            finish_call_frame(frame, output, result);
+        }
+    }
+
+    /// Records start of nested call or create
+    pub fn capture_enter(&mut self, opcode: Opcode, from: H160, to: H160, input: &[u8], gas: U256, value: U256) {
+        self.depth += 1;
+        if !self.is_call_tracer() {
+            return;
+        }
+        if self.options.get_onlyTopCall() || self.skipped > 0 {
+            self.skipped += 1;
+            return;
+        }
+        self.callstack.push(new_call_frame(opcode, from, to, input, gas, value));
+    }
+
+    /// Records end of nested call or create. Gas used is calculated from the gas left in the substate
+    pub fn capture_exit(&mut self, output: &[u8], gas_left: U256, result: &ExitResult) {
+        self.depth = self.depth.saturating_sub(1);
+        if !self.is_call_tracer() {
+            return;
+        }
+        if self.skipped > 0 {
+            self.skipped -= 1;
+            return;
+        }
+        if self.callstack.len() <= 1 {
+            return;
+        }
+
+        let mut frame = self.callstack.pop().unwrap();
+        frame.set_gasUsed(frame.get_gas().saturating_sub(gas_left.low_u64()));
+        finish_call_frame(&mut frame, output, result);
+
+        let parent = self.callstack.last_mut().unwrap();
+        parent.mut_calls().push(frame);
+    }
+
+    /// Converts collected data into execution trace
+    pub fn into_trace(self) -> ExecutionTrace {
+        let mut trace = ExecutionTrace::new();
+        if self.is_struct_tracer() {
+            trace.set_structLogs(RepeatedField::from_vec(self.struct_logs));
+        } else if self.is_access_list_tracer() {
+            let access_list = self
+                .access_list
+                .into_iter()
+                .map(|(address, slots)| {
+                    let mut item = AccessListItem::new();
+                    item.set_address(address.as_bytes().to_vec());
+                    item.set_storageSlot(slots.into_iter().map(|slot| slot.as_bytes().to_vec()).collect());
+                    item
+                })
+                .collect();
+            trace.set_accessList(RepeatedField::from_vec(access_list));
+        } else if let Some(frame) = self.callstack.into_iter().next() {
+            trace.set_callFrame(frame);
+        }
+        trace
+    }
+}
+
+/// Evaluates gas cost of the opcode and records state of EVM before its execution,
+/// if struct tracer was requested
+pub fn eval_gasometer<'config, S, H, Tr>(
+    tracer: &RefCell<Option<ExecutionTracer>>,
+    machine: &mut Machine<S>,
+    handler: &mut H,
+    opcode: Opcode,
+    position: usize,
+) -> Control<Tr>
+where
+    S: AsRef<GasometerState<'config>>
+        + AsMut<GasometerState<'config>>
+        + AsRef<RuntimeState>
+        + GasState
+        + InvokerState<'config>,
+    H: RuntimeEnvironment + RuntimeBackend,
+    Tr: From<CallCreateTrap>,
+{
+    let gas = machine.state.gas();
+    // Refund, which is already capped according to EIP-3529
+    let refund = machine.state.effective_gas().saturating_sub(gas);
+    let captured = match tracer.borrow_mut().as_mut() {
+        Some(tracer) => tracer.capture_state(machine, handler, opcode, position, gas, refund),
+        None => false,
+    };
+
+    let control = evm::standard::eval_gasometer(machine, handler, opcode, position);
+
+    if captured {
+        let error = match &control {
+            Control::Exit(Err(err)) => Some(err),
+            _ => None,
+        };
+        if let Some(tracer) = tracer.borrow_mut().as_mut() {
+            tracer.capture_gas_cost(gas.saturating_sub(machine.state.gas()), error);
+        }
+    }
+
+    control
+}
+
+fn new_call_frame(opcode: Opcode, from: H160, to: H160, input: &[u8], gas: U256, value: U256) -> TraceCallFrame {
+    let mut value_bytes = [0u8; 32];
+    value.to_big_endian(&mut value_bytes);
+
+    let mut frame = TraceCallFrame::new();
+    frame.set_field_type(opcode_name(opcode.0));
+    frame.set_from(from.as_bytes().to_vec());
+    frame.set_to(to.as_bytes().to_vec());
+    frame.set_input(input.to_vec());
+    frame.set_gas(gas.low_u64());
+    frame.set_value(value_bytes.to_vec());
+    frame
+}
+
+fn finish_call_frame(frame: &mut TraceCallFrame, output: &[u8], result: &ExitResult) {
+    match result {
+        Ok(_) => frame.set_output(output.to_vec()),
+        Err(err) => {
+            frame.set_error(format_exit_error(err));
+            // Output is kept only for reverted calls, as it contains revert reason
+            if let ExitError::Reverted = err {
+                frame.set_output(output.to_vec());
+            }
+        }
+    }
+}
+
+/// Formats error of the traced frame. Revert uses the same message as go-ethereum tracers
+fn format_exit_error(error: &ExitError) -> String {
+    match error {
+        ExitError::Reverted => "execution reverted".to_string(),
+        ExitError::Fatal(fatal) => format!("{:?}", fatal),
+        ExitError::Exception(exit) => format!("{:?}", exit),
+    }
+}
+
+/// Returns name of the opcode in the same format as go-ethereum
+fn opcode_name(opcode: u8) -> String {
+    let name = match opcode {
+        0x00 => "STOP",
+        0x01 => "ADD",
+        0x02 => "MUL",
+        0x03 => "SUB",
+        0x04 => "DIV",
+        0x05 => "SDIV",
+        0x06 => "MOD",
+        0x07 => "SMOD",
+        0x08 => "ADDMOD",
+        0x09 => "MULMOD",
+        0x0a => "EXP",
+        0x0b => "SIGNEXTEND",
+        0x10 => "LT",
+        0x11 => "GT",
+        0x12 => "SLT",
+        0x13 => "SGT",
+        0x14 => "EQ",
+        0x15 => "ISZERO",
+        0x16 => "AND",
+        0x17 => "OR",
+        0x18 => "XOR",
+        0x19 => "NOT",
+        0x1a => "BYTE",
+        0x1b => "SHL",
+        0x1c => "SHR",
+        0x1d => "SAR",
+        0x20 => "KECCAK256",
+        0x30 => "ADDRESS",
+        0x31 => "BALANCE",
+        0x32 => "ORIGIN",
+        0x33 => "CALLER",
+        0x34 => "CALLVALUE",
+        0x35 => "CALLDATALOAD",
+        0x36 => "CALLDATASIZE",
+        0x37 => "CALLDATACOPY",
+        0x38 => "CODESIZE",
+        0x39 => "CODECOPY",
+        0x3a => "GASPRICE",
+        0x3b => "EXTCODESIZE",
+        0x3c => "EXTCODECOPY",
+        0x3d => "RETURNDATASIZE",
+        0x3e => "RETURNDATACOPY",
+        0x3f => "EXTCODEHASH",
+        0x40 => "BLOCKHASH",
+        0x41 => "COINBASE",
+        0x42 => "TIMESTAMP",
+        0x43 => "NUMBER",
+        0x44 => "DIFFICULTY",
+        0x45 => "GASLIMIT",
+        0x46 => "CHAINID",
+        0x47 => "SELFBALANCE",
+        0x48 => "BASEFEE",
+        0x49 => "BLOBHASH",
+        0x4a => "BLOBBASEFEE",
+        0x50 => "POP",
+        0x51 => "MLOAD",
+        0x52 => "MSTORE",
+        0x53 => "MSTORE8",
+        0x54 => "SLOAD",
+        0x55 => "SSTORE",
+        0x56 => "JUMP",
+        0x57 => "JUMPI",
+        0x58 => "PC",
+        0x59 => "MSIZE",
+        0x5a => "GAS",
+        0x5b => "JUMPDEST",
+        0x5c => "TLOAD",
+        0x5d => "TSTORE",
+        0x5e => "MCOPY",
+        0x5f => "PUSH0",
+        0x60..=0x7f => return format!("PUSH{}", opcode - 0x5f),
+        0x80..=0x8f => return format!("DUP{}", opcode - 0x7f),
+        0x90..=0x9f => return format!("SWAP{}", opcode - 0x8f),
+        0xa0..=0xa4 => return format!("LOG{}", opcode - 0xa0),
+        0xf0 => "CREATE",
+        0xf1 => "CALL",
+        0xf2 => "CALLCODE",
+        0xf3 => "RETURN",
+        0xf4 => "DELEGATECALL",
+        0xf5 => "CREATE2",
+        0xfa => "STATICCALL",
+        0xfd => "REVERT",
+        0xfe => "INVALID",
+        0xff => "SELFDESTRUCT",
+        _ => return format!("opcode {:#x} not defined", opcode),
+    };
+    name.to_string()
+}
@@ -10,9 +10,16 @@ use std::{
 use std::boxed::Box;
 use sgx_types::*;
 use crate::error::Error;
+use crate::protobuf_generated::ffi::ExecutionTrace;
 
 pub static GASOMETER_CONFIG: Config = Config::cancun();
 
+/// Intrinsic gas charged for each authorization of EIP-7702 transaction
+pub const PER_EMPTY_ACCOUNT_COST: u64 = 25000;
+
+/// Prefix of EIP-7702 delegation designator, which is stored as code of delegating account
+pub const DELEGATION_PREFIX: [u8; 3] = [0xef, 0x01, 0x00];
+
 /// A key-value storage trait
 pub trait Storage {
     /// Checks if there is entity with such key exists in DB
@@ -70,7 +77,9 @@ pub struct ExecutionResult {
     pub logs: Vec<Log>,
     pub data: Vec<u8>,
     pub gas_used: u64,
-    pub vm_error: String
+    pub gas_refund: u64,
+    pub vm_error: String,
+    pub trace: Option<ExecutionTrace>,
 }
 
 impl ExecutionResult {
@@ -79,12 +88,14 @@ impl ExecutionResult {
         Self {
             logs: Vec::default(),
             gas_used: gas_used.unwrap_or(21000), // This is minimum gas fee to apply the transaction
+            gas_refund: 0,
             vm_error: reason,
             data,
+            trace: None,
         }
     }
 
-    pub fn from_exit_error(error: ExitError, data: Vec<u8>, gas_used: u64) -> Self {
+    pub fn from_exit_error(error: ExitError, data: Vec<u8>, gas_used: u64, gas_refund: u64) -> Self {
         let vm_error = match error {
             ExitError::Reverted => "reverted".to_string(),
             ExitError::Fatal(fatal) => {
@@ -95,7 +106,7 @@ impl ExecutionResult {
             }
         };
 
-        ExecutionResult { logs: vec![], data, gas_used, vm_error }
+        ExecutionResult { logs: vec![], data, gas_used, gas_refund, vm_error, trace: None }
     }
 }
 
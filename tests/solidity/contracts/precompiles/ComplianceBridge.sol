@@ -65,6 +65,10 @@ interface IComplianceBridge {
 
     function getIssuanceTreeRoot() external returns (bytes memory);
 
+    function getRevocationTreeRootAtHeight(uint256 blockHeight) external returns (bytes memory);
+
+    function getIssuanceTreeRootAtHeight(uint256 blockHeight) external returns (bytes memory);
+
     function revokeVerification(bytes memory verificationId) external;
 
     function convertCredential(bytes memory verificationId, bytes memory publicKey) external returns (bytes memory);
@@ -204,6 +208,18 @@ contract ComplianceProxy {
         return data;
     }
 
+    function getIssuanceRootAtHeight(uint256 blockHeight) public view returns (bytes memory) {
+        bytes memory payload = abi.encodeCall(IComplianceBridge.getIssuanceTreeRootAtHeight, (blockHeight));
+        (bool success, bytes memory data) = address(1028).staticcall(payload);
+        return data;
+    }
+
+    function getRevocationRootAtHeight(uint256 blockHeight) public view returns (bytes memory) {
+        bytes memory payload = abi.encodeCall(IComplianceBridge.getRevocationTreeRootAtHeight, (blockHeight));
+        (bool success, bytes memory data) = address(1028).staticcall(payload);
+        return data;
+    }
+
     function revokeVerification(bytes memory verificationId) public{
         bytes memory payload = abi.encodeCall(IComplianceBridge.revokeVerification,(verificationId));
         (bool success, bytes memory data) = address(1028).call(payload);
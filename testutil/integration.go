@@ -7,6 +7,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -41,6 +42,35 @@ func SubmitProposal(
 	return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "SubmitProposal failed")
 }
 
+// SubmitGovProposal delivers a gov v1 submit proposal tx, which contains provided messages
+func SubmitGovProposal(
+	ctx sdk.Context,
+	app *app.App,
+	priv cryptotypes.PrivKey,
+	msgs []sdk.Msg,
+) (event *abci.Event, err error) {
+	accountAddress := sdk.AccAddress(priv.PubKey().Address().Bytes())
+	stakeDenom := stakingtypes.DefaultParams().BondDenom
+
+	deposit := sdk.NewCoins(sdk.NewCoin(stakeDenom, math.NewInt(100000000)))
+	msg, err := govv1.NewMsgSubmitProposal(msgs, deposit, accountAddress.String(), "", "test title", "test summary")
+	if err != nil {
+		return nil, err
+	}
+	res, err := DeliverTx(ctx, app, priv, nil, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	events := res.GetEvents()
+	for _, event := range events {
+		if event.Type == "submit_proposal" && event.Attributes[0].Key == "proposal_id" {
+			return &event, nil
+		}
+	}
+	return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "SubmitGovProposal failed")
+}
+
 // Delegate delivers a delegate tx
 func Delegate(
 	ctx sdk.Context,
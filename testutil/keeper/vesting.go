@@ -70,6 +70,7 @@ func VestingKeeper(t testing.TB) (*keeper.Keeper, *authkeeper.AccountKeeper, *ba
 		paramsSubspace,
 		ak,
 		bk,
+		nil,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
@@ -38,4 +38,7 @@ var (
 
 	// ErrUnmarshalBigInt returns an error resulting from unmarshaling a big.Int from a string.
 	ErrUnmarshalBigInt = errorsmod.Register(RootCodespace, 6, "cannot unmarshal big.Int from string")
+
+	// ErrIndexerPruned returns an error resulting from a query of block, which was pruned by the custom indexer.
+	ErrIndexerPruned = errorsmod.Register(RootCodespace, 7, "block is pruned by indexer")
 )
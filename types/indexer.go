@@ -19,16 +19,64 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
 	IndexBlock(*tmtypes.Block, []*abci.ResponseDeliverTx) error
 
+	// Prune removes indexed data of blocks below retain height.
+	Prune(retainHeight int64) error
+	// PrunedHeight returns the height, below which blocks were pruned, returns 0 if indexer was never pruned.
+	PrunedHeight() (int64, error)
+
 	// GetByTxHash returns nil if tx not found.
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// FirstAvailableBlock returns the lowest block, which can be served by the indexer. Blocks below it
+// were either pruned or never indexed, e.g. if indexer was enabled after the node had synced them.
+func FirstAvailableBlock(idxer EVMTxIndexer) (int64, error) {
+	prunedHeight, err := idxer.PrunedHeight()
+	if err != nil {
+		return 0, err
+	}
+	first, err := idxer.FirstIndexedBlock()
+	if err != nil {
+		return 0, err
+	}
+	if first > prunedHeight {
+		return first, nil
+	}
+	return prunedHeight, nil
+}
+
+// EVMLogIndexer defines the interface of eth tx indexer, which also indexes emitted logs
+// by contract address and topics, so logs can be queried without scanning block results.
+type EVMLogIndexer interface {
+	EVMTxIndexer
+
+	// LogIndexedRange returns the first and the last block of the latest range of blocks with indexed logs,
+	// returns -1 if there are no such blocks
+	LogIndexedRange() (int64, int64, error)
+	// GetLogs returns logs matching the filter ordered by block number, tx index and log index.
+	GetLogs(LogFilter) ([]*ethtypes.Log, error)
+}
+
+// LogFilter defines criteria of logs query to EVMLogIndexer
+type LogFilter struct {
+	// FromBlock and ToBlock define inclusive range of blocks
+	FromBlock int64
+	ToBlock   int64
+	Addresses []common.Address
+	Topics    [][]common.Hash
+	// Limit is a maximum number of returned logs, query fails if there are more matching logs. Zero means no limit
+	Limit int
+}
@@ -2,15 +2,26 @@ package cli
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/spf13/cobra"
 
 	"swisstronik/x/compliance/types"
 )
 
+const (
+	FlagVerificationType = "type"
+	FlagRevocationStatus = "revocation-status"
+	FlagExpiresAfter     = "expires-after"
+	FlagExpiresBefore    = "expires-before"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,6 +43,12 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetVerificationsDetails(),
 		CmdGetHolderByVerificationId(),
 		CmdGetHolderPublicKey(),
+		CmdGetVerificationsExpiringBetween(),
+		CmdGetVerificationsByIssuer(),
+		CmdGetIssuerAliasHistory(),
+		CmdGetVerificationRequests(),
+		CmdGetRootHistory(),
+		CmdGetCredentialPackage(),
 	)
 
 	return cmd
@@ -316,3 +333,262 @@ func CmdGetHolderPublicKey() *cobra.Command {
 
 	return cmd
 }
+
+func CmdGetVerificationsExpiringBetween() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-verifications-expiring-between [start-timestamp] [end-timestamp]",
+		Short: "Returns verifications, which expire within provided time range (unix timestamps, inclusive)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTimestamp, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			endTimestamp, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryVerificationsExpiringBetweenRequest{
+				StartTimestamp: uint32(startTimestamp),
+				EndTimestamp:   uint32(endTimestamp),
+				Pagination:     pageReq,
+			}
+
+			resp, err := queryClient.VerificationsExpiringBetween(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiring verifications")
+
+	return cmd
+}
+
+func CmdGetVerificationsByIssuer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-verifications-by-issuer [bech32-or-hex-address]",
+		Short: "Returns verifications issued by provided issuer, filtered by type, revocation status and expiration window",
+		Long: "Returns verifications issued by provided issuer. If issuer address is omitted, verifications of type " +
+			"provided by --type flag are returned. Expiration window bounds are unix timestamps (inclusive), " +
+			"verifications without expiration timestamp are treated as never expiring.",
+		Example: fmt.Sprintf(
+			"$ %s query %s get-verifications-by-issuer swtr1... --type kyc --revocation-status not-revoked --expires-after 1735689600",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVerificationsByIssuerRequest{}
+			if len(args) > 0 {
+				address, err := types.ParseAddress(args[0])
+				if err != nil {
+					return err
+				}
+				req.IssuerAddress = address.String()
+			}
+
+			verificationType, err := cmd.Flags().GetString(FlagVerificationType)
+			if err != nil {
+				return err
+			}
+			if verificationType != "" {
+				if req.VerificationType, err = types.ParseVerificationType(verificationType); err != nil {
+					return err
+				}
+			}
+			if req.IssuerAddress == "" && req.VerificationType == types.VerificationType_VT_UNSPECIFIED {
+				return fmt.Errorf("either issuer address or --%s flag should be provided", FlagVerificationType)
+			}
+
+			revocationStatus, err := cmd.Flags().GetString(FlagRevocationStatus)
+			if err != nil {
+				return err
+			}
+			switch revocationStatus {
+			case "any":
+				req.RevocationStatus = types.RevocationStatusFilter_RSF_ANY
+			case "revoked":
+				req.RevocationStatus = types.RevocationStatusFilter_RSF_REVOKED
+			case "not-revoked":
+				req.RevocationStatus = types.RevocationStatusFilter_RSF_NOT_REVOKED
+			default:
+				return fmt.Errorf("invalid revocation status: %s, expected one of any, revoked, not-revoked", revocationStatus)
+			}
+
+			if req.ExpiresAfter, err = cmd.Flags().GetUint32(FlagExpiresAfter); err != nil {
+				return err
+			}
+			if req.ExpiresBefore, err = cmd.Flags().GetUint32(FlagExpiresBefore); err != nil {
+				return err
+			}
+
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			resp, err := queryClient.VerificationsByIssuer(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagVerificationType, "", "Verification type (for example, kyc or VT_KYC). Verifications of any type are returned if omitted")
+	cmd.Flags().String(FlagRevocationStatus, "any", "Revocation status of verifications: any, revoked or not-revoked")
+	cmd.Flags().Uint32(FlagExpiresAfter, 0, "Inclusive lower bound of expiration timestamp")
+	cmd.Flags().Uint32(FlagExpiresBefore, 0, "Inclusive upper bound of expiration timestamp. No upper bound if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "verifications")
+
+	return cmd
+}
+
+func CmdGetIssuerAliasHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-issuer-alias-history [bech32-or-hex-address]",
+		Short: "Returns actual issuer address and history of issuer migrations, which include provided address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := types.ParseAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIssuerAliasHistoryRequest{
+				IssuerAddress: address.String(),
+			}
+
+			resp, err := queryClient.IssuerAliasHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetVerificationRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-verification-requests [bech32-or-hex-address]",
+		Short: "Returns pending verification requests, which were emitted by compliance registry contract for provided issuer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := types.ParseAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryVerificationRequestsRequest{
+				IssuerAddress: address.String(),
+				Pagination:    pageReq,
+			}
+
+			resp, err := queryClient.VerificationRequests(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "verification requests")
+
+	return cmd
+}
+
+func CmdGetRootHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-root-history",
+		Short: "Returns roots of issuance and revocation trees for recent blocks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.RootHistory(context.Background(), &types.QueryRootHistoryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetCredentialPackage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-credential-package [base64-encoded verification id] [hex-encoded compressed holder public key]",
+		Short: "Returns ZK credential with issuance and non-revocation proofs, which can be verified off-chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			verificationId, err := base64.StdEncoding.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			holderPublicKey, err := hexutil.Decode(args[1])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCredentialPackageRequest{
+				VerificationId:  verificationId,
+				HolderPublicKey: holderPublicKey,
+			}
+
+			resp, err := queryClient.CredentialPackage(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -12,13 +12,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
-	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/spf13/cobra"
 
 	"swisstronik/x/compliance/types"
 )
 
+const FlagRevocationNote = "note"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -36,9 +40,17 @@ func GetTxCmd() *cobra.Command {
 		CmdCreateIssuer(),
 		CmdUpdateIssuerDetails(),
 		CmdRemoveIssuer(),
+		CmdMigrateIssuer(),
+		CmdSetIssuerVerificationTypes(),
+		CmdSetIssuerQuota(),
 		CmdConvertCredentialToZK(),
 		CmdAttachHolderPublicKey(),
 		CmdRevokeVerification(),
+		CmdRevokeVerifications(),
+		CmdVerifyIssuerProposal(),
+		CmdUnverifyIssuerProposal(),
+		CmdAddOperatorProposal(),
+		CmdRemoveOperatorProposal(),
 	)
 
 	return cmd
@@ -259,46 +271,202 @@ func CmdRemoveIssuer() *cobra.Command {
 	return cmd
 }
 
-func CmdVerifyIssuerProposal() *cobra.Command {
+// CmdMigrateIssuer command moves issuer to the new address.
+func CmdMigrateIssuer() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "verify-issuer [issuer-address]",
-		Args:    cobra.MinimumNArgs(1),
-		Short:   "Submit a proposal to verify issuer",
-		Long:    "Submit a proposal to verify issuer along with an initial deposit.",
-		Example: fmt.Sprintf("$ %s tx gov submit-legacy-proposal verify-issuer <issuer address>", version.AppName),
+		Use:   "migrate-issuer [issuer-address] [new-issuer-address]",
+		Short: "Move issuer details, verification status and issued verifications to the new address. Old address is kept as an alias",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			issuerAddress, err := types.ParseAddress(args[0])
+			if err != nil {
+				return err
+			}
+			newIssuerAddress, err := types.ParseAddress(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateIssuer(
+				clientCtx.GetFromAddress().String(),
+				issuerAddress.String(),
+				newIssuerAddress.String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdSetIssuerVerificationTypes command sets verification types, which issuer is allowed to issue.
+func CmdSetIssuerVerificationTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-issuer-verification-types [issuer-address] [verification-type]...",
+		Short: "Set verification types allowed for issuer. If no verification type provided, issuer can issue verifications of any type",
+		Example: fmt.Sprintf(
+			"%s tx compliance set-issuer-verification-types swtr1... VT_KYC VT_AML --from operator",
+			version.AppName,
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			issuerAddress, err := types.ParseAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			var verificationTypes []types.VerificationType
+			for _, arg := range args[1:] {
+				verificationType, err := types.ParseVerificationType(arg)
+				if err != nil {
+					return err
+				}
+				verificationTypes = append(verificationTypes, verificationType)
+			}
+
+			msg := types.NewMsgSetIssuerVerificationTypes(
+				clientCtx.GetFromAddress().String(),
+				issuerAddress.String(),
+				verificationTypes,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdSetIssuerQuota command sets limit of verifications, which issuer can issue within a window of blocks.
+func CmdSetIssuerQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-issuer-quota [issuer-address] [max-issuances] [window-blocks]",
+		Short: "Set maximum number of verifications issuer can issue within window of blocks. Zero max-issuances removes the limit",
+		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			issuerAddress, err := types.ParseAddress(args[0])
+			if err != nil {
+				return err
+			}
+			maxIssuances, err := strconv.ParseUint(args[1], 10, 64)
 			if err != nil {
 				return err
 			}
+			windowBlocks, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetIssuerQuota(
+				clientCtx.GetFromAddress().String(),
+				issuerAddress.String(),
+				maxIssuances,
+				windowBlocks,
+			)
 
-			description, err := cmd.Flags().GetString(cli.FlagDescription) //nolint:staticcheck
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRevokeVerifications returns cobra command to revoke multiple verifications with provided reason.
+// Verifications can be revoked by operator or creator of their issuers.
+func CmdRevokeVerifications() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-verifications [reason] [base64-encoded verification id]...",
+		Short: "Revokes multiple verifications with provided reason by issuer creator or operator",
+		Long: `Revokes multiple verifications at once. Reason is one of:
+key_compromise, issuer_compromise, issued_by_mistake, superseded, holder_request, fraud, other`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			reason, err := types.ParseRevocationReason(args[0])
 			if err != nil {
 				return err
 			}
 
-			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			var verificationIds [][]byte
+			for _, arg := range args[1:] {
+				verificationId, err := base64.StdEncoding.DecodeString(arg)
+				if err != nil {
+					return err
+				}
+				verificationIds = append(verificationIds, verificationId)
+			}
+
+			note, err := cmd.Flags().GetString(FlagRevocationNote)
 			if err != nil {
 				return err
 			}
 
-			issuerAddress := args[0]
-			from := clientCtx.GetFromAddress()
+			msg := types.NewMsgRevokeVerifications(
+				clientCtx.GetFromAddress().String(),
+				verificationIds,
+				reason,
+				note,
+			)
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
 
-			// Verified issuer can't create proposal
+	cmd.Flags().String(FlagRevocationNote, "", "optional note, which describes revocation")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdVerifyIssuerProposal command submits a governance proposal to verify issuer.
+func CmdVerifyIssuerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "propose-verify-issuer [issuer-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to verify issuer",
+		Long:    "Submit a governance proposal to verify issuer along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx compliance propose-verify-issuer <issuer address> --title=<title> --summary=<summary> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			issuer, err := types.ParseAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Verified issuer can't be verified again
 			queryClient := types.NewQueryClient(clientCtx)
 			addressDetails, err := queryClient.AddressDetails(cmd.Context(), &types.QueryAddressDetailsRequest{
-				Address: issuerAddress,
+				Address: issuer.String(),
 			})
 			if err != nil {
 				return err
@@ -307,30 +475,162 @@ func CmdVerifyIssuerProposal() *cobra.Command {
 				return errors.New("issuer was already verified")
 			}
 
-			content := types.NewVerifyIssuerProposal(title, description, issuerAddress)
+			msg := types.NewMsgVerifyIssuer(govAuthority(), issuer.String())
+			return submitGovProposal(cmd, clientCtx, &msg)
+		},
+	}
+
+	addGovProposalFlags(cmd)
+	return cmd
+}
 
-			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+// CmdUnverifyIssuerProposal command submits a governance proposal to revoke issuer verification.
+func CmdUnverifyIssuerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "propose-unverify-issuer [issuer-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to revoke issuer verification",
+		Long:    "Submit a governance proposal to revoke verification status of issuer along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx compliance propose-unverify-issuer <issuer address> --title=<title> --summary=<summary> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			issuer, err := types.ParseAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnverifyIssuer(govAuthority(), issuer.String())
+			return submitGovProposal(cmd, clientCtx, &msg)
 		},
 	}
 
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// CmdAddOperatorProposal command submits a governance proposal to add regular operator.
+func CmdAddOperatorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "propose-add-operator [operator-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to add regular operator",
+		Long:    "Submit a governance proposal to add regular operator along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx compliance propose-add-operator <operator address> --title=<title> --summary=<summary> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operator, err := types.ParseAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGovAddOperator(govAuthority(), operator.String())
+			return submitGovProposal(cmd, clientCtx, &msg)
+		},
+	}
+
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// CmdRemoveOperatorProposal command submits a governance proposal to remove regular operator.
+func CmdRemoveOperatorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "propose-remove-operator [operator-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to remove regular operator",
+		Long:    "Submit a governance proposal to remove regular operator along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx compliance propose-remove-operator <operator address> --title=<title> --summary=<summary> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operator, err := types.ParseAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGovRemoveOperator(govAuthority(), operator.String())
+			return submitGovProposal(cmd, clientCtx, &msg)
+		},
+	}
+
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// govAuthority returns address of x/gov module account, which executes passed proposals
+func govAuthority() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+// addGovProposalFlags adds common flags for commands which submit gov v1 proposals
+func addGovProposalFlags(cmd *cobra.Command) {
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
-	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
-	cmd.Flags().String(cli.FlagDeposit, "1aswtr", "deposit of proposal")
+	cmd.Flags().String(cli.FlagSummary, "", "summary of proposal")
+	cmd.Flags().String(cli.FlagMetadata, "", "metadata of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
 	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
 		panic(err)
 	}
-	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+	if err := cmd.MarkFlagRequired(cli.FlagSummary); err != nil {
 		panic(err)
 	}
 	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
 		panic(err)
 	}
-	return cmd
+	flags.AddTxFlagsToCmd(cmd)
+}
+
+// submitGovProposal wraps provided message into gov v1 proposal and broadcasts it
+func submitGovProposal(cmd *cobra.Command, clientCtx client.Context, msg sdk.Msg) error {
+	title, err := cmd.Flags().GetString(cli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	summary, err := cmd.Flags().GetString(cli.FlagSummary)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := cmd.Flags().GetString(cli.FlagMetadata)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	proposal, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{msg},
+		deposit,
+		clientCtx.GetFromAddress().String(),
+		metadata,
+		title,
+		summary,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
 }
 
 // CmdAttachHolderPublicKey command attaches holder BJJ public key if it was not done before.
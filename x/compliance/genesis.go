@@ -41,6 +41,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	// Restore issuer migrations, so verifications of migrated issuers can be resolved
+	for _, migration := range genState.IssuerMigrations {
+		if err := k.SetIssuerMigration(ctx, migration); err != nil {
+			panic(err)
+		}
+	}
+
 	// Restore linked public keys to verification id
 	for _, verificationToPublicKeyData := range genState.LinksToPublicKey {
 		if verificationToPublicKeyData.Id == nil {
@@ -76,7 +83,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		if err != nil {
 			panic(err)
 		}
-		if exists, err := k.IssuerExists(ctx, address); !exists || err != nil {
+		if exists, err := k.IssuerExists(ctx, k.ResolveIssuerAddress(ctx, address)); !exists || err != nil {
 			panic(err)
 		}
 		// Check the issuance timestamp and proof
@@ -110,6 +117,32 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			if err = k.MarkVerificationDetailsAsRevoked(ctx, verificationData.Id); err != nil {
 				panic(err)
 			}
+			// Revocation details are restored from genesis state below
+			k.RemoveRevocationDetails(ctx, verificationData.Id)
+		}
+	}
+
+	// Restore revocation details
+	for _, revocationData := range genState.RevocationDetails {
+		if revocationData.Id == nil || revocationData.Details == nil {
+			panic(errors.Wrap(types.ErrInvalidParam, "given revocation details are empty"))
+		}
+		if !revocationData.Details.Reason.IsValid() {
+			panic(errors.Wrap(types.ErrInvalidParam, "invalid revocation reason"))
+		}
+		if err := k.SetRevocationDetails(ctx, revocationData.Id, revocationData.Details); err != nil {
+			panic(err)
+		}
+	}
+
+	// Restore pending verification requests. Issuer migrations are already restored,
+	// so requests are stored by actual issuer address
+	for _, request := range genState.VerificationRequests {
+		if !request.Type.IsValid() {
+			panic(errors.Wrap(types.ErrInvalidParam, "invalid type of verification request"))
+		}
+		if err := k.SetVerificationRequest(ctx, request); err != nil {
+			panic(err)
 		}
 	}
 
@@ -189,5 +222,23 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.LinksToPublicKey = linksToPublicKey
 
+	issuerMigrations, err := k.ExportIssuerMigrations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.IssuerMigrations = issuerMigrations
+
+	revocationDetails, err := k.ExportRevocationDetails(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.RevocationDetails = revocationDetails
+
+	verificationRequests, err := k.ExportVerificationRequests(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.VerificationRequests = verificationRequests
+
 	return genesis
 }
@@ -10,6 +10,7 @@ import (
 
 	testkeeper "swisstronik/testutil/keeper"
 	"swisstronik/x/compliance"
+	"swisstronik/x/compliance/keeper"
 	"swisstronik/x/compliance/types"
 )
 
@@ -254,6 +255,18 @@ func TestInitGenesis_Validation(t *testing.T) {
 			},
 			expPanic: true,
 		},
+		{
+			name: "invalid issuer migration",
+			genState: &types.GenesisState{
+				IssuerMigrations: []*types.IssuerMigration{
+					{
+						OldAddress: "wrong address",
+						NewAddress: "swtr199wynlfwhj6ytkvujjf6mel5z7fl0mwzqck8l6",
+					},
+				},
+			},
+			expPanic: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -470,3 +483,178 @@ func TestGenesis_Success(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesis_IssuerMigrations(t *testing.T) {
+	k, ctx := testkeeper.ComplianceKeeper(t)
+
+	oldIssuer, err := sdk.AccAddressFromBech32("swtr199wynlfwhj6ytkvujjf6mel5z7fl0mwzqck8l6")
+	require.NoError(t, err)
+	newIssuer, err := sdk.AccAddressFromBech32("swtr13wl63dpe3xdhzvphp32cm9cv2vs9nvhkpaspwu")
+	require.NoError(t, err)
+
+	genState := types.GenesisState{
+		IssuerDetails: []*types.GenesisIssuerDetails{
+			{
+				Address: newIssuer.String(),
+				Details: &types.IssuerDetails{
+					Creator: "swtr16vgqffr8v0sh3n5qeqdksfpzdkqf3rtk49thun",
+					Name:    "test issuer",
+				},
+			},
+		},
+		AddressDetails: []*types.GenesisAddressDetails{
+			{
+				Address: "swtr1flhu6pdk2ydrjqryn9utq7v5mxsr8ka67fmjj6",
+				Details: &types.AddressDetails{
+					Verifications: []*types.Verification{{
+						Type:           types.VerificationType_VT_KYC,
+						VerificationId: hexutils.HexToBytes("0273FBBAFFC58F732199B20833643248C213C5DBA8F4A05DF505713FD36B8CE2"),
+						IssuerAddress:  newIssuer.String(),
+					}},
+				},
+			},
+		},
+		IssuerMigrations: []*types.IssuerMigration{
+			{
+				OldAddress:  oldIssuer.String(),
+				NewAddress:  newIssuer.String(),
+				BlockHeight: 10,
+				Timestamp:   1712018692,
+			},
+		},
+		VerificationDetails: []*types.GenesisVerificationDetails{
+			{
+				Id: hexutils.HexToBytes("0273FBBAFFC58F732199B20833643248C213C5DBA8F4A05DF505713FD36B8CE2"),
+				Details: &types.VerificationDetails{
+					Type:                types.VerificationType_VT_KYC,
+					IssuerAddress:       oldIssuer.String(), // verification was issued before migration
+					OriginChain:         "test chain",
+					IssuanceTimestamp:   1712018692,
+					ExpirationTimestamp: 1715018692,
+					OriginalData:        hexutils.HexToBytes("B639DF194671CDE06EFAA368A404F72E3306DF0359117AC7E78EC2BE04B7629D"),
+				},
+			},
+		},
+	}
+	require.NotPanics(t, func() {
+		compliance.InitGenesis(ctx, *k, genState)
+	})
+
+	require.Equal(t, newIssuer, k.ResolveIssuerAddress(ctx, oldIssuer))
+	details, err := k.GetVerificationDetails(ctx, genState.VerificationDetails[0].Id)
+	require.NoError(t, err)
+	require.Equal(t, genState.VerificationDetails[0].Details, details)
+
+	// Verification issued by old address is indexed by actual issuer address
+	verificationsByIssuer := func(k keeper.Keeper, ctx sdk.Context) [][]byte {
+		var ids [][]byte
+		k.IterateVerificationsByIssuer(ctx, newIssuer, types.VerificationType_VT_UNSPECIFIED, func(_ types.VerificationType, id []byte) bool {
+			ids = append(ids, id)
+			return true
+		})
+		return ids
+	}
+	require.Equal(t, [][]byte{genState.VerificationDetails[0].Id}, verificationsByIssuer(*k, ctx))
+
+	got := compliance.ExportGenesis(ctx, *k)
+	require.Equal(t, genState.IssuerMigrations, got.IssuerMigrations)
+
+	// Indexes are rebuilt on import of exported state
+	importedKeeper, importedCtx := testkeeper.ComplianceKeeper(t)
+	require.NotPanics(t, func() {
+		compliance.InitGenesis(importedCtx, *importedKeeper, *got)
+	})
+	require.Equal(t, [][]byte{genState.VerificationDetails[0].Id}, verificationsByIssuer(*importedKeeper, importedCtx))
+}
+
+func TestGenesis_RevocationDetails(t *testing.T) {
+	k, ctx := testkeeper.ComplianceKeeper(t)
+
+	verificationId := hexutils.HexToBytes("0273FBBAFFC58F732199B20833643248C213C5DBA8F4A05DF505713FD36B8CE2")
+	genState := types.GenesisState{
+		IssuerDetails: []*types.GenesisIssuerDetails{
+			{
+				Address: "swtr199wynlfwhj6ytkvujjf6mel5z7fl0mwzqck8l6",
+				Details: &types.IssuerDetails{
+					Creator: "swtr16vgqffr8v0sh3n5qeqdksfpzdkqf3rtk49thun",
+					Name:    "test issuer",
+				},
+			},
+		},
+		AddressDetails: []*types.GenesisAddressDetails{
+			{
+				Address: "swtr1flhu6pdk2ydrjqryn9utq7v5mxsr8ka67fmjj6",
+				Details: &types.AddressDetails{
+					Verifications: []*types.Verification{{
+						Type:           types.VerificationType_VT_KYC,
+						VerificationId: verificationId,
+						IssuerAddress:  "swtr199wynlfwhj6ytkvujjf6mel5z7fl0mwzqck8l6",
+					}},
+				},
+			},
+		},
+		VerificationDetails: []*types.GenesisVerificationDetails{
+			{
+				Id: verificationId,
+				Details: &types.VerificationDetails{
+					Type:                types.VerificationType_VT_KYC,
+					IssuerAddress:       "swtr199wynlfwhj6ytkvujjf6mel5z7fl0mwzqck8l6",
+					OriginChain:         "test chain",
+					IssuanceTimestamp:   1712018692,
+					ExpirationTimestamp: 1715018692,
+					OriginalData:        hexutils.HexToBytes("B639DF194671CDE06EFAA368A404F72E3306DF0359117AC7E78EC2BE04B7629D"),
+					IsRevoked:           true,
+				},
+			},
+		},
+		RevocationDetails: []*types.GenesisRevocationDetails{
+			{
+				Id: verificationId,
+				Details: &types.RevocationDetails{
+					Reason:      types.RevocationReason_RR_FRAUD,
+					Note:        "test note",
+					BlockHeight: 10,
+					Timestamp:   1712018700,
+				},
+			},
+		},
+	}
+	require.NotPanics(t, func() {
+		compliance.InitGenesis(ctx, *k, genState)
+	})
+
+	revocation, err := k.GetRevocationDetails(ctx, verificationId)
+	require.NoError(t, err)
+	require.Equal(t, genState.RevocationDetails[0].Details, revocation)
+
+	got := compliance.ExportGenesis(ctx, *k)
+	require.Equal(t, genState.RevocationDetails, got.RevocationDetails)
+}
+
+func TestGenesis_VerificationRequests(t *testing.T) {
+	k, ctx := testkeeper.ComplianceKeeper(t)
+
+	genState := types.GenesisState{
+		VerificationRequests: []*types.VerificationRequest{
+			{
+				Holder:        "swtr1flhu6pdk2ydrjqryn9utq7v5mxsr8ka67fmjj6",
+				IssuerAddress: "swtr199wynlfwhj6ytkvujjf6mel5z7fl0mwzqck8l6",
+				Type:          types.VerificationType_VT_KYC,
+				TxHash:        "0x0000000000000000000000000000000000000000000000000000000000000001",
+				BlockHeight:   10,
+			},
+		},
+	}
+	require.NotPanics(t, func() {
+		compliance.InitGenesis(ctx, *k, genState)
+	})
+
+	got := compliance.ExportGenesis(ctx, *k)
+	require.Equal(t, genState.VerificationRequests, got.VerificationRequests)
+
+	// Request with invalid verification type cannot be imported
+	genState.VerificationRequests[0].Type = types.VerificationType_VT_UNSPECIFIED
+	require.Panics(t, func() {
+		compliance.InitGenesis(ctx, *k, genState)
+	})
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/compliance/types"
+)
+
+// GetCredentialPackage returns ZK credential of verification with provided id together with
+// proof of its inclusion into issuance tree, proof of its non-inclusion into revocation tree and
+// roots of both trees, so holder can prove ownership of credential off-chain.
+// Package is returned only if credential is linked to provided holder public key.
+func (k Keeper) GetCredentialPackage(ctx sdk.Context, verificationId, holderPublicKey []byte) (*types.CredentialPackage, error) {
+	if len(holderPublicKey) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidParam, "holder public key is not provided")
+	}
+	xCoordPublicKey, err := types.ExtractXCoordinate(holderPublicKey, false)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "cannot parse provided public key: (%s)", err)
+	}
+
+	details, err := k.GetVerificationDetails(ctx, verificationId)
+	if err != nil {
+		return nil, err
+	}
+	if details.IsEmpty() {
+		return nil, errors.Wrap(types.ErrInvalidParam, "verification does not exist")
+	}
+	if details.IsRevoked {
+		return nil, errors.Wrap(types.ErrInvalidParam, "verification was revoked")
+	}
+
+	credential, err := k.GetZKCredentialByVerificationId(ctx, verificationId)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(credential.HolderPublicKey, xCoordPublicKey.Bytes()) {
+		return nil, errors.Wrap(types.ErrInvalidParam, "holder public key does not match public key of credential")
+	}
+	credentialHashBig, err := credential.Hash()
+	if err != nil {
+		return nil, err
+	}
+	credentialHash := common.BigToHash(credentialHashBig)
+
+	issuanceProof, err := k.generateCircomProof(ctx, types.KeyPrefixIssuanceTree, credentialHash)
+	if err != nil {
+		return nil, err
+	}
+	if issuanceProof.Fnc != types.ProofFncInclusion {
+		return nil, errors.Wrap(types.ErrInvalidParam, "credential is not included into issuance tree")
+	}
+
+	nonRevocationProof, err := k.generateCircomProof(ctx, types.KeyPrefixRevocationTree, credentialHash)
+	if err != nil {
+		return nil, err
+	}
+	if nonRevocationProof.Fnc != types.ProofFncNonInclusion {
+		return nil, errors.Wrap(types.ErrInvalidParam, "credential is included into revocation tree")
+	}
+
+	issuanceProofBytes, err := json.Marshal(issuanceProof)
+	if err != nil {
+		return nil, err
+	}
+	nonRevocationProofBytes, err := json.Marshal(nonRevocationProof)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CredentialPackage{
+		VerificationId:     verificationId,
+		Credential:         credential,
+		CredentialHash:     credentialHashBig.Bytes(),
+		IssuanceRoot:       issuanceProof.Root.BigInt().Bytes(),
+		RevocationRoot:     nonRevocationProof.Root.BigInt().Bytes(),
+		IssuanceProof:      issuanceProofBytes,
+		NonRevocationProof: nonRevocationProofBytes,
+		BlockHeight:        ctx.BlockHeight(),
+	}, nil
+}
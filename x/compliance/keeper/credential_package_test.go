@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/tests"
+	"swisstronik/x/compliance/keeper"
+	"swisstronik/x/compliance/types"
+)
+
+func (suite *KeeperTestSuite) TestCredentialPackage() {
+	ctx, _ := suite.ctx.CacheContext()
+	q := keeper.Querier{Keeper: suite.keeper}
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	holder := tests.RandomAccAddress()
+	publicKey := tests.RandomEdDSAPubKey()
+	suite.Require().NoError(suite.keeper.SetHolderPublicKey(ctx, holder, publicKey[:]))
+
+	verificationId := suite.addExpiringVerification(ctx, issuer, holder, 4000000000)
+	revokedId := suite.addExpiringVerification(ctx, issuer, holder, 4000000001)
+	suite.Require().NoError(suite.keeper.RevokeVerification(ctx, revokedId, issuer))
+
+	resp, err := q.CredentialPackage(sdk.WrapSDKContext(ctx), &types.QueryCredentialPackageRequest{
+		VerificationId:  verificationId,
+		HolderPublicKey: publicKey[:],
+	})
+	suite.Require().NoError(err)
+	pkg := resp.Package
+	suite.Require().Equal(verificationId, pkg.VerificationId)
+	suite.Require().Equal(suite.keeper.GetHolderPublicKey(ctx, holder), pkg.Credential.HolderPublicKey)
+	suite.Require().Equal(issuer.Bytes(), pkg.Credential.IssuerAddress)
+
+	credentialHash, err := suite.keeper.GetCredentialHashByVerificationId(ctx, verificationId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(credentialHash, pkg.CredentialHash)
+
+	issuanceRoot, err := suite.keeper.GetIssuanceTreeRoot(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(issuanceRoot.Bytes(), pkg.IssuanceRoot)
+	revocationRoot, err := suite.keeper.GetRevocationTreeRoot(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(revocationRoot.Bytes(), pkg.RevocationRoot)
+
+	suite.Require().NoError(types.VerifyCredentialPackage(pkg))
+
+	// Package is still valid against its roots, when trees are updated
+	suite.addExpiringVerification(ctx, issuer, holder, 4000000002)
+	suite.Require().NoError(types.VerifyCredentialPackage(pkg))
+
+	// Tampered packages are rejected
+	tampered := *pkg
+	credential := *pkg.Credential
+	credential.ExpirationTimestamp++
+	tampered.Credential = &credential
+	suite.Require().Error(types.VerifyCredentialPackage(&tampered))
+
+	tampered = *pkg
+	tampered.IssuanceRoot = revocationRoot.Bytes()
+	suite.Require().Error(types.VerifyCredentialPackage(&tampered))
+
+	tampered = *pkg
+	tampered.NonRevocationProof = pkg.IssuanceProof
+	suite.Require().Error(types.VerifyCredentialPackage(&tampered))
+
+	suite.Require().Error(types.VerifyCredentialPackage(nil))
+
+	// Package cannot be created for revoked verification
+	_, err = q.CredentialPackage(sdk.WrapSDKContext(ctx), &types.QueryCredentialPackageRequest{
+		VerificationId:  revokedId,
+		HolderPublicKey: publicKey[:],
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidParam)
+
+	// Package cannot be created for verification of holder without public key
+	anotherId := suite.addExpiringVerification(ctx, issuer, tests.RandomAccAddress(), 4000000000)
+	_, err = q.CredentialPackage(sdk.WrapSDKContext(ctx), &types.QueryCredentialPackageRequest{
+		VerificationId:  anotherId,
+		HolderPublicKey: publicKey[:],
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidParam)
+
+	// Holder public key is required and should match public key of credential
+	_, err = q.CredentialPackage(sdk.WrapSDKContext(ctx), &types.QueryCredentialPackageRequest{VerificationId: verificationId})
+	suite.Require().ErrorIs(err, types.ErrInvalidParam)
+	anotherPublicKey := tests.RandomEdDSAPubKey()
+	_, err = q.CredentialPackage(sdk.WrapSDKContext(ctx), &types.QueryCredentialPackageRequest{
+		VerificationId:  verificationId,
+		HolderPublicKey: anotherPublicKey[:],
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidParam)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"swisstronik/x/compliance/types"
+	evmtypes "swisstronik/x/evm/types"
+)
+
+var _ evmtypes.EvmHooks = EVMHooks{}
+
+// EVMHooks converts logs of compliance registry contract, set in module params,
+// to native compliance events and pending verification requests
+type EVMHooks struct {
+	k Keeper
+}
+
+// EVMHooks returns EVM hooks of compliance module
+func (k Keeper) EVMHooks() EVMHooks {
+	return EVMHooks{k: k}
+}
+
+// PostTxProcessing handles logs of successfully executed ethereum transaction.
+// If any registry log cannot be decoded, the whole transaction is reverted.
+func (h EVMHooks) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	registry, found := h.k.GetParams(ctx).GetComplianceRegistryAddress()
+	if !found {
+		return nil
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != registry {
+			continue
+		}
+
+		event, err := types.ParseRegistryLog(log)
+		if err != nil {
+			return err
+		}
+
+		switch event := event.(type) {
+		case types.RegistryVerificationRequested:
+			err = h.handleVerificationRequested(ctx, receipt, log, event)
+		case types.RegistryVerificationChecked:
+			err = ctx.EventManager().EmitTypedEvent(&types.EventVerificationChecked{
+				Registry: log.Address.Hex(),
+				Holder:   event.Holder.String(),
+				Type:     event.VerificationType,
+				Verified: event.Verified,
+				TxHash:   receipt.TxHash.Hex(),
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h EVMHooks) handleVerificationRequested(
+	ctx sdk.Context,
+	receipt *ethtypes.Receipt,
+	log *ethtypes.Log,
+	event types.RegistryVerificationRequested,
+) error {
+	request := &types.VerificationRequest{
+		Holder:        event.Holder.String(),
+		IssuerAddress: event.Issuer.String(),
+		Type:          event.VerificationType,
+		TxHash:        receipt.TxHash.Hex(),
+		BlockHeight:   ctx.BlockHeight(),
+	}
+	if err := h.k.SetVerificationRequest(ctx, request); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventVerificationRequested{
+		Registry:      log.Address.Hex(),
+		Holder:        request.Holder,
+		IssuerAddress: request.IssuerAddress,
+		Type:          request.Type,
+		TxHash:        request.TxHash,
+	})
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/status-im/keycard-go/hexutils"
+
+	"swisstronik/tests"
+	"swisstronik/x/compliance/keeper"
+	"swisstronik/x/compliance/types"
+)
+
+func (suite *KeeperTestSuite) registryLog(registry common.Address, name string, args ...interface{}) *ethtypes.Log {
+	log, err := types.PackRegistryLog(registry, name, args...)
+	suite.Require().NoError(err)
+	return log
+}
+
+func (suite *KeeperTestSuite) verificationRequests(ctx sdk.Context, issuer sdk.AccAddress) []types.VerificationRequest {
+	resp, err := keeper.Querier{Keeper: suite.keeper}.VerificationRequests(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryVerificationRequestsRequest{IssuerAddress: issuer.String()},
+	)
+	suite.Require().NoError(err)
+	return resp.Requests
+}
+
+// registryEvents returns typed events emitted by EVM hook
+func (suite *KeeperTestSuite) registryEvents(ctx sdk.Context) []interface{} {
+	var result []interface{}
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		switch msg.(type) {
+		case *types.EventVerificationRequested, *types.EventVerificationChecked:
+			result = append(result, msg)
+		}
+	}
+	return result
+}
+
+func (suite *KeeperTestSuite) TestEVMHooksPostTxProcessing() {
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	hooks := suite.keeper.EVMHooks()
+
+	registry := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	issuer := suite.createVerifiedIssuer(ctx)
+	holder := tests.RandomAccAddress()
+	holderAddress := common.BytesToAddress(holder)
+	issuerAddress := common.BytesToAddress(issuer)
+	uint32KYC := uint32(types.VerificationType_VT_KYC)
+
+	receipt := &ethtypes.Receipt{
+		TxHash: common.HexToHash("0x01"),
+		Logs: []*ethtypes.Log{
+			suite.registryLog(registry, types.RegistryEventVerificationRequested, holderAddress, issuerAddress, uint32KYC),
+			suite.registryLog(registry, types.RegistryEventVerificationChecked, holderAddress, uint32KYC, true),
+			// Logs of other contracts are ignored
+			suite.registryLog(common.HexToAddress("0x02"), types.RegistryEventVerificationRequested, holderAddress, issuerAddress, uint32(types.VerificationType_VT_AML)),
+			// Unknown events of registry are ignored
+			{Address: registry, Topics: []common.Hash{common.HexToHash("0x03")}},
+		},
+	}
+
+	// Registry is not set, so logs are not handled
+	suite.Require().NoError(hooks.PostTxProcessing(ctx, nil, receipt))
+	suite.Require().Empty(suite.verificationRequests(ctx, issuer))
+	suite.Require().Empty(suite.registryEvents(ctx))
+
+	params := suite.keeper.GetParams(ctx)
+	params.ComplianceRegistry = registry.Hex()
+	suite.keeper.SetParams(ctx, params)
+
+	suite.Require().NoError(hooks.PostTxProcessing(ctx, nil, receipt))
+
+	expectedRequest := types.VerificationRequest{
+		Holder:        holder.String(),
+		IssuerAddress: issuer.String(),
+		Type:          types.VerificationType_VT_KYC,
+		TxHash:        receipt.TxHash.Hex(),
+		BlockHeight:   10,
+	}
+	suite.Require().Equal([]types.VerificationRequest{expectedRequest}, suite.verificationRequests(ctx, issuer))
+	suite.Require().Equal([]interface{}{
+		&types.EventVerificationRequested{
+			Registry:      registry.Hex(),
+			Holder:        holder.String(),
+			IssuerAddress: issuer.String(),
+			Type:          types.VerificationType_VT_KYC,
+			TxHash:        receipt.TxHash.Hex(),
+		},
+		&types.EventVerificationChecked{
+			Registry: registry.Hex(),
+			Holder:   holder.String(),
+			Type:     types.VerificationType_VT_KYC,
+			Verified: true,
+			TxHash:   receipt.TxHash.Hex(),
+		},
+	}, suite.registryEvents(ctx))
+
+	// Request is removed, when requested verification is issued
+	_, err := suite.keeper.AddVerificationDetails(ctx, holder, types.VerificationType_VT_KYC, &types.VerificationDetails{
+		IssuerAddress:     issuer.String(),
+		OriginChain:       "test chain",
+		IssuanceTimestamp: 1600000000,
+		OriginalData:      hexutils.HexToBytes("B639DF194671CDE06EFAA368A404F72E3306DF0359117AC7E78EC2BE04B7629D"),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.verificationRequests(ctx, issuer))
+}
+
+func (suite *KeeperTestSuite) TestEVMHooksInvalidRegistryLog() {
+	ctx, _ := suite.ctx.CacheContext()
+	hooks := suite.keeper.EVMHooks()
+
+	registry := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	params := suite.keeper.GetParams(ctx)
+	params.ComplianceRegistry = registry.Hex()
+	suite.keeper.SetParams(ctx, params)
+
+	holder := common.BytesToAddress(tests.RandomAccAddress())
+	issuer := common.BytesToAddress(tests.RandomAccAddress())
+	valid := suite.registryLog(registry, types.RegistryEventVerificationRequested, holder, issuer, uint32(types.VerificationType_VT_KYC))
+
+	testCases := []struct {
+		name string
+		log  *ethtypes.Log
+	}{
+		{
+			"missing topic",
+			&ethtypes.Log{Address: registry, Topics: valid.Topics[:2], Data: valid.Data},
+		},
+		{
+			"invalid data",
+			&ethtypes.Log{Address: registry, Topics: valid.Topics, Data: []byte{0x01}},
+		},
+		{
+			"invalid verification type",
+			suite.registryLog(registry, types.RegistryEventVerificationRequested, holder, issuer, uint32(100)),
+		},
+		{
+			"unspecified verification type",
+			suite.registryLog(registry, types.RegistryEventVerificationChecked, holder, uint32(types.VerificationType_VT_UNSPECIFIED), true),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := hooks.PostTxProcessing(ctx, nil, &ethtypes.Receipt{Logs: []*ethtypes.Log{valid, tc.log}})
+			suite.Require().ErrorIs(err, types.ErrInvalidRegistryLog)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVerificationRequestsAfterIssuerMigration() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	oldIssuer := suite.createVerifiedIssuer(ctx)
+	holder := tests.RandomAccAddress()
+	err := suite.keeper.SetVerificationRequest(ctx, &types.VerificationRequest{
+		Holder:        holder.String(),
+		IssuerAddress: oldIssuer.String(),
+		Type:          types.VerificationType_VT_KYC,
+	})
+	suite.Require().NoError(err)
+
+	newIssuer := tests.RandomAccAddress()
+	_, err = suite.keeper.MigrateIssuer(ctx, oldIssuer, newIssuer)
+	suite.Require().NoError(err)
+
+	// Requests are returned by both old and new issuer addresses
+	for _, issuer := range []sdk.AccAddress{oldIssuer, newIssuer} {
+		requests := suite.verificationRequests(ctx, issuer)
+		suite.Require().Len(requests, 1)
+		suite.Require().Equal(newIssuer.String(), requests[0].IssuerAddress)
+	}
+
+	// Request emitted for old issuer address is stored by the new one
+	err = suite.keeper.SetVerificationRequest(ctx, &types.VerificationRequest{
+		Holder:        tests.RandomAccAddress().String(),
+		IssuerAddress: oldIssuer.String(),
+		Type:          types.VerificationType_VT_AML,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.verificationRequests(ctx, newIssuer), 2)
+}
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/x/compliance/types"
+)
+
+// SetVerificationExpiration adds verification to the time-ordered expiration index.
+// Verifications without expiration timestamp are never indexed.
+func (k Keeper) SetVerificationExpiration(ctx sdk.Context, verificationId []byte, expirationTimestamp uint32) {
+	if expirationTimestamp == 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationExpiration)
+	store.Set(types.VerificationExpirationKey(expirationTimestamp, verificationId), []byte{0x01})
+}
+
+// RemoveVerificationExpiration removes verification from the expiration index
+func (k Keeper) RemoveVerificationExpiration(ctx sdk.Context, verificationId []byte, expirationTimestamp uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationExpiration)
+	store.Delete(types.VerificationExpirationKey(expirationTimestamp, verificationId))
+}
+
+// IterateVerificationExpirations iterates over expiration index in ascending order of expiration timestamp.
+// Both `start` and `end` are inclusive.
+func (k Keeper) IterateVerificationExpirations(
+	ctx sdk.Context,
+	start, end uint32,
+	callback func(expirationTimestamp uint32, verificationId []byte) (continue_ bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationExpiration)
+
+	var endKey []byte
+	if end < ^uint32(0) {
+		endKey = types.ExpirationTimestampToBytes(end + 1)
+	}
+
+	iterator := store.Iterator(types.ExpirationTimestampToBytes(start), endKey)
+	defer closeIteratorOrPanic(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		expirationTimestamp, verificationId := types.SplitVerificationExpirationKey(iterator.Key())
+		if !callback(expirationTimestamp, verificationId) {
+			break
+		}
+	}
+}
+
+// ProcessExpiredVerifications handles indexed verifications, which expiration timestamp has passed
+// by the current block time. For each of them `EventVerificationExpired` is emitted and, if it is enabled
+// by module params, verification is removed from the storage.
+// At most `MaxExpiredVerificationsPerBlock` verifications are handled per call, remaining ones are left
+// for the following blocks. Handled verifications are removed from the expiration index, including
+// the ones, which cannot be processed, so a single broken entry does not block expiration of others.
+func (k Keeper) ProcessExpiredVerifications(ctx sdk.Context) {
+	blockTime := ctx.BlockTime().Unix()
+	if blockTime <= 0 {
+		return
+	}
+
+	// Verification is treated as expired if current time is greater than its expiration timestamp
+	var end uint32
+	if blockTime > int64(^uint32(0)) {
+		end = ^uint32(0)
+	} else {
+		end = uint32(blockTime) - 1
+	}
+
+	type expiredVerification struct {
+		expirationTimestamp uint32
+		verificationId      []byte
+	}
+
+	// Collect expired verifications first, since the store should not be modified during iteration
+	var expired []expiredVerification
+	k.IterateVerificationExpirations(ctx, 0, end, func(expirationTimestamp uint32, verificationId []byte) bool {
+		expired = append(expired, expiredVerification{
+			expirationTimestamp: expirationTimestamp,
+			verificationId:      bytes.Clone(verificationId),
+		})
+		return len(expired) < types.MaxExpiredVerificationsPerBlock
+	})
+
+	pruneExpired := k.GetParams(ctx).PruneExpiredVerifications
+	for _, item := range expired {
+		k.RemoveVerificationExpiration(ctx, item.verificationId, item.expirationTimestamp)
+
+		// Each verification is processed in its own cache context, so failed one does not leave partial changes
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.processExpiredVerification(cacheCtx, item.verificationId, item.expirationTimestamp, pruneExpired); err != nil {
+			k.Logger(ctx).Error(
+				"failed to process expired verification, skipping it",
+				"verificationId", hex.EncodeToString(item.verificationId),
+				"error", err,
+			)
+			continue
+		}
+		write()
+	}
+}
+
+// processExpiredVerification emits `EventVerificationExpired` for provided verification and prunes it if requested
+func (k Keeper) processExpiredVerification(ctx sdk.Context, verificationId []byte, expirationTimestamp uint32, prune bool) error {
+	details, err := k.GetRawVerificationDetails(ctx, verificationId)
+	if err != nil {
+		return err
+	}
+	// Verification could be already removed from the storage
+	if details.Type == types.VerificationType_VT_UNSPECIFIED {
+		return nil
+	}
+
+	holder := k.getHolderByVerificationId(ctx, verificationId)
+	if prune {
+		if err = k.PruneVerification(ctx, holder, verificationId); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventVerificationExpired{
+		VerificationId:      verificationId,
+		Holder:              holder.String(),
+		IssuerAddress:       details.IssuerAddress,
+		Type:                details.Type,
+		ExpirationTimestamp: expirationTimestamp,
+		Pruned:              prune,
+	})
+}
+
+// PruneVerification removes verification with provided id from the storage, including its association
+// with holder address and holder public key. Credential hashes in issuance and revocation trees are kept as is.
+func (k Keeper) PruneVerification(ctx sdk.Context, holder sdk.AccAddress, verificationId []byte) error {
+	if !holder.Empty() {
+		addressDetails, err := k.GetFullAddressDetails(ctx, holder)
+		if err != nil {
+			return err
+		}
+
+		verifications := make([]*types.Verification, 0, len(addressDetails.Verifications))
+		for _, verification := range addressDetails.Verifications {
+			if !bytes.Equal(verification.VerificationId, verificationId) {
+				verifications = append(verifications, verification)
+			}
+		}
+		addressDetails.Verifications = verifications
+
+		if err = k.SetAddressDetails(ctx, holder, addressDetails); err != nil {
+			return err
+		}
+	}
+
+	details, err := k.GetRawVerificationDetails(ctx, verificationId)
+	if err != nil {
+		return err
+	}
+	if details.Type != types.VerificationType_VT_UNSPECIFIED {
+		if err = k.RemoveVerificationIndexes(ctx, verificationId, details); err != nil {
+			return err
+		}
+	}
+
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationDetails).Delete(verificationId)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationToHolder).Delete(verificationId)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationToPubKey).Delete(verificationId)
+	k.RemoveRevocationDetails(ctx, verificationId)
+
+	return nil
+}
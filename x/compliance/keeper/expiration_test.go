@@ -0,0 +1,249 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/status-im/keycard-go/hexutils"
+
+	"swisstronik/tests"
+	"swisstronik/x/compliance/keeper"
+	"swisstronik/x/compliance/types"
+)
+
+func (suite *KeeperTestSuite) createVerifiedIssuer(ctx sdk.Context) sdk.AccAddress {
+	issuer := tests.RandomAccAddress()
+	details := &types.IssuerDetails{Creator: tests.RandomAccAddress().String(), Name: "testIssuer"}
+	err := suite.keeper.SetIssuerDetails(ctx, issuer, details)
+	suite.Require().NoError(err)
+	err = suite.keeper.SetAddressVerificationStatus(ctx, issuer, true)
+	suite.Require().NoError(err)
+	return issuer
+}
+
+func (suite *KeeperTestSuite) addExpiringVerification(ctx sdk.Context, issuer, holder sdk.AccAddress, expiration uint32) []byte {
+	verificationId, err := suite.keeper.AddVerificationDetails(
+		ctx,
+		holder,
+		types.VerificationType_VT_KYC,
+		&types.VerificationDetails{
+			IssuerAddress:       issuer.String(),
+			OriginChain:         "test chain",
+			IssuanceTimestamp:   expiration - 1000,
+			ExpirationTimestamp: expiration,
+			OriginalData:        hexutils.HexToBytes("B639DF194671CDE06EFAA368A404F72E3306DF0359117AC7E78EC2BE04B7629D"),
+		},
+	)
+	suite.Require().NoError(err)
+	return verificationId
+}
+
+// expiredEvents returns `EventVerificationExpired` events emitted for provided verification
+func (suite *KeeperTestSuite) expiredEvents(ctx sdk.Context, verificationId []byte) []*types.EventVerificationExpired {
+	var result []*types.EventVerificationExpired
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		if expired, ok := msg.(*types.EventVerificationExpired); ok && string(expired.VerificationId) == string(verificationId) {
+			result = append(result, expired)
+		}
+	}
+	return result
+}
+
+func (suite *KeeperTestSuite) TestProcessExpiredVerifications() {
+	ctx, _ := suite.ctx.CacheContext()
+	expiration := uint32(1800000000)
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	holder := tests.RandomAccAddress()
+	verificationId := suite.addExpiringVerification(ctx, issuer, holder, expiration)
+
+	// Verification is still valid at the expiration timestamp
+	ctx = ctx.WithBlockTime(time.Unix(int64(expiration), 0)).WithEventManager(sdk.NewEventManager())
+	suite.keeper.ProcessExpiredVerifications(ctx)
+	suite.Require().Empty(suite.expiredEvents(ctx, verificationId))
+
+	// Verification expires right after expiration timestamp
+	ctx = ctx.WithBlockTime(time.Unix(int64(expiration)+1, 0)).WithEventManager(sdk.NewEventManager())
+	suite.keeper.ProcessExpiredVerifications(ctx)
+	events := suite.expiredEvents(ctx, verificationId)
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(holder.String(), events[0].Holder)
+	suite.Require().Equal(issuer.String(), events[0].IssuerAddress)
+	suite.Require().Equal(types.VerificationType_VT_KYC, events[0].Type)
+	suite.Require().Equal(expiration, events[0].ExpirationTimestamp)
+	suite.Require().False(events[0].Pruned)
+
+	// Pruning is disabled by default, so verification should be kept
+	details, err := suite.keeper.GetVerificationDetails(ctx, verificationId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expiration, details.ExpirationTimestamp)
+	addressDetails, err := suite.keeper.GetAddressDetails(ctx, holder)
+	suite.Require().NoError(err)
+	suite.Require().Len(addressDetails.Verifications, 1)
+
+	// Expired verification should be processed only once
+	ctx = ctx.WithBlockTime(time.Unix(int64(expiration)+2, 0)).WithEventManager(sdk.NewEventManager())
+	suite.keeper.ProcessExpiredVerifications(ctx)
+	suite.Require().Empty(suite.expiredEvents(ctx, verificationId))
+}
+
+func (suite *KeeperTestSuite) TestProcessExpiredVerificationsLimit() {
+	ctx, _ := suite.ctx.CacheContext()
+	expiration := uint32(1800000000)
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	var ids [][]byte
+	for i := 0; i < types.MaxExpiredVerificationsPerBlock+1; i++ {
+		ids = append(ids, suite.addExpiringVerification(ctx, issuer, tests.RandomAccAddress(), expiration))
+	}
+
+	// Only limited number of verifications is processed per block
+	ctx = ctx.WithBlockTime(time.Unix(int64(expiration)+1, 0)).WithEventManager(sdk.NewEventManager())
+	suite.keeper.ProcessExpiredVerifications(ctx)
+	processed := 0
+	for _, id := range ids {
+		processed += len(suite.expiredEvents(ctx, id))
+	}
+	suite.Require().Equal(types.MaxExpiredVerificationsPerBlock, processed)
+
+	// Remaining one is processed in the next block
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.keeper.ProcessExpiredVerifications(ctx)
+	processed = 0
+	for _, id := range ids {
+		processed += len(suite.expiredEvents(ctx, id))
+	}
+	suite.Require().Equal(1, processed)
+}
+
+func (suite *KeeperTestSuite) TestProcessExpiredVerificationsSkipsBroken() {
+	ctx, _ := suite.ctx.CacheContext()
+	expiration := uint32(1800000000)
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	holder := tests.RandomAccAddress()
+	brokenId := suite.addExpiringVerification(ctx, issuer, holder, expiration-10)
+	validId := suite.addExpiringVerification(ctx, issuer, holder, expiration)
+
+	// Corrupt stored details of the first verification
+	store := prefix.NewStore(ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.KeyPrefixVerificationDetails)
+	store.Set(brokenId, []byte{0xff, 0xff, 0xff})
+
+	ctx = ctx.WithBlockTime(time.Unix(int64(expiration)+1, 0)).WithEventManager(sdk.NewEventManager())
+	suite.keeper.ProcessExpiredVerifications(ctx)
+	suite.Require().Empty(suite.expiredEvents(ctx, brokenId))
+	suite.Require().Len(suite.expiredEvents(ctx, validId), 1)
+
+	// Broken verification is dropped from the expiration index
+	var left [][]byte
+	suite.keeper.IterateVerificationExpirations(ctx, 0, ^uint32(0), func(_ uint32, verificationId []byte) bool {
+		left = append(left, verificationId)
+		return true
+	})
+	suite.Require().Empty(left)
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredVerifications() {
+	ctx, _ := suite.ctx.CacheContext()
+	expiration := uint32(1800000000)
+	suite.keeper.SetParams(ctx, types.NewParams(true, types.DefaultComplianceRegistry))
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	holder := tests.RandomAccAddress()
+	expiredId := suite.addExpiringVerification(ctx, issuer, holder, expiration)
+	activeId := suite.addExpiringVerification(ctx, issuer, holder, expiration+100)
+
+	ctx = ctx.WithBlockTime(time.Unix(int64(expiration)+1, 0)).WithEventManager(sdk.NewEventManager())
+	suite.keeper.ProcessExpiredVerifications(ctx)
+
+	events := suite.expiredEvents(ctx, expiredId)
+	suite.Require().Len(events, 1)
+	suite.Require().True(events[0].Pruned)
+	suite.Require().Empty(suite.expiredEvents(ctx, activeId))
+
+	// Expired verification should be removed from the storage
+	details, err := suite.keeper.GetRawVerificationDetails(ctx, expiredId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.VerificationType_VT_UNSPECIFIED, details.Type)
+
+	addressDetails, err := suite.keeper.GetAddressDetails(ctx, holder)
+	suite.Require().NoError(err)
+	suite.Require().Len(addressDetails.Verifications, 1)
+	suite.Require().Equal(activeId, addressDetails.Verifications[0].VerificationId)
+
+	holderResp, err := keeper.Querier{Keeper: suite.keeper}.VerificationHolder(sdk.WrapSDKContext(ctx), &types.QueryHolderByVerificationIdRequest{
+		VerificationId: base64.StdEncoding.EncodeToString(expiredId),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(holderResp.Address)
+}
+
+func (suite *KeeperTestSuite) TestVerificationsExpiringBetween() {
+	ctx, _ := suite.ctx.CacheContext()
+	q := keeper.Querier{Keeper: suite.keeper}
+	expiration := uint32(1900000000)
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	holder := tests.RandomAccAddress()
+	var ids [][]byte
+	for i := uint32(0); i < 3; i++ {
+		ids = append(ids, suite.addExpiringVerification(ctx, issuer, holder, expiration+i*10))
+	}
+
+	// Invalid range
+	_, err := q.VerificationsExpiringBetween(sdk.WrapSDKContext(ctx), &types.QueryVerificationsExpiringBetweenRequest{
+		StartTimestamp: expiration + 1,
+		EndTimestamp:   expiration,
+	})
+	suite.Require().Error(err)
+
+	// Both bounds are inclusive
+	resp, err := q.VerificationsExpiringBetween(sdk.WrapSDKContext(ctx), &types.QueryVerificationsExpiringBetweenRequest{
+		StartTimestamp: expiration,
+		EndTimestamp:   expiration + 10,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Verifications, 2)
+	suite.Require().Equal(ids[0], resp.Verifications[0].Details.VerificationId)
+	suite.Require().Equal(ids[1], resp.Verifications[1].Details.VerificationId)
+	suite.Require().Equal(holder.String(), resp.Verifications[0].Holder)
+	suite.Require().Nil(resp.Pagination.NextKey)
+
+	// Paginate over whole range
+	resp, err = q.VerificationsExpiringBetween(sdk.WrapSDKContext(ctx), &types.QueryVerificationsExpiringBetweenRequest{
+		StartTimestamp: expiration,
+		EndTimestamp:   ^uint32(0),
+		Pagination:     &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Verifications, 2)
+	suite.Require().NotNil(resp.Pagination.NextKey)
+
+	resp, err = q.VerificationsExpiringBetween(sdk.WrapSDKContext(ctx), &types.QueryVerificationsExpiringBetweenRequest{
+		StartTimestamp: expiration,
+		EndTimestamp:   ^uint32(0),
+		Pagination:     &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Verifications, 1)
+	suite.Require().Equal(ids[2], resp.Verifications[0].Details.VerificationId)
+	suite.Require().Nil(resp.Pagination.NextKey)
+
+	// Processed verifications are removed from the index
+	ctx = ctx.WithBlockTime(time.Unix(int64(expiration)+1, 0))
+	suite.keeper.ProcessExpiredVerifications(ctx)
+	resp, err = q.VerificationsExpiringBetween(sdk.WrapSDKContext(ctx), &types.QueryVerificationsExpiringBetweenRequest{
+		StartTimestamp: expiration,
+		EndTimestamp:   ^uint32(0),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Verifications, 2)
+	suite.Require().Equal(ids[1], resp.Verifications[0].Details.VerificationId)
+}
@@ -132,4 +132,71 @@ func (k Keeper) ExportLinksVerificationIdToPublicKey(ctx sdk.Context) ([]*types.
 	})
 
 	return links, nil
-}
\ No newline at end of file
+}
+func (k Keeper) ExportIssuerMigrations(ctx sdk.Context) ([]*types.IssuerMigration, error) {
+	var (
+		migrations []*types.IssuerMigration
+		migration  *types.IssuerMigration
+		err        error
+	)
+
+	k.IterateIssuerMigrations(ctx, func(oldIssuer sdk.AccAddress) bool {
+		migration, err = k.GetIssuerMigration(ctx, oldIssuer)
+		if err != nil {
+			return false
+		}
+		if migration != nil {
+			migrations = append(migrations, migration)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return migrations, nil
+}
+
+func (k Keeper) ExportRevocationDetails(ctx sdk.Context) ([]*types.GenesisRevocationDetails, error) {
+	var (
+		revocations []*types.GenesisRevocationDetails
+		details     *types.RevocationDetails
+		err         error
+	)
+
+	k.IterateRevocationDetails(ctx, func(verificationId []byte) bool {
+		details, err = k.GetRevocationDetails(ctx, verificationId)
+		if err != nil {
+			return false
+		}
+		if details != nil {
+			revocations = append(revocations, &types.GenesisRevocationDetails{
+				Id:      verificationId,
+				Details: details,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return revocations, nil
+}
+
+func (k Keeper) ExportVerificationRequests(ctx sdk.Context) ([]*types.VerificationRequest, error) {
+	var requests []*types.VerificationRequest
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationRequest)
+	defer closeIteratorOrPanic(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.VerificationRequest
+		if err := request.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+		requests = append(requests, &request)
+	}
+
+	return requests, nil
+}
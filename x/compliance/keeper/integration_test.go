@@ -1,157 +1,211 @@
 package keeper_test
 
-//
-//import (
-//	"strconv"
-//
-//	"cosmossdk.io/math"
-//	abci "github.com/cometbft/cometbft/abci/types"
-//	sdk "github.com/cosmos/cosmos-sdk/types"
-//	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
-//	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-//	. "github.com/onsi/ginkgo/v2"
-//	. "github.com/onsi/gomega"
-//
-//	"swisstronik/tests"
-//	"swisstronik/testutil"
-//	"swisstronik/utils"
-//	"swisstronik/x/compliance/types"
-//)
-//
-//var _ = Describe("VerifyIssuer", Ordered, func() {
-//	issuerCreator := tests.RandomAccAddress()
-//
-//	from, validIssuerPrivKey := tests.RandomEthAddressWithPrivateKey()
-//	validIssuer := sdk.AccAddress(from.Bytes())
-//
-//	from, invalidIssuerPrivKey := tests.RandomEthAddressWithPrivateKey()
-//	invalidIssuer := sdk.AccAddress(from.Bytes())
-//
-//	from, accountPrivKey := tests.RandomEthAddressWithPrivateKey()
-//	account := sdk.AccAddress(from.Bytes())
-//
-//	from, emptyPrivKey := tests.RandomEthAddressWithPrivateKey()
-//	empty := sdk.AccAddress(from.Bytes())
-//
-//	BeforeEach(func() {
-//		govParams := s.app.GovKeeper.GetParams(s.ctx)
-//		govParams.Quorum = "0.0000000001"
-//		err := s.app.GovKeeper.SetParams(s.ctx, govParams)
-//		Expect(err).To(BeNil())
-//	})
-//
-//	Describe("Submitting a verifying issuer proposal through governance", func() {
-//		BeforeEach(func() {
-//			// Mint coins to pay gas fee, gov deposit and registering coins in Bank keeper
-//			amount, ok := sdk.NewIntFromString("10000000000000000000")
-//			s.Require().True(ok)
-//			coins := sdk.NewCoins(
-//				sdk.NewCoin(utils.BaseDenom, amount),
-//				sdk.NewCoin(stakingtypes.DefaultParams().BondDenom, amount),
-//			)
-//			err := testutil.FundAccount(s.ctx, s.app.BankKeeper, validIssuer, coins)
-//			s.Require().NoError(err)
-//			s.Commit()
-//
-//			err = testutil.FundAccount(s.ctx, s.app.BankKeeper, invalidIssuer, coins)
-//			s.Require().NoError(err)
-//			s.Commit()
-//
-//			err = testutil.FundAccount(s.ctx, s.app.BankKeeper, account, coins)
-//			s.Require().NoError(err)
-//			s.Commit()
-//		})
-//
-//		Describe("valid issuer has been verified", func() {
-//			BeforeEach(func() {
-//				// Set issuer details(not verified)
-//				issuerDetails := &types.IssuerDetails{Creator: issuerCreator.String(), Name: "test issuer"}
-//				_ = s.keeper.SetIssuerDetails(s.ctx, validIssuer, issuerDetails)
-//
-//				// Submit proposal with sufficient deposit
-//				content := types.NewVerifyIssuerProposal("test title", "test description", validIssuer.String())
-//				event, err := testutil.SubmitProposal(s.ctx, s.app, validIssuerPrivKey, content)
-//				s.Require().NoError(err)
-//
-//				proposalID, err := strconv.ParseUint(event.Attributes[0].Value, 10, 64)
-//				s.Require().NoError(err)
-//
-//				// Make sure proposal has been created
-//				proposal, found := s.app.GovKeeper.GetProposal(s.ctx, proposalID)
-//				s.Require().True(found)
-//
-//				_, err = testutil.Delegate(s.ctx, s.app, accountPrivKey, sdk.NewCoin(utils.BaseDenom, math.NewInt(500000000000000000)), s.validator)
-//				s.Require().NoError(err)
-//
-//				_, err = testutil.Vote(s.ctx, s.app, accountPrivKey, proposalID, govv1beta1.OptionYes)
-//				s.Require().NoError(err)
-//
-//				// Make proposal pass in EndBlocker
-//				duration := proposal.VotingEndTime.Sub(s.ctx.BlockTime()) + 1
-//				s.CommitAfter(duration)
-//				s.app.EndBlocker(s.ctx, abci.RequestEndBlock{Height: s.ctx.BlockHeight()})
-//				s.Commit()
-//			})
-//			It("Issuer should be verified", func() {
-//				verified, err := s.keeper.IsAddressVerified(s.ctx, validIssuer)
-//				s.Require().NoError(err)
-//				s.Require().True(verified)
-//			})
-//		})
-//
-//		Describe("invalid issuer has not been verified", func() {
-//			BeforeEach(func() {
-//				// Set issuer details(not verified)
-//				issuerDetails := &types.IssuerDetails{Creator: issuerCreator.String(), Name: "test issuer"}
-//				_ = s.keeper.SetIssuerDetails(s.ctx, invalidIssuer, issuerDetails)
-//
-//				// Submit proposal with sufficient deposit
-//				content := types.NewVerifyIssuerProposal("test title", "test description", invalidIssuer.String())
-//				event, err := testutil.SubmitProposal(s.ctx, s.app, invalidIssuerPrivKey, content)
-//				s.Require().NoError(err)
-//
-//				proposalID, err := strconv.ParseUint(event.Attributes[0].Value, 10, 64)
-//				s.Require().NoError(err)
-//
-//				proposal, found := s.app.GovKeeper.GetProposal(s.ctx, proposalID)
-//				s.Require().True(found)
-//
-//				// There's no delegate, vote to make proposal failed
-//
-//				// Make proposal pass in EndBlocker
-//				duration := proposal.VotingEndTime.Sub(s.ctx.BlockTime()) + 1
-//				s.CommitAfter(duration)
-//				s.app.EndBlocker(s.ctx, abci.RequestEndBlock{Height: s.ctx.BlockHeight()})
-//				s.Commit()
-//			})
-//			It("Issuer should not be verified", func() {
-//				verified, err := s.keeper.IsAddressVerified(s.ctx, invalidIssuer)
-//				s.Require().NoError(err)
-//				s.Require().False(verified)
-//			})
-//		})
-//
-//		Describe("should not create a proposal for verified issuer", func() {
-//			BeforeEach(func() {
-//				// Set issuer details(verified)
-//				issuerDetails := &types.IssuerDetails{Creator: issuerCreator.String(), Name: "test issuer"}
-//				_ = s.keeper.SetIssuerDetails(s.ctx, validIssuer, issuerDetails)
-//				_ = s.keeper.SetAddressVerificationStatus(s.ctx, validIssuer, true)
-//			})
-//			It("should fail in submitting proposal", func() {
-//				// Submit proposal with sufficient deposit
-//				content := types.NewVerifyIssuerProposal("test title", "test description", validIssuer.String())
-//				_, err := testutil.SubmitProposal(s.ctx, s.app, validIssuerPrivKey, content)
-//				s.Require().Error(err)
-//			})
-//		})
-//
-//		Describe("should not create a proposal for empty issuer that doesn't exist", func() {
-//			It("should fail in submitting proposal", func() {
-//				content := types.NewVerifyIssuerProposal("test title", "test description", empty.String())
-//				_, err := testutil.SubmitProposal(s.ctx, s.app, emptyPrivKey, content)
-//				s.Require().Error(err)
-//			})
-//		})
-//	})
-//})
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	. "github.com/onsi/ginkgo/v2"
+
+	"swisstronik/tests"
+	"swisstronik/testutil"
+	"swisstronik/utils"
+	"swisstronik/x/compliance/types"
+)
+
+var _ = Describe("Governance", Ordered, func() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	issuerCreator := tests.RandomAccAddress()
+
+	from, proposerPrivKey := tests.RandomEthAddressWithPrivateKey()
+	proposer := sdk.AccAddress(from.Bytes())
+
+	from, voterPrivKey := tests.RandomEthAddressWithPrivateKey()
+	voter := sdk.AccAddress(from.Bytes())
+
+	// submitProposal submits gov v1 proposal with provided messages and returns its id
+	submitProposal := func(msgs ...sdk.Msg) uint64 {
+		event, err := testutil.SubmitGovProposal(s.ctx, s.app, proposerPrivKey, msgs)
+		s.Require().NoError(err)
+
+		proposalID, err := strconv.ParseUint(event.Attributes[0].Value, 10, 64)
+		s.Require().NoError(err)
+
+		return proposalID
+	}
+
+	// finishVoting votes for proposal if requested and makes it processed in EndBlocker
+	finishVoting := func(proposalID uint64, priv cryptotypes.PrivKey) {
+		proposal, found := s.app.GovKeeper.GetProposal(s.ctx, proposalID)
+		s.Require().True(found)
+
+		if priv != nil {
+			_, err := testutil.Vote(s.ctx, s.app, priv, proposalID, govv1beta1.OptionYes)
+			s.Require().NoError(err)
+		}
+
+		duration := proposal.VotingEndTime.Sub(s.ctx.BlockTime()) + 1
+		s.CommitAfter(duration)
+		s.app.EndBlocker(s.ctx, abci.RequestEndBlock{Height: s.ctx.BlockHeight()})
+		s.Commit()
+	}
+
+	createIssuer := func(verified bool) sdk.AccAddress {
+		issuer := tests.RandomAccAddress()
+		issuerDetails := &types.IssuerDetails{Creator: issuerCreator.String(), Name: "test issuer"}
+		err := s.keeper.SetIssuerDetails(s.ctx, issuer, issuerDetails)
+		s.Require().NoError(err)
+		err = s.keeper.SetAddressVerificationStatus(s.ctx, issuer, verified)
+		s.Require().NoError(err)
+		return issuer
+	}
+
+	BeforeAll(func() {
+		govParams := s.app.GovKeeper.GetParams(s.ctx)
+		govParams.Quorum = "0.0000000001"
+		err := s.app.GovKeeper.SetParams(s.ctx, govParams)
+		s.Require().NoError(err)
+
+		// Mint coins to pay gas fee, gov deposit and registering coins in Bank keeper
+		amount, ok := sdk.NewIntFromString("10000000000000000000")
+		s.Require().True(ok)
+		coins := sdk.NewCoins(
+			sdk.NewCoin(utils.BaseDenom, amount),
+			sdk.NewCoin(stakingtypes.DefaultParams().BondDenom, amount),
+		)
+		err = testutil.FundAccount(s.ctx, s.app.BankKeeper, proposer, coins)
+		s.Require().NoError(err)
+		err = testutil.FundAccount(s.ctx, s.app.BankKeeper, voter, coins)
+		s.Require().NoError(err)
+		s.Commit()
+
+		// Voter should have voting power to pass proposals
+		_, err = testutil.Delegate(s.ctx, s.app, voterPrivKey, sdk.NewCoin(utils.BaseDenom, math.NewInt(500000000000000000)), s.validator)
+		s.Require().NoError(err)
+		s.Commit()
+	})
+
+	Describe("Verifying issuer through governance", func() {
+		It("should verify issuer if proposal passed", func() {
+			issuer := createIssuer(false)
+
+			msg := types.NewMsgVerifyIssuer(authority, issuer.String())
+			finishVoting(submitProposal(&msg), voterPrivKey)
+
+			verified, err := s.keeper.IsAddressVerified(s.ctx, issuer)
+			s.Require().NoError(err)
+			s.Require().True(verified)
+		})
+
+		It("should not verify issuer if proposal was not voted", func() {
+			issuer := createIssuer(false)
+
+			msg := types.NewMsgVerifyIssuer(authority, issuer.String())
+			finishVoting(submitProposal(&msg), nil)
+
+			verified, err := s.keeper.IsAddressVerified(s.ctx, issuer)
+			s.Require().NoError(err)
+			s.Require().False(verified)
+		})
+
+		It("should not change verification status for already verified issuer", func() {
+			issuer := createIssuer(true)
+
+			msg := types.NewMsgVerifyIssuer(authority, issuer.String())
+			proposalID := submitProposal(&msg)
+			finishVoting(proposalID, voterPrivKey)
+
+			proposal, found := s.app.GovKeeper.GetProposal(s.ctx, proposalID)
+			s.Require().True(found)
+			s.Require().Equal("PROPOSAL_STATUS_FAILED", proposal.Status.String())
+		})
+
+		It("should verify issuer through legacy proposal", func() {
+			issuer := createIssuer(false)
+
+			content := types.NewVerifyIssuerProposal("test title", "test description", issuer.String())
+			event, err := testutil.SubmitProposal(s.ctx, s.app, proposerPrivKey, content)
+			s.Require().NoError(err)
+			proposalID, err := strconv.ParseUint(event.Attributes[0].Value, 10, 64)
+			s.Require().NoError(err)
+			finishVoting(proposalID, voterPrivKey)
+
+			verified, err := s.keeper.IsAddressVerified(s.ctx, issuer)
+			s.Require().NoError(err)
+			s.Require().True(verified)
+		})
+
+		It("should not accept proposal with invalid authority", func() {
+			issuer := createIssuer(false)
+
+			msg := types.NewMsgVerifyIssuer(proposer.String(), issuer.String())
+			_, err := testutil.SubmitGovProposal(s.ctx, s.app, proposerPrivKey, []sdk.Msg{&msg})
+			s.Require().Error(err)
+		})
+	})
+
+	Describe("Unverifying issuer through governance", func() {
+		It("should unverify issuer if proposal passed", func() {
+			issuer := createIssuer(true)
+
+			msg := types.NewMsgUnverifyIssuer(authority, issuer.String())
+			finishVoting(submitProposal(&msg), voterPrivKey)
+
+			verified, err := s.keeper.IsAddressVerified(s.ctx, issuer)
+			s.Require().NoError(err)
+			s.Require().False(verified)
+		})
+
+		It("should keep issuer verified if proposal was not voted", func() {
+			issuer := createIssuer(true)
+
+			msg := types.NewMsgUnverifyIssuer(authority, issuer.String())
+			finishVoting(submitProposal(&msg), nil)
+
+			verified, err := s.keeper.IsAddressVerified(s.ctx, issuer)
+			s.Require().NoError(err)
+			s.Require().True(verified)
+		})
+	})
+
+	Describe("Managing operators through governance", func() {
+		operator := tests.RandomAccAddress()
+
+		It("should add regular operator if proposal passed", func() {
+			msg := types.NewMsgGovAddOperator(authority, operator.String())
+			finishVoting(submitProposal(&msg), voterPrivKey)
+
+			details, err := s.keeper.GetOperatorDetails(s.ctx, operator)
+			s.Require().NoError(err)
+			s.Require().Equal(types.OperatorType_OT_REGULAR, details.OperatorType)
+		})
+
+		It("should remove regular operator if proposal passed", func() {
+			msg := types.NewMsgGovRemoveOperator(authority, operator.String())
+			finishVoting(submitProposal(&msg), voterPrivKey)
+
+			exists, err := s.keeper.OperatorExists(s.ctx, operator)
+			s.Require().NoError(err)
+			s.Require().False(exists)
+		})
+
+		It("should not remove initial operator", func() {
+			initialOperator := tests.RandomAccAddress()
+			err := s.keeper.AddOperator(s.ctx, initialOperator, types.OperatorType_OT_INITIAL)
+			s.Require().NoError(err)
+
+			msg := types.NewMsgGovRemoveOperator(authority, initialOperator.String())
+			finishVoting(submitProposal(&msg), voterPrivKey)
+
+			exists, err := s.keeper.OperatorExists(s.ctx, initialOperator)
+			s.Require().NoError(err)
+			s.Require().True(exists)
+		})
+	})
+})
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"swisstronik/x/compliance/types"
+)
+
+// SetIssuerVerificationTypes sets verification types, which issuer is allowed to issue.
+// Empty list allows issuer to issue verifications of any type.
+func (k Keeper) SetIssuerVerificationTypes(ctx sdk.Context, issuerAddress sdk.AccAddress, verificationTypes []types.VerificationType) error {
+	if err := types.ValidateVerificationTypes(verificationTypes); err != nil {
+		return errors.Wrap(types.ErrInvalidParam, err.Error())
+	}
+
+	details, err := k.GetIssuerDetails(ctx, issuerAddress)
+	if err != nil {
+		return err
+	}
+	if len(details.Name) < 1 {
+		return errors.Wrap(types.ErrInvalidIssuer, "issuer does not exist")
+	}
+
+	details.AllowedVerificationTypes = verificationTypes
+	return k.SetIssuerDetails(ctx, issuerAddress, details)
+}
+
+// SetIssuerQuota sets limit of verifications, which issuer can issue within a window of blocks.
+// Quota with zero `MaxIssuances` removes the limit.
+func (k Keeper) SetIssuerQuota(ctx sdk.Context, issuerAddress sdk.AccAddress, quota types.IssuanceQuota) error {
+	if err := quota.Validate(); err != nil {
+		return errors.Wrap(types.ErrInvalidParam, err.Error())
+	}
+
+	details, err := k.GetIssuerDetails(ctx, issuerAddress)
+	if err != nil {
+		return err
+	}
+	if len(details.Name) < 1 {
+		return errors.Wrap(types.ErrInvalidIssuer, "issuer does not exist")
+	}
+
+	if quota.IsLimited() {
+		details.IssuanceQuota = &quota
+	} else {
+		details.IssuanceQuota = nil
+	}
+	// Counter of previous quota is not relevant anymore
+	k.RemoveIssuanceCounter(ctx, issuerAddress)
+
+	return k.SetIssuerDetails(ctx, issuerAddress, details)
+}
+
+// GetIssuanceCounter returns number of verifications issued by issuer within the last tracked window
+func (k Keeper) GetIssuanceCounter(ctx sdk.Context, issuerAddress sdk.AccAddress) (*types.IssuanceCounter, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIssuanceCounter)
+
+	counterBytes := store.Get(issuerAddress.Bytes())
+	if counterBytes == nil {
+		return &types.IssuanceCounter{}, nil
+	}
+
+	var counter types.IssuanceCounter
+	if err := proto.Unmarshal(counterBytes, &counter); err != nil {
+		return nil, err
+	}
+
+	return &counter, nil
+}
+
+// RemoveIssuanceCounter deletes issuance counter of provided issuer
+func (k Keeper) RemoveIssuanceCounter(ctx sdk.Context, issuerAddress sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIssuanceCounter)
+	store.Delete(issuerAddress.Bytes())
+}
+
+// checkIssuerRestrictions checks if issuer is allowed to issue verification of provided type
+// and, if issuer has quota, increases number of verifications issued within current window.
+func (k Keeper) checkIssuerRestrictions(ctx sdk.Context, issuerAddress sdk.AccAddress, verificationType types.VerificationType) error {
+	details, err := k.GetIssuerDetails(ctx, issuerAddress)
+	if err != nil {
+		return err
+	}
+
+	if !details.IsVerificationTypeAllowed(verificationType) {
+		return errors.Wrapf(types.ErrVerificationTypeNotAllowed, "issuer cannot issue verifications of type %s", verificationType)
+	}
+
+	quota := details.IssuanceQuota
+	if !quota.IsLimited() {
+		return nil
+	}
+
+	counter, err := k.GetIssuanceCounter(ctx, issuerAddress)
+	if err != nil {
+		return err
+	}
+
+	window := uint64(ctx.BlockHeight()) / quota.WindowBlocks
+	if counter.Window != window {
+		counter = &types.IssuanceCounter{Window: window}
+	}
+
+	if counter.Count >= quota.MaxIssuances {
+		return errors.Wrapf(
+			types.ErrIssuanceQuotaExceeded,
+			"issuer can issue up to %d verifications per %d block(s)",
+			quota.MaxIssuances,
+			quota.WindowBlocks,
+		)
+	}
+	counter.Count++
+
+	return k.setIssuanceCounter(ctx, issuerAddress, counter)
+}
+
+func (k Keeper) setIssuanceCounter(ctx sdk.Context, issuerAddress sdk.AccAddress, counter *types.IssuanceCounter) error {
+	counterBytes, err := counter.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIssuanceCounter)
+	store.Set(issuerAddress.Bytes(), counterBytes)
+	return nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/status-im/keycard-go/hexutils"
+
+	"swisstronik/tests"
+	"swisstronik/x/compliance/keeper"
+	"swisstronik/x/compliance/types"
+)
+
+func (suite *KeeperTestSuite) addVerificationOfType(ctx sdk.Context, issuer sdk.AccAddress, verificationType types.VerificationType) ([]byte, error) {
+	return suite.keeper.AddVerificationDetails(
+		ctx,
+		tests.RandomAccAddress(),
+		verificationType,
+		&types.VerificationDetails{
+			IssuerAddress:     issuer.String(),
+			OriginChain:       "test chain",
+			IssuanceTimestamp: 1712018692,
+			OriginalData:      hexutils.HexToBytes("B639DF194671CDE06EFAA368A404F72E3306DF0359117AC7E78EC2BE04B7629D"),
+		},
+	)
+}
+
+func (suite *KeeperTestSuite) TestIssuerAllowedVerificationTypes() {
+	ctx, _ := suite.ctx.CacheContext()
+	issuer := suite.createVerifiedIssuer(ctx)
+
+	// Issuer without restrictions can issue any verification type
+	_, err := suite.addVerificationOfType(ctx, issuer, types.VerificationType_VT_KYC)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.SetIssuerVerificationTypes(ctx, issuer, []types.VerificationType{types.VerificationType_VT_CREDIT_SCORE})
+	suite.Require().NoError(err)
+
+	_, err = suite.addVerificationOfType(ctx, issuer, types.VerificationType_VT_KYC)
+	suite.Require().ErrorIs(err, types.ErrVerificationTypeNotAllowed)
+
+	_, err = suite.addVerificationOfType(ctx, issuer, types.VerificationType_VT_CREDIT_SCORE)
+	suite.Require().NoError(err)
+
+	// Issuer creator cannot change restrictions by updating issuer details
+	details, err := suite.keeper.GetIssuerDetails(ctx, issuer)
+	suite.Require().NoError(err)
+	msg := types.MsgUpdateIssuerDetails{
+		Signer: details.Creator,
+		Issuer: issuer.String(),
+		Details: &types.IssuerDetails{
+			Name:                     "updated issuer",
+			AllowedVerificationTypes: []types.VerificationType{types.VerificationType_VT_KYC},
+		},
+	}
+	_, err = keeper.NewMsgServerImpl(suite.keeper).HandleUpdateIssuerDetails(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	details, err = suite.keeper.GetIssuerDetails(ctx, issuer)
+	suite.Require().NoError(err)
+	suite.Require().Equal("updated issuer", details.Name)
+	suite.Require().Equal([]types.VerificationType{types.VerificationType_VT_CREDIT_SCORE}, details.AllowedVerificationTypes)
+
+	// Empty list removes restriction
+	err = suite.keeper.SetAddressVerificationStatus(ctx, issuer, true)
+	suite.Require().NoError(err)
+	err = suite.keeper.SetIssuerVerificationTypes(ctx, issuer, nil)
+	suite.Require().NoError(err)
+	_, err = suite.addVerificationOfType(ctx, issuer, types.VerificationType_VT_KYC)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestIssuerIssuanceQuota() {
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(100)
+	issuer := suite.createVerifiedIssuer(ctx)
+
+	err := suite.keeper.SetIssuerQuota(ctx, issuer, types.IssuanceQuota{MaxIssuances: 2, WindowBlocks: 10})
+	suite.Require().NoError(err)
+
+	for i := 0; i < 2; i++ {
+		_, err = suite.addVerificationOfType(ctx, issuer, types.VerificationType_VT_KYC)
+		suite.Require().NoError(err)
+	}
+
+	// Quota is shared by all blocks of the same window
+	ctx = ctx.WithBlockHeight(109)
+	_, err = suite.addVerificationOfType(ctx, issuer, types.VerificationType_VT_KYC)
+	suite.Require().ErrorIs(err, types.ErrIssuanceQuotaExceeded)
+
+	// Quota is restored in the next window
+	ctx = ctx.WithBlockHeight(110)
+	_, err = suite.addVerificationOfType(ctx, issuer, types.VerificationType_VT_KYC)
+	suite.Require().NoError(err)
+
+	counter, err := suite.keeper.GetIssuanceCounter(ctx, issuer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.IssuanceCounter{Window: 11, Count: 1}, counter)
+
+	// Removing quota allows to issue without limit
+	err = suite.keeper.SetIssuerQuota(ctx, issuer, types.IssuanceQuota{})
+	suite.Require().NoError(err)
+	for i := 0; i < 3; i++ {
+		_, err = suite.addVerificationOfType(ctx, issuer, types.VerificationType_VT_KYC)
+		suite.Require().NoError(err)
+	}
+}
@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"swisstronik/x/compliance/types"
+)
+
+// MigrateIssuer moves issuer details, verification status, issuance quota counter, issuer linkage
+// of existing verifications and issuer index entries to the new issuer address. Old issuer address is kept as an alias of the new one,
+// so verifications can still be looked up by old address. Verification details are kept as is, since they
+// describe credentials originally issued by old address.
+// Returns number of updated verification records.
+func (k Keeper) MigrateIssuer(ctx sdk.Context, oldIssuer, newIssuer sdk.AccAddress) (uint64, error) {
+	if oldIssuer.Equals(newIssuer) {
+		return 0, errors.Wrap(types.ErrInvalidParam, "new issuer address should differ from current one")
+	}
+
+	details, err := k.GetIssuerDetails(ctx, oldIssuer)
+	if err != nil {
+		return 0, err
+	}
+	if len(details.Name) < 1 {
+		return 0, errors.Wrap(types.ErrInvalidIssuer, "issuer does not exist")
+	}
+
+	if exists, err := k.IssuerExists(ctx, newIssuer); exists || err != nil {
+		return 0, errors.Wrap(types.ErrInvalidIssuer, "new issuer address is already used by another issuer")
+	}
+	// Address, which was used by issuer before, cannot be reused, so alias chains never contain loops
+	if k.isIssuerAddressUsed(ctx, newIssuer) {
+		return 0, errors.Wrap(types.ErrInvalidIssuer, "new issuer address was already used by issuer")
+	}
+
+	// Move issuer details and verification status
+	if err = k.SetIssuerDetails(ctx, newIssuer, details); err != nil {
+		return 0, err
+	}
+
+	oldAddressDetails, err := k.GetFullAddressDetails(ctx, oldIssuer)
+	if err != nil {
+		return 0, err
+	}
+	newAddressDetails, err := k.GetFullAddressDetails(ctx, newIssuer)
+	if err != nil {
+		return 0, err
+	}
+	newAddressDetails.IsVerified = oldAddressDetails.IsVerified
+	newAddressDetails.IsRevoked = oldAddressDetails.IsRevoked
+	if err = k.SetAddressDetails(ctx, newIssuer, newAddressDetails); err != nil {
+		return 0, err
+	}
+
+	// Move issuance counter, so migration cannot be used to bypass issuance quota
+	counter, err := k.GetIssuanceCounter(ctx, oldIssuer)
+	if err != nil {
+		return 0, err
+	}
+	if counter.Count > 0 {
+		if err = k.setIssuanceCounter(ctx, newIssuer, counter); err != nil {
+			return 0, err
+		}
+	}
+
+	// Remove old issuer. Verifications, which were passed by old address itself, are kept
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIssuerDetails).Delete(oldIssuer.Bytes())
+	k.RemoveIssuanceCounter(ctx, oldIssuer)
+	oldAddressDetails.IsVerified = false
+	oldAddressDetails.IsRevoked = false
+	if len(oldAddressDetails.Verifications) > 0 {
+		if err = k.SetAddressDetails(ctx, oldIssuer, oldAddressDetails); err != nil {
+			return 0, err
+		}
+	} else {
+		k.RemoveAddressDetails(ctx, oldIssuer)
+	}
+
+	// Move issuer linkage of existing verifications
+	migrated, err := k.relinkVerifications(ctx, oldIssuer, newIssuer)
+	if err != nil {
+		return 0, err
+	}
+	k.moveVerificationsByIssuer(ctx, oldIssuer, newIssuer)
+	if err = k.moveVerificationRequests(ctx, oldIssuer, newIssuer); err != nil {
+		return 0, err
+	}
+
+	err = k.SetIssuerMigration(ctx, &types.IssuerMigration{
+		OldAddress:  oldIssuer.String(),
+		NewAddress:  newIssuer.String(),
+		BlockHeight: ctx.BlockHeight(),
+		Timestamp:   ctx.BlockTime().Unix(),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return migrated, nil
+}
+
+// relinkVerifications replaces issuer address in `Verification` records issued by old issuer.
+// Holders are found using issuer verification index, so only address details of affected holders are visited.
+func (k Keeper) relinkVerifications(ctx sdk.Context, oldIssuer, newIssuer sdk.AccAddress) (uint64, error) {
+	// Collect holders first, since the store should not be modified during iteration
+	var holders []sdk.AccAddress
+	seen := make(map[string]bool)
+	k.IterateVerificationsByIssuer(ctx, oldIssuer, types.VerificationType_VT_UNSPECIFIED, func(_ types.VerificationType, verificationId []byte) bool {
+		holder := k.getHolderByVerificationId(ctx, verificationId)
+		if !holder.Empty() && !seen[string(holder)] {
+			seen[string(holder)] = true
+			holders = append(holders, holder)
+		}
+		return true
+	})
+
+	var migrated uint64
+	for _, address := range holders {
+		addressDetails, err := k.GetFullAddressDetails(ctx, address)
+		if err != nil {
+			return 0, err
+		}
+
+		updated := false
+		for _, verification := range addressDetails.Verifications {
+			if verification.IssuerAddress == oldIssuer.String() {
+				verification.IssuerAddress = newIssuer.String()
+				updated = true
+				migrated++
+			}
+		}
+
+		if updated {
+			if err = k.SetAddressDetails(ctx, address, addressDetails); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return migrated, nil
+}
+
+// SetIssuerMigration stores migration of issuer and links old issuer address to the new one
+func (k Keeper) SetIssuerMigration(ctx sdk.Context, migration *types.IssuerMigration) error {
+	oldIssuer, err := sdk.AccAddressFromBech32(migration.OldAddress)
+	if err != nil {
+		return err
+	}
+	newIssuer, err := sdk.AccAddressFromBech32(migration.NewAddress)
+	if err != nil {
+		return err
+	}
+
+	migrationBytes, err := migration.Marshal()
+	if err != nil {
+		return err
+	}
+
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIssuerAlias).Set(oldIssuer.Bytes(), migrationBytes)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIssuerPredecessor).Set(newIssuer.Bytes(), oldIssuer.Bytes())
+
+	return nil
+}
+
+// GetIssuerMigration returns migration of provided issuer address, or nil if address was not migrated
+func (k Keeper) GetIssuerMigration(ctx sdk.Context, oldIssuer sdk.AccAddress) (*types.IssuerMigration, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIssuerAlias)
+
+	migrationBytes := store.Get(oldIssuer.Bytes())
+	if migrationBytes == nil {
+		return nil, nil
+	}
+
+	var migration types.IssuerMigration
+	if err := proto.Unmarshal(migrationBytes, &migration); err != nil {
+		return nil, err
+	}
+
+	return &migration, nil
+}
+
+// ResolveIssuerAddress returns actual issuer address for provided address. If provided address
+// is not an alias of migrated issuer, it is returned as is.
+func (k Keeper) ResolveIssuerAddress(ctx sdk.Context, issuerAddress sdk.AccAddress) sdk.AccAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIssuerAlias)
+
+	current := issuerAddress
+	for {
+		migrationBytes := store.Get(current.Bytes())
+		if migrationBytes == nil {
+			return current
+		}
+
+		var migration types.IssuerMigration
+		if err := proto.Unmarshal(migrationBytes, &migration); err != nil {
+			return current
+		}
+		next, err := sdk.AccAddressFromBech32(migration.NewAddress)
+		if err != nil {
+			return current
+		}
+		current = next
+	}
+}
+
+// resolveIssuerAddresses returns actual addresses of provided issuers. Nil slice is returned as is
+func (k Keeper) resolveIssuerAddresses(ctx sdk.Context, issuers []sdk.AccAddress) []sdk.AccAddress {
+	if issuers == nil {
+		return nil
+	}
+
+	resolved := make([]sdk.AccAddress, len(issuers))
+	for i, issuer := range issuers {
+		resolved[i] = k.ResolveIssuerAddress(ctx, issuer)
+	}
+	return resolved
+}
+
+// GetIssuerAliasHistory returns actual issuer address and all the migrations of issuer,
+// which used provided address, ordered from the oldest one
+func (k Keeper) GetIssuerAliasHistory(ctx sdk.Context, issuerAddress sdk.AccAddress) (sdk.AccAddress, []types.IssuerMigration, error) {
+	predecessorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIssuerPredecessor)
+
+	// Find the first address of issuer
+	first := issuerAddress
+	for {
+		predecessor := predecessorStore.Get(first.Bytes())
+		if predecessor == nil {
+			break
+		}
+		first = predecessor
+	}
+
+	var migrations []types.IssuerMigration
+	current := first
+	for {
+		migration, err := k.GetIssuerMigration(ctx, current)
+		if err != nil {
+			return nil, nil, err
+		}
+		if migration == nil {
+			break
+		}
+		migrations = append(migrations, *migration)
+
+		current, err = sdk.AccAddressFromBech32(migration.NewAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return current, migrations, nil
+}
+
+// isIssuerAddressUsed checks if provided address was used by issuer before or is a result of migration
+func (k Keeper) isIssuerAddressUsed(ctx sdk.Context, address sdk.AccAddress) bool {
+	aliasStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIssuerAlias)
+	predecessorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIssuerPredecessor)
+	return aliasStore.Has(address.Bytes()) || predecessorStore.Has(address.Bytes())
+}
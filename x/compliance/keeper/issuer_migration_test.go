@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/tests"
+	"swisstronik/x/compliance/keeper"
+	"swisstronik/x/compliance/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrateIssuer() {
+	ctx, _ := suite.ctx.CacheContext()
+	oldIssuer := suite.createVerifiedIssuer(ctx)
+	newIssuer := tests.RandomAccAddress()
+	holder := tests.RandomAccAddress()
+
+	verificationId := suite.addExpiringVerification(ctx, oldIssuer, holder, 4000000000)
+	suite.Require().NoError(suite.keeper.SetIssuerQuota(ctx, oldIssuer, types.IssuanceQuota{MaxIssuances: 5, WindowBlocks: 100}))
+
+	migrated, err := suite.keeper.MigrateIssuer(ctx, oldIssuer, newIssuer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), migrated)
+
+	// Issuer details and verification status are moved to the new address
+	exists, err := suite.keeper.IssuerExists(ctx, oldIssuer)
+	suite.Require().NoError(err)
+	suite.Require().False(exists)
+	details, err := suite.keeper.GetIssuerDetails(ctx, newIssuer)
+	suite.Require().NoError(err)
+	suite.Require().Equal("testIssuer", details.Name)
+	suite.Require().Equal(&types.IssuanceQuota{MaxIssuances: 5, WindowBlocks: 100}, details.IssuanceQuota)
+	verified, err := suite.keeper.IsAddressVerified(ctx, newIssuer)
+	suite.Require().NoError(err)
+	suite.Require().True(verified)
+	verified, err = suite.keeper.IsAddressVerified(ctx, oldIssuer)
+	suite.Require().NoError(err)
+	suite.Require().False(verified)
+
+	// Verification records are linked to the new address, but original credential is kept
+	addressDetails, err := suite.keeper.GetAddressDetails(ctx, holder)
+	suite.Require().NoError(err)
+	suite.Require().Len(addressDetails.Verifications, 1)
+	suite.Require().Equal(newIssuer.String(), addressDetails.Verifications[0].IssuerAddress)
+	verificationDetails, err := suite.keeper.GetVerificationDetails(ctx, verificationId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(oldIssuer.String(), verificationDetails.IssuerAddress)
+	revoked, err := suite.keeper.IsVerificationRevoked(ctx, verificationId)
+	suite.Require().NoError(err)
+	suite.Require().False(revoked)
+
+	// Old address is an alias of the new one
+	for _, issuer := range []sdk.AccAddress{oldIssuer, newIssuer} {
+		has, err := suite.keeper.HasVerificationOfType(ctx, holder, types.VerificationType_VT_KYC, 3000000000, []sdk.AccAddress{issuer})
+		suite.Require().NoError(err)
+		suite.Require().True(has)
+
+		verifications, err := suite.keeper.GetVerificationsOfType(ctx, holder, types.VerificationType_VT_KYC, issuer)
+		suite.Require().NoError(err)
+		suite.Require().Len(verifications, 1)
+	}
+
+	// Only new address can issue and revoke verifications
+	_, err = suite.addVerificationOfType(ctx, oldIssuer, types.VerificationType_VT_KYC)
+	suite.Require().ErrorIs(err, types.ErrInvalidIssuer)
+	_, err = suite.addVerificationOfType(ctx, newIssuer, types.VerificationType_VT_KYC)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.RevokeVerification(ctx, verificationId, oldIssuer)
+	suite.Require().ErrorIs(err, types.ErrInvalidParam)
+	err = suite.keeper.RevokeVerification(ctx, verificationId, newIssuer)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMigrateIssuerVisitsOnlyAffectedHolders() {
+	migrate := func(unrelatedHolders int) (uint64, sdk.Gas) {
+		ctx, _ := suite.ctx.CacheContext()
+		oldIssuer := suite.createVerifiedIssuer(ctx)
+		holder := tests.RandomAccAddress()
+		suite.addExpiringVerification(ctx, oldIssuer, holder, 4000000000)
+		suite.addExpiringVerification(ctx, oldIssuer, holder, 4000000001)
+
+		anotherIssuer := suite.createVerifiedIssuer(ctx)
+		unrelated := make([]sdk.AccAddress, unrelatedHolders)
+		for i := range unrelated {
+			unrelated[i] = tests.RandomAccAddress()
+			suite.addExpiringVerification(ctx, anotherIssuer, unrelated[i], 4000000000)
+		}
+
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		migrated, err := suite.keeper.MigrateIssuer(ctx, oldIssuer, tests.RandomAccAddress())
+		suite.Require().NoError(err)
+		gasUsed := ctx.GasMeter().GasConsumed()
+
+		// Verifications of another issuer are kept as is
+		for _, address := range unrelated {
+			addressDetails, err := suite.keeper.GetAddressDetails(ctx, address)
+			suite.Require().NoError(err)
+			suite.Require().Equal(anotherIssuer.String(), addressDetails.Verifications[0].IssuerAddress)
+		}
+
+		return migrated, gasUsed
+	}
+
+	migrated, gasWithoutOthers := migrate(0)
+	suite.Require().Equal(uint64(2), migrated)
+
+	// Migration cost does not depend on number of holders verified by other issuers
+	migrated, gasWithOthers := migrate(20)
+	suite.Require().Equal(uint64(2), migrated)
+	suite.Require().Equal(gasWithoutOthers, gasWithOthers)
+}
+
+func (suite *KeeperTestSuite) TestMigrateIssuerInvalid() {
+	ctx, _ := suite.ctx.CacheContext()
+	issuer := suite.createVerifiedIssuer(ctx)
+	anotherIssuer := suite.createVerifiedIssuer(ctx)
+
+	// Not existing issuer
+	_, err := suite.keeper.MigrateIssuer(ctx, tests.RandomAccAddress(), tests.RandomAccAddress())
+	suite.Require().ErrorIs(err, types.ErrInvalidIssuer)
+
+	// New address is used by another issuer
+	_, err = suite.keeper.MigrateIssuer(ctx, issuer, anotherIssuer)
+	suite.Require().ErrorIs(err, types.ErrInvalidIssuer)
+
+	// Previous issuer address cannot be reused
+	newIssuer := tests.RandomAccAddress()
+	_, err = suite.keeper.MigrateIssuer(ctx, issuer, newIssuer)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.MigrateIssuer(ctx, newIssuer, issuer)
+	suite.Require().ErrorIs(err, types.ErrInvalidIssuer)
+}
+
+func (suite *KeeperTestSuite) TestIssuerAliasHistory() {
+	ctx, _ := suite.ctx.CacheContext()
+	q := keeper.Querier{Keeper: suite.keeper}
+
+	first := suite.createVerifiedIssuer(ctx)
+	second := tests.RandomAccAddress()
+	third := tests.RandomAccAddress()
+
+	_, err := suite.keeper.MigrateIssuer(ctx, first, second)
+	suite.Require().NoError(err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = suite.keeper.MigrateIssuer(ctx, second, third)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(third, suite.keeper.ResolveIssuerAddress(ctx, first))
+
+	for _, address := range []sdk.AccAddress{first, second, third} {
+		resp, err := q.IssuerAliasHistory(sdk.WrapSDKContext(ctx), &types.QueryIssuerAliasHistoryRequest{IssuerAddress: address.String()})
+		suite.Require().NoError(err)
+		suite.Require().Equal(third.String(), resp.CurrentAddress)
+		suite.Require().Len(resp.Migrations, 2)
+		suite.Require().Equal(first.String(), resp.Migrations[0].OldAddress)
+		suite.Require().Equal(second.String(), resp.Migrations[0].NewAddress)
+		suite.Require().Equal(second.String(), resp.Migrations[1].OldAddress)
+		suite.Require().Equal(third.String(), resp.Migrations[1].NewAddress)
+		suite.Require().Equal(ctx.BlockHeight(), resp.Migrations[1].BlockHeight)
+	}
+
+	// Issuer without migrations
+	issuer := suite.createVerifiedIssuer(ctx)
+	resp, err := q.IssuerAliasHistory(sdk.WrapSDKContext(ctx), &types.QueryIssuerAliasHistoryRequest{IssuerAddress: issuer.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(issuer.String(), resp.CurrentAddress)
+	suite.Require().Empty(resp.Migrations)
+}
+
+func (suite *KeeperTestSuite) TestHandleMigrateIssuer() {
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	details, err := suite.keeper.GetIssuerDetails(ctx, issuer)
+	suite.Require().NoError(err)
+
+	// Neither operator nor issuer creator
+	msg := types.NewMsgMigrateIssuer(tests.RandomAccAddress().String(), issuer.String(), tests.RandomAccAddress().String())
+	_, err = msgServer.HandleMigrateIssuer(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrNotOperatorOrIssuerCreator)
+
+	// Issuer creator
+	newIssuer := tests.RandomAccAddress()
+	msg = types.NewMsgMigrateIssuer(details.Creator, issuer.String(), newIssuer.String())
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	resp, err := msgServer.HandleMigrateIssuer(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), resp.MigratedVerifications)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeMigrateIssuer, events[0].Type)
+
+	// Operator
+	operator := tests.RandomAccAddress()
+	suite.Require().NoError(suite.keeper.AddOperator(ctx, operator, types.OperatorType_OT_REGULAR))
+	msg = types.NewMsgMigrateIssuer(operator.String(), newIssuer.String(), tests.RandomAccAddress().String())
+	_, err = msgServer.HandleMigrateIssuer(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+}
@@ -96,6 +96,49 @@ func (k Keeper) IterateLinksToPublicKey(ctx sdk.Context, callback func(verificat
 	}
 }
 
+func (k Keeper) IterateIssuerMigrations(ctx sdk.Context, callback func(oldIssuer sdk.AccAddress) (continue_ bool)) {
+	latestVersionIterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixIssuerAlias)
+	defer closeIteratorOrPanic(latestVersionIterator)
+
+	for ; latestVersionIterator.Valid(); latestVersionIterator.Next() {
+		key := latestVersionIterator.Key()
+		address := types.AccAddressFromKey(key)
+		if !callback(address) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateRevocationDetails(ctx sdk.Context, callback func(verificationId []byte) (continue_ bool)) {
+	latestVersionIterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixRevocationDetails)
+	defer closeIteratorOrPanic(latestVersionIterator)
+
+	for ; latestVersionIterator.Valid(); latestVersionIterator.Next() {
+		key := latestVersionIterator.Key()
+		id := types.VerificationIdFromKey(key)
+		if !callback(id) {
+			break
+		}
+	}
+}
+
+// IterateRootHistory iterates over kept tree roots. Roots are not ordered by block height
+func (k Keeper) IterateRootHistory(ctx sdk.Context, callback func(roots types.TreeRoots) (continue_ bool)) error {
+	latestVersionIterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixRootHistory)
+	defer closeIteratorOrPanic(latestVersionIterator)
+
+	for ; latestVersionIterator.Valid(); latestVersionIterator.Next() {
+		var roots types.TreeRoots
+		if err := roots.Unmarshal(latestVersionIterator.Value()); err != nil {
+			return err
+		}
+		if !callback(roots) {
+			break
+		}
+	}
+	return nil
+}
+
 func closeIteratorOrPanic(iterator sdk.Iterator) {
 	err := iterator.Close()
 	if err != nil {
@@ -24,6 +24,9 @@ type (
 		storeKey   storetypes.StoreKey
 		memKey     storetypes.StoreKey
 		paramstore paramtypes.Subspace
+
+		// the address capable of executing governance messages. Typically, this should be the x/gov module account.
+		authority sdk.AccAddress
 	}
 )
 
@@ -31,7 +34,13 @@ func NewKeeper(
 	storeKey,
 	memKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
+	authority sdk.AccAddress,
 ) *Keeper {
+	// ensure the authority account is correct
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
@@ -40,9 +49,15 @@ func NewKeeper(
 		storeKey:   storeKey,
 		memKey:     memKey,
 		paramstore: ps,
+		authority:  authority,
 	}
 }
 
+// GetAuthority returns the x/compliance module authority address
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -71,6 +86,7 @@ func (k Keeper) RemoveIssuer(ctx sdk.Context, issuerAddress sdk.AccAddress) {
 
 	// Remove address details for issuer
 	k.RemoveAddressDetails(ctx, issuerAddress)
+	k.RemoveIssuanceCounter(ctx, issuerAddress)
 }
 
 // GetIssuerDetails returns details of provided issuer address
@@ -288,7 +304,7 @@ func (k Keeper) addVerificationDetailsInternal(ctx sdk.Context, userAddress sdk.
 		return nil, errors.Wrap(types.ErrInvalidIssuer, "issuer not verified")
 	}
 
-	if verificationType <= types.VerificationType_VT_UNSPECIFIED || verificationType > types.VerificationType_VT_BIOMETRIC {
+	if !verificationType.IsValid() {
 		return nil, errors.Wrap(types.ErrInvalidParam, "invalid verification type")
 	}
 	details.Type = verificationType
@@ -316,8 +332,17 @@ func (k Keeper) addVerificationDetailsInternal(ctx sdk.Context, userAddress sdk.
 		)
 	}
 
+	// Check if issuer is allowed to issue verification of provided type and did not exceed its quota
+	if err = k.checkIssuerRestrictions(ctx, issuerAddress, verificationType); err != nil {
+		return nil, err
+	}
+
 	// If there is no such verification details associated with provided address, write them to the table
 	verificationDetailsStore.Set(verificationDetailsID, detailsBytes)
+	k.SetVerificationExpiration(ctx, verificationDetailsID, details.ExpirationTimestamp)
+	if err = k.SetVerificationIndexes(ctx, verificationDetailsID, details); err != nil {
+		return nil, err
+	}
 
 	// Associate provided verification details with user address
 	verification := &types.Verification{
@@ -343,6 +368,9 @@ func (k Keeper) addVerificationDetailsInternal(ctx sdk.Context, userAddress sdk.
 		return nil, err
 	}
 
+	// Requested verification was issued
+	k.RemoveVerificationRequest(ctx, issuerAddress, verificationType, userAddress)
+
 	return verificationDetailsID, nil
 }
 
@@ -370,6 +398,10 @@ func (k Keeper) SetVerificationDetails(
 
 	// If there is no such verification details associated with provided address, write them to the table
 	verificationDetailsStore.Set(verificationDetailsId, detailsBytes)
+	k.SetVerificationExpiration(ctx, verificationDetailsId, details.ExpirationTimestamp)
+	if err = k.SetVerificationIndexes(ctx, verificationDetailsId, details); err != nil {
+		return err
+	}
 
 	issuerAddress, err := sdk.AccAddressFromBech32(details.IssuerAddress)
 	if err != nil {
@@ -423,38 +455,53 @@ func (k Keeper) RevokeVerification(ctx sdk.Context, verificationDetailsId []byte
 		return errors.Wrap(types.ErrInvalidParam, "verification was already revoked")
 	}
 
-	if verificationDetails.IssuerAddress != issuerAddress.String() {
+	verificationIssuer, err := sdk.AccAddressFromBech32(verificationDetails.IssuerAddress)
+	if err != nil {
+		return err
+	}
+	// Verification issued by migrated issuer can be revoked only by its actual address
+	if !k.ResolveIssuerAddress(ctx, verificationIssuer).Equals(issuerAddress) {
 		return errors.Wrap(types.ErrInvalidParam, "caller is not verification issuer")
 	}
 
 	return k.MarkVerificationDetailsAsRevoked(ctx, verificationDetailsId)
 }
 
+// MarkVerificationDetailsAsRevoked marks verification as revoked without specifying revocation reason
 func (k Keeper) MarkVerificationDetailsAsRevoked(
 	ctx sdk.Context,
 	verificationDetailsId []byte,
 ) error {
+	return k.MarkVerificationsAsRevoked(ctx, [][]byte{verificationDetailsId}, types.RevocationReason_RR_UNSPECIFIED, "")
+}
+
+// markVerificationDetailsAsRevoked marks verification as revoked and returns hash of credential,
+// which should be added to revocation tree. If holder has no attached public key, returns nil hash.
+func (k Keeper) markVerificationDetailsAsRevoked(
+	ctx sdk.Context,
+	verificationDetailsId []byte,
+) (*common.Hash, error) {
 	verificationDetailsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationDetails)
 	if !verificationDetailsStore.Has(verificationDetailsId) {
-		return errors.Wrap(types.ErrInvalidParam, "there is no such verification with provided ID")
+		return nil, errors.Wrap(types.ErrInvalidParam, "there is no such verification with provided ID")
 	}
 
 	prevVerificationDetailsBytes := verificationDetailsStore.Get(verificationDetailsId)
 	if prevVerificationDetailsBytes == nil {
-		return errors.Wrap(types.ErrInvalidParam, "verification with provided ID is empty")
+		return nil, errors.Wrap(types.ErrInvalidParam, "verification with provided ID is empty")
 	}
 
 	prevVerificationDetails := &types.VerificationDetails{}
 	err := proto.Unmarshal(prevVerificationDetailsBytes, prevVerificationDetails)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	prevVerificationDetails.IsRevoked = true
 
 	detailsBytes, err := prevVerificationDetails.Marshal()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// If there is no such verification details associated with provided address, write them to the table
@@ -462,12 +509,12 @@ func (k Keeper) MarkVerificationDetailsAsRevoked(
 
 	issuerAddress, err := sdk.AccAddressFromBech32(prevVerificationDetails.IssuerAddress)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	userAddress := k.getHolderByVerificationId(ctx, verificationDetailsId)
 	if userAddress.Empty() {
-		return errors.Wrap(
+		return nil, errors.Wrap(
 			types.ErrBadRequest,
 			"cannot find associated user address. Please create a ticket if you see this error",
 		)
@@ -489,13 +536,14 @@ func (k Keeper) MarkVerificationDetailsAsRevoked(
 		}
 		credentialHash, err := credential.Hash()
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		return k.MarkCredentialHashAsRevoked(ctx, common.BigToHash(credentialHash))
+		hash := common.BigToHash(credentialHash)
+		return &hash, nil
 	}
 
-	return nil
+	return nil, nil
 }
 
 // GetVerificationDetails returns verification details for provided ID
@@ -510,12 +558,12 @@ func (k Keeper) GetVerificationDetails(ctx sdk.Context, verificationId []byte) (
 		return &types.VerificationDetails{}, nil
 	}
 
-	// Check if issuer exists
+	// Check if issuer exists. If issuer was migrated, check its actual address
 	issuerAddress, err := sdk.AccAddressFromBech32(verificationDetails.IssuerAddress)
 	if err != nil {
 		return nil, err
 	}
-	exists, err := k.IssuerExists(ctx, issuerAddress)
+	exists, err := k.IssuerExists(ctx, k.ResolveIssuerAddress(ctx, issuerAddress))
 	if err != nil {
 		return nil, err
 	}
@@ -548,6 +596,9 @@ func (k Keeper) GetVerificationDetailsByIssuer(ctx sdk.Context, userAddress sdk.
 		return nil, nil, err
 	}
 
+	// Previous address of migrated issuer is treated as an alias of actual one
+	issuerAddress = k.ResolveIssuerAddress(ctx, issuerAddress)
+
 	var (
 		filteredVerifications       []*types.Verification
 		filteredVerificationDetails []*types.VerificationDetails
@@ -567,6 +618,22 @@ func (k Keeper) GetVerificationDetailsByIssuer(ctx sdk.Context, userAddress sdk.
 }
 
 func (k Keeper) GetCredentialHashByVerificationId(ctx sdk.Context, verificationId []byte) ([]byte, error) {
+	credentialValue, err := k.GetZKCredentialByVerificationId(ctx, verificationId)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialHash, err := credentialValue.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	return credentialHash.Bytes(), nil
+}
+
+// GetZKCredentialByVerificationId returns ZK credential constructed from verification details
+// and public key attached to verification or its holder
+func (k Keeper) GetZKCredentialByVerificationId(ctx sdk.Context, verificationId []byte) (*types.ZKCredential, error) {
 	details, err := k.GetVerificationDetails(ctx, verificationId)
 	if err != nil {
 		return nil, err
@@ -588,19 +655,13 @@ func (k Keeper) GetCredentialHashByVerificationId(ctx sdk.Context, verificationI
 		return nil, errors.Wrap(types.ErrInvalidParam, "verification with provided ID has no public key to attach")
 	}
 
-	credentialValue := &types.ZKCredential{
+	return &types.ZKCredential{
 		Type:                details.Type,
 		IssuerAddress:       issuerAddress.Bytes(),
 		HolderPublicKey:     userPublicKey,
 		ExpirationTimestamp: details.ExpirationTimestamp,
 		IssuanceTimestamp:   details.IssuanceTimestamp,
-	}
-	credentialHash, err := credentialValue.Hash()
-	if err != nil {
-		return nil, err
-	}
-
-	return credentialHash.Bytes(), nil
+	}, nil
 }
 
 // HasVerificationOfType checks if user has verifications of specific type (for example, passed KYC) from provided issuers.
@@ -617,6 +678,9 @@ func (k Keeper) HasVerificationOfType(ctx sdk.Context, userAddress sdk.AccAddres
 		expirationTimestamp = ^uint32(0)
 	}
 
+	// Previous addresses of migrated issuers are treated as aliases of actual ones
+	expectedIssuers = k.resolveIssuerAddresses(ctx, expectedIssuers)
+
 	for _, verification := range userAddressDetails.Verifications {
 		if verification.Type == expectedType {
 			// If not found matched issuer, do not get details to check expiration
@@ -665,6 +729,9 @@ func (k Keeper) GetVerificationsOfType(ctx sdk.Context, userAddress sdk.AccAddre
 		return nil, nil
 	}
 
+	// Previous addresses of migrated issuers are treated as aliases of actual ones
+	expectedIssuers = k.resolveIssuerAddresses(ctx, expectedIssuers)
+
 	// Extract verification data
 	var verifications []*types.VerificationDetails
 	for _, verification := range appropriateTypeVerifications {
@@ -846,7 +913,7 @@ func (k Keeper) IsVerificationRevoked(ctx sdk.Context, verificationId []byte) (b
 	}
 
 	issuerAddress, err := sdk.AccAddressFromBech32(verificationDetails.IssuerAddress)
-	addressDetails, err := k.GetAddressDetails(ctx, issuerAddress)
+	addressDetails, err := k.GetAddressDetails(ctx, k.ResolveIssuerAddress(ctx, issuerAddress))
 	if err != nil {
 		return false, err
 	}
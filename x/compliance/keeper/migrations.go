@@ -3,6 +3,8 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"swisstronik/x/compliance/migrations/v1_0_3"
+	"swisstronik/x/compliance/migrations/v3"
+	"swisstronik/x/compliance/migrations/v4"
 )
 
 type Migrator struct {
@@ -18,3 +20,11 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1_0_2to1_0_3(ctx sdk.Context) error {
 	return v1_0_3.MigrateStore(ctx, m.keeper)
 }
+
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper)
+}
+
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper)
+}
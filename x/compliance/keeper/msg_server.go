@@ -3,9 +3,11 @@ package keeper
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"swisstronik/x/compliance/types"
 )
@@ -128,6 +130,8 @@ func (k msgServer) HandleRevokeVerification(goCtx context.Context, msg *types.Ms
 	if err != nil {
 		return nil, err
 	}
+	// If issuer was migrated, check creator of actual issuer
+	issuer = k.ResolveIssuerAddress(ctx, issuer)
 
 	// Check if signer is operator or issuer creator
 	if exists, err := k.OperatorExists(ctx, signer); !exists || err != nil {
@@ -208,6 +212,9 @@ func (k msgServer) HandleCreateIssuer(goCtx context.Context, msg *types.MsgCreat
 	}
 
 	msg.Details.Creator = signer.String()
+	// Issuer restrictions can be set only by operator through dedicated messages
+	msg.Details.AllowedVerificationTypes = nil
+	msg.Details.IssuanceQuota = nil
 
 	// Store issuer details with creator address
 	if err = k.SetIssuerDetails(ctx, issuer, msg.Details); err != nil {
@@ -264,6 +271,10 @@ func (k msgServer) HandleUpdateIssuerDetails(goCtx context.Context, msg *types.M
 		return nil, err
 	}
 
+	// Issuer restrictions can be changed only by operator through dedicated messages
+	msg.Details.AllowedVerificationTypes = details.AllowedVerificationTypes
+	msg.Details.IssuanceQuota = details.IssuanceQuota
+
 	if err = k.SetIssuerDetails(ctx, issuer, msg.Details); err != nil {
 		return nil, err
 	}
@@ -410,3 +421,354 @@ func (k msgServer) HandleConvertCredential(goCtx context.Context, msg *types.Msg
 
 	return &types.MsgConvertCredentialResponse{}, nil
 }
+
+// HandleVerifyIssuer implements the gRPC MsgServer interface. When a VerifyIssuer
+// proposal passes, it marks provided issuer as verified. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module account.
+func (k msgServer) HandleVerifyIssuer(goCtx context.Context, msg *types.MsgVerifyIssuer) (*types.MsgVerifyIssuerResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check validity of issuer address
+	issuer, err := sdk.AccAddressFromBech32(msg.IssuerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Issuer should exist and should not be verified yet
+	if exists, err := k.IssuerExists(ctx, issuer); !exists || err != nil {
+		return nil, errors.Wrap(types.ErrInvalidIssuer, "issuer does not exist")
+	}
+	verified, err := k.IsAddressVerified(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+	if verified {
+		return nil, errors.Wrap(types.ErrInvalidIssuer, "issuer already verified")
+	}
+
+	if err = k.SetAddressVerificationStatus(ctx, issuer, true); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVerifyIssuer,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.IssuerAddress),
+			sdk.NewAttribute(types.AttributeKeyVerificationStatus, strconv.FormatBool(true)),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+		),
+	)
+
+	return &types.MsgVerifyIssuerResponse{}, nil
+}
+
+// HandleUnverifyIssuer implements the gRPC MsgServer interface. When an UnverifyIssuer
+// proposal passes, it revokes verification status of provided issuer. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module account.
+func (k msgServer) HandleUnverifyIssuer(goCtx context.Context, msg *types.MsgUnverifyIssuer) (*types.MsgUnverifyIssuerResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check validity of issuer address
+	issuer, err := sdk.AccAddressFromBech32(msg.IssuerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Issuer should exist and should be verified
+	if exists, err := k.IssuerExists(ctx, issuer); !exists || err != nil {
+		return nil, errors.Wrap(types.ErrInvalidIssuer, "issuer does not exist")
+	}
+	verified, err := k.IsAddressVerified(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, errors.Wrap(types.ErrInvalidIssuer, "issuer is not verified")
+	}
+
+	if err = k.SetAddressVerificationStatus(ctx, issuer, false); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVerifyIssuer,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.IssuerAddress),
+			sdk.NewAttribute(types.AttributeKeyVerificationStatus, strconv.FormatBool(false)),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+		),
+	)
+
+	return &types.MsgUnverifyIssuerResponse{}, nil
+}
+
+// HandleGovAddOperator implements the gRPC MsgServer interface. When a GovAddOperator
+// proposal passes, it adds provided address as regular operator. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module account.
+func (k msgServer) HandleGovAddOperator(goCtx context.Context, msg *types.MsgGovAddOperator) (*types.MsgGovAddOperatorResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check validity of operator address
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Do not allow to add duplicated operator
+	exists, err := k.OperatorExists(ctx, operator)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.Wrapf(types.ErrInvalidOperator, "operator already exists")
+	}
+
+	if err = k.AddOperator(ctx, operator, types.OperatorType_OT_REGULAR); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddOperator,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+		),
+	)
+
+	return &types.MsgGovAddOperatorResponse{}, nil
+}
+
+// HandleGovRemoveOperator implements the gRPC MsgServer interface. When a GovRemoveOperator
+// proposal passes, it removes provided regular operator. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module account.
+func (k msgServer) HandleGovRemoveOperator(goCtx context.Context, msg *types.MsgGovRemoveOperator) (*types.MsgGovRemoveOperatorResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check validity of operator address
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only allowed to remove regular operator
+	if err = k.RemoveRegularOperator(ctx, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveOperator,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+		),
+	)
+
+	return &types.MsgGovRemoveOperatorResponse{}, nil
+}
+
+func (k msgServer) HandleSetIssuerVerificationTypes(goCtx context.Context, msg *types.MsgSetIssuerVerificationTypes) (*types.MsgSetIssuerVerificationTypesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check validity of signer address
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only operator can set verification types allowed for issuer
+	if exists, err := k.OperatorExists(ctx, signer); !exists || err != nil {
+		return nil, types.ErrNotOperator
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.SetIssuerVerificationTypes(ctx, issuer, msg.VerificationTypes); err != nil {
+		return nil, err
+	}
+
+	verificationTypes := make([]string, len(msg.VerificationTypes))
+	for i, verificationType := range msg.VerificationTypes {
+		verificationTypes[i] = verificationType.String()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetIssuerVerificationTypes,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Signer),
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.Issuer),
+			sdk.NewAttribute(types.AttributeKeyVerificationTypes, strings.Join(verificationTypes, ",")),
+		),
+	)
+
+	return &types.MsgSetIssuerVerificationTypesResponse{}, nil
+}
+
+func (k msgServer) HandleSetIssuerQuota(goCtx context.Context, msg *types.MsgSetIssuerQuota) (*types.MsgSetIssuerQuotaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check validity of signer address
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only operator can set issuance quota for issuer
+	if exists, err := k.OperatorExists(ctx, signer); !exists || err != nil {
+		return nil, types.ErrNotOperator
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.SetIssuerQuota(ctx, issuer, msg.Quota); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetIssuerQuota,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Signer),
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.Issuer),
+			sdk.NewAttribute(types.AttributeKeyIssuanceQuota, msg.Quota.String()),
+		),
+	)
+
+	return &types.MsgSetIssuerQuotaResponse{}, nil
+}
+
+func (k msgServer) HandleMigrateIssuer(goCtx context.Context, msg *types.MsgMigrateIssuer) (*types.MsgMigrateIssuerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check validity of signer address
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	newIssuer, err := sdk.AccAddressFromBech32(msg.NewIssuer)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := k.GetIssuerDetails(ctx, issuer)
+	if err != nil || len(details.Name) < 1 {
+		return nil, errors.Wrap(types.ErrInvalidIssuer, "issuer does not exist")
+	}
+
+	// Operator or issuer creator can migrate issuer, since issuer key itself could be compromised
+	if details.Creator != signer.String() {
+		if exists, err := k.OperatorExists(ctx, signer); !exists || err != nil {
+			// If signer is neither an operator nor issuer creator
+			return nil, errors.Wrap(types.ErrNotOperatorOrIssuerCreator, "issuer creator does not match")
+		}
+	}
+
+	migrated, err := k.MigrateIssuer(ctx, issuer, newIssuer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMigrateIssuer,
+			sdk.NewAttribute(types.AttributeKeyIssuerCreator, msg.Signer),
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.Issuer),
+			sdk.NewAttribute(types.AttributeKeyNewIssuer, msg.NewIssuer),
+			sdk.NewAttribute(types.AttributeKeyMigratedCount, strconv.FormatUint(migrated, 10)),
+		),
+	)
+
+	return &types.MsgMigrateIssuerResponse{MigratedVerifications: migrated}, nil
+}
+
+func (k msgServer) HandleRevokeVerifications(goCtx context.Context, msg *types.MsgRevokeVerifications) (*types.MsgRevokeVerificationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check validity of signer address
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	isOperator, err := k.OperatorExists(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache of issuers, which were already checked to be created by signer
+	checkedIssuers := make(map[string]struct{})
+	for _, verificationId := range msg.VerificationIds {
+		// Check if verification details exist
+		verificationDetails, err := k.GetVerificationDetails(ctx, verificationId)
+		if err != nil {
+			return nil, err
+		}
+		if verificationDetails.IsEmpty() {
+			return nil, errors.Wrapf(types.ErrInvalidParam, "verification does not exist (%x)", verificationId)
+		}
+		if verificationDetails.IsRevoked {
+			return nil, errors.Wrapf(types.ErrInvalidParam, "verification was already revoked (%x)", verificationId)
+		}
+
+		if isOperator {
+			continue
+		}
+
+		// If signer is not an operator, check if it's creator of verification issuer
+		if _, ok := checkedIssuers[verificationDetails.IssuerAddress]; ok {
+			continue
+		}
+		issuer, err := sdk.AccAddressFromBech32(verificationDetails.IssuerAddress)
+		if err != nil {
+			return nil, err
+		}
+		details, err := k.GetIssuerDetails(ctx, k.ResolveIssuerAddress(ctx, issuer))
+		if err != nil || len(details.Name) < 1 {
+			return nil, errors.Wrap(types.ErrInvalidIssuer, "issuer details not found")
+		}
+		if details.Creator != signer.String() {
+			return nil, errors.Wrap(types.ErrNotOperatorOrIssuerCreator, "issuer creator or operator does not match")
+		}
+		checkedIssuers[verificationDetails.IssuerAddress] = struct{}{}
+	}
+
+	if err = k.MarkVerificationsAsRevoked(ctx, msg.VerificationIds, msg.Reason, msg.Note); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeVerifications,
+			sdk.NewAttribute(types.AttributeKeyIssuerCreator, msg.Signer),
+			sdk.NewAttribute(types.AttributeKeyRevocationReason, msg.Reason.String()),
+			sdk.NewAttribute(types.AttributeKeyRevokedCount, strconv.Itoa(len(msg.VerificationIds))),
+		),
+	)
+
+	return &types.MsgRevokeVerificationsResponse{}, nil
+}
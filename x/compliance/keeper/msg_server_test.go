@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/iden3/go-iden3-crypto/babyjub"
 	"github.com/status-im/keycard-go/hexutils"
@@ -383,6 +384,206 @@ func (suite *KeeperTestSuite) TestSetVerificationStatus() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVerifyIssuerByAuthority() {
+	var issuer sdk.AccAddress
+	authority := suite.keeper.GetAuthority().String()
+
+	testCases := []struct {
+		name     string
+		init     func()
+		malleate func() *types.MsgVerifyIssuer
+		expected func(resp *types.MsgVerifyIssuerResponse, error error)
+	}{
+		{
+			name: "invalid authority",
+			init: func() {
+				issuer = tests.RandomAccAddress()
+			},
+			malleate: func() *types.MsgVerifyIssuer {
+				msg := types.NewMsgVerifyIssuer(tests.RandomAccAddress().String(), issuer.String())
+				return &msg
+			},
+			expected: func(resp *types.MsgVerifyIssuerResponse, error error) {
+				suite.Require().ErrorIs(error, govtypes.ErrInvalidSigner)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "issuer not exist",
+			init: func() {
+				issuer = tests.RandomAccAddress()
+			},
+			malleate: func() *types.MsgVerifyIssuer {
+				msg := types.NewMsgVerifyIssuer(authority, issuer.String())
+				return &msg
+			},
+			expected: func(resp *types.MsgVerifyIssuerResponse, error error) {
+				suite.Require().ErrorIs(error, types.ErrInvalidIssuer)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "issuer already verified",
+			init: func() {
+				issuer = tests.RandomAccAddress()
+				err := suite.keeper.SetIssuerDetails(suite.ctx, issuer, &types.IssuerDetails{Name: "test issuer"})
+				suite.Require().NoError(err)
+				err = suite.keeper.SetAddressVerificationStatus(suite.ctx, issuer, true)
+				suite.Require().NoError(err)
+			},
+			malleate: func() *types.MsgVerifyIssuer {
+				msg := types.NewMsgVerifyIssuer(authority, issuer.String())
+				return &msg
+			},
+			expected: func(resp *types.MsgVerifyIssuerResponse, error error) {
+				suite.Require().ErrorIs(error, types.ErrInvalidIssuer)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "success",
+			init: func() {
+				issuer = tests.RandomAccAddress()
+				err := suite.keeper.SetIssuerDetails(suite.ctx, issuer, &types.IssuerDetails{Name: "test issuer"})
+				suite.Require().NoError(err)
+			},
+			malleate: func() *types.MsgVerifyIssuer {
+				msg := types.NewMsgVerifyIssuer(authority, issuer.String())
+				return &msg
+			},
+			expected: func(resp *types.MsgVerifyIssuerResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().Equal(&types.MsgVerifyIssuerResponse{}, resp)
+
+				verified, err := suite.keeper.IsAddressVerified(suite.ctx, issuer)
+				suite.Require().NoError(err)
+				suite.Require().True(verified)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msgServer := keeper.NewMsgServerImpl(suite.keeper)
+			if tc.init != nil {
+				tc.init()
+			}
+			msg := tc.malleate()
+			resp, err := msgServer.HandleVerifyIssuer(sdk.WrapSDKContext(suite.ctx), msg)
+			tc.expected(resp, err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUnverifyIssuerByAuthority() {
+	var issuer sdk.AccAddress
+	authority := suite.keeper.GetAuthority().String()
+
+	testCases := []struct {
+		name     string
+		init     func()
+		malleate func() *types.MsgUnverifyIssuer
+		expected func(resp *types.MsgUnverifyIssuerResponse, error error)
+	}{
+		{
+			name: "invalid authority",
+			init: func() {
+				issuer = tests.RandomAccAddress()
+			},
+			malleate: func() *types.MsgUnverifyIssuer {
+				msg := types.NewMsgUnverifyIssuer(tests.RandomAccAddress().String(), issuer.String())
+				return &msg
+			},
+			expected: func(resp *types.MsgUnverifyIssuerResponse, error error) {
+				suite.Require().ErrorIs(error, govtypes.ErrInvalidSigner)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "issuer not verified",
+			init: func() {
+				issuer = tests.RandomAccAddress()
+				err := suite.keeper.SetIssuerDetails(suite.ctx, issuer, &types.IssuerDetails{Name: "test issuer"})
+				suite.Require().NoError(err)
+			},
+			malleate: func() *types.MsgUnverifyIssuer {
+				msg := types.NewMsgUnverifyIssuer(authority, issuer.String())
+				return &msg
+			},
+			expected: func(resp *types.MsgUnverifyIssuerResponse, error error) {
+				suite.Require().ErrorIs(error, types.ErrInvalidIssuer)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "success",
+			init: func() {
+				issuer = tests.RandomAccAddress()
+				err := suite.keeper.SetIssuerDetails(suite.ctx, issuer, &types.IssuerDetails{Name: "test issuer"})
+				suite.Require().NoError(err)
+				err = suite.keeper.SetAddressVerificationStatus(suite.ctx, issuer, true)
+				suite.Require().NoError(err)
+			},
+			malleate: func() *types.MsgUnverifyIssuer {
+				msg := types.NewMsgUnverifyIssuer(authority, issuer.String())
+				return &msg
+			},
+			expected: func(resp *types.MsgUnverifyIssuerResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().Equal(&types.MsgUnverifyIssuerResponse{}, resp)
+
+				verified, err := suite.keeper.IsAddressVerified(suite.ctx, issuer)
+				suite.Require().NoError(err)
+				suite.Require().False(verified)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msgServer := keeper.NewMsgServerImpl(suite.keeper)
+			if tc.init != nil {
+				tc.init()
+			}
+			msg := tc.malleate()
+			resp, err := msgServer.HandleUnverifyIssuer(sdk.WrapSDKContext(suite.ctx), msg)
+			tc.expected(resp, err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGovManageOperators() {
+	authority := suite.keeper.GetAuthority().String()
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	operator := tests.RandomAccAddress()
+
+	// Only governance authority can add operator
+	addMsg := types.NewMsgGovAddOperator(tests.RandomAccAddress().String(), operator.String())
+	_, err := msgServer.HandleGovAddOperator(sdk.WrapSDKContext(suite.ctx), &addMsg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	addMsg = types.NewMsgGovAddOperator(authority, operator.String())
+	_, err = msgServer.HandleGovAddOperator(sdk.WrapSDKContext(suite.ctx), &addMsg)
+	suite.Require().NoError(err)
+
+	// Duplicated operator should not be added
+	_, err = msgServer.HandleGovAddOperator(sdk.WrapSDKContext(suite.ctx), &addMsg)
+	suite.Require().ErrorIs(err, types.ErrInvalidOperator)
+
+	// Only governance authority can remove operator
+	removeMsg := types.NewMsgGovRemoveOperator(tests.RandomAccAddress().String(), operator.String())
+	_, err = msgServer.HandleGovRemoveOperator(sdk.WrapSDKContext(suite.ctx), &removeMsg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	removeMsg = types.NewMsgGovRemoveOperator(authority, operator.String())
+	_, err = msgServer.HandleGovRemoveOperator(sdk.WrapSDKContext(suite.ctx), &removeMsg)
+	suite.Require().NoError(err)
+
+	exists, err := suite.keeper.OperatorExists(suite.ctx, operator)
+	suite.Require().NoError(err)
+	suite.Require().False(exists)
+}
+
 func (suite *KeeperTestSuite) TestCreateIssuer() {
 	var (
 		operator sdk.AccAddress
@@ -1682,3 +1883,217 @@ func (suite *KeeperTestSuite) TestConvertCredential() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetIssuerVerificationTypes() {
+	var (
+		operator sdk.AccAddress
+		issuer   sdk.AccAddress
+	)
+	testCases := []struct {
+		name     string
+		init     func()
+		malleate func() *types.MsgSetIssuerVerificationTypes
+		expected func(resp *types.MsgSetIssuerVerificationTypesResponse, error error)
+	}{
+		{
+			name: "invalid verification type",
+			malleate: func() *types.MsgSetIssuerVerificationTypes {
+				msg := types.NewMsgSetIssuerVerificationTypes(
+					tests.RandomAccAddress().String(),
+					tests.RandomAccAddress().String(),
+					[]types.VerificationType{types.VerificationType_VT_KYC, types.VerificationType_VT_KYC},
+				)
+				return &msg
+			},
+			expected: func(resp *types.MsgSetIssuerVerificationTypesResponse, error error) {
+				suite.Require().ErrorIs(error, types.ErrInvalidParam)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "operator not exist",
+			init: func() {
+				operator = tests.RandomAccAddress()
+				issuer = tests.RandomAccAddress()
+			},
+			malleate: func() *types.MsgSetIssuerVerificationTypes {
+				msg := types.NewMsgSetIssuerVerificationTypes(
+					operator.String(),
+					issuer.String(),
+					[]types.VerificationType{types.VerificationType_VT_CREDIT_SCORE},
+				)
+				return &msg
+			},
+			expected: func(resp *types.MsgSetIssuerVerificationTypesResponse, error error) {
+				suite.Require().ErrorIs(error, types.ErrNotOperator)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "issuer not exist",
+			init: func() {
+				operator = tests.RandomAccAddress()
+				err := suite.keeper.AddOperator(suite.ctx, operator, types.OperatorType_OT_REGULAR)
+				suite.Require().NoError(err)
+
+				issuer = tests.RandomAccAddress()
+			},
+			malleate: func() *types.MsgSetIssuerVerificationTypes {
+				msg := types.NewMsgSetIssuerVerificationTypes(
+					operator.String(),
+					issuer.String(),
+					[]types.VerificationType{types.VerificationType_VT_CREDIT_SCORE},
+				)
+				return &msg
+			},
+			expected: func(resp *types.MsgSetIssuerVerificationTypesResponse, error error) {
+				suite.Require().ErrorIs(error, types.ErrInvalidIssuer)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "success",
+			init: func() {
+				operator = tests.RandomAccAddress()
+				err := suite.keeper.AddOperator(suite.ctx, operator, types.OperatorType_OT_REGULAR)
+				suite.Require().NoError(err)
+
+				issuer = tests.RandomAccAddress()
+				details := &types.IssuerDetails{Creator: tests.RandomAccAddress().String(), Name: "testIssuer"}
+				err = suite.keeper.SetIssuerDetails(suite.ctx, issuer, details)
+				suite.Require().NoError(err)
+			},
+			malleate: func() *types.MsgSetIssuerVerificationTypes {
+				msg := types.NewMsgSetIssuerVerificationTypes(
+					operator.String(),
+					issuer.String(),
+					[]types.VerificationType{types.VerificationType_VT_CREDIT_SCORE},
+				)
+				return &msg
+			},
+			expected: func(resp *types.MsgSetIssuerVerificationTypesResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().Equal(resp, &types.MsgSetIssuerVerificationTypesResponse{})
+
+				details, err := suite.keeper.GetIssuerDetails(suite.ctx, issuer)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]types.VerificationType{types.VerificationType_VT_CREDIT_SCORE}, details.AllowedVerificationTypes)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msgServer := keeper.NewMsgServerImpl(suite.keeper)
+			if tc.init != nil {
+				tc.init()
+			}
+			msg := tc.malleate()
+			// Basic validation is performed by ante handler
+			err := msg.ValidateBasic()
+			if err != nil {
+				tc.expected(nil, err)
+				return
+			}
+			resp, err := msgServer.HandleSetIssuerVerificationTypes(sdk.WrapSDKContext(suite.ctx), msg)
+			tc.expected(resp, err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetIssuerQuota() {
+	var (
+		operator sdk.AccAddress
+		issuer   sdk.AccAddress
+	)
+	testCases := []struct {
+		name     string
+		init     func()
+		malleate func() *types.MsgSetIssuerQuota
+		expected func(resp *types.MsgSetIssuerQuotaResponse, error error)
+	}{
+		{
+			name: "invalid window",
+			malleate: func() *types.MsgSetIssuerQuota {
+				msg := types.NewMsgSetIssuerQuota(tests.RandomAccAddress().String(), tests.RandomAccAddress().String(), 10, 0)
+				return &msg
+			},
+			expected: func(resp *types.MsgSetIssuerQuotaResponse, error error) {
+				suite.Require().ErrorIs(error, types.ErrInvalidParam)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "operator not exist",
+			init: func() {
+				operator = tests.RandomAccAddress()
+				issuer = tests.RandomAccAddress()
+			},
+			malleate: func() *types.MsgSetIssuerQuota {
+				msg := types.NewMsgSetIssuerQuota(operator.String(), issuer.String(), 10, 1)
+				return &msg
+			},
+			expected: func(resp *types.MsgSetIssuerQuotaResponse, error error) {
+				suite.Require().ErrorIs(error, types.ErrNotOperator)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "success",
+			init: func() {
+				operator = tests.RandomAccAddress()
+				err := suite.keeper.AddOperator(suite.ctx, operator, types.OperatorType_OT_REGULAR)
+				suite.Require().NoError(err)
+
+				issuer = tests.RandomAccAddress()
+				details := &types.IssuerDetails{Creator: tests.RandomAccAddress().String(), Name: "testIssuer"}
+				err = suite.keeper.SetIssuerDetails(suite.ctx, issuer, details)
+				suite.Require().NoError(err)
+			},
+			malleate: func() *types.MsgSetIssuerQuota {
+				msg := types.NewMsgSetIssuerQuota(operator.String(), issuer.String(), 10, 100)
+				return &msg
+			},
+			expected: func(resp *types.MsgSetIssuerQuotaResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().Equal(resp, &types.MsgSetIssuerQuotaResponse{})
+
+				details, err := suite.keeper.GetIssuerDetails(suite.ctx, issuer)
+				suite.Require().NoError(err)
+				suite.Require().Equal(&types.IssuanceQuota{MaxIssuances: 10, WindowBlocks: 100}, details.IssuanceQuota)
+			},
+		},
+		{
+			name: "remove quota",
+			malleate: func() *types.MsgSetIssuerQuota {
+				msg := types.NewMsgSetIssuerQuota(operator.String(), issuer.String(), 0, 0)
+				return &msg
+			},
+			expected: func(resp *types.MsgSetIssuerQuotaResponse, error error) {
+				suite.Require().NoError(error)
+
+				details, err := suite.keeper.GetIssuerDetails(suite.ctx, issuer)
+				suite.Require().NoError(err)
+				suite.Require().Nil(details.IssuanceQuota)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msgServer := keeper.NewMsgServerImpl(suite.keeper)
+			if tc.init != nil {
+				tc.init()
+			}
+			msg := tc.malleate()
+			// Basic validation is performed by ante handler
+			err := msg.ValidateBasic()
+			if err != nil {
+				tc.expected(nil, err)
+				return
+			}
+			resp, err := msgServer.HandleSetIssuerQuota(sdk.WrapSDKContext(suite.ctx), msg)
+			tc.expected(resp, err)
+		})
+	}
+}
@@ -7,7 +7,10 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	// Params which were not set yet (for example, before store migration) fall back to default values
+	params := types.DefaultParams()
+	k.paramstore.GetParamSetIfExists(ctx, &params)
+	return params
 }
 
 // SetParams set the params
@@ -15,3 +15,28 @@ func TestGetParams(t *testing.T) {
 	k.SetParams(ctx, params)
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestGetNonDefaultParams(t *testing.T) {
+	k, ctx := testkeeper.ComplianceKeeper(t)
+	require.False(t, k.GetParams(ctx).PruneExpiredVerifications)
+
+	params := types.NewParams(true, types.DefaultComplianceRegistry)
+	k.SetParams(ctx, params)
+	require.EqualValues(t, params, k.GetParams(ctx))
+}
+
+func TestValidateComplianceRegistry(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+	_, found := params.GetComplianceRegistryAddress()
+	require.False(t, found)
+
+	params.ComplianceRegistry = "0x1000000000000000000000000000000000000001"
+	require.NoError(t, params.Validate())
+	registry, found := params.GetComplianceRegistryAddress()
+	require.True(t, found)
+	require.Equal(t, params.ComplianceRegistry, registry.Hex())
+
+	params.ComplianceRegistry = "swtr199wynlfwhj6ytkvujjf6mel5z7fl0mwzqck8l6"
+	require.Error(t, params.Validate())
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -34,6 +35,20 @@ func (k Querier) IssuanceTreeRoot(goCtx context.Context, req *types.QueryIssuanc
 	return &types.QueryIssuanceTreeRootResponse{Root: root.Bytes()}, nil
 }
 
+func (k Querier) RootHistory(goCtx context.Context, req *types.QueryRootHistoryRequest) (*types.QueryRootHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	roots, err := k.GetRootHistory(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRootHistoryResponse{Roots: roots}, nil
+}
+
 func (k Querier) IssuanceProof(goCtx context.Context, req *types.QueryIssuanceProofRequest) (*types.QueryIssuanceProofResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -192,13 +207,15 @@ func (k Querier) IssuersDetails(goCtx context.Context, req *types.QueryIssuersDe
 		}
 		// NOTE: MUST CONTAIN ALL THE MEMBERS OF `IssuerDetails` AND ITERATING KEY
 		issuers = append(issuers, types.QueryIssuersDetailsResponse_MergedIssuerDetails{
-			IssuerAddress: sdk.AccAddress(key).String(),
-			Creator:       issuerDetails.Creator,
-			Name:          issuerDetails.Name,
-			Description:   issuerDetails.Description,
-			Url:           issuerDetails.Url,
-			Logo:          issuerDetails.Logo,
-			LegalEntity:   issuerDetails.LegalEntity,
+			IssuerAddress:            sdk.AccAddress(key).String(),
+			Creator:                  issuerDetails.Creator,
+			Name:                     issuerDetails.Name,
+			Description:              issuerDetails.Description,
+			Url:                      issuerDetails.Url,
+			Logo:                     issuerDetails.Logo,
+			LegalEntity:              issuerDetails.LegalEntity,
+			AllowedVerificationTypes: issuerDetails.AllowedVerificationTypes,
+			IssuanceQuota:            issuerDetails.IssuanceQuota,
 		})
 		return nil
 	})
@@ -231,7 +248,15 @@ func (k Querier) VerificationDetails(goCtx context.Context, req *types.QueryVeri
 		return &types.QueryVerificationDetailsResponse{}, nil
 	}
 
-	return &types.QueryVerificationDetailsResponse{Details: details}, nil
+	var revocation *types.RevocationDetails
+	if details.IsRevoked {
+		revocation, err = k.GetRevocationDetails(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.QueryVerificationDetailsResponse{Details: details, Revocation: revocation}, nil
 }
 
 func (k Querier) VerificationsDetails(goCtx context.Context, req *types.QueryVerificationsDetailsRequest) (*types.QueryVerificationsDetailsResponse, error) {
@@ -349,6 +374,20 @@ func (k Querier) CredentialHash(goCtx context.Context, req *types.QueryCredentia
 	return &types.QueryCredentialHashResponse{CredentialHash: credentialHashBytes}, nil
 }
 
+func (k Querier) CredentialPackage(goCtx context.Context, req *types.QueryCredentialPackageRequest) (*types.QueryCredentialPackageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	credentialPackage, err := k.GetCredentialPackage(ctx, req.VerificationId, req.HolderPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCredentialPackageResponse{Package: credentialPackage}, nil
+}
+
 func (k Querier) VerificationHolder(goCtx context.Context, req *types.QueryHolderByVerificationIdRequest) (*types.QueryHolderByVerificationIdResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -419,3 +458,264 @@ func (k Querier) AllVerificationDetailsByAddress(goCtx context.Context, req *typ
 
 	return &types.QueryAllVerificationDetailsByAddressResponse{Details: result}, nil
 }
+
+func (k Querier) VerificationsExpiringBetween(goCtx context.Context, req *types.QueryVerificationsExpiringBetweenRequest) (*types.QueryVerificationsExpiringBetweenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.StartTimestamp > req.EndTimestamp {
+		return nil, status.Error(codes.InvalidArgument, "start timestamp should not be greater than end timestamp")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// Expiration index is ordered by expiration timestamp, so only requested range is iterated
+	startKey := types.ExpirationTimestampToBytes(req.StartTimestamp)
+	if pageReq.Key != nil {
+		if bytes.Compare(pageReq.Key, startKey) < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		startKey = pageReq.Key
+	}
+	var endKey []byte
+	if req.EndTimestamp < ^uint32(0) {
+		endKey = types.ExpirationTimestampToBytes(req.EndTimestamp + 1)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationExpiration)
+	iterator := store.Iterator(startKey, endKey)
+	defer closeIteratorOrPanic(iterator)
+
+	var (
+		verifications []types.QueryVerificationsExpiringBetweenResponse_ExpiringVerification
+		skipped       uint64
+		nextKey       []byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		if skipped < pageReq.Offset {
+			skipped++
+			continue
+		}
+		if uint64(len(verifications)) == limit {
+			nextKey = bytes.Clone(iterator.Key())
+			break
+		}
+
+		_, verificationId := types.SplitVerificationExpirationKey(iterator.Key())
+		verificationDetails, err := k.GetRawVerificationDetails(ctx, verificationId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// NOTE: MUST CONTAIN ALL THE MEMBERS OF `VerificationDetails` AND ITERATING KEYS
+		verifications = append(verifications, types.QueryVerificationsExpiringBetweenResponse_ExpiringVerification{
+			Holder: k.getHolderByVerificationId(ctx, verificationId).String(),
+			Details: &types.MergedVerificationDetails{
+				VerificationType:     verificationDetails.Type,
+				VerificationId:       bytes.Clone(verificationId),
+				IssuerAddress:        verificationDetails.IssuerAddress,
+				OriginChain:          verificationDetails.OriginChain,
+				IssuanceTimestamp:    verificationDetails.IssuanceTimestamp,
+				ExpirationTimestamp:  verificationDetails.ExpirationTimestamp,
+				OriginalData:         verificationDetails.OriginalData,
+				Schema:               verificationDetails.Schema,
+				IssuerVerificationId: verificationDetails.IssuerVerificationId,
+				Version:              verificationDetails.Version,
+				IsRevoked:            verificationDetails.IsRevoked,
+			},
+		})
+	}
+
+	return &types.QueryVerificationsExpiringBetweenResponse{
+		Verifications: verifications,
+		Pagination:    &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+func (k Querier) VerificationsByIssuer(goCtx context.Context, req *types.QueryVerificationsByIssuerRequest) (*types.QueryVerificationsByIssuerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.IssuerAddress == "" && req.VerificationType == types.VerificationType_VT_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "either issuer address or verification type should be provided")
+	}
+	if req.VerificationType != types.VerificationType_VT_UNSPECIFIED && !req.VerificationType.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid verification type")
+	}
+	if _, ok := types.RevocationStatusFilter_name[int32(req.RevocationStatus)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid revocation status filter")
+	}
+	if req.ExpiresBefore != 0 && req.ExpiresAfter > req.ExpiresBefore {
+		return nil, status.Error(codes.InvalidArgument, "expiresAfter should not be greater than expiresBefore")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Use issuer index if issuer is provided, since it is usually much smaller than type index.
+	// If verification type is not provided, keys of issuer index start with verification type.
+	var (
+		keyPrefix  []byte
+		keyHasType bool
+	)
+	if req.IssuerAddress != "" {
+		issuerAddress, err := sdk.AccAddressFromBech32(req.IssuerAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		// Verifications are indexed by actual address of issuer
+		issuerAddress = k.ResolveIssuerAddress(ctx, issuerAddress)
+
+		if req.VerificationType == types.VerificationType_VT_UNSPECIFIED {
+			keyPrefix = append(types.KeyPrefixVerificationByIssuer, types.VerificationByIssuerPrefix(issuerAddress)...)
+			keyHasType = true
+		} else {
+			keyPrefix = append(types.KeyPrefixVerificationByIssuer, types.VerificationByIssuerTypePrefix(issuerAddress, req.VerificationType)...)
+		}
+	} else {
+		keyPrefix = append(types.KeyPrefixVerificationByType, types.VerificationTypeToBytes(req.VerificationType)...)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	var verifications []types.QueryVerificationsByIssuerResponse_IssuedVerification
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		verificationId := key
+		if keyHasType {
+			_, verificationId = types.SplitVerificationTypeKey(key)
+		}
+
+		// Verifications of removed issuers are returned as empty ones and skipped
+		verificationDetails, err := k.GetVerificationDetails(ctx, verificationId)
+		if err != nil {
+			return false, err
+		}
+		if verificationDetails.Type == types.VerificationType_VT_UNSPECIFIED || !matchVerificationFilters(req, verificationDetails) {
+			return false, nil
+		}
+
+		if accumulate {
+			// NOTE: MUST CONTAIN ALL THE MEMBERS OF `VerificationDetails` AND ITERATING KEYS
+			verifications = append(verifications, types.QueryVerificationsByIssuerResponse_IssuedVerification{
+				Holder: k.getHolderByVerificationId(ctx, verificationId).String(),
+				Details: &types.MergedVerificationDetails{
+					VerificationType:     verificationDetails.Type,
+					VerificationId:       bytes.Clone(verificationId),
+					IssuerAddress:        verificationDetails.IssuerAddress,
+					OriginChain:          verificationDetails.OriginChain,
+					IssuanceTimestamp:    verificationDetails.IssuanceTimestamp,
+					ExpirationTimestamp:  verificationDetails.ExpirationTimestamp,
+					OriginalData:         verificationDetails.OriginalData,
+					Schema:               verificationDetails.Schema,
+					IssuerVerificationId: verificationDetails.IssuerVerificationId,
+					Version:              verificationDetails.Version,
+					IsRevoked:            verificationDetails.IsRevoked,
+				},
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVerificationsByIssuerResponse{
+		Verifications: verifications,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// matchVerificationFilters checks if verification matches revocation status and expiration window of the request
+func matchVerificationFilters(req *types.QueryVerificationsByIssuerRequest, details *types.VerificationDetails) bool {
+	switch req.RevocationStatus {
+	case types.RevocationStatusFilter_RSF_NOT_REVOKED:
+		if details.IsRevoked {
+			return false
+		}
+	case types.RevocationStatusFilter_RSF_REVOKED:
+		if !details.IsRevoked {
+			return false
+		}
+	}
+
+	// Verification without expiration timestamp never expires
+	expirationTimestamp := details.ExpirationTimestamp
+	if expirationTimestamp == 0 {
+		expirationTimestamp = ^uint32(0)
+	}
+	if expirationTimestamp < req.ExpiresAfter {
+		return false
+	}
+	if req.ExpiresBefore != 0 && expirationTimestamp > req.ExpiresBefore {
+		return false
+	}
+
+	return true
+}
+
+func (k Querier) IssuerAliasHistory(goCtx context.Context, req *types.QueryIssuerAliasHistoryRequest) (*types.QueryIssuerAliasHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.IssuerAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	current, migrations, err := k.GetIssuerAliasHistory(ctx, address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIssuerAliasHistoryResponse{
+		CurrentAddress: current.String(),
+		Migrations:     migrations,
+	}, nil
+}
+
+func (k Querier) VerificationRequests(goCtx context.Context, req *types.QueryVerificationRequestsRequest) (*types.QueryVerificationRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issuerAddress, err := sdk.AccAddressFromBech32(req.IssuerAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Requests are stored by actual address of issuer
+	issuerAddress = k.ResolveIssuerAddress(ctx, issuerAddress)
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefixVerificationRequest, types.VerificationByIssuerPrefix(issuerAddress)...),
+	)
+
+	var requests []types.VerificationRequest
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var request types.VerificationRequest
+		if err := request.Unmarshal(value); err != nil {
+			return err
+		}
+		requests = append(requests, request)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVerificationRequestsResponse{
+		Requests:   requests,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/compliance/types"
+)
+
+// MarkVerificationsAsRevoked marks provided verifications as revoked and stores revocation time, reason and note.
+// Credential hashes of all the verifications are added to revocation tree at once.
+func (k Keeper) MarkVerificationsAsRevoked(
+	ctx sdk.Context,
+	verificationIds [][]byte,
+	reason types.RevocationReason,
+	note string,
+) error {
+	if !reason.IsValid() {
+		return errors.Wrap(types.ErrInvalidParam, "invalid revocation reason")
+	}
+
+	credentialHashes := make([]common.Hash, 0, len(verificationIds))
+	for _, verificationId := range verificationIds {
+		credentialHash, err := k.markVerificationDetailsAsRevoked(ctx, verificationId)
+		if err != nil {
+			return err
+		}
+		if credentialHash != nil {
+			credentialHashes = append(credentialHashes, *credentialHash)
+		}
+
+		err = k.SetRevocationDetails(ctx, verificationId, &types.RevocationDetails{
+			Reason:      reason,
+			Note:        note,
+			BlockHeight: ctx.BlockHeight(),
+			Timestamp:   ctx.BlockTime().Unix(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.MarkCredentialHashAsRevoked(ctx, credentialHashes...)
+}
+
+// SetRevocationDetails stores revocation details of verification with provided id
+func (k Keeper) SetRevocationDetails(ctx sdk.Context, verificationId []byte, details *types.RevocationDetails) error {
+	detailsBytes, err := details.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevocationDetails)
+	store.Set(verificationId, detailsBytes)
+	return nil
+}
+
+// GetRevocationDetails returns revocation details of verification with provided id.
+// Returns nil if verification was not revoked or was revoked before revocation details were tracked.
+func (k Keeper) GetRevocationDetails(ctx sdk.Context, verificationId []byte) (*types.RevocationDetails, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevocationDetails)
+
+	detailsBytes := store.Get(verificationId)
+	if detailsBytes == nil {
+		return nil, nil
+	}
+
+	var details types.RevocationDetails
+	if err := proto.Unmarshal(detailsBytes, &details); err != nil {
+		return nil, err
+	}
+
+	return &details, nil
+}
+
+// RemoveRevocationDetails deletes revocation details of verification with provided id
+func (k Keeper) RemoveRevocationDetails(ctx sdk.Context, verificationId []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevocationDetails)
+	store.Delete(verificationId)
+}
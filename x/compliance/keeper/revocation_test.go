@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/tests"
+	"swisstronik/x/compliance/keeper"
+	"swisstronik/x/compliance/types"
+)
+
+func (suite *KeeperTestSuite) TestHandleRevokeVerifications() {
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(100)
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	q := keeper.Querier{Keeper: suite.keeper}
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	issuerDetails, err := suite.keeper.GetIssuerDetails(ctx, issuer)
+	suite.Require().NoError(err)
+	anotherIssuer := suite.createVerifiedIssuer(ctx)
+
+	// Holder has public key, so revoked credentials are added to revocation tree
+	holder := tests.RandomAccAddress()
+	publicKey := tests.RandomEdDSAPubKey()
+	suite.Require().NoError(suite.keeper.SetHolderPublicKey(ctx, holder, publicKey[:]))
+
+	firstId := suite.addExpiringVerification(ctx, issuer, holder, 4000000000)
+	secondId := suite.addExpiringVerification(ctx, issuer, tests.RandomAccAddress(), 4000000001)
+	anotherId := suite.addExpiringVerification(ctx, anotherIssuer, tests.RandomAccAddress(), 4000000002)
+
+	revocationRoot, err := suite.keeper.GetRevocationTreeRoot(ctx)
+	suite.Require().NoError(err)
+
+	// Issuer creator cannot revoke verifications of another issuer
+	msg := types.NewMsgRevokeVerifications(issuerDetails.Creator, [][]byte{firstId, anotherId}, types.RevocationReason_RR_FRAUD, "")
+	_, err = msgServer.HandleRevokeVerifications(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrNotOperatorOrIssuerCreator)
+
+	// Not existing verification
+	msg = types.NewMsgRevokeVerifications(issuerDetails.Creator, [][]byte{firstId, {0x01}}, types.RevocationReason_RR_FRAUD, "")
+	_, err = msgServer.HandleRevokeVerifications(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidParam)
+
+	// Issuer creator revokes verifications of own issuer
+	msg = types.NewMsgRevokeVerifications(issuerDetails.Creator, [][]byte{firstId, secondId}, types.RevocationReason_RR_KEY_COMPROMISE, "incident")
+	_, err = msgServer.HandleRevokeVerifications(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	for _, verificationId := range [][]byte{firstId, secondId} {
+		resp, err := q.VerificationDetails(sdk.WrapSDKContext(ctx), &types.QueryVerificationDetailsRequest{
+			VerificationID: base64.StdEncoding.EncodeToString(verificationId),
+		})
+		suite.Require().NoError(err)
+		suite.Require().True(resp.Details.IsRevoked)
+		suite.Require().Equal(&types.RevocationDetails{
+			Reason:      types.RevocationReason_RR_KEY_COMPROMISE,
+			Note:        "incident",
+			BlockHeight: ctx.BlockHeight(),
+			Timestamp:   ctx.BlockTime().Unix(),
+		}, resp.Revocation)
+	}
+
+	// Only credential of holder with public key is added to revocation tree
+	newRevocationRoot, err := suite.keeper.GetRevocationTreeRoot(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(revocationRoot, newRevocationRoot)
+
+	// Verification cannot be revoked twice
+	_, err = msgServer.HandleRevokeVerifications(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidParam)
+
+	// Operator can revoke verifications of any issuer
+	operator := tests.RandomAccAddress()
+	suite.Require().NoError(suite.keeper.AddOperator(ctx, operator, types.OperatorType_OT_REGULAR))
+	msg = types.NewMsgRevokeVerifications(operator.String(), [][]byte{anotherId}, types.RevocationReason_RR_ISSUER_COMPROMISE, "")
+	_, err = msgServer.HandleRevokeVerifications(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+	revocation, err := suite.keeper.GetRevocationDetails(ctx, anotherId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.RevocationReason_RR_ISSUER_COMPROMISE, revocation.Reason)
+}
+
+func (suite *KeeperTestSuite) TestRevokeVerificationWithoutReason() {
+	ctx, _ := suite.ctx.CacheContext()
+	q := keeper.Querier{Keeper: suite.keeper}
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	verificationId := suite.addExpiringVerification(ctx, issuer, tests.RandomAccAddress(), 4000000000)
+
+	resp, err := q.VerificationDetails(sdk.WrapSDKContext(ctx), &types.QueryVerificationDetailsRequest{
+		VerificationID: base64.StdEncoding.EncodeToString(verificationId),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Nil(resp.Revocation)
+
+	suite.Require().NoError(suite.keeper.RevokeVerification(ctx, verificationId, issuer))
+
+	resp, err = q.VerificationDetails(sdk.WrapSDKContext(ctx), &types.QueryVerificationDetailsRequest{
+		VerificationID: base64.StdEncoding.EncodeToString(verificationId),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(resp.Details.IsRevoked)
+	suite.Require().NotNil(resp.Revocation)
+	suite.Require().Equal(types.RevocationReason_RR_UNSPECIFIED, resp.Revocation.Reason)
+	suite.Require().Equal(ctx.BlockTime().Unix(), resp.Revocation.Timestamp)
+
+	// Revocation details are removed with verification
+	holder := tests.RandomAccAddress()
+	verificationId = suite.addExpiringVerification(ctx, issuer, holder, 4000000000)
+	suite.Require().NoError(suite.keeper.MarkVerificationsAsRevoked(ctx, [][]byte{verificationId}, types.RevocationReason_RR_OTHER, ""))
+	suite.Require().NoError(suite.keeper.PruneVerification(ctx, holder, verificationId))
+	revocation, err := suite.keeper.GetRevocationDetails(ctx, verificationId)
+	suite.Require().NoError(err)
+	suite.Require().Nil(revocation)
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"swisstronik/x/compliance/types"
+)
+
+// SaveTreeRoots stores roots of issuance and revocation trees at the current block in the ring buffer
+// of recent roots. Roots of the block, which is `RootHistorySize` blocks older, are overwritten.
+func (k Keeper) SaveTreeRoots(ctx sdk.Context) error {
+	issuanceRoot, err := k.GetIssuanceTreeRoot(ctx)
+	if err != nil {
+		return err
+	}
+	revocationRoot, err := k.GetRevocationTreeRoot(ctx)
+	if err != nil {
+		return err
+	}
+
+	roots := &types.TreeRoots{
+		BlockHeight:    ctx.BlockHeight(),
+		IssuanceRoot:   issuanceRoot.Bytes(),
+		RevocationRoot: revocationRoot.Bytes(),
+	}
+	rootsBytes, err := roots.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRootHistory)
+	store.Set(types.RootHistoryKey(ctx.BlockHeight()), rootsBytes)
+	return nil
+}
+
+// GetTreeRootsAtHeight returns roots of issuance and revocation trees at the end of provided block.
+// Returns nil if roots of this block are not kept anymore.
+func (k Keeper) GetTreeRootsAtHeight(ctx sdk.Context, blockHeight int64) (*types.TreeRoots, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRootHistory)
+
+	rootsBytes := store.Get(types.RootHistoryKey(blockHeight))
+	if rootsBytes == nil {
+		return nil, nil
+	}
+
+	var roots types.TreeRoots
+	if err := proto.Unmarshal(rootsBytes, &roots); err != nil {
+		return nil, err
+	}
+	// Slot could be already overwritten by the newer block or contain roots of the older one
+	if roots.BlockHeight != blockHeight {
+		return nil, nil
+	}
+
+	return &roots, nil
+}
+
+// GetRootHistory returns all the kept tree roots ordered from the latest block
+func (k Keeper) GetRootHistory(ctx sdk.Context) ([]types.TreeRoots, error) {
+	var history []types.TreeRoots
+	err := k.IterateRootHistory(ctx, func(roots types.TreeRoots) bool {
+		history = append(history, roots)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].BlockHeight > history[j].BlockHeight
+	})
+	return history, nil
+}
+
+// GetIssuanceTreeRootAtHeight returns root of issuance tree at the end of provided block.
+// If block height is zero, root of the current tree is returned.
+func (k Keeper) GetIssuanceTreeRootAtHeight(ctx sdk.Context, blockHeight int64) (*big.Int, error) {
+	if blockHeight == 0 {
+		return k.GetIssuanceTreeRoot(ctx)
+	}
+
+	roots, err := k.getFinalTreeRoots(ctx, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(roots.IssuanceRoot), nil
+}
+
+// GetRevocationTreeRootAtHeight returns root of revocation tree at the end of provided block.
+// If block height is zero, root of the current tree is returned.
+func (k Keeper) GetRevocationTreeRootAtHeight(ctx sdk.Context, blockHeight int64) (*big.Int, error) {
+	if blockHeight == 0 {
+		return k.GetRevocationTreeRoot(ctx)
+	}
+
+	roots, err := k.getFinalTreeRoots(ctx, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(roots.RevocationRoot), nil
+}
+
+// IsKnownIssuanceRoot checks if provided root is root of the current issuance tree
+// or one of the kept historical roots
+func (k Keeper) IsKnownIssuanceRoot(ctx sdk.Context, root *big.Int) (bool, error) {
+	if root == nil {
+		return false, nil
+	}
+
+	currentRoot, err := k.GetIssuanceTreeRoot(ctx)
+	if err != nil {
+		return false, err
+	}
+	if currentRoot.Cmp(root) == 0 {
+		return true, nil
+	}
+
+	rootBytes := root.Bytes()
+	known := false
+	err = k.IterateRootHistory(ctx, func(roots types.TreeRoots) bool {
+		known = bytes.Equal(roots.IssuanceRoot, rootBytes)
+		return !known
+	})
+
+	return known, err
+}
+
+// getFinalTreeRoots returns roots saved at the end of provided block. Roots of the current block
+// are not available until the block is ended, since trees can still be changed by its transactions.
+func (k Keeper) getFinalTreeRoots(ctx sdk.Context, blockHeight int64) (*types.TreeRoots, error) {
+	if blockHeight < 0 || blockHeight > ctx.BlockHeight() {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "invalid block height %d", blockHeight)
+	}
+
+	roots, err := k.GetTreeRootsAtHeight(ctx, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	if roots == nil && blockHeight == ctx.BlockHeight() {
+		return nil, errors.Wrapf(types.ErrRootNotFound, "roots of block %d are not final yet", blockHeight)
+	}
+	if roots == nil {
+		return nil, errors.Wrapf(types.ErrRootNotFound, "roots of block %d are not kept", blockHeight)
+	}
+
+	return roots, nil
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/tests"
+	"swisstronik/x/compliance/keeper"
+	"swisstronik/x/compliance/types"
+)
+
+func (suite *KeeperTestSuite) TestRootHistory() {
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(10)
+	q := keeper.Querier{Keeper: suite.keeper}
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	holder := tests.RandomAccAddress()
+	publicKey := tests.RandomEdDSAPubKey()
+	suite.Require().NoError(suite.keeper.SetHolderPublicKey(ctx, holder, publicKey[:]))
+
+	// Block 10: credential is issued
+	suite.addExpiringVerification(ctx, issuer, holder, 4000000000)
+	issuanceRootBefore, err := suite.keeper.GetIssuanceTreeRoot(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.SaveTreeRoots(ctx))
+
+	// Block 11: another credential is issued
+	ctx = ctx.WithBlockHeight(11)
+	suite.addExpiringVerification(ctx, issuer, holder, 4000000001)
+	issuanceRootAfter, err := suite.keeper.GetIssuanceTreeRoot(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(issuanceRootBefore, issuanceRootAfter)
+	// Roots of the current block are available only after the end of the block
+	_, err = suite.keeper.GetIssuanceTreeRootAtHeight(ctx, 11)
+	suite.Require().ErrorIs(err, types.ErrRootNotFound)
+	suite.Require().NoError(suite.keeper.SaveTreeRoots(ctx))
+	root, err := suite.keeper.GetIssuanceTreeRootAtHeight(ctx, 11)
+	suite.Require().NoError(err)
+	suite.Require().Equal(issuanceRootAfter, root)
+
+	// Block 12: past roots are available
+	ctx = ctx.WithBlockHeight(12)
+	root, err = suite.keeper.GetIssuanceTreeRootAtHeight(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal(issuanceRootBefore, root)
+	root, err = suite.keeper.GetIssuanceTreeRootAtHeight(ctx, 11)
+	suite.Require().NoError(err)
+	suite.Require().Equal(issuanceRootAfter, root)
+	root, err = suite.keeper.GetIssuanceTreeRootAtHeight(ctx, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(issuanceRootAfter, root)
+	_, err = suite.keeper.GetRevocationTreeRootAtHeight(ctx, 11)
+	suite.Require().NoError(err)
+
+	// Roots of not kept and future blocks are not available
+	_, err = suite.keeper.GetIssuanceTreeRootAtHeight(ctx, 9)
+	suite.Require().ErrorIs(err, types.ErrRootNotFound)
+	_, err = suite.keeper.GetRevocationTreeRootAtHeight(ctx, 13)
+	suite.Require().ErrorIs(err, types.ErrInvalidParam)
+
+	for _, knownRoot := range []*big.Int{issuanceRootBefore, issuanceRootAfter} {
+		known, err := suite.keeper.IsKnownIssuanceRoot(ctx, knownRoot)
+		suite.Require().NoError(err)
+		suite.Require().True(known)
+	}
+	known, err := suite.keeper.IsKnownIssuanceRoot(ctx, big.NewInt(12345))
+	suite.Require().NoError(err)
+	suite.Require().False(known)
+
+	resp, err := q.RootHistory(sdk.WrapSDKContext(ctx), &types.QueryRootHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Roots, 2)
+	suite.Require().Equal(int64(11), resp.Roots[0].BlockHeight)
+	suite.Require().Equal(issuanceRootAfter.Bytes(), resp.Roots[0].IssuanceRoot)
+	suite.Require().Equal(int64(10), resp.Roots[1].BlockHeight)
+	suite.Require().Equal(issuanceRootBefore.Bytes(), resp.Roots[1].IssuanceRoot)
+}
+
+func (suite *KeeperTestSuite) TestRootHistoryRingBuffer() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	for height := int64(1); height <= types.RootHistorySize+10; height++ {
+		suite.Require().NoError(suite.keeper.SaveTreeRoots(ctx.WithBlockHeight(height)))
+	}
+	ctx = ctx.WithBlockHeight(types.RootHistorySize + 11)
+
+	history, err := suite.keeper.GetRootHistory(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, types.RootHistorySize)
+	suite.Require().Equal(int64(types.RootHistorySize+10), history[0].BlockHeight)
+	suite.Require().Equal(int64(11), history[len(history)-1].BlockHeight)
+
+	// Roots of the oldest blocks were overwritten
+	roots, err := suite.keeper.GetTreeRootsAtHeight(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Nil(roots)
+	roots, err = suite.keeper.GetTreeRootsAtHeight(ctx, 11)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(roots)
+}
@@ -55,20 +55,32 @@ func (k Keeper) AddCredentialHashToIssued(ctx sdk.Context, credentialHash *big.I
 	return tree.Add(context, key, credentialHash)
 }
 
-func (k Keeper) MarkCredentialHashAsRevoked(ctx sdk.Context, credentialHash common.Hash) error {
+// MarkCredentialHashAsRevoked adds provided credential hashes to revocation tree.
+// Revocation tree is loaded once for all the hashes.
+func (k Keeper) MarkCredentialHashAsRevoked(ctx sdk.Context, credentialHashes ...common.Hash) error {
+	if len(credentialHashes) == 0 {
+		return nil
+	}
+
 	storage := NewTreeStorage(ctx, &k, types.KeyPrefixRevocationTree)
 	tree, err := merkletree.NewMerkleTree(ctx, &storage, 32)
 	if err != nil {
 		return err
 	}
 
-	value := credentialHash.Big()
-	key, err := mimc7.Hash([]*big.Int{value}, big.NewInt(0))
-	if err != nil {
-		return err
+	for _, credentialHash := range credentialHashes {
+		value := credentialHash.Big()
+		key, err := mimc7.Hash([]*big.Int{value}, big.NewInt(0))
+		if err != nil {
+			return err
+		}
+
+		if err = tree.Add(sdk.WrapSDKContext(ctx), key, value); err != nil {
+			return err
+		}
 	}
 
-	return tree.Add(sdk.WrapSDKContext(ctx), key, value)
+	return nil
 }
 
 func (k Keeper) addZeroElementToRevocationTree(ctx sdk.Context) error {
@@ -96,18 +108,16 @@ func (k Keeper) addZeroElementToRevocationTree(ctx sdk.Context) error {
 }
 
 func (k Keeper) GetIssuanceProof(ctx sdk.Context, credentialHash common.Hash) ([]byte, error) {
-	storage := NewTreeStorage(ctx, &k, types.KeyPrefixIssuanceTree)
-	tree, err := merkletree.NewMerkleTree(ctx, &storage, 32)
+	proof, err := k.generateCircomProof(ctx, types.KeyPrefixIssuanceTree, credentialHash)
 	if err != nil {
 		return nil, err
 	}
 
-	credentialHashBig := new(big.Int).SetBytes(credentialHash.Bytes())
-	credentialKey, err := mimc7.Hash([]*big.Int{credentialHashBig}, big.NewInt(0))
-	if err != nil {
-		return nil, err
-	}
-	proof, err := tree.GenerateCircomVerifierProof(sdk.WrapSDKContext(ctx), credentialKey, nil)
+	return json.Marshal(proof)
+}
+
+func (k Keeper) GetNonRevocationProof(ctx sdk.Context, credentialHash common.Hash) ([]byte, error) {
+	proof, err := k.generateCircomProof(ctx, types.KeyPrefixRevocationTree, credentialHash)
 	if err != nil {
 		return nil, err
 	}
@@ -115,8 +125,9 @@ func (k Keeper) GetIssuanceProof(ctx sdk.Context, credentialHash common.Hash) ([
 	return json.Marshal(proof)
 }
 
-func (k Keeper) GetNonRevocationProof(ctx sdk.Context, credentialHash common.Hash) ([]byte, error) {
-	storage := NewTreeStorage(ctx, &k, types.KeyPrefixRevocationTree)
+// generateCircomProof generates proof of inclusion or non-inclusion of credential hash into the tree with provided prefix
+func (k Keeper) generateCircomProof(ctx sdk.Context, treePrefix []byte, credentialHash common.Hash) (*merkletree.CircomVerifierProof, error) {
+	storage := NewTreeStorage(ctx, &k, treePrefix)
 	tree, err := merkletree.NewMerkleTree(ctx, &storage, 32)
 	if err != nil {
 		return nil, err
@@ -127,12 +138,8 @@ func (k Keeper) GetNonRevocationProof(ctx sdk.Context, credentialHash common.Has
 	if err != nil {
 		return nil, err
 	}
-	proof, err := tree.GenerateCircomVerifierProof(sdk.WrapSDKContext(ctx), credentialKey, nil)
-	if err != nil {
-		return nil, err
-	}
 
-	return json.Marshal(proof)
+	return tree.GenerateCircomVerifierProof(sdk.WrapSDKContext(ctx), credentialKey, nil)
 }
 
 // SetTreeRoot is used only for testing
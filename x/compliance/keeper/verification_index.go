@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/x/compliance/types"
+)
+
+// SetVerificationIndexes adds verification to issuer and type indexes. Verification is indexed
+// by actual address of its issuer, so verifications of migrated issuer can be found by its new address.
+func (k Keeper) SetVerificationIndexes(ctx sdk.Context, verificationId []byte, details *types.VerificationDetails) error {
+	issuerAddress, err := sdk.AccAddressFromBech32(details.IssuerAddress)
+	if err != nil {
+		return err
+	}
+	issuerAddress = k.ResolveIssuerAddress(ctx, issuerAddress)
+
+	issuerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationByIssuer)
+	issuerStore.Set(types.VerificationByIssuerKey(issuerAddress, details.Type, verificationId), []byte{0x01})
+
+	typeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationByType)
+	typeStore.Set(types.VerificationByTypeKey(details.Type, verificationId), []byte{0x01})
+
+	return nil
+}
+
+// RemoveVerificationIndexes removes verification from issuer and type indexes
+func (k Keeper) RemoveVerificationIndexes(ctx sdk.Context, verificationId []byte, details *types.VerificationDetails) error {
+	issuerAddress, err := sdk.AccAddressFromBech32(details.IssuerAddress)
+	if err != nil {
+		return err
+	}
+	issuerAddress = k.ResolveIssuerAddress(ctx, issuerAddress)
+
+	issuerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationByIssuer)
+	issuerStore.Delete(types.VerificationByIssuerKey(issuerAddress, details.Type, verificationId))
+
+	typeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationByType)
+	typeStore.Delete(types.VerificationByTypeKey(details.Type, verificationId))
+
+	return nil
+}
+
+// IterateVerificationsByIssuer iterates over ids of verifications issued by provided issuer, ordered by
+// verification type. If verification type is specified, only verifications of this type are iterated.
+// Since verifications are indexed by actual issuer address, aliases of migrated issuer should be resolved by caller.
+func (k Keeper) IterateVerificationsByIssuer(
+	ctx sdk.Context,
+	issuerAddress sdk.AccAddress,
+	verificationType types.VerificationType,
+	callback func(verificationType types.VerificationType, verificationId []byte) (continue_ bool),
+) {
+	var keyPrefix []byte
+	if verificationType == types.VerificationType_VT_UNSPECIFIED {
+		keyPrefix = types.VerificationByIssuerPrefix(issuerAddress)
+	} else {
+		keyPrefix = types.VerificationByIssuerTypePrefix(issuerAddress, verificationType)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixVerificationByIssuer, keyPrefix...))
+	iterator := store.Iterator(nil, nil)
+	defer closeIteratorOrPanic(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		if verificationType == types.VerificationType_VT_UNSPECIFIED {
+			vt, verificationId := types.SplitVerificationTypeKey(iterator.Key())
+			if !callback(vt, verificationId) {
+				break
+			}
+		} else if !callback(verificationType, iterator.Key()) {
+			break
+		}
+	}
+}
+
+// IterateVerificationsByType iterates over ids of verifications of provided type
+func (k Keeper) IterateVerificationsByType(
+	ctx sdk.Context,
+	verificationType types.VerificationType,
+	callback func(verificationId []byte) (continue_ bool),
+) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefixVerificationByType, types.VerificationTypeToBytes(verificationType)...),
+	)
+	iterator := store.Iterator(nil, nil)
+	defer closeIteratorOrPanic(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		if !callback(iterator.Key()) {
+			break
+		}
+	}
+}
+
+// moveVerificationsByIssuer moves all the issuer index entries of old issuer to the new one
+func (k Keeper) moveVerificationsByIssuer(ctx sdk.Context, oldIssuer, newIssuer sdk.AccAddress) {
+	type indexEntry struct {
+		verificationType types.VerificationType
+		verificationId   []byte
+	}
+
+	// Collect entries first, since the store should not be modified during iteration
+	var entries []indexEntry
+	k.IterateVerificationsByIssuer(ctx, oldIssuer, types.VerificationType_VT_UNSPECIFIED, func(vt types.VerificationType, id []byte) bool {
+		entries = append(entries, indexEntry{verificationType: vt, verificationId: bytes.Clone(id)})
+		return true
+	})
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationByIssuer)
+	for _, entry := range entries {
+		store.Delete(types.VerificationByIssuerKey(oldIssuer, entry.verificationType, entry.verificationId))
+		store.Set(types.VerificationByIssuerKey(newIssuer, entry.verificationType, entry.verificationId), []byte{0x01})
+	}
+}
@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/status-im/keycard-go/hexutils"
+
+	"swisstronik/tests"
+	"swisstronik/x/compliance/keeper"
+	"swisstronik/x/compliance/types"
+)
+
+func (suite *KeeperTestSuite) addIndexedVerification(
+	ctx sdk.Context,
+	issuer, holder sdk.AccAddress,
+	verificationType types.VerificationType,
+	expiration uint32,
+) []byte {
+	verificationId, err := suite.keeper.AddVerificationDetails(
+		ctx,
+		holder,
+		verificationType,
+		&types.VerificationDetails{
+			IssuerAddress:       issuer.String(),
+			OriginChain:         "test chain",
+			IssuanceTimestamp:   1600000000,
+			ExpirationTimestamp: expiration,
+			OriginalData:        hexutils.HexToBytes("B639DF194671CDE06EFAA368A404F72E3306DF0359117AC7E78EC2BE04B7629D"),
+		},
+	)
+	suite.Require().NoError(err)
+	return verificationId
+}
+
+func (suite *KeeperTestSuite) collectVerificationsByIssuer(
+	ctx sdk.Context,
+	issuer sdk.AccAddress,
+	verificationType types.VerificationType,
+) [][]byte {
+	var ids [][]byte
+	suite.keeper.IterateVerificationsByIssuer(ctx, issuer, verificationType, func(_ types.VerificationType, id []byte) bool {
+		ids = append(ids, id)
+		return true
+	})
+	return ids
+}
+
+func (suite *KeeperTestSuite) collectVerificationsByType(ctx sdk.Context, verificationType types.VerificationType) [][]byte {
+	var ids [][]byte
+	suite.keeper.IterateVerificationsByType(ctx, verificationType, func(id []byte) bool {
+		ids = append(ids, id)
+		return true
+	})
+	return ids
+}
+
+func (suite *KeeperTestSuite) TestVerificationIndexes() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	otherIssuer := suite.createVerifiedIssuer(ctx)
+
+	holder := tests.RandomAccAddress()
+	kyc := suite.addIndexedVerification(ctx, issuer, holder, types.VerificationType_VT_KYC, 0)
+	creditScore := suite.addIndexedVerification(ctx, issuer, tests.RandomAccAddress(), types.VerificationType_VT_CREDIT_SCORE, 0)
+	otherKyc := suite.addIndexedVerification(ctx, otherIssuer, tests.RandomAccAddress(), types.VerificationType_VT_KYC, 0)
+
+	// Issuer index is ordered by verification type
+	suite.Require().Equal([][]byte{kyc, creditScore}, suite.collectVerificationsByIssuer(ctx, issuer, types.VerificationType_VT_UNSPECIFIED))
+	suite.Require().Equal([][]byte{creditScore}, suite.collectVerificationsByIssuer(ctx, issuer, types.VerificationType_VT_CREDIT_SCORE))
+	suite.Require().Equal([][]byte{otherKyc}, suite.collectVerificationsByIssuer(ctx, otherIssuer, types.VerificationType_VT_UNSPECIFIED))
+
+	// Type index contains verifications of all issuers, including ones created by test setup
+	suite.Require().Subset(suite.collectVerificationsByType(ctx, types.VerificationType_VT_KYC), [][]byte{kyc, otherKyc})
+	suite.Require().Contains(suite.collectVerificationsByType(ctx, types.VerificationType_VT_CREDIT_SCORE), creditScore)
+	suite.Require().NotContains(suite.collectVerificationsByType(ctx, types.VerificationType_VT_CREDIT_SCORE), kyc)
+
+	// Pruned verification is removed from both indexes
+	suite.Require().NoError(suite.keeper.PruneVerification(ctx, holder, kyc))
+	suite.Require().Equal([][]byte{creditScore}, suite.collectVerificationsByIssuer(ctx, issuer, types.VerificationType_VT_UNSPECIFIED))
+	suite.Require().NotContains(suite.collectVerificationsByType(ctx, types.VerificationType_VT_KYC), kyc)
+	suite.Require().Contains(suite.collectVerificationsByType(ctx, types.VerificationType_VT_KYC), otherKyc)
+}
+
+func (suite *KeeperTestSuite) TestVerificationIndexesAfterIssuerMigration() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	oldIssuer := suite.createVerifiedIssuer(ctx)
+	holder := tests.RandomAccAddress()
+	verificationId := suite.addIndexedVerification(ctx, oldIssuer, holder, types.VerificationType_VT_KYC, 0)
+
+	newIssuer := tests.RandomAccAddress()
+	_, err := suite.keeper.MigrateIssuer(ctx, oldIssuer, newIssuer)
+	suite.Require().NoError(err)
+
+	suite.Require().Empty(suite.collectVerificationsByIssuer(ctx, oldIssuer, types.VerificationType_VT_UNSPECIFIED))
+	suite.Require().Equal([][]byte{verificationId}, suite.collectVerificationsByIssuer(ctx, newIssuer, types.VerificationType_VT_UNSPECIFIED))
+
+	// Verifications are returned by both old and new issuer addresses
+	q := keeper.Querier{Keeper: suite.keeper}
+	for _, issuer := range []sdk.AccAddress{oldIssuer, newIssuer} {
+		resp, err := q.VerificationsByIssuer(sdk.WrapSDKContext(ctx), &types.QueryVerificationsByIssuerRequest{
+			IssuerAddress: issuer.String(),
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(resp.Verifications, 1)
+		suite.Require().Equal(verificationId, resp.Verifications[0].Details.VerificationId)
+	}
+
+	// Verification issued before migration can be pruned
+	suite.Require().NoError(suite.keeper.PruneVerification(ctx, holder, verificationId))
+	suite.Require().Empty(suite.collectVerificationsByIssuer(ctx, newIssuer, types.VerificationType_VT_UNSPECIFIED))
+}
+
+func (suite *KeeperTestSuite) TestQueryVerificationsByIssuer() {
+	ctx, _ := suite.ctx.CacheContext()
+	goCtx := sdk.WrapSDKContext(ctx)
+	q := keeper.Querier{Keeper: suite.keeper}
+
+	issuer := suite.createVerifiedIssuer(ctx)
+	otherIssuer := suite.createVerifiedIssuer(ctx)
+
+	expiring := suite.addIndexedVerification(ctx, issuer, tests.RandomAccAddress(), types.VerificationType_VT_KYC, 1800000000)
+	expired := suite.addIndexedVerification(ctx, issuer, tests.RandomAccAddress(), types.VerificationType_VT_KYC, 1700000000)
+	neverExpiring := suite.addIndexedVerification(ctx, issuer, tests.RandomAccAddress(), types.VerificationType_VT_KYC, 0)
+	creditScore := suite.addIndexedVerification(ctx, issuer, tests.RandomAccAddress(), types.VerificationType_VT_CREDIT_SCORE, 0)
+	otherKyc := suite.addIndexedVerification(ctx, otherIssuer, tests.RandomAccAddress(), types.VerificationType_VT_KYC, 0)
+	suite.Require().NoError(suite.keeper.MarkVerificationDetailsAsRevoked(ctx, neverExpiring))
+
+	ids := func(resp *types.QueryVerificationsByIssuerResponse) [][]byte {
+		var result [][]byte
+		for _, verification := range resp.Verifications {
+			result = append(result, verification.Details.VerificationId)
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name     string
+		req      *types.QueryVerificationsByIssuerRequest
+		expected [][]byte
+	}{
+		{
+			"all verifications of issuer",
+			&types.QueryVerificationsByIssuerRequest{IssuerAddress: issuer.String()},
+			[][]byte{expiring, expired, neverExpiring, creditScore},
+		},
+		{
+			"verifications of issuer with type",
+			&types.QueryVerificationsByIssuerRequest{
+				IssuerAddress:    issuer.String(),
+				VerificationType: types.VerificationType_VT_CREDIT_SCORE,
+			},
+			[][]byte{creditScore},
+		},
+		{
+			"revoked verifications",
+			&types.QueryVerificationsByIssuerRequest{
+				IssuerAddress:    issuer.String(),
+				RevocationStatus: types.RevocationStatusFilter_RSF_REVOKED,
+			},
+			[][]byte{neverExpiring},
+		},
+		{
+			"valid KYC verifications",
+			&types.QueryVerificationsByIssuerRequest{
+				IssuerAddress:    issuer.String(),
+				VerificationType: types.VerificationType_VT_KYC,
+				RevocationStatus: types.RevocationStatusFilter_RSF_NOT_REVOKED,
+				ExpiresAfter:     1750000000,
+			},
+			[][]byte{expiring},
+		},
+		{
+			"verifications expiring within window",
+			&types.QueryVerificationsByIssuerRequest{
+				IssuerAddress: issuer.String(),
+				ExpiresAfter:  1700000000,
+				ExpiresBefore: 1800000000,
+			},
+			[][]byte{expiring, expired},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			resp, err := q.VerificationsByIssuer(goCtx, tc.req)
+			suite.Require().NoError(err)
+			suite.Require().ElementsMatch(tc.expected, ids(resp))
+		})
+	}
+
+	// Verifications of any issuer are returned if only type is provided
+	resp, err := q.VerificationsByIssuer(goCtx, &types.QueryVerificationsByIssuerRequest{
+		VerificationType: types.VerificationType_VT_KYC,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Subset(ids(resp), [][]byte{expiring, expired, neverExpiring, otherKyc})
+	suite.Require().NotContains(ids(resp), creditScore)
+
+	// Filtered verifications are paginated
+	var paginated [][]byte
+	pageReq := &query.PageRequest{Limit: 1}
+	for {
+		resp, err := q.VerificationsByIssuer(goCtx, &types.QueryVerificationsByIssuerRequest{
+			IssuerAddress:    issuer.String(),
+			RevocationStatus: types.RevocationStatusFilter_RSF_NOT_REVOKED,
+			Pagination:       pageReq,
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(resp.Verifications), 1)
+		paginated = append(paginated, ids(resp)...)
+		if resp.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1}
+	}
+	suite.Require().ElementsMatch([][]byte{expiring, expired, creditScore}, paginated)
+
+	// Verifications of removed issuer are not returned
+	suite.keeper.RemoveIssuer(ctx, otherIssuer)
+	resp, err = q.VerificationsByIssuer(goCtx, &types.QueryVerificationsByIssuerRequest{IssuerAddress: otherIssuer.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(resp.Verifications)
+}
+
+func (suite *KeeperTestSuite) TestQueryVerificationsByIssuerInvalid() {
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	q := keeper.Querier{Keeper: suite.keeper}
+
+	testCases := []struct {
+		name string
+		req  *types.QueryVerificationsByIssuerRequest
+	}{
+		{"nil request", nil},
+		{"neither issuer nor type", &types.QueryVerificationsByIssuerRequest{}},
+		{"invalid issuer address", &types.QueryVerificationsByIssuerRequest{IssuerAddress: "invalid"}},
+		{
+			"invalid verification type",
+			&types.QueryVerificationsByIssuerRequest{VerificationType: types.VerificationType(100)},
+		},
+		{
+			"invalid revocation status",
+			&types.QueryVerificationsByIssuerRequest{
+				IssuerAddress:    tests.RandomAccAddress().String(),
+				RevocationStatus: types.RevocationStatusFilter(100),
+			},
+		},
+		{
+			"invalid expiration window",
+			&types.QueryVerificationsByIssuerRequest{
+				IssuerAddress: tests.RandomAccAddress().String(),
+				ExpiresAfter:  1800000000,
+				ExpiresBefore: 1700000000,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := q.VerificationsByIssuer(goCtx, tc.req)
+			suite.Require().Error(err)
+		})
+	}
+}
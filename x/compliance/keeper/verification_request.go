@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/x/compliance/types"
+)
+
+// SetVerificationRequest stores pending verification request. Request is stored under actual address of issuer,
+// so requests for migrated issuers are visible by the new issuer address.
+func (k Keeper) SetVerificationRequest(ctx sdk.Context, request *types.VerificationRequest) error {
+	holder, err := sdk.AccAddressFromBech32(request.Holder)
+	if err != nil {
+		return err
+	}
+	issuerAddress, err := sdk.AccAddressFromBech32(request.IssuerAddress)
+	if err != nil {
+		return err
+	}
+	issuerAddress = k.ResolveIssuerAddress(ctx, issuerAddress)
+	request.IssuerAddress = issuerAddress.String()
+
+	requestBytes, err := request.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationRequest)
+	store.Set(types.VerificationRequestKey(issuerAddress, request.Type, holder), requestBytes)
+	return nil
+}
+
+// RemoveVerificationRequest removes pending verification request, if it exists
+func (k Keeper) RemoveVerificationRequest(
+	ctx sdk.Context,
+	issuerAddress sdk.AccAddress,
+	verificationType types.VerificationType,
+	holder sdk.AccAddress,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVerificationRequest)
+	store.Delete(types.VerificationRequestKey(issuerAddress, verificationType, holder))
+}
+
+// IterateVerificationRequests iterates over pending verification requests of provided issuer
+// in ascending order of verification type
+func (k Keeper) IterateVerificationRequests(
+	ctx sdk.Context,
+	issuerAddress sdk.AccAddress,
+	callback func(request *types.VerificationRequest) (continue_ bool),
+) error {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefixVerificationRequest, types.VerificationByIssuerPrefix(issuerAddress)...),
+	)
+	iterator := store.Iterator(nil, nil)
+	defer closeIteratorOrPanic(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.VerificationRequest
+		if err := request.Unmarshal(iterator.Value()); err != nil {
+			return err
+		}
+		if !callback(&request) {
+			break
+		}
+	}
+	return nil
+}
+
+// moveVerificationRequests moves all the pending verification requests of old issuer to the new one
+func (k Keeper) moveVerificationRequests(ctx sdk.Context, oldIssuer, newIssuer sdk.AccAddress) error {
+	// Collect requests first, since the store should not be modified during iteration
+	var requests []*types.VerificationRequest
+	err := k.IterateVerificationRequests(ctx, oldIssuer, func(request *types.VerificationRequest) bool {
+		requests = append(requests, request)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, request := range requests {
+		holder, err := sdk.AccAddressFromBech32(request.Holder)
+		if err != nil {
+			return err
+		}
+		k.RemoveVerificationRequest(ctx, oldIssuer, request.Type, holder)
+
+		request.IssuerAddress = newIssuer.String()
+		if err = k.SetVerificationRequest(ctx, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
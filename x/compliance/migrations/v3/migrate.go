@@ -0,0 +1,33 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"swisstronik/x/compliance/types"
+)
+
+// MigrateStore builds time-ordered expiration index for all existing verifications,
+// so they can be handled by EndBlock once they expire.
+func MigrateStore(ctx sdk.Context, k types.ComplianceKeeper) error {
+	var (
+		verificationIds [][]byte
+		details         *types.VerificationDetails
+		err             error
+	)
+	k.IterateVerificationDetails(ctx, func(id []byte) (continue_ bool) {
+		verificationIds = append(verificationIds, id)
+		return true
+	})
+
+	for _, id := range verificationIds {
+		details, err = k.GetRawVerificationDetails(ctx, id)
+		if err != nil {
+			return err
+		}
+		k.SetVerificationExpiration(ctx, id, details.ExpirationTimestamp)
+	}
+
+	// Apply default params, since there were no params for x/compliance before
+	k.SetParams(ctx, types.DefaultParams())
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"swisstronik/x/compliance/types"
+)
+
+// MigrateStore builds issuer and type indexes for all existing verifications,
+// so they can be listed by issuer or verification type.
+func MigrateStore(ctx sdk.Context, k types.ComplianceKeeper) error {
+	var (
+		verificationIds [][]byte
+		details         *types.VerificationDetails
+		err             error
+	)
+	k.IterateVerificationDetails(ctx, func(id []byte) (continue_ bool) {
+		verificationIds = append(verificationIds, id)
+		return true
+	})
+
+	for _, id := range verificationIds {
+		details, err = k.GetRawVerificationDetails(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err = k.SetVerificationIndexes(ctx, id, details); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
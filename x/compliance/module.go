@@ -22,7 +22,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/compliance module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 4
 
 var (
 	_ module.AppModule           = AppModule{}
@@ -113,6 +113,12 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1_0_2to1_0_3); err != nil {
 		panic(err)
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -143,7 +149,15 @@ func (AppModule) ConsensusVersion() uint64 {
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock contains the logic that is automatically triggered at the end of each block
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock contains the logic that is automatically triggered at the end of each block.
+// It processes verifications, which expired by the current block time, and keeps roots of issuance
+// and revocation trees of the current block.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.ProcessExpiredVerifications(ctx)
+
+	if err := am.keeper.SaveTreeRoots(ctx); err != nil {
+		am.keeper.Logger(ctx).Error("failed to save tree roots", "error", err)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
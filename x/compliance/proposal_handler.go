@@ -20,36 +20,10 @@ func NewComplianceProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
 	}
 }
 
+// handleVerifyIssuerProposal handles legacy (gov v1beta1) proposal to verify issuer.
+// It goes through the same path as gov v1 `MsgVerifyIssuer`, executed by governance authority.
 func handleVerifyIssuerProposal(ctx sdk.Context, k *keeper.Keeper, p *types.VerifyIssuerProposal) error {
-	return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "handler disabled")
-
-	//issuer, err := sdk.AccAddressFromBech32(p.IssuerAddress)
-	//if err != nil {
-	//	return err
-	//}
-	//
-	//// Issuer should exist and be verified
-	//exists, _ := k.IssuerExists(ctx, issuer)
-	//if !exists {
-	//	return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "unknown issuer address %s", p.IssuerAddress)
-	//}
-	//verified, _ := k.IsAddressVerified(ctx, issuer)
-	//if verified {
-	//	return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "issuer already verified %s", p.IssuerAddress)
-	//}
-	//
-	//// Set issuer verified through governance proposal
-	//err = k.SetAddressVerificationStatus(ctx, issuer, true)
-	//if err != nil {
-	//	return err
-	//}
-	//
-	//ctx.EventManager().EmitEvent(
-	//	sdk.NewEvent(
-	//		types.EventTypeVerifyIssuer,
-	//		sdk.NewAttribute(types.AttributeKeyIssuer, p.IssuerAddress),
-	//		sdk.NewAttribute(types.AttributeKeyVerificationStatus, strconv.FormatBool(true)),
-	//	),
-	//)
-	//return nil
+	msg := types.NewMsgVerifyIssuer(k.GetAuthority().String(), p.IssuerAddress)
+	_, err := keeper.NewMsgServerImpl(*k).HandleVerifyIssuer(sdk.WrapSDKContext(ctx), &msg)
+	return err
 }
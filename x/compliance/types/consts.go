@@ -5,4 +5,11 @@ const (
 	MaxProofDataSize            = 4096
 	MaxSchemaSize               = 1028
 	MaxOriginChainSize          = 96
+	MaxRevocationNoteSize       = 256
+	MaxRevocationsPerMsg        = 500
+	// RootHistorySize is number of recent blocks, for which roots of issuance and revocation trees are kept
+	RootHistorySize = 256
+	// MaxExpiredVerificationsPerBlock is max number of expired verifications processed at the end of block.
+	// Remaining ones are processed in the following blocks
+	MaxExpiredVerificationsPerBlock = 100
 )
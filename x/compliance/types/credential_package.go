@@ -0,0 +1,99 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	merkletree "github.com/SigmaGmbH/go-merkletree-sql/v2"
+	"github.com/iden3/go-iden3-crypto/mimc7"
+)
+
+const (
+	// ProofFncInclusion marks circom verifier proof of inclusion into the tree
+	ProofFncInclusion = 0
+	// ProofFncNonInclusion marks circom verifier proof of non-inclusion into the tree
+	ProofFncNonInclusion = 1
+)
+
+// VerifyCredentialPackage checks credential package offline. It checks that credential hash matches credential fields,
+// credential is included into issuance tree with provided issuance root and is not included into revocation tree
+// with provided revocation root. Caller is responsible for checking that roots are known by the chain,
+// for example, using `RootHistory` query.
+func VerifyCredentialPackage(pkg *CredentialPackage) error {
+	if pkg == nil || pkg.Credential == nil {
+		return errors.New("empty credential package")
+	}
+
+	credentialHash, err := pkg.Credential.Hash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(credentialHash.Bytes(), pkg.CredentialHash) {
+		return errors.New("credential hash does not match credential")
+	}
+
+	// Credentials are stored in trees as `MiMC7(hash) => hash`
+	credentialKey, err := mimc7.Hash([]*big.Int{credentialHash}, big.NewInt(0))
+	if err != nil {
+		return err
+	}
+
+	issuanceRoot := new(big.Int).SetBytes(pkg.IssuanceRoot)
+	if err = verifyCircomProof(pkg.IssuanceProof, ProofFncInclusion, issuanceRoot, credentialKey, credentialHash); err != nil {
+		return fmt.Errorf("invalid issuance proof: %w", err)
+	}
+
+	revocationRoot := new(big.Int).SetBytes(pkg.RevocationRoot)
+	if err = verifyCircomProof(pkg.NonRevocationProof, ProofFncNonInclusion, revocationRoot, credentialKey, nil); err != nil {
+		return fmt.Errorf("invalid non-revocation proof: %w", err)
+	}
+
+	return nil
+}
+
+// verifyCircomProof checks that JSON-encoded circom verifier proof proves inclusion (or non-inclusion)
+// of provided key into the tree with provided root. For inclusion proofs value of the leaf is checked as well.
+func verifyCircomProof(encodedProof []byte, expectedFnc int, root, key, value *big.Int) error {
+	var proof merkletree.CircomVerifierProof
+	if err := json.Unmarshal(encodedProof, &proof); err != nil {
+		return err
+	}
+	if proof.Root == nil || proof.Key == nil || proof.Value == nil || proof.OldKey == nil || proof.OldValue == nil {
+		return errors.New("incomplete proof")
+	}
+
+	if proof.Fnc != expectedFnc {
+		return fmt.Errorf("unexpected proof type %d", proof.Fnc)
+	}
+	if proof.Root.BigInt().Cmp(root) != 0 {
+		return errors.New("proof root does not match")
+	}
+	if proof.Key.BigInt().Cmp(key) != 0 {
+		return errors.New("proof key does not match credential")
+	}
+	if value != nil && proof.Value.BigInt().Cmp(value) != 0 {
+		return errors.New("proof value does not match credential")
+	}
+
+	var nodeAux *merkletree.NodeAux
+	if expectedFnc == ProofFncNonInclusion && !(proof.OldKey.Equals(&merkletree.HashZero) && proof.OldValue.Equals(&merkletree.HashZero)) {
+		nodeAux = &merkletree.NodeAux{Key: proof.OldKey, Value: proof.OldValue}
+	}
+	mtProof, err := merkletree.NewProofFromData(expectedFnc == ProofFncInclusion, proof.Siblings, nodeAux)
+	if err != nil {
+		return err
+	}
+
+	calculatedRoot, err := merkletree.RootFromProof(mtProof, proof.Key.BigInt(), proof.Value.BigInt())
+	if err != nil {
+		return err
+	}
+	if !calculatedRoot.Equals(proof.Root) {
+		return errors.New("proof does not match root")
+	}
+
+	return nil
+}
@@ -3,8 +3,10 @@ package types
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"github.com/iden3/go-iden3-crypto/mimc7"
 	"math/big"
+	"slices"
 )
 
 func (vt VerificationType) ToBytes() []byte {
@@ -13,6 +15,48 @@ func (vt VerificationType) ToBytes() []byte {
 	return bytes
 }
 
+// IsValid checks if verification type is one of defined verification types
+func (vt VerificationType) IsValid() bool {
+	return vt > VerificationType_VT_UNSPECIFIED && vt <= VerificationType_VT_BIOMETRIC
+}
+
+// ValidateVerificationTypes checks that all provided verification types are valid and not duplicated
+func ValidateVerificationTypes(verificationTypes []VerificationType) error {
+	for i, vt := range verificationTypes {
+		if !vt.IsValid() {
+			return fmt.Errorf("invalid verification type: %d", vt)
+		}
+		if slices.Contains(verificationTypes[:i], vt) {
+			return fmt.Errorf("duplicated verification type: %s", vt)
+		}
+	}
+	return nil
+}
+
+// IsVerificationTypeAllowed checks if issuer is allowed to issue verifications of provided type.
+// If there are no allowed verification types, issuer can issue verifications of any type.
+func (d *IssuerDetails) IsVerificationTypeAllowed(verificationType VerificationType) bool {
+	return len(d.AllowedVerificationTypes) == 0 || slices.Contains(d.AllowedVerificationTypes, verificationType)
+}
+
+// IsLimited returns true if quota limits number of issued verifications
+func (q *IssuanceQuota) IsLimited() bool {
+	return q != nil && q.MaxIssuances > 0
+}
+
+// Validate checks if quota has valid window
+func (q IssuanceQuota) Validate() error {
+	if q.MaxIssuances > 0 && q.WindowBlocks == 0 {
+		return errors.New("window blocks should be positive if max issuances is set")
+	}
+	return nil
+}
+
+// IsValid checks if revocation reason is one of defined revocation reasons
+func (rr RevocationReason) IsValid() bool {
+	return rr >= RevocationReason_RR_UNSPECIFIED && rr <= RevocationReason_RR_OTHER
+}
+
 func (c *ZKCredential) Hash() (*big.Int, error) {
 	typeBig := big.NewInt(int64(c.Type))
 	issuerAddressBig := new(big.Int).SetBytes(c.IssuerAddress)
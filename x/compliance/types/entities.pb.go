@@ -73,6 +73,51 @@ func (VerificationType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a6b6c3ec8e3c39ee, []int{0}
 }
 
+// RevocationReason defines why verification was revoked
+type RevocationReason int32
+
+const (
+	// RR_UNSPECIFIED is used for verifications revoked without reason
+	RevocationReason_RR_UNSPECIFIED       RevocationReason = 0
+	RevocationReason_RR_KEY_COMPROMISE    RevocationReason = 1
+	RevocationReason_RR_ISSUER_COMPROMISE RevocationReason = 2
+	RevocationReason_RR_ISSUED_BY_MISTAKE RevocationReason = 3
+	RevocationReason_RR_SUPERSEDED        RevocationReason = 4
+	RevocationReason_RR_HOLDER_REQUEST    RevocationReason = 5
+	RevocationReason_RR_FRAUD             RevocationReason = 6
+	RevocationReason_RR_OTHER             RevocationReason = 7
+)
+
+var RevocationReason_name = map[int32]string{
+	0: "RR_UNSPECIFIED",
+	1: "RR_KEY_COMPROMISE",
+	2: "RR_ISSUER_COMPROMISE",
+	3: "RR_ISSUED_BY_MISTAKE",
+	4: "RR_SUPERSEDED",
+	5: "RR_HOLDER_REQUEST",
+	6: "RR_FRAUD",
+	7: "RR_OTHER",
+}
+
+var RevocationReason_value = map[string]int32{
+	"RR_UNSPECIFIED":       0,
+	"RR_KEY_COMPROMISE":    1,
+	"RR_ISSUER_COMPROMISE": 2,
+	"RR_ISSUED_BY_MISTAKE": 3,
+	"RR_SUPERSEDED":        4,
+	"RR_HOLDER_REQUEST":    5,
+	"RR_FRAUD":             6,
+	"RR_OTHER":             7,
+}
+
+func (x RevocationReason) String() string {
+	return proto.EnumName(RevocationReason_name, int32(x))
+}
+
+func (RevocationReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{1}
+}
+
 type OperatorType int32
 
 const (
@@ -101,7 +146,7 @@ func (x OperatorType) String() string {
 }
 
 func (OperatorType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a6b6c3ec8e3c39ee, []int{1}
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{2}
 }
 
 type OperatorDetails struct {
@@ -173,6 +218,13 @@ type IssuerDetails struct {
 	LegalEntity string `protobuf:"bytes,5,opt,name=legalEntity,proto3" json:"legalEntity,omitempty"`
 	// Issuer creator who created current issuer
 	Creator string `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Verification types, which issuer is allowed to issue.
+	// Empty list means that issuer can issue verifications of any type.
+	// Can be changed only by operator.
+	AllowedVerificationTypes []VerificationType `protobuf:"varint,7,rep,packed,name=allowed_verification_types,json=allowedVerificationTypes,proto3,enum=swisstronik.compliance.VerificationType" json:"allowed_verification_types,omitempty"`
+	// Optional limit of verifications, which issuer can issue within block or epoch.
+	// Can be changed only by operator.
+	IssuanceQuota *IssuanceQuota `protobuf:"bytes,8,opt,name=issuance_quota,json=issuanceQuota,proto3" json:"issuance_quota,omitempty"`
 }
 
 func (m *IssuerDetails) Reset()         { *m = IssuerDetails{} }
@@ -250,6 +302,204 @@ func (m *IssuerDetails) GetCreator() string {
 	return ""
 }
 
+func (m *IssuerDetails) GetAllowedVerificationTypes() []VerificationType {
+	if m != nil {
+		return m.AllowedVerificationTypes
+	}
+	return nil
+}
+
+func (m *IssuerDetails) GetIssuanceQuota() *IssuanceQuota {
+	if m != nil {
+		return m.IssuanceQuota
+	}
+	return nil
+}
+
+// IssuerMigration describes migration of issuer to the new address.
+// Old address is kept as an alias of the new one.
+type IssuerMigration struct {
+	// Previous issuer address
+	OldAddress string `protobuf:"bytes,1,opt,name=old_address,json=oldAddress,proto3" json:"old_address,omitempty"`
+	// New issuer address
+	NewAddress string `protobuf:"bytes,2,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+	// Height of block, in which issuer was migrated
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Unix timestamp of block, in which issuer was migrated
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *IssuerMigration) Reset()         { *m = IssuerMigration{} }
+func (m *IssuerMigration) String() string { return proto.CompactTextString(m) }
+func (*IssuerMigration) ProtoMessage()    {}
+func (*IssuerMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{2}
+}
+func (m *IssuerMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssuerMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssuerMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IssuerMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuerMigration.Merge(m, src)
+}
+func (m *IssuerMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssuerMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuerMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuerMigration proto.InternalMessageInfo
+
+func (m *IssuerMigration) GetOldAddress() string {
+	if m != nil {
+		return m.OldAddress
+	}
+	return ""
+}
+
+func (m *IssuerMigration) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+func (m *IssuerMigration) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *IssuerMigration) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// IssuanceQuota defines limit of verifications, which issuer can issue within a window of blocks
+type IssuanceQuota struct {
+	// Maximum number of verifications issued within single window. Zero means no limit.
+	MaxIssuances uint64 `protobuf:"varint,1,opt,name=max_issuances,json=maxIssuances,proto3" json:"max_issuances,omitempty"`
+	// Length of window (epoch) in blocks. 1 means that limit is applied per block.
+	WindowBlocks uint64 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+}
+
+func (m *IssuanceQuota) Reset()         { *m = IssuanceQuota{} }
+func (m *IssuanceQuota) String() string { return proto.CompactTextString(m) }
+func (*IssuanceQuota) ProtoMessage()    {}
+func (*IssuanceQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{3}
+}
+func (m *IssuanceQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssuanceQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssuanceQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IssuanceQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuanceQuota.Merge(m, src)
+}
+func (m *IssuanceQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssuanceQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuanceQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuanceQuota proto.InternalMessageInfo
+
+func (m *IssuanceQuota) GetMaxIssuances() uint64 {
+	if m != nil {
+		return m.MaxIssuances
+	}
+	return 0
+}
+
+func (m *IssuanceQuota) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+// IssuanceCounter tracks number of verifications issued by issuer within current window
+type IssuanceCounter struct {
+	// Index of window, calculated as `block height / window blocks`
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// Number of verifications issued within window
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *IssuanceCounter) Reset()         { *m = IssuanceCounter{} }
+func (m *IssuanceCounter) String() string { return proto.CompactTextString(m) }
+func (*IssuanceCounter) ProtoMessage()    {}
+func (*IssuanceCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{4}
+}
+func (m *IssuanceCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssuanceCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssuanceCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IssuanceCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuanceCounter.Merge(m, src)
+}
+func (m *IssuanceCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssuanceCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuanceCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuanceCounter proto.InternalMessageInfo
+
+func (m *IssuanceCounter) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *IssuanceCounter) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 type AddressDetails struct {
 	// Marks if contract deployed under this address is verified
 	// by community. Only verified contracts will be allowed to write
@@ -265,7 +515,7 @@ func (m *AddressDetails) Reset()         { *m = AddressDetails{} }
 func (m *AddressDetails) String() string { return proto.CompactTextString(m) }
 func (*AddressDetails) ProtoMessage()    {}
 func (*AddressDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6b6c3ec8e3c39ee, []int{2}
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{5}
 }
 func (m *AddressDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +578,7 @@ func (m *Verification) Reset()         { *m = Verification{} }
 func (m *Verification) String() string { return proto.CompactTextString(m) }
 func (*Verification) ProtoMessage()    {}
 func (*Verification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6b6c3ec8e3c39ee, []int{3}
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{6}
 }
 func (m *Verification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +657,7 @@ func (m *VerificationDetails) Reset()         { *m = VerificationDetails{} }
 func (m *VerificationDetails) String() string { return proto.CompactTextString(m) }
 func (*VerificationDetails) ProtoMessage()    {}
 func (*VerificationDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6b6c3ec8e3c39ee, []int{4}
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{7}
 }
 func (m *VerificationDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,6 +756,79 @@ func (m *VerificationDetails) GetIsRevoked() bool {
 	return false
 }
 
+// RevocationDetails describes when and why verification was revoked
+type RevocationDetails struct {
+	// Revocation reason
+	Reason RevocationReason `protobuf:"varint,1,opt,name=reason,proto3,enum=swisstronik.compliance.RevocationReason" json:"reason,omitempty"`
+	// Optional note of revoker
+	Note string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	// Height of block, in which verification was revoked
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Unix timestamp of block, in which verification was revoked
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *RevocationDetails) Reset()         { *m = RevocationDetails{} }
+func (m *RevocationDetails) String() string { return proto.CompactTextString(m) }
+func (*RevocationDetails) ProtoMessage()    {}
+func (*RevocationDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{8}
+}
+func (m *RevocationDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevocationDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevocationDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevocationDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocationDetails.Merge(m, src)
+}
+func (m *RevocationDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevocationDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocationDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocationDetails proto.InternalMessageInfo
+
+func (m *RevocationDetails) GetReason() RevocationReason {
+	if m != nil {
+		return m.Reason
+	}
+	return RevocationReason_RR_UNSPECIFIED
+}
+
+func (m *RevocationDetails) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *RevocationDetails) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *RevocationDetails) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 // MergedVerificationDetails is merged structure of iterating key and `VerificationDetails` in `entities.proto`.
 // `verification_type` and `verification_id` are iterating keys, and the following items should be same with `VerificationDetails`.
 type MergedVerificationDetails struct {
@@ -526,7 +849,7 @@ func (m *MergedVerificationDetails) Reset()         { *m = MergedVerificationDet
 func (m *MergedVerificationDetails) String() string { return proto.CompactTextString(m) }
 func (*MergedVerificationDetails) ProtoMessage()    {}
 func (*MergedVerificationDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6b6c3ec8e3c39ee, []int{5}
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{9}
 }
 func (m *MergedVerificationDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,6 +955,70 @@ func (m *MergedVerificationDetails) GetIsRevoked() bool {
 	return false
 }
 
+// TreeRoots contains roots of issuance and revocation trees at the end of block
+type TreeRoots struct {
+	// Height of block
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Root of issuance tree
+	IssuanceRoot []byte `protobuf:"bytes,2,opt,name=issuance_root,json=issuanceRoot,proto3" json:"issuance_root,omitempty"`
+	// Root of revocation tree
+	RevocationRoot []byte `protobuf:"bytes,3,opt,name=revocation_root,json=revocationRoot,proto3" json:"revocation_root,omitempty"`
+}
+
+func (m *TreeRoots) Reset()         { *m = TreeRoots{} }
+func (m *TreeRoots) String() string { return proto.CompactTextString(m) }
+func (*TreeRoots) ProtoMessage()    {}
+func (*TreeRoots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{10}
+}
+func (m *TreeRoots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreeRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreeRoots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreeRoots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreeRoots.Merge(m, src)
+}
+func (m *TreeRoots) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreeRoots) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreeRoots.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreeRoots proto.InternalMessageInfo
+
+func (m *TreeRoots) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TreeRoots) GetIssuanceRoot() []byte {
+	if m != nil {
+		return m.IssuanceRoot
+	}
+	return nil
+}
+
+func (m *TreeRoots) GetRevocationRoot() []byte {
+	if m != nil {
+		return m.RevocationRoot
+	}
+	return nil
+}
+
 // ZKCredential contains basic information, which can be used to construct proof-of-ownership of some credential
 type ZKCredential struct {
 	Type                VerificationType `protobuf:"varint,1,opt,name=type,proto3,enum=swisstronik.compliance.VerificationType" json:"type,omitempty"`
@@ -645,7 +1032,7 @@ func (m *ZKCredential) Reset()         { *m = ZKCredential{} }
 func (m *ZKCredential) String() string { return proto.CompactTextString(m) }
 func (*ZKCredential) ProtoMessage()    {}
 func (*ZKCredential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6b6c3ec8e3c39ee, []int{6}
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{11}
 }
 func (m *ZKCredential) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,99 +1096,333 @@ func (m *ZKCredential) GetIssuanceTimestamp() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("swisstronik.compliance.VerificationType", VerificationType_name, VerificationType_value)
-	proto.RegisterEnum("swisstronik.compliance.OperatorType", OperatorType_name, OperatorType_value)
-	proto.RegisterType((*OperatorDetails)(nil), "swisstronik.compliance.OperatorDetails")
-	proto.RegisterType((*IssuerDetails)(nil), "swisstronik.compliance.IssuerDetails")
-	proto.RegisterType((*AddressDetails)(nil), "swisstronik.compliance.AddressDetails")
-	proto.RegisterType((*Verification)(nil), "swisstronik.compliance.Verification")
-	proto.RegisterType((*VerificationDetails)(nil), "swisstronik.compliance.VerificationDetails")
-	proto.RegisterType((*MergedVerificationDetails)(nil), "swisstronik.compliance.MergedVerificationDetails")
-	proto.RegisterType((*ZKCredential)(nil), "swisstronik.compliance.ZKCredential")
+// CredentialPackage is a self-contained bundle, which allows holder to prove ownership
+// of ZK-SDI credential off-chain
+type CredentialPackage struct {
+	// Id of verification, from which credential was created
+	VerificationId []byte `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	// Credential fields used to calculate credential hash
+	Credential *ZKCredential `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+	// MiMC7 hash of credential
+	CredentialHash []byte `protobuf:"bytes,3,opt,name=credential_hash,json=credentialHash,proto3" json:"credential_hash,omitempty"`
+	// Root of issuance tree, against which issuance proof was generated
+	IssuanceRoot []byte `protobuf:"bytes,4,opt,name=issuance_root,json=issuanceRoot,proto3" json:"issuance_root,omitempty"`
+	// Root of revocation tree, against which non-revocation proof was generated
+	RevocationRoot []byte `protobuf:"bytes,5,opt,name=revocation_root,json=revocationRoot,proto3" json:"revocation_root,omitempty"`
+	// JSON-encoded circom verifier proof of credential inclusion into issuance tree
+	IssuanceProof []byte `protobuf:"bytes,6,opt,name=issuance_proof,json=issuanceProof,proto3" json:"issuance_proof,omitempty"`
+	// JSON-encoded circom verifier proof of credential non-inclusion into revocation tree
+	NonRevocationProof []byte `protobuf:"bytes,7,opt,name=non_revocation_proof,json=nonRevocationProof,proto3" json:"non_revocation_proof,omitempty"`
+	// Height of block, at which package was created
+	BlockHeight int64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("swisstronik/compliance/entities.proto", fileDescriptor_a6b6c3ec8e3c39ee)
+func (m *CredentialPackage) Reset()         { *m = CredentialPackage{} }
+func (m *CredentialPackage) String() string { return proto.CompactTextString(m) }
+func (*CredentialPackage) ProtoMessage()    {}
+func (*CredentialPackage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{12}
 }
-
-var fileDescriptor_a6b6c3ec8e3c39ee = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0xc7, 0xeb, 0x24, 0xcd, 0xc7, 0x89, 0x93, 0xf8, 0x4e, 0xab, 0xca, 0x54, 0x22, 0x94, 0x5c,
-	0xae, 0xa8, 0x2a, 0xd1, 0x8a, 0x8f, 0x05, 0x0b, 0x36, 0x69, 0x12, 0xc0, 0xb4, 0x69, 0xae, 0x26,
-	0x6e, 0xd0, 0xbd, 0x9b, 0xd1, 0xdc, 0x78, 0x48, 0x47, 0x75, 0x62, 0x6b, 0xc6, 0x2d, 0xcd, 0x92,
-	0x37, 0xe0, 0x01, 0xd8, 0xb3, 0x40, 0xbc, 0x07, 0xcb, 0xbb, 0x64, 0x89, 0xda, 0x37, 0xe0, 0x09,
-	0xd0, 0x8c, 0xed, 0xd6, 0xd7, 0xfd, 0x50, 0x2b, 0x60, 0x77, 0x3e, 0xe6, 0x9c, 0xf3, 0x9f, 0xf9,
-	0x8d, 0xe5, 0x81, 0x17, 0xf2, 0x47, 0x2e, 0x65, 0x24, 0x82, 0x05, 0x3f, 0xdd, 0x9b, 0x06, 0xf3,
-	0xd0, 0xe7, 0x74, 0x31, 0x65, 0x7b, 0x6c, 0x11, 0xf1, 0x88, 0x33, 0xb9, 0x1b, 0x8a, 0x20, 0x0a,
-	0xd0, 0x46, 0x66, 0xd9, 0xee, 0xcd, 0xb2, 0xcd, 0xf5, 0x59, 0x30, 0x0b, 0xf4, 0x92, 0x3d, 0x65,
-	0xc5, 0xab, 0x37, 0x9f, 0xdf, 0xd3, 0x34, 0xa4, 0x82, 0xce, 0x93, 0x96, 0x9d, 0x0b, 0x68, 0x8d,
-	0x42, 0x26, 0x68, 0x14, 0x88, 0x3e, 0x8b, 0x28, 0xf7, 0x25, 0xda, 0x84, 0x6a, 0x90, 0x84, 0x6c,
-	0x63, 0xcb, 0xd8, 0xae, 0xe1, 0x6b, 0x1f, 0x39, 0xd0, 0x48, 0x6d, 0x12, 0x2d, 0x43, 0x66, 0x17,
-	0xb6, 0x8c, 0xed, 0xe6, 0x67, 0x1f, 0xed, 0xde, 0xad, 0x6c, 0x37, 0xed, 0xed, 0x2e, 0x43, 0x86,
-	0xcd, 0x20, 0xe3, 0x75, 0x7e, 0x35, 0xa0, 0xe1, 0x48, 0x79, 0xc6, 0xae, 0x07, 0x23, 0x28, 0x2d,
-	0xe8, 0x9c, 0x25, 0x43, 0xb5, 0x8d, 0xb6, 0xa0, 0xee, 0x31, 0x39, 0x15, 0x3c, 0x8c, 0x78, 0xb0,
-	0xd0, 0xe3, 0x6a, 0x38, 0x1b, 0x42, 0x16, 0x14, 0xcf, 0x84, 0x6f, 0x17, 0x75, 0x46, 0x99, 0xaa,
-	0x8f, 0x1f, 0xcc, 0x02, 0xbb, 0x14, 0xf7, 0x51, 0xb6, 0xea, 0xe3, 0xb3, 0x19, 0xf5, 0x07, 0xea,
-	0x44, 0x97, 0xf6, 0x6a, 0xdc, 0x27, 0x13, 0x42, 0x36, 0x54, 0xa6, 0x82, 0xe9, 0x5d, 0x97, 0x75,
-	0x36, 0x75, 0x3b, 0xbf, 0x18, 0xd0, 0xec, 0x7a, 0x9e, 0x60, 0x52, 0xa6, 0x52, 0x3f, 0x80, 0x3a,
-	0x97, 0xe4, 0x9c, 0x09, 0xfe, 0x03, 0x67, 0x9e, 0x56, 0x5c, 0xc5, 0xc0, 0xe5, 0x24, 0x89, 0xa0,
-	0xf7, 0x01, 0xb8, 0x24, 0x82, 0x9d, 0x07, 0xa7, 0xcc, 0xd3, 0xb2, 0xab, 0xb8, 0xc6, 0x25, 0x8e,
-	0x03, 0xe8, 0x3b, 0x68, 0xc4, 0xc5, 0x53, 0xaa, 0x36, 0x21, 0xed, 0xe2, 0x56, 0x71, 0xbb, 0x7e,
-	0xff, 0x39, 0x4e, 0x32, 0x8b, 0xf1, 0xbb, 0xa5, 0x4a, 0x9e, 0x99, 0xcd, 0xa3, 0xaf, 0xa0, 0xa4,
-	0xd9, 0x18, 0x9a, 0xcd, 0xf6, 0x63, 0x7a, 0x6a, 0x3e, 0xba, 0x0a, 0x7d, 0x0c, 0xad, 0x6c, 0x7f,
-	0xc2, 0x63, 0xf9, 0x26, 0x6e, 0x66, 0xc3, 0x8e, 0x87, 0x5e, 0x40, 0x93, 0x6b, 0x7e, 0x84, 0xc6,
-	0x87, 0x93, 0x30, 0x68, 0xc4, 0xd1, 0xe4, 0xc4, 0x3a, 0xbf, 0x15, 0x61, 0x2d, 0x3b, 0x2a, 0x3d,
-	0xc2, 0x7f, 0xa7, 0xf2, 0xf6, 0xf0, 0xc2, 0x1d, 0xc3, 0xd1, 0x87, 0x60, 0x06, 0x82, 0xcf, 0xf8,
-	0x82, 0x4c, 0x4f, 0x28, 0x5f, 0x24, 0x0a, 0xeb, 0x71, 0xac, 0xa7, 0x42, 0xe8, 0x13, 0x40, 0xaa,
-	0x46, 0x0d, 0x23, 0x11, 0x9f, 0x33, 0x19, 0xd1, 0x79, 0xa8, 0xef, 0x4e, 0x03, 0x3f, 0x4b, 0x33,
-	0x6e, 0x9a, 0x40, 0x9f, 0xc2, 0x3a, 0xbb, 0x08, 0xb9, 0x88, 0x0f, 0xe7, 0xa6, 0x60, 0x55, 0x17,
-	0xac, 0xdd, 0xe4, 0x6e, 0x4a, 0x9e, 0x43, 0x23, 0x1e, 0x48, 0x7d, 0xe2, 0xd1, 0x88, 0xea, 0xfb,
-	0x65, 0x62, 0x33, 0x0d, 0xf6, 0x69, 0x44, 0xd1, 0x06, 0x94, 0xe5, 0xf4, 0x84, 0xcd, 0xa9, 0x5d,
-	0xd1, 0x1a, 0x13, 0x0f, 0x7d, 0x01, 0x1b, 0xc9, 0x46, 0xf3, 0x54, 0xaa, 0x7a, 0xdd, 0x7a, 0x9c,
-	0x9d, 0xbc, 0xcb, 0xc6, 0x86, 0xca, 0x39, 0x13, 0x52, 0x7d, 0x32, 0x35, 0x2d, 0x2c, 0x75, 0x73,
-	0x17, 0x13, 0x72, 0x17, 0xb3, 0xf3, 0x77, 0x11, 0xde, 0x1b, 0x32, 0x31, 0x63, 0xde, 0x5d, 0xcc,
-	0x5c, 0xb0, 0xce, 0x73, 0x3c, 0x9e, 0xcc, 0xef, 0x56, 0x87, 0xff, 0xfa, 0xc6, 0xdd, 0x82, 0x5e,
-	0x7a, 0x2c, 0xf4, 0xd5, 0xa7, 0x42, 0x2f, 0x3f, 0x01, 0x7a, 0xe5, 0x41, 0xe8, 0xd5, 0x47, 0x42,
-	0xaf, 0x3d, 0x0e, 0x3a, 0x3c, 0x04, 0xbd, 0x9e, 0x87, 0xfe, 0x53, 0x01, 0xcc, 0xd7, 0x07, 0x3d,
-	0xc1, 0x3c, 0xf5, 0xc3, 0xa1, 0xfe, 0xff, 0xf2, 0x6d, 0x9a, 0x79, 0x4c, 0x3b, 0xf0, 0xec, 0x24,
-	0xf0, 0x3d, 0x26, 0x48, 0x78, 0xf6, 0xc6, 0xe7, 0x53, 0x72, 0xca, 0x96, 0x1a, 0xa8, 0x89, 0x5b,
-	0x71, 0xe2, 0xa5, 0x8e, 0x1f, 0xb0, 0xe5, 0xbd, 0x00, 0x4a, 0xf7, 0x03, 0x78, 0x1a, 0xe2, 0x9d,
-	0xdf, 0x0d, 0xb0, 0xf2, 0xfb, 0x41, 0x08, 0x9a, 0x13, 0x97, 0x1c, 0x1f, 0x8d, 0x5f, 0x0e, 0x7a,
-	0xce, 0xd7, 0xce, 0xa0, 0x6f, 0xad, 0x20, 0x80, 0xf2, 0xc4, 0x25, 0x07, 0xaf, 0x7a, 0x96, 0x71,
-	0x6d, 0xef, 0x5b, 0x85, 0x6b, 0xfb, 0x7b, 0xab, 0x88, 0x5a, 0x50, 0x9f, 0xb8, 0xe4, 0xdb, 0xe3,
-	0x61, 0xf7, 0xc8, 0x71, 0x5f, 0x59, 0xa5, 0x24, 0xd9, 0x1d, 0x1e, 0x5a, 0xab, 0xa8, 0x09, 0xa0,
-	0xec, 0x7e, 0x1f, 0x0f, 0xc6, 0x63, 0xab, 0x8c, 0x1a, 0x50, 0x9b, 0xb8, 0xa4, 0x77, 0x3c, 0x76,
-	0x47, 0x43, 0xab, 0x82, 0xd6, 0xa0, 0xa5, 0x5c, 0x3c, 0xe8, 0x3b, 0x2e, 0x19, 0xf7, 0x46, 0x78,
-	0x60, 0x55, 0x91, 0x05, 0xe6, 0xc4, 0x25, 0xfb, 0xce, 0x68, 0x38, 0x70, 0xb1, 0xd3, 0xb3, 0x6a,
-	0x3b, 0xfb, 0x60, 0x66, 0x7f, 0xae, 0x4a, 0xea, 0x28, 0x2f, 0xb5, 0x09, 0x30, 0x72, 0x89, 0x73,
-	0xe4, 0xb8, 0x4e, 0xf7, 0xd0, 0x32, 0x12, 0x1f, 0x0f, 0xbe, 0x39, 0x3e, 0xec, 0x62, 0xab, 0xb0,
-	0xff, 0xe5, 0x1f, 0x97, 0x6d, 0xe3, 0xed, 0x65, 0xdb, 0xf8, 0xeb, 0xb2, 0x6d, 0xfc, 0x7c, 0xd5,
-	0x5e, 0x79, 0x7b, 0xd5, 0x5e, 0xf9, 0xf3, 0xaa, 0xbd, 0xf2, 0xba, 0x9d, 0x7d, 0x3b, 0x5c, 0x64,
-	0x5f, 0x0f, 0x8a, 0xb0, 0x7c, 0x53, 0xd6, 0xaf, 0x87, 0xcf, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff,
-	0xe2, 0x25, 0x58, 0x77, 0xb9, 0x08, 0x00, 0x00,
+func (m *CredentialPackage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *OperatorDetails) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CredentialPackage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredentialPackage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *OperatorDetails) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CredentialPackage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialPackage.Merge(m, src)
+}
+func (m *CredentialPackage) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialPackage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialPackage.DiscardUnknown(m)
 }
 
-func (m *OperatorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
+var xxx_messageInfo_CredentialPackage proto.InternalMessageInfo
+
+func (m *CredentialPackage) GetVerificationId() []byte {
+	if m != nil {
+		return m.VerificationId
+	}
+	return nil
+}
+
+func (m *CredentialPackage) GetCredential() *ZKCredential {
+	if m != nil {
+		return m.Credential
+	}
+	return nil
+}
+
+func (m *CredentialPackage) GetCredentialHash() []byte {
+	if m != nil {
+		return m.CredentialHash
+	}
+	return nil
+}
+
+func (m *CredentialPackage) GetIssuanceRoot() []byte {
+	if m != nil {
+		return m.IssuanceRoot
+	}
+	return nil
+}
+
+func (m *CredentialPackage) GetRevocationRoot() []byte {
+	if m != nil {
+		return m.RevocationRoot
+	}
+	return nil
+}
+
+func (m *CredentialPackage) GetIssuanceProof() []byte {
+	if m != nil {
+		return m.IssuanceProof
+	}
+	return nil
+}
+
+func (m *CredentialPackage) GetNonRevocationProof() []byte {
+	if m != nil {
+		return m.NonRevocationProof
+	}
+	return nil
+}
+
+func (m *CredentialPackage) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// VerificationRequest is a request to verify holder, which was emitted by compliance registry contract
+// and is kept until requested verification is issued
+type VerificationRequest struct {
+	// Address of holder to be verified
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// Address of issuer, which is requested to verify holder
+	IssuerAddress string `protobuf:"bytes,2,opt,name=issuer_address,json=issuerAddress,proto3" json:"issuer_address,omitempty"`
+	// Type of requested verification
+	Type VerificationType `protobuf:"varint,3,opt,name=type,proto3,enum=swisstronik.compliance.VerificationType" json:"type,omitempty"`
+	// Hash of ethereum transaction, which emitted the request
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Height of block, at which request was emitted
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *VerificationRequest) Reset()         { *m = VerificationRequest{} }
+func (m *VerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*VerificationRequest) ProtoMessage()    {}
+func (*VerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b6c3ec8e3c39ee, []int{13}
+}
+func (m *VerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationRequest.Merge(m, src)
+}
+func (m *VerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationRequest proto.InternalMessageInfo
+
+func (m *VerificationRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *VerificationRequest) GetIssuerAddress() string {
+	if m != nil {
+		return m.IssuerAddress
+	}
+	return ""
+}
+
+func (m *VerificationRequest) GetType() VerificationType {
+	if m != nil {
+		return m.Type
+	}
+	return VerificationType_VT_UNSPECIFIED
+}
+
+func (m *VerificationRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *VerificationRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("swisstronik.compliance.VerificationType", VerificationType_name, VerificationType_value)
+	proto.RegisterEnum("swisstronik.compliance.RevocationReason", RevocationReason_name, RevocationReason_value)
+	proto.RegisterEnum("swisstronik.compliance.OperatorType", OperatorType_name, OperatorType_value)
+	proto.RegisterType((*OperatorDetails)(nil), "swisstronik.compliance.OperatorDetails")
+	proto.RegisterType((*IssuerDetails)(nil), "swisstronik.compliance.IssuerDetails")
+	proto.RegisterType((*IssuerMigration)(nil), "swisstronik.compliance.IssuerMigration")
+	proto.RegisterType((*IssuanceQuota)(nil), "swisstronik.compliance.IssuanceQuota")
+	proto.RegisterType((*IssuanceCounter)(nil), "swisstronik.compliance.IssuanceCounter")
+	proto.RegisterType((*AddressDetails)(nil), "swisstronik.compliance.AddressDetails")
+	proto.RegisterType((*Verification)(nil), "swisstronik.compliance.Verification")
+	proto.RegisterType((*VerificationDetails)(nil), "swisstronik.compliance.VerificationDetails")
+	proto.RegisterType((*RevocationDetails)(nil), "swisstronik.compliance.RevocationDetails")
+	proto.RegisterType((*MergedVerificationDetails)(nil), "swisstronik.compliance.MergedVerificationDetails")
+	proto.RegisterType((*TreeRoots)(nil), "swisstronik.compliance.TreeRoots")
+	proto.RegisterType((*ZKCredential)(nil), "swisstronik.compliance.ZKCredential")
+	proto.RegisterType((*CredentialPackage)(nil), "swisstronik.compliance.CredentialPackage")
+	proto.RegisterType((*VerificationRequest)(nil), "swisstronik.compliance.VerificationRequest")
+}
+
+func init() {
+	proto.RegisterFile("swisstronik/compliance/entities.proto", fileDescriptor_a6b6c3ec8e3c39ee)
+}
+
+var fileDescriptor_a6b6c3ec8e3c39ee = []byte{
+	// 1385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0xfa, 0xdd, 0x8f, 0xd7, 0xce, 0x66, 0x9a, 0x7f, 0xff, 0x26, 0x82, 0x34, 0x38, 0x54,
+	0x44, 0x91, 0x48, 0xa1, 0x70, 0xe0, 0x80, 0x04, 0x89, 0xbd, 0x25, 0x4b, 0xe2, 0x3a, 0x1d, 0xaf,
+	0x8d, 0xd2, 0xcb, 0x68, 0x6b, 0x4f, 0xed, 0x55, 0xd6, 0x3b, 0xee, 0xee, 0xe6, 0x4d, 0xe2, 0xc2,
+	0x37, 0xe0, 0xc0, 0x91, 0x4f, 0x00, 0xe2, 0xcc, 0x57, 0xe0, 0x84, 0x7a, 0xe4, 0x82, 0x84, 0xda,
+	0x6f, 0x80, 0xc4, 0x1d, 0xcd, 0xcb, 0xda, 0x1b, 0x3b, 0x29, 0x09, 0x85, 0xdb, 0x3c, 0xbf, 0xe7,
+	0x75, 0x9e, 0xe7, 0x37, 0x33, 0xbb, 0x70, 0x37, 0x3c, 0x75, 0xc3, 0x30, 0x0a, 0x98, 0xef, 0x1e,
+	0xdd, 0xeb, 0xb1, 0xd1, 0xd8, 0x73, 0x1d, 0xbf, 0x47, 0xef, 0x51, 0x3f, 0x72, 0x23, 0x97, 0x86,
+	0x5b, 0xe3, 0x80, 0x45, 0x0c, 0xdd, 0x4e, 0x98, 0x6d, 0x4d, 0xcd, 0x56, 0x96, 0x07, 0x6c, 0xc0,
+	0x84, 0xc9, 0x3d, 0xbe, 0x92, 0xd6, 0x2b, 0xeb, 0x57, 0x04, 0x1d, 0x3b, 0x81, 0x33, 0x52, 0x21,
+	0x6b, 0x67, 0xb0, 0xd8, 0x1a, 0xd3, 0xc0, 0x89, 0x58, 0xd0, 0xa0, 0x91, 0xe3, 0x7a, 0x21, 0x5a,
+	0x81, 0x02, 0x53, 0x50, 0x55, 0x5b, 0xd3, 0x36, 0x8a, 0x78, 0x22, 0x23, 0x0b, 0xca, 0xf1, 0x9a,
+	0x44, 0xe7, 0x63, 0x5a, 0x4d, 0xad, 0x69, 0x1b, 0x95, 0xfb, 0xef, 0x6c, 0x5d, 0x5e, 0xd9, 0x56,
+	0x1c, 0xdb, 0x3e, 0x1f, 0x53, 0xac, 0xb3, 0x84, 0x54, 0xfb, 0x2d, 0x05, 0x65, 0x2b, 0x0c, 0x8f,
+	0xe9, 0x24, 0x31, 0x82, 0x8c, 0xef, 0x8c, 0xa8, 0x4a, 0x2a, 0xd6, 0x68, 0x0d, 0x4a, 0x7d, 0x1a,
+	0xf6, 0x02, 0x77, 0x1c, 0xb9, 0xcc, 0x17, 0xe9, 0x8a, 0x38, 0x09, 0x21, 0x03, 0xd2, 0xc7, 0x81,
+	0x57, 0x4d, 0x0b, 0x0d, 0x5f, 0xf2, 0x38, 0x1e, 0x1b, 0xb0, 0x6a, 0x46, 0xc6, 0xe1, 0x6b, 0x1e,
+	0xc7, 0xa3, 0x03, 0xc7, 0x33, 0x79, 0x47, 0xcf, 0xab, 0x59, 0x19, 0x27, 0x01, 0xa1, 0x2a, 0xe4,
+	0x7b, 0x01, 0x15, 0xbb, 0xce, 0x09, 0x6d, 0x2c, 0xa2, 0xa7, 0xb0, 0xe2, 0x78, 0x1e, 0x3b, 0xa5,
+	0x7d, 0x72, 0x42, 0x03, 0xf7, 0xa9, 0xdb, 0x73, 0x78, 0x66, 0xd1, 0x80, 0xb0, 0x9a, 0x5f, 0x4b,
+	0x6f, 0x54, 0xee, 0x6f, 0x5c, 0xd5, 0x81, 0x6e, 0xc2, 0x43, 0x74, 0xa1, 0xaa, 0x62, 0xcd, 0x2a,
+	0x42, 0xb4, 0x0f, 0x15, 0x37, 0x0c, 0x8f, 0xb9, 0x1b, 0x79, 0x76, 0xcc, 0x22, 0xa7, 0x5a, 0x58,
+	0xd3, 0x36, 0x4a, 0xf7, 0xef, 0x5e, 0x15, 0xdb, 0x52, 0xd6, 0x8f, 0xb8, 0x31, 0x2e, 0xbb, 0x49,
+	0xb1, 0xf6, 0xad, 0x06, 0x8b, 0xb2, 0xbf, 0x4d, 0x77, 0x10, 0x88, 0x34, 0xe8, 0x0e, 0x94, 0x98,
+	0xd7, 0x27, 0x4e, 0xbf, 0x1f, 0xd0, 0x30, 0x54, 0x8d, 0x06, 0xe6, 0xf5, 0xb7, 0x25, 0xc2, 0x0d,
+	0x7c, 0x7a, 0x3a, 0x31, 0x90, 0xed, 0x06, 0x9f, 0x9e, 0xc6, 0x06, 0x6f, 0x83, 0xfe, 0xc4, 0x63,
+	0xbd, 0x23, 0x32, 0xa4, 0xee, 0x60, 0x18, 0x89, 0xb6, 0xa7, 0x71, 0x49, 0x60, 0xbb, 0x02, 0x42,
+	0x6f, 0x42, 0x31, 0x72, 0x47, 0x34, 0x8c, 0x9c, 0xd1, 0x58, 0xcc, 0x20, 0x8d, 0xa7, 0x40, 0xed,
+	0x50, 0x4e, 0x7d, 0x52, 0x27, 0x5a, 0x87, 0xf2, 0xc8, 0x39, 0x23, 0x71, 0xf1, 0xb2, 0xaa, 0x0c,
+	0xd6, 0x47, 0xce, 0x59, 0x6c, 0x18, 0x72, 0xa3, 0x53, 0xd7, 0xef, 0xb3, 0x53, 0x22, 0x32, 0xc9,
+	0xca, 0x32, 0x58, 0x97, 0xe0, 0x8e, 0xc0, 0x6a, 0x9f, 0xc2, 0x62, 0xec, 0x51, 0x67, 0xc7, 0x7e,
+	0x44, 0x03, 0x74, 0x1b, 0x72, 0xd2, 0x44, 0x45, 0x55, 0x12, 0x5a, 0x86, 0x6c, 0x8f, 0x9b, 0xa8,
+	0x38, 0x52, 0xa8, 0x7d, 0xa7, 0x41, 0x45, 0x6d, 0x34, 0xe6, 0xe4, 0x1d, 0x28, 0xb9, 0xa1, 0x1a,
+	0x3b, 0xed, 0x8b, 0x28, 0x05, 0x0c, 0x6e, 0xd8, 0x55, 0x08, 0x7a, 0x0b, 0xc0, 0x0d, 0x49, 0x40,
+	0x4f, 0xd8, 0x11, 0xed, 0x8b, 0x70, 0x05, 0x5c, 0x74, 0x43, 0x2c, 0x01, 0xf4, 0x05, 0x94, 0x93,
+	0x9c, 0x09, 0xab, 0xe9, 0xb5, 0xf4, 0x46, 0xe9, 0xea, 0x03, 0x93, 0x64, 0x05, 0xbe, 0xe8, 0xca,
+	0xcb, 0xd3, 0x93, 0x7a, 0xf4, 0x09, 0x64, 0xc4, 0x21, 0xd4, 0xd6, 0xb4, 0x1b, 0x51, 0x50, 0x78,
+	0xa1, 0x77, 0x61, 0xf1, 0x02, 0x9d, 0x5d, 0x59, 0xbe, 0x8e, 0x2b, 0x49, 0xd8, 0xea, 0xa3, 0xbb,
+	0x92, 0x97, 0x34, 0x98, 0xf0, 0x42, 0x1e, 0xb6, 0xb2, 0x44, 0x55, 0xc7, 0x6a, 0x3f, 0xa4, 0xe1,
+	0x56, 0x32, 0x55, 0xdc, 0xc2, 0xd7, 0xab, 0x72, 0x3e, 0x79, 0xea, 0x92, 0xe4, 0x9c, 0x97, 0x2c,
+	0x70, 0x07, 0xae, 0x4f, 0x7a, 0x43, 0xc7, 0xf5, 0x55, 0x85, 0x25, 0x89, 0xd5, 0x39, 0x84, 0xde,
+	0x03, 0x34, 0x39, 0x5e, 0x17, 0x09, 0x5a, 0xc6, 0x4b, 0xb1, 0xc6, 0x8e, 0x15, 0xe8, 0x03, 0x58,
+	0xa6, 0x67, 0x63, 0x37, 0x50, 0x67, 0x7d, 0xe2, 0x90, 0x15, 0x0e, 0xb7, 0xa6, 0xba, 0xa9, 0xcb,
+	0x3a, 0x94, 0x65, 0x42, 0xc7, 0x23, 0x7d, 0x27, 0x72, 0xc4, 0x45, 0xa2, 0x63, 0x3d, 0x06, 0x1b,
+	0x4e, 0xe4, 0x70, 0x4a, 0x86, 0xbd, 0x21, 0x1d, 0x39, 0xd5, 0xbc, 0xa8, 0x51, 0x49, 0xe8, 0x23,
+	0xb8, 0xad, 0x36, 0x3a, 0x3b, 0x95, 0x82, 0xb0, 0x5b, 0x96, 0xda, 0xee, 0xc5, 0xd9, 0x54, 0x21,
+	0x7f, 0x42, 0x83, 0x90, 0xdf, 0x8d, 0x45, 0x51, 0x58, 0x2c, 0xce, 0x10, 0x13, 0x66, 0x88, 0x59,
+	0xfb, 0x5e, 0x83, 0x25, 0xbe, 0xbe, 0x38, 0xab, 0xcf, 0x20, 0x17, 0x50, 0x27, 0x64, 0xfe, 0xdf,
+	0x4d, 0x6b, 0xea, 0x8a, 0x85, 0x3d, 0x56, 0x7e, 0xe2, 0x12, 0x67, 0x11, 0x55, 0x53, 0x12, 0xeb,
+	0xd7, 0xbf, 0x34, 0xfe, 0x48, 0xc3, 0x1b, 0x4d, 0x1a, 0x0c, 0x2e, 0xde, 0x9a, 0x71, 0xd1, 0x36,
+	0x18, 0x27, 0x33, 0xe4, 0xb9, 0x31, 0xd9, 0xe6, 0x22, 0xfc, 0xdb, 0xc7, 0x63, 0x8e, 0xa1, 0x99,
+	0xeb, 0x32, 0x34, 0x7b, 0x53, 0x86, 0xe6, 0x6e, 0xc0, 0xd0, 0xfc, 0x2b, 0x19, 0x5a, 0xb8, 0x26,
+	0x43, 0x8b, 0xd7, 0x63, 0x28, 0xbc, 0x8a, 0xa1, 0xa5, 0x59, 0x86, 0x7e, 0x05, 0x45, 0x3b, 0xa0,
+	0x14, 0x33, 0x16, 0xcd, 0xbf, 0x3b, 0xda, 0x3c, 0x85, 0xd6, 0x61, 0xf2, 0x02, 0x92, 0x80, 0xb1,
+	0x48, 0x8d, 0x4b, 0x8f, 0x41, 0x1e, 0x88, 0x4f, 0x35, 0x98, 0x50, 0x57, 0x9a, 0xa5, 0xe5, 0x54,
+	0xa7, 0x30, 0x37, 0xac, 0x7d, 0x9d, 0x02, 0xfd, 0xf1, 0x5e, 0x3d, 0xa0, 0x7d, 0xfe, 0x11, 0xe6,
+	0x78, 0xff, 0xc9, 0x35, 0xa6, 0xcf, 0x92, 0x64, 0x13, 0x96, 0x86, 0xcc, 0xeb, 0xd3, 0x80, 0x8c,
+	0x8f, 0x9f, 0x78, 0x6e, 0x8f, 0x1c, 0xd1, 0x73, 0x55, 0xe0, 0xa2, 0x54, 0x1c, 0x08, 0x7c, 0x8f,
+	0x9e, 0x5f, 0x39, 0xfe, 0xcc, 0xd5, 0xe3, 0xbf, 0x19, 0xc1, 0x6a, 0x7f, 0xa6, 0x60, 0x69, 0xda,
+	0x81, 0x03, 0xa7, 0x77, 0xe4, 0x0c, 0x2e, 0x3d, 0x18, 0xda, 0xa5, 0x07, 0xa3, 0x01, 0xd0, 0x9b,
+	0x78, 0x8b, 0xfd, 0xbe, 0xe2, 0xe1, 0x4b, 0xf6, 0x1a, 0x27, 0xfc, 0x78, 0xba, 0xa9, 0x44, 0x86,
+	0x4e, 0x38, 0x8c, 0x27, 0x36, 0x85, 0x77, 0x9d, 0x70, 0x38, 0x3f, 0xff, 0xcc, 0xf5, 0xe6, 0x9f,
+	0xbd, 0x6c, 0xfe, 0xf1, 0xc0, 0x44, 0xb4, 0x71, 0xc0, 0xd8, 0x53, 0x75, 0x99, 0x4f, 0x72, 0x1c,
+	0x70, 0x10, 0xbd, 0x0f, 0xcb, 0x3e, 0x0f, 0x34, 0x8d, 0x29, 0x8d, 0xe5, 0xb9, 0x42, 0x3e, 0xbf,
+	0x1f, 0x63, 0x95, 0xf4, 0x98, 0x65, 0x72, 0x61, 0x8e, 0xc9, 0xb5, 0x5f, 0xb4, 0x8b, 0x2f, 0x29,
+	0xa6, 0xcf, 0x8e, 0x69, 0x18, 0xf1, 0x83, 0x29, 0x49, 0xa0, 0xbe, 0xdc, 0x94, 0x74, 0xdd, 0x37,
+	0x32, 0x66, 0x70, 0xfa, 0x1f, 0x31, 0xf8, 0xff, 0x90, 0x8f, 0xce, 0x64, 0xff, 0xe5, 0xd5, 0x95,
+	0x8b, 0xce, 0x44, 0xdf, 0x67, 0x37, 0x94, 0x9d, 0xdb, 0xd0, 0xe6, 0x8f, 0x1a, 0x18, 0xb3, 0x61,
+	0x11, 0x82, 0x4a, 0xd7, 0x26, 0x9d, 0x87, 0xed, 0x03, 0xb3, 0x6e, 0x3d, 0xb0, 0xcc, 0x86, 0xb1,
+	0x80, 0x00, 0x72, 0x5d, 0x9b, 0xec, 0x1d, 0xd6, 0x0d, 0x6d, 0xb2, 0xde, 0x31, 0x52, 0x93, 0xf5,
+	0x97, 0x46, 0x1a, 0x2d, 0x42, 0xa9, 0x6b, 0x93, 0xdd, 0x4e, 0x73, 0xfb, 0xa1, 0x65, 0x1f, 0x1a,
+	0x19, 0xa5, 0xdc, 0x6e, 0xee, 0x1b, 0x59, 0x54, 0x01, 0xe0, 0xeb, 0x46, 0x03, 0x9b, 0xed, 0xb6,
+	0x91, 0x43, 0x65, 0x28, 0x76, 0x6d, 0x52, 0xef, 0xb4, 0xed, 0x56, 0xd3, 0xc8, 0xa3, 0x5b, 0xb0,
+	0xc8, 0x45, 0x6c, 0x36, 0x2c, 0x9b, 0xb4, 0xeb, 0x2d, 0x6c, 0x1a, 0x05, 0x64, 0x80, 0xde, 0xb5,
+	0xc9, 0x8e, 0xd5, 0x6a, 0x9a, 0x36, 0xb6, 0xea, 0x46, 0x71, 0xf3, 0x27, 0x0d, 0x8c, 0xd9, 0x17,
+	0x8e, 0xd7, 0x8b, 0xf1, 0x4c, 0xbd, 0xff, 0x83, 0x25, 0x8c, 0xc9, 0x9e, 0x79, 0x48, 0xea, 0xad,
+	0xe6, 0x01, 0x6e, 0x35, 0xad, 0xb6, 0x69, 0x68, 0xa8, 0x0a, 0xcb, 0x18, 0x13, 0xab, 0xdd, 0xee,
+	0x98, 0x38, 0xa9, 0x49, 0x25, 0x35, 0x0d, 0xb2, 0x73, 0x48, 0x9a, 0x56, 0xdb, 0xde, 0xde, 0x33,
+	0x8d, 0x34, 0x5a, 0x82, 0x32, 0xc6, 0xa4, 0xdd, 0x39, 0x30, 0x71, 0xdb, 0x6c, 0x98, 0x0d, 0x23,
+	0xa3, 0xa2, 0xef, 0xb6, 0xf6, 0x1b, 0x26, 0x26, 0xd8, 0x7c, 0xd4, 0x31, 0xdb, 0xb6, 0x91, 0x45,
+	0x3a, 0x14, 0x30, 0x26, 0x0f, 0xf0, 0x76, 0xa7, 0x61, 0xe4, 0x94, 0xd4, 0xb2, 0x77, 0x4d, 0x6c,
+	0xe4, 0x37, 0x77, 0x40, 0x4f, 0xfe, 0x73, 0xf1, 0xa2, 0x5b, 0xb3, 0x4d, 0xae, 0x00, 0xb4, 0x6c,
+	0x62, 0x3d, 0xb4, 0x6c, 0x6b, 0x7b, 0xdf, 0xd0, 0x94, 0x8c, 0xcd, 0xcf, 0x3b, 0xfb, 0xdb, 0xd8,
+	0x48, 0xed, 0x7c, 0xfc, 0xf3, 0x8b, 0x55, 0xed, 0xf9, 0x8b, 0x55, 0xed, 0xf7, 0x17, 0xab, 0xda,
+	0x37, 0x2f, 0x57, 0x17, 0x9e, 0xbf, 0x5c, 0x5d, 0xf8, 0xf5, 0xe5, 0xea, 0xc2, 0xe3, 0xd5, 0xe4,
+	0x2f, 0xe5, 0x59, 0xf2, 0xa7, 0x52, 0xfc, 0x0b, 0x3d, 0xc9, 0x89, 0x9f, 0xca, 0x0f, 0xff, 0x1a,
+	0x00, 0x57, 0x48, 0x79, 0x66, 0xd0, 0x0e, 0x00, 0x00,
+}
+
+func (m *OperatorDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
 	if m.OperatorType != 0 {
 		i = encodeVarintEntities(dAtA, i, uint64(m.OperatorType))
@@ -838,6 +1459,36 @@ func (m *IssuerDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IssuanceQuota != nil {
+		{
+			size, err := m.IssuanceQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEntities(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AllowedVerificationTypes) > 0 {
+		dAtA3 := make([]byte, len(m.AllowedVerificationTypes)*10)
+		var j2 int
+		for _, num := range m.AllowedVerificationTypes {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintEntities(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -883,6 +1534,119 @@ func (m *IssuerDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IssuerMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssuerMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssuerMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldAddress) > 0 {
+		i -= len(m.OldAddress)
+		copy(dAtA[i:], m.OldAddress)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.OldAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IssuanceQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssuanceQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssuanceQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxIssuances != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.MaxIssuances))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IssuanceCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssuanceCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssuanceCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AddressDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1070,6 +1834,51 @@ func (m *VerificationDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RevocationDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevocationDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevocationDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MergedVerificationDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1165,7 +1974,7 @@ func (m *MergedVerificationDetails) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *ZKCredential) Marshal() (dAtA []byte, err error) {
+func (m *TreeRoots) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1175,12 +1984,54 @@ func (m *ZKCredential) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ZKCredential) MarshalTo(dAtA []byte) (int, error) {
+func (m *TreeRoots) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ZKCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TreeRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevocationRoot) > 0 {
+		i -= len(m.RevocationRoot)
+		copy(dAtA[i:], m.RevocationRoot)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.RevocationRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IssuanceRoot) > 0 {
+		i -= len(m.IssuanceRoot)
+		copy(dAtA[i:], m.IssuanceRoot)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.IssuanceRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZKCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZKCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZKCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1217,6 +2068,142 @@ func (m *ZKCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CredentialPackage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialPackage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialPackage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.NonRevocationProof) > 0 {
+		i -= len(m.NonRevocationProof)
+		copy(dAtA[i:], m.NonRevocationProof)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.NonRevocationProof)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IssuanceProof) > 0 {
+		i -= len(m.IssuanceProof)
+		copy(dAtA[i:], m.IssuanceProof)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.IssuanceProof)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RevocationRoot) > 0 {
+		i -= len(m.RevocationRoot)
+		copy(dAtA[i:], m.RevocationRoot)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.RevocationRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IssuanceRoot) > 0 {
+		i -= len(m.IssuanceRoot)
+		copy(dAtA[i:], m.IssuanceRoot)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.IssuanceRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CredentialHash) > 0 {
+		i -= len(m.CredentialHash)
+		copy(dAtA[i:], m.CredentialHash)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.CredentialHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Credential != nil {
+		{
+			size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEntities(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintEntities(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.IssuerAddress) > 0 {
+		i -= len(m.IssuerAddress)
+		copy(dAtA[i:], m.IssuerAddress)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.IssuerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEntities(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEntities(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEntities(v)
 	base := offset
@@ -1274,6 +2261,70 @@ func (m *IssuerDetails) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEntities(uint64(l))
 	}
+	if len(m.AllowedVerificationTypes) > 0 {
+		l = 0
+		for _, e := range m.AllowedVerificationTypes {
+			l += sovEntities(uint64(e))
+		}
+		n += 1 + sovEntities(uint64(l)) + l
+	}
+	if m.IssuanceQuota != nil {
+		l = m.IssuanceQuota.Size()
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	return n
+}
+
+func (m *IssuerMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldAddress)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEntities(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovEntities(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *IssuanceQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxIssuances != 0 {
+		n += 1 + sovEntities(uint64(m.MaxIssuances))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovEntities(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func (m *IssuanceCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovEntities(uint64(m.Window))
+	}
+	if m.Count != 0 {
+		n += 1 + sovEntities(uint64(m.Count))
+	}
 	return n
 }
 
@@ -1362,6 +2413,28 @@ func (m *VerificationDetails) Size() (n int) {
 	return n
 }
 
+func (m *RevocationDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovEntities(uint64(m.Reason))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEntities(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovEntities(uint64(m.Timestamp))
+	}
+	return n
+}
+
 func (m *MergedVerificationDetails) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1410,6 +2483,26 @@ func (m *MergedVerificationDetails) Size() (n int) {
 	return n
 }
 
+func (m *TreeRoots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEntities(uint64(m.BlockHeight))
+	}
+	l = len(m.IssuanceRoot)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	l = len(m.RevocationRoot)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	return n
+}
+
 func (m *ZKCredential) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1436,6 +2529,73 @@ func (m *ZKCredential) Size() (n int) {
 	return n
 }
 
+func (m *CredentialPackage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	if m.Credential != nil {
+		l = m.Credential.Size()
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	l = len(m.CredentialHash)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	l = len(m.IssuanceRoot)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	l = len(m.RevocationRoot)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	l = len(m.IssuanceProof)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	l = len(m.NonRevocationProof)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEntities(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *VerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	l = len(m.IssuerAddress)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEntities(uint64(m.Type))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEntities(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEntities(uint64(m.BlockHeight))
+	}
+	return n
+}
+
 func sovEntities(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1764,19 +2924,1158 @@ func (m *IssuerDetails) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEntities(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEntities
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
+		case 7:
+			if wireType == 0 {
+				var v VerificationType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEntities
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= VerificationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedVerificationTypes = append(m.AllowedVerificationTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEntities
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEntities
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEntities
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AllowedVerificationTypes) == 0 {
+					m.AllowedVerificationTypes = make([]VerificationType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v VerificationType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEntities
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VerificationType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedVerificationTypes = append(m.AllowedVerificationTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedVerificationTypes", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IssuanceQuota == nil {
+				m.IssuanceQuota = &IssuanceQuota{}
+			}
+			if err := m.IssuanceQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntities(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssuerMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntities
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssuerMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssuerMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntities(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssuanceQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntities
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssuanceQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssuanceQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIssuances", wireType)
+			}
+			m.MaxIssuances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIssuances |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntities(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssuanceCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntities
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssuanceCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssuanceCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntities(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntities
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVerified = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRevoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRevoked = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifications = append(m.Verifications, &Verification{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntities(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Verification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntities
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Verification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Verification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VerificationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = append(m.VerificationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerificationId == nil {
+				m.VerificationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntities(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerificationDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntities
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerificationDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerificationDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VerificationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceTimestamp", wireType)
+			}
+			m.IssuanceTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceTimestamp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalData = append(m.OriginalData[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalData == nil {
+				m.OriginalData = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerVerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerVerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRevoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRevoked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntities(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevocationDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntities
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevocationDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevocationDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= RevocationReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntities(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -1785,7 +4084,7 @@ func (m *IssuerDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressDetails) Unmarshal(dAtA []byte) error {
+func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1795,30 +4094,251 @@ func (m *AddressDetails) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowEntities
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergedVerificationDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergedVerificationDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationType", wireType)
+			}
+			m.VerificationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationType |= VerificationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = append(m.VerificationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerificationId == nil {
+				m.VerificationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceTimestamp", wireType)
+			}
+			m.IssuanceTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceTimestamp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalData = append(m.OriginalData[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalData == nil {
+				m.OriginalData = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntities
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddressDetails: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressDetails: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVerified", wireType)
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerVerificationId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -1828,17 +4348,29 @@ func (m *AddressDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsVerified = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerVerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRevoked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -1848,17 +4380,16 @@ func (m *AddressDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Version |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsRevoked = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRevoked", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -1868,26 +4399,12 @@ func (m *AddressDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEntities
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEntities
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Verifications = append(m.Verifications, &Verification{})
-			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsRevoked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEntities(dAtA[iNdEx:])
@@ -1909,7 +4426,7 @@ func (m *AddressDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Verification) Unmarshal(dAtA []byte) error {
+func (m *TreeRoots) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1932,17 +4449,17 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Verification: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreeRoots: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Verification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreeRoots: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Type = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -1952,14 +4469,14 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= VerificationType(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1986,16 +4503,16 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationId = append(m.VerificationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.VerificationId == nil {
-				m.VerificationId = []byte{}
+			m.IssuanceRoot = append(m.IssuanceRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.IssuanceRoot == nil {
+				m.IssuanceRoot = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2005,23 +4522,25 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEntities
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEntities
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
+			m.RevocationRoot = append(m.RevocationRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.RevocationRoot == nil {
+				m.RevocationRoot = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2044,7 +4563,7 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerificationDetails) Unmarshal(dAtA []byte) error {
+func (m *ZKCredential) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2067,10 +4586,10 @@ func (m *VerificationDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerificationDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: ZKCredential: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerificationDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ZKCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2096,108 +4615,6 @@ func (m *VerificationDetails) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEntities
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEntities
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEntities
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEntities
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEntities
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEntities
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginChain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceTimestamp", wireType)
-			}
-			m.IssuanceTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEntities
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IssuanceTimestamp |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
-			}
-			m.ExpirationTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEntities
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpirationTimestamp |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalData", wireType)
-			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -2223,48 +4640,16 @@ func (m *VerificationDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalData = append(m.OriginalData[:0], dAtA[iNdEx:postIndex]...)
-			if m.OriginalData == nil {
-				m.OriginalData = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEntities
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEntities
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEntities
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.IssuerAddress = append(m.IssuerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.IssuerAddress == nil {
+				m.IssuerAddress = []byte{}
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerVerificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderPublicKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2274,29 +4659,31 @@ func (m *VerificationDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEntities
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEntities
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssuerVerificationId = string(dAtA[iNdEx:postIndex])
+			m.HolderPublicKey = append(m.HolderPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.HolderPublicKey == nil {
+				m.HolderPublicKey = []byte{}
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
 			}
-			m.Version = 0
+			m.ExpirationTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2306,16 +4693,16 @@ func (m *VerificationDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				m.ExpirationTimestamp |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRevoked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceTimestamp", wireType)
 			}
-			var v int
+			m.IssuanceTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2325,12 +4712,11 @@ func (m *VerificationDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.IssuanceTimestamp |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsRevoked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEntities(dAtA[iNdEx:])
@@ -2352,7 +4738,7 @@ func (m *VerificationDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
+func (m *CredentialPackage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2375,32 +4761,13 @@ func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergedVerificationDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: CredentialPackage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergedVerificationDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CredentialPackage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationType", wireType)
-			}
-			m.VerificationType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEntities
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VerificationType |= VerificationType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
 			}
@@ -2434,11 +4801,11 @@ func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
 				m.VerificationId = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2448,29 +4815,33 @@ func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEntities
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEntities
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
+			if m.Credential == nil {
+				m.Credential = &ZKCredential{}
+			}
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2480,29 +4851,31 @@ func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEntities
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEntities
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			m.CredentialHash = append(m.CredentialHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialHash == nil {
+				m.CredentialHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceTimestamp", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceRoot", wireType)
 			}
-			m.IssuanceTimestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2512,33 +4885,29 @@ func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuanceTimestamp |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthEntities
 			}
-			m.ExpirationTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEntities
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpirationTimestamp |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
 			}
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuanceRoot = append(m.IssuanceRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.IssuanceRoot == nil {
+				m.IssuanceRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2565,16 +4934,16 @@ func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalData = append(m.OriginalData[:0], dAtA[iNdEx:postIndex]...)
-			if m.OriginalData == nil {
-				m.OriginalData = []byte{}
+			m.RevocationRoot = append(m.RevocationRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.RevocationRoot == nil {
+				m.RevocationRoot = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2584,29 +4953,31 @@ func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEntities
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEntities
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.IssuanceProof = append(m.IssuanceProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.IssuanceProof == nil {
+				m.IssuanceProof = []byte{}
+			}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerVerificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NonRevocationProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2616,48 +4987,31 @@ func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEntities
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEntities
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssuerVerificationId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEntities
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.NonRevocationProof = append(m.NonRevocationProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.NonRevocationProof == nil {
+				m.NonRevocationProof = []byte{}
 			}
-		case 11:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRevoked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var v int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2667,12 +5021,11 @@ func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsRevoked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEntities(dAtA[iNdEx:])
@@ -2694,7 +5047,7 @@ func (m *MergedVerificationDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ZKCredential) Unmarshal(dAtA []byte) error {
+func (m *VerificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2717,17 +5070,17 @@ func (m *ZKCredential) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ZKCredential: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ZKCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2737,16 +5090,29 @@ func (m *ZKCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= VerificationType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2756,31 +5122,29 @@ func (m *ZKCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEntities
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEntities
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssuerAddress = append(m.IssuerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.IssuerAddress == nil {
-				m.IssuerAddress = []byte{}
-			}
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HolderPublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2790,31 +5154,16 @@ func (m *ZKCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Type |= VerificationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEntities
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEntities
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HolderPublicKey = append(m.HolderPublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.HolderPublicKey == nil {
-				m.HolderPublicKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			m.ExpirationTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2824,16 +5173,29 @@ func (m *ZKCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpirationTimestamp |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntities
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntities
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.IssuanceTimestamp = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntities
@@ -2843,7 +5205,7 @@ func (m *ZKCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuanceTimestamp |= uint32(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15,6 +15,10 @@ const (
 	codeErrNotOperator
 	codeErrNotOperatorOrIssuer
 	codeErrInvalidIssuer
+	codeErrVerificationTypeNotAllowed
+	codeErrIssuanceQuotaExceeded
+	codeErrRootNotFound
+	codeErrInvalidRegistryLog
 )
 
 var (
@@ -28,4 +32,8 @@ var (
 	ErrNotOperatorOrIssuerCreator = sdkerrors.Register(ModuleName, codeErrNotOperatorOrIssuer, "signer is not operator or issuer creator")
 	ErrNotOperator                = sdkerrors.Register(ModuleName, codeErrNotOperator, "signer is not operator")
 	ErrInvalidIssuer              = sdkerrors.Register(ModuleName, codeErrInvalidIssuer, "invalid issuer")
+	ErrVerificationTypeNotAllowed = sdkerrors.Register(ModuleName, codeErrVerificationTypeNotAllowed, "verification type is not allowed for issuer")
+	ErrIssuanceQuotaExceeded      = sdkerrors.Register(ModuleName, codeErrIssuanceQuotaExceeded, "issuance quota exceeded")
+	ErrRootNotFound               = sdkerrors.Register(ModuleName, codeErrRootNotFound, "tree root not found for provided block")
+	ErrInvalidRegistryLog         = sdkerrors.Register(ModuleName, codeErrInvalidRegistryLog, "invalid log of compliance registry contract")
 )
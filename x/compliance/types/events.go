@@ -8,9 +8,21 @@ const (
 	EventTypeRemoveIssuer   = "remove_issuer"
 	EventTypeVerifyIssuer   = "verify_issuer"
 
+	EventTypeSetIssuerVerificationTypes = "set_issuer_verification_types"
+	EventTypeSetIssuerQuota             = "set_issuer_quota"
+	EventTypeMigrateIssuer              = "migrate_issuer"
+	EventTypeRevokeVerifications        = "revoke_verifications"
+
 	AttributeKeyOperator           = "operator"
 	AttributeKeyIssuerCreator      = "creator"
 	AttributeKeyIssuer             = "issuer"
 	AttributeKeyIssuerDetails      = "issuer_details"
 	AttributeKeyVerificationStatus = "verification_status"
+	AttributeKeyAuthority          = "authority"
+	AttributeKeyVerificationTypes  = "verification_types"
+	AttributeKeyIssuanceQuota      = "issuance_quota"
+	AttributeKeyNewIssuer          = "new_issuer"
+	AttributeKeyMigratedCount      = "migrated_verifications"
+	AttributeKeyRevokedCount       = "revoked_verifications"
+	AttributeKeyRevocationReason   = "revocation_reason"
 )
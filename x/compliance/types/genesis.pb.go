@@ -25,13 +25,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the compliance module's genesis state.
 type GenesisState struct {
-	Params              Params                                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	IssuerDetails       []*GenesisIssuerDetails                 `protobuf:"bytes,2,rep,name=issuerDetails,proto3" json:"issuerDetails,omitempty"`
-	AddressDetails      []*GenesisAddressDetails                `protobuf:"bytes,3,rep,name=addressDetails,proto3" json:"addressDetails,omitempty"`
-	VerificationDetails []*GenesisVerificationDetails           `protobuf:"bytes,4,rep,name=verificationDetails,proto3" json:"verificationDetails,omitempty"`
-	Operators           []*OperatorDetails                      `protobuf:"bytes,5,rep,name=operators,proto3" json:"operators,omitempty"`
-	PublicKeys          []*GenesisHolderPublicKeys              `protobuf:"bytes,6,rep,name=publicKeys,proto3" json:"publicKeys,omitempty"`
-	LinksToPublicKey    []*GenesisLinkVerificationIdToPublicKey `protobuf:"bytes,7,rep,name=linksToPublicKey,proto3" json:"linksToPublicKey,omitempty"`
+	Params               Params                                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	IssuerDetails        []*GenesisIssuerDetails                 `protobuf:"bytes,2,rep,name=issuerDetails,proto3" json:"issuerDetails,omitempty"`
+	AddressDetails       []*GenesisAddressDetails                `protobuf:"bytes,3,rep,name=addressDetails,proto3" json:"addressDetails,omitempty"`
+	VerificationDetails  []*GenesisVerificationDetails           `protobuf:"bytes,4,rep,name=verificationDetails,proto3" json:"verificationDetails,omitempty"`
+	Operators            []*OperatorDetails                      `protobuf:"bytes,5,rep,name=operators,proto3" json:"operators,omitempty"`
+	PublicKeys           []*GenesisHolderPublicKeys              `protobuf:"bytes,6,rep,name=publicKeys,proto3" json:"publicKeys,omitempty"`
+	LinksToPublicKey     []*GenesisLinkVerificationIdToPublicKey `protobuf:"bytes,7,rep,name=linksToPublicKey,proto3" json:"linksToPublicKey,omitempty"`
+	IssuerMigrations     []*IssuerMigration                      `protobuf:"bytes,8,rep,name=issuerMigrations,proto3" json:"issuerMigrations,omitempty"`
+	RevocationDetails    []*GenesisRevocationDetails             `protobuf:"bytes,9,rep,name=revocationDetails,proto3" json:"revocationDetails,omitempty"`
+	VerificationRequests []*VerificationRequest                  `protobuf:"bytes,10,rep,name=verificationRequests,proto3" json:"verificationRequests,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -116,6 +119,27 @@ func (m *GenesisState) GetLinksToPublicKey() []*GenesisLinkVerificationIdToPubli
 	return nil
 }
 
+func (m *GenesisState) GetIssuerMigrations() []*IssuerMigration {
+	if m != nil {
+		return m.IssuerMigrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevocationDetails() []*GenesisRevocationDetails {
+	if m != nil {
+		return m.RevocationDetails
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVerificationRequests() []*VerificationRequest {
+	if m != nil {
+		return m.VerificationRequests
+	}
+	return nil
+}
+
 type GenesisIssuerDetails struct {
 	Address string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Details *IssuerDetails `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
@@ -376,6 +400,58 @@ func (m *GenesisLinkVerificationIdToPublicKey) GetPublicKey() []byte {
 	return nil
 }
 
+type GenesisRevocationDetails struct {
+	Id      []byte             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Details *RevocationDetails `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (m *GenesisRevocationDetails) Reset()         { *m = GenesisRevocationDetails{} }
+func (m *GenesisRevocationDetails) String() string { return proto.CompactTextString(m) }
+func (*GenesisRevocationDetails) ProtoMessage()    {}
+func (*GenesisRevocationDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d430e46e02363948, []int{6}
+}
+func (m *GenesisRevocationDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisRevocationDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisRevocationDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisRevocationDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisRevocationDetails.Merge(m, src)
+}
+func (m *GenesisRevocationDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisRevocationDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisRevocationDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisRevocationDetails proto.InternalMessageInfo
+
+func (m *GenesisRevocationDetails) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *GenesisRevocationDetails) GetDetails() *RevocationDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "swisstronik.compliance.GenesisState")
 	proto.RegisterType((*GenesisIssuerDetails)(nil), "swisstronik.compliance.GenesisIssuerDetails")
@@ -383,6 +459,7 @@ func init() {
 	proto.RegisterType((*GenesisVerificationDetails)(nil), "swisstronik.compliance.GenesisVerificationDetails")
 	proto.RegisterType((*GenesisHolderPublicKeys)(nil), "swisstronik.compliance.GenesisHolderPublicKeys")
 	proto.RegisterType((*GenesisLinkVerificationIdToPublicKey)(nil), "swisstronik.compliance.GenesisLinkVerificationIdToPublicKey")
+	proto.RegisterType((*GenesisRevocationDetails)(nil), "swisstronik.compliance.GenesisRevocationDetails")
 }
 
 func init() {
@@ -390,38 +467,43 @@ func init() {
 }
 
 var fileDescriptor_d430e46e02363948 = []byte{
-	// 486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x6f, 0x12, 0x41,
-	0x14, 0xc6, 0x59, 0x8a, 0x10, 0x5e, 0xb1, 0x31, 0x63, 0xd5, 0x0d, 0x31, 0x6b, 0x83, 0xad, 0x36,
-	0x51, 0x21, 0xc1, 0x8b, 0x87, 0x26, 0x6a, 0x63, 0xa3, 0x8d, 0x26, 0xad, 0x63, 0xf5, 0xe0, 0x6d,
-	0xcb, 0x8c, 0xf8, 0xc2, 0x76, 0x67, 0x9d, 0x99, 0xaa, 0xfd, 0x2f, 0xfc, 0xb3, 0x7a, 0xec, 0xd1,
-	0x93, 0x1a, 0xf8, 0x47, 0x4c, 0x87, 0xdd, 0x65, 0x80, 0x1d, 0xe8, 0x0d, 0x36, 0xdf, 0xf7, 0xfb,
-	0xde, 0xe4, 0x7d, 0x79, 0xb0, 0xa9, 0x7e, 0xa0, 0x52, 0x5a, 0x8a, 0x18, 0x07, 0x9d, 0x9e, 0x38,
-	0x49, 0x22, 0x0c, 0xe3, 0x1e, 0xef, 0xf4, 0x79, 0xcc, 0x15, 0xaa, 0x76, 0x22, 0x85, 0x16, 0xe4,
-	0xb6, 0xa5, 0x6a, 0x4f, 0x54, 0xcd, 0xf5, 0xbe, 0xe8, 0x0b, 0x23, 0xe9, 0x5c, 0xfe, 0x1a, 0xab,
-	0x9b, 0xf7, 0x1d, 0xcc, 0x24, 0x94, 0xe1, 0x49, 0x8a, 0x6c, 0x6e, 0x39, 0x44, 0x3c, 0xd6, 0xa8,
-	0x91, 0xa7, 0xb2, 0xd6, 0xdf, 0x0a, 0x34, 0x5e, 0x8f, 0x67, 0xf9, 0xa0, 0x43, 0xcd, 0xc9, 0x0e,
-	0x54, 0xc7, 0x1c, 0xdf, 0xdb, 0xf0, 0xb6, 0x57, 0xbb, 0x41, 0xbb, 0x78, 0xb6, 0xf6, 0xa1, 0x51,
-	0xed, 0x56, 0xce, 0xff, 0xdc, 0x2b, 0xd1, 0xd4, 0x43, 0x28, 0x5c, 0x47, 0xa5, 0x4e, 0xb9, 0x7c,
-	0xc5, 0x75, 0x88, 0x91, 0xf2, 0xcb, 0x1b, 0x2b, 0xdb, 0xab, 0xdd, 0xc7, 0x2e, 0x48, 0x1a, 0xbd,
-	0x6f, 0x7b, 0xe8, 0x34, 0x82, 0x7c, 0x84, 0xb5, 0x90, 0x31, 0xc9, 0x95, 0xca, 0xa0, 0x2b, 0x06,
-	0xfa, 0x64, 0x09, 0xf4, 0xe5, 0x94, 0x89, 0xce, 0x40, 0x08, 0x83, 0x9b, 0xdf, 0xb9, 0xc4, 0x2f,
-	0xd8, 0x0b, 0x35, 0x8a, 0x38, 0x63, 0x57, 0x0c, 0xbb, 0xbb, 0x84, 0xfd, 0x69, 0xde, 0x49, 0x8b,
-	0x70, 0x64, 0x0f, 0xea, 0x22, 0xe1, 0x32, 0xd4, 0x42, 0x2a, 0xff, 0x9a, 0x61, 0x3f, 0x74, 0xb1,
-	0x0f, 0x52, 0x61, 0x06, 0x9c, 0x38, 0xc9, 0x01, 0x40, 0x72, 0x7a, 0x1c, 0x61, 0xef, 0x2d, 0x3f,
-	0x53, 0x7e, 0xd5, 0x70, 0x3a, 0x4b, 0x66, 0x7c, 0x23, 0x22, 0xc6, 0xe5, 0x61, 0x6e, 0xa3, 0x16,
-	0x82, 0x7c, 0x85, 0x1b, 0x11, 0xc6, 0x03, 0x75, 0x24, 0x72, 0x81, 0x5f, 0x33, 0xd8, 0x9d, 0x25,
-	0xd8, 0x77, 0x18, 0x0f, 0xec, 0xe7, 0xef, 0x33, 0x8b, 0x41, 0xe7, 0xa8, 0xad, 0x6f, 0xb0, 0x5e,
-	0xb4, 0x65, 0xe2, 0x43, 0x2d, 0xdd, 0x88, 0x69, 0x5a, 0x9d, 0x66, 0x7f, 0xc9, 0x73, 0xa8, 0xb1,
-	0xbc, 0x3e, 0x97, 0x1d, 0xdc, 0x72, 0x8d, 0x34, 0xdd, 0x9b, 0xcc, 0xd5, 0x52, 0x70, 0xab, 0xb0,
-	0x03, 0x0b, 0x32, 0x5f, 0xcc, 0x66, 0x3e, 0x70, 0x65, 0xce, 0xd4, 0xca, 0x0a, 0x6d, 0xba, 0xcb,
-	0x41, 0xd6, 0xa0, 0x8c, 0xcc, 0x84, 0x36, 0x68, 0x19, 0x19, 0xd9, 0x9b, 0xcd, 0x7b, 0xe4, 0xca,
-	0x2b, 0xaa, 0x5a, 0x1e, 0xfa, 0x1e, 0xee, 0x38, 0xb6, 0xbd, 0xe0, 0xad, 0x77, 0xa1, 0x9e, 0x37,
-	0xc1, 0xa4, 0x37, 0xe8, 0xe4, 0x43, 0xeb, 0x08, 0x36, 0xaf, 0xb2, 0xe9, 0xb9, 0x17, 0x2d, 0xa4,
-	0xee, 0x3e, 0x3b, 0x1f, 0x06, 0xde, 0xc5, 0x30, 0xf0, 0xfe, 0x0d, 0x03, 0xef, 0xd7, 0x28, 0x28,
-	0x5d, 0x8c, 0x82, 0xd2, 0xef, 0x51, 0x50, 0xfa, 0x1c, 0xd8, 0x87, 0xea, 0xa7, 0x7d, 0xaa, 0xf4,
-	0x59, 0xc2, 0xd5, 0x71, 0xd5, 0x1c, 0xaa, 0xa7, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xde,
-	0x53, 0x0f, 0x4a, 0x05, 0x00, 0x00,
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x6e, 0xb4, 0xf4, 0xad, 0x4c, 0xc3, 0x14, 0x88, 0x2a, 0x14, 0xa6, 0xb2, 0xc1,
+	0x10, 0xd0, 0xa2, 0x72, 0xe1, 0x30, 0x09, 0x18, 0x4c, 0x50, 0x01, 0xda, 0xf0, 0xc6, 0x0e, 0x1c,
+	0x40, 0x59, 0x63, 0x8a, 0xd5, 0x2c, 0xce, 0x6c, 0x77, 0xb0, 0x6f, 0xc1, 0xc7, 0xda, 0x71, 0x47,
+	0x4e, 0x08, 0xb5, 0x9f, 0x81, 0xfb, 0x34, 0x37, 0x69, 0xd3, 0x24, 0x4e, 0x7a, 0x6b, 0xa3, 0xff,
+	0xff, 0xf7, 0xf7, 0x7b, 0x7e, 0x4f, 0x86, 0x35, 0xf1, 0x93, 0x0a, 0x21, 0x39, 0xf3, 0x68, 0xbf,
+	0xd5, 0x65, 0x47, 0xbe, 0x4b, 0x6d, 0xaf, 0x4b, 0x5a, 0x3d, 0xe2, 0x11, 0x41, 0x45, 0xd3, 0xe7,
+	0x4c, 0x32, 0x74, 0x2b, 0xa2, 0x6a, 0x4e, 0x55, 0xf5, 0x5a, 0x8f, 0xf5, 0x98, 0x92, 0xb4, 0x2e,
+	0x7f, 0x8d, 0xd5, 0xf5, 0x7b, 0x1a, 0xa6, 0x6f, 0x73, 0xfb, 0x28, 0x40, 0xd6, 0xd7, 0x35, 0x22,
+	0xe2, 0x49, 0x2a, 0x29, 0x09, 0x64, 0x8d, 0xff, 0x25, 0xa8, 0xbe, 0x1d, 0x9f, 0x65, 0x4f, 0xda,
+	0x92, 0xa0, 0x4d, 0x28, 0x8d, 0x39, 0xa6, 0xb1, 0x6a, 0x6c, 0x2c, 0xb5, 0xad, 0x66, 0xfa, 0xd9,
+	0x9a, 0xbb, 0x4a, 0xb5, 0xb5, 0x78, 0xf6, 0xf7, 0x6e, 0x01, 0x07, 0x1e, 0x84, 0xe1, 0x1a, 0x15,
+	0x62, 0x40, 0xf8, 0x1b, 0x22, 0x6d, 0xea, 0x0a, 0xb3, 0xb8, 0xba, 0xb0, 0xb1, 0xd4, 0x7e, 0xac,
+	0x83, 0x04, 0xd1, 0x9d, 0xa8, 0x07, 0xcf, 0x22, 0xd0, 0x67, 0x58, 0xb6, 0x1d, 0x87, 0x13, 0x21,
+	0x42, 0xe8, 0x82, 0x82, 0x3e, 0xc9, 0x81, 0xbe, 0x9a, 0x31, 0xe1, 0x18, 0x04, 0x39, 0x70, 0xe3,
+	0x84, 0x70, 0xfa, 0x9d, 0x76, 0x6d, 0x49, 0x99, 0x17, 0xb2, 0x17, 0x15, 0xbb, 0x9d, 0xc3, 0x3e,
+	0x48, 0x3a, 0x71, 0x1a, 0x0e, 0x6d, 0x43, 0x85, 0xf9, 0x84, 0xdb, 0x92, 0x71, 0x61, 0x5e, 0x51,
+	0xec, 0x07, 0x3a, 0xf6, 0x4e, 0x20, 0x0c, 0x81, 0x53, 0x27, 0xda, 0x01, 0xf0, 0x07, 0x87, 0x2e,
+	0xed, 0xbe, 0x27, 0xa7, 0xc2, 0x2c, 0x29, 0x4e, 0x2b, 0xe7, 0x8c, 0xef, 0x98, 0xeb, 0x10, 0xbe,
+	0x3b, 0xb1, 0xe1, 0x08, 0x02, 0xfd, 0x80, 0x15, 0x97, 0x7a, 0x7d, 0xb1, 0xcf, 0x26, 0x02, 0xb3,
+	0xac, 0xb0, 0x9b, 0x39, 0xd8, 0x0f, 0xd4, 0xeb, 0x47, 0xcb, 0xef, 0x38, 0x11, 0x06, 0x4e, 0x50,
+	0xd1, 0x1e, 0xac, 0x8c, 0xef, 0xf3, 0x23, 0xed, 0x71, 0xe5, 0x10, 0xe6, 0xd5, 0xec, 0x46, 0x74,
+	0x66, 0xf5, 0x38, 0x01, 0x40, 0x5f, 0xe1, 0x3a, 0x27, 0x27, 0x6c, 0xf6, 0xea, 0x2a, 0x8a, 0xfa,
+	0x34, 0xe7, 0xfc, 0x38, 0xee, 0xc3, 0x49, 0x14, 0xfa, 0x06, 0xb5, 0xe8, 0x6d, 0x62, 0x72, 0x3c,
+	0x20, 0x42, 0x0a, 0x13, 0x54, 0xc4, 0x23, 0x5d, 0xc4, 0x41, 0xd2, 0x83, 0x53, 0x41, 0x8d, 0x63,
+	0xa8, 0xa5, 0xcd, 0x3e, 0x32, 0xa1, 0x1c, 0xcc, 0xa9, 0xda, 0xbf, 0x0a, 0x0e, 0xff, 0xa2, 0x17,
+	0x50, 0x76, 0x26, 0x4b, 0x75, 0xb9, 0x99, 0xeb, 0xd9, 0xed, 0x0b, 0xab, 0x0b, 0x5d, 0x0d, 0x01,
+	0x37, 0x53, 0x37, 0x23, 0x23, 0xf3, 0x65, 0x3c, 0xf3, 0xbe, 0x2e, 0x33, 0xb6, 0x6c, 0x91, 0xd0,
+	0xba, 0x7e, 0x65, 0xd0, 0x32, 0x14, 0xa9, 0xa3, 0x42, 0xab, 0xb8, 0x48, 0x1d, 0xb4, 0x1d, 0xcf,
+	0x9b, 0xab, 0xd3, 0x89, 0xd0, 0x4f, 0x70, 0x5b, 0xb3, 0x03, 0x19, 0xb5, 0xde, 0x81, 0xca, 0x64,
+	0x3f, 0x54, 0x7a, 0x15, 0x4f, 0x3f, 0x34, 0xf6, 0x61, 0x6d, 0x9e, 0xf9, 0x4f, 0x54, 0x94, 0x4d,
+	0x65, 0x60, 0xea, 0xa6, 0x32, 0x41, 0x7a, 0x1d, 0xef, 0xcd, 0x43, 0x5d, 0x6f, 0x92, 0x13, 0x1e,
+	0x3a, 0xb7, 0x9e, 0x9f, 0x0d, 0x2d, 0xe3, 0x7c, 0x68, 0x19, 0xff, 0x86, 0x96, 0xf1, 0x7b, 0x64,
+	0x15, 0xce, 0x47, 0x56, 0xe1, 0xcf, 0xc8, 0x2a, 0x7c, 0xb1, 0xa2, 0xef, 0xc5, 0xaf, 0xe8, 0x8b,
+	0x21, 0x4f, 0x7d, 0x22, 0x0e, 0x4b, 0xea, 0xbd, 0x78, 0x76, 0x31, 0x00, 0x1e, 0xd3, 0xfe, 0x2a,
+	0xd1, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -444,6 +526,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VerificationRequests) > 0 {
+		for iNdEx := len(m.VerificationRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerificationRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RevocationDetails) > 0 {
+		for iNdEx := len(m.RevocationDetails) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevocationDetails[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.IssuerMigrations) > 0 {
+		for iNdEx := len(m.IssuerMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssuerMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.LinksToPublicKey) > 0 {
 		for iNdEx := len(m.LinksToPublicKey) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -741,6 +865,48 @@ func (m *GenesisLinkVerificationIdToPublicKey) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisRevocationDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisRevocationDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisRevocationDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -796,6 +962,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.IssuerMigrations) > 0 {
+		for _, e := range m.IssuerMigrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevocationDetails) > 0 {
+		for _, e := range m.RevocationDetails {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VerificationRequests) > 0 {
+		for _, e := range m.VerificationRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -884,6 +1068,23 @@ func (m *GenesisLinkVerificationIdToPublicKey) Size() (n int) {
 	return n
 }
 
+func (m *GenesisRevocationDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1156,6 +1357,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerMigrations = append(m.IssuerMigrations, &IssuerMigration{})
+			if err := m.IssuerMigrations[len(m.IssuerMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocationDetails = append(m.RevocationDetails, &GenesisRevocationDetails{})
+			if err := m.RevocationDetails[len(m.RevocationDetails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationRequests = append(m.VerificationRequests, &VerificationRequest{})
+			if err := m.VerificationRequests[len(m.VerificationRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1767,6 +2070,126 @@ func (m *GenesisLinkVerificationIdToPublicKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisRevocationDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisRevocationDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisRevocationDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &RevocationDetails{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
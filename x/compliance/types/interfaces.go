@@ -13,4 +13,9 @@ type ComplianceKeeper interface {
 	SetIssuerDetails(ctx sdk.Context, issuerAddress sdk.AccAddress, details *IssuerDetails) error
 	GetAddressDetails(ctx sdk.Context, address sdk.AccAddress) (*AddressDetails, error)
 	LinkVerificationToHolder(ctx sdk.Context, userAddress sdk.AccAddress, verificationId []byte) error
+	IterateVerificationDetails(ctx sdk.Context, callback func(id []byte) (continue_ bool))
+	GetRawVerificationDetails(ctx sdk.Context, verificationId []byte) (*VerificationDetails, error)
+	SetVerificationExpiration(ctx sdk.Context, verificationId []byte, expirationTimestamp uint32)
+	SetVerificationIndexes(ctx sdk.Context, verificationId []byte, details *VerificationDetails) error
+	SetParams(ctx sdk.Context, params Params)
 }
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
@@ -29,18 +32,36 @@ const (
 	prefixHolderPublicKeys
 	prefixVerificationToHolder
 	prefixVerificationToPubKey
+	prefixVerificationExpiration
+	prefixIssuanceCounter
+	prefixIssuerAlias
+	prefixIssuerPredecessor
+	prefixRevocationDetails
+	prefixRootHistory
+	prefixVerificationByIssuer
+	prefixVerificationByType
+	prefixVerificationRequest
 )
 
 var (
-	KeyPrefixOperatorDetails      = []byte{prefixOperatorDetails}
-	KeyPrefixIssuerDetails        = []byte{prefixIssuerDetails}
-	KeyPrefixAddressDetails       = []byte{prefixAddressDetails}
-	KeyPrefixVerificationDetails  = []byte{prefixVerificationDetails}
-	KeyPrefixIssuanceTree         = []byte{prefixIssuanceTree}
-	KeyPrefixRevocationTree       = []byte{prefixRevocationTree}
-	KeyPrefixHolderPublicKeys     = []byte{prefixHolderPublicKeys}
-	KeyPrefixVerificationToHolder = []byte{prefixVerificationToHolder}
-	KeyPrefixVerificationToPubKey = []byte{prefixVerificationToPubKey}
+	KeyPrefixOperatorDetails        = []byte{prefixOperatorDetails}
+	KeyPrefixIssuerDetails          = []byte{prefixIssuerDetails}
+	KeyPrefixAddressDetails         = []byte{prefixAddressDetails}
+	KeyPrefixVerificationDetails    = []byte{prefixVerificationDetails}
+	KeyPrefixIssuanceTree           = []byte{prefixIssuanceTree}
+	KeyPrefixRevocationTree         = []byte{prefixRevocationTree}
+	KeyPrefixHolderPublicKeys       = []byte{prefixHolderPublicKeys}
+	KeyPrefixVerificationToHolder   = []byte{prefixVerificationToHolder}
+	KeyPrefixVerificationToPubKey   = []byte{prefixVerificationToPubKey}
+	KeyPrefixVerificationExpiration = []byte{prefixVerificationExpiration}
+	KeyPrefixIssuanceCounter        = []byte{prefixIssuanceCounter}
+	KeyPrefixIssuerAlias            = []byte{prefixIssuerAlias}
+	KeyPrefixIssuerPredecessor      = []byte{prefixIssuerPredecessor}
+	KeyPrefixRevocationDetails      = []byte{prefixRevocationDetails}
+	KeyPrefixRootHistory            = []byte{prefixRootHistory}
+	KeyPrefixVerificationByIssuer   = []byte{prefixVerificationByIssuer}
+	KeyPrefixVerificationByType     = []byte{prefixVerificationByType}
+	KeyPrefixVerificationRequest    = []byte{prefixVerificationRequest}
 )
 
 func AccAddressFromKey(key []byte) sdk.AccAddress {
@@ -52,3 +73,71 @@ func VerificationIdFromKey(key []byte) []byte {
 	kv.AssertKeyAtLeastLength(key, 1)
 	return key[1:]
 }
+
+// VerificationExpirationKey returns key of time-ordered expiration index
+// in format `big endian expiration timestamp | verification id`
+func VerificationExpirationKey(expirationTimestamp uint32, verificationId []byte) []byte {
+	return append(ExpirationTimestampToBytes(expirationTimestamp), verificationId...)
+}
+
+// SplitVerificationExpirationKey splits key of expiration index to expiration timestamp and verification id
+func SplitVerificationExpirationKey(key []byte) (uint32, []byte) {
+	kv.AssertKeyAtLeastLength(key, 5)
+	return binary.BigEndian.Uint32(key[:4]), key[4:]
+}
+
+// ExpirationTimestampToBytes encodes expiration timestamp to be used in ordered index
+func ExpirationTimestampToBytes(expirationTimestamp uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, expirationTimestamp)
+	return bz
+}
+
+// RootHistoryKey returns key of ring buffer slot, which stores tree roots of provided block
+func RootHistoryKey(blockHeight int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(blockHeight)%RootHistorySize)
+	return bz
+}
+
+// VerificationByIssuerKey returns key of issuer index in format
+// `length-prefixed issuer address | big endian verification type | verification id`
+func VerificationByIssuerKey(issuerAddress sdk.AccAddress, verificationType VerificationType, verificationId []byte) []byte {
+	return append(VerificationByIssuerTypePrefix(issuerAddress, verificationType), verificationId...)
+}
+
+// VerificationByIssuerPrefix returns prefix of issuer index, which contains all verifications of provided issuer
+func VerificationByIssuerPrefix(issuerAddress sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(issuerAddress)
+}
+
+// VerificationByIssuerTypePrefix returns prefix of issuer index, which contains verifications
+// of provided issuer and type
+func VerificationByIssuerTypePrefix(issuerAddress sdk.AccAddress, verificationType VerificationType) []byte {
+	return append(VerificationByIssuerPrefix(issuerAddress), VerificationTypeToBytes(verificationType)...)
+}
+
+// VerificationByTypeKey returns key of type index in format `big endian verification type | verification id`
+func VerificationByTypeKey(verificationType VerificationType, verificationId []byte) []byte {
+	return append(VerificationTypeToBytes(verificationType), verificationId...)
+}
+
+// SplitVerificationTypeKey splits key, which starts with verification type, to verification type and verification id
+func SplitVerificationTypeKey(key []byte) (VerificationType, []byte) {
+	kv.AssertKeyAtLeastLength(key, 5)
+	return VerificationType(binary.BigEndian.Uint32(key[:4])), key[4:]
+}
+
+// VerificationTypeToBytes encodes verification type to be used in ordered index.
+// Unlike `VerificationType.ToBytes`, which is used to derive verification id, big endian encoding is used.
+func VerificationTypeToBytes(verificationType VerificationType) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, uint32(verificationType))
+	return bz
+}
+
+// VerificationRequestKey returns key of pending verification request in format
+// `length-prefixed issuer address | big endian verification type | holder address`
+func VerificationRequestKey(issuerAddress sdk.AccAddress, verificationType VerificationType, holder sdk.AccAddress) []byte {
+	return append(VerificationByIssuerTypePrefix(issuerAddress, verificationType), holder.Bytes()...)
+}
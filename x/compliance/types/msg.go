@@ -326,3 +326,321 @@ func (msg *MsgConvertCredential) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+func NewMsgVerifyIssuer(authority, issuerAddress string) MsgVerifyIssuer {
+	return MsgVerifyIssuer{
+		Authority:     authority,
+		IssuerAddress: issuerAddress,
+	}
+}
+
+func (msg *MsgVerifyIssuer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgVerifyIssuer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.IssuerAddress)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg *MsgVerifyIssuer) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgUnverifyIssuer(authority, issuerAddress string) MsgUnverifyIssuer {
+	return MsgUnverifyIssuer{
+		Authority:     authority,
+		IssuerAddress: issuerAddress,
+	}
+}
+
+func (msg *MsgUnverifyIssuer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnverifyIssuer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.IssuerAddress)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg *MsgUnverifyIssuer) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgGovAddOperator(authority, operatorAddress string) MsgGovAddOperator {
+	return MsgGovAddOperator{
+		Authority: authority,
+		Operator:  operatorAddress,
+	}
+}
+
+func (msg *MsgGovAddOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgGovAddOperator) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg *MsgGovAddOperator) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgGovRemoveOperator(authority, operatorAddress string) MsgGovRemoveOperator {
+	return MsgGovRemoveOperator{
+		Authority: authority,
+		Operator:  operatorAddress,
+	}
+}
+
+func (msg *MsgGovRemoveOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgGovRemoveOperator) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg *MsgGovRemoveOperator) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgSetIssuerVerificationTypes(operatorAddress, issuerAddress string, verificationTypes []VerificationType) MsgSetIssuerVerificationTypes {
+	return MsgSetIssuerVerificationTypes{
+		Signer:            operatorAddress,
+		Issuer:            issuerAddress,
+		VerificationTypes: verificationTypes,
+	}
+}
+
+func (msg *MsgSetIssuerVerificationTypes) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetIssuerVerificationTypes) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if err = ValidateVerificationTypes(msg.VerificationTypes); err != nil {
+		return errors.Wrap(ErrInvalidParam, err.Error())
+	}
+
+	return nil
+}
+
+func (msg *MsgSetIssuerVerificationTypes) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func NewMsgSetIssuerQuota(operatorAddress, issuerAddress string, maxIssuances, windowBlocks uint64) MsgSetIssuerQuota {
+	return MsgSetIssuerQuota{
+		Signer: operatorAddress,
+		Issuer: issuerAddress,
+		Quota: IssuanceQuota{
+			MaxIssuances: maxIssuances,
+			WindowBlocks: windowBlocks,
+		},
+	}
+}
+
+func (msg *MsgSetIssuerQuota) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetIssuerQuota) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if err = msg.Quota.Validate(); err != nil {
+		return errors.Wrap(ErrInvalidParam, err.Error())
+	}
+
+	return nil
+}
+
+func (msg *MsgSetIssuerQuota) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func NewMsgMigrateIssuer(signerAddress, issuerAddress, newIssuerAddress string) MsgMigrateIssuer {
+	return MsgMigrateIssuer{
+		Signer:    signerAddress,
+		Issuer:    issuerAddress,
+		NewIssuer: newIssuerAddress,
+	}
+}
+
+func (msg *MsgMigrateIssuer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMigrateIssuer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.NewIssuer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new issuer address (%s)", err)
+	}
+
+	if msg.Issuer == msg.NewIssuer {
+		return errors.Wrap(ErrInvalidParam, "new issuer address should differ from current one")
+	}
+
+	return nil
+}
+
+func (msg *MsgMigrateIssuer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func NewMsgRevokeVerifications(signerAddress string, verificationIds [][]byte, reason RevocationReason, note string) MsgRevokeVerifications {
+	return MsgRevokeVerifications{
+		Signer:          signerAddress,
+		VerificationIds: verificationIds,
+		Reason:          reason,
+		Note:            note,
+	}
+}
+
+func (msg *MsgRevokeVerifications) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokeVerifications) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if len(msg.VerificationIds) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "missing verification ids")
+	}
+	if len(msg.VerificationIds) > MaxRevocationsPerMsg {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot revoke more than %d verifications at once", MaxRevocationsPerMsg)
+	}
+
+	seen := make(map[string]struct{}, len(msg.VerificationIds))
+	for _, verificationId := range msg.VerificationIds {
+		if len(verificationId) == 0 {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "missing verification id")
+		}
+		if _, ok := seen[string(verificationId)]; ok {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated verification id (%x)", verificationId)
+		}
+		seen[string(verificationId)] = struct{}{}
+	}
+
+	if msg.Reason == RevocationReason_RR_UNSPECIFIED || !msg.Reason.IsValid() {
+		return errors.Wrap(ErrInvalidParam, "invalid revocation reason")
+	}
+
+	if len(msg.Note) > MaxRevocationNoteSize {
+		return errors.Wrap(ErrInvalidParam, "revocation note too long")
+	}
+
+	return nil
+}
+
+func (msg *MsgRevokeVerifications) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
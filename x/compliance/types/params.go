@@ -1,35 +1,69 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	// DefaultPruneExpiredVerifications is false, expired verifications are kept in the storage
+	DefaultPruneExpiredVerifications = false
+	// DefaultComplianceRegistry is empty, logs of EVM contracts are not converted to compliance events
+	DefaultComplianceRegistry = ""
+)
+
+// Parameter keys
+var (
+	ParamStoreKeyPruneExpiredVerifications = []byte("PruneExpiredVerifications")
+	ParamStoreKeyComplianceRegistry        = []byte("ComplianceRegistry")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(pruneExpiredVerifications bool, complianceRegistry string) Params {
+	return Params{
+		PruneExpiredVerifications: pruneExpiredVerifications,
+		ComplianceRegistry:        complianceRegistry,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultPruneExpiredVerifications, DefaultComplianceRegistry)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyPruneExpiredVerifications, &p.PruneExpiredVerifications, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyComplianceRegistry, &p.ComplianceRegistry, validateComplianceRegistry),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateBool(p.PruneExpiredVerifications); err != nil {
+		return err
+	}
+	return validateComplianceRegistry(p.ComplianceRegistry)
+}
+
+// GetComplianceRegistryAddress returns address of compliance registry contract and flag,
+// which shows if the registry is set
+func (p Params) GetComplianceRegistryAddress() (common.Address, bool) {
+	if p.ComplianceRegistry == "" {
+		return common.Address{}, false
+	}
+	return common.HexToAddress(p.ComplianceRegistry), true
 }
 
 // String implements the Stringer interface.
@@ -37,3 +71,22 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateComplianceRegistry(i interface{}) error {
+	registry, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if registry != "" && !common.IsHexAddress(registry) {
+		return fmt.Errorf("invalid compliance registry address: %s", registry)
+	}
+	return nil
+}
@@ -25,6 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// prune_expired_verifications defines if expired verifications should be removed
+	// from the storage during EndBlock
+	PruneExpiredVerifications bool `protobuf:"varint,1,opt,name=prune_expired_verifications,json=pruneExpiredVerifications,proto3" json:"prune_expired_verifications,omitempty" yaml:"prune_expired_verifications"`
+	// compliance_registry is a hex address of compliance registry contract. Logs emitted by this contract
+	// are converted to native compliance events by EVM hook. Empty value disables the hook
+	ComplianceRegistry string `protobuf:"bytes,2,opt,name=compliance_registry,json=complianceRegistry,proto3" json:"compliance_registry,omitempty" yaml:"compliance_registry"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +65,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetPruneExpiredVerifications() bool {
+	if m != nil {
+		return m.PruneExpiredVerifications
+	}
+	return false
+}
+
+func (m *Params) GetComplianceRegistry() string {
+	if m != nil {
+		return m.ComplianceRegistry
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "swisstronik.compliance.Params")
 }
@@ -68,16 +88,22 @@ func init() {
 }
 
 var fileDescriptor_25da6e1942c61052 = []byte{
-	// 139 bytes of a gzipped FileDescriptorProto
+	// 240 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x2e, 0xcf, 0x2c,
 	0x2e, 0x2e, 0x29, 0xca, 0xcf, 0xcb, 0xcc, 0xd6, 0x4f, 0xce, 0xcf, 0x2d, 0xc8, 0xc9, 0x4c, 0xcc,
 	0x4b, 0x4e, 0xd5, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
 	0x12, 0x43, 0x52, 0xa4, 0x87, 0x50, 0x24, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa2, 0x0f,
-	0x62, 0x41, 0x54, 0x2b, 0xf1, 0x71, 0xb1, 0x05, 0x80, 0x75, 0x5b, 0xb1, 0xcc, 0x58, 0x20, 0xcf,
-	0xe0, 0x64, 0x71, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x72, 0xc8, 0x96,
-	0x57, 0x20, 0x5b, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x36, 0xd0, 0x18, 0x10, 0x00,
-	0x00, 0xff, 0xff, 0xb1, 0x48, 0x30, 0x5b, 0xa5, 0x00, 0x00, 0x00,
+	0x62, 0x41, 0x54, 0x2b, 0x1d, 0x67, 0xe4, 0x62, 0x0b, 0x00, 0x6b, 0x17, 0x4a, 0xe3, 0x92, 0x2e,
+	0x28, 0x2a, 0xcd, 0x4b, 0x8d, 0x4f, 0xad, 0x28, 0xc8, 0x2c, 0x4a, 0x4d, 0x89, 0x2f, 0x4b, 0x2d,
+	0xca, 0x4c, 0xcb, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0,
+	0x70, 0x52, 0xfb, 0x74, 0x4f, 0x5e, 0xa9, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0x8f, 0x62, 0xa5,
+	0x20, 0x49, 0xb0, 0xac, 0x2b, 0x44, 0x32, 0x0c, 0x59, 0x4e, 0xc8, 0x9f, 0x4b, 0x18, 0xe1, 0xac,
+	0xf8, 0xa2, 0xd4, 0xf4, 0xcc, 0xe2, 0x92, 0xa2, 0x4a, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x4e, 0x27,
+	0xb9, 0x4f, 0xf7, 0xe4, 0xa5, 0x20, 0xe6, 0x63, 0x51, 0xa4, 0x14, 0x24, 0x84, 0x10, 0x0d, 0x82,
+	0x0a, 0x5a, 0xb1, 0xcc, 0x58, 0x20, 0xcf, 0xe0, 0x64, 0x71, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
+	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
+	0xc7, 0x72, 0x0c, 0x51, 0x72, 0xc8, 0xc1, 0x56, 0x81, 0x1c, 0x70, 0x25, 0x95, 0x05, 0xa9, 0xc5,
+	0x49, 0x6c, 0xe0, 0xa0, 0x30, 0x06, 0x0c, 0x00, 0x1a, 0x4d, 0x39, 0x40, 0x5f, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -100,6 +126,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ComplianceRegistry) > 0 {
+		i -= len(m.ComplianceRegistry)
+		copy(dAtA[i:], m.ComplianceRegistry)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ComplianceRegistry)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PruneExpiredVerifications {
+		i--
+		if m.PruneExpiredVerifications {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -120,6 +163,13 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.PruneExpiredVerifications {
+		n += 2
+	}
+	l = len(m.ComplianceRegistry)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -158,6 +208,58 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneExpiredVerifications", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PruneExpiredVerifications = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceRegistry", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceRegistry = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -30,6 +30,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RevocationStatusFilter defines which verifications should be returned depending on their revocation status
+type RevocationStatusFilter int32
+
+const (
+	// RSF_ANY returns both revoked and not revoked verifications
+	RevocationStatusFilter_RSF_ANY RevocationStatusFilter = 0
+	// RSF_NOT_REVOKED returns only verifications, which were not revoked
+	RevocationStatusFilter_RSF_NOT_REVOKED RevocationStatusFilter = 1
+	// RSF_REVOKED returns only revoked verifications
+	RevocationStatusFilter_RSF_REVOKED RevocationStatusFilter = 2
+)
+
+var RevocationStatusFilter_name = map[int32]string{
+	0: "RSF_ANY",
+	1: "RSF_NOT_REVOKED",
+	2: "RSF_REVOKED",
+}
+
+var RevocationStatusFilter_value = map[string]int32{
+	"RSF_ANY":         0,
+	"RSF_NOT_REVOKED": 1,
+	"RSF_REVOKED":     2,
+}
+
+func (x RevocationStatusFilter) String() string {
+	return proto.EnumName(RevocationStatusFilter_name, int32(x))
+}
+
+func (RevocationStatusFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -671,13 +703,15 @@ func (m *QueryIssuersDetailsResponse) GetPagination() *query.PageResponse {
 // MergedIssuerDetails is merged structure of iterating key and `IssuerDetails` in `entities.proto`.
 // `issuerAddress` is an iterating key, and the following items should be same with `IssuerDetails`.
 type QueryIssuersDetailsResponse_MergedIssuerDetails struct {
-	IssuerAddress string `protobuf:"bytes,1,opt,name=issuerAddress,proto3" json:"issuerAddress,omitempty"`
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Url           string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	Logo          string `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
-	LegalEntity   string `protobuf:"bytes,6,opt,name=legalEntity,proto3" json:"legalEntity,omitempty"`
-	Creator       string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	IssuerAddress            string             `protobuf:"bytes,1,opt,name=issuerAddress,proto3" json:"issuerAddress,omitempty"`
+	Name                     string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description              string             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Url                      string             `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Logo                     string             `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
+	LegalEntity              string             `protobuf:"bytes,6,opt,name=legalEntity,proto3" json:"legalEntity,omitempty"`
+	Creator                  string             `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	AllowedVerificationTypes []VerificationType `protobuf:"varint,8,rep,packed,name=allowed_verification_types,json=allowedVerificationTypes,proto3,enum=swisstronik.compliance.VerificationType" json:"allowed_verification_types,omitempty"`
+	IssuanceQuota            *IssuanceQuota     `protobuf:"bytes,9,opt,name=issuance_quota,json=issuanceQuota,proto3" json:"issuance_quota,omitempty"`
 }
 
 func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Reset() {
@@ -766,6 +800,20 @@ func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) GetCreator() string {
 	return ""
 }
 
+func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) GetAllowedVerificationTypes() []VerificationType {
+	if m != nil {
+		return m.AllowedVerificationTypes
+	}
+	return nil
+}
+
+func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) GetIssuanceQuota() *IssuanceQuota {
+	if m != nil {
+		return m.IssuanceQuota
+	}
+	return nil
+}
+
 // QueryVerificationDetailsRequest is request type for the Query/VerificationDetails RPC method.
 type QueryVerificationDetailsRequest struct {
 	VerificationID string `protobuf:"bytes,1,opt,name=verificationID,proto3" json:"verificationID,omitempty"`
@@ -814,6 +862,9 @@ func (m *QueryVerificationDetailsRequest) GetVerificationID() string {
 // QueryVerificationDetailsResponse is response type for the Query/VerificationDetails RPC method.
 type QueryVerificationDetailsResponse struct {
 	Details *VerificationDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	// revocation contains time and reason of revocation. Empty if verification was not revoked
+	// or was revoked before revocation details were tracked
+	Revocation *RevocationDetails `protobuf:"bytes,2,opt,name=revocation,proto3" json:"revocation,omitempty"`
 }
 
 func (m *QueryVerificationDetailsResponse) Reset()         { *m = QueryVerificationDetailsResponse{} }
@@ -856,6 +907,13 @@ func (m *QueryVerificationDetailsResponse) GetDetails() *VerificationDetails {
 	return nil
 }
 
+func (m *QueryVerificationDetailsResponse) GetRevocation() *RevocationDetails {
+	if m != nil {
+		return m.Revocation
+	}
+	return nil
+}
+
 // QueryVerificationDetailsRequest is request type for the Query/VerificationsDetails RPC method.
 type QueryVerificationsDetailsRequest struct {
 	// pagination defines an optional pagination for the request.
@@ -1117,6 +1175,89 @@ func (m *QueryRevocationTreeRootResponse) GetRoot() []byte {
 	return nil
 }
 
+// QueryRootHistoryRequest is request type for the Query/RootHistory RPC method.
+type QueryRootHistoryRequest struct {
+}
+
+func (m *QueryRootHistoryRequest) Reset()         { *m = QueryRootHistoryRequest{} }
+func (m *QueryRootHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRootHistoryRequest) ProtoMessage()    {}
+func (*QueryRootHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{20}
+}
+func (m *QueryRootHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRootHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRootHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRootHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRootHistoryRequest.Merge(m, src)
+}
+func (m *QueryRootHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRootHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRootHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRootHistoryRequest proto.InternalMessageInfo
+
+// QueryRootHistoryResponse is response type for the Query/RootHistory RPC method.
+type QueryRootHistoryResponse struct {
+	// roots of issuance and revocation trees ordered from the latest block
+	Roots []TreeRoots `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots"`
+}
+
+func (m *QueryRootHistoryResponse) Reset()         { *m = QueryRootHistoryResponse{} }
+func (m *QueryRootHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRootHistoryResponse) ProtoMessage()    {}
+func (*QueryRootHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{21}
+}
+func (m *QueryRootHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRootHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRootHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRootHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRootHistoryResponse.Merge(m, src)
+}
+func (m *QueryRootHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRootHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRootHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRootHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRootHistoryResponse) GetRoots() []TreeRoots {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
 type QueryIssuanceProofRequest struct {
 	CredentialHash []byte `protobuf:"bytes,1,opt,name=credentialHash,proto3" json:"credentialHash,omitempty"`
 }
@@ -1125,7 +1266,7 @@ func (m *QueryIssuanceProofRequest) Reset()         { *m = QueryIssuanceProofReq
 func (m *QueryIssuanceProofRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIssuanceProofRequest) ProtoMessage()    {}
 func (*QueryIssuanceProofRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{20}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{22}
 }
 func (m *QueryIssuanceProofRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1169,7 +1310,7 @@ func (m *QueryIssuanceProofResponse) Reset()         { *m = QueryIssuanceProofRe
 func (m *QueryIssuanceProofResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIssuanceProofResponse) ProtoMessage()    {}
 func (*QueryIssuanceProofResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{21}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{23}
 }
 func (m *QueryIssuanceProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1213,7 +1354,7 @@ func (m *QueryRevocationProofRequest) Reset()         { *m = QueryRevocationProo
 func (m *QueryRevocationProofRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRevocationProofRequest) ProtoMessage()    {}
 func (*QueryRevocationProofRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{22}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{24}
 }
 func (m *QueryRevocationProofRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1257,7 +1398,7 @@ func (m *QueryRevocationProofResponse) Reset()         { *m = QueryRevocationPro
 func (m *QueryRevocationProofResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRevocationProofResponse) ProtoMessage()    {}
 func (*QueryRevocationProofResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{23}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{25}
 }
 func (m *QueryRevocationProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1301,7 +1442,7 @@ func (m *QueryAttachedHolderPublicKeyRequest) Reset()         { *m = QueryAttach
 func (m *QueryAttachedHolderPublicKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAttachedHolderPublicKeyRequest) ProtoMessage()    {}
 func (*QueryAttachedHolderPublicKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{24}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{26}
 }
 func (m *QueryAttachedHolderPublicKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1345,7 +1486,7 @@ func (m *QueryAttachedHolderPublicKeyResponse) Reset()         { *m = QueryAttac
 func (m *QueryAttachedHolderPublicKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAttachedHolderPublicKeyResponse) ProtoMessage()    {}
 func (*QueryAttachedHolderPublicKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{25}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{27}
 }
 func (m *QueryAttachedHolderPublicKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1390,7 +1531,7 @@ func (m *QueryIsCredentialInZKSDIRequest) Reset()         { *m = QueryIsCredenti
 func (m *QueryIsCredentialInZKSDIRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIsCredentialInZKSDIRequest) ProtoMessage()    {}
 func (*QueryIsCredentialInZKSDIRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{26}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{28}
 }
 func (m *QueryIsCredentialInZKSDIRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1441,7 +1582,7 @@ func (m *QueryIsCredentialInZKSDIResponse) Reset()         { *m = QueryIsCredent
 func (m *QueryIsCredentialInZKSDIResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIsCredentialInZKSDIResponse) ProtoMessage()    {}
 func (*QueryIsCredentialInZKSDIResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{27}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{29}
 }
 func (m *QueryIsCredentialInZKSDIResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1477,6 +1618,106 @@ func (m *QueryIsCredentialInZKSDIResponse) GetIncluded() bool {
 	return false
 }
 
+// QueryCredentialPackageRequest is request type for the Query/CredentialPackage RPC method.
+type QueryCredentialPackageRequest struct {
+	VerificationId []byte `protobuf:"bytes,1,opt,name=verificationId,proto3" json:"verificationId,omitempty"`
+	// holderPublicKey is compressed public key attached by holder of verification. Package is
+	// returned only if credential of verification is linked to this public key
+	HolderPublicKey []byte `protobuf:"bytes,2,opt,name=holderPublicKey,proto3" json:"holderPublicKey,omitempty"`
+}
+
+func (m *QueryCredentialPackageRequest) Reset()         { *m = QueryCredentialPackageRequest{} }
+func (m *QueryCredentialPackageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialPackageRequest) ProtoMessage()    {}
+func (*QueryCredentialPackageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{30}
+}
+func (m *QueryCredentialPackageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCredentialPackageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCredentialPackageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCredentialPackageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialPackageRequest.Merge(m, src)
+}
+func (m *QueryCredentialPackageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCredentialPackageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialPackageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCredentialPackageRequest proto.InternalMessageInfo
+
+func (m *QueryCredentialPackageRequest) GetVerificationId() []byte {
+	if m != nil {
+		return m.VerificationId
+	}
+	return nil
+}
+
+func (m *QueryCredentialPackageRequest) GetHolderPublicKey() []byte {
+	if m != nil {
+		return m.HolderPublicKey
+	}
+	return nil
+}
+
+// QueryCredentialPackageResponse is response type for the Query/CredentialPackage RPC method.
+type QueryCredentialPackageResponse struct {
+	Package *CredentialPackage `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+}
+
+func (m *QueryCredentialPackageResponse) Reset()         { *m = QueryCredentialPackageResponse{} }
+func (m *QueryCredentialPackageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialPackageResponse) ProtoMessage()    {}
+func (*QueryCredentialPackageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{31}
+}
+func (m *QueryCredentialPackageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCredentialPackageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCredentialPackageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCredentialPackageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialPackageResponse.Merge(m, src)
+}
+func (m *QueryCredentialPackageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCredentialPackageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialPackageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCredentialPackageResponse proto.InternalMessageInfo
+
+func (m *QueryCredentialPackageResponse) GetPackage() *CredentialPackage {
+	if m != nil {
+		return m.Package
+	}
+	return nil
+}
+
 type QueryCredentialHashRequest struct {
 	VerificationId []byte `protobuf:"bytes,1,opt,name=verificationId,proto3" json:"verificationId,omitempty"`
 }
@@ -1485,7 +1726,7 @@ func (m *QueryCredentialHashRequest) Reset()         { *m = QueryCredentialHashR
 func (m *QueryCredentialHashRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCredentialHashRequest) ProtoMessage()    {}
 func (*QueryCredentialHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{28}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{32}
 }
 func (m *QueryCredentialHashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1529,7 +1770,7 @@ func (m *QueryCredentialHashResponse) Reset()         { *m = QueryCredentialHash
 func (m *QueryCredentialHashResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCredentialHashResponse) ProtoMessage()    {}
 func (*QueryCredentialHashResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{29}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{33}
 }
 func (m *QueryCredentialHashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1573,7 +1814,7 @@ func (m *QueryHolderByVerificationIdRequest) Reset()         { *m = QueryHolderB
 func (m *QueryHolderByVerificationIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHolderByVerificationIdRequest) ProtoMessage()    {}
 func (*QueryHolderByVerificationIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{30}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{34}
 }
 func (m *QueryHolderByVerificationIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1617,7 +1858,7 @@ func (m *QueryHolderByVerificationIdResponse) Reset()         { *m = QueryHolder
 func (m *QueryHolderByVerificationIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHolderByVerificationIdResponse) ProtoMessage()    {}
 func (*QueryHolderByVerificationIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{31}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{35}
 }
 func (m *QueryHolderByVerificationIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1666,7 +1907,7 @@ func (m *QueryAllVerificationDetailsByAddressRequest) String() string {
 }
 func (*QueryAllVerificationDetailsByAddressRequest) ProtoMessage() {}
 func (*QueryAllVerificationDetailsByAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{32}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{36}
 }
 func (m *QueryAllVerificationDetailsByAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1721,7 +1962,7 @@ func (m *QueryAllVerificationDetailsByAddressResponse) String() string {
 }
 func (*QueryAllVerificationDetailsByAddressResponse) ProtoMessage() {}
 func (*QueryAllVerificationDetailsByAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80d6bdaf4aa1245, []int{33}
+	return fileDescriptor_f80d6bdaf4aa1245, []int{37}
 }
 func (m *QueryAllVerificationDetailsByAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1757,1482 +1998,1712 @@ func (m *QueryAllVerificationDetailsByAddressResponse) GetDetails() []*MergedVer
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "swisstronik.compliance.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "swisstronik.compliance.QueryParamsResponse")
-	proto.RegisterType((*QueryOperatorDetailsRequest)(nil), "swisstronik.compliance.QueryOperatorDetailsRequest")
-	proto.RegisterType((*QueryOperatorDetailsResponse)(nil), "swisstronik.compliance.QueryOperatorDetailsResponse")
-	proto.RegisterType((*QueryAddressDetailsRequest)(nil), "swisstronik.compliance.QueryAddressDetailsRequest")
-	proto.RegisterType((*QueryAddressDetailsResponse)(nil), "swisstronik.compliance.QueryAddressDetailsResponse")
-	proto.RegisterType((*QueryAddressesDetailsRequest)(nil), "swisstronik.compliance.QueryAddressesDetailsRequest")
-	proto.RegisterType((*QueryAddressesDetailsResponse)(nil), "swisstronik.compliance.QueryAddressesDetailsResponse")
-	proto.RegisterType((*QueryAddressesDetailsResponse_MergedAddressDetails)(nil), "swisstronik.compliance.QueryAddressesDetailsResponse.MergedAddressDetails")
-	proto.RegisterType((*QueryIssuerDetailsRequest)(nil), "swisstronik.compliance.QueryIssuerDetailsRequest")
-	proto.RegisterType((*QueryIssuerDetailsResponse)(nil), "swisstronik.compliance.QueryIssuerDetailsResponse")
-	proto.RegisterType((*QueryIssuersDetailsRequest)(nil), "swisstronik.compliance.QueryIssuersDetailsRequest")
-	proto.RegisterType((*QueryIssuersDetailsResponse)(nil), "swisstronik.compliance.QueryIssuersDetailsResponse")
-	proto.RegisterType((*QueryIssuersDetailsResponse_MergedIssuerDetails)(nil), "swisstronik.compliance.QueryIssuersDetailsResponse.MergedIssuerDetails")
-	proto.RegisterType((*QueryVerificationDetailsRequest)(nil), "swisstronik.compliance.QueryVerificationDetailsRequest")
-	proto.RegisterType((*QueryVerificationDetailsResponse)(nil), "swisstronik.compliance.QueryVerificationDetailsResponse")
-	proto.RegisterType((*QueryVerificationsDetailsRequest)(nil), "swisstronik.compliance.QueryVerificationsDetailsRequest")
-	proto.RegisterType((*QueryVerificationsDetailsResponse)(nil), "swisstronik.compliance.QueryVerificationsDetailsResponse")
-	proto.RegisterType((*QueryIssuanceTreeRootRequest)(nil), "swisstronik.compliance.QueryIssuanceTreeRootRequest")
-	proto.RegisterType((*QueryIssuanceTreeRootResponse)(nil), "swisstronik.compliance.QueryIssuanceTreeRootResponse")
-	proto.RegisterType((*QueryRevocationTreeRootRequest)(nil), "swisstronik.compliance.QueryRevocationTreeRootRequest")
-	proto.RegisterType((*QueryRevocationTreeRootResponse)(nil), "swisstronik.compliance.QueryRevocationTreeRootResponse")
-	proto.RegisterType((*QueryIssuanceProofRequest)(nil), "swisstronik.compliance.QueryIssuanceProofRequest")
-	proto.RegisterType((*QueryIssuanceProofResponse)(nil), "swisstronik.compliance.QueryIssuanceProofResponse")
-	proto.RegisterType((*QueryRevocationProofRequest)(nil), "swisstronik.compliance.QueryRevocationProofRequest")
-	proto.RegisterType((*QueryRevocationProofResponse)(nil), "swisstronik.compliance.QueryRevocationProofResponse")
-	proto.RegisterType((*QueryAttachedHolderPublicKeyRequest)(nil), "swisstronik.compliance.QueryAttachedHolderPublicKeyRequest")
-	proto.RegisterType((*QueryAttachedHolderPublicKeyResponse)(nil), "swisstronik.compliance.QueryAttachedHolderPublicKeyResponse")
-	proto.RegisterType((*QueryIsCredentialInZKSDIRequest)(nil), "swisstronik.compliance.QueryIsCredentialInZKSDIRequest")
-	proto.RegisterType((*QueryIsCredentialInZKSDIResponse)(nil), "swisstronik.compliance.QueryIsCredentialInZKSDIResponse")
-	proto.RegisterType((*QueryCredentialHashRequest)(nil), "swisstronik.compliance.QueryCredentialHashRequest")
-	proto.RegisterType((*QueryCredentialHashResponse)(nil), "swisstronik.compliance.QueryCredentialHashResponse")
-	proto.RegisterType((*QueryHolderByVerificationIdRequest)(nil), "swisstronik.compliance.QueryHolderByVerificationIdRequest")
-	proto.RegisterType((*QueryHolderByVerificationIdResponse)(nil), "swisstronik.compliance.QueryHolderByVerificationIdResponse")
-	proto.RegisterType((*QueryAllVerificationDetailsByAddressRequest)(nil), "swisstronik.compliance.QueryAllVerificationDetailsByAddressRequest")
-	proto.RegisterType((*QueryAllVerificationDetailsByAddressResponse)(nil), "swisstronik.compliance.QueryAllVerificationDetailsByAddressResponse")
+// QueryVerificationsExpiringBetweenRequest is request type for the Query/VerificationsExpiringBetween RPC method.
+type QueryVerificationsExpiringBetweenRequest struct {
+	// start_timestamp is an inclusive lower bound of expiration timestamp
+	StartTimestamp uint32 `protobuf:"varint,1,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// end_timestamp is an inclusive upper bound of expiration timestamp
+	EndTimestamp uint32 `protobuf:"varint,2,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("swisstronik/compliance/query.proto", fileDescriptor_f80d6bdaf4aa1245)
+func (m *QueryVerificationsExpiringBetweenRequest) Reset() {
+	*m = QueryVerificationsExpiringBetweenRequest{}
 }
-
-var fileDescriptor_f80d6bdaf4aa1245 = []byte{
-	// 1665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdf, 0x6f, 0xd4, 0xc6,
-	0x16, 0x8e, 0x93, 0x90, 0x90, 0x13, 0x48, 0xd0, 0x10, 0x71, 0x97, 0xbd, 0xb0, 0x09, 0xe6, 0x47,
-	0x02, 0xb9, 0xac, 0x6f, 0x12, 0x20, 0x10, 0xb8, 0x40, 0x7e, 0x01, 0x4b, 0xee, 0xd5, 0xcd, 0x5d,
-	0xae, 0xa8, 0x8a, 0x84, 0x22, 0x67, 0x3d, 0x6c, 0xdc, 0x38, 0xf6, 0x62, 0x7b, 0x29, 0x21, 0x8a,
-	0x2a, 0xf5, 0xa1, 0x52, 0xdf, 0x2a, 0xf5, 0xa1, 0xef, 0x95, 0xfa, 0x52, 0x55, 0x7d, 0x6c, 0xab,
-	0xaa, 0x55, 0xdf, 0x5a, 0xaa, 0x4a, 0x15, 0x12, 0x2f, 0x7d, 0xaa, 0x2a, 0xe8, 0x1f, 0xd0, 0x3f,
-	0xa1, 0xf2, 0xcc, 0xd9, 0x8d, 0x3d, 0xeb, 0xb1, 0xbd, 0x29, 0xbc, 0x79, 0x67, 0xe6, 0x9c, 0xf3,
-	0x7d, 0x33, 0xdf, 0x9c, 0x39, 0x47, 0x0b, 0xaa, 0xf7, 0xb6, 0xe9, 0x79, 0xbe, 0xeb, 0xd8, 0xe6,
-	0xba, 0x56, 0x71, 0x36, 0x6a, 0x96, 0xa9, 0xdb, 0x15, 0xaa, 0x3d, 0xac, 0x53, 0x77, 0xb3, 0x58,
-	0x73, 0x1d, 0xdf, 0x21, 0x87, 0x42, 0x6b, 0x8a, 0x3b, 0x6b, 0xf2, 0x43, 0x55, 0xa7, 0xea, 0xb0,
-	0x25, 0x5a, 0xf0, 0xc5, 0x57, 0xe7, 0x8f, 0x54, 0x1d, 0xa7, 0x6a, 0x51, 0x4d, 0xaf, 0x99, 0x9a,
-	0x6e, 0xdb, 0x8e, 0xaf, 0xfb, 0xa6, 0x63, 0x7b, 0x38, 0x7b, 0xa6, 0xe2, 0x78, 0x1b, 0x8e, 0xa7,
-	0xad, 0xea, 0x1e, 0x06, 0xd1, 0x1e, 0x4d, 0xac, 0x52, 0x5f, 0x9f, 0xd0, 0x6a, 0x7a, 0xd5, 0xb4,
-	0xd9, 0x62, 0x5c, 0x7b, 0x5c, 0x82, 0xad, 0xa6, 0xbb, 0xfa, 0x46, 0xc3, 0xe1, 0x49, 0xc9, 0x22,
-	0x6a, 0xfb, 0xa6, 0x6f, 0x52, 0x5c, 0xa6, 0x0e, 0x01, 0xf9, 0x5f, 0x10, 0x6d, 0x99, 0xd9, 0x96,
-	0xe9, 0xc3, 0x3a, 0xf5, 0x7c, 0xf5, 0x0e, 0x1c, 0x8c, 0x8c, 0x7a, 0x35, 0xc7, 0xf6, 0x28, 0xb9,
-	0x02, 0x3d, 0x3c, 0x46, 0x4e, 0x19, 0x51, 0xc6, 0xfa, 0x27, 0x0b, 0xc5, 0xf8, 0x1d, 0x28, 0x72,
-	0xbb, 0xb9, 0xee, 0xa7, 0xbf, 0x0e, 0x77, 0x94, 0xd1, 0x46, 0xbd, 0x09, 0x7f, 0x67, 0x4e, 0xff,
-	0x5b, 0xa3, 0xae, 0xee, 0x3b, 0xee, 0x02, 0xf5, 0x75, 0xd3, 0x6a, 0xc4, 0x24, 0x63, 0x30, 0xe8,
-	0xe0, 0xcc, 0xac, 0x61, 0xb8, 0xd4, 0xe3, 0x51, 0xfa, 0xca, 0xe2, 0xb0, 0xaa, 0xc3, 0x91, 0x78,
-	0x47, 0x08, 0x73, 0x16, 0x7a, 0x0d, 0x3e, 0x84, 0x38, 0x47, 0x65, 0x38, 0x45, 0x0f, 0x0d, 0x3b,
-	0xd5, 0x86, 0x3c, 0x0b, 0x81, 0x21, 0x05, 0xa8, 0x39, 0xe8, 0xd5, 0x23, 0x10, 0x1b, 0x3f, 0xc9,
-	0x05, 0x38, 0xe4, 0xd8, 0xd6, 0xe6, 0x1b, 0xa6, 0xbf, 0xb6, 0xf8, 0xd8, 0xf4, 0x7c, 0xd3, 0xae,
-	0x96, 0x3c, 0xaf, 0x4e, 0xdd, 0x5c, 0xe7, 0x88, 0x32, 0xb6, 0xb7, 0x2c, 0x99, 0x55, 0xdf, 0xc4,
-	0xbd, 0x11, 0xe3, 0x21, 0xa3, 0x19, 0xe8, 0x36, 0x74, 0x5f, 0x47, 0x3a, 0xa7, 0x64, 0x74, 0x04,
-	0x6b, 0x66, 0xa3, 0x3e, 0xc0, 0xdd, 0xc2, 0x49, 0x2a, 0x92, 0xb9, 0x01, 0xb0, 0xa3, 0xb0, 0x66,
-	0x04, 0x2e, 0xc7, 0x62, 0x20, 0xc7, 0x22, 0xd7, 0x3c, 0xca, 0xb1, 0xb8, 0xac, 0x57, 0x29, 0xda,
-	0x96, 0x43, 0x96, 0xea, 0x47, 0x5d, 0x70, 0x54, 0x12, 0x08, 0x59, 0xd8, 0xd0, 0xa7, 0x37, 0xe6,
-	0x72, 0xca, 0x48, 0xd7, 0x58, 0xff, 0xe4, 0x6d, 0x19, 0x95, 0x44, 0x4f, 0xc5, 0xff, 0x50, 0xb7,
-	0x4a, 0x8d, 0x28, 0x5d, 0x54, 0xdb, 0x4e, 0x08, 0x72, 0x33, 0xc2, 0xac, 0x13, 0xa5, 0x90, 0xc6,
-	0x8c, 0x87, 0x08, 0x53, 0xcb, 0x7f, 0xa3, 0xc0, 0x50, 0x5c, 0xc8, 0x04, 0x21, 0x0c, 0x43, 0xbf,
-	0xe9, 0xad, 0x3c, 0xa2, 0xae, 0xf9, 0xc0, 0xa4, 0x06, 0x9e, 0x3e, 0x98, 0xde, 0x5d, 0x1c, 0x21,
-	0x47, 0x01, 0x4c, 0x6f, 0xc5, 0xa5, 0x8f, 0x9c, 0x75, 0x6a, 0xe4, 0xba, 0xd8, 0x7c, 0x9f, 0xe9,
-	0x95, 0xf9, 0x00, 0xb9, 0x0d, 0xfb, 0xb9, 0x71, 0x85, 0xa7, 0x89, 0x5c, 0x37, 0xdb, 0xaf, 0x13,
-	0xb2, 0xfd, 0xba, 0x1b, 0x5a, 0x5c, 0x8e, 0x9a, 0xaa, 0xb3, 0x70, 0x98, 0x6d, 0x27, 0xd7, 0x9a,
-	0x70, 0xfc, 0x27, 0x60, 0xbf, 0xc9, 0xc6, 0xa3, 0x97, 0x2e, 0x3a, 0xa8, 0xde, 0xc7, 0xfb, 0x20,
-	0xb8, 0xc0, 0x83, 0xbd, 0x26, 0x5e, 0xb8, 0x93, 0x32, 0x98, 0x51, 0xfb, 0xe6, 0x75, 0x33, 0x22,
-	0xee, 0x5f, 0x97, 0x42, 0x3f, 0xe9, 0xc2, 0x5b, 0x26, 0x86, 0x41, 0x1a, 0x55, 0xe8, 0xe5, 0xac,
-	0x1b, 0xea, 0xbc, 0x99, 0xa8, 0xce, 0x78, 0x2f, 0xa8, 0xcd, 0x08, 0x51, 0x94, 0x66, 0xc3, 0xfb,
-	0xab, 0x13, 0xe6, 0x73, 0x05, 0x0e, 0xc6, 0xc4, 0xcb, 0x76, 0xa8, 0x84, 0x40, 0xb7, 0xad, 0x6f,
-	0x50, 0x06, 0xa0, 0xaf, 0xcc, 0xbe, 0xc9, 0x08, 0xf4, 0x1b, 0xd4, 0xab, 0xb8, 0x66, 0x8d, 0x61,
-	0xeb, 0x62, 0x53, 0xe1, 0x21, 0x72, 0x00, 0xba, 0xea, 0xae, 0x95, 0xeb, 0x66, 0x33, 0xc1, 0x67,
-	0xe0, 0xc7, 0x72, 0xaa, 0x4e, 0x6e, 0x0f, 0xf7, 0x13, 0x7c, 0x07, 0x7e, 0x2c, 0x5a, 0xd5, 0xad,
-	0xc5, 0xe0, 0xb9, 0xd9, 0xcc, 0xf5, 0x70, 0x3f, 0xa1, 0xa1, 0xe0, 0xee, 0x54, 0x5c, 0x1a, 0x64,
-	0xdf, 0x5c, 0x2f, 0xbf, 0x3b, 0xf8, 0x53, 0x2d, 0xc1, 0x30, 0xdb, 0xe0, 0xb0, 0xa6, 0x05, 0x49,
-	0x9c, 0x82, 0x81, 0xb0, 0xc6, 0x4b, 0x0b, 0xc8, 0x50, 0x18, 0x55, 0x4d, 0x18, 0x91, 0xbb, 0xc2,
-	0x63, 0x5f, 0x14, 0xd5, 0x3b, 0x9e, 0xe5, 0x92, 0xb5, 0x68, 0xf8, 0xad, 0x98, 0x50, 0xaf, 0x4b,
-	0xc9, 0x3f, 0x29, 0x70, 0x2c, 0x21, 0x18, 0x12, 0xbb, 0x2f, 0xe6, 0x10, 0xae, 0xea, 0x09, 0x19,
-	0x3d, 0xae, 0xa4, 0x18, 0x92, 0xa8, 0xdf, 0xa8, 0xb7, 0x57, 0xa6, 0x62, 0xb5, 0x80, 0x2f, 0x54,
-	0xa0, 0xe1, 0x00, 0xc8, 0xff, 0x5d, 0x4a, 0xcb, 0x8e, 0xe3, 0x37, 0xaa, 0x91, 0x29, 0x7c, 0x58,
-	0x5a, 0xe7, 0x91, 0x28, 0x81, 0x6e, 0xd7, 0x71, 0x7c, 0xb6, 0xa1, 0xfb, 0xca, 0xec, 0x5b, 0x1d,
-	0x81, 0x02, 0x33, 0x0a, 0x12, 0x2a, 0x47, 0x2c, 0xba, 0x3d, 0x8f, 0x32, 0x8b, 0x5b, 0x91, 0xe0,
-	0x78, 0x3e, 0x94, 0x4d, 0x03, 0x34, 0xcb, 0xae, 0xe3, 0x3c, 0x08, 0xe9, 0xb2, 0xe2, 0x52, 0x23,
-	0x28, 0xb2, 0x74, 0xeb, 0x96, 0xee, 0xad, 0xa1, 0xa9, 0x30, 0xaa, 0x5e, 0x0f, 0x25, 0xbc, 0x90,
-	0x13, 0x0c, 0xab, 0xc2, 0x3e, 0x6a, 0x57, 0x1c, 0x83, 0x1a, 0x6c, 0x1c, 0x7d, 0x44, 0xc6, 0xd4,
-	0x45, 0xcc, 0x65, 0x3b, 0xe8, 0x77, 0x05, 0x64, 0x0e, 0xf7, 0xbe, 0xc5, 0x4d, 0x1b, 0x50, 0xae,
-	0xc1, 0x71, 0xfe, 0x5c, 0xfb, 0xbe, 0x5e, 0x59, 0xa3, 0xc6, 0x2d, 0xc7, 0x32, 0xa8, 0xbb, 0x5c,
-	0x5f, 0xb5, 0xcc, 0xca, 0x12, 0xdd, 0x4c, 0xad, 0x9a, 0xd4, 0xab, 0x70, 0x22, 0xd9, 0x01, 0x82,
-	0x39, 0x04, 0x3d, 0xb5, 0xfa, 0xea, 0x12, 0xdd, 0x44, 0x18, 0xf8, 0x4b, 0xad, 0xe0, 0x49, 0x96,
-	0xbc, 0xf9, 0x26, 0xbb, 0x92, 0x7d, 0x6f, 0xe9, 0xce, 0x42, 0x29, 0xbd, 0x64, 0x6b, 0x4d, 0x25,
-	0x9d, 0x7c, 0xa7, 0x84, 0x54, 0x72, 0x15, 0xef, 0x77, 0x6c, 0x10, 0x04, 0x98, 0x87, 0xbd, 0xa6,
-	0x5d, 0xb1, 0xea, 0x06, 0x35, 0x58, 0x98, 0xbd, 0xe5, 0xe6, 0x6f, 0x75, 0x01, 0x8f, 0x7c, 0x3e,
-	0x72, 0x00, 0xb2, 0x84, 0x66, 0x34, 0xce, 0x2b, 0x3a, 0xda, 0x3c, 0x76, 0xd1, 0x0b, 0x02, 0xc8,
-	0x7a, 0xec, 0xff, 0x06, 0x95, 0xb9, 0xe1, 0x3b, 0x3d, 0x17, 0xc9, 0x23, 0x25, 0x23, 0x19, 0x54,
-	0x5f, 0x0b, 0xa8, 0x86, 0x00, 0x64, 0xde, 0x10, 0x9c, 0x5c, 0x00, 0xef, 0xc0, 0x38, 0x17, 0x80,
-	0x65, 0xc5, 0xa5, 0x9f, 0x46, 0x21, 0xf8, 0xfa, 0xea, 0xef, 0x2d, 0xf8, 0x47, 0x36, 0x00, 0x48,
-	0x65, 0x29, 0xfc, 0x66, 0xec, 0x2e, 0xa9, 0x36, 0x5f, 0x8e, 0xc9, 0xf7, 0x0e, 0xc3, 0x1e, 0x16,
-	0x9d, 0xbc, 0xaf, 0x40, 0x0f, 0xef, 0x9d, 0xc8, 0x99, 0xc4, 0xda, 0x23, 0xd2, 0xae, 0xe5, 0xc7,
-	0x33, 0xad, 0xe5, 0xd0, 0xd5, 0x53, 0xef, 0x3e, 0xff, 0xfd, 0xc3, 0xce, 0x11, 0x52, 0xd0, 0x12,
-	0xdb, 0x48, 0xf2, 0x95, 0x02, 0x83, 0x42, 0x7f, 0x44, 0xa6, 0x12, 0x03, 0xc5, 0x37, 0x76, 0xf9,
-	0x73, 0xed, 0x19, 0x21, 0xcc, 0x19, 0x06, 0xf3, 0x1c, 0x99, 0x94, 0xc1, 0x6c, 0x74, 0x85, 0xda,
-	0x96, 0xd0, 0x1f, 0x6e, 0x93, 0xcf, 0x14, 0x18, 0x10, 0x2a, 0xf5, 0xc9, 0x2c, 0x8d, 0x86, 0x00,
-	0x7c, 0xaa, 0x2d, 0x1b, 0xc4, 0x3d, 0xc1, 0x70, 0x8f, 0x93, 0xd3, 0x32, 0xdc, 0x28, 0x55, 0x6d,
-	0x4b, 0x6f, 0xc0, 0xfd, 0x54, 0x81, 0x03, 0x62, 0xab, 0x43, 0xce, 0xb5, 0xd9, 0x19, 0x71, 0xc8,
-	0xe7, 0x77, 0xd5, 0x4f, 0xa9, 0xa7, 0x19, 0xe8, 0xe3, 0xe4, 0x58, 0x0a, 0x68, 0xea, 0x91, 0xcf,
-	0x15, 0xd8, 0x1f, 0x2d, 0x36, 0x27, 0x32, 0x54, 0xc9, 0x02, 0xcc, 0xc9, 0x76, 0x4c, 0x10, 0xe3,
-	0x05, 0x86, 0xf1, 0x9f, 0xa4, 0x28, 0xc3, 0xc8, 0x8b, 0x5b, 0x6d, 0x2b, 0x52, 0xe4, 0x6e, 0x93,
-	0x8f, 0x15, 0x18, 0x88, 0x96, 0xea, 0x64, 0xb2, 0xad, 0xba, 0x3e, 0x8b, 0x18, 0xe2, 0x7b, 0x01,
-	0x75, 0x94, 0x61, 0x3e, 0x46, 0x86, 0x93, 0x31, 0x7b, 0xe4, 0x07, 0x05, 0x0e, 0xc6, 0xe4, 0x08,
-	0x32, 0x9d, 0x18, 0x55, 0x5e, 0x20, 0xe7, 0x2f, 0xb6, 0x6f, 0x88, 0x98, 0xff, 0xc5, 0x30, 0x4f,
-	0x93, 0xf3, 0x32, 0xcc, 0xe1, 0xe4, 0xaf, 0x6d, 0x45, 0x5f, 0xc9, 0x6d, 0xf2, 0x87, 0x02, 0xc3,
-	0x29, 0x59, 0x94, 0xcc, 0x27, 0xab, 0x34, 0xd3, 0x23, 0x90, 0x5f, 0xf8, 0x6b, 0x4e, 0x90, 0xed,
-	0x1c, 0x63, 0x7b, 0x85, 0xcc, 0x64, 0x61, 0xeb, 0xad, 0xac, 0x6e, 0xae, 0xb4, 0xde, 0xdf, 0xaf,
-	0x15, 0x18, 0x8a, 0x2b, 0xc4, 0x49, 0xf6, 0x43, 0x10, 0xd5, 0x76, 0x69, 0x17, 0x96, 0xc8, 0xe8,
-	0x2c, 0x63, 0x34, 0x4a, 0x4e, 0x66, 0x62, 0x14, 0xdc, 0xe7, 0x03, 0x62, 0x61, 0x9d, 0x92, 0x7c,
-	0x24, 0x75, 0x7a, 0x4a, 0xf2, 0x91, 0x55, 0xef, 0xe9, 0x80, 0x4d, 0xb4, 0xd4, 0x82, 0xfa, 0x9b,
-	0x7c, 0x89, 0x09, 0xa8, 0x59, 0x36, 0x67, 0x48, 0x40, 0x62, 0x9d, 0x9e, 0x21, 0x01, 0xb5, 0x54,
-	0xe5, 0xea, 0x35, 0x86, 0xf3, 0x12, 0x99, 0x4e, 0xc5, 0x59, 0x0b, 0xec, 0xb4, 0xad, 0x68, 0xcd,
-	0xb5, 0x4d, 0xbe, 0x50, 0x80, 0xb4, 0x36, 0x1b, 0xe4, 0x42, 0x22, 0x16, 0x69, 0xff, 0x92, 0x9f,
-	0x6e, 0xdb, 0x0e, 0x89, 0x68, 0x8c, 0xc8, 0x69, 0x32, 0x2a, 0x23, 0xe2, 0x36, 0x6d, 0xf9, 0x96,
-	0x7f, 0xa7, 0xc0, 0xa0, 0xd0, 0x20, 0xa4, 0x94, 0x02, 0xf1, 0x5d, 0x49, 0x4a, 0x29, 0x20, 0xe9,
-	0x41, 0xd4, 0x59, 0x86, 0xf7, 0x32, 0xb9, 0x94, 0x01, 0xaf, 0x64, 0xeb, 0x7f, 0x54, 0xe0, 0x6f,
-	0x92, 0xee, 0x82, 0x5c, 0x4e, 0x4e, 0x24, 0x89, 0x4d, 0x4d, 0xfe, 0xca, 0xee, 0x8c, 0x91, 0xd9,
-	0x14, 0x63, 0x76, 0x96, 0x8c, 0x4b, 0x6b, 0xb1, 0x86, 0x49, 0x28, 0xdd, 0xfc, 0xac, 0xc0, 0x60,
-	0xc9, 0xbb, 0x53, 0x37, 0x7d, 0x7d, 0xd5, 0xa2, 0x37, 0x1c, 0xf7, 0xde, 0x52, 0xca, 0x3b, 0x21,
-	0xef, 0x8b, 0x52, 0xde, 0x89, 0x84, 0x5e, 0x47, 0xbd, 0xc5, 0xb0, 0xcf, 0x91, 0xeb, 0x32, 0xec,
-	0x4f, 0xd6, 0x35, 0xb3, 0x09, 0x73, 0x07, 0x7f, 0xeb, 0x93, 0xf1, 0xad, 0x02, 0x03, 0xd1, 0x7e,
-	0x26, 0xe5, 0x85, 0x8e, 0x6d, 0xa1, 0x52, 0x5e, 0xe8, 0xf8, 0x86, 0x29, 0x3d, 0xff, 0x3f, 0x59,
-	0xd7, 0xa2, 0x62, 0x12, 0xf0, 0x1b, 0xdb, 0xe4, 0x7b, 0x05, 0x48, 0x38, 0x25, 0xf3, 0xd3, 0x26,
-	0x33, 0x89, 0x78, 0x12, 0x3b, 0xaf, 0xfc, 0xe5, 0x5d, 0xd9, 0x22, 0xa7, 0x69, 0xc6, 0x69, 0x82,
-	0x68, 0x32, 0x4e, 0x6b, 0xcc, 0xbe, 0x85, 0xc8, 0xdc, 0xc5, 0xa7, 0x2f, 0x0a, 0xca, 0xb3, 0x17,
-	0x05, 0xe5, 0xb7, 0x17, 0x05, 0xe5, 0x83, 0x97, 0x85, 0x8e, 0x67, 0x2f, 0x0b, 0x1d, 0xbf, 0xbc,
-	0x2c, 0x74, 0xdc, 0x2b, 0x84, 0x3d, 0x3d, 0x0e, 0xfb, 0xf2, 0x37, 0x6b, 0xd4, 0x5b, 0xed, 0x61,
-	0xff, 0x26, 0x4d, 0xfd, 0x19, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x5a, 0x06, 0xce, 0x37, 0x1b, 0x00,
-	0x00,
+func (m *QueryVerificationsExpiringBetweenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerificationsExpiringBetweenRequest) ProtoMessage()    {}
+func (*QueryVerificationsExpiringBetweenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{38}
+}
+func (m *QueryVerificationsExpiringBetweenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerificationsExpiringBetweenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerificationsExpiringBetweenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerificationsExpiringBetweenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerificationsExpiringBetweenRequest.Merge(m, src)
+}
+func (m *QueryVerificationsExpiringBetweenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerificationsExpiringBetweenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerificationsExpiringBetweenRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryVerificationsExpiringBetweenRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	OperatorDetails(ctx context.Context, in *QueryOperatorDetailsRequest, opts ...grpc.CallOption) (*QueryOperatorDetailsResponse, error)
-	AddressDetails(ctx context.Context, in *QueryAddressDetailsRequest, opts ...grpc.CallOption) (*QueryAddressDetailsResponse, error)
-	AddressesDetails(ctx context.Context, in *QueryAddressesDetailsRequest, opts ...grpc.CallOption) (*QueryAddressesDetailsResponse, error)
-	IssuerDetails(ctx context.Context, in *QueryIssuerDetailsRequest, opts ...grpc.CallOption) (*QueryIssuerDetailsResponse, error)
-	IssuersDetails(ctx context.Context, in *QueryIssuersDetailsRequest, opts ...grpc.CallOption) (*QueryIssuersDetailsResponse, error)
-	VerificationDetails(ctx context.Context, in *QueryVerificationDetailsRequest, opts ...grpc.CallOption) (*QueryVerificationDetailsResponse, error)
-	AllVerificationDetailsByAddress(ctx context.Context, in *QueryAllVerificationDetailsByAddressRequest, opts ...grpc.CallOption) (*QueryAllVerificationDetailsByAddressResponse, error)
-	VerificationsDetails(ctx context.Context, in *QueryVerificationsDetailsRequest, opts ...grpc.CallOption) (*QueryVerificationsDetailsResponse, error)
-	IssuanceTreeRoot(ctx context.Context, in *QueryIssuanceTreeRootRequest, opts ...grpc.CallOption) (*QueryIssuanceTreeRootResponse, error)
-	IssuanceProof(ctx context.Context, in *QueryIssuanceProofRequest, opts ...grpc.CallOption) (*QueryIssuanceProofResponse, error)
-	RevocationTreeRoot(ctx context.Context, in *QueryRevocationTreeRootRequest, opts ...grpc.CallOption) (*QueryRevocationTreeRootResponse, error)
-	RevocationProof(ctx context.Context, in *QueryRevocationProofRequest, opts ...grpc.CallOption) (*QueryRevocationProofResponse, error)
-	AttachedHolderPublicKey(ctx context.Context, in *QueryAttachedHolderPublicKeyRequest, opts ...grpc.CallOption) (*QueryAttachedHolderPublicKeyResponse, error)
-	IsSuitableForZK(ctx context.Context, in *QueryIsCredentialInZKSDIRequest, opts ...grpc.CallOption) (*QueryIsCredentialInZKSDIResponse, error)
-	CredentialHash(ctx context.Context, in *QueryCredentialHashRequest, opts ...grpc.CallOption) (*QueryCredentialHashResponse, error)
-	VerificationHolder(ctx context.Context, in *QueryHolderByVerificationIdRequest, opts ...grpc.CallOption) (*QueryHolderByVerificationIdResponse, error)
+func (m *QueryVerificationsExpiringBetweenRequest) GetStartTimestamp() uint32 {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryVerificationsExpiringBetweenRequest) GetEndTimestamp() uint32 {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
-}
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerificationsExpiringBetweenRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OperatorDetails(ctx context.Context, in *QueryOperatorDetailsRequest, opts ...grpc.CallOption) (*QueryOperatorDetailsResponse, error) {
-	out := new(QueryOperatorDetailsResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/OperatorDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryVerificationsExpiringBetweenResponse is response type for the Query/VerificationsExpiringBetween RPC method.
+type QueryVerificationsExpiringBetweenResponse struct {
+	// verifications is a slice of verifications ordered by expiration timestamp
+	Verifications []QueryVerificationsExpiringBetweenResponse_ExpiringVerification `protobuf:"bytes,1,rep,name=verifications,proto3" json:"verifications"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) AddressDetails(ctx context.Context, in *QueryAddressDetailsRequest, opts ...grpc.CallOption) (*QueryAddressDetailsResponse, error) {
-	out := new(QueryAddressDetailsResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/AddressDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerificationsExpiringBetweenResponse) Reset() {
+	*m = QueryVerificationsExpiringBetweenResponse{}
+}
+func (m *QueryVerificationsExpiringBetweenResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryVerificationsExpiringBetweenResponse) ProtoMessage() {}
+func (*QueryVerificationsExpiringBetweenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{39}
+}
+func (m *QueryVerificationsExpiringBetweenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerificationsExpiringBetweenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerificationsExpiringBetweenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVerificationsExpiringBetweenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerificationsExpiringBetweenResponse.Merge(m, src)
+}
+func (m *QueryVerificationsExpiringBetweenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerificationsExpiringBetweenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerificationsExpiringBetweenResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AddressesDetails(ctx context.Context, in *QueryAddressesDetailsRequest, opts ...grpc.CallOption) (*QueryAddressesDetailsResponse, error) {
-	out := new(QueryAddressesDetailsResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/AddressesDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerificationsExpiringBetweenResponse proto.InternalMessageInfo
+
+func (m *QueryVerificationsExpiringBetweenResponse) GetVerifications() []QueryVerificationsExpiringBetweenResponse_ExpiringVerification {
+	if m != nil {
+		return m.Verifications
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) IssuerDetails(ctx context.Context, in *QueryIssuerDetailsRequest, opts ...grpc.CallOption) (*QueryIssuerDetailsResponse, error) {
-	out := new(QueryIssuerDetailsResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/IssuerDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerificationsExpiringBetweenResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) IssuersDetails(ctx context.Context, in *QueryIssuersDetailsRequest, opts ...grpc.CallOption) (*QueryIssuersDetailsResponse, error) {
-	out := new(QueryIssuersDetailsResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/IssuersDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryVerificationsExpiringBetweenResponse_ExpiringVerification struct {
+	// holder is an address of verification holder
+	Holder  string                     `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Details *MergedVerificationDetails `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
 }
 
-func (c *queryClient) VerificationDetails(ctx context.Context, in *QueryVerificationDetailsRequest, opts ...grpc.CallOption) (*QueryVerificationDetailsResponse, error) {
-	out := new(QueryVerificationDetailsResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/VerificationDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) Reset() {
+	*m = QueryVerificationsExpiringBetweenResponse_ExpiringVerification{}
+}
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryVerificationsExpiringBetweenResponse_ExpiringVerification) ProtoMessage() {}
+func (*QueryVerificationsExpiringBetweenResponse_ExpiringVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{39, 0}
+}
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerificationsExpiringBetweenResponse_ExpiringVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerificationsExpiringBetweenResponse_ExpiringVerification.Merge(m, src)
+}
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerificationsExpiringBetweenResponse_ExpiringVerification.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllVerificationDetailsByAddress(ctx context.Context, in *QueryAllVerificationDetailsByAddressRequest, opts ...grpc.CallOption) (*QueryAllVerificationDetailsByAddressResponse, error) {
-	out := new(QueryAllVerificationDetailsByAddressResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/AllVerificationDetailsByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerificationsExpiringBetweenResponse_ExpiringVerification proto.InternalMessageInfo
+
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) VerificationsDetails(ctx context.Context, in *QueryVerificationsDetailsRequest, opts ...grpc.CallOption) (*QueryVerificationsDetailsResponse, error) {
-	out := new(QueryVerificationsDetailsResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/VerificationsDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) GetDetails() *MergedVerificationDetails {
+	if m != nil {
+		return m.Details
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) IssuanceTreeRoot(ctx context.Context, in *QueryIssuanceTreeRootRequest, opts ...grpc.CallOption) (*QueryIssuanceTreeRootResponse, error) {
-	out := new(QueryIssuanceTreeRootResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/IssuanceTreeRoot", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryVerificationsByIssuerRequest is request type for the Query/VerificationsByIssuer RPC method.
+// At least one of issuerAddress or verificationType should be provided.
+type QueryVerificationsByIssuerRequest struct {
+	// issuerAddress is an address of issuer. Previous addresses of migrated issuer are resolved to the actual one
+	IssuerAddress string `protobuf:"bytes,1,opt,name=issuerAddress,proto3" json:"issuerAddress,omitempty"`
+	// verificationType filters verifications by type. VT_UNSPECIFIED matches verifications of any type
+	VerificationType VerificationType `protobuf:"varint,2,opt,name=verificationType,proto3,enum=swisstronik.compliance.VerificationType" json:"verificationType,omitempty"`
+	// revocationStatus filters verifications by revocation status
+	RevocationStatus RevocationStatusFilter `protobuf:"varint,3,opt,name=revocationStatus,proto3,enum=swisstronik.compliance.RevocationStatusFilter" json:"revocationStatus,omitempty"`
+	// expiresAfter is an inclusive lower bound of expiration timestamp.
+	// Verifications without expiration timestamp are treated as never expiring
+	ExpiresAfter uint32 `protobuf:"varint,4,opt,name=expiresAfter,proto3" json:"expiresAfter,omitempty"`
+	// expiresBefore is an inclusive upper bound of expiration timestamp. Zero value means no upper bound,
+	// otherwise verifications without expiration timestamp are filtered out
+	ExpiresBefore uint32 `protobuf:"varint,5,opt,name=expiresBefore,proto3" json:"expiresBefore,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) IssuanceProof(ctx context.Context, in *QueryIssuanceProofRequest, opts ...grpc.CallOption) (*QueryIssuanceProofResponse, error) {
-	out := new(QueryIssuanceProofResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/IssuanceProof", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerificationsByIssuerRequest) Reset()         { *m = QueryVerificationsByIssuerRequest{} }
+func (m *QueryVerificationsByIssuerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerificationsByIssuerRequest) ProtoMessage()    {}
+func (*QueryVerificationsByIssuerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{40}
+}
+func (m *QueryVerificationsByIssuerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerificationsByIssuerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerificationsByIssuerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVerificationsByIssuerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerificationsByIssuerRequest.Merge(m, src)
+}
+func (m *QueryVerificationsByIssuerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerificationsByIssuerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerificationsByIssuerRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) RevocationTreeRoot(ctx context.Context, in *QueryRevocationTreeRootRequest, opts ...grpc.CallOption) (*QueryRevocationTreeRootResponse, error) {
-	out := new(QueryRevocationTreeRootResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/RevocationTreeRoot", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerificationsByIssuerRequest proto.InternalMessageInfo
+
+func (m *QueryVerificationsByIssuerRequest) GetIssuerAddress() string {
+	if m != nil {
+		return m.IssuerAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) RevocationProof(ctx context.Context, in *QueryRevocationProofRequest, opts ...grpc.CallOption) (*QueryRevocationProofResponse, error) {
-	out := new(QueryRevocationProofResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/RevocationProof", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerificationsByIssuerRequest) GetVerificationType() VerificationType {
+	if m != nil {
+		return m.VerificationType
 	}
-	return out, nil
+	return VerificationType_VT_UNSPECIFIED
 }
 
-func (c *queryClient) AttachedHolderPublicKey(ctx context.Context, in *QueryAttachedHolderPublicKeyRequest, opts ...grpc.CallOption) (*QueryAttachedHolderPublicKeyResponse, error) {
-	out := new(QueryAttachedHolderPublicKeyResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/AttachedHolderPublicKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerificationsByIssuerRequest) GetRevocationStatus() RevocationStatusFilter {
+	if m != nil {
+		return m.RevocationStatus
 	}
-	return out, nil
+	return RevocationStatusFilter_RSF_ANY
 }
 
-func (c *queryClient) IsSuitableForZK(ctx context.Context, in *QueryIsCredentialInZKSDIRequest, opts ...grpc.CallOption) (*QueryIsCredentialInZKSDIResponse, error) {
-	out := new(QueryIsCredentialInZKSDIResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/IsSuitableForZK", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerificationsByIssuerRequest) GetExpiresAfter() uint32 {
+	if m != nil {
+		return m.ExpiresAfter
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) CredentialHash(ctx context.Context, in *QueryCredentialHashRequest, opts ...grpc.CallOption) (*QueryCredentialHashResponse, error) {
-	out := new(QueryCredentialHashResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/CredentialHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerificationsByIssuerRequest) GetExpiresBefore() uint32 {
+	if m != nil {
+		return m.ExpiresBefore
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) VerificationHolder(ctx context.Context, in *QueryHolderByVerificationIdRequest, opts ...grpc.CallOption) (*QueryHolderByVerificationIdResponse, error) {
-	out := new(QueryHolderByVerificationIdResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/VerificationHolder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVerificationsByIssuerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	OperatorDetails(context.Context, *QueryOperatorDetailsRequest) (*QueryOperatorDetailsResponse, error)
-	AddressDetails(context.Context, *QueryAddressDetailsRequest) (*QueryAddressDetailsResponse, error)
-	AddressesDetails(context.Context, *QueryAddressesDetailsRequest) (*QueryAddressesDetailsResponse, error)
-	IssuerDetails(context.Context, *QueryIssuerDetailsRequest) (*QueryIssuerDetailsResponse, error)
-	IssuersDetails(context.Context, *QueryIssuersDetailsRequest) (*QueryIssuersDetailsResponse, error)
-	VerificationDetails(context.Context, *QueryVerificationDetailsRequest) (*QueryVerificationDetailsResponse, error)
-	AllVerificationDetailsByAddress(context.Context, *QueryAllVerificationDetailsByAddressRequest) (*QueryAllVerificationDetailsByAddressResponse, error)
-	VerificationsDetails(context.Context, *QueryVerificationsDetailsRequest) (*QueryVerificationsDetailsResponse, error)
-	IssuanceTreeRoot(context.Context, *QueryIssuanceTreeRootRequest) (*QueryIssuanceTreeRootResponse, error)
-	IssuanceProof(context.Context, *QueryIssuanceProofRequest) (*QueryIssuanceProofResponse, error)
-	RevocationTreeRoot(context.Context, *QueryRevocationTreeRootRequest) (*QueryRevocationTreeRootResponse, error)
-	RevocationProof(context.Context, *QueryRevocationProofRequest) (*QueryRevocationProofResponse, error)
-	AttachedHolderPublicKey(context.Context, *QueryAttachedHolderPublicKeyRequest) (*QueryAttachedHolderPublicKeyResponse, error)
-	IsSuitableForZK(context.Context, *QueryIsCredentialInZKSDIRequest) (*QueryIsCredentialInZKSDIResponse, error)
-	CredentialHash(context.Context, *QueryCredentialHashRequest) (*QueryCredentialHashResponse, error)
-	VerificationHolder(context.Context, *QueryHolderByVerificationIdRequest) (*QueryHolderByVerificationIdResponse, error)
+// QueryVerificationsByIssuerResponse is response type for the Query/VerificationsByIssuer RPC method.
+type QueryVerificationsByIssuerResponse struct {
+	// verifications is a slice of verifications ordered by verification type and id
+	Verifications []QueryVerificationsByIssuerResponse_IssuedVerification `protobuf:"bytes,1,rep,name=verifications,proto3" json:"verifications"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryVerificationsByIssuerResponse) Reset()         { *m = QueryVerificationsByIssuerResponse{} }
+func (m *QueryVerificationsByIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerificationsByIssuerResponse) ProtoMessage()    {}
+func (*QueryVerificationsByIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{41}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryVerificationsByIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) OperatorDetails(ctx context.Context, req *QueryOperatorDetailsRequest) (*QueryOperatorDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorDetails not implemented")
+func (m *QueryVerificationsByIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerificationsByIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AddressDetails(ctx context.Context, req *QueryAddressDetailsRequest) (*QueryAddressDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddressDetails not implemented")
+func (m *QueryVerificationsByIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerificationsByIssuerResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AddressesDetails(ctx context.Context, req *QueryAddressesDetailsRequest) (*QueryAddressesDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddressesDetails not implemented")
+func (m *QueryVerificationsByIssuerResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) IssuerDetails(ctx context.Context, req *QueryIssuerDetailsRequest) (*QueryIssuerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IssuerDetails not implemented")
+func (m *QueryVerificationsByIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerificationsByIssuerResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) IssuersDetails(ctx context.Context, req *QueryIssuersDetailsRequest) (*QueryIssuersDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IssuersDetails not implemented")
+
+var xxx_messageInfo_QueryVerificationsByIssuerResponse proto.InternalMessageInfo
+
+func (m *QueryVerificationsByIssuerResponse) GetVerifications() []QueryVerificationsByIssuerResponse_IssuedVerification {
+	if m != nil {
+		return m.Verifications
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) VerificationDetails(ctx context.Context, req *QueryVerificationDetailsRequest) (*QueryVerificationDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerificationDetails not implemented")
+
+func (m *QueryVerificationsByIssuerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) AllVerificationDetailsByAddress(ctx context.Context, req *QueryAllVerificationDetailsByAddressRequest) (*QueryAllVerificationDetailsByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllVerificationDetailsByAddress not implemented")
+
+type QueryVerificationsByIssuerResponse_IssuedVerification struct {
+	// holder is an address of verification holder
+	Holder  string                     `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Details *MergedVerificationDetails `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
 }
-func (*UnimplementedQueryServer) VerificationsDetails(ctx context.Context, req *QueryVerificationsDetailsRequest) (*QueryVerificationsDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerificationsDetails not implemented")
+
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) Reset() {
+	*m = QueryVerificationsByIssuerResponse_IssuedVerification{}
 }
-func (*UnimplementedQueryServer) IssuanceTreeRoot(ctx context.Context, req *QueryIssuanceTreeRootRequest) (*QueryIssuanceTreeRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IssuanceTreeRoot not implemented")
-}
-func (*UnimplementedQueryServer) IssuanceProof(ctx context.Context, req *QueryIssuanceProofRequest) (*QueryIssuanceProofResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IssuanceProof not implemented")
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedQueryServer) RevocationTreeRoot(ctx context.Context, req *QueryRevocationTreeRootRequest) (*QueryRevocationTreeRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevocationTreeRoot not implemented")
+func (*QueryVerificationsByIssuerResponse_IssuedVerification) ProtoMessage() {}
+func (*QueryVerificationsByIssuerResponse_IssuedVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{41, 0}
 }
-func (*UnimplementedQueryServer) RevocationProof(ctx context.Context, req *QueryRevocationProofRequest) (*QueryRevocationProofResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevocationProof not implemented")
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AttachedHolderPublicKey(ctx context.Context, req *QueryAttachedHolderPublicKeyRequest) (*QueryAttachedHolderPublicKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttachedHolderPublicKey not implemented")
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerificationsByIssuerResponse_IssuedVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) IsSuitableForZK(ctx context.Context, req *QueryIsCredentialInZKSDIRequest) (*QueryIsCredentialInZKSDIResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsSuitableForZK not implemented")
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerificationsByIssuerResponse_IssuedVerification.Merge(m, src)
 }
-func (*UnimplementedQueryServer) CredentialHash(ctx context.Context, req *QueryCredentialHashRequest) (*QueryCredentialHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CredentialHash not implemented")
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) VerificationHolder(ctx context.Context, req *QueryHolderByVerificationIdRequest) (*QueryHolderByVerificationIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerificationHolder not implemented")
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerificationsByIssuerResponse_IssuedVerification.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryVerificationsByIssuerResponse_IssuedVerification proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_OperatorDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorDetailsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OperatorDetails(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/OperatorDetails",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OperatorDetails(ctx, req.(*QueryOperatorDetailsRequest))
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) GetDetails() *MergedVerificationDetails {
+	if m != nil {
+		return m.Details
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AddressDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddressDetailsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AddressDetails(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/AddressDetails",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AddressDetails(ctx, req.(*QueryAddressDetailsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryIssuerAliasHistoryRequest is request type for the Query/IssuerAliasHistory RPC method.
+type QueryIssuerAliasHistoryRequest struct {
+	// issuerAddress is current or any previous issuer address
+	IssuerAddress string `protobuf:"bytes,1,opt,name=issuerAddress,proto3" json:"issuerAddress,omitempty"`
 }
 
-func _Query_AddressesDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddressesDetailsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AddressesDetails(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/AddressesDetails",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AddressesDetails(ctx, req.(*QueryAddressesDetailsRequest))
+func (m *QueryIssuerAliasHistoryRequest) Reset()         { *m = QueryIssuerAliasHistoryRequest{} }
+func (m *QueryIssuerAliasHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuerAliasHistoryRequest) ProtoMessage()    {}
+func (*QueryIssuerAliasHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{42}
+}
+func (m *QueryIssuerAliasHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIssuerAliasHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuerAliasHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryIssuerAliasHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuerAliasHistoryRequest.Merge(m, src)
+}
+func (m *QueryIssuerAliasHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuerAliasHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuerAliasHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_IssuerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIssuerDetailsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IssuerDetails(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/IssuerDetails",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IssuerDetails(ctx, req.(*QueryIssuerDetailsRequest))
+var xxx_messageInfo_QueryIssuerAliasHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryIssuerAliasHistoryRequest) GetIssuerAddress() string {
+	if m != nil {
+		return m.IssuerAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_IssuersDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIssuersDetailsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IssuersDetails(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/IssuersDetails",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IssuersDetails(ctx, req.(*QueryIssuersDetailsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryIssuerAliasHistoryResponse is response type for the Query/IssuerAliasHistory RPC method.
+type QueryIssuerAliasHistoryResponse struct {
+	// current_address is an actual issuer address
+	CurrentAddress string `protobuf:"bytes,1,opt,name=current_address,json=currentAddress,proto3" json:"current_address,omitempty"`
+	// migrations is a list of issuer migrations, ordered from the oldest one
+	Migrations []IssuerMigration `protobuf:"bytes,2,rep,name=migrations,proto3" json:"migrations"`
 }
 
-func _Query_VerificationDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVerificationDetailsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VerificationDetails(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/VerificationDetails",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VerificationDetails(ctx, req.(*QueryVerificationDetailsRequest))
+func (m *QueryIssuerAliasHistoryResponse) Reset()         { *m = QueryIssuerAliasHistoryResponse{} }
+func (m *QueryIssuerAliasHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuerAliasHistoryResponse) ProtoMessage()    {}
+func (*QueryIssuerAliasHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{43}
+}
+func (m *QueryIssuerAliasHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIssuerAliasHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuerAliasHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryIssuerAliasHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuerAliasHistoryResponse.Merge(m, src)
+}
+func (m *QueryIssuerAliasHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuerAliasHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuerAliasHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_AllVerificationDetailsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllVerificationDetailsByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllVerificationDetailsByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/AllVerificationDetailsByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllVerificationDetailsByAddress(ctx, req.(*QueryAllVerificationDetailsByAddressRequest))
+var xxx_messageInfo_QueryIssuerAliasHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryIssuerAliasHistoryResponse) GetCurrentAddress() string {
+	if m != nil {
+		return m.CurrentAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_VerificationsDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVerificationsDetailsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VerificationsDetails(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/VerificationsDetails",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VerificationsDetails(ctx, req.(*QueryVerificationsDetailsRequest))
+func (m *QueryIssuerAliasHistoryResponse) GetMigrations() []IssuerMigration {
+	if m != nil {
+		return m.Migrations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_IssuanceTreeRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIssuanceTreeRootRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IssuanceTreeRoot(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/IssuanceTreeRoot",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IssuanceTreeRoot(ctx, req.(*QueryIssuanceTreeRootRequest))
+// QueryVerificationRequestsRequest is request type for the Query/VerificationRequests RPC method.
+type QueryVerificationRequestsRequest struct {
+	// issuerAddress is an address of requested issuer
+	IssuerAddress string `protobuf:"bytes,1,opt,name=issuerAddress,proto3" json:"issuerAddress,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVerificationRequestsRequest) Reset()         { *m = QueryVerificationRequestsRequest{} }
+func (m *QueryVerificationRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerificationRequestsRequest) ProtoMessage()    {}
+func (*QueryVerificationRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{44}
+}
+func (m *QueryVerificationRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerificationRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerificationRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVerificationRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerificationRequestsRequest.Merge(m, src)
+}
+func (m *QueryVerificationRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerificationRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerificationRequestsRequest.DiscardUnknown(m)
 }
 
-func _Query_IssuanceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIssuanceProofRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerificationRequestsRequest proto.InternalMessageInfo
+
+func (m *QueryVerificationRequestsRequest) GetIssuerAddress() string {
+	if m != nil {
+		return m.IssuerAddress
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).IssuanceProof(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/IssuanceProof",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IssuanceProof(ctx, req.(*QueryIssuanceProofRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_RevocationTreeRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRevocationTreeRootRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryVerificationRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RevocationTreeRoot(ctx, in)
+	return nil
+}
+
+// QueryVerificationRequestsResponse is response type for the Query/VerificationRequests RPC method.
+type QueryVerificationRequestsResponse struct {
+	// requests is a slice of pending requests ordered by verification type
+	Requests []VerificationRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVerificationRequestsResponse) Reset()         { *m = QueryVerificationRequestsResponse{} }
+func (m *QueryVerificationRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerificationRequestsResponse) ProtoMessage()    {}
+func (*QueryVerificationRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80d6bdaf4aa1245, []int{45}
+}
+func (m *QueryVerificationRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerificationRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerificationRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/RevocationTreeRoot",
+}
+func (m *QueryVerificationRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerificationRequestsResponse.Merge(m, src)
+}
+func (m *QueryVerificationRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerificationRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerificationRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerificationRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryVerificationRequestsResponse) GetRequests() []VerificationRequest {
+	if m != nil {
+		return m.Requests
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RevocationTreeRoot(ctx, req.(*QueryRevocationTreeRootRequest))
+	return nil
+}
+
+func (m *QueryVerificationRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RevocationProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRevocationProofRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterEnum("swisstronik.compliance.RevocationStatusFilter", RevocationStatusFilter_name, RevocationStatusFilter_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "swisstronik.compliance.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "swisstronik.compliance.QueryParamsResponse")
+	proto.RegisterType((*QueryOperatorDetailsRequest)(nil), "swisstronik.compliance.QueryOperatorDetailsRequest")
+	proto.RegisterType((*QueryOperatorDetailsResponse)(nil), "swisstronik.compliance.QueryOperatorDetailsResponse")
+	proto.RegisterType((*QueryAddressDetailsRequest)(nil), "swisstronik.compliance.QueryAddressDetailsRequest")
+	proto.RegisterType((*QueryAddressDetailsResponse)(nil), "swisstronik.compliance.QueryAddressDetailsResponse")
+	proto.RegisterType((*QueryAddressesDetailsRequest)(nil), "swisstronik.compliance.QueryAddressesDetailsRequest")
+	proto.RegisterType((*QueryAddressesDetailsResponse)(nil), "swisstronik.compliance.QueryAddressesDetailsResponse")
+	proto.RegisterType((*QueryAddressesDetailsResponse_MergedAddressDetails)(nil), "swisstronik.compliance.QueryAddressesDetailsResponse.MergedAddressDetails")
+	proto.RegisterType((*QueryIssuerDetailsRequest)(nil), "swisstronik.compliance.QueryIssuerDetailsRequest")
+	proto.RegisterType((*QueryIssuerDetailsResponse)(nil), "swisstronik.compliance.QueryIssuerDetailsResponse")
+	proto.RegisterType((*QueryIssuersDetailsRequest)(nil), "swisstronik.compliance.QueryIssuersDetailsRequest")
+	proto.RegisterType((*QueryIssuersDetailsResponse)(nil), "swisstronik.compliance.QueryIssuersDetailsResponse")
+	proto.RegisterType((*QueryIssuersDetailsResponse_MergedIssuerDetails)(nil), "swisstronik.compliance.QueryIssuersDetailsResponse.MergedIssuerDetails")
+	proto.RegisterType((*QueryVerificationDetailsRequest)(nil), "swisstronik.compliance.QueryVerificationDetailsRequest")
+	proto.RegisterType((*QueryVerificationDetailsResponse)(nil), "swisstronik.compliance.QueryVerificationDetailsResponse")
+	proto.RegisterType((*QueryVerificationsDetailsRequest)(nil), "swisstronik.compliance.QueryVerificationsDetailsRequest")
+	proto.RegisterType((*QueryVerificationsDetailsResponse)(nil), "swisstronik.compliance.QueryVerificationsDetailsResponse")
+	proto.RegisterType((*QueryIssuanceTreeRootRequest)(nil), "swisstronik.compliance.QueryIssuanceTreeRootRequest")
+	proto.RegisterType((*QueryIssuanceTreeRootResponse)(nil), "swisstronik.compliance.QueryIssuanceTreeRootResponse")
+	proto.RegisterType((*QueryRevocationTreeRootRequest)(nil), "swisstronik.compliance.QueryRevocationTreeRootRequest")
+	proto.RegisterType((*QueryRevocationTreeRootResponse)(nil), "swisstronik.compliance.QueryRevocationTreeRootResponse")
+	proto.RegisterType((*QueryRootHistoryRequest)(nil), "swisstronik.compliance.QueryRootHistoryRequest")
+	proto.RegisterType((*QueryRootHistoryResponse)(nil), "swisstronik.compliance.QueryRootHistoryResponse")
+	proto.RegisterType((*QueryIssuanceProofRequest)(nil), "swisstronik.compliance.QueryIssuanceProofRequest")
+	proto.RegisterType((*QueryIssuanceProofResponse)(nil), "swisstronik.compliance.QueryIssuanceProofResponse")
+	proto.RegisterType((*QueryRevocationProofRequest)(nil), "swisstronik.compliance.QueryRevocationProofRequest")
+	proto.RegisterType((*QueryRevocationProofResponse)(nil), "swisstronik.compliance.QueryRevocationProofResponse")
+	proto.RegisterType((*QueryAttachedHolderPublicKeyRequest)(nil), "swisstronik.compliance.QueryAttachedHolderPublicKeyRequest")
+	proto.RegisterType((*QueryAttachedHolderPublicKeyResponse)(nil), "swisstronik.compliance.QueryAttachedHolderPublicKeyResponse")
+	proto.RegisterType((*QueryIsCredentialInZKSDIRequest)(nil), "swisstronik.compliance.QueryIsCredentialInZKSDIRequest")
+	proto.RegisterType((*QueryIsCredentialInZKSDIResponse)(nil), "swisstronik.compliance.QueryIsCredentialInZKSDIResponse")
+	proto.RegisterType((*QueryCredentialPackageRequest)(nil), "swisstronik.compliance.QueryCredentialPackageRequest")
+	proto.RegisterType((*QueryCredentialPackageResponse)(nil), "swisstronik.compliance.QueryCredentialPackageResponse")
+	proto.RegisterType((*QueryCredentialHashRequest)(nil), "swisstronik.compliance.QueryCredentialHashRequest")
+	proto.RegisterType((*QueryCredentialHashResponse)(nil), "swisstronik.compliance.QueryCredentialHashResponse")
+	proto.RegisterType((*QueryHolderByVerificationIdRequest)(nil), "swisstronik.compliance.QueryHolderByVerificationIdRequest")
+	proto.RegisterType((*QueryHolderByVerificationIdResponse)(nil), "swisstronik.compliance.QueryHolderByVerificationIdResponse")
+	proto.RegisterType((*QueryAllVerificationDetailsByAddressRequest)(nil), "swisstronik.compliance.QueryAllVerificationDetailsByAddressRequest")
+	proto.RegisterType((*QueryAllVerificationDetailsByAddressResponse)(nil), "swisstronik.compliance.QueryAllVerificationDetailsByAddressResponse")
+	proto.RegisterType((*QueryVerificationsExpiringBetweenRequest)(nil), "swisstronik.compliance.QueryVerificationsExpiringBetweenRequest")
+	proto.RegisterType((*QueryVerificationsExpiringBetweenResponse)(nil), "swisstronik.compliance.QueryVerificationsExpiringBetweenResponse")
+	proto.RegisterType((*QueryVerificationsExpiringBetweenResponse_ExpiringVerification)(nil), "swisstronik.compliance.QueryVerificationsExpiringBetweenResponse.ExpiringVerification")
+	proto.RegisterType((*QueryVerificationsByIssuerRequest)(nil), "swisstronik.compliance.QueryVerificationsByIssuerRequest")
+	proto.RegisterType((*QueryVerificationsByIssuerResponse)(nil), "swisstronik.compliance.QueryVerificationsByIssuerResponse")
+	proto.RegisterType((*QueryVerificationsByIssuerResponse_IssuedVerification)(nil), "swisstronik.compliance.QueryVerificationsByIssuerResponse.IssuedVerification")
+	proto.RegisterType((*QueryIssuerAliasHistoryRequest)(nil), "swisstronik.compliance.QueryIssuerAliasHistoryRequest")
+	proto.RegisterType((*QueryIssuerAliasHistoryResponse)(nil), "swisstronik.compliance.QueryIssuerAliasHistoryResponse")
+	proto.RegisterType((*QueryVerificationRequestsRequest)(nil), "swisstronik.compliance.QueryVerificationRequestsRequest")
+	proto.RegisterType((*QueryVerificationRequestsResponse)(nil), "swisstronik.compliance.QueryVerificationRequestsResponse")
+}
+
+func init() {
+	proto.RegisterFile("swisstronik/compliance/query.proto", fileDescriptor_f80d6bdaf4aa1245)
+}
+
+var fileDescriptor_f80d6bdaf4aa1245 = []byte{
+	// 2392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x25, 0xc7, 0x8e, 0x9f, 0xe3, 0x8f, 0x4e, 0xd2, 0xac, 0x96, 0x49, 0x64, 0x85, 0xf9,
+	0xb0, 0x93, 0xec, 0x8a, 0x6b, 0x39, 0x89, 0xf3, 0x9d, 0xd8, 0xb1, 0x93, 0x78, 0xbd, 0xd9, 0x64,
+	0x65, 0x23, 0x45, 0x02, 0x2c, 0x04, 0x5a, 0x1c, 0xcb, 0xac, 0x65, 0x52, 0x21, 0xa9, 0x24, 0x5a,
+	0xc3, 0x28, 0xb0, 0xb7, 0x3d, 0x14, 0x58, 0xa0, 0x87, 0xa2, 0x97, 0x1e, 0x7a, 0x2c, 0x8a, 0x1e,
+	0x8a, 0xa2, 0x2d, 0xfa, 0x81, 0xf6, 0xd2, 0x76, 0x8b, 0x16, 0xc5, 0x02, 0xbd, 0x14, 0x58, 0xa0,
+	0x28, 0x92, 0xfe, 0x01, 0x3d, 0xf5, 0x5c, 0x70, 0xe6, 0x51, 0x22, 0x29, 0x92, 0x22, 0x95, 0x64,
+	0x6f, 0xe2, 0xcc, 0xbc, 0x37, 0xbf, 0xdf, 0xcc, 0x7b, 0x33, 0x6f, 0x7e, 0x36, 0x48, 0xd6, 0x33,
+	0xcd, 0xb2, 0x6c, 0xd3, 0xd0, 0xb5, 0x2d, 0xb9, 0x6a, 0x6c, 0x37, 0xea, 0x9a, 0xa2, 0x57, 0xa9,
+	0xfc, 0xa4, 0x49, 0xcd, 0x56, 0xb1, 0x61, 0x1a, 0xb6, 0x41, 0x0e, 0x79, 0xc6, 0x14, 0x3b, 0x63,
+	0xc4, 0x83, 0x35, 0xa3, 0x66, 0xb0, 0x21, 0xb2, 0xf3, 0x8b, 0x8f, 0x16, 0x8f, 0xd4, 0x0c, 0xa3,
+	0x56, 0xa7, 0xb2, 0xd2, 0xd0, 0x64, 0x45, 0xd7, 0x0d, 0x5b, 0xb1, 0x35, 0x43, 0xb7, 0xb0, 0xf7,
+	0x4c, 0xd5, 0xb0, 0xb6, 0x0d, 0x4b, 0x5e, 0x57, 0x2c, 0x9c, 0x44, 0x7e, 0x3a, 0xb3, 0x4e, 0x6d,
+	0x65, 0x46, 0x6e, 0x28, 0x35, 0x4d, 0x67, 0x83, 0x71, 0xec, 0xf1, 0x08, 0x6c, 0x0d, 0xc5, 0x54,
+	0xb6, 0x5d, 0x87, 0x27, 0x23, 0x06, 0x51, 0xdd, 0xd6, 0x6c, 0x8d, 0xe2, 0x30, 0xe9, 0x20, 0x90,
+	0x8f, 0x9c, 0xd9, 0x1e, 0x30, 0xdb, 0x32, 0x7d, 0xd2, 0xa4, 0x96, 0x2d, 0xad, 0xc2, 0x01, 0x5f,
+	0xab, 0xd5, 0x30, 0x74, 0x8b, 0x92, 0xab, 0x30, 0xc8, 0xe7, 0xc8, 0x09, 0x05, 0x61, 0x7a, 0xa4,
+	0x94, 0x2f, 0x86, 0xaf, 0x40, 0x91, 0xdb, 0x2d, 0x0c, 0x7c, 0xf1, 0xaf, 0xc9, 0x3d, 0x65, 0xb4,
+	0x91, 0xee, 0xc0, 0x61, 0xe6, 0xf4, 0x7e, 0x83, 0x9a, 0x8a, 0x6d, 0x98, 0x8b, 0xd4, 0x56, 0xb4,
+	0xba, 0x3b, 0x27, 0x99, 0x86, 0x71, 0x03, 0x7b, 0xe6, 0x55, 0xd5, 0xa4, 0x16, 0x9f, 0x65, 0xb8,
+	0x1c, 0x6c, 0x96, 0x14, 0x38, 0x12, 0xee, 0x08, 0x61, 0xce, 0xc3, 0x90, 0xca, 0x9b, 0x10, 0xe7,
+	0x54, 0x14, 0xce, 0xa0, 0x07, 0xd7, 0x4e, 0xd2, 0x41, 0x64, 0x53, 0xe0, 0x94, 0x01, 0xa8, 0x39,
+	0x18, 0x52, 0x7c, 0x10, 0xdd, 0x4f, 0x72, 0x01, 0x0e, 0x19, 0x7a, 0xbd, 0xf5, 0x2d, 0xcd, 0xde,
+	0x5c, 0x7a, 0xae, 0x59, 0xb6, 0xa6, 0xd7, 0x96, 0x2d, 0xab, 0x49, 0xcd, 0x5c, 0xa6, 0x20, 0x4c,
+	0xef, 0x2b, 0x47, 0xf4, 0x4a, 0x8f, 0xe0, 0x70, 0xe8, 0x7c, 0xc8, 0xe8, 0x32, 0x0c, 0xa8, 0x8a,
+	0xad, 0x20, 0x9d, 0x53, 0x51, 0x74, 0x02, 0xd6, 0xcc, 0x46, 0xda, 0xc0, 0xd5, 0xc2, 0x4e, 0x1a,
+	0x24, 0x73, 0x1b, 0xa0, 0x13, 0x61, 0xed, 0x19, 0x78, 0x38, 0x16, 0x9d, 0x70, 0x2c, 0xf2, 0x98,
+	0xc7, 0x70, 0x2c, 0x3e, 0x50, 0x6a, 0x14, 0x6d, 0xcb, 0x1e, 0x4b, 0xe9, 0xfb, 0x59, 0x38, 0x1a,
+	0x31, 0x11, 0xb2, 0xd0, 0x61, 0x58, 0x71, 0xfb, 0x72, 0x42, 0x21, 0x3b, 0x3d, 0x52, 0x7a, 0x3f,
+	0x8a, 0x4a, 0xac, 0xa7, 0xe2, 0x3d, 0x6a, 0xd6, 0xa8, 0xea, 0xa7, 0x8b, 0xd1, 0xd6, 0x99, 0x82,
+	0xdc, 0xf1, 0x31, 0xcb, 0x60, 0x28, 0xf4, 0x62, 0xc6, 0xa7, 0xf0, 0x52, 0x13, 0x7f, 0x2b, 0xc0,
+	0xc1, 0xb0, 0x29, 0x63, 0x02, 0x61, 0x12, 0x46, 0x34, 0xab, 0xf2, 0x94, 0x9a, 0xda, 0x86, 0x46,
+	0x55, 0xdc, 0x7d, 0xd0, 0xac, 0x87, 0xd8, 0x42, 0x8e, 0x02, 0x68, 0x56, 0xc5, 0xa4, 0x4f, 0x8d,
+	0x2d, 0xaa, 0xe6, 0xb2, 0xac, 0x7f, 0x58, 0xb3, 0xca, 0xbc, 0x81, 0xbc, 0x0f, 0xa3, 0xdc, 0xb8,
+	0xca, 0x8f, 0x89, 0xdc, 0x00, 0x5b, 0xaf, 0x13, 0x51, 0xeb, 0xf5, 0xd0, 0x33, 0xb8, 0xec, 0x37,
+	0x95, 0xe6, 0xe1, 0x6d, 0xb6, 0x9c, 0x3c, 0xd6, 0x02, 0xdb, 0x7f, 0x02, 0x46, 0x35, 0xd6, 0xee,
+	0x4f, 0x3a, 0x7f, 0xa3, 0xf4, 0x31, 0x88, 0x61, 0x2e, 0x70, 0x63, 0x6f, 0x04, 0x13, 0xee, 0x64,
+	0x14, 0x4c, 0xbf, 0x7d, 0x3b, 0xdd, 0x54, 0x9f, 0xfb, 0x37, 0x15, 0xa1, 0x5f, 0x0d, 0xc0, 0xe1,
+	0xd0, 0x69, 0x90, 0x46, 0x0d, 0x86, 0x38, 0x6b, 0x37, 0x3a, 0xef, 0xc4, 0x46, 0x67, 0xb8, 0x17,
+	0x8c, 0x4d, 0x1f, 0x51, 0x0c, 0x4d, 0xd7, 0xfb, 0xeb, 0x0b, 0xcc, 0xcf, 0xb2, 0x70, 0x20, 0x64,
+	0xbe, 0x64, 0x9b, 0x4a, 0x08, 0x0c, 0xe8, 0xca, 0x36, 0x65, 0x00, 0x86, 0xcb, 0xec, 0x37, 0x29,
+	0xc0, 0x88, 0x4a, 0xad, 0xaa, 0xa9, 0x35, 0x18, 0xb6, 0x2c, 0xeb, 0xf2, 0x36, 0x91, 0x09, 0xc8,
+	0x36, 0xcd, 0x7a, 0x6e, 0x80, 0xf5, 0x38, 0x3f, 0x1d, 0x3f, 0x75, 0xa3, 0x66, 0xe4, 0xf6, 0x72,
+	0x3f, 0xce, 0x6f, 0xc7, 0x4f, 0x9d, 0xd6, 0x94, 0xfa, 0x92, 0x73, 0xdd, 0xb4, 0x72, 0x83, 0xdc,
+	0x8f, 0xa7, 0xc9, 0xc9, 0x9d, 0xaa, 0x49, 0x15, 0xdb, 0x30, 0x73, 0x43, 0x3c, 0x77, 0xf0, 0x93,
+	0x6c, 0x80, 0xa8, 0xd4, 0xeb, 0xc6, 0x33, 0xaa, 0x56, 0xbc, 0x81, 0x5c, 0xb1, 0x5b, 0x0d, 0x6a,
+	0xe5, 0xf6, 0x15, 0xb2, 0xd3, 0x63, 0xa5, 0xe9, 0x24, 0x89, 0xb0, 0xd6, 0x6a, 0xd0, 0x72, 0x0e,
+	0x7d, 0x05, 0x3b, 0x2c, 0xf2, 0x01, 0x8c, 0x39, 0x0b, 0xe2, 0x98, 0x55, 0x9e, 0x34, 0x0d, 0x5b,
+	0xc9, 0x0d, 0xf7, 0x8e, 0x5e, 0xe7, 0xc7, 0x47, 0xce, 0x60, 0xbe, 0x9a, 0xed, 0x4f, 0x69, 0x19,
+	0x26, 0x59, 0x58, 0x78, 0xe7, 0x09, 0x04, 0xf2, 0x29, 0x18, 0xf3, 0x12, 0x5a, 0x5e, 0xc4, 0x7d,
+	0x09, 0xb4, 0x4a, 0x3f, 0x17, 0xa0, 0x10, 0xed, 0x0b, 0xa3, 0x75, 0x29, 0x98, 0x74, 0x67, 0x93,
+	0x2c, 0x49, 0x30, 0xf5, 0xc8, 0x32, 0x80, 0x73, 0x08, 0x55, 0xbd, 0xb1, 0x78, 0x3a, 0xca, 0x53,
+	0xb9, 0x3d, 0xd2, 0xf5, 0xe3, 0x31, 0x96, 0xbe, 0x1d, 0x82, 0xfa, 0x4d, 0xe5, 0xf2, 0x5f, 0x05,
+	0x38, 0x16, 0x33, 0x19, 0xae, 0xd1, 0xc7, 0xc1, 0x53, 0x94, 0xe7, 0xf5, 0x4c, 0x14, 0x3f, 0x9e,
+	0x4b, 0x21, 0xeb, 0x85, 0x19, 0xec, 0xf7, 0xf6, 0xda, 0xf2, 0x58, 0xca, 0xe3, 0x1d, 0xed, 0x06,
+	0xd8, 0x9a, 0x49, 0x69, 0xd9, 0x30, 0x6c, 0xb7, 0x1e, 0x9b, 0x85, 0xa3, 0x11, 0xfd, 0x48, 0x94,
+	0xc0, 0x80, 0x69, 0x18, 0x36, 0x5b, 0xd0, 0xfd, 0x65, 0xf6, 0x5b, 0x2a, 0x40, 0x9e, 0x19, 0x75,
+	0x36, 0x2d, 0xe8, 0xf6, 0x3c, 0x4c, 0x46, 0x8e, 0x88, 0x71, 0xfc, 0x36, 0xbc, 0xc5, 0xcd, 0x0c,
+	0xc3, 0xbe, 0xab, 0x59, 0xb6, 0x61, 0xb6, 0x5c, 0x8f, 0x8f, 0x20, 0xd7, 0xdd, 0x85, 0xae, 0xae,
+	0xc1, 0x5e, 0xc7, 0xdc, 0xdd, 0x84, 0x63, 0x51, 0x9b, 0xe0, 0x62, 0x70, 0x17, 0x9d, 0x5b, 0x49,
+	0xb7, 0x3c, 0xb7, 0x98, 0x33, 0xee, 0x81, 0x69, 0x18, 0x1b, 0x9e, 0xcc, 0xaa, 0x9a, 0x54, 0xa5,
+	0xba, 0xad, 0x29, 0xf5, 0xbb, 0x8a, 0xb5, 0x89, 0x80, 0x03, 0xad, 0xd2, 0x4d, 0x10, 0xc3, 0x9c,
+	0x20, 0x42, 0x09, 0xf6, 0x53, 0xbd, 0x6a, 0xa8, 0x54, 0x65, 0xed, 0xe8, 0xc3, 0xd7, 0x26, 0x2d,
+	0xc1, 0xe1, 0xc0, 0x9a, 0xf5, 0x05, 0x64, 0x01, 0x8e, 0x84, 0xbb, 0x49, 0x01, 0xe5, 0x06, 0x1c,
+	0xe7, 0x65, 0x92, 0x6d, 0x2b, 0xd5, 0x4d, 0xaa, 0xde, 0x35, 0xea, 0x2a, 0x35, 0x1f, 0x34, 0xd7,
+	0xeb, 0x5a, 0x75, 0x85, 0xb6, 0x7a, 0x56, 0xab, 0xd2, 0x75, 0x38, 0x11, 0xef, 0x00, 0xc1, 0x1c,
+	0x82, 0xc1, 0x46, 0x73, 0x7d, 0x85, 0xb6, 0x10, 0x06, 0x7e, 0x49, 0x55, 0x8c, 0x9f, 0x65, 0xeb,
+	0x56, 0x9b, 0xdd, 0xb2, 0xfe, 0x78, 0x65, 0x75, 0x71, 0xb9, 0x77, 0xa9, 0xdc, 0x7d, 0x18, 0x66,
+	0xf8, 0x4a, 0x05, 0x0e, 0xc3, 0xeb, 0x50, 0x88, 0x9e, 0x04, 0x01, 0x8a, 0xb0, 0x4f, 0xd3, 0xab,
+	0xf5, 0xa6, 0x4a, 0x55, 0x36, 0xcd, 0xbe, 0x72, 0xfb, 0x5b, 0x7a, 0x82, 0xb9, 0xd3, 0xb1, 0x7e,
+	0xa0, 0x54, 0xb7, 0x3a, 0xc7, 0x4a, 0x17, 0x10, 0xd5, 0xdd, 0x32, 0x7f, 0xab, 0xf3, 0x40, 0xd9,
+	0xf4, 0x2f, 0x10, 0x22, 0x0e, 0x36, 0x4b, 0x14, 0xf2, 0x51, 0x53, 0x22, 0xe0, 0x5b, 0x30, 0xd4,
+	0xe0, 0x4d, 0x39, 0x21, 0xfe, 0xc8, 0xed, 0xf6, 0xe1, 0x5a, 0x4a, 0x8b, 0x18, 0xcc, 0xb7, 0x7c,
+	0xa1, 0x95, 0x92, 0x56, 0x3b, 0xa0, 0x83, 0x5e, 0x10, 0x69, 0xd2, 0x80, 0xfe, 0x00, 0x24, 0xe6,
+	0x86, 0xc7, 0xd0, 0x82, 0xef, 0x5c, 0x5e, 0x56, 0xe3, 0x41, 0x0d, 0x77, 0x81, 0x72, 0x43, 0x3b,
+	0xca, 0x1b, 0x82, 0x8b, 0x0e, 0xed, 0xef, 0xc0, 0x59, 0x1e, 0xda, 0xf5, 0x7a, 0xd8, 0x71, 0xee,
+	0x3e, 0x2d, 0xde, 0xdc, 0x8b, 0x6e, 0x07, 0xde, 0x49, 0x06, 0x00, 0xa9, 0xac, 0x78, 0xaf, 0xf3,
+	0xfe, 0x2e, 0xa9, 0x4e, 0x3d, 0xfd, 0x07, 0x01, 0xa6, 0xbb, 0x6f, 0xc7, 0xa5, 0xe7, 0x0d, 0xcd,
+	0xd4, 0xf4, 0xda, 0x02, 0xb5, 0x9f, 0x51, 0xaa, 0xbb, 0xdc, 0xa7, 0x60, 0xdc, 0xb2, 0x15, 0xd3,
+	0xae, 0xd8, 0xda, 0x36, 0xb5, 0x6c, 0x65, 0xbb, 0xc1, 0xd6, 0x60, 0xb4, 0x3c, 0xc6, 0x9a, 0xd7,
+	0xdc, 0x56, 0x72, 0x1c, 0x46, 0xa9, 0xae, 0x7a, 0x86, 0x65, 0xd8, 0xb0, 0xfd, 0x54, 0x57, 0x3b,
+	0x83, 0xfc, 0x17, 0x7c, 0xb6, 0xef, 0x0b, 0xfe, 0x7f, 0x19, 0x38, 0x9d, 0x80, 0x02, 0xae, 0xde,
+	0xa7, 0x42, 0xf8, 0x4d, 0xff, 0x30, 0xb6, 0x82, 0x4f, 0xe2, 0xba, 0xe8, 0xb6, 0x7b, 0x07, 0xbf,
+	0xd9, 0x72, 0x40, 0xdc, 0x81, 0x83, 0x61, 0xb3, 0x3a, 0xe7, 0x30, 0x3f, 0x6a, 0x30, 0x48, 0xf1,
+	0xcb, 0x1b, 0x3b, 0x7c, 0xd6, 0x57, 0x89, 0x9d, 0xef, 0x66, 0xc3, 0x2a, 0xab, 0x05, 0x7c, 0xee,
+	0xa4, 0x7a, 0x36, 0x92, 0x35, 0x98, 0x78, 0x1a, 0x28, 0xbb, 0x19, 0xc2, 0x34, 0xf5, 0x7b, 0x97,
+	0x07, 0xf2, 0x18, 0x26, 0x3a, 0x55, 0xe7, 0xaa, 0xad, 0xd8, 0x4d, 0x8b, 0x05, 0xda, 0x58, 0xa9,
+	0xd8, 0xbb, 0x70, 0xe5, 0xe3, 0x6f, 0x6b, 0x75, 0x9b, 0x9a, 0xe5, 0x2e, 0x3f, 0xec, 0xde, 0x75,
+	0x96, 0x9e, 0x5a, 0xf3, 0x1b, 0x36, 0x35, 0x73, 0x03, 0x18, 0xe2, 0x9e, 0x36, 0x87, 0x3b, 0x7e,
+	0x2f, 0xd0, 0x0d, 0xc3, 0xa4, 0xec, 0xe1, 0x33, 0x5a, 0xf6, 0x37, 0x06, 0x12, 0x61, 0xb0, 0xef,
+	0x44, 0x78, 0x99, 0xc1, 0x93, 0x35, 0x62, 0x3f, 0x30, 0x03, 0x5a, 0xe1, 0x09, 0x70, 0x2f, 0x79,
+	0x02, 0x04, 0x5d, 0xf2, 0xc7, 0xba, 0xfa, 0x35, 0xc6, 0x7d, 0x0b, 0x48, 0xf7, 0x9c, 0x5f, 0x4f,
+	0xd4, 0xdf, 0xc6, 0x2b, 0x9b, 0x2f, 0xc1, 0x7c, 0x5d, 0x53, 0x2c, 0x7f, 0x69, 0x9b, 0x50, 0x28,
+	0xf9, 0x81, 0xd0, 0xae, 0x89, 0xba, 0x1d, 0xe1, 0x56, 0x4d, 0xc1, 0x78, 0xb5, 0x69, 0x9a, 0x54,
+	0xb7, 0x2b, 0xfe, 0x4b, 0x67, 0x0c, 0x9b, 0xdd, 0xf4, 0xb9, 0x07, 0xb0, 0xad, 0xd5, 0x4c, 0xdc,
+	0xd0, 0x4c, 0x21, 0x1b, 0xa7, 0x65, 0xf2, 0x09, 0xef, 0xb9, 0xe3, 0x71, 0xab, 0x3c, 0x0e, 0xa4,
+	0xcf, 0xc3, 0x9e, 0x95, 0x48, 0x2f, 0x9d, 0x1e, 0x14, 0x08, 0xee, 0x4c, 0xdf, 0xc1, 0xfd, 0x9b,
+	0xb0, 0x67, 0x5c, 0x07, 0x12, 0x2e, 0xd8, 0x3d, 0xd8, 0x67, 0x62, 0x1b, 0x86, 0x75, 0xa2, 0xb7,
+	0x2e, 0xfa, 0xc1, 0x95, 0x68, 0xbb, 0x78, 0x6d, 0xf1, 0x7a, 0x66, 0x05, 0x0e, 0x85, 0x1f, 0x2c,
+	0x64, 0x04, 0x86, 0xca, 0xab, 0xb7, 0x2b, 0xf3, 0x1f, 0x3e, 0x9a, 0xd8, 0x43, 0x0e, 0xc0, 0xb8,
+	0xf3, 0xf1, 0xe1, 0xfd, 0xb5, 0x4a, 0x79, 0xe9, 0xe1, 0xfd, 0x95, 0xa5, 0xc5, 0x09, 0x81, 0x8c,
+	0xc3, 0x88, 0xd3, 0xe8, 0x36, 0x64, 0x4a, 0x3f, 0x93, 0x60, 0x2f, 0x5b, 0x0a, 0xf2, 0x99, 0x00,
+	0x83, 0x5c, 0x41, 0x27, 0x67, 0x62, 0xd3, 0xd7, 0x27, 0xda, 0x8b, 0x67, 0x13, 0x8d, 0xe5, 0x34,
+	0xa4, 0x53, 0x9f, 0xfe, 0xe3, 0x3f, 0xdf, 0xcb, 0x14, 0x48, 0x5e, 0x8e, 0xfd, 0x63, 0x02, 0xf9,
+	0x95, 0x00, 0xe3, 0x01, 0x95, 0x9c, 0xcc, 0xc6, 0x4e, 0x14, 0x2e, 0xef, 0x8b, 0xe7, 0xd2, 0x19,
+	0x21, 0xcc, 0xcb, 0x0c, 0xe6, 0x39, 0x52, 0x8a, 0x82, 0xe9, 0xfe, 0x6d, 0x40, 0xde, 0x09, 0xfc,
+	0x95, 0x60, 0x97, 0xfc, 0x44, 0x80, 0xb1, 0x80, 0x5e, 0x5b, 0x4a, 0x22, 0x37, 0x07, 0x80, 0xcf,
+	0xa6, 0xb2, 0x41, 0xdc, 0x33, 0x0c, 0xf7, 0x59, 0x72, 0x3a, 0x0a, 0x37, 0x26, 0xbe, 0xbc, 0xa3,
+	0xb8, 0x70, 0x7f, 0x2c, 0xc0, 0x44, 0x50, 0xf0, 0x26, 0xe7, 0x52, 0xea, 0xe3, 0x1c, 0xf2, 0xf9,
+	0xbe, 0x54, 0x75, 0xe9, 0x34, 0x03, 0x7d, 0x9c, 0x1c, 0xeb, 0x01, 0x9a, 0x5a, 0xe4, 0xa7, 0x02,
+	0x8c, 0xfa, 0x25, 0xc7, 0x99, 0x04, 0x5a, 0x69, 0x00, 0x66, 0x29, 0x8d, 0x09, 0x62, 0xbc, 0xc0,
+	0x30, 0xbe, 0x47, 0x8a, 0x51, 0x18, 0xf9, 0x39, 0x25, 0xef, 0xf8, 0xce, 0xab, 0x5d, 0xf2, 0x23,
+	0x01, 0xc6, 0xfc, 0x82, 0x2d, 0x29, 0xa5, 0x52, 0x77, 0x93, 0x04, 0x43, 0xb8, 0x22, 0x2c, 0x4d,
+	0x31, 0xcc, 0xc7, 0xc8, 0x64, 0x3c, 0x66, 0x8b, 0xfc, 0x59, 0x80, 0x03, 0x21, 0xb7, 0x14, 0x99,
+	0x4b, 0x7c, 0x89, 0x07, 0xe0, 0x5e, 0x4c, 0x6f, 0x88, 0x98, 0xaf, 0x31, 0xcc, 0x73, 0xe4, 0x7c,
+	0x14, 0x66, 0x6f, 0x09, 0x20, 0xef, 0xf8, 0xdf, 0xec, 0xbb, 0xe4, 0xbf, 0x02, 0x4c, 0xf6, 0x78,
+	0xf9, 0x90, 0x5b, 0xf1, 0x51, 0x9a, 0xe8, 0xe1, 0x26, 0x2e, 0xbe, 0x9a, 0x13, 0x64, 0xbb, 0xc0,
+	0xd8, 0x5e, 0x25, 0x97, 0x93, 0xb0, 0xb5, 0x2a, 0xeb, 0xad, 0x4a, 0x77, 0xfe, 0xfe, 0x5a, 0x80,
+	0x83, 0x61, 0x62, 0x24, 0x49, 0xbe, 0x09, 0xc1, 0x68, 0xbb, 0xd4, 0x87, 0x25, 0x32, 0x7a, 0x97,
+	0x31, 0x9a, 0x22, 0x27, 0x13, 0x31, 0x72, 0xf2, 0x79, 0x22, 0x28, 0x2e, 0xf6, 0x38, 0x7c, 0x22,
+	0xb4, 0x4a, 0xf1, 0x7c, 0x4a, 0xab, 0xa4, 0x80, 0x5d, 0xb5, 0x5d, 0x36, 0x1d, 0x6c, 0xbf, 0xc4,
+	0x03, 0xa8, 0x2d, 0xe2, 0x25, 0x38, 0x80, 0x82, 0xaa, 0xa1, 0x58, 0x4a, 0x63, 0x82, 0x38, 0x6f,
+	0x30, 0x9c, 0x97, 0xc8, 0x5c, 0x4f, 0x9c, 0x0d, 0xc7, 0x4e, 0xde, 0xf1, 0xeb, 0x24, 0xbb, 0xe4,
+	0x17, 0x02, 0x90, 0x6e, 0xc1, 0x95, 0x5c, 0x88, 0xc5, 0x12, 0xa9, 0xe1, 0x8a, 0x73, 0xa9, 0xed,
+	0x90, 0x88, 0xcc, 0x88, 0x9c, 0x26, 0x53, 0x51, 0x44, 0x3a, 0x6f, 0x23, 0xbe, 0xe4, 0xbf, 0x17,
+	0x60, 0x3c, 0x20, 0x57, 0xf6, 0x28, 0x05, 0xc2, 0x35, 0x52, 0xf1, 0x5c, 0x3a, 0x23, 0xc4, 0x3b,
+	0xcf, 0xf0, 0x5e, 0x21, 0x97, 0x12, 0xe0, 0x8d, 0x58, 0xfa, 0x1f, 0x0a, 0x30, 0xe2, 0x51, 0xa6,
+	0x89, 0x1c, 0x0f, 0xa4, 0x4b, 0xde, 0x16, 0xdf, 0x4b, 0x6e, 0x80, 0xa8, 0xdf, 0x61, 0xa8, 0x4f,
+	0x91, 0x13, 0x91, 0xa8, 0x0d, 0xc3, 0xae, 0x6c, 0x22, 0xa0, 0xbf, 0x08, 0xf0, 0x56, 0x84, 0x18,
+	0x4b, 0xae, 0xc4, 0x9f, 0x74, 0xb1, 0x1a, 0xb0, 0x78, 0xb5, 0x3f, 0x63, 0x24, 0x31, 0xcb, 0x48,
+	0xbc, 0x4b, 0xce, 0x46, 0x16, 0x8b, 0xae, 0x89, 0xe7, 0x3c, 0xfc, 0xbb, 0x00, 0xe3, 0xcb, 0xd6,
+	0x6a, 0x53, 0xb3, 0x95, 0xf5, 0x3a, 0xbd, 0x6d, 0x98, 0x8f, 0x57, 0x7a, 0x5c, 0x64, 0xd1, 0x32,
+	0xb2, 0x78, 0x31, 0xbd, 0x21, 0x62, 0xbf, 0xcb, 0xb0, 0x2f, 0x90, 0x9b, 0x51, 0xd8, 0x3f, 0xd9,
+	0x92, 0xb5, 0x36, 0xcc, 0x0e, 0xfe, 0xee, 0x3b, 0xed, 0x77, 0x02, 0x8c, 0xf9, 0x45, 0xd2, 0x1e,
+	0x25, 0x44, 0xa8, 0x2e, 0x2b, 0xce, 0xa6, 0xb2, 0x49, 0x7a, 0x41, 0x7d, 0xb2, 0x25, 0xfb, 0xa3,
+	0x3d, 0x80, 0x5f, 0xdd, 0x75, 0x82, 0xeb, 0x1b, 0x5d, 0x6a, 0x32, 0x39, 0x9f, 0x10, 0x8e, 0x5f,
+	0x34, 0x17, 0x2f, 0xa4, 0x35, 0x43, 0x22, 0x4b, 0x8c, 0xc8, 0x0d, 0x72, 0x2d, 0x11, 0x11, 0xb4,
+	0xee, 0xe6, 0xf2, 0x27, 0x01, 0x88, 0xf7, 0xfe, 0xe3, 0x91, 0x4b, 0x2e, 0xc7, 0xa2, 0x8a, 0x95,
+	0xa6, 0xc5, 0x2b, 0x7d, 0xd9, 0x22, 0xad, 0x39, 0x46, 0x6b, 0x86, 0xc8, 0x51, 0xb4, 0xb8, 0x66,
+	0xd1, 0x4d, 0xe4, 0x2b, 0x01, 0x8e, 0xc4, 0xc9, 0x90, 0xe4, 0xe6, 0x2b, 0x28, 0x98, 0x9c, 0xd8,
+	0xfc, 0x2b, 0x6b, 0xa0, 0xbd, 0xab, 0x6e, 0x2f, 0x2d, 0x4b, 0xa6, 0xe8, 0xc6, 0x29, 0x68, 0xbf,
+	0x19, 0xaa, 0x31, 0x91, 0x4b, 0xfd, 0xe8, 0x52, 0x9c, 0xcf, 0xe5, 0xfe, 0x25, 0xad, 0xde, 0xfb,
+	0xe4, 0x27, 0xb2, 0xde, 0xaa, 0xf0, 0xda, 0x9c, 0xfc, 0x51, 0x00, 0xd2, 0x2d, 0xe9, 0xf4, 0xb8,
+	0xb5, 0x23, 0xc5, 0x24, 0x71, 0x2e, 0xb5, 0x1d, 0x12, 0xb8, 0xce, 0x08, 0x5c, 0x24, 0x17, 0xd2,
+	0xbd, 0x7f, 0x64, 0xc5, 0x71, 0x46, 0x2d, 0xf2, 0xb7, 0x40, 0x95, 0x5a, 0x76, 0x45, 0x91, 0xe4,
+	0x55, 0x6a, 0x40, 0x31, 0x4a, 0x51, 0xa5, 0x06, 0x85, 0x9d, 0x64, 0xc5, 0x54, 0x08, 0x1b, 0x57,
+	0xca, 0x59, 0xb8, 0xf8, 0xc5, 0x8b, 0xbc, 0xf0, 0xe5, 0x8b, 0xbc, 0xf0, 0xef, 0x17, 0x79, 0xe1,
+	0xf3, 0x97, 0xf9, 0x3d, 0x5f, 0xbe, 0xcc, 0xef, 0xf9, 0xe7, 0xcb, 0xfc, 0x9e, 0xc7, 0x79, 0xaf,
+	0xc7, 0xe7, 0x5e, 0x9f, 0xec, 0xdf, 0x48, 0xd6, 0x07, 0xd9, 0xff, 0x3f, 0xce, 0xfe, 0x7f, 0x00,
+	0x0c, 0x50, 0x0a, 0x39, 0xe9, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	OperatorDetails(ctx context.Context, in *QueryOperatorDetailsRequest, opts ...grpc.CallOption) (*QueryOperatorDetailsResponse, error)
+	AddressDetails(ctx context.Context, in *QueryAddressDetailsRequest, opts ...grpc.CallOption) (*QueryAddressDetailsResponse, error)
+	AddressesDetails(ctx context.Context, in *QueryAddressesDetailsRequest, opts ...grpc.CallOption) (*QueryAddressesDetailsResponse, error)
+	IssuerDetails(ctx context.Context, in *QueryIssuerDetailsRequest, opts ...grpc.CallOption) (*QueryIssuerDetailsResponse, error)
+	IssuersDetails(ctx context.Context, in *QueryIssuersDetailsRequest, opts ...grpc.CallOption) (*QueryIssuersDetailsResponse, error)
+	VerificationDetails(ctx context.Context, in *QueryVerificationDetailsRequest, opts ...grpc.CallOption) (*QueryVerificationDetailsResponse, error)
+	AllVerificationDetailsByAddress(ctx context.Context, in *QueryAllVerificationDetailsByAddressRequest, opts ...grpc.CallOption) (*QueryAllVerificationDetailsByAddressResponse, error)
+	VerificationsDetails(ctx context.Context, in *QueryVerificationsDetailsRequest, opts ...grpc.CallOption) (*QueryVerificationsDetailsResponse, error)
+	IssuanceTreeRoot(ctx context.Context, in *QueryIssuanceTreeRootRequest, opts ...grpc.CallOption) (*QueryIssuanceTreeRootResponse, error)
+	IssuanceProof(ctx context.Context, in *QueryIssuanceProofRequest, opts ...grpc.CallOption) (*QueryIssuanceProofResponse, error)
+	RevocationTreeRoot(ctx context.Context, in *QueryRevocationTreeRootRequest, opts ...grpc.CallOption) (*QueryRevocationTreeRootResponse, error)
+	RevocationProof(ctx context.Context, in *QueryRevocationProofRequest, opts ...grpc.CallOption) (*QueryRevocationProofResponse, error)
+	// RootHistory returns roots of issuance and revocation trees for recent blocks
+	RootHistory(ctx context.Context, in *QueryRootHistoryRequest, opts ...grpc.CallOption) (*QueryRootHistoryResponse, error)
+	AttachedHolderPublicKey(ctx context.Context, in *QueryAttachedHolderPublicKeyRequest, opts ...grpc.CallOption) (*QueryAttachedHolderPublicKeyResponse, error)
+	IsSuitableForZK(ctx context.Context, in *QueryIsCredentialInZKSDIRequest, opts ...grpc.CallOption) (*QueryIsCredentialInZKSDIResponse, error)
+	CredentialHash(ctx context.Context, in *QueryCredentialHashRequest, opts ...grpc.CallOption) (*QueryCredentialHashResponse, error)
+	// CredentialPackage returns credential with issuance and non-revocation proofs,
+	// which can be verified off-chain
+	CredentialPackage(ctx context.Context, in *QueryCredentialPackageRequest, opts ...grpc.CallOption) (*QueryCredentialPackageResponse, error)
+	VerificationHolder(ctx context.Context, in *QueryHolderByVerificationIdRequest, opts ...grpc.CallOption) (*QueryHolderByVerificationIdResponse, error)
+	// VerificationsExpiringBetween returns verifications, which expire within provided time range
+	// and were not processed by EndBlock yet.
+	VerificationsExpiringBetween(ctx context.Context, in *QueryVerificationsExpiringBetweenRequest, opts ...grpc.CallOption) (*QueryVerificationsExpiringBetweenResponse, error)
+	// VerificationsByIssuer returns verifications issued by provided issuer or verifications of provided type,
+	// optionally filtered by revocation status and expiration window
+	VerificationsByIssuer(ctx context.Context, in *QueryVerificationsByIssuerRequest, opts ...grpc.CallOption) (*QueryVerificationsByIssuerResponse, error)
+	// IssuerAliasHistory returns migrations of issuer, which includes provided address
+	IssuerAliasHistory(ctx context.Context, in *QueryIssuerAliasHistoryRequest, opts ...grpc.CallOption) (*QueryIssuerAliasHistoryResponse, error)
+	// VerificationRequests returns pending verification requests, which were emitted
+	// by compliance registry contract for provided issuer
+	VerificationRequests(ctx context.Context, in *QueryVerificationRequestsRequest, opts ...grpc.CallOption) (*QueryVerificationRequestsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RevocationProof(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/RevocationProof",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RevocationProof(ctx, req.(*QueryRevocationProofRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AttachedHolderPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAttachedHolderPublicKeyRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OperatorDetails(ctx context.Context, in *QueryOperatorDetailsRequest, opts ...grpc.CallOption) (*QueryOperatorDetailsResponse, error) {
+	out := new(QueryOperatorDetailsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/OperatorDetails", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AttachedHolderPublicKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/AttachedHolderPublicKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AttachedHolderPublicKey(ctx, req.(*QueryAttachedHolderPublicKeyRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_IsSuitableForZK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsCredentialInZKSDIRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AddressDetails(ctx context.Context, in *QueryAddressDetailsRequest, opts ...grpc.CallOption) (*QueryAddressDetailsResponse, error) {
+	out := new(QueryAddressDetailsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/AddressDetails", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsSuitableForZK(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/IsSuitableForZK",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsSuitableForZK(ctx, req.(*QueryIsCredentialInZKSDIRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CredentialHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCredentialHashRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AddressesDetails(ctx context.Context, in *QueryAddressesDetailsRequest, opts ...grpc.CallOption) (*QueryAddressesDetailsResponse, error) {
+	out := new(QueryAddressesDetailsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/AddressesDetails", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CredentialHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/CredentialHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CredentialHash(ctx, req.(*QueryCredentialHashRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_VerificationHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHolderByVerificationIdRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) IssuerDetails(ctx context.Context, in *QueryIssuerDetailsRequest, opts ...grpc.CallOption) (*QueryIssuerDetailsResponse, error) {
+	out := new(QueryIssuerDetailsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/IssuerDetails", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).VerificationHolder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Query/VerificationHolder",
+	return out, nil
+}
+
+func (c *queryClient) IssuersDetails(ctx context.Context, in *QueryIssuersDetailsRequest, opts ...grpc.CallOption) (*QueryIssuersDetailsResponse, error) {
+	out := new(QueryIssuersDetailsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/IssuersDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VerificationHolder(ctx, req.(*QueryHolderByVerificationIdRequest))
+	return out, nil
+}
+
+func (c *queryClient) VerificationDetails(ctx context.Context, in *QueryVerificationDetailsRequest, opts ...grpc.CallOption) (*QueryVerificationDetailsResponse, error) {
+	out := new(QueryVerificationDetailsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/VerificationDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "swisstronik.compliance.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "OperatorDetails",
-			Handler:    _Query_OperatorDetails_Handler,
-		},
-		{
-			MethodName: "AddressDetails",
-			Handler:    _Query_AddressDetails_Handler,
-		},
-		{
-			MethodName: "AddressesDetails",
-			Handler:    _Query_AddressesDetails_Handler,
-		},
-		{
-			MethodName: "IssuerDetails",
-			Handler:    _Query_IssuerDetails_Handler,
-		},
-		{
-			MethodName: "IssuersDetails",
-			Handler:    _Query_IssuersDetails_Handler,
-		},
-		{
-			MethodName: "VerificationDetails",
-			Handler:    _Query_VerificationDetails_Handler,
-		},
-		{
-			MethodName: "AllVerificationDetailsByAddress",
-			Handler:    _Query_AllVerificationDetailsByAddress_Handler,
-		},
-		{
-			MethodName: "VerificationsDetails",
-			Handler:    _Query_VerificationsDetails_Handler,
-		},
-		{
-			MethodName: "IssuanceTreeRoot",
-			Handler:    _Query_IssuanceTreeRoot_Handler,
-		},
-		{
-			MethodName: "IssuanceProof",
-			Handler:    _Query_IssuanceProof_Handler,
-		},
-		{
-			MethodName: "RevocationTreeRoot",
-			Handler:    _Query_RevocationTreeRoot_Handler,
-		},
-		{
-			MethodName: "RevocationProof",
-			Handler:    _Query_RevocationProof_Handler,
-		},
-		{
-			MethodName: "AttachedHolderPublicKey",
-			Handler:    _Query_AttachedHolderPublicKey_Handler,
-		},
-		{
-			MethodName: "IsSuitableForZK",
-			Handler:    _Query_IsSuitableForZK_Handler,
-		},
-		{
-			MethodName: "CredentialHash",
-			Handler:    _Query_CredentialHash_Handler,
-		},
-		{
-			MethodName: "VerificationHolder",
-			Handler:    _Query_VerificationHolder_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "swisstronik/compliance/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllVerificationDetailsByAddress(ctx context.Context, in *QueryAllVerificationDetailsByAddressRequest, opts ...grpc.CallOption) (*QueryAllVerificationDetailsByAddressResponse, error) {
+	out := new(QueryAllVerificationDetailsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/AllVerificationDetailsByAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VerificationsDetails(ctx context.Context, in *QueryVerificationsDetailsRequest, opts ...grpc.CallOption) (*QueryVerificationsDetailsResponse, error) {
+	out := new(QueryVerificationsDetailsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/VerificationsDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) IssuanceTreeRoot(ctx context.Context, in *QueryIssuanceTreeRootRequest, opts ...grpc.CallOption) (*QueryIssuanceTreeRootResponse, error) {
+	out := new(QueryIssuanceTreeRootResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/IssuanceTreeRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IssuanceProof(ctx context.Context, in *QueryIssuanceProofRequest, opts ...grpc.CallOption) (*QueryIssuanceProofResponse, error) {
+	out := new(QueryIssuanceProofResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/IssuanceProof", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RevocationTreeRoot(ctx context.Context, in *QueryRevocationTreeRootRequest, opts ...grpc.CallOption) (*QueryRevocationTreeRootResponse, error) {
+	out := new(QueryRevocationTreeRootResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/RevocationTreeRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) RevocationProof(ctx context.Context, in *QueryRevocationProofRequest, opts ...grpc.CallOption) (*QueryRevocationProofResponse, error) {
+	out := new(QueryRevocationProofResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/RevocationProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOperatorDetailsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RootHistory(ctx context.Context, in *QueryRootHistoryRequest, opts ...grpc.CallOption) (*QueryRootHistoryResponse, error) {
+	out := new(QueryRootHistoryResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/RootHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOperatorDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AttachedHolderPublicKey(ctx context.Context, in *QueryAttachedHolderPublicKeyRequest, opts ...grpc.CallOption) (*QueryAttachedHolderPublicKeyResponse, error) {
+	out := new(QueryAttachedHolderPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/AttachedHolderPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOperatorDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) IsSuitableForZK(ctx context.Context, in *QueryIsCredentialInZKSDIRequest, opts ...grpc.CallOption) (*QueryIsCredentialInZKSDIResponse, error) {
+	out := new(QueryIsCredentialInZKSDIResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/IsSuitableForZK", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOperatorDetailsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CredentialHash(ctx context.Context, in *QueryCredentialHashRequest, opts ...grpc.CallOption) (*QueryCredentialHashResponse, error) {
+	out := new(QueryCredentialHashResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/CredentialHash", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOperatorDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CredentialPackage(ctx context.Context, in *QueryCredentialPackageRequest, opts ...grpc.CallOption) (*QueryCredentialPackageResponse, error) {
+	out := new(QueryCredentialPackageResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/CredentialPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOperatorDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) VerificationHolder(ctx context.Context, in *QueryHolderByVerificationIdRequest, opts ...grpc.CallOption) (*QueryHolderByVerificationIdResponse, error) {
+	out := new(QueryHolderByVerificationIdResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/VerificationHolder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAddressDetailsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VerificationsExpiringBetween(ctx context.Context, in *QueryVerificationsExpiringBetweenRequest, opts ...grpc.CallOption) (*QueryVerificationsExpiringBetweenResponse, error) {
+	out := new(QueryVerificationsExpiringBetweenResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/VerificationsExpiringBetween", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAddressDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VerificationsByIssuer(ctx context.Context, in *QueryVerificationsByIssuerRequest, opts ...grpc.CallOption) (*QueryVerificationsByIssuerResponse, error) {
+	out := new(QueryVerificationsByIssuerResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/VerificationsByIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAddressDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OnlyWithExistingIssuer {
-		i--
-		if m.OnlyWithExistingIssuer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) IssuerAliasHistory(ctx context.Context, in *QueryIssuerAliasHistoryRequest, opts ...grpc.CallOption) (*QueryIssuerAliasHistoryResponse, error) {
+	out := new(QueryIssuerAliasHistoryResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/IssuerAliasHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAddressDetailsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VerificationRequests(ctx context.Context, in *QueryVerificationRequestsRequest, opts ...grpc.CallOption) (*QueryVerificationRequestsResponse, error) {
+	out := new(QueryVerificationRequestsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Query/VerificationRequests", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAddressDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	OperatorDetails(context.Context, *QueryOperatorDetailsRequest) (*QueryOperatorDetailsResponse, error)
+	AddressDetails(context.Context, *QueryAddressDetailsRequest) (*QueryAddressDetailsResponse, error)
+	AddressesDetails(context.Context, *QueryAddressesDetailsRequest) (*QueryAddressesDetailsResponse, error)
+	IssuerDetails(context.Context, *QueryIssuerDetailsRequest) (*QueryIssuerDetailsResponse, error)
+	IssuersDetails(context.Context, *QueryIssuersDetailsRequest) (*QueryIssuersDetailsResponse, error)
+	VerificationDetails(context.Context, *QueryVerificationDetailsRequest) (*QueryVerificationDetailsResponse, error)
+	AllVerificationDetailsByAddress(context.Context, *QueryAllVerificationDetailsByAddressRequest) (*QueryAllVerificationDetailsByAddressResponse, error)
+	VerificationsDetails(context.Context, *QueryVerificationsDetailsRequest) (*QueryVerificationsDetailsResponse, error)
+	IssuanceTreeRoot(context.Context, *QueryIssuanceTreeRootRequest) (*QueryIssuanceTreeRootResponse, error)
+	IssuanceProof(context.Context, *QueryIssuanceProofRequest) (*QueryIssuanceProofResponse, error)
+	RevocationTreeRoot(context.Context, *QueryRevocationTreeRootRequest) (*QueryRevocationTreeRootResponse, error)
+	RevocationProof(context.Context, *QueryRevocationProofRequest) (*QueryRevocationProofResponse, error)
+	// RootHistory returns roots of issuance and revocation trees for recent blocks
+	RootHistory(context.Context, *QueryRootHistoryRequest) (*QueryRootHistoryResponse, error)
+	AttachedHolderPublicKey(context.Context, *QueryAttachedHolderPublicKeyRequest) (*QueryAttachedHolderPublicKeyResponse, error)
+	IsSuitableForZK(context.Context, *QueryIsCredentialInZKSDIRequest) (*QueryIsCredentialInZKSDIResponse, error)
+	CredentialHash(context.Context, *QueryCredentialHashRequest) (*QueryCredentialHashResponse, error)
+	// CredentialPackage returns credential with issuance and non-revocation proofs,
+	// which can be verified off-chain
+	CredentialPackage(context.Context, *QueryCredentialPackageRequest) (*QueryCredentialPackageResponse, error)
+	VerificationHolder(context.Context, *QueryHolderByVerificationIdRequest) (*QueryHolderByVerificationIdResponse, error)
+	// VerificationsExpiringBetween returns verifications, which expire within provided time range
+	// and were not processed by EndBlock yet.
+	VerificationsExpiringBetween(context.Context, *QueryVerificationsExpiringBetweenRequest) (*QueryVerificationsExpiringBetweenResponse, error)
+	// VerificationsByIssuer returns verifications issued by provided issuer or verifications of provided type,
+	// optionally filtered by revocation status and expiration window
+	VerificationsByIssuer(context.Context, *QueryVerificationsByIssuerRequest) (*QueryVerificationsByIssuerResponse, error)
+	// IssuerAliasHistory returns migrations of issuer, which includes provided address
+	IssuerAliasHistory(context.Context, *QueryIssuerAliasHistoryRequest) (*QueryIssuerAliasHistoryResponse, error)
+	// VerificationRequests returns pending verification requests, which were emitted
+	// by compliance registry contract for provided issuer
+	VerificationRequests(context.Context, *QueryVerificationRequestsRequest) (*QueryVerificationRequestsResponse, error)
 }
 
-func (m *QueryAddressDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAddressesDetailsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) OperatorDetails(ctx context.Context, req *QueryOperatorDetailsRequest) (*QueryOperatorDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorDetails not implemented")
+}
+func (*UnimplementedQueryServer) AddressDetails(ctx context.Context, req *QueryAddressDetailsRequest) (*QueryAddressDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressDetails not implemented")
+}
+func (*UnimplementedQueryServer) AddressesDetails(ctx context.Context, req *QueryAddressesDetailsRequest) (*QueryAddressesDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressesDetails not implemented")
+}
+func (*UnimplementedQueryServer) IssuerDetails(ctx context.Context, req *QueryIssuerDetailsRequest) (*QueryIssuerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuerDetails not implemented")
+}
+func (*UnimplementedQueryServer) IssuersDetails(ctx context.Context, req *QueryIssuersDetailsRequest) (*QueryIssuersDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuersDetails not implemented")
+}
+func (*UnimplementedQueryServer) VerificationDetails(ctx context.Context, req *QueryVerificationDetailsRequest) (*QueryVerificationDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerificationDetails not implemented")
+}
+func (*UnimplementedQueryServer) AllVerificationDetailsByAddress(ctx context.Context, req *QueryAllVerificationDetailsByAddressRequest) (*QueryAllVerificationDetailsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllVerificationDetailsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) VerificationsDetails(ctx context.Context, req *QueryVerificationsDetailsRequest) (*QueryVerificationsDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerificationsDetails not implemented")
+}
+func (*UnimplementedQueryServer) IssuanceTreeRoot(ctx context.Context, req *QueryIssuanceTreeRootRequest) (*QueryIssuanceTreeRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuanceTreeRoot not implemented")
+}
+func (*UnimplementedQueryServer) IssuanceProof(ctx context.Context, req *QueryIssuanceProofRequest) (*QueryIssuanceProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuanceProof not implemented")
+}
+func (*UnimplementedQueryServer) RevocationTreeRoot(ctx context.Context, req *QueryRevocationTreeRootRequest) (*QueryRevocationTreeRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevocationTreeRoot not implemented")
+}
+func (*UnimplementedQueryServer) RevocationProof(ctx context.Context, req *QueryRevocationProofRequest) (*QueryRevocationProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevocationProof not implemented")
+}
+func (*UnimplementedQueryServer) RootHistory(ctx context.Context, req *QueryRootHistoryRequest) (*QueryRootHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RootHistory not implemented")
+}
+func (*UnimplementedQueryServer) AttachedHolderPublicKey(ctx context.Context, req *QueryAttachedHolderPublicKeyRequest) (*QueryAttachedHolderPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachedHolderPublicKey not implemented")
+}
+func (*UnimplementedQueryServer) IsSuitableForZK(ctx context.Context, req *QueryIsCredentialInZKSDIRequest) (*QueryIsCredentialInZKSDIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsSuitableForZK not implemented")
+}
+func (*UnimplementedQueryServer) CredentialHash(ctx context.Context, req *QueryCredentialHashRequest) (*QueryCredentialHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialHash not implemented")
+}
+func (*UnimplementedQueryServer) CredentialPackage(ctx context.Context, req *QueryCredentialPackageRequest) (*QueryCredentialPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialPackage not implemented")
+}
+func (*UnimplementedQueryServer) VerificationHolder(ctx context.Context, req *QueryHolderByVerificationIdRequest) (*QueryHolderByVerificationIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerificationHolder not implemented")
+}
+func (*UnimplementedQueryServer) VerificationsExpiringBetween(ctx context.Context, req *QueryVerificationsExpiringBetweenRequest) (*QueryVerificationsExpiringBetweenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerificationsExpiringBetween not implemented")
+}
+func (*UnimplementedQueryServer) VerificationsByIssuer(ctx context.Context, req *QueryVerificationsByIssuerRequest) (*QueryVerificationsByIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerificationsByIssuer not implemented")
+}
+func (*UnimplementedQueryServer) IssuerAliasHistory(ctx context.Context, req *QueryIssuerAliasHistoryRequest) (*QueryIssuerAliasHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuerAliasHistory not implemented")
+}
+func (*UnimplementedQueryServer) VerificationRequests(ctx context.Context, req *QueryVerificationRequestsRequest) (*QueryVerificationRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerificationRequests not implemented")
 }
 
-func (m *QueryAddressesDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAddressesDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAddressesDetailsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OperatorDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorDetailsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/OperatorDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorDetails(ctx, req.(*QueryOperatorDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAddressesDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AddressDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/AddressDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressDetails(ctx, req.(*QueryAddressDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAddressesDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_AddressesDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressesDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressesDetails(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/AddressesDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressesDetails(ctx, req.(*QueryAddressesDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAddressesDetailsResponse_MergedAddressDetails) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_IssuerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuerDetailsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).IssuerDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/IssuerDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuerDetails(ctx, req.(*QueryIssuerDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAddressesDetailsResponse_MergedAddressDetails) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAddressesDetailsResponse_MergedAddressDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Verifications) > 0 {
-		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Query_IssuersDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuersDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.IsRevoked {
-		i--
-		if m.IsRevoked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).IssuersDetails(ctx, in)
 	}
-	if m.IsVerified {
-		i--
-		if m.IsVerified {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/IssuersDetails",
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuersDetails(ctx, req.(*QueryIssuersDetailsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIssuerDetailsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_VerificationDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerificationDetailsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryIssuerDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryIssuerDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IssuerAddress) > 0 {
-		i -= len(m.IssuerAddress)
-		copy(dAtA[i:], m.IssuerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IssuerAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).VerificationDetails(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/VerificationDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerificationDetails(ctx, req.(*QueryVerificationDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIssuerDetailsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllVerificationDetailsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllVerificationDetailsByAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AllVerificationDetailsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/AllVerificationDetailsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllVerificationDetailsByAddress(ctx, req.(*QueryAllVerificationDetailsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIssuerDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_VerificationsDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerificationsDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerificationsDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/VerificationsDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerificationsDetails(ctx, req.(*QueryVerificationsDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIssuerDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_IssuanceTreeRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuanceTreeRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).IssuanceTreeRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/IssuanceTreeRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuanceTreeRoot(ctx, req.(*QueryIssuanceTreeRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIssuersDetailsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_IssuanceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuanceProofRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).IssuanceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/IssuanceProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuanceProof(ctx, req.(*QueryIssuanceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIssuersDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RevocationTreeRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevocationTreeRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RevocationTreeRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/RevocationTreeRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevocationTreeRoot(ctx, req.(*QueryRevocationTreeRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIssuersDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_RevocationProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevocationProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).RevocationProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/RevocationProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevocationProof(ctx, req.(*QueryRevocationProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIssuersDetailsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RootHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRootHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RootHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/RootHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RootHistory(ctx, req.(*QueryRootHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIssuersDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryIssuersDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_AttachedHolderPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttachedHolderPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Issuers) > 0 {
-		for iNdEx := len(m.Issuers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Issuers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AttachedHolderPublicKey(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/AttachedHolderPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttachedHolderPublicKey(ctx, req.(*QueryAttachedHolderPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_IsSuitableForZK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsCredentialInZKSDIRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(QueryServer).IsSuitableForZK(ctx, in)
 	}
-	if len(m.LegalEntity) > 0 {
-		i -= len(m.LegalEntity)
-		copy(dAtA[i:], m.LegalEntity)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LegalEntity)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/IsSuitableForZK",
 	}
-	if len(m.Logo) > 0 {
-		i -= len(m.Logo)
-		copy(dAtA[i:], m.Logo)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Logo)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsSuitableForZK(ctx, req.(*QueryIsCredentialInZKSDIRequest))
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialHash(ctx, in)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/CredentialHash",
 	}
-	if len(m.IssuerAddress) > 0 {
-		i -= len(m.IssuerAddress)
-		copy(dAtA[i:], m.IssuerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IssuerAddress)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialHash(ctx, req.(*QueryCredentialHashRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerificationDetailsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CredentialPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialPackageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryVerificationDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryVerificationDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VerificationID) > 0 {
-		i -= len(m.VerificationID)
-		copy(dAtA[i:], m.VerificationID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationID)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialPackage(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/CredentialPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialPackage(ctx, req.(*QueryCredentialPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerificationDetailsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_VerificationHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHolderByVerificationIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).VerificationHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/VerificationHolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerificationHolder(ctx, req.(*QueryHolderByVerificationIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerificationDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_VerificationsExpiringBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerificationsExpiringBetweenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerificationsExpiringBetween(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/VerificationsExpiringBetween",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerificationsExpiringBetween(ctx, req.(*QueryVerificationsExpiringBetweenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerificationDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_VerificationsByIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerificationsByIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).VerificationsByIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/VerificationsByIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerificationsByIssuer(ctx, req.(*QueryVerificationsByIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerificationsDetailsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_IssuerAliasHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuerAliasHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).IssuerAliasHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/IssuerAliasHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuerAliasHistory(ctx, req.(*QueryIssuerAliasHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerificationsDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_VerificationRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerificationRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerificationRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Query/VerificationRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerificationRequests(ctx, req.(*QueryVerificationRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerificationsDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "swisstronik.compliance.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "OperatorDetails",
+			Handler:    _Query_OperatorDetails_Handler,
+		},
+		{
+			MethodName: "AddressDetails",
+			Handler:    _Query_AddressDetails_Handler,
+		},
+		{
+			MethodName: "AddressesDetails",
+			Handler:    _Query_AddressesDetails_Handler,
+		},
+		{
+			MethodName: "IssuerDetails",
+			Handler:    _Query_IssuerDetails_Handler,
+		},
+		{
+			MethodName: "IssuersDetails",
+			Handler:    _Query_IssuersDetails_Handler,
+		},
+		{
+			MethodName: "VerificationDetails",
+			Handler:    _Query_VerificationDetails_Handler,
+		},
+		{
+			MethodName: "AllVerificationDetailsByAddress",
+			Handler:    _Query_AllVerificationDetailsByAddress_Handler,
+		},
+		{
+			MethodName: "VerificationsDetails",
+			Handler:    _Query_VerificationsDetails_Handler,
+		},
+		{
+			MethodName: "IssuanceTreeRoot",
+			Handler:    _Query_IssuanceTreeRoot_Handler,
+		},
+		{
+			MethodName: "IssuanceProof",
+			Handler:    _Query_IssuanceProof_Handler,
+		},
+		{
+			MethodName: "RevocationTreeRoot",
+			Handler:    _Query_RevocationTreeRoot_Handler,
+		},
+		{
+			MethodName: "RevocationProof",
+			Handler:    _Query_RevocationProof_Handler,
+		},
+		{
+			MethodName: "RootHistory",
+			Handler:    _Query_RootHistory_Handler,
+		},
+		{
+			MethodName: "AttachedHolderPublicKey",
+			Handler:    _Query_AttachedHolderPublicKey_Handler,
+		},
+		{
+			MethodName: "IsSuitableForZK",
+			Handler:    _Query_IsSuitableForZK_Handler,
+		},
+		{
+			MethodName: "CredentialHash",
+			Handler:    _Query_CredentialHash_Handler,
+		},
+		{
+			MethodName: "CredentialPackage",
+			Handler:    _Query_CredentialPackage_Handler,
+		},
+		{
+			MethodName: "VerificationHolder",
+			Handler:    _Query_VerificationHolder_Handler,
+		},
+		{
+			MethodName: "VerificationsExpiringBetween",
+			Handler:    _Query_VerificationsExpiringBetween_Handler,
+		},
+		{
+			MethodName: "VerificationsByIssuer",
+			Handler:    _Query_VerificationsByIssuer_Handler,
+		},
+		{
+			MethodName: "IssuerAliasHistory",
+			Handler:    _Query_IssuerAliasHistory_Handler,
+		},
+		{
+			MethodName: "VerificationRequests",
+			Handler:    _Query_VerificationRequests_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "swisstronik/compliance/query.proto",
 }
 
-func (m *QueryVerificationsDetailsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3242,46 +3713,20 @@ func (m *QueryVerificationsDetailsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVerificationsDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVerificationsDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Verifications) > 0 {
-		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIssuanceTreeRootRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3291,20 +3736,30 @@ func (m *QueryIssuanceTreeRootRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIssuanceTreeRootRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIssuanceTreeRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIssuanceTreeRootResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOperatorDetailsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3314,27 +3769,27 @@ func (m *QueryIssuanceTreeRootResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIssuanceTreeRootResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOperatorDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIssuanceTreeRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRevocationTreeRootRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryOperatorDetailsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3344,20 +3799,32 @@ func (m *QueryRevocationTreeRootRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRevocationTreeRootRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOperatorDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRevocationTreeRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRevocationTreeRootResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAddressDetailsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3367,27 +3834,37 @@ func (m *QueryRevocationTreeRootResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRevocationTreeRootResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAddressDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRevocationTreeRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAddressDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+	if m.OnlyWithExistingIssuer {
+		i--
+		if m.OnlyWithExistingIssuer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIssuanceProofRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAddressDetailsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3397,27 +3874,32 @@ func (m *QueryIssuanceProofRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIssuanceProofRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAddressDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIssuanceProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAddressDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CredentialHash) > 0 {
-		i -= len(m.CredentialHash)
-		copy(dAtA[i:], m.CredentialHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialHash)))
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIssuanceProofResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAddressesDetailsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3427,27 +3909,32 @@ func (m *QueryIssuanceProofResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIssuanceProofResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAddressesDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIssuanceProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAddressesDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EncodedProof) > 0 {
-		i -= len(m.EncodedProof)
-		copy(dAtA[i:], m.EncodedProof)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncodedProof)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRevocationProofRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAddressesDetailsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3457,27 +3944,46 @@ func (m *QueryRevocationProofRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRevocationProofRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAddressesDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRevocationProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAddressesDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CredentialHash) > 0 {
-		i -= len(m.CredentialHash)
-		copy(dAtA[i:], m.CredentialHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialHash)))
-		i--
-		dAtA[i] = 0xa
-	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRevocationProofResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAddressesDetailsResponse_MergedAddressDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3487,27 +3993,61 @@ func (m *QueryRevocationProofResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRevocationProofResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAddressesDetailsResponse_MergedAddressDetails) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRevocationProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAddressesDetailsResponse_MergedAddressDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EncodedProof) > 0 {
-		i -= len(m.EncodedProof)
-		copy(dAtA[i:], m.EncodedProof)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncodedProof)))
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.IsRevoked {
+		i--
+		if m.IsRevoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsVerified {
+		i--
+		if m.IsVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAttachedHolderPublicKeyRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryIssuerDetailsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3517,27 +4057,27 @@ func (m *QueryAttachedHolderPublicKeyRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryAttachedHolderPublicKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIssuerDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAttachedHolderPublicKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIssuerDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if len(m.IssuerAddress) > 0 {
+		i -= len(m.IssuerAddress)
+		copy(dAtA[i:], m.IssuerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IssuerAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAttachedHolderPublicKeyResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryIssuerDetailsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3547,27 +4087,32 @@ func (m *QueryAttachedHolderPublicKeyResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryAttachedHolderPublicKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIssuerDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAttachedHolderPublicKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIssuerDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsCredentialInZKSDIRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryIssuersDetailsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3577,34 +4122,32 @@ func (m *QueryIsCredentialInZKSDIRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsCredentialInZKSDIRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIssuersDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsCredentialInZKSDIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIssuersDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VerificationID) > 0 {
-		i -= len(m.VerificationID)
-		copy(dAtA[i:], m.VerificationID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsCredentialInZKSDIResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryIssuersDetailsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3614,30 +4157,46 @@ func (m *QueryIsCredentialInZKSDIResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsCredentialInZKSDIResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIssuersDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsCredentialInZKSDIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIssuersDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Included {
-		i--
-		if m.Included {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuers) > 0 {
+		for iNdEx := len(m.Issuers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Issuers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCredentialHashRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3647,27 +4206,99 @@ func (m *QueryCredentialHashRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCredentialHashRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCredentialHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VerificationId) > 0 {
-		i -= len(m.VerificationId)
-		copy(dAtA[i:], m.VerificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationId)))
+	if m.IssuanceQuota != nil {
+		{
+			size, err := m.IssuanceQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AllowedVerificationTypes) > 0 {
+		dAtA11 := make([]byte, len(m.AllowedVerificationTypes)*10)
+		var j10 int
+		for _, num := range m.AllowedVerificationTypes {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LegalEntity) > 0 {
+		i -= len(m.LegalEntity)
+		copy(dAtA[i:], m.LegalEntity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LegalEntity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Logo) > 0 {
+		i -= len(m.Logo)
+		copy(dAtA[i:], m.Logo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Logo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IssuerAddress) > 0 {
+		i -= len(m.IssuerAddress)
+		copy(dAtA[i:], m.IssuerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IssuerAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCredentialHashResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVerificationDetailsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3677,27 +4308,27 @@ func (m *QueryCredentialHashResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCredentialHashResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVerificationDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCredentialHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVerificationDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CredentialHash) > 0 {
-		i -= len(m.CredentialHash)
-		copy(dAtA[i:], m.CredentialHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialHash)))
+	if len(m.VerificationID) > 0 {
+		i -= len(m.VerificationID)
+		copy(dAtA[i:], m.VerificationID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHolderByVerificationIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVerificationDetailsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3707,27 +4338,44 @@ func (m *QueryHolderByVerificationIdRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryHolderByVerificationIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVerificationDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHolderByVerificationIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVerificationDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VerificationId) > 0 {
-		i -= len(m.VerificationId)
-		copy(dAtA[i:], m.VerificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationId)))
+	if m.Revocation != nil {
+		{
+			size, err := m.Revocation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHolderByVerificationIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVerificationsDetailsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3737,27 +4385,32 @@ func (m *QueryHolderByVerificationIdResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryHolderByVerificationIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVerificationsDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHolderByVerificationIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVerificationsDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllVerificationDetailsByAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVerificationsDetailsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3767,37 +4420,46 @@ func (m *QueryAllVerificationDetailsByAddressRequest) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllVerificationDetailsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVerificationsDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllVerificationDetailsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVerificationsDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OnlyWithExistingIssuer {
-		i--
-		if m.OnlyWithExistingIssuer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllVerificationDetailsByAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryIssuanceTreeRootRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3807,570 +4469,2948 @@ func (m *QueryAllVerificationDetailsByAddressResponse) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllVerificationDetailsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIssuanceTreeRootRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllVerificationDetailsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIssuanceTreeRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Details) > 0 {
-		for iNdEx := len(m.Details) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Details[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIssuanceTreeRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryIssuanceTreeRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorDetailsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIssuanceTreeRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorDetailsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRevocationTreeRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAddressDetailsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRevocationTreeRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevocationTreeRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.OnlyWithExistingIssuer {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressDetailsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRevocationTreeRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevocationTreeRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevocationTreeRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressesDetailsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRootHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRootHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRootHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressesDetailsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRootHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, e := range m.Addresses {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAddressesDetailsResponse_MergedAddressDetails) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRootHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRootHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IsVerified {
-		n += 2
-	}
-	if m.IsRevoked {
-		n += 2
-	}
-	if len(m.Verifications) > 0 {
-		for _, e := range m.Verifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Roots) > 0 {
+		for iNdEx := len(m.Roots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIssuerDetailsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IssuerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryIssuanceProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIssuerDetailsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryIssuanceProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIssuersDetailsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIssuanceProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.CredentialHash) > 0 {
+		i -= len(m.CredentialHash)
+		copy(dAtA[i:], m.CredentialHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIssuersDetailsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Issuers) > 0 {
-		for _, e := range m.Issuers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryIssuanceProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IssuerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.LegalEntity)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryIssuanceProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVerificationDetailsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIssuanceProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.VerificationID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EncodedProof) > 0 {
+		i -= len(m.EncodedProof)
+		copy(dAtA[i:], m.EncodedProof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncodedProof)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVerificationDetailsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRevocationProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryVerificationsDetailsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRevocationProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevocationProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.CredentialHash) > 0 {
+		i -= len(m.CredentialHash)
+		copy(dAtA[i:], m.CredentialHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVerificationsDetailsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRevocationProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevocationProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevocationProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Verifications) > 0 {
-		for _, e := range m.Verifications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EncodedProof) > 0 {
+		i -= len(m.EncodedProof)
+		copy(dAtA[i:], m.EncodedProof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncodedProof)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIssuanceTreeRootRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAttachedHolderPublicKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIssuanceTreeRootResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAttachedHolderPublicKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRevocationTreeRootRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAttachedHolderPublicKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRevocationTreeRootResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAttachedHolderPublicKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIssuanceProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CredentialHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAttachedHolderPublicKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIssuanceProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAttachedHolderPublicKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EncodedProof)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRevocationProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CredentialHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryIsCredentialInZKSDIRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRevocationProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EncodedProof)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryIsCredentialInZKSDIRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAttachedHolderPublicKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIsCredentialInZKSDIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.VerificationID) > 0 {
+		i -= len(m.VerificationID)
+		copy(dAtA[i:], m.VerificationID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAttachedHolderPublicKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryIsCredentialInZKSDIResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIsCredentialInZKSDIRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.VerificationID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryIsCredentialInZKSDIResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsCredentialInZKSDIResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIsCredentialInZKSDIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Included {
-		n += 2
+		i--
+		if m.Included {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCredentialHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VerificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCredentialPackageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCredentialHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CredentialHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryCredentialPackageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHolderByVerificationIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCredentialPackageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.VerificationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.HolderPublicKey) > 0 {
+		i -= len(m.HolderPublicKey)
+		copy(dAtA[i:], m.HolderPublicKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HolderPublicKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHolderByVerificationIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCredentialPackageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialPackageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialPackageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Package != nil {
+		{
+			size, err := m.Package.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialHash) > 0 {
+		i -= len(m.CredentialHash)
+		copy(dAtA[i:], m.CredentialHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHolderByVerificationIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHolderByVerificationIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHolderByVerificationIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHolderByVerificationIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHolderByVerificationIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHolderByVerificationIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVerificationDetailsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVerificationDetailsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVerificationDetailsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OnlyWithExistingIssuer {
+		i--
+		if m.OnlyWithExistingIssuer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVerificationDetailsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVerificationDetailsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVerificationDetailsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		for iNdEx := len(m.Details) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Details[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerificationsExpiringBetweenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerificationsExpiringBetweenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerificationsExpiringBetweenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerificationsExpiringBetweenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerificationsExpiringBetweenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerificationsExpiringBetweenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerificationsByIssuerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerificationsByIssuerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerificationsByIssuerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpiresBefore != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiresBefore))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiresAfter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiresAfter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RevocationStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevocationStatus))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VerificationType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VerificationType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.IssuerAddress) > 0 {
+		i -= len(m.IssuerAddress)
+		copy(dAtA[i:], m.IssuerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IssuerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerificationsByIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerificationsByIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerificationsByIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuerAliasHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuerAliasHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuerAliasHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IssuerAddress) > 0 {
+		i -= len(m.IssuerAddress)
+		copy(dAtA[i:], m.IssuerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IssuerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuerAliasHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuerAliasHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuerAliasHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CurrentAddress) > 0 {
+		i -= len(m.CurrentAddress)
+		copy(dAtA[i:], m.CurrentAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerificationRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerificationRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerificationRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IssuerAddress) > 0 {
+		i -= len(m.IssuerAddress)
+		copy(dAtA[i:], m.IssuerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IssuerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerificationRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerificationRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerificationRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOperatorDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OnlyWithExistingIssuer {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAddressDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressesDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressesDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressesDetailsResponse_MergedAddressDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsVerified {
+		n += 2
+	}
+	if m.IsRevoked {
+		n += 2
+	}
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIssuerDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IssuerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuerDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuersDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuersDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issuers) > 0 {
+		for _, e := range m.Issuers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IssuerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LegalEntity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AllowedVerificationTypes) > 0 {
+		l = 0
+		for _, e := range m.AllowedVerificationTypes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.IssuanceQuota != nil {
+		l = m.IssuanceQuota.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerificationDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerificationDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Revocation != nil {
+		l = m.Revocation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerificationsDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerificationsDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuanceTreeRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIssuanceTreeRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevocationTreeRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRevocationTreeRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRootHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRootHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roots) > 0 {
+		for _, e := range m.Roots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIssuanceProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredentialHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuanceProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncodedProof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevocationProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredentialHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevocationProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncodedProof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttachedHolderPublicKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttachedHolderPublicKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsCredentialInZKSDIRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VerificationID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsCredentialInZKSDIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Included {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCredentialPackageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HolderPublicKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialPackageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Package != nil {
+		l = m.Package.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredentialHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHolderByVerificationIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHolderByVerificationIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVerificationDetailsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OnlyWithExistingIssuer {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllVerificationDetailsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		for _, e := range m.Details {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVerificationsExpiringBetweenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.StartTimestamp))
+	}
+	if m.EndTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.EndTimestamp))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerificationsExpiringBetweenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerificationsByIssuerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IssuerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VerificationType != 0 {
+		n += 1 + sovQuery(uint64(m.VerificationType))
+	}
+	if m.RevocationStatus != 0 {
+		n += 1 + sovQuery(uint64(m.RevocationStatus))
+	}
+	if m.ExpiresAfter != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiresAfter))
+	}
+	if m.ExpiresBefore != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiresBefore))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerificationsByIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuerAliasHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IssuerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuerAliasHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrentAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVerificationRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IssuerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerificationRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &OperatorDetails{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyWithExistingIssuer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyWithExistingIssuer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &AddressDetails{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressesDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressesDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressesDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressesDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressesDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressesDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, QueryAddressesDetailsResponse_MergedAddressDetails{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressesDetailsResponse_MergedAddressDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergedAddressDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergedAddressDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVerified = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRevoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRevoked = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifications = append(m.Verifications, &Verification{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIssuerDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIssuerDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIssuerDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryAllVerificationDetailsByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.OnlyWithExistingIssuer {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryAllVerificationDetailsByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Details) > 0 {
-		for _, e := range m.Details {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryIssuerDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIssuerDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIssuerDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &IssuerDetails{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuersDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4393,12 +7433,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuersDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuersDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4420,7 +7496,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuersDetailsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4430,28 +7506,62 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIssuersDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIssuersDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Issuers = append(m.Issuers, QueryIssuersDetailsResponse_MergedIssuerDetails{})
+			if err := m.Issuers[len(m.Issuers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4478,7 +7588,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4503,7 +7616,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4526,15 +7639,15 @@ func (m *QueryOperatorDetailsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergedIssuerDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergedIssuerDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4562,61 +7675,272 @@ func (m *QueryOperatorDetailsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOperatorDetailsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalEntity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegalEntity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorDetailsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v VerificationType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= VerificationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedVerificationTypes = append(m.AllowedVerificationTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AllowedVerificationTypes) == 0 {
+					m.AllowedVerificationTypes = make([]VerificationType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v VerificationType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VerificationType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedVerificationTypes = append(m.AllowedVerificationTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedVerificationTypes", wireType)
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceQuota", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4643,10 +7967,10 @@ func (m *QueryOperatorDetailsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &OperatorDetails{}
+			if m.IssuanceQuota == nil {
+				m.IssuanceQuota = &IssuanceQuota{}
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IssuanceQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4671,7 +7995,7 @@ func (m *QueryOperatorDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4694,15 +8018,15 @@ func (m *QueryAddressDetailsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerificationDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerificationDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4730,28 +8054,8 @@ func (m *QueryAddressDetailsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.VerificationID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnlyWithExistingIssuer", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.OnlyWithExistingIssuer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4773,7 +8077,7 @@ func (m *QueryAddressDetailsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationDetailsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4796,15 +8100,15 @@ func (m *QueryAddressDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerificationDetailsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerificationDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4831,10 +8135,46 @@ func (m *QueryAddressDetailsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &AddressDetails{}
+			if m.Details == nil {
+				m.Details = &VerificationDetails{}
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Revocation == nil {
+				m.Revocation = &RevocationDetails{}
+			}
+			if err := m.Revocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4859,7 +8199,7 @@ func (m *QueryAddressDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressesDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationsDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4882,10 +8222,10 @@ func (m *QueryAddressesDetailsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressesDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerificationsDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressesDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerificationsDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4945,7 +8285,7 @@ func (m *QueryAddressesDetailsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressesDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationsDetailsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4968,15 +8308,15 @@ func (m *QueryAddressesDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressesDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerificationsDetailsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressesDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerificationsDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5003,8 +8343,8 @@ func (m *QueryAddressesDetailsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, QueryAddressesDetailsResponse_MergedAddressDetails{})
-			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Verifications = append(m.Verifications, MergedVerificationDetails{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5065,7 +8405,7 @@ func (m *QueryAddressesDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressesDetailsResponse_MergedAddressDetails) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuanceTreeRootRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5088,17 +8428,67 @@ func (m *QueryAddressesDetailsResponse_MergedAddressDetails) Unmarshal(dAtA []by
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergedAddressDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuanceTreeRootRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergedAddressDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuanceTreeRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIssuanceTreeRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIssuanceTreeRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIssuanceTreeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5108,98 +8498,76 @@ func (m *QueryAddressesDetailsResponse_MergedAddressDetails) Unmarshal(dAtA []by
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVerified", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsVerified = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRevoked", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsRevoked = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevocationTreeRootRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Verifications = append(m.Verifications, &Verification{})
-			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevocationTreeRootRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevocationTreeRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5221,7 +8589,7 @@ func (m *QueryAddressesDetailsResponse_MergedAddressDetails) Unmarshal(dAtA []by
 	}
 	return nil
 }
-func (m *QueryIssuerDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRevocationTreeRootResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5244,17 +8612,17 @@ func (m *QueryIssuerDetailsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIssuerDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRevocationTreeRootResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIssuerDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRevocationTreeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5264,23 +8632,25 @@ func (m *QueryIssuerDetailsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5303,7 +8673,7 @@ func (m *QueryIssuerDetailsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIssuerDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRootHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5326,48 +8696,12 @@ func (m *QueryIssuerDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIssuerDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRootHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIssuerDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRootHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Details == nil {
-				m.Details = &IssuerDetails{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5389,7 +8723,7 @@ func (m *QueryIssuerDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIssuersDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRootHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5412,15 +8746,15 @@ func (m *QueryIssuersDetailsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIssuersDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRootHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIssuersDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRootHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5447,10 +8781,8 @@ func (m *QueryIssuersDetailsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Roots = append(m.Roots, TreeRoots{})
+			if err := m.Roots[len(m.Roots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5475,7 +8807,7 @@ func (m *QueryIssuersDetailsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIssuersDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuanceProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5498,51 +8830,17 @@ func (m *QueryIssuersDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIssuersDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuanceProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIssuersDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuanceProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Issuers = append(m.Issuers, QueryIssuersDetailsResponse_MergedIssuerDetails{})
-			if err := m.Issuers[len(m.Issuers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5552,26 +8850,24 @@ func (m *QueryIssuersDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CredentialHash = append(m.CredentialHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialHash == nil {
+				m.CredentialHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5595,7 +8891,7 @@ func (m *QueryIssuersDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuanceProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5618,17 +8914,17 @@ func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergedIssuerDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuanceProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergedIssuerDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuanceProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5638,61 +8934,81 @@ func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			m.EncodedProof = append(m.EncodedProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedProof == nil {
+				m.EncodedProof = []byte{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevocationProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevocationProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevocationProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5702,61 +9018,81 @@ func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			m.CredentialHash = append(m.CredentialHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialHash == nil {
+				m.CredentialHash = []byte{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevocationProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevocationProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevocationProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5766,59 +9102,79 @@ func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LegalEntity", wireType)
+			m.EncodedProof = append(m.EncodedProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedProof == nil {
+				m.EncodedProof = []byte{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttachedHolderPublicKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LegalEntity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttachedHolderPublicKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttachedHolderPublicKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5846,7 +9202,7 @@ func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5869,7 +9225,7 @@ func (m *QueryIssuersDetailsResponse_MergedIssuerDetails) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *QueryVerificationDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttachedHolderPublicKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5892,17 +9248,17 @@ func (m *QueryVerificationDetailsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerificationDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttachedHolderPublicKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerificationDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttachedHolderPublicKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5912,23 +9268,25 @@ func (m *QueryVerificationDetailsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationID = string(dAtA[iNdEx:postIndex])
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5951,7 +9309,7 @@ func (m *QueryVerificationDetailsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerificationDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsCredentialInZKSDIRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5974,17 +9332,17 @@ func (m *QueryVerificationDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerificationDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsCredentialInZKSDIRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerificationDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsCredentialInZKSDIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5994,26 +9352,56 @@ func (m *QueryVerificationDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &VerificationDetails{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.VerificationID = append(m.VerificationID[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerificationID == nil {
+				m.VerificationID = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6037,7 +9425,7 @@ func (m *QueryVerificationDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerificationsDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsCredentialInZKSDIResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6060,17 +9448,17 @@ func (m *QueryVerificationsDetailsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerificationsDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsCredentialInZKSDIResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerificationsDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsCredentialInZKSDIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6080,28 +9468,12 @@ func (m *QueryVerificationsDetailsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Included = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6123,7 +9495,7 @@ func (m *QueryVerificationsDetailsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerificationsDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCredentialPackageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6146,17 +9518,17 @@ func (m *QueryVerificationsDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerificationsDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCredentialPackageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerificationsDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCredentialPackageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6166,31 +9538,31 @@ func (m *QueryVerificationsDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Verifications = append(m.Verifications, MergedVerificationDetails{})
-			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.VerificationId = append(m.VerificationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerificationId == nil {
+				m.VerificationId = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderPublicKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6200,26 +9572,24 @@ func (m *QueryVerificationsDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.HolderPublicKey = append(m.HolderPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.HolderPublicKey == nil {
+				m.HolderPublicKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6243,7 +9613,7 @@ func (m *QueryVerificationsDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIssuanceTreeRootRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCredentialPackageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6266,12 +9636,48 @@ func (m *QueryIssuanceTreeRootRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIssuanceTreeRootRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCredentialPackageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIssuanceTreeRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCredentialPackageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Package == nil {
+				m.Package = &CredentialPackage{}
+			}
+			if err := m.Package.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6293,7 +9699,7 @@ func (m *QueryIssuanceTreeRootRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIssuanceTreeRootResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCredentialHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6316,15 +9722,15 @@ func (m *QueryIssuanceTreeRootResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIssuanceTreeRootResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCredentialHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIssuanceTreeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCredentialHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6351,9 +9757,9 @@ func (m *QueryIssuanceTreeRootResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
+			m.VerificationId = append(m.VerificationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerificationId == nil {
+				m.VerificationId = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6377,7 +9783,7 @@ func (m *QueryIssuanceTreeRootResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRevocationTreeRootRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCredentialHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6400,12 +9806,46 @@ func (m *QueryRevocationTreeRootRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevocationTreeRootRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCredentialHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevocationTreeRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCredentialHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialHash = append(m.CredentialHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialHash == nil {
+				m.CredentialHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6427,7 +9867,7 @@ func (m *QueryRevocationTreeRootRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRevocationTreeRootResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHolderByVerificationIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6450,17 +9890,17 @@ func (m *QueryRevocationTreeRootResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevocationTreeRootResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHolderByVerificationIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevocationTreeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHolderByVerificationIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6470,25 +9910,23 @@ func (m *QueryRevocationTreeRootResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
-			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6511,7 +9949,7 @@ func (m *QueryRevocationTreeRootResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIssuanceProofRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHolderByVerificationIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6534,17 +9972,17 @@ func (m *QueryIssuanceProofRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIssuanceProofRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHolderByVerificationIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIssuanceProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHolderByVerificationIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6554,25 +9992,23 @@ func (m *QueryIssuanceProofRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialHash = append(m.CredentialHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CredentialHash == nil {
-				m.CredentialHash = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6595,7 +10031,7 @@ func (m *QueryIssuanceProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIssuanceProofResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllVerificationDetailsByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6618,17 +10054,17 @@ func (m *QueryIssuanceProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIssuanceProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllVerificationDetailsByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIssuanceProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllVerificationDetailsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncodedProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6638,26 +10074,44 @@ func (m *QueryIssuanceProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncodedProof = append(m.EncodedProof[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncodedProof == nil {
-				m.EncodedProof = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyWithExistingIssuer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyWithExistingIssuer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6679,7 +10133,7 @@ func (m *QueryIssuanceProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRevocationProofRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllVerificationDetailsByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6702,17 +10156,17 @@ func (m *QueryRevocationProofRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevocationProofRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllVerificationDetailsByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevocationProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllVerificationDetailsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6722,24 +10176,24 @@ func (m *QueryRevocationProofRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialHash = append(m.CredentialHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CredentialHash == nil {
-				m.CredentialHash = []byte{}
+			m.Details = append(m.Details, &MergedVerificationDetails{})
+			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -6763,7 +10217,7 @@ func (m *QueryRevocationProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRevocationProofResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationsExpiringBetweenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6786,17 +10240,55 @@ func (m *QueryRevocationProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevocationProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerificationsExpiringBetweenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevocationProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerificationsExpiringBetweenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+			}
+			m.StartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimestamp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
+			}
+			m.EndTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTimestamp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncodedProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6806,24 +10298,26 @@ func (m *QueryRevocationProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncodedProof = append(m.EncodedProof[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncodedProof == nil {
-				m.EncodedProof = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -6847,7 +10341,7 @@ func (m *QueryRevocationProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttachedHolderPublicKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationsExpiringBetweenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6870,17 +10364,17 @@ func (m *QueryAttachedHolderPublicKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttachedHolderPublicKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerificationsExpiringBetweenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttachedHolderPublicKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerificationsExpiringBetweenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6890,23 +10384,61 @@ func (m *QueryAttachedHolderPublicKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Verifications = append(m.Verifications, QueryVerificationsExpiringBetweenResponse_ExpiringVerification{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6929,7 +10461,7 @@ func (m *QueryAttachedHolderPublicKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttachedHolderPublicKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationsExpiringBetweenResponse_ExpiringVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6952,17 +10484,17 @@ func (m *QueryAttachedHolderPublicKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttachedHolderPublicKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExpiringVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttachedHolderPublicKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExpiringVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6972,24 +10504,58 @@ func (m *QueryAttachedHolderPublicKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &MergedVerificationDetails{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -7013,7 +10579,7 @@ func (m *QueryAttachedHolderPublicKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsCredentialInZKSDIRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationsByIssuerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7036,15 +10602,15 @@ func (m *QueryIsCredentialInZKSDIRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsCredentialInZKSDIRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerificationsByIssuerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsCredentialInZKSDIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerificationsByIssuerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7072,13 +10638,89 @@ func (m *QueryIsCredentialInZKSDIRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationType", wireType)
+			}
+			m.VerificationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationType |= VerificationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationStatus", wireType)
+			}
+			m.RevocationStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevocationStatus |= RevocationStatusFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAfter", wireType)
+			}
+			m.ExpiresAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAfter |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresBefore", wireType)
+			}
+			m.ExpiresBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresBefore |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7088,24 +10730,26 @@ func (m *QueryIsCredentialInZKSDIRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationID = append(m.VerificationID[:0], dAtA[iNdEx:postIndex]...)
-			if m.VerificationID == nil {
-				m.VerificationID = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -7129,7 +10773,7 @@ func (m *QueryIsCredentialInZKSDIRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsCredentialInZKSDIResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationsByIssuerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7152,17 +10796,17 @@ func (m *QueryIsCredentialInZKSDIResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsCredentialInZKSDIResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerificationsByIssuerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsCredentialInZKSDIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerificationsByIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7172,67 +10816,31 @@ func (m *QueryIsCredentialInZKSDIResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Included = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCredentialHashRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Verifications = append(m.Verifications, QueryVerificationsByIssuerResponse_IssuedVerification{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCredentialHashRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCredentialHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7242,24 +10850,26 @@ func (m *QueryCredentialHashRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationId = append(m.VerificationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.VerificationId == nil {
-				m.VerificationId = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -7283,7 +10893,7 @@ func (m *QueryCredentialHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCredentialHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationsByIssuerResponse_IssuedVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7306,17 +10916,17 @@ func (m *QueryCredentialHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCredentialHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IssuedVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCredentialHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IssuedVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7326,24 +10936,58 @@ func (m *QueryCredentialHashResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialHash = append(m.CredentialHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CredentialHash == nil {
-				m.CredentialHash = []byte{}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &MergedVerificationDetails{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -7367,7 +11011,7 @@ func (m *QueryCredentialHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHolderByVerificationIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuerAliasHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7390,15 +11034,15 @@ func (m *QueryHolderByVerificationIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHolderByVerificationIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuerAliasHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHolderByVerificationIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuerAliasHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7426,7 +11070,7 @@ func (m *QueryHolderByVerificationIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7449,7 +11093,7 @@ func (m *QueryHolderByVerificationIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHolderByVerificationIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuerAliasHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7472,15 +11116,15 @@ func (m *QueryHolderByVerificationIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHolderByVerificationIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuerAliasHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHolderByVerificationIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuerAliasHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7508,7 +11152,41 @@ func (m *QueryHolderByVerificationIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.CurrentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrations = append(m.Migrations, IssuerMigration{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7531,7 +11209,7 @@ func (m *QueryHolderByVerificationIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVerificationDetailsByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7554,15 +11232,15 @@ func (m *QueryAllVerificationDetailsByAddressRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVerificationDetailsByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerificationRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVerificationDetailsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerificationRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7590,13 +11268,13 @@ func (m *QueryAllVerificationDetailsByAddressRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnlyWithExistingIssuer", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7606,12 +11284,28 @@ func (m *QueryAllVerificationDetailsByAddressRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OnlyWithExistingIssuer = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7633,7 +11327,7 @@ func (m *QueryAllVerificationDetailsByAddressRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryAllVerificationDetailsByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerificationRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7656,15 +11350,15 @@ func (m *QueryAllVerificationDetailsByAddressResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVerificationDetailsByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerificationRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVerificationDetailsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerificationRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7691,8 +11385,44 @@ func (m *QueryAllVerificationDetailsByAddressResponse) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = append(m.Details, &MergedVerificationDetails{})
-			if err := m.Details[len(m.Details)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, VerificationRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
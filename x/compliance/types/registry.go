@@ -0,0 +1,161 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// RegistryEventVerificationRequested is emitted by compliance registry contract
+	// to request issuer to verify holder
+	RegistryEventVerificationRequested = "VerificationRequested"
+	// RegistryEventVerificationChecked is emitted by compliance registry contract
+	// to report result of holder verification check
+	RegistryEventVerificationChecked = "VerificationChecked"
+)
+
+// registryABIJSON contains events of compliance registry contract, which are handled by EVM hook:
+//
+//	event VerificationRequested(address indexed holder, address indexed issuer, uint32 verificationType);
+//	event VerificationChecked(address indexed holder, uint32 indexed verificationType, bool verified);
+const registryABIJSON = `[
+	{
+		"type": "event",
+		"name": "VerificationRequested",
+		"anonymous": false,
+		"inputs": [
+			{"name": "holder", "type": "address", "indexed": true},
+			{"name": "issuer", "type": "address", "indexed": true},
+			{"name": "verificationType", "type": "uint32", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "VerificationChecked",
+		"anonymous": false,
+		"inputs": [
+			{"name": "holder", "type": "address", "indexed": true},
+			{"name": "verificationType", "type": "uint32", "indexed": true},
+			{"name": "verified", "type": "bool", "indexed": false}
+		]
+	}
+]`
+
+// RegistryABI is an ABI of events emitted by compliance registry contract
+var RegistryABI abi.ABI
+
+func init() {
+	var err error
+	if RegistryABI, err = abi.JSON(strings.NewReader(registryABIJSON)); err != nil {
+		panic(err)
+	}
+}
+
+// RegistryVerificationRequested is a decoded `VerificationRequested` log of compliance registry contract
+type RegistryVerificationRequested struct {
+	Holder           sdk.AccAddress
+	Issuer           sdk.AccAddress
+	VerificationType VerificationType
+}
+
+// RegistryVerificationChecked is a decoded `VerificationChecked` log of compliance registry contract
+type RegistryVerificationChecked struct {
+	Holder           sdk.AccAddress
+	VerificationType VerificationType
+	Verified         bool
+}
+
+// ParseRegistryLog decodes log of compliance registry contract. It returns one of
+// `RegistryVerificationRequested`, `RegistryVerificationChecked` or nil, if log has unknown signature.
+func ParseRegistryLog(log *ethtypes.Log) (interface{}, error) {
+	if len(log.Topics) == 0 {
+		return nil, nil
+	}
+
+	event, err := RegistryABI.EventByID(log.Topics[0])
+	if err != nil {
+		// Registry contract can emit other events, which are not handled by the hook
+		return nil, nil
+	}
+
+	indexed := abi.Arguments{}
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if len(log.Topics) != len(indexed)+1 {
+		return nil, errorsmod.Wrapf(ErrInvalidRegistryLog, "%s: expected %d topics, got %d", event.Name, len(indexed)+1, len(log.Topics))
+	}
+
+	fields := make(map[string]interface{})
+	if err = abi.ParseTopicsIntoMap(fields, indexed, log.Topics[1:]); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidRegistryLog, "%s: %s", event.Name, err)
+	}
+	if err = event.Inputs.UnpackIntoMap(fields, log.Data); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidRegistryLog, "%s: %s", event.Name, err)
+	}
+
+	verificationType := VerificationType(fields["verificationType"].(uint32))
+	if !verificationType.IsValid() {
+		return nil, errorsmod.Wrapf(ErrInvalidRegistryLog, "%s: invalid verification type %d", event.Name, verificationType)
+	}
+	holder := fields["holder"].(common.Address)
+
+	switch event.Name {
+	case RegistryEventVerificationRequested:
+		return RegistryVerificationRequested{
+			Holder:           holder.Bytes(),
+			Issuer:           fields["issuer"].(common.Address).Bytes(),
+			VerificationType: verificationType,
+		}, nil
+	default:
+		return RegistryVerificationChecked{
+			Holder:           holder.Bytes(),
+			VerificationType: verificationType,
+			Verified:         fields["verified"].(bool),
+		}, nil
+	}
+}
+
+// PackRegistryLog creates log of compliance registry contract with provided event and arguments.
+// Arguments are passed in the order of event inputs.
+func PackRegistryLog(registry common.Address, name string, args ...interface{}) (*ethtypes.Log, error) {
+	event, found := RegistryABI.Events[name]
+	if !found {
+		return nil, errorsmod.Wrapf(ErrInvalidRegistryLog, "unknown event %s", name)
+	}
+	if len(args) != len(event.Inputs) {
+		return nil, errorsmod.Wrapf(ErrInvalidRegistryLog, "%s: expected %d arguments, got %d", name, len(event.Inputs), len(args))
+	}
+
+	log := &ethtypes.Log{Address: registry, Topics: []common.Hash{event.ID}}
+	var nonIndexed []interface{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexed = append(nonIndexed, args[i])
+			continue
+		}
+		switch value := args[i].(type) {
+		case common.Address:
+			log.Topics = append(log.Topics, common.BytesToHash(value.Bytes()))
+		case uint32:
+			log.Topics = append(log.Topics, common.BigToHash(new(big.Int).SetUint64(uint64(value))))
+		default:
+			return nil, errorsmod.Wrapf(ErrInvalidRegistryLog, "%s: unsupported indexed argument %T", name, value)
+		}
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidRegistryLog, "%s: %s", name, err)
+	}
+	log.Data = data
+	return log, nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -848,28 +849,26 @@ func (m *MsgConvertCredentialResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertCredentialResponse proto.InternalMessageInfo
 
-// VerifyIssuerProposal is a gov Content type to verify issuer
-type VerifyIssuerProposal struct {
-	// title of the proposal
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// description of the proposal
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	// an address of issuer to verify
-	IssuerAddress string `protobuf:"bytes,3,opt,name=issuer_address,json=issuerAddress,proto3" json:"issuer_address,omitempty"`
+// MsgVerifyIssuer defines a Msg for verifying issuer through governance.
+type MsgVerifyIssuer struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// issuer address to verify
+	IssuerAddress string `protobuf:"bytes,2,opt,name=issuer_address,json=issuerAddress,proto3" json:"issuer_address,omitempty"`
 }
 
-func (m *VerifyIssuerProposal) Reset()         { *m = VerifyIssuerProposal{} }
-func (m *VerifyIssuerProposal) String() string { return proto.CompactTextString(m) }
-func (*VerifyIssuerProposal) ProtoMessage()    {}
-func (*VerifyIssuerProposal) Descriptor() ([]byte, []int) {
+func (m *MsgVerifyIssuer) Reset()         { *m = MsgVerifyIssuer{} }
+func (m *MsgVerifyIssuer) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyIssuer) ProtoMessage()    {}
+func (*MsgVerifyIssuer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b617e43f088d8eed, []int{18}
 }
-func (m *VerifyIssuerProposal) XXX_Unmarshal(b []byte) error {
+func (m *MsgVerifyIssuer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VerifyIssuerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgVerifyIssuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VerifyIssuerProposal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgVerifyIssuer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -879,1387 +878,4707 @@ func (m *VerifyIssuerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *VerifyIssuerProposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VerifyIssuerProposal.Merge(m, src)
+func (m *MsgVerifyIssuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyIssuer.Merge(m, src)
 }
-func (m *VerifyIssuerProposal) XXX_Size() int {
+func (m *MsgVerifyIssuer) XXX_Size() int {
 	return m.Size()
 }
-func (m *VerifyIssuerProposal) XXX_DiscardUnknown() {
-	xxx_messageInfo_VerifyIssuerProposal.DiscardUnknown(m)
+func (m *MsgVerifyIssuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyIssuer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VerifyIssuerProposal proto.InternalMessageInfo
-
-func (m *VerifyIssuerProposal) GetTitle() string {
-	if m != nil {
-		return m.Title
-	}
-	return ""
-}
+var xxx_messageInfo_MsgVerifyIssuer proto.InternalMessageInfo
 
-func (m *VerifyIssuerProposal) GetDescription() string {
+func (m *MsgVerifyIssuer) GetAuthority() string {
 	if m != nil {
-		return m.Description
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *VerifyIssuerProposal) GetIssuerAddress() string {
+func (m *MsgVerifyIssuer) GetIssuerAddress() string {
 	if m != nil {
 		return m.IssuerAddress
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgAddOperator)(nil), "swisstronik.compliance.MsgAddOperator")
-	proto.RegisterType((*MsgAddOperatorResponse)(nil), "swisstronik.compliance.MsgAddOperatorResponse")
-	proto.RegisterType((*MsgRemoveOperator)(nil), "swisstronik.compliance.MsgRemoveOperator")
-	proto.RegisterType((*MsgRemoveOperatorResponse)(nil), "swisstronik.compliance.MsgRemoveOperatorResponse")
-	proto.RegisterType((*MsgSetVerificationStatus)(nil), "swisstronik.compliance.MsgSetVerificationStatus")
-	proto.RegisterType((*MsgSetVerificationStatusResponse)(nil), "swisstronik.compliance.MsgSetVerificationStatusResponse")
-	proto.RegisterType((*MsgCreateIssuer)(nil), "swisstronik.compliance.MsgCreateIssuer")
-	proto.RegisterType((*MsgCreateIssuerResponse)(nil), "swisstronik.compliance.MsgCreateIssuerResponse")
-	proto.RegisterType((*MsgUpdateIssuerDetails)(nil), "swisstronik.compliance.MsgUpdateIssuerDetails")
-	proto.RegisterType((*MsgUpdateIssuerDetailsResponse)(nil), "swisstronik.compliance.MsgUpdateIssuerDetailsResponse")
-	proto.RegisterType((*MsgRemoveIssuer)(nil), "swisstronik.compliance.MsgRemoveIssuer")
-	proto.RegisterType((*MsgRemoveIssuerResponse)(nil), "swisstronik.compliance.MsgRemoveIssuerResponse")
-	proto.RegisterType((*MsgRevokeVerification)(nil), "swisstronik.compliance.MsgRevokeVerification")
-	proto.RegisterType((*MsgRevokeVerificationResponse)(nil), "swisstronik.compliance.MsgRevokeVerificationResponse")
-	proto.RegisterType((*MsgAttachHolderPublicKey)(nil), "swisstronik.compliance.MsgAttachHolderPublicKey")
-	proto.RegisterType((*MsgAttachHolderPublicKeyResponse)(nil), "swisstronik.compliance.MsgAttachHolderPublicKeyResponse")
-	proto.RegisterType((*MsgConvertCredential)(nil), "swisstronik.compliance.MsgConvertCredential")
-	proto.RegisterType((*MsgConvertCredentialResponse)(nil), "swisstronik.compliance.MsgConvertCredentialResponse")
-	proto.RegisterType((*VerifyIssuerProposal)(nil), "swisstronik.compliance.VerifyIssuerProposal")
+type MsgVerifyIssuerResponse struct {
 }
 
-func init() { proto.RegisterFile("swisstronik/compliance/tx.proto", fileDescriptor_b617e43f088d8eed) }
-
-var fileDescriptor_b617e43f088d8eed = []byte{
-	// 796 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcf, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc6, 0xe3, 0xdb, 0x7b, 0x7b, 0xcb, 0x09, 0xb4, 0xaa, 0x15, 0xd2, 0xd4, 0x05, 0x27, 0x8a,
-	0xd4, 0x3f, 0x54, 0x10, 0x93, 0xf2, 0x47, 0x55, 0x37, 0xa8, 0x94, 0x45, 0x2b, 0x14, 0x28, 0x29,
-	0x74, 0xc1, 0x26, 0x72, 0xec, 0xc1, 0x1d, 0xc5, 0xf1, 0x58, 0x9e, 0x69, 0x68, 0x84, 0x90, 0x10,
-	0x2c, 0x10, 0x2b, 0x58, 0xb1, 0xe6, 0x11, 0x78, 0x0c, 0x96, 0x5d, 0xb2, 0x44, 0xed, 0x82, 0x3e,
-	0x06, 0x8a, 0x3d, 0x31, 0x76, 0x32, 0x36, 0x09, 0x42, 0x62, 0x95, 0xcc, 0xcc, 0x37, 0xe7, 0xfb,
-	0x79, 0xe6, 0xf8, 0x93, 0xa1, 0x48, 0x9f, 0x63, 0x4a, 0x99, 0x47, 0x1c, 0xdc, 0xd2, 0x0c, 0xd2,
-	0x76, 0x6d, 0xac, 0x3b, 0x06, 0xd2, 0xd8, 0x51, 0xc5, 0xf5, 0x08, 0x23, 0x72, 0x3e, 0x22, 0xa8,
-	0xfc, 0x14, 0x28, 0x39, 0x8b, 0x58, 0xc4, 0x97, 0x68, 0xbd, 0x7f, 0x81, 0x5a, 0x51, 0x0d, 0x42,
-	0xdb, 0x84, 0x6a, 0x4d, 0x9d, 0x22, 0xad, 0x53, 0x6d, 0x22, 0xa6, 0x57, 0x35, 0x83, 0x60, 0x87,
-	0xaf, 0xcf, 0xf1, 0xf5, 0x36, 0xb5, 0xb4, 0x4e, 0xb5, 0xf7, 0xc3, 0x17, 0x16, 0x13, 0x38, 0x90,
-	0xc3, 0x30, 0xc3, 0x88, 0x06, 0xb2, 0xf2, 0x23, 0x98, 0xae, 0x51, 0x6b, 0xd3, 0x34, 0x1f, 0xba,
-	0xc8, 0xd3, 0x19, 0xf1, 0xe4, 0x3c, 0x4c, 0x52, 0x6c, 0x39, 0xc8, 0x2b, 0x48, 0x25, 0x69, 0xe5,
-	0x5c, 0x9d, 0x8f, 0x64, 0x05, 0xa6, 0x08, 0xd7, 0x14, 0xfe, 0xf1, 0x57, 0xc2, 0xf1, 0x46, 0xf6,
-	0xf5, 0xf7, 0xcf, 0xab, 0x5c, 0x58, 0x2e, 0x40, 0x3e, 0x5e, 0xb2, 0x8e, 0xa8, 0x4b, 0x1c, 0x8a,
-	0xca, 0x8f, 0x61, 0xb6, 0x46, 0xad, 0x3a, 0x6a, 0x93, 0x0e, 0xfa, 0x73, 0x7e, 0x0b, 0x30, 0x3f,
-	0x54, 0x35, 0xb4, 0x7c, 0x2b, 0x41, 0xa1, 0x46, 0xad, 0x3d, 0xc4, 0xf6, 0x91, 0x87, 0x9f, 0x61,
-	0x43, 0x67, 0x98, 0x38, 0x7b, 0x4c, 0x67, 0x87, 0x34, 0xd1, 0x7a, 0x11, 0xa6, 0x31, 0xa5, 0x87,
-	0xc8, 0x6b, 0xe8, 0xa6, 0xe9, 0x21, 0x4a, 0x39, 0xc0, 0x85, 0x60, 0x76, 0x33, 0x98, 0x94, 0x8b,
-	0x90, 0xc5, 0xb4, 0xd1, 0xf1, 0xeb, 0x22, 0xb3, 0x30, 0x51, 0x92, 0x56, 0xa6, 0xea, 0x80, 0xe9,
-	0x3e, 0x9f, 0x89, 0x63, 0x96, 0xa1, 0x94, 0x04, 0x12, 0xd2, 0xbe, 0x97, 0x60, 0xa6, 0x46, 0xad,
-	0x2d, 0x0f, 0xe9, 0x0c, 0xed, 0xf8, 0x66, 0x89, 0x90, 0x79, 0x98, 0x0c, 0x70, 0x38, 0x1c, 0x1f,
-	0xc9, 0x77, 0xe0, 0x7f, 0x13, 0x31, 0x1d, 0xdb, 0xd4, 0x27, 0xca, 0xae, 0x2d, 0x56, 0xc4, 0x1d,
-	0x57, 0x09, 0x0c, 0xee, 0x05, 0xe2, 0x7a, 0x7f, 0x57, 0x9c, 0x7a, 0x1e, 0xe6, 0x06, 0x80, 0x42,
-	0xd8, 0x8f, 0x92, 0x7f, 0xd1, 0x4f, 0x5c, 0x33, 0x5c, 0xe3, 0xb5, 0xfe, 0x32, 0x73, 0x09, 0x54,
-	0x31, 0x57, 0x88, 0xfe, 0xc0, 0x3f, 0xe6, 0xa0, 0x65, 0x7e, 0xef, 0x98, 0x45, 0xa7, 0x14, 0xad,
-	0x17, 0x5a, 0x21, 0xb8, 0xe8, 0x2f, 0x75, 0x48, 0x0b, 0x45, 0x6f, 0x3e, 0xd1, 0x70, 0x19, 0x66,
-	0x3a, 0x11, 0x5d, 0x03, 0x9b, 0xbe, 0xf3, 0xf9, 0xfa, 0x74, 0x74, 0x7a, 0x67, 0xa0, 0xbb, 0x8a,
-	0x70, 0x59, 0x68, 0x13, 0x72, 0xb4, 0xfc, 0xf7, 0x60, 0x93, 0x31, 0xdd, 0x38, 0xd8, 0x26, 0xb6,
-	0x89, 0xbc, 0xdd, 0xc3, 0xa6, 0x8d, 0x8d, 0xfb, 0xa8, 0x9b, 0x88, 0xb2, 0x0a, 0xb3, 0x07, 0xbe,
-	0xb4, 0xe1, 0xfa, 0xda, 0x46, 0x0b, 0x75, 0x39, 0xcc, 0xcc, 0x41, 0xbc, 0x86, 0xa8, 0xd7, 0x85,
-	0x66, 0x21, 0x90, 0x01, 0xb9, 0x5e, 0x67, 0x11, 0xa7, 0x83, 0x3c, 0xb6, 0xe5, 0x21, 0xb3, 0x97,
-	0x4c, 0xba, 0x9d, 0x08, 0xb3, 0x04, 0x03, 0x07, 0x30, 0xca, 0xb1, 0xa8, 0x70, 0x49, 0x64, 0x12,
-	0x42, 0xbc, 0x80, 0x9c, 0x7f, 0x5a, 0xdd, 0xe0, 0xd6, 0x76, 0x3d, 0xe2, 0x12, 0xaa, 0xdb, 0x72,
-	0x0e, 0xfe, 0x63, 0x98, 0xd9, 0x88, 0x33, 0x04, 0x03, 0xb9, 0x04, 0x59, 0x13, 0x51, 0xc3, 0xc3,
-	0x6e, 0xcf, 0x8b, 0x37, 0x44, 0x74, 0x4a, 0x90, 0x1c, 0x13, 0x82, 0xe4, 0xd8, 0xf8, 0xf7, 0xec,
-	0x53, 0x31, 0xb3, 0x76, 0x36, 0x05, 0x13, 0x35, 0x6a, 0xc9, 0x2d, 0x98, 0xdd, 0xd6, 0x1d, 0xd3,
-	0x46, 0xd1, 0x18, 0x5e, 0x4a, 0x7a, 0x03, 0xe2, 0xd9, 0xaa, 0x54, 0x46, 0xd3, 0xf5, 0x9f, 0x58,
-	0x66, 0x90, 0x0b, 0xcc, 0x06, 0x62, 0xf8, 0x4a, 0x4a, 0x9d, 0xb8, 0x54, 0xa9, 0x8e, 0x2c, 0x0d,
-	0x5d, 0xdf, 0x49, 0xb0, 0x10, 0xd8, 0x8a, 0x93, 0xf8, 0x7a, 0x4a, 0x49, 0xe1, 0x0e, 0x65, 0x7d,
-	0xdc, 0x1d, 0x21, 0x8b, 0x03, 0x72, 0x80, 0x12, 0x8b, 0xd9, 0xe5, 0x94, 0x7a, 0x51, 0xa1, 0xa2,
-	0x8d, 0x28, 0x0c, 0xfd, 0xde, 0x48, 0x30, 0x1f, 0x18, 0x8a, 0xa2, 0x32, 0xed, 0xfe, 0x04, 0x7a,
-	0xe5, 0xf6, 0x78, 0xfa, 0xe1, 0xa7, 0x8e, 0xa5, 0xde, 0xf2, 0x2f, 0xaf, 0x72, 0x84, 0xa7, 0x16,
-	0xe5, 0x9e, 0xfc, 0x4a, 0x82, 0x42, 0xdf, 0x70, 0x28, 0xfb, 0xae, 0xa5, 0x56, 0x1b, 0x94, 0x2b,
-	0xb7, 0xc6, 0x92, 0x0b, 0x9a, 0x4e, 0x1c, 0x7b, 0x69, 0x4d, 0x27, 0xdc, 0x91, 0xda, 0x74, 0xa9,
-	0x69, 0x27, 0xbf, 0x84, 0x39, 0xde, 0x74, 0x43, 0x81, 0x77, 0x35, 0xad, 0xa1, 0x06, 0xd5, 0xca,
-	0xcd, 0x71, 0xd4, 0x7d, 0xfb, 0xbb, 0xeb, 0x5f, 0x4e, 0x54, 0xe9, 0xf8, 0x44, 0x95, 0xbe, 0x9d,
-	0xa8, 0xd2, 0x87, 0x53, 0x35, 0x73, 0x7c, 0xaa, 0x66, 0xbe, 0x9e, 0xaa, 0x99, 0xa7, 0x6a, 0xf4,
-	0x3b, 0xf1, 0x28, 0xf6, 0xc5, 0xda, 0x75, 0x11, 0x6d, 0x4e, 0xfa, 0xdf, 0x89, 0x37, 0x7e, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xdc, 0x2a, 0x3f, 0xd4, 0xd8, 0x0a, 0x00, 0x00,
+func (m *MsgVerifyIssuerResponse) Reset()         { *m = MsgVerifyIssuerResponse{} }
+func (m *MsgVerifyIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyIssuerResponse) ProtoMessage()    {}
+func (*MsgVerifyIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{19}
+}
+func (m *MsgVerifyIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifyIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyIssuerResponse.Merge(m, src)
+}
+func (m *MsgVerifyIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyIssuerResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgVerifyIssuerResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	HandleAddOperator(ctx context.Context, in *MsgAddOperator, opts ...grpc.CallOption) (*MsgAddOperatorResponse, error)
-	HandleRemoveOperator(ctx context.Context, in *MsgRemoveOperator, opts ...grpc.CallOption) (*MsgRemoveOperatorResponse, error)
-	HandleSetVerificationStatus(ctx context.Context, in *MsgSetVerificationStatus, opts ...grpc.CallOption) (*MsgSetVerificationStatusResponse, error)
-	HandleCreateIssuer(ctx context.Context, in *MsgCreateIssuer, opts ...grpc.CallOption) (*MsgCreateIssuerResponse, error)
-	HandleUpdateIssuerDetails(ctx context.Context, in *MsgUpdateIssuerDetails, opts ...grpc.CallOption) (*MsgUpdateIssuerDetailsResponse, error)
-	HandleRemoveIssuer(ctx context.Context, in *MsgRemoveIssuer, opts ...grpc.CallOption) (*MsgRemoveIssuerResponse, error)
-	HandleRevokeVerification(ctx context.Context, in *MsgRevokeVerification, opts ...grpc.CallOption) (*MsgRevokeVerificationResponse, error)
-	HandleAttachHolderPublicKey(ctx context.Context, in *MsgAttachHolderPublicKey, opts ...grpc.CallOption) (*MsgAttachHolderPublicKeyResponse, error)
-	HandleConvertCredential(ctx context.Context, in *MsgConvertCredential, opts ...grpc.CallOption) (*MsgConvertCredentialResponse, error)
+// MsgUnverifyIssuer defines a Msg for revoking issuer verification through governance.
+type MsgUnverifyIssuer struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// issuer address to unverify
+	IssuerAddress string `protobuf:"bytes,2,opt,name=issuer_address,json=issuerAddress,proto3" json:"issuer_address,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUnverifyIssuer) Reset()         { *m = MsgUnverifyIssuer{} }
+func (m *MsgUnverifyIssuer) String() string { return proto.CompactTextString(m) }
+func (*MsgUnverifyIssuer) ProtoMessage()    {}
+func (*MsgUnverifyIssuer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{20}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUnverifyIssuer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) HandleAddOperator(ctx context.Context, in *MsgAddOperator, opts ...grpc.CallOption) (*MsgAddOperatorResponse, error) {
-	out := new(MsgAddOperatorResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleAddOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnverifyIssuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnverifyIssuer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnverifyIssuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnverifyIssuer.Merge(m, src)
+}
+func (m *MsgUnverifyIssuer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnverifyIssuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnverifyIssuer.DiscardUnknown(m)
 }
 
-func (c *msgClient) HandleRemoveOperator(ctx context.Context, in *MsgRemoveOperator, opts ...grpc.CallOption) (*MsgRemoveOperatorResponse, error) {
-	out := new(MsgRemoveOperatorResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleRemoveOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnverifyIssuer proto.InternalMessageInfo
+
+func (m *MsgUnverifyIssuer) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) HandleSetVerificationStatus(ctx context.Context, in *MsgSetVerificationStatus, opts ...grpc.CallOption) (*MsgSetVerificationStatusResponse, error) {
-	out := new(MsgSetVerificationStatusResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleSetVerificationStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnverifyIssuer) GetIssuerAddress() string {
+	if m != nil {
+		return m.IssuerAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) HandleCreateIssuer(ctx context.Context, in *MsgCreateIssuer, opts ...grpc.CallOption) (*MsgCreateIssuerResponse, error) {
-	out := new(MsgCreateIssuerResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleCreateIssuer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgUnverifyIssuerResponse struct {
 }
 
-func (c *msgClient) HandleUpdateIssuerDetails(ctx context.Context, in *MsgUpdateIssuerDetails, opts ...grpc.CallOption) (*MsgUpdateIssuerDetailsResponse, error) {
-	out := new(MsgUpdateIssuerDetailsResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleUpdateIssuerDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnverifyIssuerResponse) Reset()         { *m = MsgUnverifyIssuerResponse{} }
+func (m *MsgUnverifyIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnverifyIssuerResponse) ProtoMessage()    {}
+func (*MsgUnverifyIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{21}
+}
+func (m *MsgUnverifyIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnverifyIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnverifyIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnverifyIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnverifyIssuerResponse.Merge(m, src)
+}
+func (m *MsgUnverifyIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnverifyIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnverifyIssuerResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) HandleRemoveIssuer(ctx context.Context, in *MsgRemoveIssuer, opts ...grpc.CallOption) (*MsgRemoveIssuerResponse, error) {
-	out := new(MsgRemoveIssuerResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleRemoveIssuer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUnverifyIssuerResponse proto.InternalMessageInfo
+
+// MsgGovAddOperator defines a Msg for adding regular operator through governance.
+type MsgGovAddOperator struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// operator address to add
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
-func (c *msgClient) HandleRevokeVerification(ctx context.Context, in *MsgRevokeVerification, opts ...grpc.CallOption) (*MsgRevokeVerificationResponse, error) {
-	out := new(MsgRevokeVerificationResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleRevokeVerification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGovAddOperator) Reset()         { *m = MsgGovAddOperator{} }
+func (m *MsgGovAddOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgGovAddOperator) ProtoMessage()    {}
+func (*MsgGovAddOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{22}
+}
+func (m *MsgGovAddOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovAddOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovAddOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgGovAddOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovAddOperator.Merge(m, src)
+}
+func (m *MsgGovAddOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovAddOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovAddOperator.DiscardUnknown(m)
 }
 
-func (c *msgClient) HandleAttachHolderPublicKey(ctx context.Context, in *MsgAttachHolderPublicKey, opts ...grpc.CallOption) (*MsgAttachHolderPublicKeyResponse, error) {
-	out := new(MsgAttachHolderPublicKeyResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleAttachHolderPublicKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGovAddOperator proto.InternalMessageInfo
+
+func (m *MsgGovAddOperator) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) HandleConvertCredential(ctx context.Context, in *MsgConvertCredential, opts ...grpc.CallOption) (*MsgConvertCredentialResponse, error) {
-	out := new(MsgConvertCredentialResponse)
-	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleConvertCredential", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGovAddOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	HandleAddOperator(context.Context, *MsgAddOperator) (*MsgAddOperatorResponse, error)
-	HandleRemoveOperator(context.Context, *MsgRemoveOperator) (*MsgRemoveOperatorResponse, error)
-	HandleSetVerificationStatus(context.Context, *MsgSetVerificationStatus) (*MsgSetVerificationStatusResponse, error)
-	HandleCreateIssuer(context.Context, *MsgCreateIssuer) (*MsgCreateIssuerResponse, error)
-	HandleUpdateIssuerDetails(context.Context, *MsgUpdateIssuerDetails) (*MsgUpdateIssuerDetailsResponse, error)
-	HandleRemoveIssuer(context.Context, *MsgRemoveIssuer) (*MsgRemoveIssuerResponse, error)
-	HandleRevokeVerification(context.Context, *MsgRevokeVerification) (*MsgRevokeVerificationResponse, error)
-	HandleAttachHolderPublicKey(context.Context, *MsgAttachHolderPublicKey) (*MsgAttachHolderPublicKeyResponse, error)
-	HandleConvertCredential(context.Context, *MsgConvertCredential) (*MsgConvertCredentialResponse, error)
+type MsgGovAddOperatorResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgGovAddOperatorResponse) Reset()         { *m = MsgGovAddOperatorResponse{} }
+func (m *MsgGovAddOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovAddOperatorResponse) ProtoMessage()    {}
+func (*MsgGovAddOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{23}
 }
-
-func (*UnimplementedMsgServer) HandleAddOperator(ctx context.Context, req *MsgAddOperator) (*MsgAddOperatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleAddOperator not implemented")
+func (m *MsgGovAddOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) HandleRemoveOperator(ctx context.Context, req *MsgRemoveOperator) (*MsgRemoveOperatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleRemoveOperator not implemented")
+func (m *MsgGovAddOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovAddOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) HandleSetVerificationStatus(ctx context.Context, req *MsgSetVerificationStatus) (*MsgSetVerificationStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleSetVerificationStatus not implemented")
+func (m *MsgGovAddOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovAddOperatorResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) HandleCreateIssuer(ctx context.Context, req *MsgCreateIssuer) (*MsgCreateIssuerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleCreateIssuer not implemented")
+func (m *MsgGovAddOperatorResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) HandleUpdateIssuerDetails(ctx context.Context, req *MsgUpdateIssuerDetails) (*MsgUpdateIssuerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleUpdateIssuerDetails not implemented")
+func (m *MsgGovAddOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovAddOperatorResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) HandleRemoveIssuer(ctx context.Context, req *MsgRemoveIssuer) (*MsgRemoveIssuerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleRemoveIssuer not implemented")
+
+var xxx_messageInfo_MsgGovAddOperatorResponse proto.InternalMessageInfo
+
+// MsgGovRemoveOperator defines a Msg for removing regular operator through governance.
+type MsgGovRemoveOperator struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// operator address to remove
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
 }
-func (*UnimplementedMsgServer) HandleRevokeVerification(ctx context.Context, req *MsgRevokeVerification) (*MsgRevokeVerificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleRevokeVerification not implemented")
+
+func (m *MsgGovRemoveOperator) Reset()         { *m = MsgGovRemoveOperator{} }
+func (m *MsgGovRemoveOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgGovRemoveOperator) ProtoMessage()    {}
+func (*MsgGovRemoveOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{24}
 }
-func (*UnimplementedMsgServer) HandleAttachHolderPublicKey(ctx context.Context, req *MsgAttachHolderPublicKey) (*MsgAttachHolderPublicKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleAttachHolderPublicKey not implemented")
+func (m *MsgGovRemoveOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) HandleConvertCredential(ctx context.Context, req *MsgConvertCredential) (*MsgConvertCredentialResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleConvertCredential not implemented")
+func (m *MsgGovRemoveOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovRemoveOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgGovRemoveOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovRemoveOperator.Merge(m, src)
+}
+func (m *MsgGovRemoveOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovRemoveOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovRemoveOperator.DiscardUnknown(m)
 }
 
-func _Msg_HandleAddOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddOperator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleAddOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Msg/HandleAddOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleAddOperator(ctx, req.(*MsgAddOperator))
+var xxx_messageInfo_MsgGovRemoveOperator proto.InternalMessageInfo
+
+func (m *MsgGovRemoveOperator) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_HandleRemoveOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveOperator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleRemoveOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Msg/HandleRemoveOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleRemoveOperator(ctx, req.(*MsgRemoveOperator))
+func (m *MsgGovRemoveOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_HandleSetVerificationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetVerificationStatus)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleSetVerificationStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Msg/HandleSetVerificationStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleSetVerificationStatus(ctx, req.(*MsgSetVerificationStatus))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgGovRemoveOperatorResponse struct {
 }
 
-func _Msg_HandleCreateIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateIssuer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleCreateIssuer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Msg/HandleCreateIssuer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleCreateIssuer(ctx, req.(*MsgCreateIssuer))
+func (m *MsgGovRemoveOperatorResponse) Reset()         { *m = MsgGovRemoveOperatorResponse{} }
+func (m *MsgGovRemoveOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovRemoveOperatorResponse) ProtoMessage()    {}
+func (*MsgGovRemoveOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{25}
+}
+func (m *MsgGovRemoveOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovRemoveOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovRemoveOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGovRemoveOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovRemoveOperatorResponse.Merge(m, src)
+}
+func (m *MsgGovRemoveOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovRemoveOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovRemoveOperatorResponse.DiscardUnknown(m)
 }
 
-func _Msg_HandleUpdateIssuerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateIssuerDetails)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleUpdateIssuerDetails(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Msg/HandleUpdateIssuerDetails",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleUpdateIssuerDetails(ctx, req.(*MsgUpdateIssuerDetails))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgGovRemoveOperatorResponse proto.InternalMessageInfo
+
+// VerifyIssuerProposal is a gov Content type to verify issuer
+type VerifyIssuerProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// an address of issuer to verify
+	IssuerAddress string `protobuf:"bytes,3,opt,name=issuer_address,json=issuerAddress,proto3" json:"issuer_address,omitempty"`
 }
 
-func _Msg_HandleRemoveIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveIssuer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleRemoveIssuer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Msg/HandleRemoveIssuer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleRemoveIssuer(ctx, req.(*MsgRemoveIssuer))
+func (m *VerifyIssuerProposal) Reset()         { *m = VerifyIssuerProposal{} }
+func (m *VerifyIssuerProposal) String() string { return proto.CompactTextString(m) }
+func (*VerifyIssuerProposal) ProtoMessage()    {}
+func (*VerifyIssuerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{26}
+}
+func (m *VerifyIssuerProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyIssuerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyIssuerProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *VerifyIssuerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyIssuerProposal.Merge(m, src)
+}
+func (m *VerifyIssuerProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyIssuerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyIssuerProposal.DiscardUnknown(m)
 }
 
-func _Msg_HandleRevokeVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeVerification)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleRevokeVerification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Msg/HandleRevokeVerification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleRevokeVerification(ctx, req.(*MsgRevokeVerification))
+var xxx_messageInfo_VerifyIssuerProposal proto.InternalMessageInfo
+
+func (m *VerifyIssuerProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_HandleAttachHolderPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAttachHolderPublicKey)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleAttachHolderPublicKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Msg/HandleAttachHolderPublicKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleAttachHolderPublicKey(ctx, req.(*MsgAttachHolderPublicKey))
+func (m *VerifyIssuerProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_HandleConvertCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertCredential)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *VerifyIssuerProposal) GetIssuerAddress() string {
+	if m != nil {
+		return m.IssuerAddress
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleConvertCredential(ctx, in)
+	return ""
+}
+
+// MsgSetIssuerVerificationTypes defines a Msg for setting verification types allowed for issuer.
+type MsgSetIssuerVerificationTypes struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// issuer address to set allowed verification types
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// allowed verification types. Empty list allows all the verification types
+	VerificationTypes []VerificationType `protobuf:"varint,3,rep,packed,name=verification_types,json=verificationTypes,proto3,enum=swisstronik.compliance.VerificationType" json:"verification_types,omitempty"`
+}
+
+func (m *MsgSetIssuerVerificationTypes) Reset()         { *m = MsgSetIssuerVerificationTypes{} }
+func (m *MsgSetIssuerVerificationTypes) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIssuerVerificationTypes) ProtoMessage()    {}
+func (*MsgSetIssuerVerificationTypes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{27}
+}
+func (m *MsgSetIssuerVerificationTypes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIssuerVerificationTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIssuerVerificationTypes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/swisstronik.compliance.Msg/HandleConvertCredential",
+}
+func (m *MsgSetIssuerVerificationTypes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIssuerVerificationTypes.Merge(m, src)
+}
+func (m *MsgSetIssuerVerificationTypes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIssuerVerificationTypes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIssuerVerificationTypes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIssuerVerificationTypes proto.InternalMessageInfo
+
+func (m *MsgSetIssuerVerificationTypes) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleConvertCredential(ctx, req.(*MsgConvertCredential))
+	return ""
+}
+
+func (m *MsgSetIssuerVerificationTypes) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "swisstronik.compliance.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "HandleAddOperator",
-			Handler:    _Msg_HandleAddOperator_Handler,
-		},
-		{
-			MethodName: "HandleRemoveOperator",
-			Handler:    _Msg_HandleRemoveOperator_Handler,
-		},
-		{
-			MethodName: "HandleSetVerificationStatus",
-			Handler:    _Msg_HandleSetVerificationStatus_Handler,
-		},
-		{
-			MethodName: "HandleCreateIssuer",
-			Handler:    _Msg_HandleCreateIssuer_Handler,
-		},
-		{
-			MethodName: "HandleUpdateIssuerDetails",
-			Handler:    _Msg_HandleUpdateIssuerDetails_Handler,
-		},
-		{
-			MethodName: "HandleRemoveIssuer",
-			Handler:    _Msg_HandleRemoveIssuer_Handler,
-		},
-		{
-			MethodName: "HandleRevokeVerification",
-			Handler:    _Msg_HandleRevokeVerification_Handler,
-		},
-		{
-			MethodName: "HandleAttachHolderPublicKey",
-			Handler:    _Msg_HandleAttachHolderPublicKey_Handler,
-		},
-		{
-			MethodName: "HandleConvertCredential",
-			Handler:    _Msg_HandleConvertCredential_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "swisstronik/compliance/tx.proto",
+func (m *MsgSetIssuerVerificationTypes) GetVerificationTypes() []VerificationType {
+	if m != nil {
+		return m.VerificationTypes
+	}
+	return nil
 }
 
-func (m *MsgAddOperator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgSetIssuerVerificationTypesResponse struct {
+}
+
+func (m *MsgSetIssuerVerificationTypesResponse) Reset()         { *m = MsgSetIssuerVerificationTypesResponse{} }
+func (m *MsgSetIssuerVerificationTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIssuerVerificationTypesResponse) ProtoMessage()    {}
+func (*MsgSetIssuerVerificationTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{28}
+}
+func (m *MsgSetIssuerVerificationTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIssuerVerificationTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIssuerVerificationTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetIssuerVerificationTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIssuerVerificationTypesResponse.Merge(m, src)
+}
+func (m *MsgSetIssuerVerificationTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIssuerVerificationTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIssuerVerificationTypesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddOperator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetIssuerVerificationTypesResponse proto.InternalMessageInfo
+
+// MsgSetIssuerQuota defines a Msg for setting issuance quota of issuer.
+type MsgSetIssuerQuota struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// issuer address to set issuance quota
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// issuance quota. Zero `max_issuances` removes the limit
+	Quota IssuanceQuota `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota"`
 }
 
-func (m *MsgAddOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgSetIssuerQuota) Reset()         { *m = MsgSetIssuerQuota{} }
+func (m *MsgSetIssuerQuota) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIssuerQuota) ProtoMessage()    {}
+func (*MsgSetIssuerQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{29}
+}
+func (m *MsgSetIssuerQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIssuerQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIssuerQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetIssuerQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIssuerQuota.Merge(m, src)
+}
+func (m *MsgSetIssuerQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIssuerQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIssuerQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIssuerQuota proto.InternalMessageInfo
+
+func (m *MsgSetIssuerQuota) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddOperatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetIssuerQuota) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetIssuerQuota) GetQuota() IssuanceQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return IssuanceQuota{}
 }
 
-func (m *MsgAddOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetIssuerQuotaResponse struct {
 }
 
-func (m *MsgRemoveOperator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetIssuerQuotaResponse) Reset()         { *m = MsgSetIssuerQuotaResponse{} }
+func (m *MsgSetIssuerQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIssuerQuotaResponse) ProtoMessage()    {}
+func (*MsgSetIssuerQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{30}
+}
+func (m *MsgSetIssuerQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIssuerQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIssuerQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetIssuerQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIssuerQuotaResponse.Merge(m, src)
+}
+func (m *MsgSetIssuerQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIssuerQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIssuerQuotaResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveOperator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetIssuerQuotaResponse proto.InternalMessageInfo
+
+// MsgMigrateIssuer defines a Msg for moving issuer details, verification status and
+// issued verifications to the new issuer address.
+type MsgMigrateIssuer struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// current issuer address
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// new issuer address
+	NewIssuer string `protobuf:"bytes,3,opt,name=new_issuer,json=newIssuer,proto3" json:"new_issuer,omitempty"`
 }
 
-func (m *MsgRemoveOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgMigrateIssuer) Reset()         { *m = MsgMigrateIssuer{} }
+func (m *MsgMigrateIssuer) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateIssuer) ProtoMessage()    {}
+func (*MsgMigrateIssuer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{31}
+}
+func (m *MsgMigrateIssuer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateIssuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateIssuer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgMigrateIssuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateIssuer.Merge(m, src)
+}
+func (m *MsgMigrateIssuer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateIssuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateIssuer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateIssuer proto.InternalMessageInfo
+
+func (m *MsgMigrateIssuer) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRemoveOperatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateIssuer) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRemoveOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMigrateIssuer) GetNewIssuer() string {
+	if m != nil {
+		return m.NewIssuer
+	}
+	return ""
 }
 
-func (m *MsgRemoveOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgMigrateIssuerResponse struct {
+	// number of verification records, which were moved to the new issuer address
+	MigratedVerifications uint64 `protobuf:"varint,1,opt,name=migrated_verifications,json=migratedVerifications,proto3" json:"migrated_verifications,omitempty"`
 }
 
-func (m *MsgSetVerificationStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateIssuerResponse) Reset()         { *m = MsgMigrateIssuerResponse{} }
+func (m *MsgMigrateIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateIssuerResponse) ProtoMessage()    {}
+func (*MsgMigrateIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{32}
+}
+func (m *MsgMigrateIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgMigrateIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateIssuerResponse.Merge(m, src)
+}
+func (m *MsgMigrateIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateIssuerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetVerificationStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgMigrateIssuerResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateIssuerResponse) GetMigratedVerifications() uint64 {
+	if m != nil {
+		return m.MigratedVerifications
+	}
+	return 0
 }
 
-func (m *MsgSetVerificationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsVerified {
-		i--
-		if m.IsVerified {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// MsgRevokeVerifications defines a Msg for revoking multiple verifications at once.
+type MsgRevokeVerifications struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// ids of verifications to revoke
+	VerificationIds [][]byte `protobuf:"bytes,2,rep,name=verification_ids,json=verificationIds,proto3" json:"verification_ids,omitempty"`
+	// revocation reason
+	Reason RevocationReason `protobuf:"varint,3,opt,name=reason,proto3,enum=swisstronik.compliance.RevocationReason" json:"reason,omitempty"`
+	// optional note, which describes revocation
+	Note string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (m *MsgRevokeVerifications) Reset()         { *m = MsgRevokeVerifications{} }
+func (m *MsgRevokeVerifications) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVerifications) ProtoMessage()    {}
+func (*MsgRevokeVerifications) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{33}
+}
+func (m *MsgRevokeVerifications) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeVerifications) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVerifications.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.IssuerAddress) > 0 {
-		i -= len(m.IssuerAddress)
-		copy(dAtA[i:], m.IssuerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IssuerAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRevokeVerifications) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVerifications.Merge(m, src)
+}
+func (m *MsgRevokeVerifications) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeVerifications) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVerifications.DiscardUnknown(m)
 }
 
-func (m *MsgSetVerificationStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevokeVerifications proto.InternalMessageInfo
+
+func (m *MsgRevokeVerifications) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetVerificationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeVerifications) GetVerificationIds() [][]byte {
+	if m != nil {
+		return m.VerificationIds
+	}
+	return nil
 }
 
-func (m *MsgSetVerificationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeVerifications) GetReason() RevocationReason {
+	if m != nil {
+		return m.Reason
+	}
+	return RevocationReason_RR_UNSPECIFIED
 }
 
-func (m *MsgCreateIssuer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeVerifications) GetNote() string {
+	if m != nil {
+		return m.Note
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateIssuer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRevokeVerificationsResponse struct {
 }
 
-func (m *MsgCreateIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgRevokeVerificationsResponse) Reset()         { *m = MsgRevokeVerificationsResponse{} }
+func (m *MsgRevokeVerificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVerificationsResponse) ProtoMessage()    {}
+func (*MsgRevokeVerificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b617e43f088d8eed, []int{34}
+}
+func (m *MsgRevokeVerificationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeVerificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVerificationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRevokeVerificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVerificationsResponse.Merge(m, src)
+}
+func (m *MsgRevokeVerificationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeVerificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVerificationsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateIssuerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgRevokeVerificationsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddOperator)(nil), "swisstronik.compliance.MsgAddOperator")
+	proto.RegisterType((*MsgAddOperatorResponse)(nil), "swisstronik.compliance.MsgAddOperatorResponse")
+	proto.RegisterType((*MsgRemoveOperator)(nil), "swisstronik.compliance.MsgRemoveOperator")
+	proto.RegisterType((*MsgRemoveOperatorResponse)(nil), "swisstronik.compliance.MsgRemoveOperatorResponse")
+	proto.RegisterType((*MsgSetVerificationStatus)(nil), "swisstronik.compliance.MsgSetVerificationStatus")
+	proto.RegisterType((*MsgSetVerificationStatusResponse)(nil), "swisstronik.compliance.MsgSetVerificationStatusResponse")
+	proto.RegisterType((*MsgCreateIssuer)(nil), "swisstronik.compliance.MsgCreateIssuer")
+	proto.RegisterType((*MsgCreateIssuerResponse)(nil), "swisstronik.compliance.MsgCreateIssuerResponse")
+	proto.RegisterType((*MsgUpdateIssuerDetails)(nil), "swisstronik.compliance.MsgUpdateIssuerDetails")
+	proto.RegisterType((*MsgUpdateIssuerDetailsResponse)(nil), "swisstronik.compliance.MsgUpdateIssuerDetailsResponse")
+	proto.RegisterType((*MsgRemoveIssuer)(nil), "swisstronik.compliance.MsgRemoveIssuer")
+	proto.RegisterType((*MsgRemoveIssuerResponse)(nil), "swisstronik.compliance.MsgRemoveIssuerResponse")
+	proto.RegisterType((*MsgRevokeVerification)(nil), "swisstronik.compliance.MsgRevokeVerification")
+	proto.RegisterType((*MsgRevokeVerificationResponse)(nil), "swisstronik.compliance.MsgRevokeVerificationResponse")
+	proto.RegisterType((*MsgAttachHolderPublicKey)(nil), "swisstronik.compliance.MsgAttachHolderPublicKey")
+	proto.RegisterType((*MsgAttachHolderPublicKeyResponse)(nil), "swisstronik.compliance.MsgAttachHolderPublicKeyResponse")
+	proto.RegisterType((*MsgConvertCredential)(nil), "swisstronik.compliance.MsgConvertCredential")
+	proto.RegisterType((*MsgConvertCredentialResponse)(nil), "swisstronik.compliance.MsgConvertCredentialResponse")
+	proto.RegisterType((*MsgVerifyIssuer)(nil), "swisstronik.compliance.MsgVerifyIssuer")
+	proto.RegisterType((*MsgVerifyIssuerResponse)(nil), "swisstronik.compliance.MsgVerifyIssuerResponse")
+	proto.RegisterType((*MsgUnverifyIssuer)(nil), "swisstronik.compliance.MsgUnverifyIssuer")
+	proto.RegisterType((*MsgUnverifyIssuerResponse)(nil), "swisstronik.compliance.MsgUnverifyIssuerResponse")
+	proto.RegisterType((*MsgGovAddOperator)(nil), "swisstronik.compliance.MsgGovAddOperator")
+	proto.RegisterType((*MsgGovAddOperatorResponse)(nil), "swisstronik.compliance.MsgGovAddOperatorResponse")
+	proto.RegisterType((*MsgGovRemoveOperator)(nil), "swisstronik.compliance.MsgGovRemoveOperator")
+	proto.RegisterType((*MsgGovRemoveOperatorResponse)(nil), "swisstronik.compliance.MsgGovRemoveOperatorResponse")
+	proto.RegisterType((*VerifyIssuerProposal)(nil), "swisstronik.compliance.VerifyIssuerProposal")
+	proto.RegisterType((*MsgSetIssuerVerificationTypes)(nil), "swisstronik.compliance.MsgSetIssuerVerificationTypes")
+	proto.RegisterType((*MsgSetIssuerVerificationTypesResponse)(nil), "swisstronik.compliance.MsgSetIssuerVerificationTypesResponse")
+	proto.RegisterType((*MsgSetIssuerQuota)(nil), "swisstronik.compliance.MsgSetIssuerQuota")
+	proto.RegisterType((*MsgSetIssuerQuotaResponse)(nil), "swisstronik.compliance.MsgSetIssuerQuotaResponse")
+	proto.RegisterType((*MsgMigrateIssuer)(nil), "swisstronik.compliance.MsgMigrateIssuer")
+	proto.RegisterType((*MsgMigrateIssuerResponse)(nil), "swisstronik.compliance.MsgMigrateIssuerResponse")
+	proto.RegisterType((*MsgRevokeVerifications)(nil), "swisstronik.compliance.MsgRevokeVerifications")
+	proto.RegisterType((*MsgRevokeVerificationsResponse)(nil), "swisstronik.compliance.MsgRevokeVerificationsResponse")
 }
 
-func (m *MsgCreateIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("swisstronik/compliance/tx.proto", fileDescriptor_b617e43f088d8eed) }
+
+var fileDescriptor_b617e43f088d8eed = []byte{
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xd9, 0x34, 0xb4, 0x2f, 0x90, 0x1f, 0x66, 0x9b, 0x6c, 0x5c, 0xba, 0x59, 0xad, 0x94,
+	0x26, 0xa9, 0xda, 0xdd, 0x6e, 0x68, 0xaa, 0xa8, 0x12, 0x82, 0xa4, 0x48, 0x6d, 0x84, 0x16, 0x1a,
+	0xa7, 0x2d, 0x12, 0x97, 0x95, 0xb3, 0x1e, 0x1c, 0x2b, 0x1b, 0xcf, 0xd6, 0x33, 0x71, 0x1a, 0x10,
+	0x52, 0x55, 0x0e, 0x88, 0x13, 0x48, 0x48, 0x88, 0x23, 0x7f, 0x02, 0x07, 0xb8, 0x72, 0xee, 0x05,
+	0xa9, 0xe2, 0xc4, 0x09, 0xa1, 0xe4, 0x00, 0x7f, 0x06, 0xf2, 0xcc, 0xec, 0xc4, 0x3f, 0xc6, 0xc6,
+	0x1b, 0x21, 0x7a, 0xda, 0xb5, 0xe7, 0x7b, 0xef, 0xfb, 0x3c, 0xef, 0xf9, 0x9b, 0x27, 0xc3, 0x3c,
+	0x39, 0x74, 0x09, 0xa1, 0x3e, 0xf6, 0xdc, 0xbd, 0x66, 0x17, 0xef, 0xf7, 0x7b, 0xae, 0xe5, 0x75,
+	0x51, 0x93, 0x3e, 0x69, 0xf4, 0x7d, 0x4c, 0xb1, 0x3e, 0x13, 0x01, 0x34, 0x4e, 0x01, 0x46, 0xd9,
+	0xc1, 0x0e, 0x66, 0x90, 0x66, 0xf8, 0x8f, 0xa3, 0x8d, 0x6a, 0x17, 0x93, 0x7d, 0x4c, 0x9a, 0x3b,
+	0x16, 0x41, 0xcd, 0xa0, 0xb5, 0x83, 0xa8, 0xd5, 0x6a, 0x76, 0xb1, 0xeb, 0x89, 0xf5, 0x59, 0xb1,
+	0xbe, 0x4f, 0x9c, 0x66, 0xd0, 0x0a, 0x7f, 0xc4, 0xc2, 0x1c, 0x5f, 0xe8, 0xf0, 0x8c, 0xfc, 0x42,
+	0x2c, 0x2d, 0x64, 0x48, 0x44, 0x1e, 0x75, 0xa9, 0x8b, 0x04, 0xac, 0xbe, 0x05, 0x13, 0x6d, 0xe2,
+	0xac, 0xdb, 0xf6, 0x87, 0x7d, 0xe4, 0x5b, 0x14, 0xfb, 0xfa, 0x0c, 0x8c, 0x11, 0xd7, 0xf1, 0x90,
+	0x5f, 0xd1, 0x6a, 0xda, 0xd2, 0x05, 0x53, 0x5c, 0xe9, 0x06, 0x9c, 0xc7, 0x02, 0x53, 0x79, 0x85,
+	0xad, 0xc8, 0xeb, 0xdb, 0xe3, 0xcf, 0xfe, 0xfa, 0xf1, 0xaa, 0x00, 0xd6, 0x2b, 0x30, 0x13, 0x4f,
+	0x69, 0x22, 0xd2, 0xc7, 0x1e, 0x41, 0xf5, 0x07, 0x30, 0xdd, 0x26, 0x8e, 0x89, 0xf6, 0x71, 0x80,
+	0xfe, 0x3b, 0xbe, 0x4b, 0x30, 0x97, 0xca, 0x2a, 0x29, 0xbf, 0xd4, 0xa0, 0xd2, 0x26, 0xce, 0x36,
+	0xa2, 0x8f, 0x90, 0xef, 0x7e, 0xe2, 0x76, 0x2d, 0xea, 0x62, 0x6f, 0x9b, 0x5a, 0xf4, 0x80, 0x64,
+	0x52, 0x2f, 0xc0, 0x84, 0x4b, 0xc8, 0x01, 0xf2, 0x3b, 0x96, 0x6d, 0xfb, 0x88, 0x10, 0x21, 0xe0,
+	0x75, 0x7e, 0x77, 0x9d, 0xdf, 0xd4, 0xe7, 0x61, 0xdc, 0x25, 0x9d, 0x80, 0xe5, 0x45, 0x76, 0xa5,
+	0x54, 0xd3, 0x96, 0xce, 0x9b, 0xe0, 0x92, 0x47, 0xe2, 0x4e, 0x5c, 0x66, 0x1d, 0x6a, 0x59, 0x42,
+	0xa4, 0xda, 0xaf, 0x35, 0x98, 0x6c, 0x13, 0xe7, 0x8e, 0x8f, 0x2c, 0x8a, 0x36, 0x19, 0x59, 0xa6,
+	0xc8, 0x19, 0x18, 0xe3, 0x72, 0x84, 0x38, 0x71, 0xa5, 0xbf, 0x03, 0xaf, 0xda, 0x88, 0x5a, 0x6e,
+	0x8f, 0x30, 0x45, 0xe3, 0x2b, 0x0b, 0x0d, 0x75, 0x33, 0x36, 0x38, 0xc1, 0x7b, 0x1c, 0x6c, 0x0e,
+	0xa2, 0xe2, 0xaa, 0xe7, 0x60, 0x36, 0x21, 0x48, 0x8a, 0xfd, 0x4e, 0x63, 0x85, 0x7e, 0xd8, 0xb7,
+	0xe5, 0x9a, 0xc8, 0xf5, 0x92, 0x35, 0xd7, 0xa0, 0xaa, 0xd6, 0x25, 0xa5, 0x7f, 0x00, 0x93, 0xb2,
+	0x65, 0xce, 0xb6, 0xcd, 0xaa, 0x5d, 0x8a, 0xe6, 0x93, 0x54, 0x08, 0x2e, 0xb2, 0xa5, 0x00, 0xef,
+	0xa1, 0x68, 0xe5, 0x33, 0x09, 0x17, 0x61, 0x32, 0x88, 0xe0, 0x3a, 0xae, 0xcd, 0x98, 0x5f, 0x33,
+	0x27, 0xa2, 0xb7, 0x37, 0x13, 0xdd, 0x35, 0x0f, 0x97, 0x95, 0x34, 0x52, 0xc7, 0x1e, 0x7b, 0x0f,
+	0xd6, 0x29, 0xb5, 0xba, 0xbb, 0xf7, 0x70, 0xcf, 0x46, 0xfe, 0xfd, 0x83, 0x9d, 0x9e, 0xdb, 0x7d,
+	0x1f, 0x1d, 0x65, 0x4a, 0xb9, 0x0a, 0xd3, 0xbb, 0x0c, 0xda, 0xe9, 0x33, 0x6c, 0x67, 0x0f, 0x1d,
+	0x09, 0x31, 0x93, 0xbb, 0xf1, 0x1c, 0xaa, 0x5e, 0x57, 0x92, 0x49, 0x41, 0x5d, 0x28, 0x87, 0x9d,
+	0x85, 0xbd, 0x00, 0xf9, 0xf4, 0x8e, 0x8f, 0xec, 0xd0, 0x99, 0xac, 0x5e, 0xa6, 0x98, 0x2b, 0x90,
+	0xd8, 0x80, 0x22, 0xdb, 0x52, 0x85, 0x37, 0x55, 0x24, 0x52, 0xc4, 0x53, 0xfe, 0xc2, 0xb1, 0x1d,
+	0x3b, 0x12, 0x9d, 0x70, 0x0b, 0x2e, 0x58, 0x07, 0x74, 0x17, 0xfb, 0x2e, 0x3d, 0xe2, 0x1a, 0x36,
+	0x2a, 0xbf, 0xfd, 0x74, 0xbd, 0x2c, 0xec, 0x55, 0xbc, 0xfd, 0xdb, 0xd4, 0x77, 0x3d, 0xc7, 0x3c,
+	0x85, 0x16, 0x74, 0x8d, 0xdb, 0x13, 0xa1, 0xbe, 0xd3, 0x30, 0xd1, 0x3b, 0x51, 0x05, 0x52, 0xdd,
+	0x33, 0x8d, 0x19, 0xe6, 0x43, 0x2f, 0x78, 0x89, 0xfa, 0xb8, 0xbd, 0xc6, 0x35, 0x48, 0x85, 0x87,
+	0x4c, 0xe0, 0x5d, 0x1c, 0x44, 0x4f, 0x90, 0xb3, 0x0a, 0xcc, 0x73, 0x7c, 0xb5, 0xaa, 0x38, 0xb1,
+	0x54, 0xf5, 0x29, 0x6b, 0xad, 0xbb, 0x38, 0x48, 0x1c, 0x35, 0xff, 0x87, 0x30, 0xde, 0x71, 0x29,
+	0x6e, 0xa9, 0xed, 0x33, 0x28, 0x47, 0x6b, 0x7d, 0xdf, 0xc7, 0x7d, 0x4c, 0xac, 0x9e, 0x5e, 0x86,
+	0x73, 0xd4, 0xa5, 0x3d, 0x24, 0xba, 0x9e, 0x5f, 0xe8, 0x35, 0x18, 0xb7, 0x11, 0xe9, 0xfa, 0x6e,
+	0x3f, 0xec, 0x6e, 0x41, 0x1e, 0xbd, 0xa5, 0xa8, 0x6a, 0x49, 0x55, 0xd5, 0xd1, 0xbf, 0x7f, 0x98,
+	0x1f, 0xa9, 0xff, 0xac, 0x31, 0x9b, 0xd8, 0x46, 0x94, 0xb3, 0x47, 0x9d, 0xe2, 0xc1, 0x51, 0x1f,
+	0x0d, 0xef, 0xdc, 0x1f, 0x81, 0x1e, 0x73, 0x2b, 0x1a, 0x66, 0xa9, 0x94, 0x6a, 0xa5, 0xa5, 0x89,
+	0x95, 0xa5, 0x2c, 0x13, 0x4f, 0xd2, 0x9a, 0xd3, 0x41, 0xe2, 0x4e, 0xc2, 0xd1, 0x17, 0x61, 0x21,
+	0x57, 0xb6, 0xdc, 0xdd, 0x6f, 0xf9, 0x1b, 0x23, 0x91, 0x5b, 0x07, 0x98, 0x5a, 0x43, 0x3f, 0xd4,
+	0x3a, 0x9c, 0x7b, 0x1c, 0x06, 0x16, 0x39, 0x8c, 0xc2, 0x3f, 0x8c, 0x65, 0x63, 0xf4, 0xf9, 0x1f,
+	0xf3, 0x23, 0x26, 0x8f, 0x54, 0x4d, 0x28, 0x71, 0x51, 0x52, 0xb2, 0x07, 0x53, 0x6d, 0xe2, 0xb4,
+	0x5d, 0xc7, 0x3f, 0xfb, 0x99, 0x7f, 0x19, 0xc0, 0x43, 0x87, 0x1d, 0xb1, 0xc6, 0x1b, 0xe0, 0x82,
+	0x87, 0x0e, 0x37, 0x15, 0x67, 0xd5, 0x16, 0x54, 0x92, 0x7c, 0x03, 0x2d, 0xfa, 0x2a, 0xcc, 0xec,
+	0xf3, 0x05, 0xbb, 0x13, 0x2d, 0x09, 0x61, 0x3a, 0x46, 0xcd, 0x8b, 0x83, 0xd5, 0x68, 0x05, 0x48,
+	0xfd, 0x17, 0x3e, 0x09, 0xa4, 0x4f, 0x9f, 0xec, 0x7e, 0x5a, 0x86, 0xa9, 0xc4, 0x29, 0x17, 0xda,
+	0x51, 0x29, 0x3c, 0x59, 0xe2, 0x7e, 0x4e, 0xf4, 0x77, 0x61, 0xcc, 0x47, 0x16, 0xc1, 0x1e, 0x7b,
+	0xb0, 0x9c, 0xb6, 0x0a, 0xf9, 0x07, 0xa7, 0x5e, 0x88, 0x37, 0x45, 0x9c, 0xae, 0xc3, 0xa8, 0x87,
+	0x29, 0xaa, 0x8c, 0x32, 0x09, 0xec, 0xbf, 0x6a, 0x62, 0x50, 0xe8, 0x1f, 0xec, 0xcc, 0xca, 0xaf,
+	0x53, 0x50, 0x6a, 0x13, 0x47, 0xdf, 0x83, 0xe9, 0x7b, 0x96, 0x67, 0xf7, 0x50, 0xd4, 0xf0, 0xae,
+	0x64, 0x29, 0x8a, 0xcf, 0xc1, 0x46, 0xa3, 0x18, 0x4e, 0x96, 0x83, 0x42, 0x99, 0x93, 0x25, 0x7c,
+	0x6c, 0x39, 0x27, 0x4f, 0x1c, 0x6a, 0xb4, 0x0a, 0x43, 0x25, 0xeb, 0x57, 0x1a, 0x5c, 0xe2, 0xb4,
+	0xea, 0xa9, 0xf9, 0x46, 0x4e, 0x4a, 0x65, 0x84, 0xb1, 0x36, 0x6c, 0x84, 0xd4, 0xe2, 0x81, 0xce,
+	0xa5, 0xc4, 0x46, 0xe2, 0xc5, 0x9c, 0x7c, 0x51, 0xa0, 0xd1, 0x2c, 0x08, 0x94, 0x7c, 0x5f, 0x68,
+	0x30, 0xc7, 0x09, 0x55, 0x63, 0x6d, 0x5e, 0xfd, 0x14, 0x78, 0xe3, 0xd6, 0x70, 0xf8, 0xf4, 0x53,
+	0xc7, 0x26, 0xd4, 0xc5, 0x7f, 0x2d, 0x65, 0x81, 0xa7, 0x56, 0xcd, 0xa8, 0xfa, 0x53, 0x0d, 0x2a,
+	0x03, 0xc2, 0xd4, 0x9c, 0x7a, 0x3d, 0x37, 0x5b, 0x12, 0x6e, 0xac, 0x0e, 0x05, 0x57, 0x34, 0x9d,
+	0x7a, 0x44, 0xcd, 0x6b, 0x3a, 0x65, 0x84, 0xb1, 0x36, 0x6c, 0x84, 0xd4, 0xf2, 0x39, 0xcc, 0x8a,
+	0xa6, 0x4b, 0x0d, 0xa7, 0xd7, 0xf2, 0x1a, 0x2a, 0x89, 0x36, 0x6e, 0x0e, 0x83, 0x4e, 0x57, 0x3f,
+	0x36, 0x95, 0xe6, 0x55, 0x3f, 0x0a, 0x34, 0x9a, 0x05, 0x81, 0x69, 0x97, 0x49, 0xcc, 0x99, 0x79,
+	0x2e, 0x13, 0x87, 0x1a, 0xad, 0xc2, 0xd0, 0x34, 0x6b, 0x62, 0x78, 0xcc, 0x63, 0x8d, 0x43, 0x8d,
+	0x56, 0x61, 0x68, 0xba, 0xb4, 0xe9, 0xe1, 0xf0, 0x5a, 0x7e, 0xb6, 0x84, 0xaf, 0xde, 0x1c, 0x06,
+	0x2d, 0xe9, 0xbf, 0xd7, 0xa0, 0x26, 0xad, 0x35, 0x6b, 0x04, 0x5b, 0xcd, 0x77, 0xcb, 0x8c, 0x30,
+	0xe3, 0xed, 0x33, 0x85, 0xa5, 0xeb, 0x91, 0x98, 0x9d, 0x96, 0x8b, 0xa4, 0x65, 0x50, 0xa3, 0x55,
+	0x18, 0x2a, 0x59, 0x1f, 0xc3, 0x1b, 0x9c, 0x35, 0x3e, 0xff, 0x2c, 0xe5, 0x64, 0x8a, 0x21, 0x8d,
+	0x1b, 0x45, 0x91, 0x0a, 0x8b, 0x57, 0xcd, 0x2b, 0x8d, 0xa1, 0xec, 0x2b, 0xdf, 0xe2, 0x73, 0xe6,
+	0x89, 0x8d, 0xb5, 0xe7, 0xc7, 0x55, 0xed, 0xc5, 0x71, 0x55, 0xfb, 0xf3, 0xb8, 0xaa, 0x7d, 0x73,
+	0x52, 0x1d, 0x79, 0x71, 0x52, 0x1d, 0xf9, 0xfd, 0xa4, 0x3a, 0xf2, 0x71, 0x35, 0xfa, 0xe1, 0xee,
+	0x49, 0xec, 0xeb, 0x62, 0x58, 0xb0, 0x9d, 0x31, 0xf6, 0xe1, 0xee, 0xad, 0x7f, 0x06, 0x00, 0xc7,
+	0xba, 0x02, 0x9d, 0x84, 0x14, 0x00, 0x00,
 }
 
-func (m *MsgCreateIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	HandleAddOperator(ctx context.Context, in *MsgAddOperator, opts ...grpc.CallOption) (*MsgAddOperatorResponse, error)
+	HandleRemoveOperator(ctx context.Context, in *MsgRemoveOperator, opts ...grpc.CallOption) (*MsgRemoveOperatorResponse, error)
+	HandleSetVerificationStatus(ctx context.Context, in *MsgSetVerificationStatus, opts ...grpc.CallOption) (*MsgSetVerificationStatusResponse, error)
+	HandleCreateIssuer(ctx context.Context, in *MsgCreateIssuer, opts ...grpc.CallOption) (*MsgCreateIssuerResponse, error)
+	HandleUpdateIssuerDetails(ctx context.Context, in *MsgUpdateIssuerDetails, opts ...grpc.CallOption) (*MsgUpdateIssuerDetailsResponse, error)
+	HandleRemoveIssuer(ctx context.Context, in *MsgRemoveIssuer, opts ...grpc.CallOption) (*MsgRemoveIssuerResponse, error)
+	HandleRevokeVerification(ctx context.Context, in *MsgRevokeVerification, opts ...grpc.CallOption) (*MsgRevokeVerificationResponse, error)
+	HandleAttachHolderPublicKey(ctx context.Context, in *MsgAttachHolderPublicKey, opts ...grpc.CallOption) (*MsgAttachHolderPublicKeyResponse, error)
+	HandleConvertCredential(ctx context.Context, in *MsgConvertCredential, opts ...grpc.CallOption) (*MsgConvertCredentialResponse, error)
+	// HandleVerifyIssuer defines a governance operation for verifying an issuer.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account.
+	HandleVerifyIssuer(ctx context.Context, in *MsgVerifyIssuer, opts ...grpc.CallOption) (*MsgVerifyIssuerResponse, error)
+	// HandleUnverifyIssuer defines a governance operation for revoking verification
+	// status of an issuer. The authority is hard-coded to the Cosmos SDK x/gov module account.
+	HandleUnverifyIssuer(ctx context.Context, in *MsgUnverifyIssuer, opts ...grpc.CallOption) (*MsgUnverifyIssuerResponse, error)
+	// HandleGovAddOperator defines a governance operation for adding a regular operator.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account.
+	HandleGovAddOperator(ctx context.Context, in *MsgGovAddOperator, opts ...grpc.CallOption) (*MsgGovAddOperatorResponse, error)
+	// HandleGovRemoveOperator defines a governance operation for removing a regular operator.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account.
+	HandleGovRemoveOperator(ctx context.Context, in *MsgGovRemoveOperator, opts ...grpc.CallOption) (*MsgGovRemoveOperatorResponse, error)
+	// HandleSetIssuerVerificationTypes sets verification types, which issuer is allowed to issue.
+	// Can be executed only by operator.
+	HandleSetIssuerVerificationTypes(ctx context.Context, in *MsgSetIssuerVerificationTypes, opts ...grpc.CallOption) (*MsgSetIssuerVerificationTypesResponse, error)
+	// HandleSetIssuerQuota sets limit of verifications, which issuer can issue within block or epoch.
+	// Can be executed only by operator.
+	HandleSetIssuerQuota(ctx context.Context, in *MsgSetIssuerQuota, opts ...grpc.CallOption) (*MsgSetIssuerQuotaResponse, error)
+	// HandleMigrateIssuer moves issuer to the new address, for example, if issuer key was compromised.
+	// Can be executed by operator or issuer creator.
+	HandleMigrateIssuer(ctx context.Context, in *MsgMigrateIssuer, opts ...grpc.CallOption) (*MsgMigrateIssuerResponse, error)
+	// HandleRevokeVerifications revokes multiple verifications with provided reason at once.
+	// Can be executed by operator or creator of issuers of all the verifications.
+	HandleRevokeVerifications(ctx context.Context, in *MsgRevokeVerifications, opts ...grpc.CallOption) (*MsgRevokeVerificationsResponse, error)
 }
 
-func (m *MsgUpdateIssuerDetails) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgUpdateIssuerDetails) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgUpdateIssuerDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) HandleAddOperator(ctx context.Context, in *MsgAddOperator, opts ...grpc.CallOption) (*MsgAddOperatorResponse, error) {
+	out := new(MsgAddOperatorResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleAddOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateIssuerDetailsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) HandleRemoveOperator(ctx context.Context, in *MsgRemoveOperator, opts ...grpc.CallOption) (*MsgRemoveOperatorResponse, error) {
+	out := new(MsgRemoveOperatorResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleRemoveOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateIssuerDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) HandleSetVerificationStatus(ctx context.Context, in *MsgSetVerificationStatus, opts ...grpc.CallOption) (*MsgSetVerificationStatusResponse, error) {
+	out := new(MsgSetVerificationStatusResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleSetVerificationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateIssuerDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveIssuer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) HandleCreateIssuer(ctx context.Context, in *MsgCreateIssuer, opts ...grpc.CallOption) (*MsgCreateIssuerResponse, error) {
+	out := new(MsgCreateIssuerResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleCreateIssuer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveIssuer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgRemoveIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) HandleUpdateIssuerDetails(ctx context.Context, in *MsgUpdateIssuerDetails, opts ...grpc.CallOption) (*MsgUpdateIssuerDetailsResponse, error) {
+	out := new(MsgUpdateIssuerDetailsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleUpdateIssuerDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveIssuerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) HandleRemoveIssuer(ctx context.Context, in *MsgRemoveIssuer, opts ...grpc.CallOption) (*MsgRemoveIssuerResponse, error) {
+	out := new(MsgRemoveIssuerResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleRemoveIssuer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRevokeVerification) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) HandleRevokeVerification(ctx context.Context, in *MsgRevokeVerification, opts ...grpc.CallOption) (*MsgRevokeVerificationResponse, error) {
+	out := new(MsgRevokeVerificationResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleRevokeVerification", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRevokeVerification) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgRevokeVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VerificationId) > 0 {
-		i -= len(m.VerificationId)
-		copy(dAtA[i:], m.VerificationId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) HandleAttachHolderPublicKey(ctx context.Context, in *MsgAttachHolderPublicKey, opts ...grpc.CallOption) (*MsgAttachHolderPublicKeyResponse, error) {
+	out := new(MsgAttachHolderPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleAttachHolderPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRevokeVerificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) HandleConvertCredential(ctx context.Context, in *MsgConvertCredential, opts ...grpc.CallOption) (*MsgConvertCredentialResponse, error) {
+	out := new(MsgConvertCredentialResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleConvertCredential", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRevokeVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRevokeVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAttachHolderPublicKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) HandleVerifyIssuer(ctx context.Context, in *MsgVerifyIssuer, opts ...grpc.CallOption) (*MsgVerifyIssuerResponse, error) {
+	out := new(MsgVerifyIssuerResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleVerifyIssuer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAttachHolderPublicKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgAttachHolderPublicKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HolderPublicKey) > 0 {
-		i -= len(m.HolderPublicKey)
-		copy(dAtA[i:], m.HolderPublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HolderPublicKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) HandleUnverifyIssuer(ctx context.Context, in *MsgUnverifyIssuer, opts ...grpc.CallOption) (*MsgUnverifyIssuerResponse, error) {
+	out := new(MsgUnverifyIssuerResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleUnverifyIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAttachHolderPublicKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) HandleGovAddOperator(ctx context.Context, in *MsgGovAddOperator, opts ...grpc.CallOption) (*MsgGovAddOperatorResponse, error) {
+	out := new(MsgGovAddOperatorResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleGovAddOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAttachHolderPublicKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAttachHolderPublicKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgConvertCredential) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) HandleGovRemoveOperator(ctx context.Context, in *MsgGovRemoveOperator, opts ...grpc.CallOption) (*MsgGovRemoveOperatorResponse, error) {
+	out := new(MsgGovRemoveOperatorResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleGovRemoveOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgConvertCredential) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgConvertCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VerificationId) > 0 {
-		i -= len(m.VerificationId)
-		copy(dAtA[i:], m.VerificationId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) HandleSetIssuerVerificationTypes(ctx context.Context, in *MsgSetIssuerVerificationTypes, opts ...grpc.CallOption) (*MsgSetIssuerVerificationTypesResponse, error) {
+	out := new(MsgSetIssuerVerificationTypesResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleSetIssuerVerificationTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgConvertCredentialResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) HandleSetIssuerQuota(ctx context.Context, in *MsgSetIssuerQuota, opts ...grpc.CallOption) (*MsgSetIssuerQuotaResponse, error) {
+	out := new(MsgSetIssuerQuotaResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleSetIssuerQuota", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgConvertCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgConvertCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) HandleMigrateIssuer(ctx context.Context, in *MsgMigrateIssuer, opts ...grpc.CallOption) (*MsgMigrateIssuerResponse, error) {
+	out := new(MsgMigrateIssuerResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleMigrateIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *VerifyIssuerProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) HandleRevokeVerifications(ctx context.Context, in *MsgRevokeVerifications, opts ...grpc.CallOption) (*MsgRevokeVerificationsResponse, error) {
+	out := new(MsgRevokeVerificationsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.compliance.Msg/HandleRevokeVerifications", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *VerifyIssuerProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	HandleAddOperator(context.Context, *MsgAddOperator) (*MsgAddOperatorResponse, error)
+	HandleRemoveOperator(context.Context, *MsgRemoveOperator) (*MsgRemoveOperatorResponse, error)
+	HandleSetVerificationStatus(context.Context, *MsgSetVerificationStatus) (*MsgSetVerificationStatusResponse, error)
+	HandleCreateIssuer(context.Context, *MsgCreateIssuer) (*MsgCreateIssuerResponse, error)
+	HandleUpdateIssuerDetails(context.Context, *MsgUpdateIssuerDetails) (*MsgUpdateIssuerDetailsResponse, error)
+	HandleRemoveIssuer(context.Context, *MsgRemoveIssuer) (*MsgRemoveIssuerResponse, error)
+	HandleRevokeVerification(context.Context, *MsgRevokeVerification) (*MsgRevokeVerificationResponse, error)
+	HandleAttachHolderPublicKey(context.Context, *MsgAttachHolderPublicKey) (*MsgAttachHolderPublicKeyResponse, error)
+	HandleConvertCredential(context.Context, *MsgConvertCredential) (*MsgConvertCredentialResponse, error)
+	// HandleVerifyIssuer defines a governance operation for verifying an issuer.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account.
+	HandleVerifyIssuer(context.Context, *MsgVerifyIssuer) (*MsgVerifyIssuerResponse, error)
+	// HandleUnverifyIssuer defines a governance operation for revoking verification
+	// status of an issuer. The authority is hard-coded to the Cosmos SDK x/gov module account.
+	HandleUnverifyIssuer(context.Context, *MsgUnverifyIssuer) (*MsgUnverifyIssuerResponse, error)
+	// HandleGovAddOperator defines a governance operation for adding a regular operator.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account.
+	HandleGovAddOperator(context.Context, *MsgGovAddOperator) (*MsgGovAddOperatorResponse, error)
+	// HandleGovRemoveOperator defines a governance operation for removing a regular operator.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account.
+	HandleGovRemoveOperator(context.Context, *MsgGovRemoveOperator) (*MsgGovRemoveOperatorResponse, error)
+	// HandleSetIssuerVerificationTypes sets verification types, which issuer is allowed to issue.
+	// Can be executed only by operator.
+	HandleSetIssuerVerificationTypes(context.Context, *MsgSetIssuerVerificationTypes) (*MsgSetIssuerVerificationTypesResponse, error)
+	// HandleSetIssuerQuota sets limit of verifications, which issuer can issue within block or epoch.
+	// Can be executed only by operator.
+	HandleSetIssuerQuota(context.Context, *MsgSetIssuerQuota) (*MsgSetIssuerQuotaResponse, error)
+	// HandleMigrateIssuer moves issuer to the new address, for example, if issuer key was compromised.
+	// Can be executed by operator or issuer creator.
+	HandleMigrateIssuer(context.Context, *MsgMigrateIssuer) (*MsgMigrateIssuerResponse, error)
+	// HandleRevokeVerifications revokes multiple verifications with provided reason at once.
+	// Can be executed by operator or creator of issuers of all the verifications.
+	HandleRevokeVerifications(context.Context, *MsgRevokeVerifications) (*MsgRevokeVerificationsResponse, error)
 }
 
-func (m *VerifyIssuerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IssuerAddress) > 0 {
-		i -= len(m.IssuerAddress)
-		copy(dAtA[i:], m.IssuerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IssuerAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) HandleAddOperator(ctx context.Context, req *MsgAddOperator) (*MsgAddOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleAddOperator not implemented")
 }
-func (m *MsgAddOperator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (*UnimplementedMsgServer) HandleRemoveOperator(ctx context.Context, req *MsgRemoveOperator) (*MsgRemoveOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleRemoveOperator not implemented")
+}
+func (*UnimplementedMsgServer) HandleSetVerificationStatus(ctx context.Context, req *MsgSetVerificationStatus) (*MsgSetVerificationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleSetVerificationStatus not implemented")
+}
+func (*UnimplementedMsgServer) HandleCreateIssuer(ctx context.Context, req *MsgCreateIssuer) (*MsgCreateIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleCreateIssuer not implemented")
+}
+func (*UnimplementedMsgServer) HandleUpdateIssuerDetails(ctx context.Context, req *MsgUpdateIssuerDetails) (*MsgUpdateIssuerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleUpdateIssuerDetails not implemented")
+}
+func (*UnimplementedMsgServer) HandleRemoveIssuer(ctx context.Context, req *MsgRemoveIssuer) (*MsgRemoveIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleRemoveIssuer not implemented")
+}
+func (*UnimplementedMsgServer) HandleRevokeVerification(ctx context.Context, req *MsgRevokeVerification) (*MsgRevokeVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleRevokeVerification not implemented")
+}
+func (*UnimplementedMsgServer) HandleAttachHolderPublicKey(ctx context.Context, req *MsgAttachHolderPublicKey) (*MsgAttachHolderPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleAttachHolderPublicKey not implemented")
+}
+func (*UnimplementedMsgServer) HandleConvertCredential(ctx context.Context, req *MsgConvertCredential) (*MsgConvertCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleConvertCredential not implemented")
+}
+func (*UnimplementedMsgServer) HandleVerifyIssuer(ctx context.Context, req *MsgVerifyIssuer) (*MsgVerifyIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleVerifyIssuer not implemented")
+}
+func (*UnimplementedMsgServer) HandleUnverifyIssuer(ctx context.Context, req *MsgUnverifyIssuer) (*MsgUnverifyIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleUnverifyIssuer not implemented")
+}
+func (*UnimplementedMsgServer) HandleGovAddOperator(ctx context.Context, req *MsgGovAddOperator) (*MsgGovAddOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleGovAddOperator not implemented")
+}
+func (*UnimplementedMsgServer) HandleGovRemoveOperator(ctx context.Context, req *MsgGovRemoveOperator) (*MsgGovRemoveOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleGovRemoveOperator not implemented")
+}
+func (*UnimplementedMsgServer) HandleSetIssuerVerificationTypes(ctx context.Context, req *MsgSetIssuerVerificationTypes) (*MsgSetIssuerVerificationTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleSetIssuerVerificationTypes not implemented")
+}
+func (*UnimplementedMsgServer) HandleSetIssuerQuota(ctx context.Context, req *MsgSetIssuerQuota) (*MsgSetIssuerQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleSetIssuerQuota not implemented")
+}
+func (*UnimplementedMsgServer) HandleMigrateIssuer(ctx context.Context, req *MsgMigrateIssuer) (*MsgMigrateIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMigrateIssuer not implemented")
+}
+func (*UnimplementedMsgServer) HandleRevokeVerifications(ctx context.Context, req *MsgRevokeVerifications) (*MsgRevokeVerificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleRevokeVerifications not implemented")
 }
 
-func (m *MsgAddOperatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgRemoveOperator) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleAddOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddOperator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).HandleAddOperator(ctx, in)
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleAddOperator",
 	}
-	return n
-}
-
-func (m *MsgRemoveOperatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleAddOperator(ctx, req.(*MsgAddOperator))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetVerificationStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleRemoveOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveOperator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).HandleRemoveOperator(ctx, in)
 	}
-	l = len(m.IssuerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleRemoveOperator",
 	}
-	if m.IsVerified {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleRemoveOperator(ctx, req.(*MsgRemoveOperator))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetVerificationStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleSetVerificationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVerificationStatus)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).HandleSetVerificationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleSetVerificationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleSetVerificationStatus(ctx, req.(*MsgSetVerificationStatus))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateIssuer) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleCreateIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).HandleCreateIssuer(ctx, in)
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleCreateIssuer",
 	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleCreateIssuer(ctx, req.(*MsgCreateIssuer))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateIssuerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleUpdateIssuerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateIssuerDetails)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).HandleUpdateIssuerDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleUpdateIssuerDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleUpdateIssuerDetails(ctx, req.(*MsgUpdateIssuerDetails))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateIssuerDetails) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleRemoveIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).HandleRemoveIssuer(ctx, in)
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleRemoveIssuer",
 	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleRemoveIssuer(ctx, req.(*MsgRemoveIssuer))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateIssuerDetailsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleRevokeVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeVerification)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).HandleRevokeVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleRevokeVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleRevokeVerification(ctx, req.(*MsgRevokeVerification))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveIssuer) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleAttachHolderPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttachHolderPublicKey)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).HandleAttachHolderPublicKey(ctx, in)
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleAttachHolderPublicKey",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleAttachHolderPublicKey(ctx, req.(*MsgAttachHolderPublicKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveIssuerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleConvertCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCredential)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).HandleConvertCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleConvertCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleConvertCredential(ctx, req.(*MsgConvertCredential))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevokeVerification) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleVerifyIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).HandleVerifyIssuer(ctx, in)
 	}
-	l = len(m.VerificationId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleVerifyIssuer",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleVerifyIssuer(ctx, req.(*MsgVerifyIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevokeVerificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleUnverifyIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnverifyIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).HandleUnverifyIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleUnverifyIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleUnverifyIssuer(ctx, req.(*MsgUnverifyIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAttachHolderPublicKey) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleGovAddOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovAddOperator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).HandleGovAddOperator(ctx, in)
 	}
-	l = len(m.HolderPublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleGovAddOperator",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleGovAddOperator(ctx, req.(*MsgGovAddOperator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAttachHolderPublicKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleGovRemoveOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovRemoveOperator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).HandleGovRemoveOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleGovRemoveOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleGovRemoveOperator(ctx, req.(*MsgGovRemoveOperator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertCredential) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleSetIssuerVerificationTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIssuerVerificationTypes)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).HandleSetIssuerVerificationTypes(ctx, in)
 	}
-	l = len(m.VerificationId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleSetIssuerVerificationTypes",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleSetIssuerVerificationTypes(ctx, req.(*MsgSetIssuerVerificationTypes))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertCredentialResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleSetIssuerQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIssuerQuota)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).HandleSetIssuerQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleSetIssuerQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleSetIssuerQuota(ctx, req.(*MsgSetIssuerQuota))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VerifyIssuerProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_HandleMigrateIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMigrateIssuer(ctx, in)
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleMigrateIssuer",
 	}
-	l = len(m.IssuerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMigrateIssuer(ctx, req.(*MsgMigrateIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleRevokeVerifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeVerifications)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleRevokeVerifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.compliance.Msg/HandleRevokeVerifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleRevokeVerifications(ctx, req.(*MsgRevokeVerifications))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "swisstronik.compliance.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HandleAddOperator",
+			Handler:    _Msg_HandleAddOperator_Handler,
+		},
+		{
+			MethodName: "HandleRemoveOperator",
+			Handler:    _Msg_HandleRemoveOperator_Handler,
+		},
+		{
+			MethodName: "HandleSetVerificationStatus",
+			Handler:    _Msg_HandleSetVerificationStatus_Handler,
+		},
+		{
+			MethodName: "HandleCreateIssuer",
+			Handler:    _Msg_HandleCreateIssuer_Handler,
+		},
+		{
+			MethodName: "HandleUpdateIssuerDetails",
+			Handler:    _Msg_HandleUpdateIssuerDetails_Handler,
+		},
+		{
+			MethodName: "HandleRemoveIssuer",
+			Handler:    _Msg_HandleRemoveIssuer_Handler,
+		},
+		{
+			MethodName: "HandleRevokeVerification",
+			Handler:    _Msg_HandleRevokeVerification_Handler,
+		},
+		{
+			MethodName: "HandleAttachHolderPublicKey",
+			Handler:    _Msg_HandleAttachHolderPublicKey_Handler,
+		},
+		{
+			MethodName: "HandleConvertCredential",
+			Handler:    _Msg_HandleConvertCredential_Handler,
+		},
+		{
+			MethodName: "HandleVerifyIssuer",
+			Handler:    _Msg_HandleVerifyIssuer_Handler,
+		},
+		{
+			MethodName: "HandleUnverifyIssuer",
+			Handler:    _Msg_HandleUnverifyIssuer_Handler,
+		},
+		{
+			MethodName: "HandleGovAddOperator",
+			Handler:    _Msg_HandleGovAddOperator_Handler,
+		},
+		{
+			MethodName: "HandleGovRemoveOperator",
+			Handler:    _Msg_HandleGovRemoveOperator_Handler,
+		},
+		{
+			MethodName: "HandleSetIssuerVerificationTypes",
+			Handler:    _Msg_HandleSetIssuerVerificationTypes_Handler,
+		},
+		{
+			MethodName: "HandleSetIssuerQuota",
+			Handler:    _Msg_HandleSetIssuerQuota_Handler,
+		},
+		{
+			MethodName: "HandleMigrateIssuer",
+			Handler:    _Msg_HandleMigrateIssuer_Handler,
+		},
+		{
+			MethodName: "HandleRevokeVerifications",
+			Handler:    _Msg_HandleRevokeVerifications_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "swisstronik/compliance/tx.proto",
+}
+
+func (m *MsgAddOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVerificationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVerificationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVerificationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsVerified {
+		i--
+		if m.IsVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.IssuerAddress) > 0 {
+		i -= len(m.IssuerAddress)
+		copy(dAtA[i:], m.IssuerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IssuerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVerificationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVerificationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVerificationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateIssuerDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateIssuerDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateIssuerDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateIssuerDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateIssuerDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateIssuerDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttachHolderPublicKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttachHolderPublicKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttachHolderPublicKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HolderPublicKey) > 0 {
+		i -= len(m.HolderPublicKey)
+		copy(dAtA[i:], m.HolderPublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HolderPublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttachHolderPublicKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttachHolderPublicKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttachHolderPublicKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IssuerAddress) > 0 {
+		i -= len(m.IssuerAddress)
+		copy(dAtA[i:], m.IssuerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IssuerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnverifyIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnverifyIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnverifyIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IssuerAddress) > 0 {
+		i -= len(m.IssuerAddress)
+		copy(dAtA[i:], m.IssuerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IssuerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnverifyIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnverifyIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnverifyIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovAddOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovAddOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovAddOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovAddOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovAddOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovAddOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovRemoveOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovRemoveOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovRemoveOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovRemoveOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovRemoveOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovRemoveOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyIssuerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyIssuerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyIssuerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IssuerAddress) > 0 {
+		i -= len(m.IssuerAddress)
+		copy(dAtA[i:], m.IssuerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IssuerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIssuerVerificationTypes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIssuerVerificationTypes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIssuerVerificationTypes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationTypes) > 0 {
+		dAtA4 := make([]byte, len(m.VerificationTypes)*10)
+		var j3 int
+		for _, num := range m.VerificationTypes {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIssuerVerificationTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIssuerVerificationTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIssuerVerificationTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIssuerQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIssuerQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIssuerQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIssuerQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIssuerQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIssuerQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewIssuer) > 0 {
+		i -= len(m.NewIssuer)
+		copy(dAtA[i:], m.NewIssuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewIssuer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigratedVerifications != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MigratedVerifications))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVerifications) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVerifications) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVerifications) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VerificationIds) > 0 {
+		for iNdEx := len(m.VerificationIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationIds[iNdEx])
+			copy(dAtA[i:], m.VerificationIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.VerificationIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVerificationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVerificationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVerificationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetVerificationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IssuerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsVerified {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetVerificationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateIssuerDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateIssuerDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttachHolderPublicKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HolderPublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttachHolderPublicKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVerifyIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IssuerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVerifyIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnverifyIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IssuerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnverifyIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovAddOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovAddOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovRemoveOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGovRemoveOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *VerifyIssuerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IssuerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetIssuerVerificationTypes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VerificationTypes) > 0 {
+		l = 0
+		for _, e := range m.VerificationTypes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSetIssuerVerificationTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetIssuerQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Quota.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetIssuerQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewIssuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigratedVerifications != 0 {
+		n += 1 + sovTx(uint64(m.MigratedVerifications))
+	}
+	return n
+}
+
+func (m *MsgRevokeVerifications) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VerificationIds) > 0 {
+		for _, b := range m.VerificationIds {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTx(uint64(m.Reason))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeVerificationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVerificationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVerificationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVerificationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVerified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVerificationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVerificationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVerificationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &IssuerDetails{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateIssuerDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIssuerDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIssuerDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &IssuerDetails{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateIssuerDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIssuerDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIssuerDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = append(m.VerificationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerificationId == nil {
+				m.VerificationId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttachHolderPublicKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttachHolderPublicKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttachHolderPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderPublicKey = append(m.HolderPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.HolderPublicKey == nil {
+				m.HolderPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttachHolderPublicKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttachHolderPublicKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttachHolderPublicKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAddOperator) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCredential) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2282,10 +5601,10 @@ func (m *MsgAddOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCredential: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2322,9 +5641,9 @@ func (m *MsgAddOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2334,23 +5653,25 @@ func (m *MsgAddOperator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.VerificationId = append(m.VerificationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerificationId == nil {
+				m.VerificationId = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2373,7 +5694,7 @@ func (m *MsgAddOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCredentialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2396,10 +5717,10 @@ func (m *MsgAddOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCredentialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2423,7 +5744,7 @@ func (m *MsgAddOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveOperator) Unmarshal(dAtA []byte) error {
+func (m *MsgVerifyIssuer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2446,15 +5767,15 @@ func (m *MsgRemoveOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVerifyIssuer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVerifyIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2482,11 +5803,11 @@ func (m *MsgRemoveOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2514,7 +5835,7 @@ func (m *MsgRemoveOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2537,7 +5858,7 @@ func (m *MsgRemoveOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVerifyIssuerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2560,10 +5881,10 @@ func (m *MsgRemoveOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVerifyIssuerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVerifyIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2587,7 +5908,7 @@ func (m *MsgRemoveOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetVerificationStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgUnverifyIssuer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2610,15 +5931,15 @@ func (m *MsgSetVerificationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetVerificationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnverifyIssuer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetVerificationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnverifyIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2646,7 +5967,7 @@ func (m *MsgSetVerificationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2680,26 +6001,6 @@ func (m *MsgSetVerificationStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVerified", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsVerified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2721,7 +6022,7 @@ func (m *MsgSetVerificationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetVerificationStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnverifyIssuerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2744,10 +6045,10 @@ func (m *MsgSetVerificationStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetVerificationStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnverifyIssuerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetVerificationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnverifyIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2771,7 +6072,7 @@ func (m *MsgSetVerificationStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
+func (m *MsgGovAddOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2794,15 +6095,15 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIssuer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovAddOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovAddOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2830,11 +6131,11 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2862,43 +6163,7 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Details == nil {
-				m.Details = &IssuerDetails{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2921,7 +6186,7 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovAddOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2944,10 +6209,10 @@ func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIssuerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovAddOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovAddOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2971,7 +6236,7 @@ func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateIssuerDetails) Unmarshal(dAtA []byte) error {
+func (m *MsgGovRemoveOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2994,15 +6259,15 @@ func (m *MsgUpdateIssuerDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateIssuerDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovRemoveOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateIssuerDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovRemoveOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3030,11 +6295,11 @@ func (m *MsgUpdateIssuerDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3062,43 +6327,7 @@ func (m *MsgUpdateIssuerDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Details == nil {
-				m.Details = &IssuerDetails{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3121,7 +6350,7 @@ func (m *MsgUpdateIssuerDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateIssuerDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovRemoveOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3144,10 +6373,10 @@ func (m *MsgUpdateIssuerDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateIssuerDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovRemoveOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateIssuerDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovRemoveOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3171,7 +6400,7 @@ func (m *MsgUpdateIssuerDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveIssuer) Unmarshal(dAtA []byte) error {
+func (m *VerifyIssuerProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3194,15 +6423,15 @@ func (m *MsgRemoveIssuer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveIssuer: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyIssuerProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyIssuerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3230,11 +6459,11 @@ func (m *MsgRemoveIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3262,58 +6491,40 @@ func (m *MsgRemoveIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveIssuerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveIssuerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3335,7 +6546,7 @@ func (m *MsgRemoveIssuerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeVerification) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIssuerVerificationTypes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3358,10 +6569,10 @@ func (m *MsgRevokeVerification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeVerification: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIssuerVerificationTypes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIssuerVerificationTypes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3398,9 +6609,9 @@ func (m *MsgRevokeVerification) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3410,26 +6621,93 @@ func (m *MsgRevokeVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationId = append(m.VerificationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.VerificationId == nil {
-				m.VerificationId = []byte{}
-			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v VerificationType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= VerificationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VerificationTypes = append(m.VerificationTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.VerificationTypes) == 0 {
+					m.VerificationTypes = make([]VerificationType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v VerificationType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VerificationType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VerificationTypes = append(m.VerificationTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationTypes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3451,7 +6729,7 @@ func (m *MsgRevokeVerification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeVerificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIssuerVerificationTypesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3474,10 +6752,10 @@ func (m *MsgRevokeVerificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeVerificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIssuerVerificationTypesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIssuerVerificationTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3501,7 +6779,7 @@ func (m *MsgRevokeVerificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAttachHolderPublicKey) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIssuerQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3524,10 +6802,10 @@ func (m *MsgAttachHolderPublicKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAttachHolderPublicKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIssuerQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAttachHolderPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIssuerQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3564,9 +6842,9 @@ func (m *MsgAttachHolderPublicKey) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HolderPublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3576,24 +6854,55 @@ func (m *MsgAttachHolderPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HolderPublicKey = append(m.HolderPublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.HolderPublicKey == nil {
-				m.HolderPublicKey = []byte{}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3617,7 +6926,7 @@ func (m *MsgAttachHolderPublicKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAttachHolderPublicKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIssuerQuotaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3640,10 +6949,10 @@ func (m *MsgAttachHolderPublicKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAttachHolderPublicKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIssuerQuotaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAttachHolderPublicKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIssuerQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3667,7 +6976,7 @@ func (m *MsgAttachHolderPublicKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCredential) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateIssuer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3690,10 +6999,10 @@ func (m *MsgConvertCredential) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCredential: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateIssuer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3730,9 +7039,9 @@ func (m *MsgConvertCredential) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3742,25 +7051,55 @@ func (m *MsgConvertCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationId = append(m.VerificationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.VerificationId == nil {
-				m.VerificationId = []byte{}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewIssuer", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewIssuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3783,7 +7122,7 @@ func (m *MsgConvertCredential) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCredentialResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateIssuerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3806,12 +7145,31 @@ func (m *MsgConvertCredentialResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCredentialResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateIssuerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedVerifications", wireType)
+			}
+			m.MigratedVerifications = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratedVerifications |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3833,7 +7191,7 @@ func (m *MsgConvertCredentialResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyIssuerProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeVerifications) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3856,15 +7214,15 @@ func (m *VerifyIssuerProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyIssuerProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeVerifications: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyIssuerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeVerifications: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3892,13 +7250,13 @@ func (m *VerifyIssuerProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationIds", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3908,27 +7266,46 @@ func (m *VerifyIssuerProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.VerificationIds = append(m.VerificationIds, make([]byte, postIndex-iNdEx))
+			copy(m.VerificationIds[len(m.VerificationIds)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= RevocationReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3956,7 +7333,7 @@ func (m *VerifyIssuerProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssuerAddress = string(dAtA[iNdEx:postIndex])
+			m.Note = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3979,6 +7356,56 @@ func (m *VerifyIssuerProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevokeVerificationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeVerificationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeVerificationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
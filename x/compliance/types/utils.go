@@ -7,6 +7,7 @@ import (
 	"github.com/iden3/go-iden3-crypto/babyjub"
 	"math/big"
 	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -51,3 +52,39 @@ func ExtractXCoordinate(compressedPublicKeyBytes []byte, isLittleEndian bool) (*
 
 	return pointBuf.X, nil
 }
+
+// ParseVerificationType converts provided verification type name (for example, `VT_KYC` or `kyc`)
+// or its numeric value into VerificationType
+func ParseVerificationType(input string) (VerificationType, error) {
+	name := strings.ToUpper(strings.TrimSpace(input))
+	if !strings.HasPrefix(name, "VT_") {
+		name = "VT_" + name
+	}
+	if value, ok := VerificationType_value[name]; ok && VerificationType(value).IsValid() {
+		return VerificationType(value), nil
+	}
+
+	if value, err := strconv.ParseUint(input, 10, 32); err == nil && VerificationType(value).IsValid() {
+		return VerificationType(value), nil
+	}
+
+	return VerificationType_VT_UNSPECIFIED, fmt.Errorf("invalid verification type: %s", input)
+}
+
+// ParseRevocationReason converts provided revocation reason name (for example, `RR_FRAUD` or `fraud`)
+// or its numeric value into RevocationReason
+func ParseRevocationReason(input string) (RevocationReason, error) {
+	name := strings.ToUpper(strings.TrimSpace(input))
+	if !strings.HasPrefix(name, "RR_") {
+		name = "RR_" + name
+	}
+	if value, ok := RevocationReason_value[name]; ok {
+		return RevocationReason(value), nil
+	}
+
+	if value, err := strconv.ParseUint(input, 10, 32); err == nil && RevocationReason(value).IsValid() {
+		return RevocationReason(value), nil
+	}
+
+	return RevocationReason_RR_UNSPECIFIED, fmt.Errorf("invalid revocation reason: %s", input)
+}
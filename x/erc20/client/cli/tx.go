@@ -0,0 +1,275 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"swisstronik/x/erc20/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "ERC20 conversion transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdConvertCoin(),
+		CmdConvertERC20(),
+		CmdRegisterCoinProposal(),
+		CmdRegisterERC20Proposal(),
+		CmdToggleConversionProposal(),
+	)
+
+	return cmd
+}
+
+// CmdConvertCoin command converts native coin to ERC20 tokens.
+func CmdConvertCoin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-coin [coin] [receiver-hex-address]",
+		Short: "Convert native coin to ERC20 tokens. Receiver defaults to the sender address",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			receiver := common.BytesToAddress(clientCtx.GetFromAddress())
+			if len(args) == 2 {
+				if !common.IsHexAddress(args[1]) {
+					return fmt.Errorf("invalid receiver hex address %s", args[1])
+				}
+				receiver = common.HexToAddress(args[1])
+			}
+
+			msg := types.NewMsgConvertCoin(coin, receiver, clientCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdConvertERC20 command converts ERC20 tokens to native coin.
+func CmdConvertERC20() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20 [contract-address] [amount] [receiver-bech32-address]",
+		Short: "Convert ERC20 tokens to native coin. Receiver defaults to the sender address",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract hex address %s", args[0])
+			}
+			contract := common.HexToAddress(args[0])
+
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			receiver := clientCtx.GetFromAddress()
+			if len(args) == 3 {
+				if receiver, err = sdk.AccAddressFromBech32(args[2]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgConvertERC20(amount, receiver, contract, clientCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdRegisterCoinProposal command submits a governance proposal to register token pair of native coin.
+func CmdRegisterCoinProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "propose-register-coin [metadata-json-file] [erc20-address]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to register native coin and ERC20 contract, which represents it",
+		Long:    "Submit a governance proposal to register native coin along with an initial deposit. ERC20 contract should allow erc20 module to mint and burn tokens.",
+		Example: fmt.Sprintf("$ %s tx erc20 propose-register-coin metadata.json <erc20 address> --title=<title> --summary=<summary> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			metadataBytes, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var metadata banktypes.Metadata
+			if err = clientCtx.Codec.UnmarshalJSON(metadataBytes, &metadata); err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[1]) {
+				return fmt.Errorf("invalid ERC20 hex address %s", args[1])
+			}
+
+			msg := types.NewMsgRegisterCoin(govAuthority(), metadata, common.HexToAddress(args[1]))
+			return submitGovProposal(cmd, clientCtx, &msg)
+		},
+	}
+
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// CmdRegisterERC20Proposal command submits a governance proposal to register token pair of ERC20 contract.
+func CmdRegisterERC20Proposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "propose-register-erc20 [erc20-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to register ERC20 contract",
+		Long:    "Submit a governance proposal to register ERC20 contract along with an initial deposit. Native coin, which represents ERC20 token, is created by the module.",
+		Example: fmt.Sprintf("$ %s tx erc20 propose-register-erc20 <erc20 address> --title=<title> --summary=<summary> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid ERC20 hex address %s", args[0])
+			}
+
+			msg := types.NewMsgRegisterERC20(govAuthority(), common.HexToAddress(args[0]))
+			return submitGovProposal(cmd, clientCtx, &msg)
+		},
+	}
+
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// CmdToggleConversionProposal command submits a governance proposal to enable or disable conversions of token pair.
+func CmdToggleConversionProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "propose-toggle-conversion [erc20-address-or-denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to toggle conversions of token pair",
+		Long:    "Submit a governance proposal to enable or disable conversions of registered token pair along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx erc20 propose-toggle-conversion <erc20 address or denom> --title=<title> --summary=<summary> --deposit=<deposit>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgToggleConversion(govAuthority(), args[0])
+			return submitGovProposal(cmd, clientCtx, &msg)
+		},
+	}
+
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+func govAuthority() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+// addGovProposalFlags adds common flags for commands which submit gov v1 proposals
+func addGovProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagSummary, "", "summary of proposal")
+	cmd.Flags().String(cli.FlagMetadata, "", "metadata of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagSummary); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	flags.AddTxFlagsToCmd(cmd)
+}
+
+// submitGovProposal wraps provided message into gov v1 proposal and broadcasts it
+func submitGovProposal(cmd *cobra.Command, clientCtx client.Context, msg sdk.Msg) error {
+	title, err := cmd.Flags().GetString(cli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	summary, err := cmd.Flags().GetString(cli.FlagSummary)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := cmd.Flags().GetString(cli.FlagMetadata)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	proposal, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{msg},
+		deposit,
+		clientCtx.GetFromAddress().String(),
+		metadata,
+		title,
+		summary,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+}
@@ -0,0 +1,32 @@
+package erc20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/x/erc20/keeper"
+	"swisstronik/x/erc20/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, pair := range genState.TokenPairs {
+		if err := k.SetTokenPair(ctx, pair); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	pairs, err := k.GetTokenPairs(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:     k.GetParams(ctx),
+		TokenPairs: pairs,
+	}
+}
@@ -0,0 +1,92 @@
+package erc20
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"swisstronik/ibc"
+	"swisstronik/x/erc20/keeper"
+)
+
+var _ porttypes.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware wraps ICS-20 transfer application and converts transferred coins,
+// which have registered token pairs, to ERC20 tokens
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{
+		Module: ibc.NewModule(app),
+		keeper: k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// If underlying application successfully received packet, received coins are converted to ERC20 tokens.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	return im.keeper.OnRecvPacket(ctx, packet, ack)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// If underlying application refunded coins, they are converted back to ERC20 tokens.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	if err := im.Module.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnAcknowledgementPacket(ctx, data, ack)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// Refunded coins are converted back to ERC20 tokens.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	if err := im.Module.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeoutPacket(ctx, data)
+	return nil
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/erc20/types"
+)
+
+// ConvertCoin converts native coin of sender to ERC20 tokens of receiver.
+// For contracts, owned by the module, coins are escrowed and tokens are minted.
+// For external contracts, coins are burnt and escrowed tokens are released.
+func (k Keeper) ConvertCoin(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	coin sdk.Coin,
+	sender sdk.AccAddress,
+	receiver common.Address,
+) error {
+	contract := pair.GetERC20Contract()
+	module := k.ModuleAddress()
+	coins := sdk.NewCoins(coin)
+
+	balanceBefore, err := k.BalanceOf(ctx, contract, receiver)
+	if err != nil {
+		return err
+	}
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	switch {
+	case pair.IsNativeCoin():
+		if _, err = k.CallERC20(ctx, module, contract, true, types.ERC20MethodMint, receiver, coin.Amount.BigInt()); err != nil {
+			return err
+		}
+	case pair.IsNativeERC20():
+		if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err = k.transferERC20(ctx, contract, module, receiver, coin.Amount.BigInt()); err != nil {
+			return err
+		}
+	default:
+		return errors.Wrapf(types.ErrUndefinedOwner, "token pair %s", pair.Erc20Address)
+	}
+
+	if err = k.checkBalance(ctx, contract, receiver, new(big.Int).Add(balanceBefore, coin.Amount.BigInt())); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertCoin,
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.Hex()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, coin.String()),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, contract.Hex()),
+		),
+	)
+
+	return nil
+}
+
+// ConvertERC20 converts ERC20 tokens of sender to native coins of receiver.
+// For contracts, owned by the module, tokens are burnt and escrowed coins are released.
+// For external contracts, tokens are escrowed and coins are minted.
+func (k Keeper) ConvertERC20(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	amount sdkmath.Int,
+	sender sdk.AccAddress,
+	receiver sdk.AccAddress,
+) error {
+	contract := pair.GetERC20Contract()
+	module := k.ModuleAddress()
+	senderAddress := common.BytesToAddress(sender)
+	coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, amount))
+
+	balanceBefore, err := k.BalanceOf(ctx, contract, senderAddress)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case pair.IsNativeCoin():
+		if _, err = k.CallERC20(ctx, module, contract, true, types.ERC20MethodBurnCoins, senderAddress, amount.BigInt()); err != nil {
+			return err
+		}
+	case pair.IsNativeERC20():
+		if err = k.transferERC20(ctx, contract, senderAddress, module, amount.BigInt()); err != nil {
+			return err
+		}
+		if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	default:
+		return errors.Wrapf(types.ErrUndefinedOwner, "token pair %s", pair.Erc20Address)
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return err
+	}
+
+	if err = k.checkBalance(ctx, contract, senderAddress, new(big.Int).Sub(balanceBefore, amount.BigInt())); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertERC20,
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, contract.Hex()),
+		),
+	)
+
+	return nil
+}
+
+// checkBalance ensures that ERC20 balance of account is equal to expected one.
+// It protects against malicious contracts, which do not update balances as expected.
+func (k Keeper) checkBalance(ctx sdk.Context, contract, account common.Address, expected *big.Int) error {
+	balance, err := k.BalanceOf(ctx, contract, account)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(expected) != 0 {
+		return errors.Wrapf(types.ErrBalanceInvariance, "expected balance %s of %s, got %s", expected, account.Hex(), balance)
+	}
+	return nil
+}
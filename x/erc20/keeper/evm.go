@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/erc20/types"
+)
+
+// ERC20Data contains metadata of ERC20 token
+type ERC20Data struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+}
+
+// CallERC20 calls method of ERC20 contract on behalf of `from` address and returns unpacked outputs
+func (k Keeper) CallERC20(
+	ctx sdk.Context,
+	from, contract common.Address,
+	commit bool,
+	method string,
+	args ...interface{},
+) ([]interface{}, error) {
+	data, err := types.ERC20ABI.Pack(method, args...)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrEVMCall, "cannot pack %s call: %s", method, err)
+	}
+
+	res, err := k.evmKeeper.CallEVMWithData(ctx, from, contract, data, commit)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrEVMCall, "%s of contract %s: %s", method, contract.Hex(), err)
+	}
+
+	outputs, err := types.ERC20ABI.Unpack(method, res.Ret)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrEVMCall, "cannot unpack %s result of contract %s: %s", method, contract.Hex(), err)
+	}
+	return outputs, nil
+}
+
+// QueryERC20 returns name, symbol and decimals of ERC20 token
+func (k Keeper) QueryERC20(ctx sdk.Context, contract common.Address) (ERC20Data, error) {
+	var data ERC20Data
+	module := k.ModuleAddress()
+
+	outputs, err := k.CallERC20(ctx, module, contract, false, types.ERC20MethodName)
+	if err != nil {
+		return data, err
+	}
+	data.Name = outputs[0].(string)
+
+	outputs, err = k.CallERC20(ctx, module, contract, false, types.ERC20MethodSymbol)
+	if err != nil {
+		return data, err
+	}
+	data.Symbol = outputs[0].(string)
+
+	outputs, err = k.CallERC20(ctx, module, contract, false, types.ERC20MethodDecimals)
+	if err != nil {
+		return data, err
+	}
+	data.Decimals = outputs[0].(uint8)
+
+	return data, nil
+}
+
+// BalanceOf returns ERC20 token balance of provided account
+func (k Keeper) BalanceOf(ctx sdk.Context, contract, account common.Address) (*big.Int, error) {
+	outputs, err := k.CallERC20(ctx, k.ModuleAddress(), contract, false, types.ERC20MethodBalanceOf, account)
+	if err != nil {
+		return nil, err
+	}
+	return outputs[0].(*big.Int), nil
+}
+
+// transferERC20 transfers ERC20 tokens on behalf of `from` address and checks returned status
+func (k Keeper) transferERC20(ctx sdk.Context, contract, from, to common.Address, amount *big.Int) error {
+	outputs, err := k.CallERC20(ctx, from, contract, true, types.ERC20MethodTransfer, to, amount)
+	if err != nil {
+		return err
+	}
+	if success := outputs[0].(bool); !success {
+		return errors.Wrapf(types.ErrEVMCall, "transfer of contract %s returned false", contract.Hex())
+	}
+	return nil
+}
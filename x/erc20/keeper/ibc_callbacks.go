@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/ibc"
+	"swisstronik/x/erc20/types"
+)
+
+// OnRecvPacket converts received IBC vouchers and returned ERC20 representations to ERC20 tokens of recipient,
+// if they have enabled token pair. Native coins of the chain are kept as is and can be converted explicitly.
+// If conversion fails, error acknowledgement is returned, so the whole transfer is reverted and refunded
+// on the source chain.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	if !k.IsERC20Enabled(ctx) {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	_, recipient, _, _, err := ibc.GetTransferSenderRecipient(packet)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// Module accounts cannot hold ERC20 tokens on behalf of users
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return ack
+	}
+
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+	if !strings.HasPrefix(coin.Denom, transfertypes.DenomPrefix+"/") && !types.IsERC20Denom(coin.Denom) {
+		return ack
+	}
+
+	pair, found := k.getAutoConvertiblePair(ctx, coin.Denom)
+	if !found {
+		return ack
+	}
+
+	if err = k.ConvertCoin(ctx, pair, coin, recipient, common.BytesToAddress(recipient)); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket converts refunded coins back to ERC20 tokens of sender, if packet was not received
+// by the destination chain
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	data transfertypes.FungibleTokenPacketData,
+	ack channeltypes.Acknowledgement,
+) {
+	if _, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		k.convertRefundedCoin(ctx, data)
+	}
+}
+
+// OnTimeoutPacket converts refunded coins back to ERC20 tokens of sender
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, data transfertypes.FungibleTokenPacketData) {
+	k.convertRefundedCoin(ctx, data)
+}
+
+// convertRefundedCoin converts refunded coins, which represent ERC20 tokens, back to ERC20 tokens of sender.
+// Refund should not fail because of conversion, so coins are kept as is in case of error.
+func (k Keeper) convertRefundedCoin(ctx sdk.Context, data transfertypes.FungibleTokenPacketData) {
+	if !k.IsERC20Enabled(ctx) {
+		return
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return
+	}
+
+	coin := ibc.GetSentCoin(data.Denom, data.Amount)
+	pair, found := k.getAutoConvertiblePair(ctx, coin.Denom)
+	if !found || !pair.IsNativeERC20() {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err = k.ConvertCoin(cacheCtx, pair, coin, sender, common.BytesToAddress(sender)); err != nil {
+		k.Logger(ctx).Error("failed to convert refunded coin", "coin", coin.String(), "sender", data.Sender, "error", err)
+		return
+	}
+	write()
+}
+
+// getAutoConvertiblePair returns enabled token pair of provided denomination
+func (k Keeper) getAutoConvertiblePair(ctx sdk.Context, denom string) (types.TokenPair, bool) {
+	id := k.GetDenomMap(ctx, denom)
+	if id == nil {
+		return types.TokenPair{}, false
+	}
+	pair, found, err := k.GetTokenPair(ctx, id)
+	if err != nil || !found || !pair.Enabled {
+		return types.TokenPair{}, false
+	}
+	return pair, true
+}
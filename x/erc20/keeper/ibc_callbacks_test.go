@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/tests"
+	"swisstronik/x/erc20/types"
+)
+
+func transferPacket(data transfertypes.FungibleTokenPacketData) channeltypes.Packet {
+	return channeltypes.NewPacket(
+		data.GetBytes(), 1,
+		transfertypes.PortID, "channel-0",
+		transfertypes.PortID, "channel-1",
+		clienttypes.NewHeight(0, 100), 0,
+	)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	// Voucher of coin received from counterparty chain
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom()
+	_, contract := suite.registerCoin(ctx, voucher)
+
+	recipient := tests.RandomAccAddress()
+	sender, err := sdk.Bech32ifyAddressBytes("cosmos", tests.RandomAccAddress())
+	suite.Require().NoError(err)
+	packet := transferPacket(transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, recipient.String(), ""))
+
+	// Transfer application has already minted vouchers to recipient
+	suite.fundAccount(ctx, recipient, sdk.NewCoins(sdk.NewCoin(voucher, sdkmath.NewInt(100))))
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	result := suite.keeper.OnRecvPacket(ctx, packet, ack)
+	suite.Require().True(result.Success())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, recipient, voucher).IsZero())
+	suite.Require().Equal(int64(100), contract.balanceOf(common.BytesToAddress(recipient)).Int64())
+
+	// Vouchers without token pair are kept as is
+	other := tests.RandomAccAddress()
+	packet = transferPacket(transfertypes.NewFungibleTokenPacketData("uosmo", "5", sender, other.String(), ""))
+	result = suite.keeper.OnRecvPacket(ctx, packet, ack)
+	suite.Require().True(result.Success())
+
+	// Failed conversion reverts the whole transfer
+	packet = transferPacket(transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, other.String(), ""))
+	result = suite.keeper.OnRecvPacket(ctx, packet, ack)
+	suite.Require().False(result.Success())
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	pair, contract := suite.registerERC20(ctx)
+	user := tests.RandomAccAddress()
+	userAddress := common.BytesToAddress(user)
+	contract.balances[userAddress] = big.NewInt(100)
+	suite.Require().NoError(suite.keeper.ConvertERC20(ctx, pair, sdkmath.NewInt(100), user, user))
+
+	// Refunded coins, which represent ERC20 tokens, are converted back
+	data := transfertypes.NewFungibleTokenPacketData(pair.Denom, "100", user.String(), "cosmos1receiver", "")
+	suite.keeper.OnTimeoutPacket(ctx, data)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, user, pair.Denom).IsZero())
+	suite.Require().Equal(int64(100), contract.balanceOf(userAddress).Int64())
+
+	// Successful acknowledgement does not convert anything
+	suite.fundAccount(ctx, user, sdk.NewCoins(sdk.NewCoin(pair.Denom, sdkmath.NewInt(100))))
+	suite.keeper.OnAcknowledgementPacket(ctx, data, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	suite.Require().Equal(int64(100), suite.app.BankKeeper.GetBalance(ctx, user, pair.Denom).Amount.Int64())
+
+	// Failed conversion does not fail refund, coins are kept as is
+	contract.brokenTransfer = true
+	suite.keeper.OnAcknowledgementPacket(ctx, data, channeltypes.NewErrorAcknowledgement(types.ErrEVMCall))
+	suite.Require().Equal(int64(100), suite.app.BankKeeper.GetBalance(ctx, user, pair.Denom).Amount.Int64())
+	suite.Require().Equal(int64(100), contract.balanceOf(userAddress).Int64())
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/erc20/types"
+)
+
+type (
+	Keeper struct {
+		storeKey   storetypes.StoreKey
+		paramstore paramtypes.Subspace
+
+		// the address capable of executing governance messages. Typically, this should be the x/gov module account.
+		authority sdk.AccAddress
+
+		accountKeeper types.AccountKeeper
+		bankKeeper    types.BankKeeper
+		evmKeeper     types.EVMKeeper
+	}
+)
+
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	ps paramtypes.Subspace,
+	authority sdk.AccAddress,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	evmKeeper types.EVMKeeper,
+) *Keeper {
+	// ensure the authority account is correct
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	// ensure erc20 module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the erc20 module account has not been set")
+	}
+
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		storeKey:      storeKey,
+		paramstore:    ps,
+		authority:     authority,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		evmKeeper:     evmKeeper,
+	}
+}
+
+// GetAuthority returns the x/erc20 module authority address
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// ModuleAddress returns hex address of erc20 module account, which owns contracts of native coins
+// and escrows ERC20 tokens
+func (k Keeper) ModuleAddress() common.Address {
+	return common.BytesToAddress(k.accountKeeper.GetModuleAddress(types.ModuleName))
+}
@@ -0,0 +1,459 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"swisstronik/app"
+	"swisstronik/tests"
+	"swisstronik/testutil"
+	"swisstronik/utils"
+	"swisstronik/x/erc20/keeper"
+	"swisstronik/x/erc20/types"
+	evmtypes "swisstronik/x/evm/types"
+)
+
+// fakeERC20 keeps state of ERC20 contract, called by fakeEVMKeeper
+type fakeERC20 struct {
+	name     string
+	symbol   string
+	decimals uint8
+	// minter is allowed to call `mint` and `burnCoins`
+	minter   common.Address
+	balances map[common.Address]*big.Int
+	// brokenTransfer makes `transfer` succeed without moving tokens
+	brokenTransfer bool
+}
+
+func (c *fakeERC20) balanceOf(account common.Address) *big.Int {
+	if balance, found := c.balances[account]; found {
+		return balance
+	}
+	return big.NewInt(0)
+}
+
+func (c *fakeERC20) move(from, to common.Address, amount *big.Int) error {
+	if from != (common.Address{}) {
+		if c.balanceOf(from).Cmp(amount) < 0 {
+			return errors.New("insufficient balance")
+		}
+		c.balances[from] = new(big.Int).Sub(c.balanceOf(from), amount)
+	}
+	if to != (common.Address{}) {
+		c.balances[to] = new(big.Int).Add(c.balanceOf(to), amount)
+	}
+	return nil
+}
+
+// fakeEVMKeeper executes calls of ERC20 contracts in memory instead of SGXVM
+type fakeEVMKeeper struct {
+	contracts map[common.Address]*fakeERC20
+}
+
+func (k *fakeEVMKeeper) CallEVMWithData(
+	_ sdk.Context,
+	from, contract common.Address,
+	data []byte,
+	_ bool,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	token, found := k.contracts[contract]
+	if !found {
+		return nil, evmtypes.ErrVMExecution
+	}
+
+	method, err := types.ERC20ABI.MethodById(data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []interface{}
+	switch method.Name {
+	case types.ERC20MethodName:
+		ret = []interface{}{token.name}
+	case types.ERC20MethodSymbol:
+		ret = []interface{}{token.symbol}
+	case types.ERC20MethodDecimals:
+		ret = []interface{}{token.decimals}
+	case types.ERC20MethodBalanceOf:
+		ret = []interface{}{token.balanceOf(args[0].(common.Address))}
+	case types.ERC20MethodTransfer:
+		if !token.brokenTransfer {
+			if err = token.move(from, args[0].(common.Address), args[1].(*big.Int)); err != nil {
+				return nil, err
+			}
+		}
+		ret = []interface{}{true}
+	case types.ERC20MethodMint, types.ERC20MethodBurnCoins:
+		if from != token.minter {
+			return nil, errors.New("caller is not a minter")
+		}
+		if method.Name == types.ERC20MethodMint {
+			err = token.move(common.Address{}, args[0].(common.Address), args[1].(*big.Int))
+		} else {
+			err = token.move(args[0].(common.Address), common.Address{}, args[1].(*big.Int))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := method.Outputs.Pack(ret...)
+	if err != nil {
+		return nil, err
+	}
+	return &evmtypes.MsgEthereumTxResponse{Ret: res}, nil
+}
+
+func (k *fakeEVMKeeper) GetAccountWithoutBalance(_ sdk.Context, addr common.Address) *evmtypes.Account {
+	if _, found := k.contracts[addr]; !found {
+		return nil
+	}
+	return &evmtypes.Account{CodeHash: crypto.Keccak256(addr.Bytes())}
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	app       *app.App
+	keeper    keeper.Keeper
+	evm       *fakeEVMKeeper
+	authority string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	chainID := utils.TestnetChainID + "-1"
+	suite.app, _ = app.SetupSwissApp(nil, chainID)
+
+	header := testutil.NewHeader(1, time.Now().UTC(), chainID, sdk.ConsAddress(tests.RandomAccAddress()), nil, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+
+	suite.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	suite.evm = &fakeEVMKeeper{contracts: map[common.Address]*fakeERC20{}}
+	suite.keeper = *keeper.NewKeeper(
+		suite.app.GetKey(types.StoreKey),
+		suite.app.GetSubspace(types.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		suite.app.AccountKeeper,
+		suite.app.BankKeeper,
+		suite.evm,
+	)
+}
+
+// deployContract adds ERC20 contract, which can be minted and burnt by erc20 module
+func (suite *KeeperTestSuite) deployContract(symbol string, decimals uint8) (common.Address, *fakeERC20) {
+	address := common.BytesToAddress(tests.RandomAccAddress())
+	contract := &fakeERC20{
+		name:     symbol + " token",
+		symbol:   symbol,
+		decimals: decimals,
+		minter:   suite.keeper.ModuleAddress(),
+		balances: map[common.Address]*big.Int{},
+	}
+	suite.evm.contracts[address] = contract
+	return address, contract
+}
+
+// deployEVMContract deploys test ERC20 contract through EVM keeper and mints provided supply to owner
+func (suite *KeeperTestSuite) deployEVMContract(ctx sdk.Context, owner common.Address, ownerKey cryptotypes.PrivKey, supply *big.Int) common.Address {
+	ctorArgs, err := evmtypes.ERC20Contract.ABI.Pack("", owner, supply)
+	suite.Require().NoError(err)
+	data := append(evmtypes.ERC20Contract.Bin, ctorArgs...)
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(ctx, owner)
+	tx := evmtypes.NewSGXVMTxContract(chainID, nonce, nil, 5_000_000, nil, nil, nil, data, nil)
+	tx.From = owner.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), tests.NewTestSigner(ownerKey)))
+
+	rsp, err := suite.app.EvmKeeper.HandleTx(sdk.WrapSDKContext(ctx), tx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.VmError)
+	return crypto.CreateAddress(owner, nonce)
+}
+
+func (suite *KeeperTestSuite) fundAccount(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) {
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, coins))
+}
+
+func (suite *KeeperTestSuite) registerCoin(ctx sdk.Context, denom string) (types.TokenPair, *fakeERC20) {
+	address, contract := suite.deployContract("IBC", 6)
+	pair, err := suite.keeper.RegisterCoin(ctx, banktypes.Metadata{
+		Description: "IBC voucher",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        denom,
+		Symbol:      "IBC",
+	}, address)
+	suite.Require().NoError(err)
+	return pair, contract
+}
+
+func (suite *KeeperTestSuite) registerERC20(ctx sdk.Context) (types.TokenPair, *fakeERC20) {
+	address, contract := suite.deployContract("TKN", 18)
+	pair, err := suite.keeper.RegisterERC20(ctx, address)
+	suite.Require().NoError(err)
+	return pair, contract
+}
+
+func (suite *KeeperTestSuite) TestTokenPairs() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	coinPair, _ := suite.registerCoin(ctx, "ibc/ABCD")
+	erc20Pair, _ := suite.registerERC20(ctx)
+
+	for _, pair := range []types.TokenPair{coinPair, erc20Pair} {
+		for _, token := range []string{pair.Erc20Address, pair.Denom} {
+			found, err := suite.keeper.GetTokenPairByToken(ctx, token)
+			suite.Require().NoError(err)
+			suite.Require().Equal(pair, found)
+		}
+	}
+
+	pairs, err := suite.keeper.GetTokenPairs(ctx)
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.TokenPair{coinPair, erc20Pair}, pairs)
+
+	_, err = suite.keeper.GetTokenPairByToken(ctx, "unknown")
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	resp, err := keeper.Querier{Keeper: suite.keeper}.TokenPairs(sdk.WrapSDKContext(ctx), &types.QueryTokenPairsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.TokenPairs, 2)
+}
+
+func (suite *KeeperTestSuite) TestRegistration() {
+	ctx, _ := suite.ctx.CacheContext()
+
+	// Registered ERC20 gets native coin with metadata built from token details
+	pair, _ := suite.registerERC20(ctx)
+	suite.Require().Equal(types.OWNER_EXTERNAL, pair.ContractOwner)
+	suite.Require().Equal(types.CreateDenom(pair.GetERC20Contract()), pair.Denom)
+	metadata, found := suite.app.BankKeeper.GetDenomMetaData(ctx, pair.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal("tkn", metadata.Display)
+	suite.Require().Equal(uint32(18), metadata.DenomUnits[1].Exponent)
+
+	// Registered coin keeps existing metadata
+	existing := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ibc/EXISTING", Exponent: 0}},
+		Base:       "ibc/EXISTING",
+		Display:    "ibc/EXISTING",
+		Name:       "existing",
+		Symbol:     "EXT",
+	}
+	suite.app.BankKeeper.SetDenomMetaData(ctx, existing)
+	contract, _ := suite.deployContract("EXT", 0)
+	coinPair, err := suite.keeper.RegisterCoin(ctx, banktypes.Metadata{Base: "ibc/EXISTING"}, contract)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.OWNER_MODULE, coinPair.ContractOwner)
+	metadata, _ = suite.app.BankKeeper.GetDenomMetaData(ctx, "ibc/EXISTING")
+	suite.Require().Equal(existing, metadata)
+
+	// Contract and denomination can be registered only once
+	_, err = suite.keeper.RegisterERC20(ctx, pair.GetERC20Contract())
+	suite.Require().ErrorIs(err, types.ErrTokenPairAlreadyExists)
+	other, _ := suite.deployContract("OTHER", 0)
+	_, err = suite.keeper.RegisterCoin(ctx, banktypes.Metadata{Base: "ibc/EXISTING"}, other)
+	suite.Require().ErrorIs(err, types.ErrTokenPairAlreadyExists)
+
+	// Only deployed contracts can be registered
+	_, err = suite.keeper.RegisterERC20(ctx, common.HexToAddress("0x01"))
+	suite.Require().ErrorIs(err, types.ErrInvalidContract)
+
+	// Conversions can be toggled by both contract address and denomination
+	toggled, err := suite.keeper.ToggleConversion(ctx, pair.Denom)
+	suite.Require().NoError(err)
+	suite.Require().False(toggled.Enabled)
+	toggled, err = suite.keeper.ToggleConversion(ctx, pair.Erc20Address)
+	suite.Require().NoError(err)
+	suite.Require().True(toggled.Enabled)
+}
+
+func (suite *KeeperTestSuite) TestAuthority() {
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	contract, _ := suite.deployContract("TKN", 18)
+
+	msg := types.NewMsgRegisterERC20(tests.RandomAccAddress().String(), contract)
+	_, err := msgServer.HandleRegisterERC20(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	msg = types.NewMsgRegisterERC20(suite.authority, contract)
+	resp, err := msgServer.HandleRegisterERC20(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(contract.Hex(), resp.TokenPair.Erc20Address)
+}
+
+func (suite *KeeperTestSuite) TestConvertNativeCoin() {
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	denom := "ibc/ABCD"
+	pair, contract := suite.registerCoin(ctx, denom)
+	user := tests.RandomAccAddress()
+	userAddress := common.BytesToAddress(user)
+	suite.fundAccount(ctx, user, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))))
+
+	// Coins are escrowed and tokens are minted
+	convertCoin := types.NewMsgConvertCoin(sdk.NewCoin(denom, sdkmath.NewInt(60)), userAddress, user)
+	_, err := msgServer.HandleConvertCoin(goCtx, &convertCoin)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(40), suite.app.BankKeeper.GetBalance(ctx, user, denom).Amount.Int64())
+	suite.Require().Equal(int64(60), contract.balanceOf(userAddress).Int64())
+	moduleAccount := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(int64(60), suite.app.BankKeeper.GetBalance(ctx, moduleAccount, denom).Amount.Int64())
+
+	// Tokens are burnt and escrowed coins are released
+	convertERC20 := types.NewMsgConvertERC20(sdkmath.NewInt(50), user, pair.GetERC20Contract(), user)
+	_, err = msgServer.HandleConvertERC20(goCtx, &convertERC20)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(90), suite.app.BankKeeper.GetBalance(ctx, user, denom).Amount.Int64())
+	suite.Require().Equal(int64(10), contract.balanceOf(userAddress).Int64())
+
+	// Tokens cannot be converted over the balance
+	convertERC20 = types.NewMsgConvertERC20(sdkmath.NewInt(11), user, pair.GetERC20Contract(), user)
+	_, err = msgServer.HandleConvertERC20(goCtx, &convertERC20)
+	suite.Require().ErrorIs(err, types.ErrEVMCall)
+}
+
+func (suite *KeeperTestSuite) TestConvertNativeERC20() {
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	pair, contract := suite.registerERC20(ctx)
+	user := tests.RandomAccAddress()
+	userAddress := common.BytesToAddress(user)
+	contract.balances[userAddress] = big.NewInt(100)
+
+	// Tokens are escrowed and coins are minted
+	convertERC20 := types.NewMsgConvertERC20(sdkmath.NewInt(70), user, pair.GetERC20Contract(), user)
+	_, err := msgServer.HandleConvertERC20(goCtx, &convertERC20)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(70), suite.app.BankKeeper.GetBalance(ctx, user, pair.Denom).Amount.Int64())
+	suite.Require().Equal(int64(30), contract.balanceOf(userAddress).Int64())
+	suite.Require().Equal(int64(70), contract.balanceOf(suite.keeper.ModuleAddress()).Int64())
+
+	// Coins are burnt and escrowed tokens are released
+	convertCoin := types.NewMsgConvertCoin(sdk.NewCoin(pair.Denom, sdkmath.NewInt(20)), userAddress, user)
+	_, err = msgServer.HandleConvertCoin(goCtx, &convertCoin)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(50), suite.app.BankKeeper.GetBalance(ctx, user, pair.Denom).Amount.Int64())
+	suite.Require().Equal(int64(50), contract.balanceOf(userAddress).Int64())
+	suite.Require().Equal(int64(50), suite.app.BankKeeper.GetSupply(ctx, pair.Denom).Amount.Int64())
+
+	// Contract, which does not move tokens, is rejected
+	contract.brokenTransfer = true
+	_, err = msgServer.HandleConvertERC20(goCtx, &convertERC20)
+	suite.Require().ErrorIs(err, types.ErrBalanceInvariance)
+}
+
+func (suite *KeeperTestSuite) TestConvertNativeERC20WithEVM() {
+	ctx, _ := suite.ctx.CacheContext()
+	// EVM keeper requires block proposer to be an existing validator
+	validator := suite.app.StakingKeeper.GetAllValidators(ctx)[0]
+	consAddress, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	header := ctx.BlockHeader()
+	header.ProposerAddress = consAddress
+	ctx = ctx.WithBlockHeader(header)
+	erc20Keeper := *keeper.NewKeeper(
+		suite.app.GetKey(types.StoreKey),
+		suite.app.GetSubspace(types.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		suite.app.AccountKeeper,
+		suite.app.BankKeeper,
+		suite.app.EvmKeeper,
+	)
+	msgServer := keeper.NewMsgServerImpl(erc20Keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	userAddress, userKey := tests.RandomEthAddressWithPrivateKey()
+	user := sdk.AccAddress(userAddress.Bytes())
+	contract := suite.deployEVMContract(ctx, userAddress, userKey, big.NewInt(100))
+	pair := types.NewTokenPair(contract, types.CreateDenom(contract), types.OWNER_EXTERNAL)
+	suite.Require().NoError(erc20Keeper.SetTokenPair(ctx, pair))
+	module := erc20Keeper.ModuleAddress()
+
+	userNonce := suite.app.EvmKeeper.GetNonce(ctx, userAddress)
+	moduleNonce := suite.app.EvmKeeper.GetNonce(ctx, module)
+
+	// Tokens are escrowed by real contract and nonce of the user is not changed
+	gasBefore := ctx.GasMeter().GasConsumed()
+	convertERC20 := types.NewMsgConvertERC20(sdkmath.NewInt(70), user, contract, user)
+	_, err = msgServer.HandleConvertERC20(goCtx, &convertERC20)
+	suite.Require().NoError(err)
+	suite.Require().Greater(ctx.GasMeter().GasConsumed(), gasBefore)
+	suite.Require().Equal(userNonce, suite.app.EvmKeeper.GetNonce(ctx, userAddress))
+	suite.Require().Equal(int64(70), suite.app.BankKeeper.GetBalance(ctx, user, pair.Denom).Amount.Int64())
+	balance, err := erc20Keeper.BalanceOf(ctx, contract, userAddress)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(30), balance.Int64())
+	balance, err = erc20Keeper.BalanceOf(ctx, contract, module)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(70), balance.Int64())
+
+	// Escrowed tokens are released by module and nonce of the module is not changed
+	convertCoin := types.NewMsgConvertCoin(sdk.NewCoin(pair.Denom, sdkmath.NewInt(20)), userAddress, user)
+	_, err = msgServer.HandleConvertCoin(goCtx, &convertCoin)
+	suite.Require().NoError(err)
+	suite.Require().Equal(moduleNonce, suite.app.EvmKeeper.GetNonce(ctx, module))
+	suite.Require().Equal(userNonce, suite.app.EvmKeeper.GetNonce(ctx, userAddress))
+	suite.Require().Equal(int64(50), suite.app.BankKeeper.GetBalance(ctx, user, pair.Denom).Amount.Int64())
+	balance, err = erc20Keeper.BalanceOf(ctx, contract, userAddress)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(50), balance.Int64())
+}
+
+func (suite *KeeperTestSuite) TestConvertDisabled() {
+	ctx, _ := suite.ctx.CacheContext()
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	pair, contract := suite.registerERC20(ctx)
+	user := tests.RandomAccAddress()
+	contract.balances[common.BytesToAddress(user)] = big.NewInt(100)
+	msg := types.NewMsgConvertERC20(sdkmath.NewInt(10), user, pair.GetERC20Contract(), user)
+
+	_, err := suite.keeper.ToggleConversion(ctx, pair.Denom)
+	suite.Require().NoError(err)
+	_, err = msgServer.HandleConvertERC20(goCtx, &msg)
+	suite.Require().ErrorIs(err, types.ErrTokenPairDisabled)
+
+	_, err = suite.keeper.ToggleConversion(ctx, pair.Denom)
+	suite.Require().NoError(err)
+	suite.keeper.SetParams(ctx, types.NewParams(false))
+	_, err = msgServer.HandleConvertERC20(goCtx, &msg)
+	suite.Require().ErrorIs(err, types.ErrERC20Disabled)
+
+	// Unregistered tokens cannot be converted
+	suite.keeper.SetParams(ctx, types.DefaultParams())
+	msg = types.NewMsgConvertERC20(sdkmath.NewInt(10), user, common.HexToAddress("0x01"), user)
+	_, err = msgServer.HandleConvertERC20(goCtx, &msg)
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/erc20/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// HandleConvertCoin converts native coin of sender to ERC20 tokens of receiver
+func (k msgServer) HandleConvertCoin(goCtx context.Context, msg *types.MsgConvertCoin) (*types.MsgConvertCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiver := common.HexToAddress(msg.Receiver)
+
+	pair, err := k.getEnabledPair(ctx, msg.Coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.bankKeeper.IsSendEnabledCoins(ctx, msg.Coin); err != nil {
+		return nil, err
+	}
+
+	if err = k.ConvertCoin(ctx, pair, msg.Coin, sender, receiver); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// HandleConvertERC20 converts ERC20 tokens of sender to native coins of receiver
+func (k msgServer) HandleConvertERC20(goCtx context.Context, msg *types.MsgConvertERC20) (*types.MsgConvertERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	pair, err := k.getEnabledPair(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Receiver)
+	}
+
+	if err = k.ConvertERC20(ctx, pair, msg.Amount, sender, receiver); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertERC20Response{}, nil
+}
+
+// HandleRegisterCoin implements the gRPC MsgServer interface. When a RegisterCoin
+// proposal passes, it creates token pair for native coin and provided ERC20 contract.
+// The update can only be performed if the requested authority is the Cosmos SDK governance module account.
+func (k msgServer) HandleRegisterCoin(goCtx context.Context, msg *types.MsgRegisterCoin) (*types.MsgRegisterCoinResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, err := k.RegisterCoin(ctx, msg.Metadata, common.HexToAddress(msg.Erc20Address))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCoinResponse{TokenPair: pair}, nil
+}
+
+// HandleRegisterERC20 implements the gRPC MsgServer interface. When a RegisterERC20
+// proposal passes, it creates token pair for provided ERC20 contract and new native coin.
+// The update can only be performed if the requested authority is the Cosmos SDK governance module account.
+func (k msgServer) HandleRegisterERC20(goCtx context.Context, msg *types.MsgRegisterERC20) (*types.MsgRegisterERC20Response, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, err := k.RegisterERC20(ctx, common.HexToAddress(msg.Erc20Address))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterERC20Response{TokenPair: pair}, nil
+}
+
+// HandleToggleConversion implements the gRPC MsgServer interface. When a ToggleConversion
+// proposal passes, it enables or disables conversions of registered token pair.
+// The update can only be performed if the requested authority is the Cosmos SDK governance module account.
+func (k msgServer) HandleToggleConversion(goCtx context.Context, msg *types.MsgToggleConversion) (*types.MsgToggleConversionResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, err := k.ToggleConversion(ctx, msg.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgToggleConversionResponse{TokenPair: pair}, nil
+}
+
+func (k msgServer) checkAuthority(authority string) error {
+	if k.authority.String() != authority {
+		return errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), authority)
+	}
+	return nil
+}
+
+// getEnabledPair returns token pair, if both module and token pair conversions are enabled
+func (k Keeper) getEnabledPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	if !k.IsERC20Enabled(ctx) {
+		return types.TokenPair{}, types.ErrERC20Disabled
+	}
+
+	pair, err := k.GetTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+	if !pair.Enabled {
+		return types.TokenPair{}, errors.Wrapf(types.ErrTokenPairDisabled, "token %s", token)
+	}
+	return pair, nil
+}
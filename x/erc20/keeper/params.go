@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/x/erc20/types"
+)
+
+// GetParams get all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramstore.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
+
+// IsERC20Enabled returns true if conversions between native coins and ERC20 tokens are enabled
+func (k Keeper) IsERC20Enabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).EnableErc20
+}
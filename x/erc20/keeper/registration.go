@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/erc20/types"
+)
+
+// RegisterCoin creates token pair for native coin and deployed ERC20 contract, which represents it.
+// The contract should allow erc20 module to mint and burn tokens. If denomination has no metadata yet,
+// provided metadata is stored.
+func (k Keeper) RegisterCoin(ctx sdk.Context, metadata banktypes.Metadata, contract common.Address) (types.TokenPair, error) {
+	denom := metadata.Base
+	if types.IsERC20Denom(denom) {
+		return types.TokenPair{}, errors.Wrapf(types.ErrInvalidTokenPair, "cannot register coin %s, which represents ERC20 token", denom)
+	}
+	if err := k.checkRegistration(ctx, contract, denom); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		if err := metadata.Validate(); err != nil {
+			return types.TokenPair{}, errors.Wrapf(types.ErrInvalidTokenPair, "invalid metadata of %s: %s", denom, err)
+		}
+		k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	pair := types.NewTokenPair(contract, denom, types.OWNER_MODULE)
+	if err := k.SetTokenPair(ctx, pair); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCoin,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return pair, nil
+}
+
+// RegisterERC20 creates token pair for deployed ERC20 contract and native coin, which is created by the module.
+// Metadata of native coin is built from name, symbol and decimals of ERC20 token.
+func (k Keeper) RegisterERC20(ctx sdk.Context, contract common.Address) (types.TokenPair, error) {
+	denom := types.CreateDenom(contract)
+	if err := k.checkRegistration(ctx, contract, denom); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	metadata := createMetadata(denom, contract, data)
+	if err = metadata.Validate(); err != nil {
+		return types.TokenPair{}, errors.Wrapf(types.ErrInvalidContract, "invalid metadata of %s: %s", denom, err)
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	pair := types.NewTokenPair(contract, denom, types.OWNER_EXTERNAL)
+	if err = k.SetTokenPair(ctx, pair); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return pair, nil
+}
+
+// ToggleConversion enables or disables conversions of registered token pair
+func (k Keeper) ToggleConversion(ctx sdk.Context, token string) (types.TokenPair, error) {
+	pair, err := k.GetTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	pair.Enabled = !pair.Enabled
+	if err = k.SetTokenPair(ctx, pair); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeToggleConversion,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(pair.Enabled)),
+		),
+	)
+
+	return pair, nil
+}
+
+// checkRegistration ensures that neither contract nor denomination are registered and contract is deployed
+func (k Keeper) checkRegistration(ctx sdk.Context, contract common.Address, denom string) error {
+	if k.IsERC20Registered(ctx, contract) {
+		return errors.Wrapf(types.ErrTokenPairAlreadyExists, "contract %s is already registered", contract.Hex())
+	}
+	if k.IsDenomRegistered(ctx, denom) {
+		return errors.Wrapf(types.ErrTokenPairAlreadyExists, "coin %s is already registered", denom)
+	}
+
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if account == nil || !account.IsContract() {
+		return errors.Wrapf(types.ErrInvalidContract, "%s is not a contract", contract.Hex())
+	}
+	return nil
+}
+
+// createMetadata creates metadata of native coin, which represents ERC20 token. Display unit is
+// a lowercase token symbol, if it is a valid denomination, so amounts can be displayed with decimals.
+func createMetadata(denom string, contract common.Address, data ERC20Data) banktypes.Metadata {
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("Cosmos coin representation of ERC20 token %s", contract.Hex()),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        data.Name,
+		Symbol:      data.Symbol,
+	}
+
+	display := strings.ToLower(data.Symbol)
+	if data.Decimals > 0 && display != denom && sdk.ValidateDenom(display) == nil {
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: display, Exponent: uint32(data.Decimals)})
+		metadata.Display = display
+	}
+	return metadata
+}
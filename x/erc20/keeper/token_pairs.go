@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/erc20/types"
+)
+
+// SetTokenPair stores token pair and indexes it by ERC20 contract address and denomination
+func (k Keeper) SetTokenPair(ctx sdk.Context, pair types.TokenPair) error {
+	pairBytes, err := pair.Marshal()
+	if err != nil {
+		return err
+	}
+
+	id := pair.GetId()
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair).Set(id, pairBytes)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC20).Set(pair.GetERC20Contract().Bytes(), id)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom).Set([]byte(pair.Denom), id)
+	return nil
+}
+
+// GetTokenPair returns token pair with provided id
+func (k Keeper) GetTokenPair(ctx sdk.Context, id []byte) (types.TokenPair, bool, error) {
+	var pair types.TokenPair
+
+	pairBytes := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair).Get(id)
+	if pairBytes == nil {
+		return pair, false, nil
+	}
+	if err := pair.Unmarshal(pairBytes); err != nil {
+		return pair, false, err
+	}
+	return pair, true, nil
+}
+
+// GetTokenPairId returns id of token pair by either hex address of ERC20 contract or by denomination of native coin
+func (k Keeper) GetTokenPairId(ctx sdk.Context, token string) []byte {
+	if common.IsHexAddress(token) {
+		return k.GetERC20Map(ctx, common.HexToAddress(token))
+	}
+	return k.GetDenomMap(ctx, token)
+}
+
+// GetTokenPairByToken returns token pair by either hex address of ERC20 contract or by denomination of native coin
+func (k Keeper) GetTokenPairByToken(ctx sdk.Context, token string) (types.TokenPair, error) {
+	id := k.GetTokenPairId(ctx, token)
+	if id == nil {
+		return types.TokenPair{}, errors.Wrapf(types.ErrTokenPairNotFound, "token %s is not registered", token)
+	}
+
+	pair, found, err := k.GetTokenPair(ctx, id)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+	if !found {
+		return types.TokenPair{}, errors.Wrapf(types.ErrTokenPairNotFound, "token %s is not registered", token)
+	}
+	return pair, nil
+}
+
+// GetERC20Map returns id of token pair by ERC20 contract address
+func (k Keeper) GetERC20Map(ctx sdk.Context, erc20Address common.Address) []byte {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC20).Get(erc20Address.Bytes())
+}
+
+// GetDenomMap returns id of token pair by denomination of native coin
+func (k Keeper) GetDenomMap(ctx sdk.Context, denom string) []byte {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom).Get([]byte(denom))
+}
+
+// IsERC20Registered returns true if token pair for provided ERC20 contract is registered
+func (k Keeper) IsERC20Registered(ctx sdk.Context, erc20Address common.Address) bool {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC20).Has(erc20Address.Bytes())
+}
+
+// IsDenomRegistered returns true if token pair for provided denomination is registered
+func (k Keeper) IsDenomRegistered(ctx sdk.Context, denom string) bool {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom).Has([]byte(denom))
+}
+
+// IterateTokenPairs iterates over all registered token pairs
+func (k Keeper) IterateTokenPairs(ctx sdk.Context, callback func(pair types.TokenPair) (continue_ bool)) error {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.TokenPair
+		if err := pair.Unmarshal(iterator.Value()); err != nil {
+			return err
+		}
+		if !callback(pair) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetTokenPairs returns all registered token pairs
+func (k Keeper) GetTokenPairs(ctx sdk.Context) ([]types.TokenPair, error) {
+	pairs := []types.TokenPair{}
+	err := k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+		pairs = append(pairs, pair)
+		return true
+	})
+	return pairs, err
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterCodec(Amino)
+	Amino.Seal()
+}
+
+func RegisterCodec(cdc *codec.LegacyAmino) {}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
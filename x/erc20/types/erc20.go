@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// ERC20 contract functions, which are called by erc20 module
+const (
+	ERC20MethodName      = "name"
+	ERC20MethodSymbol    = "symbol"
+	ERC20MethodDecimals  = "decimals"
+	ERC20MethodBalanceOf = "balanceOf"
+	ERC20MethodTransfer  = "transfer"
+	// ERC20MethodMint and ERC20MethodBurnCoins are called only for contracts, which represent native coins.
+	// These functions are compatible with ERC20MinterBurnerDecimals contract.
+	ERC20MethodMint      = "mint"
+	ERC20MethodBurnCoins = "burnCoins"
+)
+
+const erc20ABIJSON = `[
+	{"type": "function", "name": "name", "stateMutability": "view", "inputs": [], "outputs": [{"name": "", "type": "string"}]},
+	{"type": "function", "name": "symbol", "stateMutability": "view", "inputs": [], "outputs": [{"name": "", "type": "string"}]},
+	{"type": "function", "name": "decimals", "stateMutability": "view", "inputs": [], "outputs": [{"name": "", "type": "uint8"}]},
+	{
+		"type": "function", "name": "balanceOf", "stateMutability": "view",
+		"inputs": [{"name": "account", "type": "address"}],
+		"outputs": [{"name": "", "type": "uint256"}]
+	},
+	{
+		"type": "function", "name": "transfer", "stateMutability": "nonpayable",
+		"inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}],
+		"outputs": [{"name": "", "type": "bool"}]
+	},
+	{
+		"type": "function", "name": "mint", "stateMutability": "nonpayable",
+		"inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}],
+		"outputs": []
+	},
+	{
+		"type": "function", "name": "burnCoins", "stateMutability": "nonpayable",
+		"inputs": [{"name": "from", "type": "address"}, {"name": "amount", "type": "uint256"}],
+		"outputs": []
+	}
+]`
+
+// ERC20ABI contains functions of ERC20 contract, which are called by erc20 module
+var ERC20ABI abi.ABI
+
+func init() {
+	var err error
+	if ERC20ABI, err = abi.JSON(strings.NewReader(erc20ABIJSON)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	codeErrERC20Disabled = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrInvalidTokenPair
+	codeErrTokenPairNotFound
+	codeErrTokenPairAlreadyExists
+	codeErrTokenPairDisabled
+	codeErrInvalidContract
+	codeErrEVMCall
+	codeErrBalanceInvariance
+	codeErrUndefinedOwner
+)
+
+var (
+	ErrERC20Disabled          = sdkerrors.Register(ModuleName, codeErrERC20Disabled, "erc20 module is disabled")
+	ErrInvalidTokenPair       = sdkerrors.Register(ModuleName, codeErrInvalidTokenPair, "invalid token pair")
+	ErrTokenPairNotFound      = sdkerrors.Register(ModuleName, codeErrTokenPairNotFound, "token pair not found")
+	ErrTokenPairAlreadyExists = sdkerrors.Register(ModuleName, codeErrTokenPairAlreadyExists, "token pair already exists")
+	ErrTokenPairDisabled      = sdkerrors.Register(ModuleName, codeErrTokenPairDisabled, "token pair conversions are disabled")
+	ErrInvalidContract        = sdkerrors.Register(ModuleName, codeErrInvalidContract, "invalid ERC20 contract")
+	ErrEVMCall                = sdkerrors.Register(ModuleName, codeErrEVMCall, "ERC20 contract call failed")
+	ErrBalanceInvariance      = sdkerrors.Register(ModuleName, codeErrBalanceInvariance, "unexpected change of ERC20 balance")
+	ErrUndefinedOwner         = sdkerrors.Register(ModuleName, codeErrUndefinedOwner, "undefined owner of ERC20 contract")
+)
@@ -0,0 +1,15 @@
+package types
+
+// erc20 module events
+const (
+	EventTypeConvertCoin      = "convert_coin"
+	EventTypeConvertERC20     = "convert_erc20"
+	EventTypeRegisterCoin     = "register_coin"
+	EventTypeRegisterERC20    = "register_erc20"
+	EventTypeToggleConversion = "toggle_token_conversion"
+
+	AttributeKeyCosmosCoin = "cosmos_coin"
+	AttributeKeyERC20Token = "erc20_token"
+	AttributeKeyReceiver   = "receiver"
+	AttributeKeyEnabled    = "enabled"
+)
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "swisstronik/x/evm/types"
+)
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	BlockedAddr(addr sdk.AccAddress) bool
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+	HasSupply(ctx sdk.Context, denom string) bool
+}
+
+// EVMKeeper defines the expected EVM keeper. Contracts are called through SGXVM,
+// so state of encrypted contracts stays consistent.
+type EVMKeeper interface {
+	CallEVMWithData(ctx sdk.Context, from common.Address, contract common.Address, data []byte, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *evmtypes.Account
+}
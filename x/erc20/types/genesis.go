@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:     DefaultParams(),
+		TokenPairs: []TokenPair{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure
+func (gs GenesisState) Validate() error {
+	denoms := make(map[string]struct{}, len(gs.TokenPairs))
+	contracts := make(map[string]struct{}, len(gs.TokenPairs))
+
+	for _, pair := range gs.TokenPairs {
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+
+		contract := strings.ToLower(pair.Erc20Address)
+		if _, found := denoms[pair.Denom]; found {
+			return fmt.Errorf("duplicated token pair for denomination %s", pair.Denom)
+		}
+		if _, found := contracts[contract]; found {
+			return fmt.Errorf("duplicated token pair for ERC20 contract %s", pair.Erc20Address)
+		}
+		denoms[pair.Denom] = struct{}{}
+		contracts[contract] = struct{}{}
+	}
+
+	return gs.Params.Validate()
+}
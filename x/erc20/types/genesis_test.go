@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"swisstronik/x/erc20/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	otherContract := common.HexToAddress("0x1000000000000000000000000000000000000002")
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					types.NewTokenPair(contract, "ibc/ABCD", types.OWNER_MODULE),
+					types.NewTokenPair(otherContract, types.CreateDenom(otherContract), types.OWNER_EXTERNAL),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated denomination",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					types.NewTokenPair(contract, "ibc/ABCD", types.OWNER_MODULE),
+					types.NewTokenPair(otherContract, "ibc/ABCD", types.OWNER_MODULE),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated contract",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					types.NewTokenPair(contract, "ibc/ABCD", types.OWNER_MODULE),
+					types.NewTokenPair(contract, "ibc/EFGH", types.OWNER_MODULE),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid token pair",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: []types.TokenPair{types.NewTokenPair(contract, "ibc/ABCD", types.OWNER_UNSPECIFIED)},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
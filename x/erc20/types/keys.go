@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "erc20"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+const (
+	prefixTokenPair = iota + 1
+	prefixTokenPairByERC20
+	prefixTokenPairByDenom
+)
+
+var (
+	KeyPrefixTokenPair        = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+)
+
+// TokenPairId returns id of token pair, which is keccak256 hash of ERC20 contract address and denomination
+func TokenPairId(erc20Address common.Address, denom string) []byte {
+	return crypto.Keccak256(erc20Address.Bytes(), []byte(denom))
+}
@@ -0,0 +1,181 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+func NewMsgConvertCoin(coin sdk.Coin, receiver common.Address, sender sdk.AccAddress) MsgConvertCoin {
+	return MsgConvertCoin{
+		Coin:     coin,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+func (msg *MsgConvertCoin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgConvertCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if !common.IsHexAddress(msg.Receiver) {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver hex address %s", msg.Receiver)
+	}
+	if !msg.Coin.IsValid() || !msg.Coin.IsPositive() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin %s", msg.Coin)
+	}
+	return nil
+}
+
+func (msg *MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgConvertERC20(amount sdkmath.Int, receiver sdk.AccAddress, contract common.Address, sender sdk.AccAddress) MsgConvertERC20 {
+	return MsgConvertERC20{
+		ContractAddress: contract.Hex(),
+		Amount:          amount,
+		Receiver:        receiver.String(),
+		Sender:          sender.String(),
+	}
+}
+
+func (msg *MsgConvertERC20) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgConvertERC20) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address %s", msg.ContractAddress)
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", msg.Amount)
+	}
+	return nil
+}
+
+func (msg *MsgConvertERC20) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgRegisterCoin(authority string, metadata banktypes.Metadata, erc20Address common.Address) MsgRegisterCoin {
+	return MsgRegisterCoin{
+		Authority:    authority,
+		Metadata:     metadata,
+		Erc20Address: erc20Address.Hex(),
+	}
+}
+
+func (msg *MsgRegisterCoin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRegisterCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if err := msg.Metadata.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidTokenPair, "invalid coin metadata (%s)", err)
+	}
+	if IsERC20Denom(msg.Metadata.Base) {
+		return errors.Wrapf(ErrInvalidTokenPair, "cannot register coin, which represents ERC20 token: %s", msg.Metadata.Base)
+	}
+	if !common.IsHexAddress(msg.Erc20Address) {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid ERC20 hex address %s", msg.Erc20Address)
+	}
+	return nil
+}
+
+func (msg *MsgRegisterCoin) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgRegisterERC20(authority string, erc20Address common.Address) MsgRegisterERC20 {
+	return MsgRegisterERC20{
+		Authority:    authority,
+		Erc20Address: erc20Address.Hex(),
+	}
+}
+
+func (msg *MsgRegisterERC20) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRegisterERC20) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if !common.IsHexAddress(msg.Erc20Address) {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid ERC20 hex address %s", msg.Erc20Address)
+	}
+	return nil
+}
+
+func (msg *MsgRegisterERC20) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgToggleConversion(authority, token string) MsgToggleConversion {
+	return MsgToggleConversion{
+		Authority: authority,
+		Token:     token,
+	}
+}
+
+func (msg *MsgToggleConversion) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgToggleConversion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if !common.IsHexAddress(msg.Token) {
+		if err := sdk.ValidateDenom(msg.Token); err != nil {
+			return errors.Wrapf(ErrInvalidTokenPair, "token should be hex address or denomination (%s)", err)
+		}
+	}
+	return nil
+}
+
+func (msg *MsgToggleConversion) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
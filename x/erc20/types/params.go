@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	// DefaultEnableERC20 is true, conversions are enabled
+	DefaultEnableERC20 = true
+)
+
+// Parameter keys
+var (
+	ParamStoreKeyEnableERC20 = []byte("EnableERC20")
+)
+
+// ParamKeyTable the param key table for erc20 module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(enableERC20 bool) Params {
+	return Params{
+		EnableErc20: enableERC20,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultEnableERC20)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableERC20, &p.EnableErc20, validateBool),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validateBool(p.EnableErc20)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ERC20DenomPrefix is a prefix of denomination of native coins, which represent ERC20 tokens
+const ERC20DenomPrefix = "erc20/"
+
+// CreateDenom returns denomination of native coin, which represents ERC20 token
+func CreateDenom(erc20Address common.Address) string {
+	return ERC20DenomPrefix + erc20Address.Hex()
+}
+
+// IsERC20Denom returns true if denomination belongs to native coin, which represents ERC20 token
+func IsERC20Denom(denom string) bool {
+	return strings.HasPrefix(denom, ERC20DenomPrefix)
+}
+
+// NewTokenPair creates enabled token pair
+func NewTokenPair(erc20Address common.Address, denom string, owner Owner) TokenPair {
+	return TokenPair{
+		Erc20Address:  erc20Address.Hex(),
+		Denom:         denom,
+		Enabled:       true,
+		ContractOwner: owner,
+	}
+}
+
+// GetId returns id of token pair
+func (tp TokenPair) GetId() []byte {
+	return TokenPairId(tp.GetERC20Contract(), tp.Denom)
+}
+
+// GetERC20Contract returns address of ERC20 contract
+func (tp TokenPair) GetERC20Contract() common.Address {
+	return common.HexToAddress(tp.Erc20Address)
+}
+
+// IsNativeCoin returns true if ERC20 contract represents native coin
+func (tp TokenPair) IsNativeCoin() bool {
+	return tp.ContractOwner == OWNER_MODULE
+}
+
+// IsNativeERC20 returns true if native coin represents ERC20 token
+func (tp TokenPair) IsNativeERC20() bool {
+	return tp.ContractOwner == OWNER_EXTERNAL
+}
+
+// Validate performs a stateless validation of token pair
+func (tp TokenPair) Validate() error {
+	if !common.IsHexAddress(tp.Erc20Address) {
+		return errors.Wrapf(ErrInvalidTokenPair, "invalid ERC20 hex address %s", tp.Erc20Address)
+	}
+	if err := sdk.ValidateDenom(tp.Denom); err != nil {
+		return errors.Wrap(ErrInvalidTokenPair, err.Error())
+	}
+	switch tp.ContractOwner {
+	case OWNER_MODULE:
+		if IsERC20Denom(tp.Denom) {
+			return errors.Wrapf(ErrInvalidTokenPair, "denomination %s of native coin cannot have %s prefix", tp.Denom, ERC20DenomPrefix)
+		}
+	case OWNER_EXTERNAL:
+		if tp.Denom != CreateDenom(tp.GetERC20Contract()) {
+			return errors.Wrapf(ErrInvalidTokenPair, "expected denomination %s, got %s", CreateDenom(tp.GetERC20Contract()), tp.Denom)
+		}
+	default:
+		return errors.Wrapf(ErrUndefinedOwner, "owner %s", tp.ContractOwner)
+	}
+	return nil
+}
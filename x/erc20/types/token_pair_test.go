@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"swisstronik/x/erc20/types"
+)
+
+func TestTokenPairValidate(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name  string
+		pair  types.TokenPair
+		valid bool
+	}{
+		{"native coin", types.NewTokenPair(contract, "aswtr", types.OWNER_MODULE), true},
+		{"ibc voucher", types.NewTokenPair(contract, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", types.OWNER_MODULE), true},
+		{"native ERC20", types.NewTokenPair(contract, types.CreateDenom(contract), types.OWNER_EXTERNAL), true},
+		{"native coin with erc20 prefix", types.NewTokenPair(contract, types.CreateDenom(contract), types.OWNER_MODULE), false},
+		{"native ERC20 with unexpected denom", types.NewTokenPair(contract, "aswtr", types.OWNER_EXTERNAL), false},
+		{"undefined owner", types.NewTokenPair(contract, "aswtr", types.OWNER_UNSPECIFIED), false},
+		{"invalid contract", types.TokenPair{Erc20Address: "0x01", Denom: "aswtr", ContractOwner: types.OWNER_MODULE}, false},
+		{"invalid denom", types.NewTokenPair(contract, "1", types.OWNER_MODULE), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pair.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTokenPairId(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	pair := types.NewTokenPair(contract, "aswtr", types.OWNER_MODULE)
+
+	require.Equal(t, types.TokenPairId(contract, "aswtr"), pair.GetId())
+	require.NotEqual(t, pair.GetId(), types.NewTokenPair(contract, "uatom", types.OWNER_MODULE).GetId())
+	require.True(t, types.IsERC20Denom(types.CreateDenom(contract)))
+	require.False(t, types.IsERC20Denom("aswtr"))
+}
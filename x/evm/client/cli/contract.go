@@ -0,0 +1,603 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"swisstronik/client/sgx"
+	"swisstronik/crypto/deoxys"
+	"swisstronik/server/config"
+	ethermint "swisstronik/types"
+	"swisstronik/x/evm/types"
+)
+
+const (
+	FlagValue                = "value"
+	FlagGasLimit             = "gas-limit"
+	FlagMaxFeePerGas         = "max-fee-per-gas"
+	FlagMaxPriorityFeePerGas = "max-priority-fee-per-gas"
+)
+
+// CallOutput is a decoded return value of contract method
+type CallOutput struct {
+	Name  string      `json:"name,omitempty"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// contractArtifact contains fields of Hardhat, Truffle and Foundry artifacts, which are used by CLI
+type contractArtifact struct {
+	ABI      json.RawMessage `json:"abi"`
+	Bytecode json.RawMessage `json:"bytecode"`
+}
+
+// loadABI reads contract ABI from JSON file. File can contain either ABI or contract artifact with `abi` field.
+func loadABI(path string) (abi.ABI, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+
+	var artifact contractArtifact
+	if err := json.Unmarshal(bz, &artifact); err == nil && len(artifact.ABI) != 0 {
+		bz = artifact.ABI
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(string(bz)))
+	if err != nil {
+		return abi.ABI{}, errors.Wrapf(err, "failed to parse ABI from %s", path)
+	}
+	return contractABI, nil
+}
+
+// loadBytecode reads contract bytecode from file. File can contain either hex encoded bytecode or contract artifact
+// with `bytecode` field.
+func loadBytecode(path string) ([]byte, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	hexBytecode := strings.TrimSpace(string(bz))
+	var artifact contractArtifact
+	if err := json.Unmarshal(bz, &artifact); err == nil && len(artifact.Bytecode) != 0 {
+		// Foundry stores bytecode as object with `object` field
+		var foundryBytecode struct {
+			Object string `json:"object"`
+		}
+		if err := json.Unmarshal(artifact.Bytecode, &hexBytecode); err != nil {
+			if err := json.Unmarshal(artifact.Bytecode, &foundryBytecode); err != nil {
+				return nil, fmt.Errorf("unsupported bytecode format in %s", path)
+			}
+			hexBytecode = foundryBytecode.Object
+		}
+	}
+
+	if !strings.HasPrefix(hexBytecode, "0x") {
+		hexBytecode = "0x" + hexBytecode
+	}
+	bytecode, err := hexutil.Decode(hexBytecode)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode bytecode from %s", path)
+	}
+	if len(bytecode) == 0 {
+		return nil, fmt.Errorf("bytecode in %s is empty", path)
+	}
+	return bytecode, nil
+}
+
+// packCall returns method of contract ABI and input of the call with provided arguments
+func packCall(contractABI abi.ABI, methodName string, args []string) (abi.Method, []byte, error) {
+	method, found := contractABI.Methods[methodName]
+	if !found {
+		return abi.Method{}, nil, fmt.Errorf("method %s not found in ABI", methodName)
+	}
+
+	values, err := parseABIArgs(method.Inputs, args)
+	if err != nil {
+		return abi.Method{}, nil, errors.Wrapf(err, "invalid arguments of method %s", methodName)
+	}
+
+	data, err := contractABI.Pack(methodName, values...)
+	if err != nil {
+		return abi.Method{}, nil, err
+	}
+	return method, data, nil
+}
+
+// packConstructor returns bytecode of contract followed by encoded constructor arguments
+func packConstructor(contractABI abi.ABI, bytecode []byte, args []string) ([]byte, error) {
+	values, err := parseABIArgs(contractABI.Constructor.Inputs, args)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid arguments of constructor")
+	}
+
+	encodedArgs, err := contractABI.Pack("", values...)
+	if err != nil {
+		return nil, err
+	}
+	return append(bytecode, encodedArgs...), nil
+}
+
+// parseABIArgs converts command line arguments to values of ABI types
+func parseABIArgs(inputs abi.Arguments, args []string) ([]interface{}, error) {
+	if len(inputs) != len(args) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(inputs), len(args))
+	}
+
+	values := make([]interface{}, len(args))
+	for i, input := range inputs {
+		value, err := parseABIArg(input.Type, args[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "argument %d (%s)", i, input.Type.String())
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// parseABIArg converts string to value of provided ABI type. Arrays are expected as JSON arrays.
+func parseABIArg(t abi.Type, arg string) (interface{}, error) {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		return parseABIInteger(t, arg)
+	case abi.BoolTy:
+		return strconv.ParseBool(arg)
+	case abi.StringTy:
+		return arg, nil
+	case abi.AddressTy:
+		address, err := accountToHex(arg)
+		if err != nil {
+			return nil, err
+		}
+		return common.HexToAddress(address), nil
+	case abi.BytesTy:
+		return hexutil.Decode(arg)
+	case abi.FixedBytesTy:
+		bz, err := hexutil.Decode(arg)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != t.Size {
+			return nil, fmt.Errorf("expected %d bytes, got %d", t.Size, len(bz))
+		}
+		value := reflect.New(t.GetType()).Elem()
+		reflect.Copy(value, reflect.ValueOf(bz))
+		return value.Interface(), nil
+	case abi.SliceTy, abi.ArrayTy:
+		return parseABIArray(t, arg)
+	default:
+		return nil, fmt.Errorf("unsupported argument type %s", t.String())
+	}
+}
+
+func parseABIInteger(t abi.Type, arg string) (interface{}, error) {
+	value, ok := new(big.Int).SetString(arg, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %s", arg)
+	}
+
+	if t.T == abi.UintTy {
+		if value.Sign() < 0 || value.BitLen() > t.Size {
+			return nil, fmt.Errorf("%s is out of range of %s", arg, t.String())
+		}
+	} else {
+		minValue := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1)))
+		maxValue := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1)), big.NewInt(1))
+		if value.Cmp(minValue) < 0 || value.Cmp(maxValue) > 0 {
+			return nil, fmt.Errorf("%s is out of range of %s", arg, t.String())
+		}
+	}
+
+	// Integers with size up to 64 bits are packed from native Go types
+	goType := t.GetType()
+	switch goType.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(value.Int64()).Convert(goType).Interface(), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.ValueOf(value.Uint64()).Convert(goType).Interface(), nil
+	default:
+		return value, nil
+	}
+}
+
+func parseABIArray(t abi.Type, arg string) (interface{}, error) {
+	var elements []json.RawMessage
+	if err := json.Unmarshal([]byte(arg), &elements); err != nil {
+		return nil, errors.Wrap(err, "array should be provided as JSON array")
+	}
+	if t.T == abi.ArrayTy && len(elements) != t.Size {
+		return nil, fmt.Errorf("expected %d elements, got %d", t.Size, len(elements))
+	}
+
+	var value reflect.Value
+	if t.T == abi.ArrayTy {
+		value = reflect.New(t.GetType()).Elem()
+	} else {
+		value = reflect.MakeSlice(t.GetType(), len(elements), len(elements))
+	}
+
+	for i, element := range elements {
+		// Elements can be provided either as JSON strings or as raw values, like numbers or nested arrays
+		elementArg := string(element)
+		var str string
+		if err := json.Unmarshal(element, &str); err == nil {
+			elementArg = str
+		}
+
+		elementValue, err := parseABIArg(*t.Elem, elementArg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "element %d", i)
+		}
+		value.Index(i).Set(reflect.ValueOf(elementValue))
+	}
+	return value.Interface(), nil
+}
+
+// formatOutputs converts decoded return values of method to values, which can be printed as JSON
+func formatOutputs(outputs abi.Arguments, values []interface{}) []CallOutput {
+	result := make([]CallOutput, len(values))
+	for i, value := range values {
+		result[i] = CallOutput{
+			Name:  outputs[i].Name,
+			Type:  outputs[i].Type.String(),
+			Value: formatABIValue(reflect.ValueOf(value)),
+		}
+	}
+	return result
+}
+
+func formatABIValue(value reflect.Value) interface{} {
+	switch v := value.Interface().(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	}
+
+	switch value.Kind() {
+	case reflect.Array:
+		// Fixed size byte arrays are printed as hex
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(bz), value)
+			return hexutil.Encode(bz)
+		}
+		fallthrough
+	case reflect.Slice:
+		elements := make([]interface{}, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elements[i] = formatABIValue(value.Index(i))
+		}
+		return elements
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10)
+	default:
+		return value.Interface()
+	}
+}
+
+// getNodePublicKey returns node public key of the epoch, which contains the latest block
+func getNodePublicKey(ctx context.Context, clientCtx client.Context, queryClient types.QueryClient) ([]byte, error) {
+	height, err := rpc.GetChainHeight(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := queryClient.NodePublicKey(ctx, &types.QueryNodePublicKey{BlockNumber: uint64(height)})
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Decode(res.NodePublicKey)
+}
+
+// encryptInput encrypts input of the call using node public key and provided encryption key
+func encryptInput(ctx context.Context, clientCtx client.Context, queryClient types.QueryClient, encryptionKey, data []byte) (encryptedData, nodePublicKey []byte, err error) {
+	nodePublicKey, err = getNodePublicKey(ctx, clientCtx, queryClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	encryptedData, err = deoxys.EncryptECDH(encryptionKey, nodePublicKey, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encryptedData, nodePublicKey, nil
+}
+
+// getValue returns value passed using `--value` flag
+func getValue(cmd *cobra.Command) (*big.Int, error) {
+	valueStr, err := cmd.Flags().GetString(FlagValue)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := new(big.Int).SetString(valueStr, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid value %s", valueStr)
+	}
+	return value, nil
+}
+
+// getKeyringSigner returns signer for key provided using `--from` flag
+func getKeyringSigner(clientCtx client.Context, from string) (*sgx.KeyringSigner, error) {
+	_, name, _, err := client.GetFromFields(clientCtx, clientCtx.Keyring, from)
+	if err != nil {
+		return nil, err
+	}
+	return sgx.NewKeyringSigner(clientCtx.Keyring, name)
+}
+
+// signCall signs call arguments, so SGXVM can recover `msg.sender` of the call
+func signCall(ctx context.Context, clientCtx client.Context, queryClient types.QueryClient, args *types.CallArgs, fromKey string) error {
+	signer, err := getKeyringSigner(clientCtx, fromKey)
+	if err != nil {
+		return err
+	}
+	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		return errors.Wrap(err, "chain id is required to sign the call")
+	}
+	account, err := queryClient.Account(ctx, &types.QueryAccountRequest{Address: signer.Address().Hex()})
+	if err != nil {
+		return err
+	}
+
+	// Signed transaction should be the same as transaction, which is constructed from call arguments by node
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    account.Nonce,
+		GasPrice: big.NewInt(0),
+		Gas:      config.DefaultGasCap,
+		To:       args.To,
+		Value:    args.Value.ToInt(),
+		Data:     *args.Data,
+	})
+	signedTx, err := signer.SignTx(tx, chainID)
+	if err != nil {
+		return err
+	}
+
+	v, r, s := signedTx.RawSignatureValues()
+	nonce := hexutil.Uint64(account.Nonce)
+	gas := hexutil.Uint64(config.DefaultGasCap)
+	from := signer.Address()
+	args.From = &from
+	args.Nonce = &nonce
+	args.Gas = &gas
+	args.GasPrice = (*hexutil.Big)(big.NewInt(0))
+	args.ChainID = (*hexutil.Big)(chainID)
+	args.V, args.R, args.S = (*hexutil.Big)(v), (*hexutil.Big)(r), (*hexutil.Big)(s)
+	return nil
+}
+
+// callContract executes encrypted call of the contract and returns decrypted result
+func callContract(cmd *cobra.Command, clientCtx client.Context, contract common.Address, data []byte) ([]byte, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	value, err := getValue(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptionKey, err := sgx.NewEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	encryptedData, nodePublicKey, err := encryptInput(cmd.Context(), clientCtx, queryClient, encryptionKey, data)
+	if err != nil {
+		return nil, err
+	}
+
+	args := types.CallArgs{
+		To:    &contract,
+		Value: (*hexutil.Big)(value),
+		Data:  (*hexutil.Bytes)(&encryptedData),
+	}
+	if from, _ := cmd.Flags().GetString(flags.FlagFrom); from != "" {
+		if err := signCall(cmd.Context(), clientCtx, queryClient, &args, from); err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	res, err := queryClient.EthCall(cmd.Context(), &types.EthCallRequest{
+		Args:   bz,
+		GasCap: config.DefaultGasCap,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret, decryptErr := sgx.DecryptData(encryptionKey, nodePublicKey, res.Ret)
+	if res.Failed() {
+		if strings.Contains(res.VmError, "reverted") && decryptErr == nil {
+			return nil, types.NewExecErrorWithReason(ret)
+		}
+		return nil, errors.New(res.VmError)
+	}
+	if decryptErr != nil {
+		return nil, errors.Wrap(decryptErr, "failed to decrypt result of the call")
+	}
+	return ret, nil
+}
+
+// estimateGas estimates gas required by transaction. Input of calls is encrypted using one-time key.
+func estimateGas(cmd *cobra.Command, clientCtx client.Context, from common.Address, to *common.Address, data []byte) (uint64, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	value, err := getValue(cmd)
+	if err != nil {
+		return 0, err
+	}
+
+	if to != nil && len(data) != 0 {
+		encryptionKey, err := sgx.NewEncryptionKey()
+		if err != nil {
+			return 0, err
+		}
+		if data, _, err = encryptInput(cmd.Context(), clientCtx, queryClient, encryptionKey, data); err != nil {
+			return 0, err
+		}
+	}
+
+	args := types.TransactionArgs{
+		From:  &from,
+		To:    to,
+		Value: (*hexutil.Big)(value),
+		Data:  (*hexutil.Bytes)(&data),
+	}
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := queryClient.EstimateGas(cmd.Context(), &types.EthCallRequest{
+		Args:   bz,
+		GasCap: config.DefaultGasCap,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.Gas, nil
+}
+
+// buildEthereumTx builds ethereum transaction, encrypts its input if it is a call and signs it using key from keyring
+func buildEthereumTx(cmd *cobra.Command, clientCtx client.Context, to *common.Address, data []byte) (*types.MsgHandleTx, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	signer, err := sgx.NewKeyringSigner(clientCtx.Keyring, clientCtx.GetFromName())
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := queryClient.Account(cmd.Context(), &types.QueryAccountRequest{Address: signer.Address().Hex()})
+	if err != nil {
+		return nil, err
+	}
+	value, err := getValue(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit, err := cmd.Flags().GetUint64(FlagGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	if gasLimit == 0 {
+		if gasLimit, err = estimateGas(cmd, clientCtx, signer.Address(), to, data); err != nil {
+			return nil, errors.Wrap(err, "failed to estimate gas")
+		}
+	}
+
+	gasFeeCap, gasTipCap, err := getFees(cmd, queryClient)
+	if err != nil {
+		return nil, err
+	}
+
+	// Input of calls is encrypted using key of signer, so it will be able to decrypt it later
+	if to != nil && len(data) != 0 {
+		encryptionKey, err := signer.EncryptionKey()
+		if err != nil {
+			return nil, err
+		}
+		if data, _, err = encryptInput(cmd.Context(), clientCtx, queryClient, encryptionKey, data); err != nil {
+			return nil, err
+		}
+	}
+
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     account.Nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       gasLimit,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	})
+	signedTx, err := signer.SignTx(tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.MsgHandleTx{}
+	if err := msg.FromEthereumTx(signedTx); err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// getFees returns fee cap and tip cap of transaction. If fee cap is not provided, it is set to doubled base fee.
+func getFees(cmd *cobra.Command, queryClient types.QueryClient) (*big.Int, *big.Int, error) {
+	gasTipCap, err := getBigIntFlag(cmd, FlagMaxPriorityFeePerGas)
+	if err != nil {
+		return nil, nil, err
+	}
+	if gasTipCap == nil {
+		gasTipCap = new(big.Int)
+	}
+	gasFeeCap, err := getBigIntFlag(cmd, FlagMaxFeePerGas)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if gasFeeCap == nil {
+		res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+		if err != nil {
+			return nil, nil, err
+		}
+		gasFeeCap = new(big.Int)
+		if res.BaseFee != nil {
+			gasFeeCap.Mul(res.BaseFee.BigInt(), big.NewInt(2))
+		}
+		gasFeeCap.Add(gasFeeCap, gasTipCap)
+	}
+
+	if gasFeeCap.Cmp(gasTipCap) < 0 {
+		return nil, nil, fmt.Errorf("max fee per gas %s is less than max priority fee per gas %s", gasFeeCap, gasTipCap)
+	}
+	return gasFeeCap, gasTipCap, nil
+}
+
+// getBigIntFlag returns value of integer flag. Nil is returned for empty flag.
+func getBigIntFlag(cmd *cobra.Command, flag string) (*big.Int, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	if str == "" {
+		return nil, nil
+	}
+	value, ok := new(big.Int).SetString(str, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid %s: %s", flag, str)
+	}
+	return value, nil
+}
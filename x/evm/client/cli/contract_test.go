@@ -0,0 +1,189 @@
+package cli
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+const testABI = `[
+	{"type":"constructor","inputs":[{"name":"name","type":"string"},{"name":"supply","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"info","stateMutability":"view","inputs":[],"outputs":[{"name":"owner","type":"address"},{"name":"decimals","type":"uint8"},{"name":"id","type":"bytes32"},{"name":"holders","type":"address[]"},{"name":"balance","type":"uint256"}]}
+]`
+
+func writeTestFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadABI(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		expErr  bool
+	}{
+		{"plain ABI", testABI, false},
+		{"artifact", `{"contractName":"Token","abi":` + testABI + `,"bytecode":"0x6080"}`, false},
+		{"invalid JSON", `{`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contractABI, err := loadABI(writeTestFile(t, "abi.json", tc.content))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, contractABI.Methods, "transfer")
+			require.Len(t, contractABI.Constructor.Inputs, 2)
+		})
+	}
+}
+
+func TestLoadBytecode(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		expErr  bool
+	}{
+		{"hex with prefix", "0x6080\n", false},
+		{"hex without prefix", "6080", false},
+		{"hardhat artifact", `{"abi":[],"bytecode":"0x6080"}`, false},
+		{"foundry artifact", `{"abi":[],"bytecode":{"object":"0x6080"}}`, false},
+		{"empty bytecode", `{"abi":[],"bytecode":"0x"}`, true},
+		{"invalid hex", "0xzz", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bytecode, err := loadBytecode(writeTestFile(t, "bytecode", tc.content))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []byte{0x60, 0x80}, bytecode)
+		})
+	}
+}
+
+func TestParseABIArg(t *testing.T) {
+	newType := func(typ string) abi.Type {
+		abiType, err := abi.NewType(typ, "", nil)
+		require.NoError(t, err)
+		return abiType
+	}
+	address := common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7")
+
+	testCases := []struct {
+		name     string
+		typ      string
+		arg      string
+		expValue interface{}
+		expErr   bool
+	}{
+		{"uint256", "uint256", "1000000000000000000000", new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1000)), false},
+		{"uint256 hex", "uint256", "0x10", big.NewInt(16), false},
+		{"uint8", "uint8", "255", uint8(255), false},
+		{"uint8 out of range", "uint8", "256", nil, true},
+		{"negative uint", "uint256", "-1", nil, true},
+		{"int64", "int64", "-5", int64(-5), false},
+		{"int8 lower bound", "int8", "-128", int8(-128), false},
+		{"int8 out of range", "int8", "128", nil, true},
+		{"invalid integer", "uint256", "abc", nil, true},
+		{"bool", "bool", "true", true, false},
+		{"string", "string", "hello", "hello", false},
+		{"hex address", "address", "0x3B98c72760f7BBa69D62ED6f48278451251948e7", address, false},
+		{"bech32 address", "address", "cosmos18wvvwfmq77a6d8tza4h5sfuy2yj3jj88yqg82a", address, false},
+		{"invalid address", "address", "0x123", nil, true},
+		{"bytes", "bytes", "0x0102", []byte{1, 2}, false},
+		{"bytes4", "bytes4", "0x01020304", [4]byte{1, 2, 3, 4}, false},
+		{"bytes4 with wrong length", "bytes4", "0x0102", nil, true},
+		{"uint256 array", "uint256[]", `[1, "2"]`, []*big.Int{big.NewInt(1), big.NewInt(2)}, false},
+		{"fixed address array", "address[2]", `["0x3B98c72760f7BBa69D62ED6f48278451251948e7","0x3B98c72760f7BBa69D62ED6f48278451251948e7"]`, [2]common.Address{address, address}, false},
+		{"fixed array with wrong length", "uint8[2]", `[1]`, nil, true},
+		{"nested array", "uint8[][]", `[[1,2],[3]]`, [][]uint8{{1, 2}, {3}}, false},
+		{"array is not JSON", "uint8[]", `1,2`, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := parseABIArg(newType(tc.typ), tc.arg)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expValue, value)
+		})
+	}
+}
+
+func TestPackCallAndFormatOutputs(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(testABI))
+	require.NoError(t, err)
+
+	_, _, err = packCall(contractABI, "unknown", nil)
+	require.ErrorContains(t, err, "not found")
+
+	_, _, err = packCall(contractABI, "transfer", []string{"0x3B98c72760f7BBa69D62ED6f48278451251948e7"})
+	require.ErrorContains(t, err, "expected 2 arguments")
+
+	method, data, err := packCall(contractABI, "transfer", []string{"0x3B98c72760f7BBa69D62ED6f48278451251948e7", "100"})
+	require.NoError(t, err)
+	require.Equal(t, method.ID, data[:4])
+
+	values, err := method.Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	require.Equal(t, []CallOutput{
+		{Name: "to", Type: "address", Value: "0x3B98c72760f7BBa69D62ED6f48278451251948e7"},
+		{Name: "amount", Type: "uint256", Value: "100"},
+	}, formatOutputs(method.Inputs, values))
+
+	info := contractABI.Methods["info"]
+	ret, err := info.Outputs.Pack(
+		common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7"),
+		uint8(18),
+		[32]byte{0x01},
+		[]common.Address{{0x02}},
+		big.NewInt(5),
+	)
+	require.NoError(t, err)
+	values, err = info.Outputs.Unpack(ret)
+	require.NoError(t, err)
+	require.Equal(t, []CallOutput{
+		{Name: "owner", Type: "address", Value: "0x3B98c72760f7BBa69D62ED6f48278451251948e7"},
+		{Name: "decimals", Type: "uint8", Value: "18"},
+		{Name: "id", Type: "bytes32", Value: hexutil.Encode(common.Hash{0x01}.Bytes())},
+		{Name: "holders", Type: "address[]", Value: []interface{}{common.Address{0x02}.Hex()}},
+		{Name: "balance", Type: "uint256", Value: "5"},
+	}, formatOutputs(info.Outputs, values))
+}
+
+func TestPackConstructor(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(testABI))
+	require.NoError(t, err)
+	bytecode := []byte{0x60, 0x80}
+
+	data, err := packConstructor(contractABI, bytecode, []string{"Token", "1000"})
+	require.NoError(t, err)
+	require.Equal(t, bytecode, data[:2])
+
+	values, err := contractABI.Constructor.Inputs.Unpack(data[2:])
+	require.NoError(t, err)
+	require.Equal(t, "Token", values[0])
+	require.Equal(t, big.NewInt(1000), values[1])
+
+	_, err = packConstructor(contractABI, bytecode, []string{"Token"})
+	require.Error(t, err)
+}
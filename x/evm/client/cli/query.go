@@ -16,6 +16,9 @@
 package cli
 
 import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -38,6 +41,9 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetEpochsCmd(),
+		GetCallCmd(),
+		GetEstimateGasCmd(),
 	)
 	return cmd
 }
@@ -146,3 +152,133 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetEpochsCmd queries the schedule of epochs
+func GetEpochsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epochs",
+		Short: "Get the schedule of epochs",
+		Long:  "Get epoch numbers, starting blocks and node public keys of epochs agreed by consensus.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Epochs(cmd.Context(), &types.QueryEpochsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCallCmd executes encrypted call of contract method and prints decoded result
+func GetCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call CONTRACT ABI_FILE METHOD [ARGS...]",
+		Short: "Call contract method without sending transaction",
+		Long: `Call contract method without sending transaction. Input of the call is encrypted using node public key
+of the current epoch and result is decrypted and decoded according to ABI of the method.
+ABI_FILE should contain contract ABI or Hardhat, Truffle or Foundry artifact. Array arguments are provided as JSON arrays.
+If key is provided using --from flag, the call is signed, so contract receives its address as msg.sender.`,
+		Example: `swisstronikd query evm call 0xd9145CCE52D386f254917e481eB44e9943F39138 ./ERC20.json balanceOf 0x5B38Da6a701c568545dCfcB03FcB875f56beddC4`,
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+			contractABI, err := loadABI(args[1])
+			if err != nil {
+				return err
+			}
+			method, data, err := packCall(contractABI, args[2], args[3:])
+			if err != nil {
+				return err
+			}
+
+			ret, err := callContract(cmd, clientCtx, common.HexToAddress(contract), data)
+			if err != nil {
+				return err
+			}
+
+			values, err := method.Outputs.Unpack(ret)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(formatOutputs(method.Outputs, values))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().String(FlagValue, "0", "Amount of native currency in the smallest denomination sent with the call")
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of private key, which signs the call")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEstimateGasCmd estimates gas required to execute contract method
+func GetEstimateGasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-gas FROM CONTRACT ABI_FILE METHOD [ARGS...]",
+		Short: "Estimate gas required to execute contract method",
+		Long: `Estimate gas required to execute contract method on behalf of FROM address.
+Input of the call is encrypted using node public key of the current epoch.`,
+		Example: `swisstronikd query evm estimate-gas 0x5B38Da6a701c568545dCfcB03FcB875f56beddC4 0xd9145CCE52D386f254917e481eB44e9943F39138 ./ERC20.json transfer 0xAb8483F64d9C6d1EcF9b849Ae677dD3315835cb2 100`,
+		Args:    cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+			contract, err := accountToHex(args[1])
+			if err != nil {
+				return err
+			}
+			contractABI, err := loadABI(args[2])
+			if err != nil {
+				return err
+			}
+			_, data, err := packCall(contractABI, args[3], args[4:])
+			if err != nil {
+				return err
+			}
+
+			to := common.HexToAddress(contract)
+			gas, err := estimateGas(cmd, clientCtx, common.HexToAddress(from), &to, data)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&types.EstimateGasResponse{Gas: gas})
+		},
+	}
+
+	cmd.Flags().String(FlagValue, "0", "Amount of native currency in the smallest denomination sent with the call")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
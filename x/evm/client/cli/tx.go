@@ -23,7 +23,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
@@ -40,7 +42,11 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewDeployCmd(),
+		NewSendCmd(),
+	)
 	return cmd
 }
 
@@ -70,57 +76,161 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			rsp, err := rpctypes.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+			return broadcastEthereumTx(cmd, clientCtx, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDeployCmd command deploys contract using key from keyring
+func NewDeployCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy BYTECODE_FILE [ABI_FILE [CONSTRUCTOR_ARGS...]]",
+		Short: "Deploy contract",
+		Long: `Deploy contract using ethereum transaction signed by eth_secp256k1 key from keyring.
+BYTECODE_FILE should contain hex encoded bytecode or Hardhat, Truffle or Foundry artifact. If contract constructor
+has arguments, ABI_FILE with contract ABI or artifact should be provided. Array arguments are provided as JSON arrays.
+Gas limit is estimated, unless it is provided using --gas-limit flag.`,
+		Example: `swisstronikd tx evm deploy ./ERC20.json ./ERC20.json "Test token" TKN --from mykey`,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+			data, err := loadBytecode(args[0])
 			if err != nil {
 				return err
 			}
-
-			if clientCtx.GenerateOnly {
-				json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+			if len(args) > 1 {
+				contractABI, err := loadABI(args[1])
 				if err != nil {
 					return err
 				}
+				if data, err = packConstructor(contractABI, data, args[2:]); err != nil {
+					return err
+				}
+			}
 
-				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			msg, err := buildEthereumTx(cmd, clientCtx, nil, data)
+			if err != nil {
+				return err
 			}
 
-			if !clientCtx.SkipConfirm {
-				out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
-				if err != nil {
-					return err
-				}
+			from := common.BytesToAddress(clientCtx.GetFromAddress())
+			_, _ = fmt.Fprintf(os.Stderr, "contract address: %s\n", crypto.CreateAddress(from, msg.AsTransaction().Nonce()).Hex())
 
-				_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+			return broadcastEthereumTx(cmd, clientCtx, msg)
+		},
+	}
 
-				buf := bufio.NewReader(os.Stdin)
-				ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+	addEthereumTxFlags(cmd)
+	return cmd
+}
 
-				if err != nil || !ok {
-					_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
-					return err
-				}
+// NewSendCmd command sends encrypted transaction, which calls contract method, using key from keyring
+func NewSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send CONTRACT ABI_FILE METHOD [ARGS...]",
+		Short: "Send transaction, which calls contract method",
+		Long: `Send ethereum transaction, which calls contract method, signed by eth_secp256k1 key from keyring.
+Input of the transaction is encrypted using node public key of the current epoch and key derived from the sender key,
+so the sender is able to decrypt it later. ABI_FILE should contain contract ABI or Hardhat, Truffle or Foundry artifact.
+Array arguments are provided as JSON arrays. Gas limit is estimated, unless it is provided using --gas-limit flag.`,
+		Example: `swisstronikd tx evm send 0xd9145CCE52D386f254917e481eB44e9943F39138 ./ERC20.json transfer 0xAb8483F64d9C6d1EcF9b849Ae677dD3315835cb2 100 --from mykey`,
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			contract, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+			contractABI, err := loadABI(args[1])
+			if err != nil {
+				return err
+			}
+			_, data, err := packCall(contractABI, args[2], args[3:])
 			if err != nil {
 				return err
 			}
 
-			// broadcast to a Tendermint node
-			res, err := clientCtx.BroadcastTx(txBytes)
+			to := common.HexToAddress(contract)
+			msg, err := buildEthereumTx(cmd, clientCtx, &to, data)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return broadcastEthereumTx(cmd, clientCtx, msg)
 		},
 	}
 
-	flags.AddTxFlagsToCmd(cmd)
+	addEthereumTxFlags(cmd)
 	return cmd
 }
+
+func addEthereumTxFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagValue, "0", "Amount of native currency in the smallest denomination sent with the transaction")
+	cmd.Flags().Uint64(FlagGasLimit, 0, "Gas limit of the transaction. If not provided, gas limit is estimated")
+	cmd.Flags().String(FlagMaxFeePerGas, "", "Max fee per gas. If not provided, doubled base fee is used")
+	cmd.Flags().String(FlagMaxPriorityFeePerGas, "0", "Max priority fee per gas")
+	flags.AddTxFlagsToCmd(cmd)
+}
+
+// broadcastEthereumTx wraps ethereum transaction into cosmos transaction and broadcasts it
+func broadcastEthereumTx(cmd *cobra.Command, clientCtx client.Context, msg *types.MsgHandleTx) error {
+	rsp, err := rpctypes.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.GenerateOnly {
+		json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+	}
+
+	if !clientCtx.SkipConfirm {
+		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+
+		buf := bufio.NewReader(os.Stdin)
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+
+		if err != nil || !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
+			return err
+		}
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return err
+	}
+
+	// broadcast to a Tendermint node
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
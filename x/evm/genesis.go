@@ -49,6 +49,8 @@ func InitGenesis(
 		panic("the EVM module account has not been set")
 	}
 
+	k.SetEpochs(ctx, data.Epochs)
+
 	for _, account := range data.Accounts {
 		address := common.HexToAddress(account.Address)
 		accAddress := sdk.AccAddress(address.Bytes())
@@ -113,5 +115,6 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 	return &types.GenesisState{
 		Accounts: ethGenAccounts,
 		Params:   k.GetParams(ctx),
+		Epochs:   k.GetEpochs(ctx),
 	}
 }
@@ -572,6 +572,7 @@ func (suite *EvmTestSuite) TestERC20TransferReverted() {
 			k.SetBalance(suite.ctx, suite.from, big.NewInt(1000000000000000))
 			contract := suite.deployERC20Contract()
 
+			k.CleanHooks()
 			k.SetHooks(tc.hooks)
 
 			data, err := types.ERC20Contract.ABI.Pack("transfer", suite.from, big.NewInt(10))
@@ -664,6 +665,7 @@ func (suite *EvmTestSuite) TestContractDeploymentRevert() {
 			k := suite.app.EvmKeeper
 
 			// test with different hooks scenarios
+			k.CleanHooks()
 			k.SetHooks(tc.hooks)
 
 			nonce := k.GetNonce(suite.ctx, suite.from)
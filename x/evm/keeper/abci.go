@@ -22,8 +22,15 @@ import (
 )
 
 // BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// It also halts the node if epochs of its enclave differ from epochs agreed by consensus,
+// since the node would not be able to decrypt transactions and state as other nodes do.
 func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	k.WithChainID(ctx)
+
+	if err := k.VerifyEnclaveEpochs(ctx); err != nil {
+		k.Logger(ctx).Error("enclave epochs do not match consensus, update enclave epochs using attestation server", "error", err.Error())
+		panic(err)
+	}
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
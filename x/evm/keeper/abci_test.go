@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+
 	"github.com/cometbft/cometbft/abci/types"
 	evmtypes "swisstronik/x/evm/types"
 )
@@ -16,3 +18,22 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 	suite.Require().Equal(1, len(em.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
 }
+
+func (suite *KeeperTestSuite) TestBeginBlockEpochs() {
+	ctx, _ := suite.ctx.CacheContext()
+	height := uint64(ctx.BlockHeight())
+
+	// epochs of reference executor match the genesis epoch using node public key
+	suite.app.EvmKeeper.SetEpochs(ctx, []evmtypes.Epoch{
+		{EpochNumber: 0, StartingBlock: 0, NodePublicKey: suite.nodePublicKey},
+		{EpochNumber: 1, StartingBlock: height + 10, NodePublicKey: bytes.Repeat([]byte{1}, evmtypes.NodePublicKeyLength)},
+	})
+	suite.Require().NotPanics(func() {
+		suite.app.EvmKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+	})
+
+	// node halts once an epoch unknown to its enclave starts
+	suite.Require().Panics(func() {
+		suite.app.EvmKeeper.BeginBlock(ctx.WithBlockHeight(int64(height+10)), types.RequestBeginBlock{})
+	})
+}
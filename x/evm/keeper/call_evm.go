@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"math/big"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"swisstronik/server/config"
+	"swisstronik/x/evm/types"
+)
+
+// CallEVMWithData executes unencrypted call of provided contract through SGXVM on behalf of `from` address.
+// It is used by other modules to interact with contracts, for example, to mint or transfer ERC20 tokens.
+// If commit is false, state changes are discarded. Nonce of `from` address is restored after the call,
+// since the call is a part of cosmos transaction, which already increased account sequence.
+// Gas used by SGXVM is consumed from the gas meter of provided context.
+func (k *Keeper) CallEVMWithData(
+	ctx sdk.Context,
+	from common.Address,
+	contract common.Address,
+	data []byte,
+	commit bool,
+) (*types.MsgEthereumTxResponse, error) {
+	cfg, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, k.eip155ChainID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	gasLimit := config.DefaultGasCap
+	if remaining := ctx.GasMeter().GasRemaining(); remaining < gasLimit {
+		gasLimit = remaining
+	}
+
+	nonce := k.GetNonce(ctx, from)
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		nonce,
+		big.NewInt(0),
+		gasLimit,
+		big.NewInt(0),
+		big.NewInt(0),
+		big.NewInt(0),
+		data,
+		ethtypes.AccessList{},
+		!commit,
+	)
+
+	txContext, err := CreateSGXVMContextFromMessage(ctx, k, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	txConfig := k.TxConfig(ctx, common.Hash{})
+	res, err := k.ApplyMessageWithConfig(ctx, msg, commit, cfg, txConfig, txContext, true, make([]byte, 65), ethtypes.LegacyTxType)
+	if err != nil {
+		return nil, err
+	}
+
+	// SGXVM increases nonce of the caller for committed calls, but module calls should not change it
+	if commit {
+		if err := k.SetNonce(ctx, from, nonce); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to restore nonce")
+		}
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm call")
+
+	if res.Failed() {
+		// SGXVM reports revert in the same way as it is checked by `eth_call`
+		if strings.Contains(res.VmError, "reverted") {
+			return nil, errorsmod.Wrap(types.ErrVMExecution, types.NewExecErrorWithReason(res.Ret).Error())
+		}
+		return nil, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+	}
+
+	return res, nil
+}
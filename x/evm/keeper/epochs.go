@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/SigmaGmbH/librustgo"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/evm/types"
+)
+
+// GetEpochs returns the schedule of epochs agreed by consensus ordered by epoch number
+func (k Keeper) GetEpochs(ctx sdk.Context) []types.Epoch {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpoch)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	epochs := []types.Epoch{}
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.Epoch
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+		epochs = append(epochs, epoch)
+	}
+	return epochs
+}
+
+// SetEpochs replaces the schedule of epochs
+func (k Keeper) SetEpochs(ctx sdk.Context, epochs []types.Epoch) {
+	store := ctx.KVStore(k.storeKey)
+	for _, epoch := range k.GetEpochs(ctx) {
+		store.Delete(types.EpochKey(epoch.EpochNumber))
+	}
+	for i := range epochs {
+		store.Set(types.EpochKey(epochs[i].EpochNumber), k.cdc.MustMarshal(&epochs[i]))
+	}
+}
+
+// GetEpochNodePublicKey returns node public key of the epoch, which the provided block belongs to,
+// according to the schedule of epochs agreed by consensus. Returns false if there is no such epoch.
+func (k Keeper) GetEpochNodePublicKey(ctx sdk.Context, blockNumber uint64) (common.Hash, bool) {
+	started := types.StartedEpochs(k.GetEpochs(ctx), blockNumber)
+	if len(started) == 0 {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(started[len(started)-1].NodePublicKey), true
+}
+
+// VerifyEnclaveEpochs checks that epochs of enclave match epochs agreed by consensus at current block.
+// Check is skipped until the schedule of epochs is set on-chain.
+func (k Keeper) VerifyEnclaveEpochs(ctx sdk.Context) error {
+	epochs := k.GetEpochs(ctx)
+	if len(epochs) == 0 {
+		return nil
+	}
+
+	enclaveEpochs, err := librustgo.ListEpochs()
+	if err != nil {
+		return err
+	}
+	return types.CompareEnclaveEpochs(epochs, enclaveEpochs, uint64(ctx.BlockHeight()))
+}
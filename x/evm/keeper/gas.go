@@ -36,7 +36,14 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	gas, err := core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+
+	// each EIP-7702 authorization is charged as creation of new account
+	authorizations := uint64(len(types.GetAuthorizationList(msg)))
+	return gas + authorizations*types.PerEmptyAccountCost, nil
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
@@ -88,3 +95,21 @@ func GasToRefund(availableRefund, gasConsumed, refundQuotient uint64) uint64 {
 	}
 	return refund
 }
+
+// GasUsedAfterRefund returns gas consumed by the transaction after applying the refund counter
+// reported by SGXVM. Refund is capped by the refund quotient of the gas used by the transaction.
+func GasUsedAfterRefund(gasLimit, gasUsed, refund, refundQuotient uint64) (uint64, error) {
+	if gasUsed > gasLimit {
+		return 0, errorsmod.Wrapf(types.ErrGasOverflow, "gas used %d exceeds gas limit %d", gasUsed, gasLimit)
+	}
+	return gasUsed - GasToRefund(refund, gasUsed, refundQuotient), nil
+}
+
+// RefundQuotient returns the refund quotient according to the chain rules at provided height.
+// Since London hard fork, refund is limited by a fifth part of used gas (EIP-3529).
+func RefundQuotient(cfg *params.ChainConfig, height *big.Int) uint64 {
+	if cfg.IsLondon(height) {
+		return params.RefundQuotientEIP3529
+	}
+	return params.RefundQuotient
+}
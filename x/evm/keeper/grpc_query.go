@@ -27,6 +27,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/SigmaGmbH/librustgo"
+	rustgotypes "github.com/SigmaGmbH/librustgo/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -34,6 +36,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	evmcommontypes "swisstronik/types"
@@ -161,6 +164,70 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	return nil, status.Error(codes.Unavailable, "Storage request was disabled, since storage is encrypted. Check docs at https://swisstronik.gitbook.io/swisstronik-docs/ for more information")
 }
 
+// StorageEncrypted implements the Query/StorageEncrypted gRPC method. Enclave checks that request
+// was signed by contract owner and returns storage value encrypted for the owner.
+func (k Keeper) StorageEncrypted(c context.Context, req *types.QueryStorageEncryptedRequest) (*types.QueryStorageEncryptedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmcommontypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := common.HexToAddress(req.Address)
+	key := common.HexToHash(req.Key)
+
+	// reject malformed requests before reaching the enclave
+	signer, err := types.RecoverStorageAtEncryptedSigner(address, key, req.UserPublicKey, req.Signature)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	acct := k.GetAccountWithoutBalance(ctx, address)
+	if acct == nil || !acct.IsContract() {
+		return nil, status.Errorf(codes.InvalidArgument, "account %s is not a contract", address.Hex())
+	}
+
+	// Signer, which is not a deployer of the contract, can be an owner only if the owner slot is set.
+	// Owner slot is encrypted, so its value is checked by enclave
+	isDeployer := crypto.CreateAddress(signer, req.DeploymentNonce) == address
+	if !isDeployer && len(k.GetState(ctx, address, common.Hash{})) == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not an owner of contract %s", signer.Hex(), address.Hex())
+	}
+
+	connector := Connector{
+		Context:   ctx,
+		EVMKeeper: &k,
+	}
+
+	res, err := librustgo.GetStorageAtEncrypted(
+		connector,
+		address.Bytes(),
+		key.Bytes(),
+		req.UserPublicKey,
+		req.Signature,
+		req.DeploymentNonce,
+		uint64(ctx.BlockHeight()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if len(res.EncryptedValue) == 0 {
+		return nil, status.Error(codes.Unavailable, "enclave returned empty storage value")
+	}
+
+	return &types.QueryStorageEncryptedResponse{
+		Value:         res.EncryptedValue,
+		NodePublicKey: res.NodePublicKey,
+	}, nil
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
@@ -225,7 +292,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
-	txType := args.ToTransaction().AsTransaction().Type()
+	txType := args.ToTransaction().TxType()
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -317,7 +384,7 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
-	txType := args.ToTransaction().AsTransaction().Type()
+	txType := args.ToTransaction().TxType()
 
 	// convert the tx args to an ethereum message
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -325,13 +392,16 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// authorizations of EIP-7702 message are kept when the message is updated
+	authorizationList := types.GetAuthorizationList(msg)
+
 	// NOTE: the errors from the executable below should be consistent with go-ethereum,
 	// so we don't wrap them with the gRPC status code
 
 	// Create a helper to check if a gas allowance results in an executable transaction
 	executable := func(gas uint64) (vmError bool, rsp *types.MsgEthereumTxResponse, err error) {
 		// update the message with the new gas value
-		msg = ethtypes.NewMessage(
+		ethMsg := ethtypes.NewMessage(
 			msg.From(),
 			msg.To(),
 			msg.Nonce(),
@@ -344,6 +414,10 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 			msg.AccessList(),
 			msg.IsFake(),
 		)
+		msg = ethMsg
+		if authorizationList != nil {
+			msg = types.NewSetCodeMessage(ethMsg, authorizationList)
+		}
 
 		txContext, err := CreateSGXVMContextFromMessage(ctx, &k, msg)
 		if err != nil {
@@ -389,18 +463,398 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements eth_createAccessList rpc api. Call is executed repeatedly with access list
+// collected by SGXVM during previous execution, until access list stops changing.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.CallArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// Sender, recipient and precompiles are always warm, so they are not included into access list
+	from := args.GetFrom()
+	to := crypto.CreateAddress(from, nonce)
+	if args.To != nil {
+		to = *args.To
+	}
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	excluded := map[common.Address]struct{}{from: {}, to: {}}
+	for _, precompile := range vm.DefaultActivePrecompiles(rules) {
+		excluded[precompile] = struct{}{}
+	}
+
+	accessList := ethtypes.AccessList{}
+	if args.AccessList != nil {
+		accessList, _ = types.MergeSGXVMAccessList(*args.AccessList, nil, excluded)
+	}
+
+	combinedSignature := make([]byte, 65)
+	if args.V != nil && args.S != nil && args.R != nil {
+		v, s, r := args.V.ToInt(), args.S.ToInt(), args.R.ToInt()
+		combinedSignature, err = CombineSignature(v, r, s, cfg.ChainConfig.ChainID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	traceOptions := &librustgo.TraceOptions{Tracer: rustgotypes.TracerType_TRACER_ACCESS_LIST}
+	for {
+		args.AccessList = &accessList
+		txType := args.ToTransaction().TxType()
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		txContext, err := CreateSGXVMContextFromMessage(ctx, &k, msg)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// pass false to not commit StateDB
+		res, trace, err := k.ApplyMessageWithTrace(ctx, msg, false, cfg, txConfig, txContext, req.Unencrypted, combinedSignature, txType, traceOptions)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if trace == nil {
+			return nil, status.Error(codes.Internal, "SGXVM enclave did not return access list")
+		}
+
+		var extended bool
+		accessList, extended = types.MergeSGXVMAccessList(accessList, trace.AccessList, excluded)
+		if !extended {
+			return &types.CreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+	}
+}
+
+// SimulateV1 implements eth_simulateV1 rpc api. All the blocks are simulated on top of the same cached
+// context, so each call observes state changes made by previous calls. Cached context is discarded afterwards.
+func (k Keeper) SimulateV1(c context.Context, req *types.QuerySimulateV1Request) (*types.QuerySimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var opts types.SimulateOptions
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	proposerAddress := GetProposerAddress(ctx, req.ProposerAddress)
+
+	gasRemaining := req.GasCap
+	blockNumber, blockTime := ctx.BlockHeight(), uint64(ctx.BlockTime().Unix())
+	results := make([]*types.SimulateBlockResult, 0, len(opts.BlockStateCalls))
+	for i, block := range opts.BlockStateCalls {
+		// Simulated blocks follow the current one, unless number and time are overridden
+		number, timestamp := blockNumber+1, blockTime+1
+		if overrides := block.BlockOverrides; overrides != nil {
+			if overrides.Number != nil {
+				number = overrides.Number.ToInt().Int64()
+			}
+			if overrides.Time != nil {
+				timestamp = uint64(*overrides.Time)
+			}
+		}
+		if number <= blockNumber || timestamp <= blockTime {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: block number and timestamp should be increasing", i)
+		}
+		blockNumber, blockTime = number, timestamp
+		ctx = ctx.WithBlockHeight(blockNumber).WithBlockTime(time.Unix(int64(blockTime), 0).UTC())
+
+		cfg, err := k.EVMConfig(ctx, proposerAddress, chainID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if block.BlockOverrides != nil && block.BlockOverrides.BaseFeePerGas != nil {
+			cfg.BaseFee = block.BlockOverrides.BaseFeePerGas.ToInt()
+		}
+
+		if err = k.applyStateOverrides(ctx, block.StateOverrides); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: %s", i, err)
+		}
+
+		result := types.NewSimulateBlockResult(uint64(blockNumber), blockTime, cfg.BaseFee)
+		txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+		for j, args := range block.Calls {
+			if req.GasCap != 0 && gasRemaining == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "block %d call %d: gas cap of simulation exhausted", i, j)
+			}
+
+			res, err := k.simulateCall(ctx, args, gasRemaining, cfg, txConfig, req.Unencrypted)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "block %d call %d: %s", i, j, err)
+			}
+
+			logs := types.LogsToEthereum(res.Logs)
+			result.Calls = append(result.Calls, types.NewSimulateCallResult(res, logs))
+			result.GasUsed += hexutil.Uint64(res.GasUsed)
+			if req.GasCap != 0 {
+				if res.GasUsed > gasRemaining {
+					gasRemaining = 0
+				} else {
+					gasRemaining -= res.GasUsed
+				}
+			}
+			txConfig.TxIndex++
+			txConfig.LogIndex += uint(len(logs))
+		}
+		results = append(results, result)
+	}
+
+	resultData, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySimulateV1Response{
+		Data: resultData,
+	}, nil
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
 func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*types.QueryTraceTxResponse, error) {
-	return nil, status.Error(codes.Internal, "traceTx is disabled")
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !k.traceNode && !req.Msg.Unencrypted {
+		return nil, status.Error(codes.PermissionDenied, types.ErrTracingNotAllowed.Error())
+	}
+
+	traceOptions, err := types.NewSGXVMTraceOptions(req.TraceConfig)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(req.BlockNumber).
+		WithBlockTime(req.BlockTime).
+		WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+
+	// replay predecessors to get correct state for traced transaction
+	for i, predecessor := range req.Predecessors {
+		txConfig.TxHash = predecessor.TxHash()
+		txConfig.TxIndex = uint(i)
+		rsp, _, err := k.replayTransaction(ctx, predecessor, signer, cfg, txConfig, nil)
+		if err != nil {
+			continue
+		}
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+
+	txConfig.TxHash = req.Msg.TxHash()
+	txConfig.TxIndex = uint(len(req.Predecessors))
+	rsp, trace, err := k.replayTransaction(ctx, req.Msg, signer, cfg, txConfig, traceOptions)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result, err := types.FormatExecutionTrace(traceOptions, trace, rsp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceTxResponse{
+		Data: resultData,
+	}, nil
 }
 
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment for all the transactions in the queried block.
 // The return value will be tracer dependent.
 func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest) (*types.QueryTraceBlockResponse, error) {
-	return nil, status.Error(codes.Internal, "traceBlock is disabled")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	traceOptions, err := types.NewSGXVMTraceOptions(req.TraceConfig)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(req.BlockNumber).
+		WithBlockTime(req.BlockTime).
+		WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+
+	results := make([]*types.TxTraceResult, 0, len(req.Txs))
+	for i, tx := range req.Txs {
+		txConfig.TxHash = tx.TxHash()
+		txConfig.TxIndex = uint(i)
+		result := &types.TxTraceResult{}
+
+		// Encrypted transactions are still replayed, since following transactions may depend on their state changes
+		options := traceOptions
+		if !k.traceNode && !tx.Unencrypted {
+			options = nil
+			result.Error = types.ErrTracingNotAllowed.Error()
+		}
+
+		rsp, trace, err := k.replayTransaction(ctx, tx, signer, cfg, txConfig, options)
+		if err != nil {
+			result.Error = err.Error()
+			results = append(results, result)
+			continue
+		}
+		txConfig.LogIndex += uint(len(rsp.Logs))
+
+		if options != nil {
+			if result.Result, err = types.FormatExecutionTrace(options, trace, rsp); err != nil {
+				result.Error = err.Error()
+			}
+		}
+		results = append(results, result)
+	}
+
+	resultData, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceBlockResponse{
+		Data: resultData,
+	}, nil
+}
+
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call in the provided environment. The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !k.traceNode && !req.Unencrypted {
+		return nil, status.Error(codes.PermissionDenied, types.ErrTracingNotAllowed.Error())
+	}
+
+	traceOptions, err := types.NewSGXVMTraceOptions(req.TraceConfig)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.CallArgs
+	if err = json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// ApplyMessageWithTrace expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	txType := args.ToTransaction().TxType()
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	txContext, err := CreateSGXVMContextFromMessage(ctx, &k, msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	combinedSignature := make([]byte, 65)
+	if args.V != nil && args.S != nil && args.R != nil {
+		v, s, r := args.V.ToInt(), args.S.ToInt(), args.R.ToInt()
+		combinedSignature, err = CombineSignature(v, r, s, cfg.ChainConfig.ChainID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	// pass false to not commit StateDB
+	rsp, trace, err := k.ApplyMessageWithTrace(ctx, msg, false, cfg, txConfig, txContext, req.Unencrypted, combinedSignature, txType, traceOptions)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result, err := types.FormatExecutionTrace(traceOptions, trace, rsp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
 }
 
 // BaseFee implements the Query/BaseFee gRPC method
@@ -420,11 +874,23 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// Epochs implements the Query/Epochs gRPC method
+func (k Keeper) Epochs(c context.Context, _ *types.QueryEpochsRequest) (*types.QueryEpochsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEpochsResponse{Epochs: k.GetEpochs(ctx)}, nil
+}
+
 // NodePublicKey implements the Query/NodePublicKey gRPC method
-func (k Keeper) NodePublicKey(ctx context.Context, req *types.QueryNodePublicKey) (*types.QueryNodePublicKeyResponse, error) {
-	nodePublicKey, err := k.GetNodePublicKey(req.BlockNumber)
-	if err != nil {
-		return nil, err
+func (k Keeper) NodePublicKey(c context.Context, req *types.QueryNodePublicKey) (*types.QueryNodePublicKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// schedule of epochs agreed by consensus takes precedence over epochs of local enclave
+	nodePublicKey, found := k.GetEpochNodePublicKey(ctx, req.BlockNumber)
+	if !found {
+		var err error
+		if nodePublicKey, err = k.GetNodePublicKey(req.BlockNumber); err != nil {
+			return nil, err
+		}
 	}
 
 	res := &types.QueryNodePublicKeyResponse{NodePublicKey: nodePublicKey.Hex()}
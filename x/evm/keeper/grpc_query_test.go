@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 	"swisstronik/crypto/deoxys"
 
 	sdkmath "cosmossdk.io/math"
@@ -12,7 +13,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -286,6 +289,72 @@ func (suite *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryStorageEncrypted() {
+	suite.SetupSGXVMTest()
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, suite.address, big.NewInt(10000000)))
+	chainID := suite.app.EvmKeeper.ChainID()
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+
+	handleTx := func(to *common.Address, data []byte) {
+		ethTx := ethtypes.NewTx(&ethtypes.LegacyTx{
+			Nonce:    suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+			GasPrice: big.NewInt(0),
+			Gas:      200000,
+			To:       to,
+			Value:    big.NewInt(0),
+			Data:     data,
+		})
+		msg := &types.MsgHandleTx{}
+		suite.Require().NoError(msg.FromEthereumTx(ethTx))
+		msg.Unencrypted = true
+		msg.From = suite.address.Hex()
+		suite.Require().NoError(msg.Sign(ethSigner, suite.signer))
+		res, err := suite.app.EvmKeeper.HandleTx(suite.ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+	}
+
+	// deploy contract, which increments slot 0 on each call, and call it once
+	deploymentNonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	handleTx(nil, hexutil.MustDecode("0x6012600c60003960126000f36000546001018060005560005260206000f3"))
+	contract := crypto.CreateAddress(suite.address, deploymentNonce)
+	handleTx(&contract, nil)
+
+	userPrivateKey := [32]byte{1}
+	userPublicKey := deoxys.GetCurve25519PublicKey(userPrivateKey)
+	request := func(key []byte, nonce uint64) *types.QueryStorageEncryptedRequest {
+		ownerKey, err := crypto.ToECDSA(key)
+		suite.Require().NoError(err)
+		signature, err := types.SignStorageAtEncrypted(contract, common.Hash{}, userPublicKey[:], func(hash []byte) ([]byte, error) {
+			return crypto.Sign(hash, ownerKey)
+		})
+		suite.Require().NoError(err)
+		return &types.QueryStorageEncryptedRequest{
+			Address:         contract.Hex(),
+			Key:             common.Hash{}.Hex(),
+			UserPublicKey:   userPublicKey[:],
+			Signature:       signature,
+			DeploymentNonce: nonce,
+		}
+	}
+
+	// deployer reads the slot, which is encrypted for provided user key
+	res, err := suite.queryClient.StorageEncrypted(suite.ctx, request(suite.privateKey, deploymentNonce))
+	suite.Require().NoError(err)
+	value, err := deoxys.DecryptECDH(userPrivateKey[:], res.NodePublicKey, res.Value)
+	suite.Require().NoError(err)
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)).Bytes(), value)
+
+	// wrong deployment nonce does not prove ownership and owner slot contains another address
+	_, err = suite.queryClient.StorageEncrypted(suite.ctx, request(suite.privateKey, deploymentNonce+1))
+	suite.Require().Error(err)
+
+	// another account is not an owner of the contract
+	_, stranger := tests.RandomEthAddressWithPrivateKey()
+	_, err = suite.queryClient.StorageEncrypted(suite.ctx, request(stranger.Bytes(), deploymentNonce))
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -948,3 +1017,216 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTraceTx() {
+	suite.SetupSGXVMTest()
+
+	// contract sets slot 0 to 1 if it is empty, otherwise clears it
+	contract := tests.RandomEthAddress()
+	code := hexutil.MustDecode("0x60005415600d576000600055005b600160005500")
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, code))
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, suite.address, big.NewInt(10000000)))
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	ethTx := ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		GasPrice: big.NewInt(10),
+		Gas:      100000,
+		To:       &contract,
+		Value:    big.NewInt(0),
+	})
+	msg := &types.MsgHandleTx{}
+	suite.Require().NoError(msg.FromEthereumTx(ethTx))
+	msg.Unencrypted = true
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	// each trace is executed on top of the same state
+	traceTx := func(traceConfig *types.TraceConfig) []byte {
+		cacheCtx, _ := suite.ctx.CacheContext()
+		res, err := suite.app.EvmKeeper.TraceTx(sdk.WrapSDKContext(cacheCtx), &types.QueryTraceTxRequest{
+			Msg:         msg,
+			TraceConfig: traceConfig,
+			BlockNumber: suite.ctx.BlockHeight(),
+			BlockTime:   suite.ctx.BlockTime(),
+			ChainId:     chainID.Int64(),
+		})
+		suite.Require().NoError(err)
+		return res.Data
+	}
+
+	// struct logger is used by default
+	var result logger.ExecutionResult
+	suite.Require().NoError(json.Unmarshal(traceTx(nil), &result))
+	suite.Require().False(result.Failed)
+	suite.Require().Equal(uint64(43126), result.Gas)
+
+	ops := make([]string, len(result.StructLogs))
+	for i, log := range result.StructLogs {
+		ops[i] = log.Op
+	}
+	suite.Require().Equal([]string{
+		"PUSH1", "SLOAD", "ISZERO", "PUSH1", "JUMPI", "JUMPDEST", "PUSH1", "PUSH1", "SSTORE", "STOP",
+	}, ops)
+
+	// regular node does not expose contract state
+	sstore := result.StructLogs[8]
+	suite.Require().Empty(sstore.Stack)
+	suite.Require().Empty(sstore.Storage)
+
+	suite.app.EvmKeeper.SetTraceNode(true)
+	defer suite.app.EvmKeeper.SetTraceNode(false)
+	var traceNodeResult logger.ExecutionResult
+	suite.Require().NoError(json.Unmarshal(traceTx(nil), &traceNodeResult))
+	sstore = traceNodeResult.StructLogs[8]
+	suite.Require().Equal([]string{"0x1", "0x0"}, *sstore.Stack)
+	suite.Require().Equal(
+		fmt.Sprintf("%x", common.BigToHash(big.NewInt(1))),
+		(*sstore.Storage)[fmt.Sprintf("%x", common.Hash{})],
+	)
+
+	// call tracer
+	var frame types.CallFrame
+	suite.Require().NoError(json.Unmarshal(traceTx(&types.TraceConfig{Tracer: types.TracerCall}), &frame))
+	suite.Require().Equal("CALL", frame.Type)
+	suite.Require().Equal(strings.ToLower(suite.address.Hex()), frame.From)
+	suite.Require().Equal(strings.ToLower(contract.Hex()), frame.To)
+	suite.Require().Equal("0x0", frame.Value)
+	suite.Require().Empty(frame.Error)
+	suite.Require().Empty(frame.Calls)
+
+	// tracing of tx does not change the state
+	suite.Require().Equal(common.Hash{}, common.BytesToHash(suite.app.EvmKeeper.GetState(suite.ctx, contract, common.Hash{})))
+}
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	suite.SetupSGXVMTest()
+
+	// contract returns 32 bytes of calldata multiplied by two
+	contract := tests.RandomEthAddress()
+	code := hexutil.MustDecode("0x60003560020260005260206000f3")
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, code))
+
+	input := hexutil.Bytes(common.BigToHash(big.NewInt(21)).Bytes())
+	args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contract, Data: &input})
+	suite.Require().NoError(err)
+
+	var frame types.CallFrame
+	res, err := suite.queryClient.TraceCall(suite.ctx, &types.QueryTraceCallRequest{
+		Args:        args,
+		GasCap:      config.DefaultGasCap,
+		ChainId:     suite.app.EvmKeeper.ChainID().Int64(),
+		TraceConfig: &types.TraceConfig{Tracer: types.TracerCall},
+		Unencrypted: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(json.Unmarshal(res.Data, &frame))
+	suite.Require().Equal("CALL", frame.Type)
+	suite.Require().Equal("0x"+common.Bytes2Hex(input), frame.Input)
+	suite.Require().Equal(hexutil.Encode(common.BigToHash(big.NewInt(42)).Bytes()), frame.Output)
+
+	// encrypted calls cannot be traced by regular node
+	_, err = suite.queryClient.TraceCall(suite.ctx, &types.QueryTraceCallRequest{
+		Args:        args,
+		GasCap:      config.DefaultGasCap,
+		ChainId:     suite.app.EvmKeeper.ChainID().Int64(),
+		TraceConfig: &types.TraceConfig{Tracer: types.TracerCall},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	suite.SetupSGXVMTest()
+
+	// contract sets slot 0 to 1 if it is empty, otherwise clears it
+	counter := tests.RandomEthAddress()
+	code := hexutil.MustDecode("0x60005415600d576000600055005b600160005500")
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, counter, code))
+
+	// proxy calls counter contract
+	proxy := tests.RandomEthAddress()
+	code = append(hexutil.MustDecode("0x6020600060006000600073"), counter.Bytes()...)
+	code = append(code, hexutil.MustDecode("0x5af15060206000f3")...)
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, proxy, code))
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &proxy})
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.CreateAccessList(suite.ctx, &types.EthCallRequest{
+		Args:        args,
+		GasCap:      config.DefaultGasCap,
+		ChainId:     suite.app.EvmKeeper.ChainID().Int64(),
+		Unencrypted: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+
+	// sender and recipient are always warm, so only called contract and its slot are included
+	suite.Require().Equal([]types.AccessTuple{{
+		Address:     counter.Hex(),
+		StorageKeys: []string{common.Hash{}.Hex()},
+	}}, res.AccessList)
+
+	// provided access list is kept and not extended
+	accessList := ethtypes.AccessList{{Address: counter, StorageKeys: []common.Hash{{}}}}
+	args, err = json.Marshal(&types.TransactionArgs{From: &suite.address, To: &proxy, AccessList: &accessList})
+	suite.Require().NoError(err)
+	resWithList, err := suite.queryClient.CreateAccessList(suite.ctx, &types.EthCallRequest{
+		Args:        args,
+		GasCap:      config.DefaultGasCap,
+		ChainId:     suite.app.EvmKeeper.ChainID().Int64(),
+		Unencrypted: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res, resWithList)
+}
+
+func (suite *KeeperTestSuite) TestSimulateV1CodeOverride() {
+	suite.SetupSGXVMTest()
+
+	// contract returns value of storage slot 0
+	reader := hexutil.Bytes(hexutil.MustDecode("0x60005460005260206000f3"))
+
+	contract := tests.RandomEthAddress()
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, []byte{0x00}))
+
+	withStorage := tests.RandomEthAddress()
+	suite.app.EvmKeeper.SetState(suite.ctx, withStorage, common.Hash{}, common.Hash{1}.Bytes())
+
+	delegation := hexutil.Bytes(types.AddressToDelegation(contract))
+
+	testCases := []struct {
+		name    string
+		address common.Address
+		code    hexutil.Bytes
+		expPass bool
+	}{
+		{"override code of new account", tests.RandomEthAddress(), reader, true},
+		{"override code of existing contract", contract, reader, false},
+		{"override code of account with storage", withStorage, reader, false},
+		{"delegate new account", tests.RandomEthAddress(), delegation, true},
+		{"delegate existing account", suite.address, delegation, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			code := tc.code
+			opts, err := json.Marshal(types.SimulateOptions{BlockStateCalls: []types.SimulateBlock{{
+				StateOverrides: types.StateOverride{tc.address: {Code: &code}},
+				Calls:          []types.CallArgs{{From: &suite.address, To: &tc.address}},
+			}}})
+			suite.Require().NoError(err)
+
+			_, err = suite.queryClient.SimulateV1(suite.ctx, &types.QuerySimulateV1Request{
+				Opts:        opts,
+				GasCap:      config.DefaultGasCap,
+				ChainId:     suite.app.EvmKeeper.ChainID().Int64(),
+				Unencrypted: true,
+			})
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, "cannot override code")
+			}
+		})
+	}
+}
@@ -78,6 +78,9 @@ type Keeper struct {
 
 	// list of epoch data which includes epoch number, starting block and relevant node public key
 	epochs []*rustgotypes.EpochData
+
+	// defines if encrypted transactions can be traced by this node
+	traceNode bool
 }
 
 // NewKeeper generates new evm module keeper
@@ -261,6 +264,24 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// CleanHooks resets the hooks for the EVM module
+// NOTE: Should only be used for testing purposes
+func (k *Keeper) CleanHooks() *Keeper {
+	k.hooks = nil
+	return k
+}
+
+// SetTraceNode sets if encrypted transactions can be traced by this node
+func (k *Keeper) SetTraceNode(traceNode bool) *Keeper {
+	k.traceNode = traceNode
+	return k
+}
+
+// IsTraceNode returns true if encrypted transactions can be traced by this node
+func (k Keeper) IsTraceNode() bool {
+	return k.traceNode
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -48,3 +48,24 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateEpochs implements the gRPC MsgServer interface. When an UpdateEpochs
+// proposal passes, it replaces the schedule of epochs. Epochs, which already
+// started, should be kept unchanged.
+func (k *Keeper) UpdateEpochs(goCtx context.Context, req *types.MsgUpdateEpochs) (*types.MsgUpdateEpochsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := types.ValidateEpochs(req.Epochs); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateEpochsUpdate(k.GetEpochs(ctx), req.Epochs, uint64(ctx.BlockHeight())); err != nil {
+		return nil, err
+	}
+
+	k.SetEpochs(ctx, req.Epochs)
+
+	return &types.MsgUpdateEpochsResponse{}, nil
+}
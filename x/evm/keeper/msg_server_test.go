@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"math/big"
@@ -140,3 +142,71 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateEpochs() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	height := uint64(suite.ctx.BlockHeight())
+	otherKey := bytes.Repeat([]byte{1}, types.NodePublicKeyLength)
+
+	genesisEpoch := types.Epoch{EpochNumber: 0, StartingBlock: 0, NodePublicKey: suite.nodePublicKey}
+	scheduledEpoch := types.Epoch{EpochNumber: 1, StartingBlock: height + 10, NodePublicKey: otherKey}
+	suite.app.EvmKeeper.SetEpochs(suite.ctx, []types.Epoch{genesisEpoch, scheduledEpoch})
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateEpochs
+		expErr error
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateEpochs{Authority: "foobar", Epochs: []types.Epoch{genesisEpoch}},
+			govtypes.ErrInvalidSigner,
+		},
+		{
+			"fail - started epoch is removed",
+			&types.MsgUpdateEpochs{Authority: authority, Epochs: []types.Epoch{}},
+			types.ErrInvalidEpochs,
+		},
+		{
+			"fail - started epoch is changed",
+			&types.MsgUpdateEpochs{Authority: authority, Epochs: []types.Epoch{{EpochNumber: 0, StartingBlock: 0, NodePublicKey: otherKey}}},
+			types.ErrInvalidEpochs,
+		},
+		{
+			"fail - new epoch starts in the past",
+			&types.MsgUpdateEpochs{Authority: authority, Epochs: []types.Epoch{genesisEpoch, {EpochNumber: 1, StartingBlock: height, NodePublicKey: otherKey}}},
+			types.ErrInvalidEpochs,
+		},
+		{
+			"fail - invalid node public key",
+			&types.MsgUpdateEpochs{Authority: authority, Epochs: []types.Epoch{genesisEpoch, {EpochNumber: 1, StartingBlock: height + 1, NodePublicKey: []byte{1}}}},
+			types.ErrInvalidEpochs,
+		},
+		{
+			"pass - scheduled epoch is postponed",
+			&types.MsgUpdateEpochs{Authority: authority, Epochs: []types.Epoch{genesisEpoch, {EpochNumber: 1, StartingBlock: height + 20, NodePublicKey: otherKey}}},
+			nil,
+		},
+		{
+			"pass - scheduled epoch is cancelled",
+			&types.MsgUpdateEpochs{Authority: authority, Epochs: []types.Epoch{genesisEpoch}},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			epochsBefore := suite.app.EvmKeeper.GetEpochs(ctx)
+
+			_, err := suite.app.EvmKeeper.UpdateEpochs(ctx, tc.msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(epochsBefore, suite.app.EvmKeeper.GetEpochs(ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.msg.Epochs, suite.app.EvmKeeper.GetEpochs(ctx))
+		})
+	}
+}
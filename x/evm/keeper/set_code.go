@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/SigmaGmbH/librustgo"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"swisstronik/x/evm/types"
+)
+
+// GetDelegation returns address, to which execution of provided account was
+// delegated using EIP-7702 authorization
+func (k *Keeper) GetDelegation(ctx sdk.Context, addr common.Address) (common.Address, bool, error) {
+	code, err := k.GetAccountCode(ctx, addr)
+	if err != nil {
+		return common.Address{}, false, err
+	}
+	target, ok := types.ParseDelegation(code)
+	return target, ok, nil
+}
+
+// SetDelegation sets code of provided account to the delegation designator of
+// target address. Delegation to the zero address clears the code of the account.
+func (k *Keeper) SetDelegation(ctx sdk.Context, addr, target common.Address) error {
+	if target == (common.Address{}) {
+		return k.SetAccountCode(ctx, addr, nil)
+	}
+	return k.SetAccountCode(ctx, addr, types.AddressToDelegation(target))
+}
+
+// ApplySetCodeAuthorization verifies provided EIP-7702 authorization against
+// the current state and sets delegation of the authority account. It returns
+// address of the authority. Invalid authorizations do not change the state and
+// should be skipped by the caller.
+func (k *Keeper) ApplySetCodeAuthorization(ctx sdk.Context, chainID *big.Int, auth types.SetCodeAuthorization) (common.Address, error) {
+	if err := auth.Validate(); err != nil {
+		return common.Address{}, err
+	}
+
+	authChainID := auth.ChainID.ToInt()
+	if authChainID.Sign() != 0 && authChainID.Cmp(chainID) != 0 {
+		return common.Address{}, errorsmod.Wrapf(types.ErrInvalidAuthorization, "chain id mismatch, expected %s, got %s", chainID, authChainID)
+	}
+
+	authority, err := auth.Authority()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	// only accounts without code or with existing delegation can be delegated
+	code, err := k.GetAccountCode(ctx, authority)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if _, delegated := types.ParseDelegation(code); len(code) != 0 && !delegated {
+		return common.Address{}, errorsmod.Wrapf(types.ErrInvalidAuthorization, "authority %s has non-delegation code", authority)
+	}
+
+	nonce := k.GetNonce(ctx, authority)
+	if nonce != uint64(auth.Nonce) {
+		return common.Address{}, errorsmod.Wrapf(types.ErrInvalidAuthorization, "nonce mismatch, expected %d, got %d", nonce, auth.Nonce)
+	}
+
+	if err := k.SetNonce(ctx, authority, nonce+1); err != nil {
+		return common.Address{}, err
+	}
+	if err := k.SetDelegation(ctx, authority, auth.Address); err != nil {
+		return common.Address{}, err
+	}
+
+	return authority, nil
+}
+
+// ApplySetCodeAuthorizations applies authorizations of EIP-7702 message before its
+// execution. Invalid authorizations are skipped. It returns authorization list in the
+// format expected by SGXVM and gas, which should be refunded for authorities, which
+// already existed.
+func (k *Keeper) ApplySetCodeAuthorizations(
+	ctx sdk.Context,
+	chainID *big.Int,
+	auths []types.SetCodeAuthorization,
+) ([]*librustgo.AuthorizationListItem, uint64, error) {
+	var (
+		authorizationList []*librustgo.AuthorizationListItem
+		refund            uint64
+	)
+
+	for _, auth := range auths {
+		authorizationList = append(authorizationList, &librustgo.AuthorizationListItem{
+			ChainId: bigBytes(auth.ChainID),
+			Address: auth.Address.Bytes(),
+			Nonce:   uint64(auth.Nonce),
+			YParity: uint32(auth.V),
+			R:       bigBytes(auth.R),
+			S:       bigBytes(auth.S),
+		})
+
+		authority, err := auth.Authority()
+		if err != nil {
+			continue
+		}
+		existed := k.GetAccountWithoutBalance(ctx, authority) != nil
+
+		if _, err := k.ApplySetCodeAuthorization(ctx, chainID, auth); err != nil {
+			if errorsmod.IsOf(err, types.ErrInvalidAuthorization) {
+				continue
+			}
+			return nil, 0, err
+		}
+
+		if existed {
+			refund += types.PerEmptyAccountCost - types.PerAuthBaseCost
+		}
+	}
+
+	return authorizationList, refund, nil
+}
+
+// bigBytes returns big endian representation of provided value or nil if value is not set
+func bigBytes(value *hexutil.Big) []byte {
+	if value == nil {
+		return nil
+	}
+	return value.ToInt().Bytes()
+}
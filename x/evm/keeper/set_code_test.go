@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"swisstronik/tests"
+	evmtypes "swisstronik/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestSetDelegation() {
+	suite.SetupTest()
+
+	addr := tests.RandomEthAddress()
+	target := tests.RandomEthAddress()
+
+	_, ok, err := suite.app.EvmKeeper.GetDelegation(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().False(ok)
+
+	suite.Require().NoError(suite.app.EvmKeeper.SetDelegation(suite.ctx, addr, target))
+	delegation, ok, err := suite.app.EvmKeeper.GetDelegation(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().True(ok)
+	suite.Require().Equal(target, delegation)
+
+	code, err := suite.app.EvmKeeper.GetAccountCode(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(evmtypes.AddressToDelegation(target), code)
+
+	// delegation to zero address clears the code
+	suite.Require().NoError(suite.app.EvmKeeper.SetDelegation(suite.ctx, addr, common.Address{}))
+	_, ok, err = suite.app.EvmKeeper.GetDelegation(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().False(ok)
+
+	acct := suite.app.EvmKeeper.GetAccountWithoutBalance(suite.ctx, addr)
+	suite.Require().NotNil(acct)
+	suite.Require().False(acct.IsContract())
+}
+
+func (suite *KeeperTestSuite) TestApplySetCodeAuthorization() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	authority := crypto.PubkeyToAddress(key.PublicKey)
+	target := tests.RandomEthAddress()
+	chainID := suite.app.EvmKeeper.ChainID()
+
+	sign := func(chainID *big.Int, nonce uint64, address common.Address) evmtypes.SetCodeAuthorization {
+		auth, err := evmtypes.SignSetCodeAuthorization(evmtypes.SetCodeAuthorization{
+			ChainID: (*hexutil.Big)(chainID),
+			Address: address,
+			Nonce:   hexutil.Uint64(nonce),
+		}, key)
+		suite.Require().NoError(err)
+		return auth
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func() evmtypes.SetCodeAuthorization
+		expPass  bool
+		expNonce uint64
+		expCode  []byte
+	}{
+		{
+			"success - authorization for current chain",
+			func() evmtypes.SetCodeAuthorization { return sign(chainID, 0, target) },
+			true,
+			1,
+			evmtypes.AddressToDelegation(target),
+		},
+		{
+			"success - authorization for any chain",
+			func() evmtypes.SetCodeAuthorization { return sign(big.NewInt(0), 0, target) },
+			true,
+			1,
+			evmtypes.AddressToDelegation(target),
+		},
+		{
+			"success - existing delegation is replaced",
+			func() evmtypes.SetCodeAuthorization {
+				suite.Require().NoError(suite.app.EvmKeeper.SetDelegation(suite.ctx, authority, tests.RandomEthAddress()))
+				return sign(chainID, 0, target)
+			},
+			true,
+			1,
+			evmtypes.AddressToDelegation(target),
+		},
+		{
+			"success - delegation is cleared",
+			func() evmtypes.SetCodeAuthorization {
+				suite.Require().NoError(suite.app.EvmKeeper.SetDelegation(suite.ctx, authority, target))
+				return sign(chainID, 0, common.Address{})
+			},
+			true,
+			1,
+			nil,
+		},
+		{
+			"fail - chain id mismatch",
+			func() evmtypes.SetCodeAuthorization { return sign(big.NewInt(1), 0, target) },
+			false,
+			0,
+			nil,
+		},
+		{
+			"fail - nonce mismatch",
+			func() evmtypes.SetCodeAuthorization { return sign(chainID, 1, target) },
+			false,
+			0,
+			nil,
+		},
+		{
+			"fail - authority has contract code",
+			func() evmtypes.SetCodeAuthorization {
+				suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, authority, []byte{0x60, 0x80}))
+				return sign(chainID, 0, target)
+			},
+			false,
+			0,
+			[]byte{0x60, 0x80},
+		},
+		{
+			"fail - invalid signature",
+			func() evmtypes.SetCodeAuthorization {
+				auth := sign(chainID, 0, target)
+				auth.V = 2
+				return auth
+			},
+			false,
+			0,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			auth := tc.malleate()
+
+			recovered, err := suite.app.EvmKeeper.ApplySetCodeAuthorization(suite.ctx, chainID, auth)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(authority, recovered)
+			} else {
+				suite.Require().ErrorIs(err, evmtypes.ErrInvalidAuthorization)
+			}
+
+			suite.Require().Equal(tc.expNonce, suite.app.EvmKeeper.GetNonce(suite.ctx, authority))
+			code, err := suite.app.EvmKeeper.GetAccountCode(suite.ctx, authority)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expCode, code)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleSetCodeTx() {
+	suite.SetupTest()
+
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	authority := crypto.PubkeyToAddress(key.PublicKey)
+	chainID := suite.app.EvmKeeper.ChainID()
+
+	// contract increments storage slot 0 on each call and returns new value
+	target := tests.RandomEthAddress()
+	counterCode := hexutil.MustDecode("0x6000546001018060005560005260206000f3")
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, target, counterCode))
+
+	validAuth, err := evmtypes.SignSetCodeAuthorization(evmtypes.SetCodeAuthorization{
+		ChainID: (*hexutil.Big)(chainID),
+		Address: target,
+		Nonce:   0,
+	}, key)
+	suite.Require().NoError(err)
+
+	// authorization with wrong nonce is skipped
+	invalidAuth, err := evmtypes.SignSetCodeAuthorization(evmtypes.SetCodeAuthorization{
+		ChainID: (*hexutil.Big)(chainID),
+		Address: tests.RandomEthAddress(),
+		Nonce:   5,
+	}, key)
+	suite.Require().NoError(err)
+
+	nonce := hexutil.Uint64(suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+	gas := hexutil.Uint64(200000)
+	args := evmtypes.TransactionArgs{
+		From:                 &suite.address,
+		To:                   &authority,
+		Gas:                  &gas,
+		Nonce:                &nonce,
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(0)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(0)),
+		ChainID:              (*hexutil.Big)(chainID),
+		AuthorizationList:    []evmtypes.SetCodeAuthorization{validAuth, invalidAuth},
+	}
+
+	msg := args.ToTransaction()
+	msg.Unencrypted = true
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	rsp, err := suite.app.EvmKeeper.HandleTx(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.VmError)
+	suite.Require().Equal(msg.TxHash().Hex(), rsp.Hash)
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)).Bytes(), rsp.Ret)
+	// intrinsic gas includes both authorizations
+	suite.Require().Greater(rsp.GasUsed, uint64(21000+2*evmtypes.PerEmptyAccountCost))
+
+	// code of delegation target is executed in context of authority
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)).Bytes(), suite.app.EvmKeeper.GetState(suite.ctx, authority, common.Hash{}))
+	suite.Require().Empty(suite.app.EvmKeeper.GetState(suite.ctx, target, common.Hash{}))
+
+	delegation, ok, err := suite.app.EvmKeeper.GetDelegation(suite.ctx, authority)
+	suite.Require().NoError(err)
+	suite.Require().True(ok)
+	suite.Require().Equal(target, delegation)
+	suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetNonce(suite.ctx, authority))
+}
@@ -18,7 +18,6 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/params"
 	"github.com/pkg/errors"
 
 	evmcommontypes "swisstronik/types"
@@ -37,7 +36,7 @@ func (k *Keeper) HandleTx(goCtx context.Context, msg *types.MsgHandleTx) (*types
 	txIndex := k.GetTxIndexTransient(ctx)
 
 	labels := []metrics.Label{
-		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", tx.Type())),
+		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", msg.TxType())),
 	}
 	if tx.To() == nil {
 		labels = append(labels, telemetry.NewLabel("execution", "create"))
@@ -45,7 +44,7 @@ func (k *Keeper) HandleTx(goCtx context.Context, msg *types.MsgHandleTx) (*types
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
-	response, err := k.ApplySGXVMTransaction(ctx, tx, msg.Unencrypted)
+	response, err := k.ApplySGXVMMessage(ctx, msg)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
@@ -125,32 +124,51 @@ func (k *Keeper) HandleTx(goCtx context.Context, msg *types.MsgHandleTx) (*types
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, sender),
-			sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", tx.Type())),
+			sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", msg.TxType())),
 		),
 	})
 
 	return response, nil
 }
 
+// ApplySGXVMTransaction applies provided Ethereum transaction in the same way as ApplySGXVMMessage
 func (k *Keeper) ApplySGXVMTransaction(
 	ctx sdk.Context,
 	tx *ethtypes.Transaction,
 	isUnencrypted bool,
+) (*types.MsgEthereumTxResponse, error) {
+	ethMsg := &types.MsgHandleTx{Unencrypted: isUnencrypted}
+	if err := ethMsg.FromEthereumTx(tx); err != nil {
+		return nil, err
+	}
+	return k.ApplySGXVMMessage(ctx, ethMsg)
+}
+
+// ApplySGXVMMessage applies transaction of provided message using SGXVM, refunds leftover gas
+// and updates transient block data. Unlike ApplySGXVMTransaction, it supports all transaction
+// types, including EIP-7702 set code transactions.
+func (k *Keeper) ApplySGXVMMessage(
+	ctx sdk.Context,
+	ethMsg *types.MsgHandleTx,
 ) (*types.MsgEthereumTxResponse, error) {
 	var (
 		bloom        *big.Int
 		bloomReceipt ethtypes.Bloom
 	)
 
+	tx := ethMsg.AsTransaction()
+	txType := ethMsg.TxType()
+	isUnencrypted := ethMsg.Unencrypted
+
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
-	txConfig := k.TxConfig(ctx, tx.Hash())
+	txConfig := k.TxConfig(ctx, ethMsg.TxHash())
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
 
 	// get the signer according to the chain rules from the config and block height
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	msg, err := ethMsg.AsMessage(signer, cfg.BaseFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
@@ -177,7 +195,7 @@ func (k *Keeper) ApplySGXVMTransaction(
 		return nil, errorsmod.Wrap(err, "failed to extract signature from tx")
 	}
 
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, true, cfg, txConfig, txContext, isUnencrypted, combinedSignature, tx.Type())
+	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, true, cfg, txConfig, txContext, isUnencrypted, combinedSignature, txType)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
@@ -206,7 +224,7 @@ func (k *Keeper) ApplySGXVMTransaction(
 	}
 
 	receipt := &ethtypes.Receipt{
-		Type:              tx.Type(),
+		Type:              txType,
 		PostState:         nil, // TODO: intermediate state root
 		CumulativeGasUsed: cumulativeGasUsed,
 		Bloom:             bloomReceipt,
@@ -272,11 +290,30 @@ func (k *Keeper) ApplyMessageWithConfig(
 	transactionSignature []byte,
 	txType uint8,
 ) (*types.MsgEthereumTxResponse, error) {
+	res, _, err := k.ApplyMessageWithTrace(ctx, msg, commit, cfg, txConfig, txContext, isUnencrypted, transactionSignature, txType, nil)
+	return res, err
+}
+
+// ApplyMessageWithTrace executes message in the same way as `ApplyMessageWithConfig` and returns
+// execution trace collected by SGXVM using provided trace options. If trace options are nil,
+// message is executed without tracing and returned trace is nil.
+func (k *Keeper) ApplyMessageWithTrace(
+	ctx sdk.Context,
+	msg core.Message,
+	commit bool,
+	cfg *types.EVMConfig,
+	txConfig types.TxConfig,
+	txContext *librustgo.TransactionContext,
+	isUnencrypted bool,
+	transactionSignature []byte,
+	txType uint8,
+	traceOptions *librustgo.TraceOptions,
+) (*types.MsgEthereumTxResponse, *librustgo.ExecutionTrace, error) {
 	// return error if contract creation or call are disabled through governance
 	if !cfg.Params.EnableCreate && msg.To() == nil {
-		return nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
 	} else if !cfg.Params.EnableCall && msg.To() != nil {
-		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
 	leftoverGas := msg.Gas()
@@ -284,13 +321,29 @@ func (k *Keeper) ApplyMessageWithConfig(
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
-		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
+		return nil, nil, errorsmod.Wrap(err, "intrinsic gas failed")
 	}
 
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if leftoverGas < intrinsicGas {
 		// eth_estimateGas will check for this exact error
-		return nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
+		return nil, nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
+	}
+
+	// authorizations of EIP-7702 message are applied before execution, even if execution fails.
+	// If message is not committed, they are applied to the cache context, which is discarded.
+	var (
+		authorizationList   []*librustgo.AuthorizationListItem
+		authorizationRefund uint64
+	)
+	if auths := types.GetAuthorizationList(msg); auths != nil {
+		if !commit {
+			ctx, _ = ctx.CacheContext()
+		}
+		authorizationList, authorizationRefund, err = k.ApplySetCodeAuthorizations(ctx, cfg.ChainConfig.ChainID, auths)
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(err, "failed to apply authorizations")
+		}
 	}
 
 	connector := Connector{
@@ -298,10 +351,16 @@ func (k *Keeper) ApplyMessageWithConfig(
 		EVMKeeper: k,
 	}
 
+	// SGXVM exposes contract state and decrypted data in trace only to trace nodes. It is
+	// decided by node configuration, regardless of the traced request
+	if traceOptions != nil {
+		traceOptions.TraceNode = k.traceNode
+	}
+
 	var res *librustgo.HandleTransactionResponse
 	if contractCreation {
 		k.SetNonce(ctx, msg.From(), msg.Nonce())
-		res, err = librustgo.Create(
+		res, err = librustgo.CreateWithTrace(
 			connector,
 			msg.From().Bytes(),
 			msg.Data(),
@@ -316,10 +375,11 @@ func (k *Keeper) ApplyMessageWithConfig(
 			msg.GasFeeCap(),
 			msg.GasTipCap(),
 			txType,
+			traceOptions,
 		)
 		k.SetNonce(ctx, msg.From(), msg.Nonce()+1)
 	} else {
-		res, err = librustgo.Call(
+		res, err = librustgo.CallWithTrace(
 			connector,
 			msg.From().Bytes(),
 			msg.To().Bytes(),
@@ -336,30 +396,30 @@ func (k *Keeper) ApplyMessageWithConfig(
 			msg.GasFeeCap(),
 			msg.GasTipCap(),
 			txType,
+			traceOptions,
+			authorizationList,
 		)
 	}
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// calculate gas refund
-	if msg.Gas() < leftoverGas {
-		return nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
+	// apply refund counter reported by SGXVM, so receipts, refunded fees and block gas use the same value
+	refundQuotient := RefundQuotient(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	gasUsed, err := GasUsedAfterRefund(msg.Gas(), res.GasUsed, res.GasRefund+authorizationRefund, refundQuotient)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "apply message")
 	}
-	// refund gas
-	temporaryGasUsed := msg.Gas() - leftoverGas
-	refundQuotient := params.RefundQuotientEIP3529
-	leftoverGas += GasToRefund(0, temporaryGasUsed, refundQuotient)
 
 	logs := SGXVMLogsToEthereum(res.Logs, txConfig, txContext.BlockNumber)
 	return &types.MsgEthereumTxResponse{
-		GasUsed: res.GasUsed,
+		GasUsed: gasUsed,
 		VmError: res.VmError,
 		Ret:     res.Ret,
 		Logs:    types.NewLogsFromEth(logs),
 		Hash:    txConfig.TxHash.Hex(),
-	}, nil
+	}, res.Trace, nil
 }
 
 func (k *Keeper) EstimateGasMessageWithConfig(
@@ -392,6 +452,19 @@ func (k *Keeper) EstimateGasMessageWithConfig(
 		return nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
 	}
 
+	// authorizations of EIP-7702 message are applied to the cache context, which is discarded
+	// after estimation. SGXVM estimates gas without authorizations, so their intrinsic gas is
+	// excluded from the gas limit and added to the gas used.
+	var authorizationGas, authorizationRefund uint64
+	if auths := types.GetAuthorizationList(msg); auths != nil {
+		ctx, _ = ctx.CacheContext()
+		if _, authorizationRefund, err = k.ApplySetCodeAuthorizations(ctx, cfg.ChainConfig.ChainID, auths); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply authorizations")
+		}
+		authorizationGas = uint64(len(auths)) * types.PerEmptyAccountCost
+		leftoverGas -= authorizationGas
+	}
+
 	connector := Connector{
 		Context:   ctx,
 		EVMKeeper: k,
@@ -440,18 +513,16 @@ func (k *Keeper) EstimateGasMessageWithConfig(
 		return nil, err
 	}
 
-	// calculate gas refund
-	if msg.Gas() < leftoverGas {
-		return nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
+	// apply refund counter in the same way as during execution of transaction
+	refundQuotient := RefundQuotient(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	gasUsed, err := GasUsedAfterRefund(msg.Gas(), res.GasUsed+authorizationGas, res.GasRefund+authorizationRefund, refundQuotient)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "apply message")
 	}
-	// refund gas
-	temporaryGasUsed := msg.Gas() - leftoverGas
-	refundQuotient := params.RefundQuotientEIP3529
-	leftoverGas += GasToRefund(0, temporaryGasUsed, refundQuotient)
 
 	logs := SGXVMLogsToEthereum(res.Logs, txConfig, txContext.BlockNumber)
 	return &types.MsgEthereumTxResponse{
-		GasUsed: res.GasUsed,
+		GasUsed: gasUsed,
 		VmError: res.VmError,
 		Ret:     res.Ret,
 		Logs:    types.NewLogsFromEth(logs),
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"errors"
+	"math"
 	"math/big"
 
 	"github.com/SigmaGmbH/librustgo"
@@ -71,9 +72,9 @@ func (q Connector) Query(req []byte) ([]byte, error) {
 	case *librustgo.CosmosRequest_GetAccountCodeSize:
 		return q.GetAccountCodeSize(request)
 	case *librustgo.CosmosRequest_IssuanceTreeRoot:
-		return q.GetIssuanceTreeRoot()
+		return q.GetIssuanceTreeRoot(request)
 	case *librustgo.CosmosRequest_RevocationTreeRoot:
-		return q.GetRevocationTreeRoot()
+		return q.GetRevocationTreeRoot(request)
 	case *librustgo.CosmosRequest_AddVerificationDetailsV2:
 		return q.AddVerificationDetailsV2(request)
 	case *librustgo.CosmosRequest_RevokeVerification:
@@ -355,8 +356,15 @@ func (q Connector) GetVerificationData(req *librustgo.CosmosRequest_GetVerificat
 	})
 }
 
-func (q Connector) GetIssuanceTreeRoot() ([]byte, error) {
-	root, err := q.EVMKeeper.ComplianceKeeper.GetIssuanceTreeRoot(q.Context)
+// GetIssuanceTreeRoot returns root of issuance tree. If block height is provided,
+// returns root of issuance tree at the end of that block.
+func (q Connector) GetIssuanceTreeRoot(req *librustgo.CosmosRequest_IssuanceTreeRoot) ([]byte, error) {
+	blockHeight, err := toBlockHeight(req.IssuanceTreeRoot.GetBlockHeight())
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := q.EVMKeeper.ComplianceKeeper.GetIssuanceTreeRootAtHeight(q.Context, blockHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -370,8 +378,15 @@ func (q Connector) GetIssuanceTreeRoot() ([]byte, error) {
 	})
 }
 
-func (q Connector) GetRevocationTreeRoot() ([]byte, error) {
-	root, err := q.EVMKeeper.ComplianceKeeper.GetRevocationTreeRoot(q.Context)
+// GetRevocationTreeRoot returns root of revocation tree. If block height is provided,
+// returns root of revocation tree at the end of that block.
+func (q Connector) GetRevocationTreeRoot(req *librustgo.CosmosRequest_RevocationTreeRoot) ([]byte, error) {
+	blockHeight, err := toBlockHeight(req.RevocationTreeRoot.GetBlockHeight())
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := q.EVMKeeper.ComplianceKeeper.GetRevocationTreeRootAtHeight(q.Context, blockHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -419,3 +434,11 @@ func (q Connector) ConvertCredential(req *librustgo.CosmosRequest_ConvertCredent
 
 	return proto.Marshal(&librustgo.QueryConvertCredentialResponse{})
 }
+
+// toBlockHeight converts block height received from SGXVM into signed block height used by Cosmos SDK
+func toBlockHeight(blockHeight uint64) (int64, error) {
+	if blockHeight > math.MaxInt64 {
+		return 0, errors.New("invalid block height")
+	}
+	return int64(blockHeight), nil
+}
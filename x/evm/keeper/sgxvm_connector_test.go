@@ -596,3 +596,73 @@ func (suite *KeeperTestSuite) TestRevocationRoot() {
 
 	suite.Require().Equal(expectedRootValue, decodedRootValue)
 }
+
+func (suite *KeeperTestSuite) TestTreeRootsAtHeight() {
+	ctx, _ := suite.ctx.CacheContext()
+	complianceKeeper := suite.app.ComplianceKeeper
+	connector := evmkeeper.Connector{
+		Context:   ctx,
+		EVMKeeper: suite.app.EvmKeeper,
+	}
+
+	setRoots := func(issuanceRoot, revocationRoot int64) {
+		root, err := merkletree.NewHashFromBigInt(big.NewInt(issuanceRoot))
+		suite.Require().NoError(err)
+		suite.Require().NoError(complianceKeeper.SetTreeRoot(ctx, compliancetypes.KeyPrefixIssuanceTree, root))
+		root, err = merkletree.NewHashFromBigInt(big.NewInt(revocationRoot))
+		suite.Require().NoError(err)
+		suite.Require().NoError(complianceKeeper.SetTreeRoot(ctx, compliancetypes.KeyPrefixRevocationTree, root))
+	}
+
+	// Roots are saved at the end of the block and changed in the next one
+	pastHeight := uint64(ctx.BlockHeight())
+	setRoots(123, 321)
+	suite.Require().NoError(complianceKeeper.SaveTreeRoots(ctx))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	connector.Context = ctx
+	setRoots(456, 654)
+
+	queryRoots := func(blockHeight uint64) (*big.Int, *big.Int, error) {
+		request, err := proto.Marshal(&librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_IssuanceTreeRoot{
+				IssuanceTreeRoot: &librustgo.QueryIssuanceTreeRoot{BlockHeight: blockHeight},
+			},
+		})
+		suite.Require().NoError(err)
+		respBytes, err := connector.Query(request)
+		if err != nil {
+			return nil, nil, err
+		}
+		issuanceResp := &librustgo.QueryIssuanceTreeRootResponse{}
+		suite.Require().NoError(proto.Unmarshal(respBytes, issuanceResp))
+
+		request, err = proto.Marshal(&librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_RevocationTreeRoot{
+				RevocationTreeRoot: &librustgo.QueryRevocationTreeRoot{BlockHeight: blockHeight},
+			},
+		})
+		suite.Require().NoError(err)
+		respBytes, err = connector.Query(request)
+		if err != nil {
+			return nil, nil, err
+		}
+		revocationResp := &librustgo.QueryRevocationTreeRootResponse{}
+		suite.Require().NoError(proto.Unmarshal(respBytes, revocationResp))
+
+		return new(big.Int).SetBytes(issuanceResp.Root), new(big.Int).SetBytes(revocationResp.Root), nil
+	}
+
+	issuanceRoot, revocationRoot, err := queryRoots(pastHeight)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(123), issuanceRoot)
+	suite.Require().Equal(big.NewInt(321), revocationRoot)
+
+	issuanceRoot, revocationRoot, err = queryRoots(0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(456), issuanceRoot)
+	suite.Require().Equal(big.NewInt(654), revocationRoot)
+
+	// Roots of the current block are not final yet
+	_, _, err = queryRoots(uint64(ctx.BlockHeight()))
+	suite.Require().ErrorIs(err, compliancetypes.ErrRootNotFound)
+}
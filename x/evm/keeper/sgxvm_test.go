@@ -4,13 +4,16 @@ import (
 	"encoding/json"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"swisstronik/server/config"
+	"swisstronik/tests"
 	"swisstronik/x/evm/keeper"
 	"swisstronik/x/evm/types"
 )
@@ -221,6 +224,65 @@ func (suite *KeeperTestSuite) TestDryRun() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGasRefund() {
+	suite.SetupSGXVMTest()
+
+	// contract sets slot 0 to 1 if it is empty, otherwise clears it
+	contract := tests.RandomEthAddress()
+	code := hexutil.MustDecode("0x60005415600d576000600055005b600160005500")
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, code))
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, suite.address, big.NewInt(10000000)))
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	gasLimit := uint64(100000)
+	gasPrice := big.NewInt(10)
+
+	handleTx := func() *types.MsgEthereumTxResponse {
+		ethTx := ethtypes.NewTx(&ethtypes.LegacyTx{
+			Nonce:    suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+			GasPrice: gasPrice,
+			Gas:      gasLimit,
+			To:       &contract,
+			Value:    big.NewInt(0),
+		})
+
+		msg := &types.MsgHandleTx{}
+		suite.Require().NoError(msg.FromEthereumTx(ethTx))
+		msg.From = suite.address.Hex()
+		suite.Require().NoError(msg.Sign(ethSigner, suite.signer))
+
+		// fees are deducted by ante handler
+		fees := sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(msg.GetFee())))
+		err := suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, suite.address.Bytes(), authtypes.FeeCollectorName, fees)
+		suite.Require().NoError(err)
+
+		res, err := suite.app.EvmKeeper.HandleTx(suite.ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+		return res
+	}
+
+	// slot 0 is set, there is no refund
+	handleTx()
+
+	balanceBefore := suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address)
+	gasUsedBefore := suite.app.EvmKeeper.GetTransientGasUsed(suite.ctx)
+
+	// slot 0 is cleared. Execution consumes 26025 gas, refund of 4800 is not capped by a fifth part of used gas
+	res := handleTx()
+	suite.Require().Equal(uint64(26025-4800), res.GasUsed)
+
+	// sender pays only for gas used after refund
+	balanceAfter := suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address)
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(res.GasUsed))
+	suite.Require().Equal(fee, new(big.Int).Sub(balanceBefore, balanceAfter))
+
+	// block gas uses the same value
+	suite.Require().Equal(gasUsedBefore+res.GasUsed, suite.app.EvmKeeper.GetTransientGasUsed(suite.ctx))
+}
+
 func (suite *KeeperTestSuite) TestMultipleTransfers() {
 	balanceToSet := int64(10)
 	amountToTransfer := int64(1)
@@ -401,16 +463,31 @@ func (suite *KeeperTestSuite) TestCallToCompliance() {
 		name      string
 		txDataHex string
 		expected  *big.Int
+		atHeight  bool
 	}{
 		{
 			"Request issuance tree root",
 			"0xd0376bd2",
 			big.NewInt(123),
+			false,
 		},
 		{
 			"Request revocation tree root",
 			"0x3db94a04",
 			big.NewInt(321),
+			false,
+		},
+		{
+			"Request issuance tree root at height",
+			"0xc8997920",
+			big.NewInt(123),
+			true,
+		},
+		{
+			"Request revocation tree root at height",
+			"0xd6db03e8",
+			big.NewInt(321),
+			true,
 		},
 	}
 
@@ -430,6 +507,12 @@ func (suite *KeeperTestSuite) TestCallToCompliance() {
 			templateLegacyTx.Nonce = nonce
 			templateLegacyTx.Gas = 100_000
 			templateLegacyTx.Data = hexutil.MustDecode(tc.txDataHex)
+			if tc.atHeight {
+				// Roots of the block are requested once they are saved at the end of it
+				suite.Require().NoError(suite.app.ComplianceKeeper.SaveTreeRoots(suite.ctx))
+				blockHeight := big.NewInt(suite.ctx.BlockHeight())
+				templateLegacyTx.Data = append(templateLegacyTx.Data, common.LeftPadBytes(blockHeight.Bytes(), 32)...)
+			}
 			ethTx := ethtypes.NewTx(templateLegacyTx)
 			msg := &types.MsgHandleTx{}
 			err = msg.FromEthereumTx(ethTx)
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"swisstronik/x/evm/types"
+)
+
+// applyStateOverrides overrides nonce, balance and code of provided accounts. Contract storage
+// cannot be overridden, since it is encrypted by SGXVM. Code can be set only for accounts without
+// code and storage, otherwise replaced code could read encrypted storage of the contract.
+func (k *Keeper) applyStateOverrides(ctx sdk.Context, overrides types.StateOverride) error {
+	for address, account := range overrides {
+		if account.Nonce != nil {
+			if err := k.SetNonce(ctx, address, uint64(*account.Nonce)); err != nil {
+				return err
+			}
+		}
+		if account.Balance != nil {
+			if err := k.SetBalance(ctx, address, account.Balance.ToInt()); err != nil {
+				return err
+			}
+		}
+		if account.Code != nil {
+			if err := k.checkCodeOverride(ctx, address, *account.Code); err != nil {
+				return err
+			}
+			if err := k.SetAccountCode(ctx, address, *account.Code); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkCodeOverride returns an error if code of provided account cannot be overridden. Storage
+// of contracts is encrypted using contract address, therefore code of contracts and accounts
+// with storage cannot be replaced. Existing accounts cannot be delegated without authorization.
+func (k *Keeper) checkCodeOverride(ctx sdk.Context, address common.Address, code []byte) error {
+	currentCode, err := k.GetAccountCode(ctx, address)
+	if err != nil {
+		return err
+	}
+	if len(currentCode) != 0 {
+		return fmt.Errorf("cannot override code of existing contract %s", address.Hex())
+	}
+
+	hasStorage := false
+	k.ForEachStorage(ctx, address, func(common.Hash, []byte) bool {
+		hasStorage = true
+		return false
+	})
+	if hasStorage {
+		return fmt.Errorf("cannot override code of %s, since account has storage", address.Hex())
+	}
+
+	if _, ok := types.ParseDelegation(code); ok && k.GetAccountWithoutBalance(ctx, address) != nil {
+		return fmt.Errorf("cannot override code of existing account %s with delegation", address.Hex())
+	}
+	return nil
+}
+
+// simulateCall executes a single call of `eth_simulateV1` and commits its state changes
+// to provided context, so following calls are executed on top of them
+func (k *Keeper) simulateCall(
+	ctx sdk.Context,
+	args types.CallArgs,
+	gasCap uint64,
+	cfg *types.EVMConfig,
+	txConfig types.TxConfig,
+	isUnencrypted bool,
+) (*types.MsgEthereumTxResponse, error) {
+	if args.Nonce == nil {
+		nonce := k.GetNonce(ctx, args.GetFrom())
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+
+	txType := args.ToTransaction().TxType()
+	msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	txContext, err := CreateSGXVMContextFromMessage(ctx, k, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	combinedSignature := make([]byte, 65)
+	if args.V != nil && args.S != nil && args.R != nil {
+		combinedSignature, err = CombineSignature(args.V.ToInt(), args.R.ToInt(), args.S.ToInt(), cfg.ChainConfig.ChainID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Nonce of sender is increased by ante handler before execution of contract call
+	if msg.To() != nil {
+		if err = k.SetNonce(ctx, msg.From(), msg.Nonce()+1); err != nil {
+			return nil, err
+		}
+	}
+
+	txConfig.TxHash = args.ToTransaction().TxHash()
+	return k.ApplyMessageWithConfig(ctx, msg, true, cfg, txConfig, txContext, isUnencrypted, combinedSignature, txType)
+}
@@ -349,6 +349,49 @@ func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGasUsedAfterRefund() {
+	// Gas used and refund counters of EIP-3529 test cases, executed as calls with
+	// access list, which contains single storage key (25300 of intrinsic gas).
+	// Ref: https://eips.ethereum.org/EIPS/eip-3529#test-cases
+	testCases := []struct {
+		name         string
+		gasUsed      uint64
+		refund       uint64
+		expLondon    uint64
+		expPreLondon uint64
+	}{
+		{"no-op, original 0", 25512, 0, 25512, 25512},
+		{"set, original 0", 45412, 0, 45412, 45412},
+		{"set and clear, original 0", 45412, 19900, 36330, 25512},
+		{"clear, original 1", 28312, 4800, 23512, 23512},
+		{"clear and restore, original 1", 28312, 2800, 25512, 25512},
+		{"no-op, original 1", 25512, 0, 25512, 25512},
+		{"set, clear and set, original 0", 65418, 19900, 52335, 45518},
+		{"clear, set and clear, original 1", 31218, 7600, 24975, 23618},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			gasUsed, err := keeper.GasUsedAfterRefund(100000, tc.gasUsed, tc.refund, params.RefundQuotientEIP3529)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expLondon, gasUsed)
+
+			gasUsed, err = keeper.GasUsedAfterRefund(100000, tc.gasUsed, tc.refund, params.RefundQuotient)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPreLondon, gasUsed)
+		})
+	}
+
+	_, err := keeper.GasUsedAfterRefund(21000, 21001, 0, params.RefundQuotientEIP3529)
+	suite.Require().ErrorIs(err, types.ErrGasOverflow)
+}
+
+func (suite *KeeperTestSuite) TestRefundQuotient() {
+	cfg := &params.ChainConfig{LondonBlock: big.NewInt(10)}
+	suite.Require().Equal(params.RefundQuotient, keeper.RefundQuotient(cfg, big.NewInt(9)))
+	suite.Require().Equal(params.RefundQuotientEIP3529, keeper.RefundQuotient(cfg, big.NewInt(10)))
+}
+
 func (suite *KeeperTestSuite) TestEVMConfig() {
 	proposerAddress := suite.ctx.BlockHeader().ProposerAddress
 	cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(commontypes.EvmChainID))
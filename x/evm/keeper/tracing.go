@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"github.com/SigmaGmbH/librustgo"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"swisstronik/x/evm/types"
+)
+
+// replayTransaction executes transaction of traced block on top of the provided context and commits
+// its state changes, so following transactions of the block are executed against the correct state.
+// If trace options are provided, execution trace collected by SGXVM is returned.
+func (k *Keeper) replayTransaction(
+	ctx sdk.Context,
+	tx *types.MsgHandleTx,
+	signer ethtypes.Signer,
+	cfg *types.EVMConfig,
+	txConfig types.TxConfig,
+	traceOptions *librustgo.TraceOptions,
+) (*types.MsgEthereumTxResponse, *librustgo.ExecutionTrace, error) {
+	ethTx := tx.AsTransaction()
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txContext, err := CreateSGXVMContext(ctx, k, ethTx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v, r, s := ethTx.RawSignatureValues()
+	combinedSignature, err := CombineSignature(v, r, s, cfg.ChainConfig.ChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Nonce of sender is increased by ante handler before execution of contract call
+	if msg.To() != nil {
+		k.SetNonce(ctx, msg.From(), msg.Nonce()+1)
+	}
+
+	return k.ApplyMessageWithTrace(ctx, msg, true, cfg, txConfig, txContext, tx.Unencrypted, combinedSignature, tx.TxType(), traceOptions)
+}
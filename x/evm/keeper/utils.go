@@ -103,6 +103,11 @@ func VerifyFee(
 		)
 	}
 
+	// each EIP-7702 authorization is charged as creation of new account
+	if setCodeTx, ok := txData.(*types.SetCodeTx); ok {
+		intrinsicGas += uint64(len(setCodeTx.Authorizations)) * types.PerEmptyAccountCost
+	}
+
 	// intrinsic gas verification during CheckTx
 	if isCheckTx && gasLimit < intrinsicGas {
 		return nil, errorsmod.Wrapf(
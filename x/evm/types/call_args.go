@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -36,6 +37,9 @@ type CallArgs struct {
 	AccessList *ethtypes.AccessList `json:"accessList,omitempty"`
 	ChainID    *hexutil.Big         `json:"chainId,omitempty"`
 
+	// Introduced by SetCodeTxType transaction.
+	AuthorizationList []SetCodeAuthorization `json:"authorizationList,omitempty"`
+
 	// Used to recover original eth_call sender. If empty, zero address will be recovered
 	V *hexutil.Big `json:"v,omitempty"`
 	R *hexutil.Big `json:"r,omitempty"`
@@ -109,6 +113,27 @@ func (args *CallArgs) ToTransaction() *MsgHandleTx {
 
 	var data TxData
 	switch {
+	case args.AuthorizationList != nil:
+		al := AccessList{}
+		if args.AccessList != nil {
+			al = NewAccessList(args.AccessList)
+		}
+
+		data = &SetCodeTx{
+			To:             to,
+			ChainID:        &chainID,
+			Nonce:          nonce,
+			GasLimit:       gas,
+			GasFeeCap:      &maxFeePerGas,
+			GasTipCap:      &maxPriorityFeePerGas,
+			Amount:         &value,
+			Data:           args.GetData(),
+			Accesses:       al,
+			Authorizations: NewAuthorizationList(args.AuthorizationList),
+			V:              v,
+			R:              r,
+			S:              s,
+		}
 	case args.MaxFeePerGas != nil:
 		al := AccessList{}
 		if args.AccessList != nil {
@@ -165,19 +190,16 @@ func (args *CallArgs) ToTransaction() *MsgHandleTx {
 	msg := MsgHandleTx{
 		Data: any,
 	}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.TxHash().Hex()
 
 	// If there is no provided signature or chain id, we use zeroed From address
 	if args.ChainID == nil || v == nil || r == nil || s == nil {
 		msg.From = common.Address{}.Hex()
 	} else {
 		// Otherwise recover `msg.sender` from provided signature
-		signer := ethtypes.LatestSignerForChainID(args.ChainID.ToInt())
-		sender, err := signer.Sender(msg.AsTransaction())
-		if err != nil {
+		if _, err := msg.GetSender(args.ChainID.ToInt()); err != nil {
 			return nil
 		}
-		msg.From = sender.Hex()
 	}
 
 	return &msg
@@ -185,10 +207,10 @@ func (args *CallArgs) ToTransaction() *MsgHandleTx {
 
 // ToMessage converts the arguments to the Message type used by the core evm.
 // This assumes that setTxDefaults has been called.
-func (args *CallArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (ethtypes.Message, error) {
+func (args *CallArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (core.Message, error) {
 	// Reject invalid combinations of pre- and post-1559 fee styles
 	if args.GasPrice != nil && (args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil) {
-		return ethtypes.Message{}, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
+		return nil, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
 	}
 
 	// Recover from signature or use zero address if none specified.
@@ -256,6 +278,9 @@ func (args *CallArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (ethtypes
 	}
 
 	msg := ethtypes.NewMessage(addr, args.To, nonce, value, gas, gasPrice, gasFeeCap, gasTipCap, data, accessList, true)
+	if args.AuthorizationList != nil {
+		return NewSetCodeMessage(msg, args.AuthorizationList), nil
+	}
 	return msg, nil
 }
 
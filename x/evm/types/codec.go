@@ -39,6 +39,7 @@ var (
 const (
 	// Amino names
 	updateParamsName = "ethermint/MsgUpdateParams"
+	updateEpochsName = "ethermint/MsgUpdateEpochs"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -56,11 +57,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateEpochs{},
 		&MsgHandleTx{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
 		(*TxData)(nil),
+		&SetCodeTx{},
 		&DynamicFeeTx{},
 		&AccessListTx{},
 		&LegacyTx{},
@@ -104,4 +107,5 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgUpdateEpochs{}, updateEpochsName, nil)
 }
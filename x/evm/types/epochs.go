@@ -0,0 +1,113 @@
+package types
+
+import (
+	"bytes"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	rustgotypes "github.com/SigmaGmbH/librustgo/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// NodePublicKeyLength is the length of x25519 public key
+const NodePublicKeyLength = 32
+
+// Validate performs a basic validation of epoch fields
+func (e Epoch) Validate() error {
+	if e.EpochNumber > math.MaxUint16 {
+		return errorsmod.Wrapf(ErrInvalidEpochs, "epoch number %d exceeds %d", e.EpochNumber, math.MaxUint16)
+	}
+	if len(e.NodePublicKey) != NodePublicKeyLength {
+		return errorsmod.Wrapf(ErrInvalidEpochs, "invalid node public key length of epoch %d, expected %d, got %d", e.EpochNumber, NodePublicKeyLength, len(e.NodePublicKey))
+	}
+	return nil
+}
+
+// equal returns true if both epochs have the same number, starting block and node public key
+func (e Epoch) equal(other Epoch) bool {
+	return e.EpochNumber == other.EpochNumber && e.StartingBlock == other.StartingBlock && bytes.Equal(e.NodePublicKey, other.NodePublicKey)
+}
+
+// ValidateEpochs checks that epochs are valid and ordered by both epoch number and starting block
+func ValidateEpochs(epochs []Epoch) error {
+	for i, epoch := range epochs {
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+		if i == 0 {
+			continue
+		}
+
+		prev := epochs[i-1]
+		if epoch.EpochNumber <= prev.EpochNumber {
+			return errorsmod.Wrapf(ErrInvalidEpochs, "epoch %d should have greater number than epoch %d", epoch.EpochNumber, prev.EpochNumber)
+		}
+		if epoch.StartingBlock <= prev.StartingBlock {
+			return errorsmod.Wrapf(ErrInvalidEpochs, "epoch %d should start after epoch %d", epoch.EpochNumber, prev.EpochNumber)
+		}
+	}
+	return nil
+}
+
+// ValidateEpochsUpdate checks that new schedule of epochs keeps all epochs, which started before or at the provided block.
+func ValidateEpochsUpdate(current, updated []Epoch, blockNumber uint64) error {
+	started := StartedEpochs(current, blockNumber)
+	if len(updated) < len(started) {
+		return errorsmod.Wrapf(ErrInvalidEpochs, "started epochs cannot be removed, expected at least %d epochs, got %d", len(started), len(updated))
+	}
+	for i, epoch := range started {
+		if !epoch.equal(updated[i]) {
+			return errorsmod.Wrapf(ErrInvalidEpochs, "started epoch %d cannot be changed", epoch.EpochNumber)
+		}
+	}
+	// new epochs cannot be scheduled in the past, otherwise already processed blocks would belong to them
+	for _, epoch := range updated[len(started):] {
+		if epoch.StartingBlock <= blockNumber {
+			return errorsmod.Wrapf(ErrInvalidEpochs, "epoch %d should start after block %d", epoch.EpochNumber, blockNumber)
+		}
+	}
+	return nil
+}
+
+// StartedEpochs returns epochs, which started before or at the provided block
+func StartedEpochs(epochs []Epoch, blockNumber uint64) []Epoch {
+	for i, epoch := range epochs {
+		if epoch.StartingBlock > blockNumber {
+			return epochs[:i]
+		}
+	}
+	return epochs
+}
+
+// CompareEnclaveEpochs checks that epochs of enclave, which started before or at the provided block,
+// are the same as epochs agreed by consensus. Epochs of enclave are expected to be ordered by epoch number.
+func CompareEnclaveEpochs(epochs []Epoch, enclaveEpochs []*rustgotypes.EpochData, blockNumber uint64) error {
+	started := StartedEpochs(epochs, blockNumber)
+
+	var enclaveStarted []*rustgotypes.EpochData
+	for _, epoch := range enclaveEpochs {
+		if epoch.StartingBlock <= blockNumber {
+			enclaveStarted = append(enclaveStarted, epoch)
+		}
+	}
+
+	if len(started) != len(enclaveStarted) {
+		return errorsmod.Wrapf(ErrEpochsMismatch, "expected %d started epochs at block %d, enclave has %d", len(started), blockNumber, len(enclaveStarted))
+	}
+	for i, epoch := range started {
+		enclaveEpoch := enclaveStarted[i]
+		if !epoch.equal(Epoch{
+			EpochNumber:   enclaveEpoch.EpochNumber,
+			StartingBlock: enclaveEpoch.StartingBlock,
+			NodePublicKey: enclaveEpoch.NodePublicKey,
+		}) {
+			return errorsmod.Wrapf(
+				ErrEpochsMismatch,
+				"expected epoch %d starting at block %d with node public key %s, enclave has epoch %d starting at block %d with node public key %s",
+				epoch.EpochNumber, epoch.StartingBlock, hexutil.Encode(epoch.NodePublicKey),
+				enclaveEpoch.EpochNumber, enclaveEpoch.StartingBlock, hexutil.Encode(enclaveEpoch.NodePublicKey),
+			)
+		}
+	}
+	return nil
+}
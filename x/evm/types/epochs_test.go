@@ -0,0 +1,131 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	rustgotypes "github.com/SigmaGmbH/librustgo/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testEpoch(number uint32, startingBlock uint64) Epoch {
+	return Epoch{
+		EpochNumber:   number,
+		StartingBlock: startingBlock,
+		NodePublicKey: bytes.Repeat([]byte{byte(number + 1)}, NodePublicKeyLength),
+	}
+}
+
+func TestValidateEpochs(t *testing.T) {
+	testCases := []struct {
+		name    string
+		epochs  []Epoch
+		expPass bool
+	}{
+		{"empty schedule", []Epoch{}, true},
+		{"valid schedule", []Epoch{testEpoch(0, 0), testEpoch(1, 100), testEpoch(3, 200)}, true},
+		{"invalid node public key", []Epoch{{EpochNumber: 0, NodePublicKey: []byte{1, 2, 3}}}, false},
+		{"epoch number exceeds uint16", []Epoch{testEpoch(1<<16, 0)}, false},
+		{"unordered epoch numbers", []Epoch{testEpoch(1, 0), testEpoch(1, 100)}, false},
+		{"unordered starting blocks", []Epoch{testEpoch(0, 100), testEpoch(1, 100)}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateEpochs(tc.epochs)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidEpochs, tc.name)
+		}
+	}
+}
+
+func TestValidateEpochsUpdate(t *testing.T) {
+	current := []Epoch{testEpoch(0, 0), testEpoch(1, 100), testEpoch(2, 200)}
+
+	changedKey := testEpoch(1, 100)
+	changedKey.NodePublicKey = bytes.Repeat([]byte{0xff}, NodePublicKeyLength)
+
+	testCases := []struct {
+		name    string
+		updated []Epoch
+		expPass bool
+	}{
+		{"schedule future epoch", []Epoch{testEpoch(0, 0), testEpoch(1, 100), testEpoch(2, 300)}, true},
+		{"remove future epoch", []Epoch{testEpoch(0, 0), testEpoch(1, 100)}, true},
+		{"remove started epoch", []Epoch{testEpoch(0, 0)}, false},
+		{"change started epoch", []Epoch{testEpoch(0, 0), changedKey}, false},
+		{"schedule epoch in the past", []Epoch{testEpoch(0, 0), testEpoch(1, 100), testEpoch(2, 150)}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateEpochsUpdate(current, tc.updated, 150)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidEpochs, tc.name)
+		}
+	}
+}
+
+func TestCompareEnclaveEpochs(t *testing.T) {
+	epochs := []Epoch{testEpoch(0, 0), testEpoch(1, 100), testEpoch(2, 200)}
+	toEnclave := func(epochs ...Epoch) []*rustgotypes.EpochData {
+		res := make([]*rustgotypes.EpochData, len(epochs))
+		for i, epoch := range epochs {
+			res[i] = &rustgotypes.EpochData{EpochNumber: epoch.EpochNumber, StartingBlock: epoch.StartingBlock, NodePublicKey: epoch.NodePublicKey}
+		}
+		return res
+	}
+
+	changedKey := testEpoch(1, 100)
+	changedKey.NodePublicKey = bytes.Repeat([]byte{0xff}, NodePublicKeyLength)
+
+	testCases := []struct {
+		name          string
+		enclaveEpochs []*rustgotypes.EpochData
+		expPass       bool
+	}{
+		{"same epochs", toEnclave(epochs...), true},
+		{"future epoch is not added to enclave yet", toEnclave(epochs[0], epochs[1]), true},
+		{"enclave has different future epoch", toEnclave(epochs[0], epochs[1], testEpoch(2, 300)), true},
+		{"enclave misses started epoch", toEnclave(epochs[0]), false},
+		{"enclave has extra started epoch", toEnclave(epochs[0], testEpoch(1, 50), testEpoch(2, 100)), false},
+		{"enclave has different node public key", toEnclave(epochs[0], changedKey), false},
+		{"enclave has no epochs", nil, false},
+	}
+
+	for _, tc := range testCases {
+		err := CompareEnclaveEpochs(epochs, tc.enclaveEpochs, 150)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrEpochsMismatch, tc.name)
+		}
+	}
+}
+
+func TestMsgUpdateEpochsValidateBasic(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     MsgUpdateEpochs
+		expPass bool
+	}{
+		{"valid msg", MsgUpdateEpochs{Authority: authority, Epochs: []Epoch{testEpoch(0, 0), testEpoch(1, 100)}}, true},
+		{"invalid authority", MsgUpdateEpochs{Authority: "invalid", Epochs: []Epoch{testEpoch(0, 0)}}, false},
+		{"invalid epochs", MsgUpdateEpochs{Authority: authority, Epochs: []Epoch{testEpoch(1, 0), testEpoch(0, 100)}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -49,6 +49,11 @@ const (
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
 	codeErrEmptyNodePublicKey
+	codeErrTracingNotAllowed
+	codeErrTracerNotSupported
+	codeErrInvalidEpochs
+	codeErrEpochsMismatch
+	codeErrInvalidAuthorization
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -119,6 +124,21 @@ var (
 
 	// ErrEmptyNodePublicKey returns an error if stored node public key for specific block is empty
 	ErrEmptyNodePublicKey = errorsmod.Register(ModuleName, codeErrEmptyNodePublicKey, "empty node public key")
+
+	// ErrTracingNotAllowed returns an error if encrypted transaction is traced on node, which is not configured as trace node
+	ErrTracingNotAllowed = errorsmod.Register(ModuleName, codeErrTracingNotAllowed, "tracing of encrypted transactions is not allowed")
+
+	// ErrTracerNotSupported returns an error if requested tracer is not supported by SGXVM
+	ErrTracerNotSupported = errorsmod.Register(ModuleName, codeErrTracerNotSupported, "tracer is not supported")
+
+	// ErrInvalidEpochs returns an error if provided schedule of epochs is invalid
+	ErrInvalidEpochs = errorsmod.Register(ModuleName, codeErrInvalidEpochs, "invalid epochs")
+
+	// ErrEpochsMismatch returns an error if epochs of enclave differ from epochs agreed by consensus
+	ErrEpochsMismatch = errorsmod.Register(ModuleName, codeErrEpochsMismatch, "enclave epochs mismatch")
+
+	// ErrInvalidAuthorization returns an error if EIP-7702 set code authorization is invalid
+	ErrInvalidAuthorization = errorsmod.Register(ModuleName, codeErrInvalidAuthorization, "invalid set code authorization")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
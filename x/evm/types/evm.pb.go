@@ -684,6 +684,72 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+// Epoch defines a period of blocks, transactions and state of which are encrypted
+// with keys derived from the same epoch key
+type Epoch struct {
+	// epoch_number is a sequence number of the epoch assigned by key manager
+	EpochNumber uint32 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epochNumber"`
+	// starting_block is the first block of the epoch
+	StartingBlock uint64 `protobuf:"varint,2,opt,name=starting_block,json=startingBlock,proto3" json:"startingBlock"`
+	// node_public_key is x25519 public key used for transaction encryption
+	// within the epoch
+	NodePublicKey []byte `protobuf:"bytes,3,opt,name=node_public_key,json=nodePublicKey,proto3" json:"nodePublicKey"`
+}
+
+func (m *Epoch) Reset()         { *m = Epoch{} }
+func (m *Epoch) String() string { return proto.CompactTextString(m) }
+func (*Epoch) ProtoMessage()    {}
+func (*Epoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
+}
+func (m *Epoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Epoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Epoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Epoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Epoch.Merge(m, src)
+}
+func (m *Epoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Epoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Epoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Epoch proto.InternalMessageInfo
+
+func (m *Epoch) GetEpochNumber() uint32 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *Epoch) GetStartingBlock() uint64 {
+	if m != nil {
+		return m.StartingBlock
+	}
+	return 0
+}
+
+func (m *Epoch) GetNodePublicKey() []byte {
+	if m != nil {
+		return m.NodePublicKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
@@ -693,113 +759,119 @@ func init() {
 	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+	proto.RegisterType((*Epoch)(nil), "ethermint.evm.v1.Epoch")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0xe3, 0xc6,
-	0x15, 0xb6, 0x2d, 0xda, 0xa6, 0x46, 0xb2, 0x44, 0x8f, 0xb5, 0x8e, 0xb2, 0x8b, 0x9a, 0x2e, 0x2f,
-	0x0a, 0x17, 0x48, 0xec, 0xd8, 0x81, 0xd1, 0x45, 0x82, 0x16, 0xb5, 0x76, 0x9d, 0xc4, 0xee, 0x36,
-	0x35, 0xc6, 0x0e, 0x0a, 0x14, 0x28, 0x88, 0x11, 0x39, 0xa1, 0x18, 0x93, 0x1c, 0x61, 0x66, 0xa8,
-	0x95, 0xda, 0x3e, 0x40, 0x8b, 0xde, 0xf4, 0x09, 0x8a, 0x3c, 0x4e, 0xd0, 0xab, 0xbd, 0x2c, 0x7a,
-	0x41, 0x14, 0xde, 0x3b, 0x5f, 0xea, 0x09, 0x8a, 0xf9, 0x11, 0xf5, 0x63, 0xa3, 0xad, 0x75, 0xe5,
-	0xf9, 0xce, 0x39, 0xf3, 0x7d, 0x33, 0x67, 0xce, 0x68, 0x0e, 0x0d, 0x9e, 0x13, 0xd1, 0x23, 0x2c,
-	0x8d, 0x33, 0x71, 0x44, 0x06, 0xe9, 0xd1, 0xe0, 0x58, 0xfe, 0x39, 0xec, 0x33, 0x2a, 0x28, 0x74,
-	0x4a, 0xdf, 0xa1, 0x34, 0x0e, 0x8e, 0x9f, 0xb7, 0x22, 0x1a, 0x51, 0xe5, 0x3c, 0x92, 0x23, 0x1d,
-	0xe7, 0xfd, 0xa5, 0x02, 0x36, 0xae, 0x30, 0xc3, 0x29, 0x87, 0xc7, 0xa0, 0x4a, 0x06, 0xa9, 0x1f,
-	0x92, 0x8c, 0xa6, 0xed, 0xd5, 0xfd, 0xd5, 0x83, 0x6a, 0xa7, 0x35, 0x2e, 0x5c, 0x67, 0x84, 0xd3,
-	0xe4, 0x33, 0xaf, 0x74, 0x79, 0xc8, 0x26, 0x83, 0xf4, 0xb5, 0x1c, 0xc2, 0x9f, 0x83, 0x2d, 0x92,
-	0xe1, 0x6e, 0x42, 0xfc, 0x80, 0x11, 0x2c, 0x48, 0x7b, 0x6d, 0x7f, 0xf5, 0xc0, 0xee, 0xb4, 0xc7,
-	0x85, 0xdb, 0x32, 0xd3, 0x66, 0xdd, 0x1e, 0xaa, 0x6b, 0xfc, 0x4a, 0x41, 0xf8, 0x33, 0x50, 0x9b,
-	0xf8, 0x71, 0x92, 0xb4, 0x2b, 0x6a, 0xf2, 0xee, 0xb8, 0x70, 0xe1, 0xfc, 0x64, 0x9c, 0x24, 0x1e,
-	0x02, 0x66, 0x2a, 0x4e, 0x12, 0x78, 0x06, 0x00, 0x19, 0x0a, 0x86, 0x7d, 0x12, 0xf7, 0x79, 0xdb,
-	0xda, 0xaf, 0x1c, 0x54, 0x3a, 0xde, 0x5d, 0xe1, 0x56, 0xcf, 0xa5, 0xf5, 0xfc, 0xe2, 0x8a, 0x8f,
-	0x0b, 0x77, 0xdb, 0x90, 0x94, 0x81, 0x1e, 0xaa, 0x2a, 0x70, 0x1e, 0xf7, 0x39, 0xfc, 0x3d, 0xa8,
-	0x07, 0x3d, 0x1c, 0x67, 0x7e, 0x40, 0xb3, 0x6f, 0xe3, 0xa8, 0xbd, 0xbe, 0xbf, 0x7a, 0x50, 0x3b,
-	0xf9, 0xd1, 0xe1, 0x62, 0xde, 0x0e, 0x5f, 0xc9, 0xa8, 0x57, 0x2a, 0xa8, 0xf3, 0xe2, 0x87, 0xc2,
-	0x5d, 0x19, 0x17, 0xee, 0x8e, 0xa6, 0x9e, 0x25, 0xf0, 0x50, 0x2d, 0x98, 0x46, 0xc2, 0x13, 0xf0,
-	0x0c, 0x27, 0x09, 0x7d, 0xeb, 0xe7, 0x99, 0x4c, 0x34, 0x09, 0x04, 0x09, 0x7d, 0x31, 0xe4, 0xed,
-	0x0d, 0xb9, 0x49, 0xb4, 0xa3, 0x9c, 0xdf, 0x4c, 0x7d, 0x37, 0x43, 0xee, 0xfd, 0x7d, 0x1b, 0xd4,
-	0x66, 0xd4, 0x60, 0x0a, 0x9a, 0x3d, 0x9a, 0x12, 0x2e, 0x08, 0x0e, 0xfd, 0x6e, 0x42, 0x83, 0x5b,
-	0x73, 0x2c, 0xaf, 0xff, 0x55, 0xb8, 0x3f, 0x89, 0x62, 0xd1, 0xcb, 0xbb, 0x87, 0x01, 0x4d, 0x8f,
-	0x02, 0xca, 0x53, 0xca, 0xcd, 0x9f, 0x8f, 0x79, 0x78, 0x7b, 0x24, 0x46, 0x7d, 0xc2, 0x0f, 0x2f,
-	0x32, 0x31, 0x2e, 0xdc, 0x5d, 0xbd, 0xd8, 0x05, 0x2a, 0x0f, 0x35, 0x4a, 0x4b, 0x47, 0x1a, 0xe0,
-	0x08, 0x34, 0x42, 0x4c, 0xfd, 0x6f, 0x29, 0xbb, 0x35, 0x6a, 0x6b, 0x4a, 0xed, 0xfa, 0xff, 0x57,
-	0xbb, 0x2b, 0xdc, 0xfa, 0xeb, 0xb3, 0xdf, 0x7c, 0x41, 0xd9, 0xad, 0xe2, 0x1c, 0x17, 0xee, 0x33,
-	0xad, 0x3e, 0xcf, 0xec, 0xa1, 0x7a, 0x88, 0x69, 0x19, 0x06, 0x7f, 0x0b, 0x9c, 0x32, 0x80, 0xe7,
-	0xfd, 0x3e, 0x65, 0xc2, 0x54, 0xc3, 0xc7, 0x77, 0x85, 0xdb, 0x30, 0x94, 0xd7, 0xda, 0x33, 0x2e,
-	0xdc, 0x0f, 0x16, 0x48, 0xcd, 0x1c, 0x0f, 0x35, 0x0c, 0xad, 0x09, 0x85, 0x1c, 0xd4, 0x49, 0xdc,
-	0x3f, 0x3e, 0xfd, 0xc4, 0xec, 0xc8, 0x52, 0x3b, 0xba, 0x7a, 0xd2, 0x8e, 0x6a, 0xe7, 0x17, 0x57,
-	0xc7, 0xa7, 0x9f, 0x4c, 0x36, 0x64, 0xce, 0x7e, 0x96, 0xd6, 0x43, 0x35, 0x0d, 0xf5, 0x6e, 0x2e,
-	0x80, 0x81, 0x7e, 0x0f, 0xf3, 0x9e, 0xaa, 0xac, 0x6a, 0xe7, 0xe0, 0xae, 0x70, 0x81, 0x66, 0xfa,
-	0x0a, 0xf3, 0xde, 0xf4, 0x5c, 0xba, 0xa3, 0x3f, 0xe0, 0x4c, 0xc4, 0x79, 0x3a, 0xe1, 0x02, 0x7a,
-	0xb2, 0x8c, 0x2a, 0xd7, 0x7f, 0x6a, 0xd6, 0xbf, 0xb1, 0xf4, 0xfa, 0x4f, 0x1f, 0x5b, 0xff, 0xe9,
-	0xfc, 0xfa, 0x75, 0x4c, 0x29, 0xfa, 0xd2, 0x88, 0x6e, 0x2e, 0x2d, 0xfa, 0xf2, 0x31, 0xd1, 0x97,
-	0xf3, 0xa2, 0x3a, 0x46, 0x16, 0xfb, 0x42, 0x26, 0xda, 0xf6, 0xf2, 0xc5, 0xfe, 0x20, 0xa9, 0x8d,
-	0xd2, 0xa2, 0xe5, 0xfe, 0x04, 0x5a, 0x01, 0xcd, 0xb8, 0x90, 0xb6, 0x8c, 0xf6, 0x13, 0x62, 0x34,
-	0xab, 0x4a, 0xf3, 0xe2, 0x49, 0x9a, 0x2f, 0xcc, 0xaf, 0xc1, 0x23, 0x7c, 0x1e, 0xda, 0x99, 0x37,
-	0x6b, 0xf5, 0x3e, 0x70, 0xfa, 0x44, 0x10, 0xc6, 0xbb, 0x39, 0x8b, 0x8c, 0x32, 0x50, 0xca, 0xe7,
-	0x4f, 0x52, 0x36, 0xf7, 0x60, 0x91, 0xcb, 0x43, 0xcd, 0xa9, 0x49, 0x2b, 0x7e, 0x07, 0x1a, 0xb1,
-	0x5c, 0x46, 0x37, 0x4f, 0x8c, 0x5e, 0x4d, 0xe9, 0xbd, 0x7a, 0x92, 0x9e, 0xb9, 0xcc, 0xf3, 0x4c,
-	0x1e, 0xda, 0x9a, 0x18, 0xb4, 0x56, 0x0e, 0x60, 0x9a, 0xc7, 0xcc, 0x8f, 0x12, 0x1c, 0xc4, 0x84,
-	0x19, 0xbd, 0xba, 0xd2, 0xfb, 0xf2, 0x49, 0x7a, 0x1f, 0x6a, 0xbd, 0x87, 0x6c, 0x1e, 0x72, 0xa4,
-	0xf1, 0x4b, 0x6d, 0xd3, 0xb2, 0x21, 0xa8, 0x77, 0x09, 0x4b, 0xe2, 0xcc, 0x08, 0x6e, 0x29, 0xc1,
-	0xb3, 0x27, 0x09, 0x9a, 0x3a, 0x9d, 0xe5, 0xf1, 0x50, 0x4d, 0xc3, 0x52, 0x25, 0xa1, 0x59, 0x48,
-	0x27, 0x2a, 0xdb, 0xcb, 0xab, 0xcc, 0xf2, 0x78, 0xa8, 0xa6, 0xa1, 0x56, 0x19, 0x82, 0x1d, 0xcc,
-	0x18, 0x7d, 0xbb, 0x90, 0x43, 0xa8, 0xc4, 0xbe, 0x7a, 0x92, 0xd8, 0x73, 0x2d, 0xf6, 0x08, 0x9d,
-	0x87, 0xb6, 0x95, 0x75, 0x2e, 0x8b, 0x39, 0x80, 0x11, 0xc3, 0xa3, 0x05, 0xe1, 0xd6, 0xf2, 0x87,
-	0xf7, 0x90, 0xcd, 0x43, 0x8e, 0x34, 0xce, 0xc9, 0xfe, 0x11, 0xb4, 0x52, 0xc2, 0x22, 0xe2, 0x67,
-	0x44, 0xf0, 0x7e, 0x12, 0x0b, 0x23, 0xfc, 0x6c, 0xf9, 0xfb, 0xf8, 0x18, 0x9f, 0x87, 0xa0, 0x32,
-	0x7f, 0x6d, 0xac, 0xe5, 0xe5, 0xe0, 0x3d, 0x9c, 0x45, 0x3d, 0x1c, 0x1b, 0xd9, 0xdd, 0xe5, 0x2f,
-	0xc7, 0x3c, 0x93, 0x87, 0xb6, 0x26, 0x86, 0xb2, 0x7e, 0x02, 0x9c, 0x05, 0xf9, 0xa4, 0x7e, 0x3e,
-	0x58, 0xbe, 0x7e, 0x66, 0x79, 0x64, 0xfb, 0xa1, 0xa0, 0x52, 0xb9, 0xb4, 0xec, 0x86, 0xd3, 0xbc,
-	0xb4, 0xec, 0xa6, 0xe3, 0x5c, 0x5a, 0xb6, 0xe3, 0x6c, 0x5f, 0x5a, 0xf6, 0x8e, 0xd3, 0x42, 0x5b,
-	0x23, 0x9a, 0x50, 0x7f, 0xf0, 0xa9, 0x9e, 0x84, 0x6a, 0xe4, 0x2d, 0xe6, 0xe6, 0x37, 0x12, 0x35,
-	0x02, 0x2c, 0x70, 0x32, 0xe2, 0x26, 0x55, 0xc8, 0xd1, 0x09, 0x9c, 0x79, 0xb5, 0x8f, 0xc0, 0xfa,
-	0xb5, 0x90, 0x8d, 0x9b, 0x03, 0x2a, 0xb7, 0x64, 0xa4, 0xbb, 0x11, 0x24, 0x87, 0xb0, 0x05, 0xd6,
-	0x07, 0x38, 0xc9, 0x75, 0x07, 0x58, 0x45, 0x1a, 0x78, 0x57, 0xa0, 0x79, 0xc3, 0x70, 0xc6, 0x71,
-	0x20, 0x62, 0x9a, 0xbd, 0xa1, 0x11, 0x87, 0x10, 0x58, 0xea, 0x55, 0xd4, 0x73, 0xd5, 0x18, 0xfe,
-	0x14, 0x58, 0x09, 0x8d, 0x78, 0x7b, 0x6d, 0xbf, 0x72, 0x50, 0x3b, 0x79, 0xf6, 0xb0, 0x07, 0x7b,
-	0x43, 0x23, 0xa4, 0x42, 0xbc, 0x7f, 0xac, 0x81, 0xca, 0x1b, 0x1a, 0xc1, 0x36, 0xd8, 0xc4, 0x61,
-	0xc8, 0x08, 0xe7, 0x86, 0x69, 0x02, 0xe1, 0x2e, 0xd8, 0x10, 0xb4, 0x1f, 0x07, 0x9a, 0xae, 0x8a,
-	0x0c, 0x92, 0xc2, 0x21, 0x16, 0x58, 0xf5, 0x15, 0x75, 0xa4, 0xc6, 0xf0, 0x04, 0xd4, 0xd5, 0xce,
-	0xfc, 0x2c, 0x4f, 0xbb, 0x84, 0xa9, 0xf6, 0xc0, 0xea, 0x34, 0xef, 0x0b, 0xb7, 0xa6, 0xec, 0x5f,
-	0x2b, 0x33, 0x9a, 0x05, 0xf0, 0x23, 0xb0, 0x29, 0x86, 0xb3, 0x2f, 0xfb, 0xce, 0x7d, 0xe1, 0x36,
-	0xc5, 0x74, 0x9b, 0xf2, 0xe1, 0x46, 0x1b, 0x62, 0xa8, 0x1e, 0xf0, 0x23, 0x60, 0x8b, 0xa1, 0x1f,
-	0x67, 0x21, 0x19, 0xaa, 0xc7, 0xdb, 0xea, 0xb4, 0xee, 0x0b, 0xd7, 0x99, 0x09, 0xbf, 0x90, 0x3e,
-	0xb4, 0x29, 0x86, 0x6a, 0x00, 0x3f, 0x02, 0x40, 0x2f, 0x49, 0x29, 0xe8, 0xa7, 0x77, 0xeb, 0xbe,
-	0x70, 0xab, 0xca, 0xaa, 0xb8, 0xa7, 0x43, 0xe8, 0x81, 0x75, 0xcd, 0x6d, 0x2b, 0xee, 0xfa, 0x7d,
-	0xe1, 0xda, 0x09, 0x8d, 0x34, 0xa7, 0x76, 0xc9, 0x54, 0x31, 0x92, 0xd2, 0x01, 0x09, 0xd5, 0xeb,
-	0x66, 0xa3, 0x09, 0xf4, 0xfe, 0xba, 0x06, 0xec, 0x9b, 0x21, 0x22, 0x3c, 0x4f, 0x04, 0xfc, 0x02,
-	0x38, 0x01, 0xcd, 0x04, 0xc3, 0x81, 0xf0, 0xe7, 0x52, 0xdb, 0x79, 0x31, 0x7d, 0x69, 0x16, 0x23,
-	0x3c, 0xd4, 0x9c, 0x98, 0xce, 0x4c, 0xfe, 0x5b, 0x60, 0xbd, 0x9b, 0x50, 0x9a, 0xaa, 0x4a, 0xa8,
-	0x23, 0x0d, 0x20, 0x52, 0x59, 0x53, 0xa7, 0x5c, 0x51, 0x9d, 0xf6, 0x8f, 0x1f, 0x9e, 0xf2, 0x42,
-	0xa9, 0x74, 0x76, 0x4d, 0xb7, 0xdd, 0xd0, 0xda, 0x66, 0xbe, 0x27, 0x73, 0xab, 0x4a, 0xc9, 0x01,
-	0x15, 0x46, 0x84, 0x3a, 0xb4, 0x3a, 0x92, 0x43, 0xf8, 0x1c, 0xd8, 0x8c, 0x0c, 0x08, 0x13, 0x24,
-	0x54, 0x87, 0x63, 0xa3, 0x12, 0xc3, 0x0f, 0x81, 0x1d, 0x61, 0xee, 0xe7, 0x9c, 0x84, 0xfa, 0x24,
-	0xd0, 0x66, 0x84, 0xf9, 0x37, 0x9c, 0x84, 0x9f, 0x59, 0x7f, 0xfe, 0xde, 0x5d, 0xf1, 0x30, 0xa8,
-	0x9d, 0x05, 0x01, 0xe1, 0xfc, 0x26, 0xef, 0x27, 0xe4, 0xbf, 0x54, 0xd8, 0x09, 0xa8, 0x73, 0x41,
-	0x19, 0x8e, 0x88, 0x7f, 0x4b, 0x46, 0xa6, 0xce, 0x74, 0xd5, 0x18, 0xfb, 0xaf, 0xc8, 0x88, 0xa3,
-	0x59, 0x60, 0x24, 0xbe, 0xb7, 0x40, 0xed, 0x86, 0xe1, 0x80, 0x98, 0x0e, 0x5f, 0xd6, 0xaa, 0x84,
-	0xcc, 0x48, 0x18, 0x24, 0xb5, 0x45, 0x9c, 0x12, 0x9a, 0x0b, 0x73, 0x9f, 0x26, 0x50, 0xce, 0x60,
-	0x84, 0x0c, 0x49, 0xa0, 0xd2, 0x68, 0x21, 0x83, 0xe0, 0x29, 0xd8, 0x0a, 0x63, 0xae, 0x3e, 0x97,
-	0xb8, 0xc0, 0xc1, 0xad, 0xde, 0x7e, 0xc7, 0xb9, 0x2f, 0xdc, 0xba, 0x71, 0x5c, 0x4b, 0x3b, 0x9a,
-	0x43, 0xf0, 0x73, 0xd0, 0x9c, 0x4e, 0x53, 0xab, 0xd5, 0x1f, 0x28, 0x1d, 0x78, 0x5f, 0xb8, 0x8d,
-	0x32, 0x54, 0x79, 0xd0, 0x02, 0x96, 0x27, 0x1d, 0x92, 0x6e, 0x1e, 0xa9, 0xe2, 0xb3, 0x91, 0x06,
-	0xd2, 0x9a, 0xc4, 0x69, 0x2c, 0x54, 0xb1, 0xad, 0x23, 0x0d, 0xe0, 0xe7, 0xa0, 0x4a, 0x07, 0x84,
-	0xb1, 0x38, 0x24, 0x5c, 0xb5, 0x3a, 0xff, 0xeb, 0x5b, 0x0b, 0x4d, 0xe3, 0xe5, 0xe6, 0xcc, 0xa7,
-	0x60, 0x4a, 0x52, 0xca, 0x46, 0xaa, 0x77, 0x31, 0x9b, 0xd3, 0x8e, 0x5f, 0x2b, 0x3b, 0x9a, 0x43,
-	0xb0, 0x03, 0xa0, 0x99, 0xc6, 0x88, 0xc8, 0x59, 0xe6, 0xab, 0xfb, 0x5f, 0x57, 0x73, 0xd5, 0x2d,
-	0xd4, 0x5e, 0xa4, 0x9c, 0xaf, 0xb1, 0xc0, 0xe8, 0x81, 0x05, 0xfe, 0x02, 0x40, 0x7d, 0x26, 0xfe,
-	0x77, 0x9c, 0x96, 0x1f, 0x8b, 0xba, 0xb5, 0x50, 0xfa, 0xda, 0x6b, 0xd6, 0xec, 0x68, 0x74, 0xc9,
-	0xa9, 0xd9, 0xc5, 0xa5, 0x65, 0x5b, 0xce, 0xfa, 0xa5, 0x65, 0x6f, 0x3a, 0x76, 0x99, 0x3f, 0xb3,
-	0x0b, 0xb4, 0x33, 0xc1, 0x33, 0xcb, 0xeb, 0xfc, 0xf2, 0x87, 0xbb, 0xbd, 0xd5, 0x77, 0x77, 0x7b,
-	0xab, 0xff, 0xbe, 0xdb, 0x5b, 0xfd, 0xdb, 0xfb, 0xbd, 0x95, 0x77, 0xef, 0xf7, 0x56, 0xfe, 0xf9,
-	0x7e, 0x6f, 0xe5, 0x77, 0xb3, 0xef, 0x03, 0x19, 0xc8, 0xe7, 0x61, 0xfa, 0xfd, 0x3f, 0x54, 0xff,
-	0x01, 0x50, 0x6f, 0x44, 0x77, 0x43, 0x7d, 0xd9, 0x7f, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe2, 0xe1, 0x18, 0x7a, 0x1f, 0x10, 0x00, 0x00,
+	// 1681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4d, 0x6f, 0xe3, 0xc6,
+	0x19, 0xb6, 0x2d, 0xda, 0xa6, 0x46, 0x5f, 0xf4, 0x58, 0xeb, 0x28, 0xbb, 0xa8, 0xe9, 0xf2, 0x50,
+	0xb8, 0x40, 0x62, 0xc7, 0x0e, 0x8c, 0x6e, 0x13, 0xb4, 0xa8, 0xb5, 0xeb, 0x24, 0x76, 0xb6, 0xa9,
+	0x31, 0xeb, 0xa0, 0x40, 0x81, 0x82, 0x18, 0x91, 0x13, 0x8a, 0x31, 0xc9, 0x11, 0x66, 0x86, 0x5a,
+	0xa9, 0xed, 0x0f, 0x68, 0xd1, 0x4b, 0x7f, 0x41, 0x91, 0x9f, 0xd0, 0x9f, 0x11, 0xf4, 0x94, 0x63,
+	0xd1, 0x03, 0x51, 0x78, 0x6f, 0x3e, 0xfa, 0x17, 0x14, 0xf3, 0x21, 0x89, 0x92, 0x8d, 0x60, 0xad,
+	0x93, 0xe6, 0x79, 0x3f, 0x9e, 0x67, 0xe6, 0x9d, 0x97, 0x9c, 0xa1, 0xc0, 0x53, 0x22, 0xfa, 0x84,
+	0xa5, 0x71, 0x26, 0x0e, 0xc9, 0x30, 0x3d, 0x1c, 0x1e, 0xc9, 0x9f, 0x83, 0x01, 0xa3, 0x82, 0x42,
+	0x67, 0xea, 0x3b, 0x90, 0xc6, 0xe1, 0xd1, 0xd3, 0x76, 0x44, 0x23, 0xaa, 0x9c, 0x87, 0x72, 0xa4,
+	0xe3, 0xbc, 0xbf, 0x55, 0xc0, 0xc6, 0x25, 0x66, 0x38, 0xe5, 0xf0, 0x08, 0x54, 0xc9, 0x30, 0xf5,
+	0x43, 0x92, 0xd1, 0xb4, 0xb3, 0xba, 0xb7, 0xba, 0x5f, 0xed, 0xb6, 0xef, 0x0a, 0xd7, 0x19, 0xe3,
+	0x34, 0xf9, 0xc4, 0x9b, 0xba, 0x3c, 0x64, 0x93, 0x61, 0xfa, 0x52, 0x0e, 0xe1, 0xaf, 0x40, 0x83,
+	0x64, 0xb8, 0x97, 0x10, 0x3f, 0x60, 0x04, 0x0b, 0xd2, 0x59, 0xdb, 0x5b, 0xdd, 0xb7, 0xbb, 0x9d,
+	0xbb, 0xc2, 0x6d, 0x9b, 0xb4, 0xb2, 0xdb, 0x43, 0x75, 0x8d, 0x5f, 0x28, 0x08, 0x7f, 0x01, 0x6a,
+	0x13, 0x3f, 0x4e, 0x92, 0x4e, 0x45, 0x25, 0xef, 0xdc, 0x15, 0x2e, 0x9c, 0x4f, 0xc6, 0x49, 0xe2,
+	0x21, 0x60, 0x52, 0x71, 0x92, 0xc0, 0x53, 0x00, 0xc8, 0x48, 0x30, 0xec, 0x93, 0x78, 0xc0, 0x3b,
+	0xd6, 0x5e, 0x65, 0xbf, 0xd2, 0xf5, 0x6e, 0x0a, 0xb7, 0x7a, 0x26, 0xad, 0x67, 0xe7, 0x97, 0xfc,
+	0xae, 0x70, 0xb7, 0x0c, 0xc9, 0x34, 0xd0, 0x43, 0x55, 0x05, 0xce, 0xe2, 0x01, 0x87, 0x7f, 0x04,
+	0xf5, 0xa0, 0x8f, 0xe3, 0xcc, 0x0f, 0x68, 0xf6, 0x4d, 0x1c, 0x75, 0xd6, 0xf7, 0x56, 0xf7, 0x6b,
+	0xc7, 0x3f, 0x39, 0x58, 0xac, 0xdb, 0xc1, 0x0b, 0x19, 0xf5, 0x42, 0x05, 0x75, 0x9f, 0x7d, 0x5f,
+	0xb8, 0x2b, 0x77, 0x85, 0xbb, 0xad, 0xa9, 0xcb, 0x04, 0x1e, 0xaa, 0x05, 0xb3, 0x48, 0x78, 0x0c,
+	0x9e, 0xe0, 0x24, 0xa1, 0x6f, 0xfc, 0x3c, 0x93, 0x85, 0x26, 0x81, 0x20, 0xa1, 0x2f, 0x46, 0xbc,
+	0xb3, 0x21, 0x17, 0x89, 0xb6, 0x95, 0xf3, 0xeb, 0x99, 0xef, 0x6a, 0xc4, 0xbd, 0x7f, 0x6e, 0x81,
+	0x5a, 0x49, 0x0d, 0xa6, 0xa0, 0xd5, 0xa7, 0x29, 0xe1, 0x82, 0xe0, 0xd0, 0xef, 0x25, 0x34, 0xb8,
+	0x36, 0xdb, 0xf2, 0xf2, 0xbf, 0x85, 0xfb, 0xb3, 0x28, 0x16, 0xfd, 0xbc, 0x77, 0x10, 0xd0, 0xf4,
+	0x30, 0xa0, 0x3c, 0xa5, 0xdc, 0xfc, 0x7c, 0xc8, 0xc3, 0xeb, 0x43, 0x31, 0x1e, 0x10, 0x7e, 0x70,
+	0x9e, 0x89, 0xbb, 0xc2, 0xdd, 0xd1, 0x93, 0x5d, 0xa0, 0xf2, 0x50, 0x73, 0x6a, 0xe9, 0x4a, 0x03,
+	0x1c, 0x83, 0x66, 0x88, 0xa9, 0xff, 0x0d, 0x65, 0xd7, 0x46, 0x6d, 0x4d, 0xa9, 0xbd, 0x7e, 0x77,
+	0xb5, 0x9b, 0xc2, 0xad, 0xbf, 0x3c, 0xfd, 0xdd, 0x67, 0x94, 0x5d, 0x2b, 0xce, 0xbb, 0xc2, 0x7d,
+	0xa2, 0xd5, 0xe7, 0x99, 0x3d, 0x54, 0x0f, 0x31, 0x9d, 0x86, 0xc1, 0xdf, 0x03, 0x67, 0x1a, 0xc0,
+	0xf3, 0xc1, 0x80, 0x32, 0x61, 0xba, 0xe1, 0xc3, 0x9b, 0xc2, 0x6d, 0x1a, 0xca, 0xd7, 0xda, 0x73,
+	0x57, 0xb8, 0xef, 0x2d, 0x90, 0x9a, 0x1c, 0x0f, 0x35, 0x0d, 0xad, 0x09, 0x85, 0x1c, 0xd4, 0x49,
+	0x3c, 0x38, 0x3a, 0xf9, 0xc8, 0xac, 0xc8, 0x52, 0x2b, 0xba, 0x7c, 0xd4, 0x8a, 0x6a, 0x67, 0xe7,
+	0x97, 0x47, 0x27, 0x1f, 0x4d, 0x16, 0x64, 0xf6, 0xbe, 0x4c, 0xeb, 0xa1, 0x9a, 0x86, 0x7a, 0x35,
+	0xe7, 0xc0, 0x40, 0xbf, 0x8f, 0x79, 0x5f, 0x75, 0x56, 0xb5, 0xbb, 0x7f, 0x53, 0xb8, 0x40, 0x33,
+	0x7d, 0x81, 0x79, 0x7f, 0xb6, 0x2f, 0xbd, 0xf1, 0x9f, 0x70, 0x26, 0xe2, 0x3c, 0x9d, 0x70, 0x01,
+	0x9d, 0x2c, 0xa3, 0xa6, 0xf3, 0x3f, 0x31, 0xf3, 0xdf, 0x58, 0x7a, 0xfe, 0x27, 0x0f, 0xcd, 0xff,
+	0x64, 0x7e, 0xfe, 0x3a, 0x66, 0x2a, 0xfa, 0xdc, 0x88, 0x6e, 0x2e, 0x2d, 0xfa, 0xfc, 0x21, 0xd1,
+	0xe7, 0xf3, 0xa2, 0x3a, 0x46, 0x36, 0xfb, 0x42, 0x25, 0x3a, 0xf6, 0xf2, 0xcd, 0x7e, 0xaf, 0xa8,
+	0xcd, 0xa9, 0x45, 0xcb, 0xfd, 0x05, 0xb4, 0x03, 0x9a, 0x71, 0x21, 0x6d, 0x19, 0x1d, 0x24, 0xc4,
+	0x68, 0x56, 0x95, 0xe6, 0xf9, 0xa3, 0x34, 0x9f, 0x99, 0xb7, 0xc1, 0x03, 0x7c, 0x1e, 0xda, 0x9e,
+	0x37, 0x6b, 0xf5, 0x01, 0x70, 0x06, 0x44, 0x10, 0xc6, 0x7b, 0x39, 0x8b, 0x8c, 0x32, 0x50, 0xca,
+	0x67, 0x8f, 0x52, 0x36, 0xcf, 0xc1, 0x22, 0x97, 0x87, 0x5a, 0x33, 0x93, 0x56, 0xfc, 0x16, 0x34,
+	0x63, 0x39, 0x8d, 0x5e, 0x9e, 0x18, 0xbd, 0x9a, 0xd2, 0x7b, 0xf1, 0x28, 0x3d, 0xf3, 0x30, 0xcf,
+	0x33, 0x79, 0xa8, 0x31, 0x31, 0x68, 0xad, 0x1c, 0xc0, 0x34, 0x8f, 0x99, 0x1f, 0x25, 0x38, 0x88,
+	0x09, 0x33, 0x7a, 0x75, 0xa5, 0xf7, 0xf9, 0xa3, 0xf4, 0xde, 0xd7, 0x7a, 0xf7, 0xd9, 0x3c, 0xe4,
+	0x48, 0xe3, 0xe7, 0xda, 0xa6, 0x65, 0x43, 0x50, 0xef, 0x11, 0x96, 0xc4, 0x99, 0x11, 0x6c, 0x28,
+	0xc1, 0xd3, 0x47, 0x09, 0x9a, 0x3e, 0x2d, 0xf3, 0x78, 0xa8, 0xa6, 0xe1, 0x54, 0x25, 0xa1, 0x59,
+	0x48, 0x27, 0x2a, 0x5b, 0xcb, 0xab, 0x94, 0x79, 0x3c, 0x54, 0xd3, 0x50, 0xab, 0x8c, 0xc0, 0x36,
+	0x66, 0x8c, 0xbe, 0x59, 0xa8, 0x21, 0x54, 0x62, 0x5f, 0x3c, 0x4a, 0xec, 0xa9, 0x16, 0x7b, 0x80,
+	0xce, 0x43, 0x5b, 0xca, 0x3a, 0x57, 0xc5, 0x1c, 0xc0, 0x88, 0xe1, 0xf1, 0x82, 0x70, 0x7b, 0xf9,
+	0xcd, 0xbb, 0xcf, 0xe6, 0x21, 0x47, 0x1a, 0xe7, 0x64, 0xff, 0x0c, 0xda, 0x29, 0x61, 0x11, 0xf1,
+	0x33, 0x22, 0xf8, 0x20, 0x89, 0x85, 0x11, 0x7e, 0xb2, 0xfc, 0xf3, 0xf8, 0x10, 0x9f, 0x87, 0xa0,
+	0x32, 0x7f, 0x65, 0xac, 0xd3, 0x87, 0x83, 0xf7, 0x71, 0x16, 0xf5, 0x71, 0x6c, 0x64, 0x77, 0x96,
+	0x7f, 0x38, 0xe6, 0x99, 0x3c, 0xd4, 0x98, 0x18, 0xa6, 0xfd, 0x13, 0xe0, 0x2c, 0xc8, 0x27, 0xfd,
+	0xf3, 0xde, 0xf2, 0xfd, 0x53, 0xe6, 0x91, 0xd7, 0x0f, 0x05, 0x95, 0xca, 0x85, 0x65, 0x37, 0x9d,
+	0xd6, 0x85, 0x65, 0xb7, 0x1c, 0xe7, 0xc2, 0xb2, 0x1d, 0x67, 0xeb, 0xc2, 0xb2, 0xb7, 0x9d, 0x36,
+	0x6a, 0x8c, 0x69, 0x42, 0xfd, 0xe1, 0xc7, 0x3a, 0x09, 0xd5, 0xc8, 0x1b, 0xcc, 0xcd, 0x3b, 0x12,
+	0x35, 0x03, 0x2c, 0x70, 0x32, 0xe6, 0xa6, 0x54, 0xc8, 0xd1, 0x05, 0x2c, 0x9d, 0xda, 0x87, 0x60,
+	0xfd, 0xb5, 0x90, 0x17, 0x37, 0x07, 0x54, 0xae, 0xc9, 0x58, 0xdf, 0x46, 0x90, 0x1c, 0xc2, 0x36,
+	0x58, 0x1f, 0xe2, 0x24, 0xd7, 0x37, 0xc0, 0x2a, 0xd2, 0xc0, 0xbb, 0x04, 0xad, 0x2b, 0x86, 0x33,
+	0x8e, 0x03, 0x11, 0xd3, 0xec, 0x15, 0x8d, 0x38, 0x84, 0xc0, 0x52, 0xa7, 0xa2, 0xce, 0x55, 0x63,
+	0xf8, 0x73, 0x60, 0x25, 0x34, 0xe2, 0x9d, 0xb5, 0xbd, 0xca, 0x7e, 0xed, 0xf8, 0xc9, 0xfd, 0x3b,
+	0xd8, 0x2b, 0x1a, 0x21, 0x15, 0xe2, 0xfd, 0x7b, 0x0d, 0x54, 0x5e, 0xd1, 0x08, 0x76, 0xc0, 0x26,
+	0x0e, 0x43, 0x46, 0x38, 0x37, 0x4c, 0x13, 0x08, 0x77, 0xc0, 0x86, 0xa0, 0x83, 0x38, 0xd0, 0x74,
+	0x55, 0x64, 0x90, 0x14, 0x0e, 0xb1, 0xc0, 0xea, 0x5e, 0x51, 0x47, 0x6a, 0x0c, 0x8f, 0x41, 0x5d,
+	0xad, 0xcc, 0xcf, 0xf2, 0xb4, 0x47, 0x98, 0xba, 0x1e, 0x58, 0xdd, 0xd6, 0x6d, 0xe1, 0xd6, 0x94,
+	0xfd, 0x2b, 0x65, 0x46, 0x65, 0x00, 0x3f, 0x00, 0x9b, 0x62, 0x54, 0x3e, 0xd9, 0xb7, 0x6f, 0x0b,
+	0xb7, 0x25, 0x66, 0xcb, 0x94, 0x07, 0x37, 0xda, 0x10, 0x23, 0xf9, 0x0b, 0x0f, 0x81, 0x2d, 0x46,
+	0x7e, 0x9c, 0x85, 0x64, 0xa4, 0x0e, 0x6f, 0xab, 0xdb, 0xbe, 0x2d, 0x5c, 0xa7, 0x14, 0x7e, 0x2e,
+	0x7d, 0x68, 0x53, 0x8c, 0xd4, 0x00, 0x7e, 0x00, 0x80, 0x9e, 0x92, 0x52, 0xd0, 0x47, 0x6f, 0xe3,
+	0xb6, 0x70, 0xab, 0xca, 0xaa, 0xb8, 0x67, 0x43, 0xe8, 0x81, 0x75, 0xcd, 0x6d, 0x2b, 0xee, 0xfa,
+	0x6d, 0xe1, 0xda, 0x09, 0x8d, 0x34, 0xa7, 0x76, 0xc9, 0x52, 0x31, 0x92, 0xd2, 0x21, 0x09, 0xd5,
+	0xe9, 0x66, 0xa3, 0x09, 0xf4, 0xfe, 0xbe, 0x06, 0xec, 0xab, 0x11, 0x22, 0x3c, 0x4f, 0x04, 0xfc,
+	0x0c, 0x38, 0x01, 0xcd, 0x04, 0xc3, 0x81, 0xf0, 0xe7, 0x4a, 0xdb, 0x7d, 0x36, 0x3b, 0x69, 0x16,
+	0x23, 0x3c, 0xd4, 0x9a, 0x98, 0x4e, 0x4d, 0xfd, 0xdb, 0x60, 0xbd, 0x97, 0x50, 0x9a, 0xaa, 0x4e,
+	0xa8, 0x23, 0x0d, 0x20, 0x52, 0x55, 0x53, 0xbb, 0x5c, 0x51, 0x37, 0xed, 0x9f, 0xde, 0xdf, 0xe5,
+	0x85, 0x56, 0xe9, 0xee, 0x98, 0xdb, 0x76, 0x53, 0x6b, 0x9b, 0x7c, 0x4f, 0xd6, 0x56, 0xb5, 0x92,
+	0x03, 0x2a, 0x8c, 0x08, 0xb5, 0x69, 0x75, 0x24, 0x87, 0xf0, 0x29, 0xb0, 0x19, 0x19, 0x12, 0x26,
+	0x48, 0xa8, 0x36, 0xc7, 0x46, 0x53, 0x0c, 0xdf, 0x07, 0x76, 0x84, 0xb9, 0x9f, 0x73, 0x12, 0xea,
+	0x9d, 0x40, 0x9b, 0x11, 0xe6, 0x5f, 0x73, 0x12, 0x7e, 0x62, 0xfd, 0xf5, 0x3b, 0x77, 0xc5, 0xc3,
+	0xa0, 0x76, 0x1a, 0x04, 0x84, 0xf3, 0xab, 0x7c, 0x90, 0x90, 0x1f, 0xe9, 0xb0, 0x63, 0x50, 0xe7,
+	0x82, 0x32, 0x1c, 0x11, 0xff, 0x9a, 0x8c, 0x4d, 0x9f, 0xe9, 0xae, 0x31, 0xf6, 0x2f, 0xc9, 0x98,
+	0xa3, 0x32, 0x30, 0x12, 0xdf, 0x59, 0xa0, 0x76, 0xc5, 0x70, 0x40, 0xcc, 0x0d, 0x5f, 0xf6, 0xaa,
+	0x84, 0xcc, 0x48, 0x18, 0x24, 0xb5, 0x45, 0x9c, 0x12, 0x9a, 0x0b, 0xf3, 0x3c, 0x4d, 0xa0, 0xcc,
+	0x60, 0x84, 0x8c, 0x48, 0xa0, 0xca, 0x68, 0x21, 0x83, 0xe0, 0x09, 0x68, 0x84, 0x31, 0x57, 0x9f,
+	0x4b, 0x5c, 0xe0, 0xe0, 0x5a, 0x2f, 0xbf, 0xeb, 0xdc, 0x16, 0x6e, 0xdd, 0x38, 0x5e, 0x4b, 0x3b,
+	0x9a, 0x43, 0xf0, 0x53, 0xd0, 0x9a, 0xa5, 0xa9, 0xd9, 0xea, 0x0f, 0x94, 0x2e, 0xbc, 0x2d, 0xdc,
+	0xe6, 0x34, 0x54, 0x79, 0xd0, 0x02, 0x96, 0x3b, 0x1d, 0x92, 0x5e, 0x1e, 0xa9, 0xe6, 0xb3, 0x91,
+	0x06, 0xd2, 0x9a, 0xc4, 0x69, 0x2c, 0x54, 0xb3, 0xad, 0x23, 0x0d, 0xe0, 0xa7, 0xa0, 0x4a, 0x87,
+	0x84, 0xb1, 0x38, 0x24, 0xbc, 0x03, 0xde, 0xe1, 0x5b, 0x0b, 0xcd, 0xe2, 0xe5, 0xe2, 0xcc, 0xa7,
+	0x60, 0x4a, 0x52, 0xca, 0xc6, 0x9d, 0xda, 0x6c, 0x71, 0xda, 0xf1, 0x5b, 0x65, 0x47, 0x73, 0x08,
+	0x76, 0x01, 0x34, 0x69, 0x8c, 0x88, 0x9c, 0x65, 0xbe, 0x7a, 0xfe, 0xeb, 0x2a, 0x57, 0x3d, 0x85,
+	0xda, 0x8b, 0x94, 0xf3, 0x25, 0x16, 0x18, 0xdd, 0xb3, 0xc0, 0x5f, 0x03, 0xa8, 0xf7, 0xc4, 0xff,
+	0x96, 0xd3, 0xe9, 0xc7, 0xa2, 0xbe, 0x5a, 0x28, 0x7d, 0xed, 0x35, 0x73, 0x76, 0x34, 0xba, 0xe0,
+	0xd4, 0xac, 0xe2, 0xc2, 0xb2, 0x2d, 0x67, 0xfd, 0xc2, 0xb2, 0x37, 0x1d, 0x7b, 0x5a, 0x3f, 0xb3,
+	0x0a, 0xb4, 0x3d, 0xc1, 0xa5, 0xe9, 0x79, 0xff, 0x5a, 0x05, 0xeb, 0x67, 0x03, 0x1a, 0xf4, 0x65,
+	0x9b, 0x11, 0x39, 0x98, 0xbc, 0x9c, 0x64, 0x8b, 0x34, 0x74, 0x9b, 0x29, 0xfb, 0xe4, 0xe5, 0x54,
+	0x02, 0xf0, 0x39, 0x68, 0x72, 0x81, 0x99, 0x88, 0xb3, 0xa8, 0xf4, 0x0d, 0x67, 0x75, 0xb7, 0x6e,
+	0x0b, 0xb7, 0x31, 0xf1, 0xa8, 0x23, 0x02, 0xcd, 0x43, 0xf8, 0x4b, 0xd0, 0xca, 0x68, 0x48, 0xfc,
+	0x41, 0xde, 0x4b, 0xe2, 0x40, 0x36, 0xb6, 0x7e, 0x53, 0xea, 0x54, 0xe9, 0xba, 0x54, 0x9e, 0x2f,
+	0xc9, 0x18, 0xcd, 0xc3, 0xee, 0x6f, 0xbe, 0xbf, 0xd9, 0x5d, 0xfd, 0xe1, 0x66, 0x77, 0xf5, 0x7f,
+	0x37, 0xbb, 0xab, 0xff, 0x78, 0xbb, 0xbb, 0xf2, 0xc3, 0xdb, 0xdd, 0x95, 0xff, 0xbc, 0xdd, 0x5d,
+	0xf9, 0x43, 0xf9, 0x48, 0x23, 0x43, 0x79, 0xa2, 0xcd, 0xfe, 0xb2, 0x18, 0x49, 0x8b, 0x3e, 0xd6,
+	0x7a, 0x1b, 0xea, 0xcf, 0x88, 0x8f, 0xff, 0x3f, 0x00, 0xaf, 0xf4, 0xc9, 0x4a, 0xd2, 0x10, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1537,6 +1609,46 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Epoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Epoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodePublicKey) > 0 {
+		i -= len(m.NodePublicKey)
+		copy(dAtA[i:], m.NodePublicKey)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.NodePublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartingBlock != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.StartingBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvm(v)
 	base := offset
@@ -1836,6 +1948,25 @@ func (m *TraceConfig) Size() (n int) {
 	return n
 }
 
+func (m *Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvm(uint64(m.EpochNumber))
+	}
+	if m.StartingBlock != 0 {
+		n += 1 + sovEvm(uint64(m.StartingBlock))
+	}
+	l = len(m.NodePublicKey)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3982,6 +4113,128 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Epoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Epoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Epoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingBlock", wireType)
+			}
+			m.StartingBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodePublicKey = append(m.NodePublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodePublicKey == nil {
+				m.NodePublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -60,5 +60,9 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	if err := ValidateEpochs(gs.Epochs); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
@@ -29,6 +29,8 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// epochs defines the schedule of epochs agreed by consensus.
+	Epochs []Epoch `protobuf:"bytes,3,rep,name=epochs,proto3" json:"epochs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +80,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetEpochs() []Epoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -152,26 +161,27 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbf, 0x4e, 0x83, 0x40,
-	0x1c, 0xe6, 0x6c, 0x53, 0xec, 0xd5, 0xa8, 0xb9, 0x98, 0x48, 0x18, 0xae, 0xa4, 0x83, 0x61, 0x3a,
-	0xd2, 0x9a, 0x38, 0x2b, 0x8b, 0xab, 0xa1, 0x9b, 0xdb, 0x15, 0x7e, 0xa1, 0x0c, 0x70, 0x84, 0xbb,
-	0x12, 0x5d, 0x1d, 0x9d, 0x7c, 0x0e, 0x9f, 0xa4, 0x63, 0x47, 0x27, 0x35, 0xf0, 0x22, 0x86, 0x83,
-	0xd6, 0x28, 0xdb, 0x07, 0xdf, 0xbf, 0xdf, 0x7d, 0x98, 0x82, 0x5a, 0x43, 0x91, 0x26, 0x99, 0xf2,
-	0xa0, 0x4c, 0xbd, 0x72, 0xee, 0xc5, 0x90, 0x81, 0x4c, 0x24, 0xcb, 0x0b, 0xa1, 0x04, 0x39, 0x3f,
-	0xf0, 0x0c, 0xca, 0x94, 0x95, 0x73, 0xdb, 0xee, 0x39, 0x1a, 0x42, 0xab, 0xed, 0x8b, 0x58, 0xc4,
-	0x42, 0x43, 0xaf, 0x41, 0xed, 0xdf, 0xd9, 0x2b, 0xc2, 0x27, 0xf7, 0x6d, 0xea, 0x52, 0x71, 0x05,
-	0xc4, 0xc7, 0xc7, 0x3c, 0x0c, 0xc5, 0x26, 0x53, 0xd2, 0x42, 0xce, 0xc0, 0x9d, 0x2c, 0x1c, 0xf6,
-	0xbf, 0x87, 0x75, 0x8e, 0xbb, 0x56, 0xe8, 0x0f, 0xb7, 0x9f, 0x53, 0x23, 0x38, 0xf8, 0xc8, 0x0d,
-	0x1e, 0xe5, 0xbc, 0xe0, 0xa9, 0xb4, 0x8e, 0x1c, 0xe4, 0x4e, 0x16, 0x56, 0x3f, 0xe1, 0x41, 0xf3,
-	0x9d, 0xb3, 0x53, 0xcf, 0x5e, 0x10, 0x3e, 0xfd, 0x1b, 0x4d, 0x2c, 0x6c, 0xf2, 0x28, 0x2a, 0x40,
-	0x36, 0xd7, 0x20, 0x77, 0x1c, 0xec, 0x3f, 0x09, 0xc1, 0xc3, 0x50, 0x44, 0xa0, 0x2b, 0xc6, 0x81,
-	0xc6, 0xc4, 0xc7, 0xa6, 0x54, 0xa2, 0xe0, 0x31, 0x58, 0x03, 0x7d, 0xfb, 0x65, 0xbf, 0x59, 0x3f,
-	0xd3, 0x3f, 0x6b, 0x8a, 0xdf, 0xbf, 0xa6, 0xe6, 0xb2, 0xd5, 0x07, 0x7b, 0xa3, 0x7f, 0xbb, 0xad,
-	0x28, 0xda, 0x55, 0x14, 0x7d, 0x57, 0x14, 0xbd, 0xd5, 0xd4, 0xd8, 0xd5, 0xd4, 0xf8, 0xa8, 0xa9,
-	0xf1, 0x78, 0x15, 0x27, 0x6a, 0xbd, 0x59, 0xb1, 0x50, 0xa4, 0xcd, 0xae, 0x42, 0x7a, 0xbf, 0x73,
-	0x3f, 0xe9, 0xc1, 0xd5, 0x73, 0x0e, 0x72, 0x35, 0xd2, 0xd3, 0x5e, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xe3, 0x61, 0xf0, 0x9f, 0xc0, 0x01, 0x00, 0x00,
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xbf, 0x55, 0xfb, 0xd7, 0x45, 0x80, 0x2c, 0x24, 0xa2, 0x0e, 0x6e, 0xd5, 0x01,
+	0x75, 0x72, 0xd4, 0x22, 0x98, 0x21, 0x12, 0x62, 0x45, 0xe9, 0xc6, 0xe6, 0xa6, 0x57, 0x49, 0x86,
+	0xc4, 0x51, 0xec, 0x46, 0xb0, 0xf2, 0x04, 0x3c, 0x07, 0x6f, 0xc1, 0xd6, 0xb1, 0x23, 0x13, 0xa0,
+	0xe4, 0x45, 0x90, 0x9d, 0xa4, 0x08, 0x22, 0xb6, 0x9b, 0x9c, 0xf3, 0xdd, 0x73, 0x93, 0x83, 0x29,
+	0xa8, 0x10, 0xb2, 0x38, 0x4a, 0x94, 0x03, 0x79, 0xec, 0xe4, 0x73, 0x27, 0x80, 0x04, 0x64, 0x24,
+	0x59, 0x9a, 0x09, 0x25, 0xc8, 0xf1, 0x5e, 0x67, 0x90, 0xc7, 0x2c, 0x9f, 0x8f, 0x46, 0x2d, 0x42,
+	0x0b, 0xc6, 0x3d, 0x3a, 0x09, 0x44, 0x20, 0xcc, 0xe8, 0xe8, 0xa9, 0x7a, 0x3b, 0x7d, 0x45, 0xf8,
+	0xe0, 0xb6, 0xda, 0xba, 0x54, 0x5c, 0x01, 0x71, 0xf1, 0x7f, 0xee, 0xfb, 0x62, 0x93, 0x28, 0x69,
+	0xa3, 0x49, 0x67, 0x36, 0x5c, 0x4c, 0xd8, 0xef, 0x1c, 0x56, 0x13, 0xd7, 0x95, 0xd1, 0xed, 0x6e,
+	0xdf, 0xc7, 0x96, 0xb7, 0xe7, 0xc8, 0x25, 0xee, 0xa5, 0x3c, 0xe3, 0xb1, 0xb4, 0xff, 0x4d, 0xd0,
+	0x6c, 0xb8, 0xb0, 0xdb, 0x1b, 0xee, 0x8c, 0x5e, 0x93, 0xb5, 0x9b, 0x5c, 0xe0, 0x1e, 0xa4, 0xc2,
+	0x0f, 0xa5, 0xdd, 0x31, 0xc9, 0xa7, 0x6d, 0xee, 0x46, 0xeb, 0x0d, 0x56, 0x99, 0xa7, 0x4f, 0x08,
+	0x1f, 0xfe, 0xbc, 0x88, 0xd8, 0xb8, 0xcf, 0xd7, 0xeb, 0x0c, 0xa4, 0xfe, 0x08, 0x34, 0x1b, 0x78,
+	0xcd, 0x23, 0x21, 0xb8, 0xeb, 0x8b, 0x35, 0x98, 0xcb, 0x06, 0x9e, 0x99, 0x89, 0x8b, 0xfb, 0x52,
+	0x89, 0x8c, 0x07, 0xf0, 0x77, 0xb0, 0xf9, 0x3b, 0xee, 0x91, 0x0e, 0x7e, 0xf9, 0x18, 0xf7, 0x97,
+	0x95, 0xdf, 0x6b, 0x40, 0xf7, 0x6a, 0x5b, 0x50, 0xb4, 0x2b, 0x28, 0xfa, 0x2c, 0x28, 0x7a, 0x2e,
+	0xa9, 0xb5, 0x2b, 0xa9, 0xf5, 0x56, 0x52, 0xeb, 0xfe, 0x2c, 0x88, 0x54, 0xb8, 0x59, 0x31, 0x5f,
+	0xc4, 0xba, 0x0e, 0x21, 0x9d, 0xef, 0x96, 0x1e, 0x4c, 0x4f, 0xea, 0x31, 0x05, 0xb9, 0xea, 0x99,
+	0x46, 0xce, 0xbf, 0x06, 0x00, 0x91, 0xdc, 0xed, 0x35, 0xf7, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +204,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -297,6 +321,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -425,6 +455,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, Epoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -73,6 +73,8 @@ type ComplianceKeeper interface {
 	GetVerificationDetailsByIssuer(ctx sdk.Context, userAddress, issuerAddress sdk.AccAddress) ([]*compliancetypes.Verification, []*compliancetypes.VerificationDetails, error)
 	GetIssuanceTreeRoot(ctx sdk.Context) (*big.Int, error)
 	GetRevocationTreeRoot(ctx sdk.Context) (*big.Int, error)
+	GetIssuanceTreeRootAtHeight(ctx sdk.Context, blockHeight int64) (*big.Int, error)
+	GetRevocationTreeRootAtHeight(ctx sdk.Context, blockHeight int64) (*big.Int, error)
 	SetTreeRoot(context sdk.Context, treeKey []byte, root *merkletree.Hash) error
 	IsVerificationRevoked(ctx sdk.Context, verificationId []byte) (bool, error)
 	RevokeVerification(ctx sdk.Context, verificationDetailsId []byte, issuerAddress sdk.AccAddress) error
@@ -16,6 +16,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -41,6 +43,7 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixEpoch
 )
 
 // prefix bytes for the EVM transient store
@@ -56,6 +59,7 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+	KeyPrefixEpoch   = []byte{prefixEpoch}
 )
 
 // Transient Store key prefixes
@@ -75,3 +79,8 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// EpochKey defines the key under which an epoch is stored.
+func EpochKey(epochNumber uint32) []byte {
+	return binary.BigEndian.AppendUint32(append([]byte{}, KeyPrefixEpoch...), epochNumber)
+}
@@ -31,6 +31,7 @@ var (
 	_ sdk.Tx     = &MsgHandleTx{}
 	_ ante.GasTx = &MsgHandleTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgUpdateEpochs{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgHandleTx{}
 )
@@ -101,3 +102,24 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgUpdateEpochs message.
+func (m MsgUpdateEpochs) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateEpochs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errortypes.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateEpochs(m.Epochs)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateEpochs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -142,7 +142,7 @@ func newMsgHandleTx(
 	}
 
 	msg := MsgHandleTx{Data: dataAny}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.TxHash().Hex()
 	return &msg
 }
 
@@ -163,6 +163,18 @@ func (msg *MsgHandleTx) FromEthereumTx(tx *ethtypes.Transaction) error {
 	return nil
 }
 
+// FromSetCodeTx populates the message fields from the given EIP-7702 set code transaction
+func (msg *MsgHandleTx) FromSetCodeTx(txData *SetCodeTx) error {
+	anyTxData, err := PackTxData(txData)
+	if err != nil {
+		return err
+	}
+
+	msg.Data = anyTxData
+	msg.Hash = txData.Hash().Hex()
+	return nil
+}
+
 // Route returns the route value of an MsgHandleTx.
 func (msg MsgHandleTx) Route() string { return RouterKey }
 
@@ -193,7 +205,7 @@ func (msg MsgHandleTx) ValidateBasic() error {
 	}
 
 	// Validate Hash field after validated txData to avoid panic
-	txHash := msg.AsTransaction().Hash().Hex()
+	txHash := msg.TxHash().Hex()
 	if msg.Hash != txHash {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid tx hash %s, expected: %s", msg.Hash, txHash)
 	}
@@ -247,6 +259,24 @@ func (msg *MsgHandleTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.Si
 		return fmt.Errorf("sender address not defined for message")
 	}
 
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		setCodeTx = setCodeTx.Copy().(*SetCodeTx)
+		sig, _, err := keyringSigner.SignByAddress(from, setCodeTx.SigHash(ethSigner.ChainID()).Bytes())
+		if err != nil {
+			return err
+		}
+
+		if err := setCodeTx.WithSignature(ethSigner.ChainID(), sig); err != nil {
+			return err
+		}
+		return msg.FromSetCodeTx(setCodeTx)
+	}
+
 	tx := msg.AsTransaction()
 	txHash := ethSigner.Hash(tx)
 
@@ -300,7 +330,11 @@ func (msg *MsgHandleTx) GetFrom() sdk.AccAddress {
 	return common.HexToAddress(msg.From).Bytes()
 }
 
-// AsTransaction creates an Ethereum Transaction type from the msg fields
+// AsTransaction creates an Ethereum Transaction type from the msg fields.
+//
+// NOTE: EIP-7702 set code transactions are returned as dynamic fee transactions
+// without authorizations. Use TxHash, MarshalBinary, GetSender and AsMessage to
+// access fields, which depend on the transaction type.
 func (msg MsgHandleTx) AsTransaction() *ethtypes.Transaction {
 	txData, err := UnpackTxData(msg.Data)
 	if err != nil {
@@ -310,15 +344,95 @@ func (msg MsgHandleTx) AsTransaction() *ethtypes.Transaction {
 	return ethtypes.NewTx(txData.AsEthereumData())
 }
 
-// AsMessage creates an Ethereum core.Message from the msg fields
+// TxType returns the type of the Ethereum transaction
+func (msg MsgHandleTx) TxType() uint8 {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return 0
+	}
+	return txData.TxType()
+}
+
+// TxHash returns the hash of the Ethereum transaction
+func (msg MsgHandleTx) TxHash() common.Hash {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return common.Hash{}
+	}
+
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return setCodeTx.Hash()
+	}
+	return ethtypes.NewTx(txData.AsEthereumData()).Hash()
+}
+
+// MarshalBinary returns the canonical encoding of the Ethereum transaction
+func (msg MsgHandleTx) MarshalBinary() ([]byte, error) {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return setCodeTx.MarshalBinary()
+	}
+	return ethtypes.NewTx(txData.AsEthereumData()).MarshalBinary()
+}
+
+// AsMessage creates an Ethereum core.Message from the msg fields. Messages of
+// set code transactions are returned as SetCodeMessage.
 func (msg MsgHandleTx) AsMessage(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
-	return msg.AsTransaction().AsMessage(signer, baseFee)
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	setCodeTx, ok := txData.(*SetCodeTx)
+	if !ok {
+		return msg.AsTransaction().AsMessage(signer, baseFee)
+	}
+
+	from, err := setCodeTx.Sender(signer.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := setCodeTx.GetGasFeeCap()
+	if baseFee != nil {
+		gasPrice = setCodeTx.EffectiveGasPrice(baseFee)
+	}
+
+	return NewSetCodeMessage(
+		ethtypes.NewMessage(
+			from,
+			setCodeTx.GetTo(),
+			setCodeTx.GetNonce(),
+			setCodeTx.GetValue(),
+			setCodeTx.GetGas(),
+			gasPrice,
+			setCodeTx.GetGasFeeCap(),
+			setCodeTx.GetGasTipCap(),
+			setCodeTx.GetData(),
+			setCodeTx.GetAccessList(),
+			false,
+		),
+		setCodeTx.GetAuthorizationList(),
+	), nil
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (msg *MsgHandleTx) GetSender(chainID *big.Int) (common.Address, error) {
-	signer := ethtypes.LatestSignerForChainID(chainID)
-	from, err := signer.Sender(msg.AsTransaction())
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var from common.Address
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		from, err = setCodeTx.Sender(chainID)
+	} else {
+		from, err = ethtypes.LatestSignerForChainID(chainID).Sender(msg.AsTransaction())
+	}
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -334,6 +448,14 @@ func (msg MsgHandleTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 
 // UnmarshalBinary decodes the canonical encoding of transactions.
 func (msg *MsgHandleTx) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] == SetCodeTxType {
+		txData, err := UnmarshalSetCodeTx(b)
+		if err != nil {
+			return err
+		}
+		return msg.FromSetCodeTx(txData)
+	}
+
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(b); err != nil {
 		return err
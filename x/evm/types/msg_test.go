@@ -18,6 +18,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 
@@ -759,6 +760,70 @@ func (suite *MsgsTestSuite) TestTransactionCoding() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgHandleTx_SetCodeTx() {
+	authorityKey, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+
+	auth, err := types.SignSetCodeAuthorization(types.SetCodeAuthorization{
+		ChainID: (*hexutil.Big)(suite.chainID),
+		Address: suite.to,
+		Nonce:   1,
+	}, authorityKey)
+	suite.Require().NoError(err)
+
+	gas := hexutil.Uint64(100000)
+	args := types.TransactionArgs{
+		From:                 &suite.from,
+		To:                   &suite.to,
+		Gas:                  &gas,
+		MaxFeePerGas:         (*hexutil.Big)(suite.hundredBigInt),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1)),
+		ChainID:              (*hexutil.Big)(suite.chainID),
+		AuthorizationList:    []types.SetCodeAuthorization{auth},
+	}
+
+	msg := args.ToTransaction()
+	suite.Require().Equal(uint8(types.SetCodeTxType), msg.TxType())
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(suite.chainID), suite.signer))
+	suite.Require().NoError(msg.ValidateBasic())
+
+	sender, err := msg.GetSender(suite.chainID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, sender)
+
+	// set code transaction is not bound to another chain
+	_, err = msg.GetSender(big.NewInt(2))
+	suite.Require().Error(err)
+
+	bz, err := msg.MarshalBinary()
+	suite.Require().NoError(err)
+	suite.Require().Equal(byte(types.SetCodeTxType), bz[0])
+	suite.Require().Equal(crypto.Keccak256Hash(bz), msg.TxHash())
+
+	parsedTx := &types.MsgHandleTx{}
+	suite.Require().NoError(parsedTx.UnmarshalBinary(bz))
+	suite.Require().Equal(msg.Hash, parsedTx.Hash)
+	suite.Require().Equal(msg.TxHash(), parsedTx.TxHash())
+
+	sender, err = parsedTx.GetSender(suite.chainID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, sender)
+
+	coreMsg, err := parsedTx.AsMessage(ethtypes.LatestSignerForChainID(suite.chainID), big.NewInt(10))
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, coreMsg.From())
+	suite.Require().Equal(big.NewInt(11), coreMsg.GasPrice())
+	suite.Require().Equal([]types.SetCodeAuthorization{auth}, types.GetAuthorizationList(coreMsg))
+
+	authority, err := types.GetAuthorizationList(coreMsg)[0].Authority()
+	suite.Require().NoError(err)
+	suite.Require().Equal(crypto.PubkeyToAddress(authorityKey.PublicKey), authority)
+
+	// malformed set code transaction
+	err = parsedTx.UnmarshalBinary(append([]byte{types.SetCodeTxType}, 0xc0))
+	suite.Require().Error(err)
+}
+
 func encodeDecodeBinary(tx *ethtypes.Transaction) (*types.MsgHandleTx, error) {
 	data, err := tx.MarshalBinary()
 	if err != nil {
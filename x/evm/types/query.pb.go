@@ -521,6 +521,113 @@ func (m *QueryStorageResponse) GetValue() string {
 	return ""
 }
 
+// QueryStorageEncryptedRequest is the request type for the
+// Query/StorageEncrypted RPC method.
+type QueryStorageEncryptedRequest struct {
+	// address is the ethereum hex address of the contract.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// key defines the key of the storage state
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// user_public_key is x25519 public key, which is used to encrypt the value
+	UserPublicKey []byte `protobuf:"bytes,3,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"`
+	// signature is made by contract owner over contract address, storage key
+	// and user public key
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// deployment_nonce is the nonce of the contract deployer used to create the
+	// contract. It is used to prove that signer deployed the contract.
+	DeploymentNonce uint64 `protobuf:"varint,5,opt,name=deployment_nonce,json=deploymentNonce,proto3" json:"deployment_nonce,omitempty"`
+}
+
+func (m *QueryStorageEncryptedRequest) Reset()         { *m = QueryStorageEncryptedRequest{} }
+func (m *QueryStorageEncryptedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageEncryptedRequest) ProtoMessage()    {}
+func (*QueryStorageEncryptedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{10}
+}
+func (m *QueryStorageEncryptedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageEncryptedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageEncryptedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageEncryptedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageEncryptedRequest.Merge(m, src)
+}
+func (m *QueryStorageEncryptedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageEncryptedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageEncryptedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageEncryptedRequest proto.InternalMessageInfo
+
+// QueryStorageEncryptedResponse is the response type for the
+// Query/StorageEncrypted RPC method.
+type QueryStorageEncryptedResponse struct {
+	// value is the storage value encrypted with the key derived from user public
+	// key and node public key
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// node_public_key is x25519 public key of the node used for encryption
+	NodePublicKey []byte `protobuf:"bytes,2,opt,name=node_public_key,json=nodePublicKey,proto3" json:"node_public_key,omitempty"`
+}
+
+func (m *QueryStorageEncryptedResponse) Reset()         { *m = QueryStorageEncryptedResponse{} }
+func (m *QueryStorageEncryptedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageEncryptedResponse) ProtoMessage()    {}
+func (*QueryStorageEncryptedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{11}
+}
+func (m *QueryStorageEncryptedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageEncryptedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageEncryptedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageEncryptedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageEncryptedResponse.Merge(m, src)
+}
+func (m *QueryStorageEncryptedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageEncryptedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageEncryptedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageEncryptedResponse proto.InternalMessageInfo
+
+func (m *QueryStorageEncryptedResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *QueryStorageEncryptedResponse) GetNodePublicKey() []byte {
+	if m != nil {
+		return m.NodePublicKey
+	}
+	return nil
+}
+
 // QueryCodeRequest is the request type for the Query/Code RPC method.
 type QueryCodeRequest struct {
 	// address is the ethereum hex address to query the code for.
@@ -531,7 +638,7 @@ func (m *QueryCodeRequest) Reset()         { *m = QueryCodeRequest{} }
 func (m *QueryCodeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeRequest) ProtoMessage()    {}
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{10}
+	return fileDescriptor_e15a877459347994, []int{12}
 }
 func (m *QueryCodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +678,7 @@ func (m *QueryCodeResponse) Reset()         { *m = QueryCodeResponse{} }
 func (m *QueryCodeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeResponse) ProtoMessage()    {}
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{11}
+	return fileDescriptor_e15a877459347994, []int{13}
 }
 func (m *QueryCodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -619,7 +726,7 @@ func (m *QueryTxLogsRequest) Reset()         { *m = QueryTxLogsRequest{} }
 func (m *QueryTxLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsRequest) ProtoMessage()    {}
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{12}
+	return fileDescriptor_e15a877459347994, []int{14}
 }
 func (m *QueryTxLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +767,7 @@ func (m *QueryTxLogsResponse) Reset()         { *m = QueryTxLogsResponse{} }
 func (m *QueryTxLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsResponse) ProtoMessage()    {}
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{13}
+	return fileDescriptor_e15a877459347994, []int{15}
 }
 func (m *QueryTxLogsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +818,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{14}
+	return fileDescriptor_e15a877459347994, []int{16}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,7 +857,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{15}
+	return fileDescriptor_e15a877459347994, []int{17}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +910,7 @@ func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{16}
+	return fileDescriptor_e15a877459347994, []int{18}
 }
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +986,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{17}
+	return fileDescriptor_e15a877459347994, []int{19}
 }
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,6 +1036,198 @@ func (m *EstimateGasResponse) GetReturnValue() []byte {
 	return nil
 }
 
+// CreateAccessListResponse defines CreateAccessList response
+type CreateAccessListResponse struct {
+	// access_list contains accounts and storage slots touched by the call
+	AccessList []AccessTuple `protobuf:"bytes,1,rep,name=access_list,json=accessList,proto3" json:"access_list"`
+	// gas_used is the amount of gas used by the call with the returned access list
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm_error is the error returned by vm execution
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *CreateAccessListResponse) Reset()         { *m = CreateAccessListResponse{} }
+func (m *CreateAccessListResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAccessListResponse) ProtoMessage()    {}
+func (*CreateAccessListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{20}
+}
+func (m *CreateAccessListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAccessListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAccessListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAccessListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccessListResponse.Merge(m, src)
+}
+func (m *CreateAccessListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAccessListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccessListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccessListResponse proto.InternalMessageInfo
+
+func (m *CreateAccessListResponse) GetAccessList() []AccessTuple {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *CreateAccessListResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *CreateAccessListResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+// QuerySimulateV1Request defines SimulateV1 request
+type QuerySimulateV1Request struct {
+	// opts are simulation options in the same json format as the json rpc api.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the gas cap of all the simulated calls
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// unencrypted defines if call data is not encrypted
+	Unencrypted bool `protobuf:"varint,5,opt,name=unencrypted,proto3" json:"unencrypted,omitempty"`
+}
+
+func (m *QuerySimulateV1Request) Reset()         { *m = QuerySimulateV1Request{} }
+func (m *QuerySimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateV1Request) ProtoMessage()    {}
+func (*QuerySimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{21}
+}
+func (m *QuerySimulateV1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateV1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateV1Request.Merge(m, src)
+}
+func (m *QuerySimulateV1Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateV1Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateV1Request proto.InternalMessageInfo
+
+func (m *QuerySimulateV1Request) GetOpts() []byte {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *QuerySimulateV1Request) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QuerySimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QuerySimulateV1Request) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QuerySimulateV1Request) GetUnencrypted() bool {
+	if m != nil {
+		return m.Unencrypted
+	}
+	return false
+}
+
+// QuerySimulateV1Response defines SimulateV1 response
+type QuerySimulateV1Response struct {
+	// data is the list of simulated blocks serialized in json
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QuerySimulateV1Response) Reset()         { *m = QuerySimulateV1Response{} }
+func (m *QuerySimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateV1Response) ProtoMessage()    {}
+func (*QuerySimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{22}
+}
+func (m *QuerySimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateV1Response.Merge(m, src)
+}
+func (m *QuerySimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateV1Response proto.InternalMessageInfo
+
+func (m *QuerySimulateV1Response) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -955,7 +1254,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{18}
+	return fileDescriptor_e15a877459347994, []int{23}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1057,7 +1356,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{19}
+	return fileDescriptor_e15a877459347994, []int{24}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1414,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{20}
+	return fileDescriptor_e15a877459347994, []int{25}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1203,7 +1502,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{21}
+	return fileDescriptor_e15a877459347994, []int{26}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1239,23 +1538,34 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
-// QueryBaseFeeRequest defines the request type for querying the EIP1559 base
-// fee.
-type QueryBaseFeeRequest struct {
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,5,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// unencrypted defines if call data is not encrypted
+	Unencrypted bool `protobuf:"varint,6,opt,name=unencrypted,proto3" json:"unencrypted,omitempty"`
 }
 
-func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
-func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBaseFeeRequest) ProtoMessage()    {}
-func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{27}
 }
-func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1265,43 +1575,169 @@ func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
 }
-func (m *QueryBaseFeeRequest) XXX_Size() int {
+func (m *QueryTraceCallRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
 
-// QueryBaseFeeResponse returns the EIP1559 base fee.
-type QueryBaseFeeResponse struct {
-	// base_fee is the EIP1559 base fee
-	BaseFee *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee,omitempty"`
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
 }
 
-func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
-func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBaseFeeResponse) ProtoMessage()    {}
-func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
-}
-func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetUnencrypted() bool {
+	if m != nil {
+		return m.Unencrypted
+	}
+	return false
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{28}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// QueryBaseFeeRequest defines the request type for querying the EIP1559 base
+// fee.
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{29}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse returns the EIP1559 base fee.
+type QueryBaseFeeResponse struct {
+	// base_fee is the EIP1559 base fee
+	BaseFee *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee,omitempty"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{30}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
 }
 func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
@@ -1325,7 +1761,7 @@ func (m *QueryNodePublicKey) Reset()         { *m = QueryNodePublicKey{} }
 func (m *QueryNodePublicKey) String() string { return proto.CompactTextString(m) }
 func (*QueryNodePublicKey) ProtoMessage()    {}
 func (*QueryNodePublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{24}
+	return fileDescriptor_e15a877459347994, []int{31}
 }
 func (m *QueryNodePublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,7 +1806,7 @@ func (m *QueryNodePublicKeyResponse) Reset()         { *m = QueryNodePublicKeyRe
 func (m *QueryNodePublicKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNodePublicKeyResponse) ProtoMessage()    {}
 func (*QueryNodePublicKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{25}
+	return fileDescriptor_e15a877459347994, []int{32}
 }
 func (m *QueryNodePublicKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1406,6 +1842,91 @@ func (m *QueryNodePublicKeyResponse) GetNodePublicKey() string {
 	return ""
 }
 
+// QueryEpochsRequest defines the request type for querying the schedule of
+// epochs.
+type QueryEpochsRequest struct {
+}
+
+func (m *QueryEpochsRequest) Reset()         { *m = QueryEpochsRequest{} }
+func (m *QueryEpochsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochsRequest) ProtoMessage()    {}
+func (*QueryEpochsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{33}
+}
+func (m *QueryEpochsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochsRequest.Merge(m, src)
+}
+func (m *QueryEpochsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochsRequest proto.InternalMessageInfo
+
+// QueryEpochsResponse defines the response type for querying the schedule of
+// epochs.
+type QueryEpochsResponse struct {
+	// epochs is the list of epochs ordered by epoch number
+	Epochs []Epoch `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+}
+
+func (m *QueryEpochsResponse) Reset()         { *m = QueryEpochsResponse{} }
+func (m *QueryEpochsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochsResponse) ProtoMessage()    {}
+func (*QueryEpochsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{34}
+}
+func (m *QueryEpochsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochsResponse.Merge(m, src)
+}
+func (m *QueryEpochsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochsResponse) GetEpochs() []Epoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
@@ -1417,6 +1938,8 @@ func init() {
 	proto.RegisterType((*QueryBalanceResponse)(nil), "ethermint.evm.v1.QueryBalanceResponse")
 	proto.RegisterType((*QueryStorageRequest)(nil), "ethermint.evm.v1.QueryStorageRequest")
 	proto.RegisterType((*QueryStorageResponse)(nil), "ethermint.evm.v1.QueryStorageResponse")
+	proto.RegisterType((*QueryStorageEncryptedRequest)(nil), "ethermint.evm.v1.QueryStorageEncryptedRequest")
+	proto.RegisterType((*QueryStorageEncryptedResponse)(nil), "ethermint.evm.v1.QueryStorageEncryptedResponse")
 	proto.RegisterType((*QueryCodeRequest)(nil), "ethermint.evm.v1.QueryCodeRequest")
 	proto.RegisterType((*QueryCodeResponse)(nil), "ethermint.evm.v1.QueryCodeResponse")
 	proto.RegisterType((*QueryTxLogsRequest)(nil), "ethermint.evm.v1.QueryTxLogsRequest")
@@ -1425,118 +1948,148 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*CreateAccessListResponse)(nil), "ethermint.evm.v1.CreateAccessListResponse")
+	proto.RegisterType((*QuerySimulateV1Request)(nil), "ethermint.evm.v1.QuerySimulateV1Request")
+	proto.RegisterType((*QuerySimulateV1Response)(nil), "ethermint.evm.v1.QuerySimulateV1Response")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "ethermint.evm.v1.QueryTraceCallResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryNodePublicKey)(nil), "ethermint.evm.v1.QueryNodePublicKey")
 	proto.RegisterType((*QueryNodePublicKeyResponse)(nil), "ethermint.evm.v1.QueryNodePublicKeyResponse")
+	proto.RegisterType((*QueryEpochsRequest)(nil), "ethermint.evm.v1.QueryEpochsRequest")
+	proto.RegisterType((*QueryEpochsResponse)(nil), "ethermint.evm.v1.QueryEpochsResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x5b, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x8c, 0x93, 0x36, 0xff, 0x69, 0xda, 0xba, 0xfb, 0x4f, 0x62, 0x77,
-	0xdb, 0x5c, 0x9b, 0xee, 0x92, 0x80, 0x8a, 0xe8, 0x0b, 0x4d, 0x42, 0x7a, 0xa1, 0x17, 0x95, 0xa5,
-	0xea, 0x03, 0x52, 0x65, 0x8d, 0x77, 0x27, 0x6b, 0x2b, 0xf6, 0xae, 0xbb, 0x33, 0x36, 0x4e, 0x4b,
-	0x11, 0x42, 0xa2, 0x2a, 0x2a, 0x42, 0x95, 0x78, 0x47, 0xfd, 0x06, 0x7c, 0x8d, 0x3e, 0x56, 0xe2,
-	0x05, 0x78, 0x28, 0xa8, 0xe5, 0x81, 0xcf, 0xc0, 0x03, 0x42, 0x73, 0x59, 0x7b, 0xd7, 0xeb, 0xb5,
-	0x53, 0x54, 0x9e, 0x78, 0xda, 0x9d, 0x33, 0xe7, 0xf2, 0x3b, 0x33, 0x67, 0xce, 0xf9, 0x81, 0x59,
-	0x4c, 0x2b, 0xd8, 0xaf, 0x57, 0x5d, 0x6a, 0xe0, 0x56, 0xdd, 0x68, 0xad, 0x1b, 0x77, 0x9b, 0xd8,
-	0xdf, 0xd7, 0x1b, 0xbe, 0x47, 0x3d, 0x38, 0xdd, 0xd9, 0xd5, 0x71, 0xab, 0xae, 0xb7, 0xd6, 0xd5,
-	0x55, 0xcb, 0x23, 0x75, 0x8f, 0x18, 0x65, 0x44, 0xb0, 0x50, 0x35, 0x5a, 0xeb, 0x65, 0x4c, 0xd1,
-	0xba, 0xd1, 0x40, 0x4e, 0xd5, 0x45, 0xb4, 0xea, 0xb9, 0xc2, 0x5a, 0x55, 0x63, 0xbe, 0x99, 0x13,
-	0xb1, 0x77, 0x22, 0xb6, 0x47, 0xdb, 0x72, 0x6b, 0xc6, 0xf1, 0x1c, 0x8f, 0xff, 0x1a, 0xec, 0x4f,
-	0x4a, 0x67, 0x1d, 0xcf, 0x73, 0x6a, 0xd8, 0x40, 0x8d, 0xaa, 0x81, 0x5c, 0xd7, 0xa3, 0x3c, 0x12,
-	0x91, 0xbb, 0x05, 0xb9, 0xcb, 0x57, 0xe5, 0xe6, 0xae, 0x41, 0xab, 0x75, 0x4c, 0x28, 0xaa, 0x37,
-	0x84, 0x82, 0xf6, 0x1e, 0x38, 0xf2, 0x11, 0x43, 0xbb, 0x69, 0x59, 0x5e, 0xd3, 0xa5, 0x26, 0xbe,
-	0xdb, 0xc4, 0x84, 0xc2, 0x3c, 0xc8, 0x20, 0xdb, 0xf6, 0x31, 0x21, 0x79, 0xa5, 0xa8, 0x2c, 0x4f,
-	0x98, 0xc1, 0xf2, 0x7c, 0xf6, 0xd1, 0xd3, 0xc2, 0xc8, 0x1f, 0x4f, 0x0b, 0x23, 0x9a, 0x05, 0x66,
-	0xa2, 0xa6, 0xa4, 0xe1, 0xb9, 0x04, 0x33, 0xdb, 0x32, 0xaa, 0x21, 0xd7, 0xc2, 0x81, 0xad, 0x5c,
-	0xc2, 0xff, 0x83, 0x09, 0xcb, 0xb3, 0x71, 0xa9, 0x82, 0x48, 0x25, 0x3f, 0xca, 0xf7, 0xb2, 0x4c,
-	0x70, 0x19, 0x91, 0x0a, 0x9c, 0x01, 0x63, 0xae, 0xc7, 0x8c, 0x52, 0x45, 0x65, 0x39, 0x6d, 0x8a,
-	0x85, 0xf6, 0x3e, 0x38, 0xc1, 0x83, 0x6c, 0xf3, 0xe3, 0xfd, 0x07, 0x28, 0x1f, 0x2a, 0x40, 0xed,
-	0xe7, 0x41, 0x82, 0x5d, 0x00, 0x87, 0xc4, 0xcd, 0x95, 0xa2, 0x9e, 0xa6, 0x84, 0x74, 0x53, 0x08,
-	0xa1, 0x0a, 0xb2, 0x84, 0x05, 0x65, 0xf8, 0x46, 0x39, 0xbe, 0xce, 0x9a, 0xb9, 0x40, 0xc2, 0x6b,
-	0xc9, 0x6d, 0xd6, 0xcb, 0xd8, 0x97, 0x19, 0x4c, 0x49, 0xe9, 0x0d, 0x2e, 0xd4, 0xae, 0x82, 0x59,
-	0x8e, 0xe3, 0x36, 0xaa, 0x55, 0x6d, 0x44, 0x3d, 0xbf, 0x27, 0x99, 0x93, 0x60, 0xd2, 0xf2, 0xdc,
-	0x5e, 0x1c, 0x39, 0x26, 0xdb, 0x8c, 0x65, 0xf5, 0x58, 0x01, 0x73, 0x09, 0xde, 0x64, 0x62, 0x4b,
-	0xe0, 0x70, 0x80, 0x2a, 0xea, 0x31, 0x00, 0xfb, 0x06, 0x53, 0x0b, 0x8a, 0x68, 0x4b, 0xdc, 0xf3,
-	0xeb, 0x5c, 0xcf, 0x5b, 0xb2, 0x88, 0x3a, 0xa6, 0xc3, 0x8a, 0x48, 0xbb, 0x2a, 0x83, 0x7d, 0x4c,
-	0x3d, 0x1f, 0x39, 0xc3, 0x83, 0xc1, 0x69, 0x90, 0xda, 0xc3, 0xfb, 0xb2, 0xde, 0xd8, 0x6f, 0x28,
-	0xfc, 0x9a, 0x0c, 0xdf, 0x71, 0x26, 0xc3, 0xcf, 0x80, 0xb1, 0x16, 0xaa, 0x35, 0x83, 0xe0, 0x62,
-	0xa1, 0x9d, 0x03, 0xd3, 0xb2, 0x94, 0xec, 0xd7, 0x4a, 0x72, 0x09, 0xfc, 0x2f, 0x64, 0x27, 0x43,
-	0x40, 0x90, 0x66, 0xb5, 0xcf, 0xad, 0x26, 0x4d, 0xfe, 0xaf, 0xdd, 0x03, 0x90, 0x2b, 0xde, 0x6a,
-	0x5f, 0xf3, 0x1c, 0x12, 0x84, 0x80, 0x20, 0xcd, 0x5f, 0x8c, 0xf0, 0xcf, 0xff, 0xe1, 0x45, 0x00,
-	0xba, 0x7d, 0x85, 0xe7, 0x96, 0xdb, 0x58, 0xd4, 0x45, 0xd1, 0xea, 0xac, 0x09, 0xe9, 0xa2, 0x5f,
-	0xc9, 0x26, 0xa4, 0xdf, 0xec, 0x1e, 0x95, 0x19, 0xb2, 0x0c, 0x81, 0xfc, 0x5a, 0x91, 0x07, 0x1b,
-	0x04, 0x97, 0x38, 0x57, 0x40, 0xba, 0xe6, 0x39, 0x2c, 0xbb, 0xd4, 0x72, 0x6e, 0xe3, 0xa8, 0xde,
-	0xdb, 0xfa, 0xf4, 0x6b, 0x9e, 0x63, 0x72, 0x15, 0x78, 0xa9, 0x0f, 0xa8, 0xa5, 0xa1, 0xa0, 0x44,
-	0x9c, 0x30, 0x2a, 0x6d, 0x46, 0x9e, 0xc3, 0x4d, 0xe4, 0xa3, 0x7a, 0x70, 0x0e, 0xda, 0x75, 0x09,
-	0x30, 0x90, 0x4a, 0x80, 0xe7, 0xc0, 0x78, 0x83, 0x4b, 0xf8, 0x01, 0xe5, 0x36, 0xf2, 0x71, 0x88,
-	0xc2, 0x62, 0x2b, 0xfd, 0xec, 0x45, 0x61, 0xc4, 0x94, 0xda, 0xda, 0xcf, 0x0a, 0x38, 0xb4, 0x43,
-	0x2b, 0xdb, 0xa8, 0x56, 0x0b, 0x9d, 0x34, 0xf2, 0x1d, 0x12, 0xdc, 0x09, 0xfb, 0x87, 0xc7, 0x41,
-	0xc6, 0x41, 0xa4, 0x64, 0xa1, 0x86, 0x7c, 0x1e, 0xe3, 0x0e, 0x22, 0xdb, 0xa8, 0x01, 0xef, 0x80,
-	0xe9, 0x86, 0xef, 0x35, 0x3c, 0x82, 0xfd, 0xce, 0x13, 0x63, 0xcf, 0x63, 0x72, 0x6b, 0xe3, 0xcf,
-	0x17, 0x05, 0xdd, 0xa9, 0xd2, 0x4a, 0xb3, 0xac, 0x5b, 0x5e, 0xdd, 0x90, 0xb3, 0x41, 0x7c, 0xce,
-	0x12, 0x7b, 0xcf, 0xa0, 0xfb, 0x0d, 0x4c, 0xf4, 0xed, 0xee, 0xdb, 0x36, 0x0f, 0x07, 0xbe, 0x82,
-	0x77, 0x79, 0x02, 0x64, 0xad, 0x0a, 0xaa, 0xba, 0xa5, 0xaa, 0x9d, 0x4f, 0x17, 0x95, 0xe5, 0x94,
-	0x99, 0xe1, 0xeb, 0x2b, 0x36, 0x2c, 0x82, 0x5c, 0xd3, 0xc5, 0xae, 0xe5, 0xef, 0x37, 0x28, 0xb6,
-	0xf3, 0x63, 0x45, 0x65, 0x39, 0x6b, 0x86, 0x45, 0x5a, 0x19, 0x1c, 0xd9, 0x21, 0xb4, 0x5a, 0x47,
-	0x14, 0x5f, 0x42, 0xdd, 0xa3, 0x9a, 0x06, 0x29, 0x07, 0x89, 0xf4, 0xd2, 0x26, 0xfb, 0x85, 0xc7,
-	0xc0, 0xf8, 0x2e, 0xaa, 0xd6, 0xb0, 0xcd, 0x93, 0xcb, 0x9a, 0x72, 0xc5, 0xba, 0x91, 0x8f, 0x69,
-	0xd3, 0x77, 0x4b, 0xe2, 0x1d, 0xf0, 0xc4, 0xcc, 0x9c, 0x90, 0xdd, 0xe6, 0xaf, 0xe1, 0xaf, 0x54,
-	0x50, 0x30, 0x3e, 0xb2, 0xf0, 0xad, 0x76, 0x70, 0x88, 0x06, 0x48, 0xd5, 0x89, 0x23, 0x2f, 0x63,
-	0x2e, 0x7e, 0x19, 0xd7, 0x89, 0x73, 0x19, 0xb9, 0x76, 0x8d, 0x99, 0x30, 0x4d, 0x78, 0x01, 0x4c,
-	0x52, 0xe6, 0xa2, 0x64, 0x79, 0xee, 0x6e, 0xd5, 0xe1, 0xb1, 0xfa, 0x5a, 0xf2, 0x40, 0xdb, 0x5c,
-	0xc9, 0xcc, 0xd1, 0xee, 0x02, 0x6e, 0x82, 0xc9, 0x86, 0x8f, 0x6d, 0x6c, 0x61, 0x42, 0x3c, 0x9f,
-	0xe4, 0xd3, 0xbc, 0x56, 0x87, 0xc4, 0x8e, 0x98, 0xb0, 0x84, 0xcb, 0x35, 0xcf, 0xda, 0x0b, 0x1a,
-	0xdd, 0x18, 0x3f, 0xf2, 0x1c, 0x97, 0x89, 0x36, 0x07, 0xe7, 0x00, 0x10, 0x2a, 0xfc, 0x35, 0x8e,
-	0xf3, 0xd7, 0x38, 0xc1, 0x25, 0x7c, 0x80, 0x6d, 0x07, 0xdb, 0x6c, 0xc6, 0xe6, 0x33, 0x3c, 0x09,
-	0x55, 0x17, 0x03, 0x58, 0x0f, 0x06, 0xb0, 0x7e, 0x2b, 0x18, 0xc0, 0x5b, 0x59, 0x56, 0x8d, 0x4f,
-	0x7e, 0x2d, 0x28, 0xd2, 0x09, 0xdb, 0xe9, 0x5b, 0x54, 0xd9, 0x7f, 0xa7, 0xa8, 0x26, 0x06, 0x16,
-	0x15, 0x88, 0x15, 0xd5, 0x87, 0xe9, 0xec, 0xe8, 0x74, 0xca, 0xcc, 0xd2, 0x76, 0xa9, 0xea, 0xda,
-	0xb8, 0xad, 0xad, 0xca, 0xe6, 0xd9, 0xb9, 0xff, 0x6e, 0x67, 0xb3, 0x11, 0x45, 0xc1, 0x2b, 0x62,
-	0xff, 0xda, 0x37, 0x29, 0x70, 0xac, 0xab, 0xbc, 0xc5, 0xf2, 0x0d, 0xd5, 0x0b, 0x6d, 0x07, 0xfd,
-	0x65, 0x58, 0xbd, 0xd0, 0x36, 0x79, 0x03, 0xf5, 0xf2, 0x5f, 0xbf, 0x6c, 0xed, 0x2c, 0x38, 0x1e,
-	0xbb, 0x8d, 0x01, 0xb7, 0x77, 0xb4, 0x33, 0xe0, 0x09, 0xbe, 0x88, 0x83, 0x41, 0xa2, 0xdd, 0xe9,
-	0x0c, 0x6f, 0x29, 0x96, 0x2e, 0x76, 0x40, 0x96, 0x75, 0xfb, 0xd2, 0x2e, 0x96, 0x03, 0x74, 0x6b,
-	0xf5, 0x97, 0x17, 0x85, 0xc5, 0x03, 0xe4, 0x73, 0xc5, 0xa5, 0x6c, 0xd2, 0x73, 0x77, 0xda, 0xbb,
-	0x72, 0x0a, 0xdc, 0xf0, 0x6c, 0x7c, 0xb3, 0x59, 0xae, 0x55, 0xad, 0xab, 0x78, 0x3f, 0x76, 0x77,
-	0xa2, 0x99, 0x85, 0xef, 0x4e, 0xfb, 0x40, 0x52, 0xbe, 0x88, 0x61, 0x07, 0xdd, 0x22, 0x38, 0xec,
-	0x32, 0x16, 0xda, 0xe0, 0x3b, 0x25, 0xc6, 0x0d, 0x24, 0xe7, 0x73, 0xc3, 0xfa, 0x1b, 0x5f, 0x1c,
-	0x02, 0x63, 0xdc, 0x0d, 0xfc, 0x4a, 0x01, 0x19, 0xc9, 0xaf, 0xe0, 0x42, 0xbc, 0xcc, 0xfa, 0x10,
-	0x68, 0x75, 0x71, 0x98, 0x9a, 0x00, 0xa3, 0x9d, 0xf9, 0xf2, 0xc7, 0xdf, 0xbf, 0x1b, 0x5d, 0x80,
-	0xa7, 0x8c, 0x18, 0xf1, 0x97, 0x1c, 0xcb, 0xb8, 0x2f, 0x4b, 0xe3, 0x01, 0xfc, 0x5e, 0x01, 0x53,
-	0x11, 0x1a, 0x0b, 0xcf, 0x24, 0x84, 0xe9, 0x47, 0x97, 0xd5, 0xb5, 0x83, 0x29, 0x4b, 0x64, 0x1b,
-	0x1c, 0xd9, 0x1a, 0x5c, 0x8d, 0x23, 0x0b, 0x18, 0x73, 0x0c, 0xe0, 0x0f, 0x0a, 0x98, 0xee, 0x65,
-	0xa4, 0x50, 0x4f, 0x08, 0x9b, 0x40, 0x84, 0x55, 0xe3, 0xc0, 0xfa, 0x12, 0xe9, 0x79, 0x8e, 0xf4,
-	0x1d, 0xb8, 0x11, 0x47, 0xda, 0x0a, 0x6c, 0xba, 0x60, 0xc3, 0x24, 0xfb, 0x01, 0x7c, 0xa8, 0x80,
-	0x8c, 0xe4, 0x9e, 0x89, 0x57, 0x1b, 0xa5, 0xb5, 0x89, 0x57, 0xdb, 0x43, 0x61, 0xb5, 0x35, 0x0e,
-	0x6b, 0x11, 0x9e, 0x8e, 0xc3, 0x92, 0x5c, 0x96, 0x84, 0x8e, 0xee, 0xb1, 0x02, 0x32, 0x92, 0x85,
-	0x26, 0x02, 0x89, 0x52, 0xde, 0x44, 0x20, 0x3d, 0x64, 0x56, 0x5b, 0xe7, 0x40, 0xce, 0xc0, 0x95,
-	0x38, 0x10, 0x22, 0x54, 0xbb, 0x38, 0x8c, 0xfb, 0x7b, 0x78, 0xff, 0x01, 0xbc, 0x07, 0xd2, 0x8c,
-	0xac, 0x42, 0x2d, 0xb1, 0x64, 0x3a, 0x0c, 0x58, 0x3d, 0x35, 0x50, 0x47, 0x62, 0x58, 0xe1, 0x18,
-	0x4e, 0xc1, 0x93, 0xfd, 0xaa, 0xc9, 0x8e, 0x9c, 0xc4, 0xa7, 0x60, 0x5c, 0xf0, 0x35, 0x78, 0x3a,
-	0xc1, 0x73, 0x84, 0x16, 0xaa, 0x0b, 0x43, 0xb4, 0x24, 0x82, 0x22, 0x47, 0xa0, 0xc2, 0x7c, 0x1c,
-	0x81, 0x20, 0x84, 0xb0, 0x0d, 0x32, 0x92, 0x0f, 0xc2, 0x62, 0xdc, 0x67, 0x94, 0x2a, 0xaa, 0x4b,
-	0x7d, 0x07, 0xd5, 0x0e, 0x93, 0xe1, 0x66, 0xbd, 0x3b, 0x0d, 0x35, 0x8d, 0xc7, 0x9d, 0x85, 0x6a,
-	0x3c, 0x2e, 0xa6, 0x95, 0x92, 0xc5, 0xc2, 0x7d, 0x0e, 0x72, 0x21, 0xba, 0x76, 0x80, 0xe8, 0x7d,
-	0x72, 0xee, 0xc3, 0xf7, 0xb4, 0x45, 0x1e, 0xbb, 0x08, 0xe7, 0xfb, 0xc4, 0x96, 0xea, 0x25, 0xc6,
-	0x02, 0x3f, 0x03, 0x19, 0x39, 0xc4, 0x13, 0x6b, 0x2f, 0x4a, 0xf2, 0x12, 0x6b, 0xaf, 0x87, 0x0b,
-	0x0c, 0xca, 0x5e, 0xcc, 0x70, 0xda, 0x86, 0x8f, 0x14, 0x00, 0xba, 0x83, 0x08, 0x2e, 0x0f, 0x72,
-	0x1d, 0x66, 0x0e, 0xea, 0xca, 0x01, 0x34, 0x25, 0x8e, 0x05, 0x8e, 0xa3, 0x00, 0xe7, 0x92, 0x70,
-	0xf0, 0xf9, 0xc1, 0x0e, 0x42, 0x0e, 0xb3, 0x01, 0xdd, 0x20, 0x3c, 0x03, 0x07, 0x74, 0x83, 0xc8,
-	0x4c, 0x1c, 0x74, 0x10, 0xc1, 0xac, 0x84, 0xdf, 0x2a, 0x60, 0x2a, 0x3a, 0xec, 0x92, 0x5e, 0x40,
-	0x44, 0x2b, 0xb1, 0xb1, 0xf7, 0x9d, 0x7f, 0x83, 0x9e, 0x62, 0xcf, 0x5c, 0xdc, 0xba, 0xf0, 0xec,
-	0xe5, 0xbc, 0xf2, 0xfc, 0xe5, 0xbc, 0xf2, 0xdb, 0xcb, 0x79, 0xe5, 0xc9, 0xab, 0xf9, 0x91, 0xe7,
-	0xaf, 0xe6, 0x47, 0x7e, 0x7a, 0x35, 0x3f, 0xf2, 0x49, 0x78, 0x98, 0xe3, 0x16, 0x9b, 0xe5, 0x5d,
-	0x67, 0x6d, 0xee, 0x8e, 0x0f, 0xf4, 0xf2, 0x38, 0xe7, 0x42, 0x6f, 0xff, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0xf5, 0x67, 0x75, 0xe3, 0x50, 0x13, 0x00, 0x00,
+	// 1936 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x94, 0x48, 0x3d, 0x4a, 0x31, 0x3b, 0x92, 0x6d, 0x7a, 0xab, 0xaf, 0xac, 0x2d,
+	0xea, 0xc3, 0x0a, 0xb7, 0x52, 0x9a, 0x14, 0x4d, 0x0f, 0x8d, 0xa4, 0x28, 0x1f, 0xb5, 0x63, 0xb8,
+	0xac, 0xeb, 0x43, 0x01, 0x63, 0x31, 0x5c, 0x8e, 0x97, 0x0b, 0x91, 0xbb, 0x9b, 0x9d, 0x21, 0x4b,
+	0x25, 0x71, 0x0f, 0x45, 0x1b, 0xa4, 0x48, 0x51, 0x04, 0x28, 0xd0, 0x63, 0x11, 0xf4, 0x1f, 0x68,
+	0xff, 0x89, 0x02, 0xb9, 0x35, 0x40, 0x2f, 0x6d, 0x0f, 0x6e, 0x6b, 0xf7, 0xd0, 0xbf, 0xa1, 0x87,
+	0xa2, 0x98, 0x8f, 0x25, 0x77, 0xb9, 0x5c, 0x91, 0x0e, 0xd2, 0x43, 0x90, 0x13, 0x67, 0xde, 0xbc,
+	0x79, 0xef, 0x37, 0xef, 0x6b, 0xdf, 0x23, 0xac, 0x12, 0xd6, 0x22, 0x61, 0xc7, 0xf5, 0x98, 0x49,
+	0x7a, 0x1d, 0xb3, 0x77, 0x60, 0xbe, 0xd3, 0x25, 0xe1, 0x79, 0x2d, 0x08, 0x7d, 0xe6, 0xa3, 0xf2,
+	0xe0, 0xb4, 0x46, 0x7a, 0x9d, 0x5a, 0xef, 0x40, 0xdf, 0xb3, 0x7d, 0xda, 0xf1, 0xa9, 0xd9, 0xc0,
+	0x94, 0x48, 0x56, 0xb3, 0x77, 0xd0, 0x20, 0x0c, 0x1f, 0x98, 0x01, 0x76, 0x5c, 0x0f, 0x33, 0xd7,
+	0xf7, 0xe4, 0x6d, 0x5d, 0x4f, 0xc9, 0xe6, 0x42, 0xe4, 0xd9, 0xb5, 0xd4, 0x19, 0xeb, 0xab, 0xa3,
+	0x15, 0xc7, 0x77, 0x7c, 0xb1, 0x34, 0xf9, 0x4a, 0x51, 0x57, 0x1d, 0xdf, 0x77, 0xda, 0xc4, 0xc4,
+	0x81, 0x6b, 0x62, 0xcf, 0xf3, 0x99, 0xd0, 0x44, 0xd5, 0xe9, 0x86, 0x3a, 0x15, 0xbb, 0x46, 0xf7,
+	0xa1, 0xc9, 0xdc, 0x0e, 0xa1, 0x0c, 0x77, 0x02, 0xc9, 0x60, 0x7c, 0x1b, 0x96, 0xbf, 0xcf, 0xd1,
+	0x1e, 0xd9, 0xb6, 0xdf, 0xf5, 0x58, 0x9d, 0xbc, 0xd3, 0x25, 0x94, 0xa1, 0x0a, 0x14, 0x70, 0xb3,
+	0x19, 0x12, 0x4a, 0x2b, 0xda, 0xa6, 0xb6, 0xb3, 0x50, 0x8f, 0xb6, 0xaf, 0x14, 0x3f, 0xfc, 0x64,
+	0x63, 0xe6, 0xdf, 0x9f, 0x6c, 0xcc, 0x18, 0x36, 0xac, 0x24, 0xaf, 0xd2, 0xc0, 0xf7, 0x28, 0xe1,
+	0x77, 0x1b, 0xb8, 0x8d, 0x3d, 0x9b, 0x44, 0x77, 0xd5, 0x16, 0x7d, 0x1d, 0x16, 0x6c, 0xbf, 0x49,
+	0xac, 0x16, 0xa6, 0xad, 0xca, 0xac, 0x38, 0x2b, 0x72, 0xc2, 0x9b, 0x98, 0xb6, 0xd0, 0x0a, 0xcc,
+	0x79, 0x3e, 0xbf, 0x94, 0xdb, 0xd4, 0x76, 0xf2, 0x75, 0xb9, 0x31, 0xbe, 0x0b, 0xd7, 0x84, 0x92,
+	0x13, 0x61, 0xde, 0xcf, 0x81, 0xf2, 0x03, 0x0d, 0xf4, 0x71, 0x12, 0x14, 0xd8, 0x2d, 0x78, 0x4e,
+	0x7a, 0xce, 0x4a, 0x4a, 0x5a, 0x92, 0xd4, 0x23, 0x49, 0x44, 0x3a, 0x14, 0x29, 0x57, 0xca, 0xf1,
+	0xcd, 0x0a, 0x7c, 0x83, 0x3d, 0x17, 0x81, 0xa5, 0x54, 0xcb, 0xeb, 0x76, 0x1a, 0x24, 0x54, 0x2f,
+	0x58, 0x52, 0xd4, 0x3b, 0x82, 0x68, 0xdc, 0x82, 0x55, 0x81, 0xe3, 0x3e, 0x6e, 0xbb, 0x4d, 0xcc,
+	0xfc, 0x70, 0xe4, 0x31, 0xcf, 0xc3, 0xa2, 0xed, 0x7b, 0xa3, 0x38, 0x4a, 0x9c, 0x76, 0x94, 0x7a,
+	0xd5, 0x47, 0x1a, 0xac, 0x65, 0x48, 0x53, 0x0f, 0xdb, 0x86, 0x4b, 0x11, 0xaa, 0xa4, 0xc4, 0x08,
+	0xec, 0x17, 0xf8, 0xb4, 0x28, 0x88, 0x8e, 0xa5, 0x9f, 0x9f, 0xc5, 0x3d, 0xdf, 0x80, 0x95, 0xe4,
+	0xd5, 0x49, 0x41, 0x64, 0xdc, 0x52, 0xca, 0x7e, 0xc0, 0xfc, 0x10, 0x3b, 0x93, 0x95, 0xa1, 0x32,
+	0xe4, 0xce, 0xc8, 0xb9, 0x8a, 0x37, 0xbe, 0x8c, 0xa9, 0xdf, 0x87, 0x95, 0xa4, 0x30, 0xa5, 0x7e,
+	0x05, 0xe6, 0x7a, 0xb8, 0xdd, 0x8d, 0x94, 0xcb, 0x8d, 0xf1, 0x47, 0x0d, 0x56, 0xe3, 0xec, 0xa7,
+	0x9e, 0x1d, 0x9e, 0x07, 0x8c, 0x34, 0x3f, 0x07, 0x08, 0x54, 0x85, 0x4b, 0x5d, 0x4a, 0x42, 0x2b,
+	0xe8, 0x36, 0xda, 0xae, 0x6d, 0xf1, 0x53, 0x6e, 0xdc, 0xc5, 0xfa, 0x12, 0x27, 0xdf, 0x15, 0xd4,
+	0x5b, 0xe4, 0x1c, 0xad, 0xc2, 0x02, 0x75, 0x1d, 0x0f, 0xb3, 0x6e, 0x48, 0x2a, 0x79, 0xc1, 0x31,
+	0x24, 0xa0, 0x5d, 0x28, 0x37, 0x49, 0xd0, 0xf6, 0xcf, 0x3b, 0x84, 0x3b, 0x49, 0x24, 0xd0, 0x9c,
+	0xf0, 0xd1, 0xa5, 0x21, 0xfd, 0x0e, 0x27, 0xc7, 0x5e, 0xfd, 0x00, 0xd6, 0x32, 0x9e, 0x31, 0xee,
+	0xf9, 0x8b, 0xea, 0xf9, 0x1c, 0xb1, 0xc7, 0xd3, 0x37, 0x86, 0x78, 0x56, 0x22, 0xe6, 0xe4, 0x01,
+	0x62, 0xe3, 0x65, 0x28, 0xab, 0x8c, 0x6b, 0x3e, 0x53, 0x2c, 0x6c, 0xc3, 0xd7, 0x62, 0xf7, 0x14,
+	0x14, 0x04, 0x79, 0x5e, 0x22, 0x14, 0x12, 0xb1, 0x36, 0xde, 0x05, 0x24, 0x18, 0xef, 0xf5, 0x6f,
+	0xfb, 0x0e, 0x8d, 0x54, 0x20, 0xc8, 0x8b, 0xc2, 0x22, 0xe5, 0x8b, 0x35, 0x7a, 0x1d, 0x60, 0x58,
+	0x7e, 0x05, 0xda, 0xd2, 0x61, 0xb5, 0x26, 0x73, 0xbb, 0xc6, 0x6b, 0x75, 0x4d, 0x96, 0x75, 0x55,
+	0xab, 0x6b, 0x77, 0x87, 0x11, 0x55, 0x8f, 0xdd, 0x8c, 0x81, 0xfc, 0x85, 0x06, 0xcb, 0x09, 0xe5,
+	0x0a, 0xe7, 0x2e, 0xe4, 0xdb, 0xbe, 0xc3, 0x5f, 0x97, 0xdb, 0x29, 0x1d, 0x5e, 0xae, 0x8d, 0x7e,
+	0x21, 0x6a, 0xb7, 0x7d, 0xa7, 0x2e, 0x58, 0xd0, 0x1b, 0x63, 0x40, 0x6d, 0x4f, 0x04, 0x25, 0xf5,
+	0xc4, 0x51, 0x19, 0x2b, 0xca, 0x0e, 0x77, 0x71, 0x88, 0x3b, 0x91, 0x1d, 0x8c, 0xb7, 0x61, 0x39,
+	0x41, 0x55, 0x00, 0x5f, 0x86, 0xf9, 0x40, 0x50, 0x84, 0x81, 0x4a, 0x87, 0x95, 0x34, 0x44, 0x79,
+	0xe3, 0x38, 0xff, 0xe9, 0xe3, 0x8d, 0x99, 0xba, 0xe2, 0x36, 0xfe, 0xaa, 0xc1, 0x73, 0xa7, 0xac,
+	0x75, 0x82, 0xdb, 0xed, 0x98, 0xa5, 0x71, 0xe8, 0xd0, 0xc8, 0x27, 0x7c, 0x8d, 0xae, 0x42, 0xc1,
+	0xc1, 0xd4, 0xb2, 0x71, 0xa0, 0xaa, 0xc8, 0xbc, 0x83, 0xe9, 0x09, 0x0e, 0xd0, 0x03, 0x28, 0x07,
+	0xa1, 0x1f, 0xf8, 0x3c, 0xd6, 0xa3, 0x10, 0x10, 0x81, 0x7e, 0x7c, 0xf8, 0x9f, 0xc7, 0x1b, 0x35,
+	0xc7, 0x65, 0xad, 0x6e, 0xa3, 0x66, 0xfb, 0x1d, 0x53, 0x7d, 0x42, 0xe5, 0xcf, 0x0b, 0xb4, 0x79,
+	0x66, 0xb2, 0xf3, 0x80, 0xd0, 0xda, 0xc9, 0xb0, 0x04, 0xd6, 0x2f, 0x45, 0xb2, 0x14, 0x01, 0x5d,
+	0x83, 0xa2, 0xdd, 0xc2, 0xae, 0x67, 0xb9, 0x4d, 0x91, 0x1d, 0xb9, 0x7a, 0x41, 0xec, 0xdf, 0x6a,
+	0xa2, 0x4d, 0x28, 0x75, 0x3d, 0x12, 0x05, 0xb7, 0x48, 0x8b, 0x62, 0x3d, 0x4e, 0x32, 0x1a, 0xb0,
+	0x7c, 0x4a, 0x99, 0xdb, 0xc1, 0x8c, 0xbc, 0x81, 0x87, 0xa6, 0x2a, 0x43, 0xce, 0xc1, 0xf2, 0x79,
+	0xf9, 0x3a, 0x5f, 0xa2, 0x2b, 0x30, 0xff, 0x10, 0xbb, 0x6d, 0xd2, 0x14, 0x8f, 0x2b, 0xd6, 0xd5,
+	0x8e, 0x17, 0xed, 0x90, 0xb0, 0x6e, 0xe8, 0x59, 0x32, 0x5f, 0x64, 0x06, 0x97, 0x24, 0xed, 0xbe,
+	0x28, 0x1a, 0xbf, 0xd1, 0xa0, 0x72, 0x12, 0x12, 0xcc, 0xc8, 0x91, 0x6d, 0x13, 0x4a, 0x6f, 0xbb,
+	0x74, 0x58, 0xa5, 0x5f, 0x83, 0x12, 0x16, 0x54, 0xab, 0xed, 0x52, 0xa6, 0x82, 0x67, 0x2d, 0xed,
+	0x19, 0x79, 0xf5, 0x5e, 0x37, 0x68, 0x13, 0xe5, 0x1e, 0xc0, 0x03, 0x69, 0xdc, 0x06, 0xdc, 0xf6,
+	0x5d, 0xaa, 0xf0, 0xe5, 0xeb, 0xdc, 0x17, 0x3f, 0xa4, 0xa4, 0xc9, 0x8f, 0x7a, 0x1d, 0x8b, 0x84,
+	0xa1, 0x2f, 0x6b, 0xf7, 0x42, 0xbd, 0xd0, 0xeb, 0x9c, 0xf2, 0xad, 0xf1, 0x4f, 0x0d, 0xae, 0xc8,
+	0x32, 0xe0, 0x76, 0xba, 0x6d, 0xcc, 0xc8, 0xfd, 0x83, 0x98, 0x83, 0xfd, 0x80, 0x0d, 0x1c, 0xcc,
+	0xd7, 0x5f, 0x4e, 0x07, 0xbf, 0x00, 0x57, 0x53, 0x4f, 0x1c, 0x16, 0x96, 0x26, 0x66, 0x38, 0x7a,
+	0x23, 0x5f, 0x1b, 0xff, 0xcd, 0x45, 0xc9, 0x1d, 0x62, 0x9b, 0xdc, 0xeb, 0x47, 0xf6, 0x30, 0x21,
+	0xd7, 0xa1, 0x8e, 0x4a, 0x9c, 0x31, 0xee, 0x79, 0x9b, 0x3a, 0x6f, 0x62, 0xaf, 0xd9, 0xe6, 0x57,
+	0x38, 0x27, 0x7a, 0x15, 0x16, 0x19, 0x17, 0x61, 0xd9, 0xbe, 0xf7, 0xd0, 0x75, 0x2a, 0xb9, 0xac,
+	0x9b, 0x42, 0xd1, 0x89, 0x60, 0xaa, 0x97, 0xd8, 0x70, 0x83, 0x8e, 0x60, 0x31, 0x08, 0x49, 0x93,
+	0x70, 0x27, 0xfb, 0x21, 0xad, 0xe4, 0x37, 0x73, 0x93, 0x75, 0x27, 0xae, 0xf0, 0xe0, 0x6c, 0xb4,
+	0x7d, 0xfb, 0x2c, 0xfa, 0x76, 0xcf, 0x09, 0xeb, 0x95, 0x04, 0x4d, 0x7e, 0xb9, 0xd1, 0x1a, 0x80,
+	0x64, 0x11, 0x95, 0x73, 0x5e, 0x04, 0xc8, 0x82, 0xa0, 0x88, 0x9e, 0xec, 0x24, 0x3a, 0x66, 0x6e,
+	0x87, 0x54, 0x0a, 0xe2, 0x11, 0x7a, 0x4d, 0xf6, 0x94, 0xb5, 0xa8, 0xa7, 0xac, 0xdd, 0x8b, 0x7a,
+	0xca, 0xe3, 0x22, 0x0f, 0xcd, 0x8f, 0xff, 0xbe, 0xa1, 0x29, 0x21, 0xfc, 0x64, 0x6c, 0x7c, 0x14,
+	0xff, 0x3f, 0xf1, 0xb1, 0x70, 0x61, 0x7c, 0x40, 0x2a, 0x3e, 0xbe, 0x97, 0x2f, 0xce, 0x96, 0x73,
+	0xf5, 0x22, 0xeb, 0x5b, 0xae, 0xd7, 0x24, 0x7d, 0x63, 0x4f, 0xf5, 0x03, 0x03, 0xff, 0x5f, 0x10,
+	0x2c, 0xbf, 0xcc, 0xc1, 0x95, 0x21, 0xf3, 0x31, 0x7f, 0x6f, 0x2c, 0x5e, 0x58, 0x9f, 0x56, 0xb4,
+	0x69, 0x7c, 0xc6, 0x39, 0xbf, 0x80, 0x78, 0xf9, 0xaa, 0x3b, 0x7b, 0x90, 0xea, 0x71, 0x6f, 0x5c,
+	0xe0, 0xbd, 0xdf, 0xcd, 0xc2, 0xe5, 0x21, 0xff, 0x97, 0xf0, 0xeb, 0x36, 0x1a, 0x32, 0x73, 0xcf,
+	0x1c, 0x32, 0x23, 0xe9, 0x31, 0x9f, 0x2e, 0x9f, 0xfb, 0x70, 0x65, 0xd4, 0x46, 0x17, 0x98, 0xf4,
+	0xf2, 0x60, 0x0c, 0xa0, 0xe4, 0x75, 0x12, 0xf5, 0x51, 0xc6, 0x03, 0x58, 0x49, 0x92, 0x95, 0x88,
+	0x53, 0x28, 0xf2, 0x66, 0xc7, 0x7a, 0x48, 0x54, 0x9b, 0x7d, 0xbc, 0xf7, 0xb7, 0xc7, 0x1b, 0xd5,
+	0x29, 0x4c, 0xf6, 0x96, 0xc7, 0xf8, 0x3c, 0x20, 0xc4, 0x19, 0xdf, 0x52, 0x4d, 0xd0, 0x9d, 0x78,
+	0x0f, 0x9a, 0x4a, 0x07, 0xf9, 0x2d, 0x8f, 0xa7, 0x83, 0xf1, 0x1a, 0xe8, 0xe9, 0x8b, 0x03, 0x74,
+	0x63, 0x9a, 0x5d, 0x35, 0x19, 0x26, 0x9b, 0xdd, 0xa8, 0x07, 0x3b, 0x0d, 0x7c, 0xbb, 0x35, 0xe8,
+	0xc1, 0x6e, 0xc3, 0x72, 0x82, 0xaa, 0x84, 0xbe, 0x04, 0xf3, 0x44, 0x50, 0x54, 0x69, 0xb8, 0x9a,
+	0xf6, 0x96, 0xb8, 0x11, 0xb5, 0x60, 0x92, 0xf9, 0xf0, 0x4f, 0xcb, 0x30, 0x27, 0xc4, 0xa1, 0x9f,
+	0x6b, 0x50, 0x50, 0x93, 0x1e, 0xda, 0x4a, 0x5f, 0x1e, 0x33, 0xca, 0xeb, 0xd5, 0x49, 0x6c, 0x12,
+	0x9b, 0x71, 0xf3, 0xa7, 0x7f, 0xfe, 0xd7, 0xaf, 0x67, 0xb7, 0xd0, 0x75, 0x33, 0xf5, 0x17, 0x84,
+	0x9a, 0xf6, 0xcc, 0xf7, 0x54, 0x84, 0x3f, 0x42, 0xbf, 0xd5, 0x60, 0x29, 0x31, 0x50, 0xa3, 0x9b,
+	0x19, 0x6a, 0xc6, 0x0d, 0xee, 0xfa, 0xfe, 0x74, 0xcc, 0x0a, 0xd9, 0xa1, 0x40, 0xb6, 0x8f, 0xf6,
+	0xd2, 0xc8, 0xa2, 0xd9, 0x3d, 0x05, 0xf0, 0xf7, 0x1a, 0x94, 0x47, 0x67, 0x63, 0x54, 0xcb, 0x50,
+	0x9b, 0x31, 0x92, 0xeb, 0xe6, 0xd4, 0xfc, 0x0a, 0xe9, 0x2b, 0x02, 0xe9, 0x37, 0xd1, 0x61, 0x1a,
+	0x69, 0x2f, 0xba, 0x33, 0x04, 0x1b, 0x1f, 0xf7, 0x1f, 0xa1, 0x0f, 0x34, 0x28, 0xa8, 0x29, 0x38,
+	0xd3, 0xb5, 0xc9, 0x01, 0x5b, 0xaf, 0x4e, 0x62, 0x53, 0xb0, 0xf6, 0x05, 0xac, 0x2a, 0xba, 0x91,
+	0x86, 0xa5, 0xa6, 0x6a, 0x1a, 0x33, 0xdd, 0x47, 0x1a, 0x14, 0xd4, 0x64, 0x98, 0x09, 0x24, 0x39,
+	0x7c, 0xeb, 0xd5, 0x49, 0x6c, 0x0a, 0xc8, 0x81, 0x00, 0x72, 0x13, 0xed, 0xa6, 0x81, 0x50, 0xc9,
+	0x3a, 0xc4, 0x61, 0xbe, 0x77, 0x46, 0xce, 0x1f, 0xa1, 0x3f, 0x68, 0x50, 0x1e, 0x9d, 0x53, 0x33,
+	0x1d, 0x99, 0x31, 0x97, 0xeb, 0xe6, 0xd4, 0xfc, 0x0a, 0xe8, 0x77, 0x04, 0xd0, 0x97, 0xd0, 0x8b,
+	0x99, 0x40, 0xad, 0x41, 0x95, 0x4c, 0x41, 0x7e, 0x17, 0xf2, 0x7c, 0x84, 0x45, 0x46, 0x66, 0x94,
+	0x0f, 0xe6, 0x62, 0xfd, 0xfa, 0x85, 0x3c, 0x0a, 0xcd, 0xae, 0x40, 0x73, 0x1d, 0x3d, 0x3f, 0x2e,
+	0x01, 0x9a, 0x09, 0xe7, 0xfd, 0x18, 0xe6, 0xe5, 0x14, 0x87, 0x6e, 0x64, 0x48, 0x4e, 0x0c, 0x8b,
+	0xfa, 0xd6, 0x04, 0x2e, 0x85, 0x60, 0x53, 0x20, 0xd0, 0x51, 0x25, 0x8d, 0x40, 0x8e, 0x89, 0xa8,
+	0x0f, 0x05, 0x35, 0x25, 0xa2, 0xcd, 0x31, 0x55, 0x2d, 0x31, 0x40, 0xea, 0xdb, 0x63, 0x5b, 0xa2,
+	0x53, 0x4e, 0x23, 0xdd, 0xce, 0xb0, 0xef, 0x32, 0x0c, 0xa1, 0x77, 0x15, 0xe9, 0x69, 0xbd, 0x84,
+	0xb5, 0x2c, 0x9b, 0xab, 0xfb, 0x09, 0x94, 0x62, 0x43, 0xdc, 0x14, 0xda, 0xc7, 0xbc, 0x79, 0xcc,
+	0x14, 0x68, 0x54, 0x85, 0xee, 0x4d, 0xb4, 0x3e, 0x46, 0xb7, 0x62, 0xb7, 0xf8, 0x6c, 0xf8, 0x2b,
+	0x0d, 0xca, 0xa3, 0x03, 0xde, 0x14, 0x28, 0xf6, 0xd2, 0x1c, 0x59, 0x63, 0xe2, 0x45, 0x09, 0x6c,
+	0x8b, 0x3b, 0x56, 0x6c, 0x8a, 0x44, 0x1f, 0x6a, 0x00, 0xc3, 0x81, 0x07, 0xed, 0x64, 0x05, 0xff,
+	0xe8, 0xd8, 0xa7, 0xef, 0x4e, 0xc1, 0xa9, 0x10, 0x6d, 0x09, 0x44, 0x1b, 0x68, 0x6d, 0x4c, 0x82,
+	0x28, 0x6e, 0xab, 0x77, 0x80, 0xde, 0x87, 0x82, 0x6a, 0xa5, 0x33, 0x4b, 0x49, 0x72, 0xd4, 0xd2,
+	0xab, 0x93, 0xd8, 0x26, 0x47, 0x86, 0x6c, 0x8b, 0x58, 0x5f, 0x18, 0x62, 0xd8, 0x0e, 0x66, 0x1a,
+	0x22, 0xd5, 0xbf, 0xeb, 0xbb, 0x53, 0x70, 0x4e, 0x36, 0x84, 0xc4, 0x21, 0x5a, 0x0e, 0xf4, 0x33,
+	0x0d, 0x16, 0x06, 0x5d, 0x14, 0xda, 0xbe, 0x48, 0x7e, 0x3c, 0x48, 0x76, 0x26, 0x33, 0x2a, 0x1c,
+	0x37, 0x04, 0x8e, 0x75, 0xb4, 0x9a, 0x85, 0x43, 0xe4, 0xca, 0xfb, 0xfc, 0x1b, 0x23, 0xfa, 0xa6,
+	0x0b, 0xbe, 0x31, 0xf1, 0xee, 0x4d, 0xaf, 0x4e, 0x62, 0x9b, 0xec, 0x8f, 0xa8, 0xcb, 0xe3, 0x99,
+	0xb2, 0x94, 0x6c, 0xd3, 0xb2, 0x8a, 0x54, 0x82, 0x4b, 0xdf, 0x9f, 0x86, 0x6b, 0x9a, 0x6a, 0x39,
+	0xd2, 0xd1, 0xf1, 0x6a, 0x29, 0x3b, 0xb4, 0x4c, 0x20, 0x89, 0xb6, 0x4e, 0xdf, 0x9a, 0xc0, 0x35,
+	0xb9, 0x5a, 0xca, 0x8e, 0xee, 0xf8, 0xd5, 0x4f, 0x9f, 0xac, 0x6b, 0x9f, 0x3d, 0x59, 0xd7, 0xfe,
+	0xf1, 0x64, 0x5d, 0xfb, 0xf8, 0xe9, 0xfa, 0xcc, 0x67, 0x4f, 0xd7, 0x67, 0xfe, 0xf2, 0x74, 0x7d,
+	0xe6, 0x47, 0xf1, 0xfe, 0x97, 0xf4, 0x78, 0xfb, 0x3b, 0x94, 0xd1, 0x17, 0x52, 0x44, 0x0f, 0xdc,
+	0x98, 0x17, 0x13, 0xd9, 0x8b, 0xff, 0x1b, 0x00, 0x39, 0x55, 0x46, 0xae, 0xa9, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1563,6 +2116,9 @@ type QueryClient interface {
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// StorageEncrypted queries storage slot of the contract on behalf of its
+	// owner. Returned value is encrypted for the owner.
+	StorageEncrypted(ctx context.Context, in *QueryStorageEncryptedRequest, opts ...grpc.CallOption) (*QueryStorageEncryptedResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/evm module.
@@ -1571,16 +2127,24 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *QuerySimulateV1Request, opts ...grpc.CallOption) (*QuerySimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork
 	// status.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	NodePublicKey(ctx context.Context, in *QueryNodePublicKey, opts ...grpc.CallOption) (*QueryNodePublicKeyResponse, error)
+	// Epochs queries the schedule of epochs agreed by consensus
+	Epochs(ctx context.Context, in *QueryEpochsRequest, opts ...grpc.CallOption) (*QueryEpochsResponse, error)
 }
 
 type queryClient struct {
@@ -1636,6 +2200,15 @@ func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) StorageEncrypted(ctx context.Context, in *QueryStorageEncryptedRequest, opts ...grpc.CallOption) (*QueryStorageEncryptedResponse, error) {
+	out := new(QueryStorageEncryptedResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/StorageEncrypted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
 	out := new(QueryCodeResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Code", in, out, opts...)
@@ -1672,6 +2245,24 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error) {
+	out := new(CreateAccessListResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CreateAccessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateV1(ctx context.Context, in *QuerySimulateV1Request, opts ...grpc.CallOption) (*QuerySimulateV1Response, error) {
+	out := new(QuerySimulateV1Response)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceTx", in, out, opts...)
@@ -1690,6 +2281,15 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
@@ -1708,6 +2308,15 @@ func (c *queryClient) NodePublicKey(ctx context.Context, in *QueryNodePublicKey,
 	return out, nil
 }
 
+func (c *queryClient) Epochs(ctx context.Context, in *QueryEpochsRequest, opts ...grpc.CallOption) (*QueryEpochsResponse, error) {
+	out := new(QueryEpochsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Epochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1722,6 +2331,9 @@ type QueryServer interface {
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// StorageEncrypted queries storage slot of the contract on behalf of its
+	// owner. Returned value is encrypted for the owner.
+	StorageEncrypted(context.Context, *QueryStorageEncryptedRequest) (*QueryStorageEncryptedResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/evm module.
@@ -1730,16 +2342,24 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(context.Context, *EthCallRequest) (*CreateAccessListResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *QuerySimulateV1Request) (*QuerySimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork
 	// status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	NodePublicKey(context.Context, *QueryNodePublicKey) (*QueryNodePublicKeyResponse, error)
+	// Epochs queries the schedule of epochs agreed by consensus
+	Epochs(context.Context, *QueryEpochsRequest) (*QueryEpochsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1761,6 +2381,9 @@ func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceR
 func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageRequest) (*QueryStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
 }
+func (*UnimplementedQueryServer) StorageEncrypted(ctx context.Context, req *QueryStorageEncryptedRequest) (*QueryStorageEncryptedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageEncrypted not implemented")
+}
 func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
@@ -1773,18 +2396,30 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) CreateAccessList(ctx context.Context, req *EthCallRequest) (*CreateAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessList not implemented")
+}
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *QuerySimulateV1Request) (*QuerySimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
 func (*UnimplementedQueryServer) NodePublicKey(ctx context.Context, req *QueryNodePublicKey) (*QueryNodePublicKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NodePublicKey not implemented")
 }
+func (*UnimplementedQueryServer) Epochs(ctx context.Context, req *QueryEpochsRequest) (*QueryEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Epochs not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1880,6 +2515,24 @@ func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageEncrypted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageEncryptedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageEncrypted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/StorageEncrypted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageEncrypted(ctx, req.(*QueryStorageEncryptedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCodeRequest)
 	if err := dec(in); err != nil {
@@ -1952,6 +2605,42 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CreateAccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateAccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/CreateAccessList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateAccessList(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/SimulateV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*QuerySimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -1988,6 +2677,24 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -2024,8 +2731,26 @@ func _Query_NodePublicKey_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethermint.evm.v1.Query",
+func _Query_Epochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Epochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/Epochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Epochs(ctx, req.(*QueryEpochsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethermint.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -2048,6 +2773,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Storage",
 			Handler:    _Query_Storage_Handler,
 		},
+		{
+			MethodName: "StorageEncrypted",
+			Handler:    _Query_StorageEncrypted_Handler,
+		},
 		{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
@@ -2064,6 +2793,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "CreateAccessList",
+			Handler:    _Query_CreateAccessList_Handler,
+		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2072,6 +2809,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
@@ -2080,6 +2821,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NodePublicKey",
 			Handler:    _Query_NodePublicKey_Handler,
 		},
+		{
+			MethodName: "Epochs",
+			Handler:    _Query_Epochs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/query.proto",
@@ -2424,6 +3169,99 @@ func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryStorageEncryptedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageEncryptedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageEncryptedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeploymentNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DeploymentNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UserPublicKey) > 0 {
+		i -= len(m.UserPublicKey)
+		copy(dAtA[i:], m.UserPublicKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserPublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageEncryptedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageEncryptedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageEncryptedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodePublicKey) > 0 {
+		i -= len(m.NodePublicKey)
+		copy(dAtA[i:], m.NodePublicKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodePublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2733,6 +3571,142 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreateAccessListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAccessListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAccessListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateV1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unencrypted {
+		i--
+		if m.Unencrypted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateV1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2977,7 +3951,7 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2987,44 +3961,143 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Unencrypted {
+		i--
+		if m.Unencrypted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BaseFee != nil {
+	if m.TraceConfig != nil {
 		{
-			size := m.BaseFee.Size()
-			i -= size
-			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
 			i = encodeVarintQuery(dAtA, i, uint64(size))
@@ -3093,6 +4166,66 @@ func (m *QueryNodePublicKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3257,6 +4390,51 @@ func (m *QueryStorageResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStorageEncryptedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UserPublicKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DeploymentNonce != 0 {
+		n += 1 + sovQuery(uint64(m.DeploymentNonce))
+	}
+	return n
+}
+
+func (m *QueryStorageEncryptedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NodePublicKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryCodeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3384,6 +4562,67 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *CreateAccessListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Unencrypted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3487,17 +4726,60 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
+func (m *QueryTraceCallRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Unencrypted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -3534,6 +4816,30 @@ func (m *QueryNodePublicKeyResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4519,7 +5825,7 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageEncryptedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4542,10 +5848,10 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageEncryptedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageEncryptedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4580,59 +5886,75 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPublicKey", wireType)
 			}
-			if iNdEx >= l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UserPublicKey = append(m.UserPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.UserPublicKey == nil {
+				m.UserPublicKey = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4659,11 +5981,30 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentNonce", wireType)
+			}
+			m.DeploymentNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeploymentNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4685,7 +6026,7 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageEncryptedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4708,17 +6049,17 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageEncryptedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageEncryptedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4728,29 +6069,31 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePublicKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4760,26 +6103,24 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NodePublicKey = append(m.NodePublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodePublicKey == nil {
+				m.NodePublicKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4803,7 +6144,7 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4826,17 +6167,17 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4846,60 +6187,106 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4923,7 +6310,7 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4946,12 +6333,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4973,7 +6428,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4996,15 +6451,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5031,7 +6486,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5056,7 +6548,57 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5075,21 +6617,923 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unencrypted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unencrypted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnValue = append(m.ReturnValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnValue == nil {
+				m.ReturnValue = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateAccessListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateAccessListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateAccessListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessTuple{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateV1Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateV1Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unencrypted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unencrypted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateV1Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateV1Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgHandleTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predecessors = append(m.Predecessors, &MsgHandleTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5099,31 +7543,29 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
-			if m.Args == nil {
-				m.Args = []byte{}
-			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
-			m.GasCap = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5133,12 +7575,26 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasCap |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -5172,7 +7628,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -5191,7 +7647,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Unencrypted", wireType)
 			}
@@ -5232,7 +7688,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5255,54 +7711,15 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-			}
-			m.Gas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Failed = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5329,9 +7746,9 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReturnValue = append(m.ReturnValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.ReturnValue == nil {
-				m.ReturnValue = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5355,7 +7772,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5378,15 +7795,15 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5413,10 +7830,8 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgHandleTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Txs = append(m.Txs, &MsgHandleTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5456,40 +7871,6 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predecessors = append(m.Predecessors, &MsgHandleTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
@@ -5627,26 +8008,6 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unencrypted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Unencrypted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5668,7 +8029,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5691,10 +8052,10 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5750,76 +8111,42 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
-	return nil
-}
-func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+	return nil
+}
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &MsgHandleTx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5829,33 +8156,31 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
 			}
-			m.BlockNumber = 0
+			m.GasCap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5865,16 +8190,16 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
+				m.GasCap |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5884,29 +8209,31 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5916,30 +8243,16 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5949,31 +8262,33 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unencrypted", wireType)
 			}
-			m.ChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5983,11 +8298,12 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Unencrypted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6009,7 +8325,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6032,10 +8348,10 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6380,6 +8696,140 @@ func (m *QueryNodePublicKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, Epoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,230 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// SetCodeTxType is the type of EIP-7702 set code transactions.
+	//
+	// NOTE: go-ethereum v1.10 transactions cannot represent this type, therefore
+	// hashing, signing and binary encoding of such transactions are implemented
+	// by SetCodeTx.
+	SetCodeTxType = 0x04
+
+	// SetCodeAuthorizationMagic is prepended to RLP encoded authorization
+	// before hashing, to avoid signature collisions with other signed payloads
+	SetCodeAuthorizationMagic = 0x05
+
+	// PerEmptyAccountCost is the intrinsic gas charged for each authorization
+	PerEmptyAccountCost = 25000
+
+	// PerAuthBaseCost is the cost of processing an authorization. Difference
+	// with PerEmptyAccountCost is refunded if the authority account exists.
+	PerAuthBaseCost = 12500
+)
+
+// DelegationPrefix is the prefix of delegation designator, which is stored as code
+// of an account, which delegated its execution to another address (EIP-7702)
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// AddressToDelegation returns delegation designator for provided address
+func AddressToDelegation(addr common.Address) []byte {
+	return append(common.CopyBytes(DelegationPrefix), addr.Bytes()...)
+}
+
+// ParseDelegation returns target address of delegation designator. If provided
+// code is not a valid delegation designator, it returns false
+func ParseDelegation(code []byte) (common.Address, bool) {
+	if len(code) != len(DelegationPrefix)+common.AddressLength || !bytes.HasPrefix(code, DelegationPrefix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(code[len(DelegationPrefix):]), true
+}
+
+// SetCodeAuthorization is an authorization from an account to set code of
+// this account to the delegation designator of provided address (EIP-7702)
+type SetCodeAuthorization struct {
+	ChainID *hexutil.Big   `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	V       hexutil.Uint64 `json:"yParity"`
+	R       *hexutil.Big   `json:"r"`
+	S       *hexutil.Big   `json:"s"`
+}
+
+// authorizationRLP is the RLP encoding of set code authorization
+type authorizationRLP struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V       uint64
+	R       *big.Int
+	S       *big.Int
+}
+
+// SignSetCodeAuthorization signs provided authorization using private key of
+// authority account
+func SignSetCodeAuthorization(auth SetCodeAuthorization, prv *ecdsa.PrivateKey) (SetCodeAuthorization, error) {
+	sig, err := crypto.Sign(auth.SigHash().Bytes(), prv)
+	if err != nil {
+		return SetCodeAuthorization{}, err
+	}
+
+	auth.R = (*hexutil.Big)(new(big.Int).SetBytes(sig[:32]))
+	auth.S = (*hexutil.Big)(new(big.Int).SetBytes(sig[32:64]))
+	auth.V = hexutil.Uint64(sig[64])
+	return auth, nil
+}
+
+// SigHash returns hash of authorization, which is signed by authority
+func (auth SetCodeAuthorization) SigHash() common.Hash {
+	enc, err := rlp.EncodeToBytes([]interface{}{
+		auth.ChainID.ToInt(),
+		auth.Address,
+		uint64(auth.Nonce),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash([]byte{SetCodeAuthorizationMagic}, enc)
+}
+
+// Validate performs a stateless validation of the authorization fields
+func (auth SetCodeAuthorization) Validate() error {
+	if auth.ChainID == nil || auth.R == nil || auth.S == nil {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "chain id and signature values cannot be nil")
+	}
+	if auth.ChainID.ToInt().Sign() < 0 || auth.ChainID.ToInt().BitLen() > 256 {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "invalid chain id %s", auth.ChainID)
+	}
+	if uint64(auth.Nonce) == math.MaxUint64 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "nonce overflow")
+	}
+	if auth.V > 1 {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "invalid y parity %d", auth.V)
+	}
+	// signatures with high s value are rejected according to EIP-2
+	if !crypto.ValidateSignatureValues(byte(auth.V), auth.R.ToInt(), auth.S.ToInt(), true) {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "invalid signature values")
+	}
+	return nil
+}
+
+// Authority recovers address of the account, which signed the authorization
+func (auth SetCodeAuthorization) Authority() (common.Address, error) {
+	if err := auth.Validate(); err != nil {
+		return common.Address{}, err
+	}
+
+	addr, err := recoverAddress(auth.SigHash(), byte(auth.V), auth.R.ToInt(), auth.S.ToInt())
+	if err != nil {
+		return common.Address{}, errorsmod.Wrap(ErrInvalidAuthorization, err.Error())
+	}
+	return addr, nil
+}
+
+func (auth SetCodeAuthorization) toRLP() authorizationRLP {
+	return authorizationRLP{
+		ChainID: auth.ChainID.ToInt(),
+		Address: auth.Address,
+		Nonce:   uint64(auth.Nonce),
+		V:       uint64(auth.V),
+		R:       auth.R.ToInt(),
+		S:       auth.S.ToInt(),
+	}
+}
+
+func (auth authorizationRLP) toSetCodeAuthorization() SetCodeAuthorization {
+	return SetCodeAuthorization{
+		ChainID: (*hexutil.Big)(auth.ChainID),
+		Address: auth.Address,
+		Nonce:   hexutil.Uint64(auth.Nonce),
+		V:       hexutil.Uint64(auth.V),
+		R:       (*hexutil.Big)(auth.R),
+		S:       (*hexutil.Big)(auth.S),
+	}
+}
+
+// AuthorizationList is an EIP-7702 authorization list that represents the slice of
+// the protobuf AuthorizationTuples.
+type AuthorizationList []AuthorizationTuple
+
+// NewAuthorizationList creates a new protobuf-compatible AuthorizationList from
+// set code authorizations
+func NewAuthorizationList(auths []SetCodeAuthorization) AuthorizationList {
+	if auths == nil {
+		return nil
+	}
+
+	al := AuthorizationList{}
+	for _, auth := range auths {
+		tuple := AuthorizationTuple{
+			Address: auth.Address.Hex(),
+			Nonce:   uint64(auth.Nonce),
+			V:       uint64(auth.V),
+		}
+		if auth.ChainID != nil {
+			chainID := sdkmath.NewIntFromBigInt(auth.ChainID.ToInt())
+			tuple.ChainID = &chainID
+		}
+		if auth.R != nil {
+			tuple.R = auth.R.ToInt().Bytes()
+		}
+		if auth.S != nil {
+			tuple.S = auth.S.ToInt().Bytes()
+		}
+		al = append(al, tuple)
+	}
+
+	return al
+}
+
+// ToSetCodeAuthorizations is an utility function to convert the protobuf compatible
+// AuthorizationList to set code authorizations
+func (al AuthorizationList) ToSetCodeAuthorizations() []SetCodeAuthorization {
+	auths := make([]SetCodeAuthorization, 0, len(al))
+	for _, tuple := range al {
+		auth := SetCodeAuthorization{
+			Address: common.HexToAddress(tuple.Address),
+			Nonce:   hexutil.Uint64(tuple.Nonce),
+			V:       hexutil.Uint64(tuple.V),
+			R:       (*hexutil.Big)(new(big.Int).SetBytes(tuple.R)),
+			S:       (*hexutil.Big)(new(big.Int).SetBytes(tuple.S)),
+		}
+		if tuple.ChainID != nil {
+			auth.ChainID = (*hexutil.Big)(tuple.ChainID.BigInt())
+		}
+		auths = append(auths, auth)
+	}
+
+	return auths
+}
+
+// recoverAddress recovers address of the account, which signed provided hash.
+// Signature values should be validated by the caller.
+func recoverAddress(hash common.Hash, v byte, r, s *big.Int) (common.Address, error) {
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = v
+
+	pub, err := crypto.Ecrecover(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var addr common.Address
+	copy(addr[:], crypto.Keccak256(pub[1:])[12:])
+	return addr, nil
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func (suite *TxDataTestSuite) TestDelegation() {
+	delegation := AddressToDelegation(suite.addr)
+	suite.Require().Len(delegation, 23)
+	suite.Require().Equal(DelegationPrefix, delegation[:3])
+
+	addr, ok := ParseDelegation(delegation)
+	suite.Require().True(ok)
+	suite.Require().Equal(suite.addr, addr)
+
+	testCases := []struct {
+		name string
+		code []byte
+	}{
+		{"empty code", nil},
+		{"contract code", []byte{0x60, 0x80, 0x60, 0x40}},
+		{"prefix only", DelegationPrefix},
+		{"invalid prefix", append([]byte{0xef, 0x01, 0x01}, suite.addr.Bytes()...)},
+		{"trailing bytes", append(AddressToDelegation(suite.addr), 0x00)},
+	}
+
+	for _, tc := range testCases {
+		_, ok := ParseDelegation(tc.code)
+		suite.Require().False(ok, tc.name)
+	}
+}
+
+func (suite *TxDataTestSuite) TestSetCodeAuthorization() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	authority := crypto.PubkeyToAddress(key.PublicKey)
+
+	auth, err := SignSetCodeAuthorization(SetCodeAuthorization{
+		ChainID: (*hexutil.Big)(big.NewInt(1291)),
+		Address: suite.addr,
+		Nonce:   5,
+	}, key)
+	suite.Require().NoError(err)
+
+	recovered, err := auth.Authority()
+	suite.Require().NoError(err)
+	suite.Require().Equal(authority, recovered)
+
+	// authorization is bound to chain id, delegated address and nonce
+	modified := auth
+	modified.Nonce++
+	recovered, err = modified.Authority()
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(authority, recovered)
+
+	bz, err := json.Marshal(auth)
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(bz), `"chainId":"0x50b"`)
+	suite.Require().Contains(string(bz), `"yParity":`)
+
+	var decoded SetCodeAuthorization
+	suite.Require().NoError(json.Unmarshal(bz, &decoded))
+	suite.Require().Equal(auth, decoded)
+}
+
+func (suite *TxDataTestSuite) TestSetCodeAuthorizationValidate() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+
+	auth, err := SignSetCodeAuthorization(SetCodeAuthorization{
+		ChainID: (*hexutil.Big)(big.NewInt(0)),
+		Address: common.Address{},
+		Nonce:   0,
+	}, key)
+	suite.Require().NoError(err)
+
+	secp256k1N := crypto.S256().Params().N
+
+	testCases := []struct {
+		name     string
+		malleate func(auth *SetCodeAuthorization)
+		expPass  bool
+	}{
+		{"valid authorization", func(auth *SetCodeAuthorization) {}, true},
+		{"nil chain id", func(auth *SetCodeAuthorization) { auth.ChainID = nil }, false},
+		{"negative chain id", func(auth *SetCodeAuthorization) { auth.ChainID = (*hexutil.Big)(big.NewInt(-1)) }, false},
+		{"chain id overflow", func(auth *SetCodeAuthorization) { auth.ChainID = (*hexutil.Big)(suite.overflowBigInt) }, false},
+		{"nonce overflow", func(auth *SetCodeAuthorization) { auth.Nonce = hexutil.Uint64(^uint64(0)) }, false},
+		{"invalid y parity", func(auth *SetCodeAuthorization) { auth.V = 27 }, false},
+		{"nil r", func(auth *SetCodeAuthorization) { auth.R = nil }, false},
+		{"zero s", func(auth *SetCodeAuthorization) { auth.S = (*hexutil.Big)(big.NewInt(0)) }, false},
+		{
+			"high s",
+			func(auth *SetCodeAuthorization) {
+				auth.S = (*hexutil.Big)(new(big.Int).Sub(secp256k1N, auth.S.ToInt()))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		authCopy := auth
+		tc.malleate(&authCopy)
+
+		err := authCopy.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			continue
+		}
+		suite.Require().ErrorIs(err, ErrInvalidAuthorization, tc.name)
+
+		_, err = authCopy.Authority()
+		suite.Require().Error(err, tc.name)
+	}
+}
@@ -0,0 +1,474 @@
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"swisstronik/types"
+)
+
+// setCodeTxRLP is the RLP encoding of signed EIP-7702 set code transaction
+type setCodeTxRLP struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	AuthList   []authorizationRLP
+	V          *big.Int
+	R          *big.Int
+	S          *big.Int
+}
+
+func newSetCodeTx(enc setCodeTxRLP) (*SetCodeTx, error) {
+	txData := &SetCodeTx{
+		Nonce:    enc.Nonce,
+		Data:     enc.Data,
+		GasLimit: enc.Gas,
+		To:       enc.To.Hex(),
+		Accesses: NewAccessList(&enc.AccessList),
+	}
+
+	amountInt, err := types.SafeNewIntFromBigInt(enc.Value)
+	if err != nil {
+		return nil, err
+	}
+	txData.Amount = &amountInt
+
+	gasFeeCapInt, err := types.SafeNewIntFromBigInt(enc.GasFeeCap)
+	if err != nil {
+		return nil, err
+	}
+	txData.GasFeeCap = &gasFeeCapInt
+
+	gasTipCapInt, err := types.SafeNewIntFromBigInt(enc.GasTipCap)
+	if err != nil {
+		return nil, err
+	}
+	txData.GasTipCap = &gasTipCapInt
+
+	auths := make([]SetCodeAuthorization, 0, len(enc.AuthList))
+	for _, auth := range enc.AuthList {
+		auths = append(auths, auth.toSetCodeAuthorization())
+	}
+	txData.Authorizations = NewAuthorizationList(auths)
+
+	txData.SetSignatureValues(enc.ChainID, enc.V, enc.R, enc.S)
+	return txData, nil
+}
+
+// TxType returns the tx type
+func (tx *SetCodeTx) TxType() uint8 {
+	return SetCodeTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *SetCodeTx) Copy() TxData {
+	return &SetCodeTx{
+		ChainID:        tx.ChainID,
+		Nonce:          tx.Nonce,
+		GasTipCap:      tx.GasTipCap,
+		GasFeeCap:      tx.GasFeeCap,
+		GasLimit:       tx.GasLimit,
+		To:             tx.To,
+		Amount:         tx.Amount,
+		Data:           common.CopyBytes(tx.Data),
+		Accesses:       tx.Accesses,
+		Authorizations: tx.Authorizations,
+		V:              common.CopyBytes(tx.V),
+		R:              common.CopyBytes(tx.R),
+		S:              common.CopyBytes(tx.S),
+	}
+}
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetAuthorizationList returns set code authorizations of the transaction.
+func (tx *SetCodeTx) GetAuthorizationList() []SetCodeAuthorization {
+	return tx.Authorizations.ToSetCodeAuthorizations()
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *SetCodeTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *SetCodeTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *SetCodeTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetValue returns the tx amount.
+func (tx *SetCodeTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *SetCodeTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *SetCodeTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns a DynamicFeeTx transaction tx from the proto-formatted
+// TxData defined on the Cosmos EVM.
+//
+// NOTE: go-ethereum transaction cannot contain authorizations, therefore the
+// returned data can only be used to access fee and call fields. Hash, sender and
+// binary encoding must be obtained from SetCodeTx itself.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.DynamicFeeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GetGas(),
+		To:         tx.GetTo(),
+		Value:      tx.GetValue(),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return rawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *SetCodeTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields.
+func (tx SetCodeTx) Validate() error {
+	if tx.GasTipCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas tip cap cannot nil")
+	}
+
+	if tx.GasFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas fee cap cannot nil")
+	}
+
+	if tx.GasTipCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas tip cap cannot be negative %s", tx.GasTipCap)
+	}
+
+	if tx.GasFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas fee cap cannot be negative %s", tx.GasFeeCap)
+	}
+
+	if !types.IsValidInt256(tx.GetGasTipCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetGasFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if tx.GasFeeCap.LT(*tx.GasTipCap) {
+		return errorsmod.Wrapf(
+			ErrInvalidGasCap, "max priority fee per gas higher than max fee per gas (%s > %s)",
+			tx.GasTipCap, tx.GasFeeCap,
+		)
+	}
+
+	if !types.IsValidInt256(tx.Fee()) {
+		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
+	}
+
+	amount := tx.GetValue()
+	// Amount can be 0
+	if amount != nil && amount.Sign() == -1 {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be negative %s", amount)
+	}
+	if !types.IsValidInt256(amount) {
+		return errorsmod.Wrap(ErrInvalidAmount, "out of bound")
+	}
+
+	// set code transactions cannot be used for contract creation
+	if tx.To == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "to address must be present on SetCode txs")
+	}
+	if err := types.ValidateAddress(tx.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if tx.GetChainID() == nil {
+		return errorsmod.Wrap(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be present on SetCode txs",
+		)
+	}
+
+	if len(tx.Authorizations) == 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "authorization list cannot be empty")
+	}
+
+	for _, tuple := range tx.Authorizations {
+		if err := types.ValidateAddress(tuple.Address); err != nil {
+			return errorsmod.Wrap(err, "invalid authorization address")
+		}
+	}
+
+	for _, auth := range tx.GetAuthorizationList() {
+		if err := auth.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Fee returns gasprice * gaslimit.
+func (tx SetCodeTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GasLimit)
+}
+
+// Cost returns amount + gasprice * gaslimit.
+func (tx SetCodeTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *SetCodeTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GasLimit)
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+func (tx *SetCodeTx) toRLP() setCodeTxRLP {
+	enc := setCodeTxRLP{
+		ChainID:    bigOrZero(tx.GetChainID()),
+		Nonce:      tx.Nonce,
+		GasTipCap:  bigOrZero(tx.GetGasTipCap()),
+		GasFeeCap:  bigOrZero(tx.GetGasFeeCap()),
+		Gas:        tx.GasLimit,
+		Value:      bigOrZero(tx.GetValue()),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		AuthList:   make([]authorizationRLP, 0, len(tx.Authorizations)),
+	}
+	if to := tx.GetTo(); to != nil {
+		enc.To = *to
+	}
+	if enc.AccessList == nil {
+		enc.AccessList = ethtypes.AccessList{}
+	}
+	for _, auth := range tx.GetAuthorizationList() {
+		enc.AuthList = append(enc.AuthList, auth.toRLP())
+	}
+
+	v, r, s := tx.GetRawSignatureValues()
+	enc.V, enc.R, enc.S = bigOrZero(v), bigOrZero(r), bigOrZero(s)
+	return enc
+}
+
+// SigHash returns the hash to be signed by the sender of transaction
+func (tx *SetCodeTx) SigHash(chainID *big.Int) common.Hash {
+	enc := tx.toRLP()
+	payload, err := rlp.EncodeToBytes([]interface{}{
+		chainID,
+		enc.Nonce,
+		enc.GasTipCap,
+		enc.GasFeeCap,
+		enc.Gas,
+		enc.To,
+		enc.Value,
+		enc.Data,
+		enc.AccessList,
+		enc.AuthList,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash([]byte{SetCodeTxType}, payload)
+}
+
+// MarshalBinary returns the canonical encoding of the transaction
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	payload, err := rlp.EncodeToBytes(tx.toRLP())
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{SetCodeTxType}, payload...), nil
+}
+
+// UnmarshalSetCodeTx decodes the canonical encoding of set code transaction
+func UnmarshalSetCodeTx(b []byte) (*SetCodeTx, error) {
+	if len(b) == 0 || b[0] != SetCodeTxType {
+		return nil, ethtypes.ErrTxTypeNotSupported
+	}
+
+	var enc setCodeTxRLP
+	if err := rlp.DecodeBytes(b[1:], &enc); err != nil {
+		return nil, err
+	}
+	return newSetCodeTx(enc)
+}
+
+// Hash returns the hash of the transaction
+func (tx *SetCodeTx) Hash() common.Hash {
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(bz)
+}
+
+// Sender recovers the address of the account, which signed the transaction
+// for the given chain id
+func (tx *SetCodeTx) Sender(chainID *big.Int) (common.Address, error) {
+	if txChainID := tx.GetChainID(); txChainID == nil || txChainID.Cmp(chainID) != 0 {
+		return common.Address{}, errorsmod.Wrapf(
+			ethtypes.ErrInvalidChainId, "have %s want %s", txChainID, chainID,
+		)
+	}
+
+	// zero y parity is encoded as empty bytes
+	v, r, s := tx.GetRawSignatureValues()
+	v, r, s = bigOrZero(v), bigOrZero(r), bigOrZero(s)
+	if !v.IsUint64() || v.Uint64() > 1 {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+	if !crypto.ValidateSignatureValues(byte(v.Uint64()), r, s, true) {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	return recoverAddress(tx.SigHash(chainID), byte(v.Uint64()), r, s)
+}
+
+// WithSignature sets signature values from the 65 bytes [R || S || V] signature
+func (tx *SetCodeTx) WithSignature(chainID *big.Int, sig []byte) error {
+	if len(sig) != crypto.SignatureLength {
+		return errorsmod.Wrapf(ethtypes.ErrInvalidSig, "wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+	}
+
+	tx.SetSignatureValues(
+		chainID,
+		new(big.Int).SetBytes(sig[64:]),
+		new(big.Int).SetBytes(sig[:32]),
+		new(big.Int).SetBytes(sig[32:64]),
+	)
+	return nil
+}
+
+func bigOrZero(i *big.Int) *big.Int {
+	if i == nil {
+		return new(big.Int)
+	}
+	return i
+}
+
+// SetCodeMessage is a core.Message of EIP-7702 set code transaction, which
+// carries authorizations in addition to the go-ethereum message fields
+type SetCodeMessage struct {
+	ethtypes.Message
+
+	authorizations []SetCodeAuthorization
+}
+
+// NewSetCodeMessage returns a new message with provided authorizations
+func NewSetCodeMessage(msg ethtypes.Message, authorizations []SetCodeAuthorization) SetCodeMessage {
+	return SetCodeMessage{
+		Message:        msg,
+		authorizations: authorizations,
+	}
+}
+
+// AuthorizationList returns set code authorizations of the message
+func (msg SetCodeMessage) AuthorizationList() []SetCodeAuthorization {
+	return msg.authorizations
+}
+
+// GetAuthorizationList returns set code authorizations of provided message or
+// nil if the message is not a SetCodeMessage
+func GetAuthorizationList(msg core.Message) []SetCodeAuthorization {
+	if setCodeMsg, ok := msg.(SetCodeMessage); ok {
+		return setCodeMsg.AuthorizationList()
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func (suite *TxDataTestSuite) TestSetCodeTxValidate() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+
+	auth, err := SignSetCodeAuthorization(SetCodeAuthorization{
+		ChainID: (*hexutil.Big)(big.NewInt(0)),
+		Address: suite.addr,
+		Nonce:   0,
+	}, key)
+	suite.Require().NoError(err)
+
+	invalidAuth := auth
+	invalidAuth.V = 27
+
+	validTx := func() SetCodeTx {
+		return SetCodeTx{
+			ChainID:        &suite.sdkInt,
+			GasTipCap:      &suite.sdkInt,
+			GasFeeCap:      &suite.sdkInt,
+			GasLimit:       suite.uint64,
+			Amount:         &suite.sdkInt,
+			To:             suite.hexAddr,
+			Authorizations: NewAuthorizationList([]SetCodeAuthorization{auth}),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(tx *SetCodeTx)
+		expError bool
+	}{
+		{"valid", func(tx *SetCodeTx) {}, false},
+		{"gas fee cap < gas tip cap", func(tx *SetCodeTx) { tx.GasFeeCap = &suite.sdkZeroInt }, true},
+		{"amount is negative", func(tx *SetCodeTx) { tx.Amount = &suite.sdkMinusOneInt }, true},
+		{"to address is empty", func(tx *SetCodeTx) { tx.To = "" }, true},
+		{"to address is invalid", func(tx *SetCodeTx) { tx.To = suite.invalidAddr }, true},
+		{"chain ID not present", func(tx *SetCodeTx) { tx.ChainID = nil }, true},
+		{"empty authorization list", func(tx *SetCodeTx) { tx.Authorizations = nil }, true},
+		{
+			"invalid authorization",
+			func(tx *SetCodeTx) { tx.Authorizations = NewAuthorizationList([]SetCodeAuthorization{invalidAuth}) },
+			true,
+		},
+		{
+			"invalid authorization address",
+			func(tx *SetCodeTx) { tx.Authorizations[0].Address = suite.invalidAddr },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tx := validTx()
+		tc.malleate(&tx)
+
+		err := tx.Validate()
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+	}
+}
+
+func (suite *TxDataTestSuite) TestSetCodeTxEncoding() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	chainID := big.NewInt(1291)
+
+	auth, err := SignSetCodeAuthorization(SetCodeAuthorization{
+		ChainID: (*hexutil.Big)(chainID),
+		Address: suite.addr,
+		Nonce:   1,
+	}, key)
+	suite.Require().NoError(err)
+
+	tx := &SetCodeTx{
+		Nonce:          2,
+		GasTipCap:      &suite.sdkInt,
+		GasFeeCap:      &suite.sdkInt,
+		GasLimit:       suite.uint64,
+		Amount:         &suite.sdkZeroInt,
+		To:             suite.hexAddr,
+		Data:           suite.hexDataBytes,
+		Accesses:       AccessList{},
+		Authorizations: NewAuthorizationList([]SetCodeAuthorization{auth}),
+	}
+
+	sig, err := crypto.Sign(tx.SigHash(chainID).Bytes(), key)
+	suite.Require().NoError(err)
+	suite.Require().NoError(tx.WithSignature(chainID, sig))
+
+	sender, err := tx.Sender(chainID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(crypto.PubkeyToAddress(key.PublicKey), sender)
+
+	bz, err := tx.MarshalBinary()
+	suite.Require().NoError(err)
+
+	decoded, err := UnmarshalSetCodeTx(bz)
+	suite.Require().NoError(err)
+	suite.Require().Equal(tx.Hash(), decoded.Hash())
+	suite.Require().Equal(tx.GetAuthorizationList(), decoded.GetAuthorizationList())
+
+	sender, err = decoded.Sender(chainID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(crypto.PubkeyToAddress(key.PublicKey), sender)
+
+	// signature hash covers authorization list
+	decoded.Authorizations[0].Nonce++
+	suite.Require().NotEqual(tx.SigHash(chainID), decoded.SigHash(chainID))
+
+	_, err = UnmarshalSetCodeTx(bz[1:])
+	suite.Require().Error(err)
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks, which can be simulated by a single `eth_simulateV1` call
+	MaxSimulateBlocks = 256
+
+	// SimulateErrCodeReverted is the error code of simulated call, which was reverted
+	SimulateErrCodeReverted = 3
+	// SimulateErrCodeVMError is the error code of simulated call, which failed with vm error
+	SimulateErrCodeVMError = -32015
+)
+
+// SimulateOptions defines parameters of `eth_simulateV1` call
+type SimulateOptions struct {
+	BlockStateCalls []SimulateBlock `json:"blockStateCalls"`
+}
+
+// SimulateBlock is a batch of calls, which are simulated within the same block.
+// Block and state overrides are applied before execution of the calls.
+type SimulateBlock struct {
+	BlockOverrides *SimulateBlockOverrides `json:"blockOverrides,omitempty"`
+	StateOverrides StateOverride           `json:"stateOverrides,omitempty"`
+	Calls          []CallArgs              `json:"calls"`
+}
+
+// SimulateBlockOverrides defines fields of block header, which can be overridden during simulation
+type SimulateBlockOverrides struct {
+	Number        *hexutil.Big    `json:"number,omitempty"`
+	Time          *hexutil.Uint64 `json:"time,omitempty"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+}
+
+// StateOverride is the collection of overridden accounts
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the simulation.
+// Contract storage is encrypted by SGXVM, therefore storage cannot be overridden.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce,omitempty"`
+	Code      *hexutil.Bytes               `json:"code,omitempty"`
+	Balance   *hexutil.Big                 `json:"balance,omitempty"`
+	State     *map[common.Hash]common.Hash `json:"state,omitempty"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff,omitempty"`
+}
+
+// Validate performs a basic validation of the simulation options
+func (opts SimulateOptions) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks, maximum is %d", MaxSimulateBlocks)
+	}
+
+	for i, block := range opts.BlockStateCalls {
+		for address, account := range block.StateOverrides {
+			if account.State != nil || account.StateDiff != nil {
+				return fmt.Errorf("block %d: cannot override storage of %s, since contract storage is encrypted", i, address.Hex())
+			}
+			if account.Balance != nil && account.Balance.ToInt().Sign() < 0 {
+				return fmt.Errorf("block %d: negative balance override for %s", i, address.Hex())
+			}
+		}
+	}
+	return nil
+}
+
+// SimulateCallResult is the result of a single simulated call
+type SimulateCallResult struct {
+	ReturnData hexutil.Bytes      `json:"returnData"`
+	Logs       []*ethtypes.Log    `json:"logs"`
+	GasUsed    hexutil.Uint64     `json:"gasUsed"`
+	Status     hexutil.Uint64     `json:"status"`
+	Error      *SimulateCallError `json:"error,omitempty"`
+}
+
+// SimulateCallError describes the reason of simulated call failure
+type SimulateCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// NewSimulateCallResult creates result of simulated call from the response of SGXVM
+func NewSimulateCallResult(res *MsgEthereumTxResponse, logs []*ethtypes.Log) SimulateCallResult {
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	result := SimulateCallResult{
+		ReturnData: res.Ret,
+		Logs:       logs,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+		Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		// SGXVM reports revert in the same way as it is checked by `eth_call`
+		if strings.Contains(res.VmError, "reverted") {
+			revertErr := NewExecErrorWithReason(res.Ret)
+			result.Error = &SimulateCallError{
+				Code:    SimulateErrCodeReverted,
+				Message: revertErr.Error(),
+				Data:    revertErr.ErrorData().(string),
+			}
+		} else {
+			result.Error = &SimulateCallError{
+				Code:    SimulateErrCodeVMError,
+				Message: res.VmError,
+			}
+		}
+	}
+	return result
+}
+
+// SimulateBlockResult is the result of a simulated block
+type SimulateBlockResult struct {
+	Number        hexutil.Uint64       `json:"number"`
+	Timestamp     hexutil.Uint64       `json:"timestamp"`
+	GasUsed       hexutil.Uint64       `json:"gasUsed"`
+	BaseFeePerGas *hexutil.Big         `json:"baseFeePerGas,omitempty"`
+	Calls         []SimulateCallResult `json:"calls"`
+}
+
+// NewSimulateBlockResult creates an empty result of simulated block
+func NewSimulateBlockResult(number, timestamp uint64, baseFee *big.Int) *SimulateBlockResult {
+	result := &SimulateBlockResult{
+		Number:    hexutil.Uint64(number),
+		Timestamp: hexutil.Uint64(timestamp),
+		Calls:     []SimulateCallResult{},
+	}
+	if baseFee != nil {
+		result.BaseFeePerGas = (*hexutil.Big)(baseFee)
+	}
+	return result
+}
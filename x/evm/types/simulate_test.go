@@ -0,0 +1,110 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateOptionsValidate(t *testing.T) {
+	address := common.HexToAddress("0x01")
+	storage := map[common.Hash]common.Hash{}
+
+	testCases := []struct {
+		name     string
+		opts     SimulateOptions
+		expError bool
+	}{
+		{"empty input", SimulateOptions{}, true},
+		{"too many blocks", SimulateOptions{BlockStateCalls: make([]SimulateBlock, MaxSimulateBlocks+1)}, true},
+		{
+			"storage override",
+			SimulateOptions{BlockStateCalls: []SimulateBlock{
+				{StateOverrides: StateOverride{address: {State: &storage}}},
+			}},
+			true,
+		},
+		{
+			"storage diff override",
+			SimulateOptions{BlockStateCalls: []SimulateBlock{
+				{StateOverrides: StateOverride{address: {StateDiff: &storage}}},
+			}},
+			true,
+		},
+		{
+			"negative balance",
+			SimulateOptions{BlockStateCalls: []SimulateBlock{
+				{StateOverrides: StateOverride{address: {Balance: (*hexutil.Big)(big.NewInt(-1))}}},
+			}},
+			true,
+		},
+		{
+			"valid overrides",
+			SimulateOptions{BlockStateCalls: []SimulateBlock{
+				{
+					StateOverrides: StateOverride{address: {
+						Balance: (*hexutil.Big)(big.NewInt(100)),
+						Nonce:   new(hexutil.Uint64),
+						Code:    &hexutil.Bytes{0x60, 0x00},
+					}},
+					Calls: []CallArgs{{To: &address}},
+				},
+			}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSimulateOptionsJSON(t *testing.T) {
+	var opts SimulateOptions
+	err := json.Unmarshal([]byte(`{
+		"blockStateCalls": [{
+			"blockOverrides": {"number": "0x10", "time": "0x20"},
+			"stateOverrides": {"0x0000000000000000000000000000000000000001": {"balance": "0x64"}},
+			"calls": [{"to": "0x0000000000000000000000000000000000000002", "input": "0x01"}]
+		}]
+	}`), &opts)
+	require.NoError(t, err)
+	require.Len(t, opts.BlockStateCalls, 1)
+
+	block := opts.BlockStateCalls[0]
+	require.Equal(t, int64(16), block.BlockOverrides.Number.ToInt().Int64())
+	require.Equal(t, uint64(32), uint64(*block.BlockOverrides.Time))
+	require.Equal(t, int64(100), block.StateOverrides[common.HexToAddress("0x01")].Balance.ToInt().Int64())
+	require.Len(t, block.Calls, 1)
+	require.Equal(t, common.HexToAddress("0x02"), *block.Calls[0].To)
+}
+
+func TestNewSimulateCallResult(t *testing.T) {
+	result := NewSimulateCallResult(&MsgEthereumTxResponse{GasUsed: 21000, Ret: []byte{0x01}}, nil)
+	require.Equal(t, hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), result.Status)
+	require.Equal(t, hexutil.Uint64(21000), result.GasUsed)
+	require.NotNil(t, result.Logs)
+	require.Nil(t, result.Error)
+
+	result = NewSimulateCallResult(&MsgEthereumTxResponse{VmError: "execution reverted"}, nil)
+	require.Equal(t, hexutil.Uint64(ethtypes.ReceiptStatusFailed), result.Status)
+	require.Equal(t, SimulateErrCodeReverted, result.Error.Code)
+	require.Equal(t, "0x", result.Error.Data)
+
+	result = NewSimulateCallResult(&MsgEthereumTxResponse{VmError: "out of gas"}, nil)
+	require.Equal(t, hexutil.Uint64(ethtypes.ReceiptStatusFailed), result.Status)
+	require.Equal(t, SimulateErrCodeVMError, result.Error.Code)
+	require.Equal(t, "out of gas", result.Error.Message)
+}
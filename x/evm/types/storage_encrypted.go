@@ -0,0 +1,54 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// StorageAtEncryptedSignHash returns hash, which should be signed by contract owner
+// to read storage slot of the contract. Hash follows EIP-191, so request can be signed
+// using `personal_sign`.
+func StorageAtEncryptedSignHash(contract common.Address, key common.Hash, userPublicKey []byte) []byte {
+	return accounts.TextHash(crypto.Keccak256(contract.Bytes(), key.Bytes(), userPublicKey))
+}
+
+// SignStorageAtEncrypted signs request to read storage slot of the contract using
+// provided signer function, which should sign provided hash using private key of contract owner.
+func SignStorageAtEncrypted(
+	contract common.Address,
+	key common.Hash,
+	userPublicKey []byte,
+	sign func(hash []byte) ([]byte, error),
+) ([]byte, error) {
+	if len(userPublicKey) != NodePublicKeyLength {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "expected %d bytes, got %d", NodePublicKeyLength, len(userPublicKey))
+	}
+	return sign(StorageAtEncryptedSignHash(contract, key, userPublicKey))
+}
+
+// RecoverStorageAtEncryptedSigner returns address of account, which signed request
+// to read storage slot of the contract. Enclave performs the same recovery and checks
+// that recovered address is an owner of the contract.
+func RecoverStorageAtEncryptedSigner(contract common.Address, key common.Hash, userPublicKey, signature []byte) (common.Address, error) {
+	if len(userPublicKey) != NodePublicKeyLength {
+		return common.Address{}, errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "expected %d bytes, got %d", NodePublicKeyLength, len(userPublicKey))
+	}
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid signature length, expected %d bytes, got %d", crypto.SignatureLength, len(signature))
+	}
+
+	// signatures produced by `personal_sign` use 27 / 28 as recovery id
+	sig := common.CopyBytes(signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(StorageAtEncryptedSignHash(contract, key, userPublicKey), sig)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid signature: %s", err)
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
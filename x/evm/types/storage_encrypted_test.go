@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverStorageAtEncryptedSigner(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	contract := crypto.CreateAddress(owner, 0)
+	key := common.BigToHash(common.Big1)
+	userPublicKey := common.BigToHash(common.Big2).Bytes()
+
+	sign := func(hash []byte) ([]byte, error) {
+		return crypto.Sign(hash, privKey)
+	}
+
+	signature, err := SignStorageAtEncrypted(contract, key, userPublicKey, sign)
+	require.NoError(t, err)
+
+	// signature produced by `personal_sign`
+	personalSignature := common.CopyBytes(signature)
+	personalSignature[crypto.RecoveryIDOffset] += 27
+
+	testCases := []struct {
+		name          string
+		key           common.Hash
+		userPublicKey []byte
+		signature     []byte
+		expSigner     common.Address
+		expPass       bool
+	}{
+		{"valid signature", key, userPublicKey, signature, owner, true},
+		{"valid personal_sign signature", key, userPublicKey, personalSignature, owner, true},
+		{"signature for another storage key", common.BigToHash(common.Big3), userPublicKey, signature, owner, false},
+		{"invalid user public key", key, userPublicKey[:31], signature, common.Address{}, false},
+		{"invalid signature length", key, userPublicKey, signature[:64], common.Address{}, false},
+	}
+
+	for _, tc := range testCases {
+		signer, err := RecoverStorageAtEncryptedSigner(contract, tc.key, tc.userPublicKey, tc.signature)
+		if !tc.expPass {
+			// signature over different message recovers to another address
+			require.True(t, err != nil || signer != tc.expSigner, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expSigner, signer, tc.name)
+	}
+
+	_, err = SignStorageAtEncrypted(contract, key, userPublicKey[:31], sign)
+	require.Error(t, err)
+}
@@ -0,0 +1,232 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	rustgotypes "github.com/SigmaGmbH/librustgo/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+)
+
+// TracerCall is the name of call tracer, which is compatible with the native `callTracer` of go-ethereum
+const TracerCall = "callTracer"
+
+// CallTracerConfig defines tracer config of call tracer, passed as JSON in `tracerConfig` field of TraceConfig
+type CallTracerConfig struct {
+	// If true, call tracer won't collect any subcalls
+	OnlyTopCall bool `json:"onlyTopCall"`
+}
+
+// CallFrame is a single call frame collected by call tracer. It is serialized in the same way
+// as call frames of go-ethereum native `callTracer`.
+type CallFrame struct {
+	Type    string      `json:"type"`
+	From    string      `json:"from"`
+	To      string      `json:"to,omitempty"`
+	Value   string      `json:"value,omitempty"`
+	Gas     string      `json:"gas"`
+	GasUsed string      `json:"gasUsed"`
+	Input   string      `json:"input"`
+	Output  string      `json:"output,omitempty"`
+	Error   string      `json:"error,omitempty"`
+	Calls   []CallFrame `json:"calls,omitempty"`
+}
+
+// NewSGXVMTraceOptions converts provided trace config to trace options, which are passed to SGXVM.
+// Only struct logger (default one) and call tracer are supported, since custom tracers cannot be executed inside enclave.
+func NewSGXVMTraceOptions(config *TraceConfig) (*rustgotypes.TraceOptions, error) {
+	if config == nil {
+		return &rustgotypes.TraceOptions{Tracer: rustgotypes.TracerType_TRACER_STRUCT}, nil
+	}
+
+	if config.Limit < 0 {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid trace limit %d", config.Limit)
+	}
+
+	switch config.Tracer {
+	case "", TracerStruct:
+		return &rustgotypes.TraceOptions{
+			Tracer:           rustgotypes.TracerType_TRACER_STRUCT,
+			DisableStack:     config.DisableStack,
+			DisableStorage:   config.DisableStorage,
+			EnableMemory:     config.EnableMemory,
+			EnableReturnData: config.EnableReturnData,
+			Limit:            uint64(config.Limit),
+		}, nil
+	case TracerCall:
+		var tracerConfig CallTracerConfig
+		if config.TracerJsonConfig != "" {
+			if err := json.Unmarshal([]byte(config.TracerJsonConfig), &tracerConfig); err != nil {
+				return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid tracer config: %s", err)
+			}
+		}
+		return &rustgotypes.TraceOptions{
+			Tracer:      rustgotypes.TracerType_TRACER_CALL,
+			OnlyTopCall: tracerConfig.OnlyTopCall,
+		}, nil
+	default:
+		return nil, errorsmod.Wrapf(ErrTracerNotSupported, "tracer %s is not supported, use struct logger or %s", config.Tracer, TracerCall)
+	}
+}
+
+// FormatExecutionTrace converts execution trace collected by SGXVM to the go-ethereum compatible
+// result of requested tracer
+func FormatExecutionTrace(
+	options *rustgotypes.TraceOptions,
+	trace *rustgotypes.ExecutionTrace,
+	res *MsgEthereumTxResponse,
+) (interface{}, error) {
+	if trace == nil {
+		return nil, fmt.Errorf("SGXVM enclave did not return execution trace")
+	}
+
+	switch options.Tracer {
+	case rustgotypes.TracerType_TRACER_CALL:
+		if trace.CallFrame == nil {
+			return nil, fmt.Errorf("SGXVM enclave did not return call frame")
+		}
+		return NewCallFrame(trace.CallFrame), nil
+	default:
+		return &logger.ExecutionResult{
+			Gas:         res.GasUsed,
+			Failed:      res.Failed(),
+			ReturnValue: fmt.Sprintf("%x", res.Ret),
+			StructLogs:  FormatStructLogs(options, trace.StructLogs),
+		}, nil
+	}
+}
+
+// FormatStructLogs converts struct logs collected by SGXVM to the format of go-ethereum struct logger
+func FormatStructLogs(options *rustgotypes.TraceOptions, logs []*rustgotypes.TraceStructLog) []logger.StructLogRes {
+	formatted := make([]logger.StructLogRes, len(logs))
+	for index, log := range logs {
+		formatted[index] = logger.StructLogRes{
+			Pc:            log.Pc,
+			Op:            log.Op,
+			Gas:           log.Gas,
+			GasCost:       log.GasCost,
+			Depth:         int(log.Depth),
+			Error:         log.Error,
+			RefundCounter: log.Refund,
+		}
+
+		if !options.DisableStack {
+			stack := make([]string, len(log.Stack))
+			for i, value := range log.Stack {
+				stack[i] = "0x" + new(big.Int).SetBytes(value).Text(16)
+			}
+			formatted[index].Stack = &stack
+		}
+
+		if options.EnableMemory {
+			memory := make([]string, 0, (len(log.Memory)+31)/32)
+			for i := 0; i+32 <= len(log.Memory); i += 32 {
+				memory = append(memory, fmt.Sprintf("%x", log.Memory[i:i+32]))
+			}
+			formatted[index].Memory = &memory
+		}
+
+		if !options.DisableStorage && len(log.Storage) > 0 {
+			storage := make(map[string]string, len(log.Storage))
+			for _, entry := range log.Storage {
+				storage[fmt.Sprintf("%x", common.BytesToHash(entry.Key))] = fmt.Sprintf("%x", common.BytesToHash(entry.Value))
+			}
+			formatted[index].Storage = &storage
+		}
+	}
+	return formatted
+}
+
+// NewCallFrame converts call frame collected by SGXVM to the format of go-ethereum call tracer
+func NewCallFrame(frame *rustgotypes.TraceCallFrame) *CallFrame {
+	callFrame := &CallFrame{
+		Type:    strings.ToUpper(frame.Type),
+		From:    strings.ToLower(common.BytesToAddress(frame.From).Hex()),
+		Gas:     "0x" + strconv.FormatUint(frame.Gas, 16),
+		GasUsed: "0x" + strconv.FormatUint(frame.GasUsed, 16),
+		Input:   "0x" + common.Bytes2Hex(frame.Input),
+		Error:   frame.Error,
+	}
+
+	if len(frame.To) > 0 {
+		callFrame.To = strings.ToLower(common.BytesToAddress(frame.To).Hex())
+	}
+	// Static and delegate calls cannot transfer value
+	if callFrame.Type != "STATICCALL" && callFrame.Type != "DELEGATECALL" {
+		callFrame.Value = "0x" + new(big.Int).SetBytes(frame.Value).Text(16)
+	}
+	if len(frame.Output) > 0 {
+		callFrame.Output = "0x" + common.Bytes2Hex(frame.Output)
+	}
+
+	for _, call := range frame.Calls {
+		callFrame.Calls = append(callFrame.Calls, *NewCallFrame(call))
+	}
+	return callFrame
+}
+
+// MergeSGXVMAccessList adds accounts and storage slots touched during SGXVM execution to provided access list.
+// Excluded addresses, such as sender, recipient and precompiles, are removed, since they are always warm.
+// Returns the resulting access list and flag, which shows if touched accounts or slots were missing in provided access list.
+func MergeSGXVMAccessList(
+	accessList ethtypes.AccessList,
+	touched []*rustgotypes.AccessListItem,
+	excluded map[common.Address]struct{},
+) (ethtypes.AccessList, bool) {
+	merged := make(ethtypes.AccessList, 0, len(accessList))
+	indexes := make(map[common.Address]int, len(accessList))
+	slots := make(map[common.Address]map[common.Hash]struct{}, len(accessList))
+
+	addSlot := func(address common.Address, slot common.Hash) bool {
+		if _, found := slots[address][slot]; found {
+			return false
+		}
+		slots[address][slot] = struct{}{}
+		merged[indexes[address]].StorageKeys = append(merged[indexes[address]].StorageKeys, slot)
+		return true
+	}
+	addAddress := func(address common.Address) bool {
+		if _, found := indexes[address]; found {
+			return false
+		}
+		indexes[address] = len(merged)
+		slots[address] = make(map[common.Hash]struct{})
+		merged = append(merged, ethtypes.AccessTuple{Address: address, StorageKeys: []common.Hash{}})
+		return true
+	}
+
+	for _, tuple := range accessList {
+		if _, found := excluded[tuple.Address]; found {
+			continue
+		}
+		addAddress(tuple.Address)
+		for _, slot := range tuple.StorageKeys {
+			addSlot(tuple.Address, slot)
+		}
+	}
+
+	extended := false
+	for _, item := range touched {
+		address := common.BytesToAddress(item.Address)
+		if _, found := excluded[address]; found {
+			continue
+		}
+		if addAddress(address) {
+			extended = true
+		}
+		for _, slot := range item.StorageSlot {
+			if addSlot(address, common.BytesToHash(slot)) {
+				extended = true
+			}
+		}
+	}
+
+	return merged, extended
+}
@@ -0,0 +1,221 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	rustgotypes "github.com/SigmaGmbH/librustgo/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSGXVMTraceOptions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		config   *TraceConfig
+		expected *rustgotypes.TraceOptions
+		expError bool
+	}{
+		{
+			"nil config uses struct logger",
+			nil,
+			&rustgotypes.TraceOptions{Tracer: rustgotypes.TracerType_TRACER_STRUCT},
+			false,
+		},
+		{
+			"struct logger",
+			&TraceConfig{DisableStack: true, EnableMemory: true, Limit: 10},
+			&rustgotypes.TraceOptions{
+				Tracer:       rustgotypes.TracerType_TRACER_STRUCT,
+				DisableStack: true,
+				EnableMemory: true,
+				Limit:        10,
+			},
+			false,
+		},
+		{
+			"call tracer",
+			&TraceConfig{Tracer: TracerCall, TracerJsonConfig: `{"onlyTopCall":true}`},
+			&rustgotypes.TraceOptions{Tracer: rustgotypes.TracerType_TRACER_CALL, OnlyTopCall: true},
+			false,
+		},
+		{
+			"invalid call tracer config",
+			&TraceConfig{Tracer: TracerCall, TracerJsonConfig: `{"onlyTopCall":`},
+			nil,
+			true,
+		},
+		{
+			"negative limit",
+			&TraceConfig{Limit: -1},
+			nil,
+			true,
+		},
+		{
+			"javascript tracer is not supported",
+			&TraceConfig{Tracer: "{result: function() { return 1 }}"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options, err := NewSGXVMTraceOptions(tc.config)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, options)
+		})
+	}
+}
+
+func TestFormatExecutionTraceStructLogs(t *testing.T) {
+	memory := make([]byte, 64)
+	memory[31] = 0x80
+
+	trace := &rustgotypes.ExecutionTrace{
+		StructLogs: []*rustgotypes.TraceStructLog{
+			{
+				Pc:      2,
+				Op:      "SSTORE",
+				Gas:     100,
+				GasCost: 20,
+				Depth:   1,
+				Stack:   [][]byte{{0x01}, {}},
+				Memory:  memory,
+				Storage: []*rustgotypes.TraceStorageEntry{{Key: []byte{0x01}, Value: []byte{0x02}}},
+				Refund:  5,
+			},
+		},
+	}
+	options := &rustgotypes.TraceOptions{Tracer: rustgotypes.TracerType_TRACER_STRUCT, EnableMemory: true}
+	res := &MsgEthereumTxResponse{GasUsed: 21000, Ret: []byte{0xab}}
+
+	result, err := FormatExecutionTrace(options, trace, res)
+	require.NoError(t, err)
+
+	executionResult, ok := result.(*logger.ExecutionResult)
+	require.True(t, ok)
+	require.Equal(t, uint64(21000), executionResult.Gas)
+	require.False(t, executionResult.Failed)
+	require.Equal(t, "ab", executionResult.ReturnValue)
+	require.Len(t, executionResult.StructLogs, 1)
+
+	log := executionResult.StructLogs[0]
+	require.Equal(t, "SSTORE", log.Op)
+	require.Equal(t, 1, log.Depth)
+	require.Equal(t, uint64(5), log.RefundCounter)
+	require.Equal(t, []string{"0x1", "0x0"}, *log.Stack)
+	require.Equal(t, []string{
+		"0000000000000000000000000000000000000000000000000000000000000080",
+		"0000000000000000000000000000000000000000000000000000000000000000",
+	}, *log.Memory)
+	require.Equal(t, map[string]string{
+		"0000000000000000000000000000000000000000000000000000000000000001": "0000000000000000000000000000000000000000000000000000000000000002",
+	}, *log.Storage)
+
+	// Disabled parts of struct logs are omitted
+	options = &rustgotypes.TraceOptions{Tracer: rustgotypes.TracerType_TRACER_STRUCT, DisableStack: true, DisableStorage: true}
+	result, err = FormatExecutionTrace(options, trace, res)
+	require.NoError(t, err)
+	log = result.(*logger.ExecutionResult).StructLogs[0]
+	require.Nil(t, log.Stack)
+	require.Nil(t, log.Memory)
+	require.Nil(t, log.Storage)
+}
+
+func TestFormatExecutionTraceCallFrame(t *testing.T) {
+	from := common.HexToAddress("0x00000000000000000000000000000000000000aA")
+	to := common.HexToAddress("0x00000000000000000000000000000000000000bB")
+
+	trace := &rustgotypes.ExecutionTrace{
+		CallFrame: &rustgotypes.TraceCallFrame{
+			Type:    "call",
+			From:    from.Bytes(),
+			To:      to.Bytes(),
+			Value:   []byte{0x0a},
+			Gas:     50000,
+			GasUsed: 21000,
+			Input:   []byte{0x01, 0x02},
+			Output:  []byte{0x03},
+			Calls: []*rustgotypes.TraceCallFrame{
+				{Type: "STATICCALL", From: to.Bytes(), To: from.Bytes(), Gas: 100, GasUsed: 10, Error: "execution reverted"},
+			},
+		},
+	}
+	options := &rustgotypes.TraceOptions{Tracer: rustgotypes.TracerType_TRACER_CALL}
+
+	result, err := FormatExecutionTrace(options, trace, &MsgEthereumTxResponse{})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"type": "CALL",
+		"from": "0x00000000000000000000000000000000000000aa",
+		"to": "0x00000000000000000000000000000000000000bb",
+		"value": "0xa",
+		"gas": "0xc350",
+		"gasUsed": "0x5208",
+		"input": "0x0102",
+		"output": "0x03",
+		"calls": [{
+			"type": "STATICCALL",
+			"from": "0x00000000000000000000000000000000000000bb",
+			"to": "0x00000000000000000000000000000000000000aa",
+			"gas": "0x64",
+			"gasUsed": "0xa",
+			"input": "0x",
+			"error": "execution reverted"
+		}]
+	}`, string(data))
+}
+
+func TestFormatExecutionTraceMissing(t *testing.T) {
+	structOptions := &rustgotypes.TraceOptions{Tracer: rustgotypes.TracerType_TRACER_STRUCT}
+	_, err := FormatExecutionTrace(structOptions, nil, &MsgEthereumTxResponse{})
+	require.Error(t, err)
+
+	callOptions := &rustgotypes.TraceOptions{Tracer: rustgotypes.TracerType_TRACER_CALL}
+	_, err = FormatExecutionTrace(callOptions, &rustgotypes.ExecutionTrace{}, &MsgEthereumTxResponse{})
+	require.Error(t, err)
+}
+
+func TestMergeSGXVMAccessList(t *testing.T) {
+	sender := common.HexToAddress("0x01")
+	contract := common.HexToAddress("0x02")
+	token := common.HexToAddress("0x03")
+	slot1, slot2 := common.HexToHash("0x01"), common.HexToHash("0x02")
+	excluded := map[common.Address]struct{}{sender: {}}
+
+	// Excluded addresses are removed from provided access list
+	accessList, extended := MergeSGXVMAccessList(ethtypes.AccessList{
+		{Address: sender, StorageKeys: []common.Hash{slot1}},
+		{Address: contract, StorageKeys: []common.Hash{slot1, slot1}},
+	}, nil, excluded)
+	require.False(t, extended)
+	require.Equal(t, ethtypes.AccessList{{Address: contract, StorageKeys: []common.Hash{slot1}}}, accessList)
+
+	// Touched accounts and slots are appended
+	accessList, extended = MergeSGXVMAccessList(accessList, []*rustgotypes.AccessListItem{
+		{Address: sender.Bytes(), StorageSlot: [][]byte{slot2.Bytes()}},
+		{Address: contract.Bytes(), StorageSlot: [][]byte{slot1.Bytes(), slot2.Bytes()}},
+		{Address: token.Bytes()},
+	}, excluded)
+	require.True(t, extended)
+	require.Equal(t, ethtypes.AccessList{
+		{Address: contract, StorageKeys: []common.Hash{slot1, slot2}},
+		{Address: token, StorageKeys: []common.Hash{}},
+	}, accessList)
+
+	// Access list is not extended if all the touched accounts and slots are already included
+	_, extended = MergeSGXVMAccessList(accessList, []*rustgotypes.AccessListItem{
+		{Address: contract.Bytes(), StorageSlot: [][]byte{slot2.Bytes()}},
+	}, excluded)
+	require.False(t, extended)
+}
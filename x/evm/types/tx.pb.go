@@ -256,6 +256,121 @@ func (m *DynamicFeeTx) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DynamicFeeTx proto.InternalMessageInfo
 
+// SetCodeTx is the data of EIP-7702 set code transactions.
+type SetCodeTx struct {
+	// chain_id of the destination EVM chain
+	ChainID *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"chainID"`
+	// nonce corresponds to the account nonce (transaction sequence).
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gas_tip_cap defines the max value for the gas tip
+	GasTipCap *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=gas_tip_cap,json=gasTipCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_tip_cap,omitempty"`
+	// gas_fee_cap defines the max value for the gas fee
+	GasFeeCap *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=gas_fee_cap,json=gasFeeCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_fee_cap,omitempty"`
+	// gas defines the gas limit defined for the transaction.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	// to is the hex formatted address of the recipient. Set code transactions
+	// cannot create contracts, so it is required.
+	To string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	// value defines the the transaction amount.
+	Amount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"value,omitempty"`
+	// data is the data payload bytes of the transaction.
+	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	// accesses is an array of access tuples
+	Accesses AccessList `protobuf:"bytes,9,rep,name=accesses,proto3,castrepeated=AccessList" json:"accessList"`
+	// authorizations is an array of authorization tuples
+	Authorizations AuthorizationList `protobuf:"bytes,10,rep,name=authorizations,proto3,castrepeated=AuthorizationList" json:"authorizationList"`
+	// v defines the signature value
+	V []byte `protobuf:"bytes,11,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the signature value
+	R []byte `protobuf:"bytes,12,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value
+	S []byte `protobuf:"bytes,13,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *SetCodeTx) Reset()         { *m = SetCodeTx{} }
+func (m *SetCodeTx) String() string { return proto.CompactTextString(m) }
+func (*SetCodeTx) ProtoMessage()    {}
+func (*SetCodeTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{4}
+}
+func (m *SetCodeTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCodeTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCodeTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCodeTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCodeTx.Merge(m, src)
+}
+func (m *SetCodeTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCodeTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCodeTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCodeTx proto.InternalMessageInfo
+
+// AuthorizationTuple is an EIP-7702 authorization to set code of the signer
+// account to the delegation designator of the address.
+type AuthorizationTuple struct {
+	// chain_id of the chain, where authorization is valid. Zero chain id allows
+	// to use authorization on any chain.
+	ChainID *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"chainID"`
+	// address is a hex formatted ethereum address of the delegation target
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// nonce of the signer account
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// v defines the signature y parity
+	V uint64 `protobuf:"varint,4,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the signature value
+	R []byte `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value
+	S []byte `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *AuthorizationTuple) Reset()         { *m = AuthorizationTuple{} }
+func (m *AuthorizationTuple) String() string { return proto.CompactTextString(m) }
+func (*AuthorizationTuple) ProtoMessage()    {}
+func (*AuthorizationTuple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{5}
+}
+func (m *AuthorizationTuple) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizationTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizationTuple.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizationTuple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationTuple.Merge(m, src)
+}
+func (m *AuthorizationTuple) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizationTuple) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationTuple.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizationTuple proto.InternalMessageInfo
+
 // ExtensionOptionsEthereumTx is an extension option for ethereum transactions
 type ExtensionOptionsEthereumTx struct {
 }
@@ -264,7 +379,7 @@ func (m *ExtensionOptionsEthereumTx) Reset()         { *m = ExtensionOptionsEthe
 func (m *ExtensionOptionsEthereumTx) String() string { return proto.CompactTextString(m) }
 func (*ExtensionOptionsEthereumTx) ProtoMessage()    {}
 func (*ExtensionOptionsEthereumTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{4}
+	return fileDescriptor_f75ac0a12d075f21, []int{6}
 }
 func (m *ExtensionOptionsEthereumTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,7 +430,7 @@ func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
 func (m *MsgEthereumTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEthereumTxResponse) ProtoMessage()    {}
 func (*MsgEthereumTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{5}
+	return fileDescriptor_f75ac0a12d075f21, []int{7}
 }
 func (m *MsgEthereumTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +472,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{6}
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,7 +524,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{7}
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,83 +553,190 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateEpochs defines a Msg for updating the schedule of epochs.
+type MsgUpdateEpochs struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// epochs defines the whole schedule of epochs ordered by epoch number.
+	// NOTE: Already started epochs must be supplied unchanged.
+	Epochs []Epoch `protobuf:"bytes,2,rep,name=epochs,proto3" json:"epochs"`
+}
+
+func (m *MsgUpdateEpochs) Reset()         { *m = MsgUpdateEpochs{} }
+func (m *MsgUpdateEpochs) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEpochs) ProtoMessage()    {}
+func (*MsgUpdateEpochs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{10}
+}
+func (m *MsgUpdateEpochs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEpochs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEpochs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEpochs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEpochs.Merge(m, src)
+}
+func (m *MsgUpdateEpochs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEpochs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEpochs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEpochs proto.InternalMessageInfo
+
+func (m *MsgUpdateEpochs) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateEpochs) GetEpochs() []Epoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+// MsgUpdateEpochsResponse defines the response structure for executing a
+// MsgUpdateEpochs message.
+type MsgUpdateEpochsResponse struct {
+}
+
+func (m *MsgUpdateEpochsResponse) Reset()         { *m = MsgUpdateEpochsResponse{} }
+func (m *MsgUpdateEpochsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEpochsResponse) ProtoMessage()    {}
+func (*MsgUpdateEpochsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{11}
+}
+func (m *MsgUpdateEpochsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEpochsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEpochsResponse.Merge(m, src)
+}
+func (m *MsgUpdateEpochsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEpochsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEpochsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgHandleTx)(nil), "ethermint.evm.v1.MsgHandleTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
 	proto.RegisterType((*AccessListTx)(nil), "ethermint.evm.v1.AccessListTx")
 	proto.RegisterType((*DynamicFeeTx)(nil), "ethermint.evm.v1.DynamicFeeTx")
+	proto.RegisterType((*SetCodeTx)(nil), "ethermint.evm.v1.SetCodeTx")
+	proto.RegisterType((*AuthorizationTuple)(nil), "ethermint.evm.v1.AuthorizationTuple")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "ethermint.evm.v1.ExtensionOptionsEthereumTx")
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateEpochs)(nil), "ethermint.evm.v1.MsgUpdateEpochs")
+	proto.RegisterType((*MsgUpdateEpochsResponse)(nil), "ethermint.evm.v1.MsgUpdateEpochsResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 978 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x13, 0x27, 0x71, 0x26, 0xd1, 0x52, 0x8d, 0xb6, 0xaa, 0x13, 0x68, 0x1c, 0x82, 0x04,
-	0x69, 0xa5, 0xb5, 0xd5, 0x05, 0xf5, 0xb0, 0xa7, 0x6e, 0xba, 0xdb, 0xd2, 0x6a, 0x57, 0x54, 0x26,
-	0xbd, 0x50, 0xa4, 0x68, 0xd6, 0x99, 0x9d, 0x58, 0xc4, 0x33, 0x96, 0x67, 0x62, 0x92, 0x6b, 0x4f,
-	0x1c, 0x41, 0xf0, 0x01, 0x38, 0x70, 0xe2, 0x84, 0x44, 0x3f, 0x00, 0xc7, 0x8a, 0x53, 0x05, 0x17,
-	0x84, 0x50, 0x40, 0x59, 0x24, 0xa4, 0xde, 0xe0, 0x13, 0xa0, 0x19, 0x3b, 0xff, 0x9a, 0xdd, 0x02,
-	0x65, 0x11, 0xa7, 0xcc, 0x9b, 0xf7, 0xe6, 0xe7, 0xf7, 0xde, 0xef, 0x37, 0xf3, 0x02, 0xaa, 0x58,
-	0xf4, 0x71, 0x14, 0xf8, 0x54, 0x38, 0x38, 0x0e, 0x9c, 0xf8, 0x9a, 0x23, 0x46, 0x76, 0x18, 0x31,
-	0xc1, 0xe0, 0x85, 0xb9, 0xcb, 0xc6, 0x71, 0x60, 0xc7, 0xd7, 0x6a, 0x97, 0x3c, 0xc6, 0x03, 0xc6,
-	0x9d, 0x80, 0x13, 0x19, 0x19, 0x70, 0x92, 0x84, 0xd6, 0xaa, 0x89, 0xa3, 0xab, 0x2c, 0x27, 0x31,
-	0x52, 0x57, 0x6d, 0xed, 0x03, 0x12, 0x2c, 0xf1, 0x6d, 0x12, 0x46, 0x58, 0x72, 0x46, 0xae, 0xd2,
-	0xdd, 0x57, 0x08, 0x63, 0x64, 0x80, 0x1d, 0x14, 0xfa, 0x0e, 0xa2, 0x94, 0x09, 0x24, 0x7c, 0x46,
-	0x67, 0x78, 0xd5, 0xd4, 0xab, 0xac, 0xa3, 0xe1, 0xb1, 0x83, 0xe8, 0x38, 0x71, 0x35, 0x3f, 0xd3,
-	0x40, 0xf9, 0x90, 0x93, 0xb7, 0x11, 0xed, 0x0d, 0x70, 0x67, 0x04, 0x5b, 0x40, 0xef, 0x21, 0x81,
-	0x4c, 0xad, 0xa1, 0xb5, 0xca, 0xdb, 0x9b, 0x76, 0x72, 0xd2, 0x9e, 0x9d, 0xb4, 0x77, 0xe9, 0xd8,
-	0x55, 0x11, 0xd0, 0x02, 0x7a, 0x1f, 0xf1, 0xbe, 0x99, 0x6b, 0x68, 0xad, 0x52, 0xbb, 0xfc, 0xc7,
-	0xc4, 0x2a, 0x46, 0x83, 0x70, 0xa7, 0xb9, 0xd5, 0x74, 0x95, 0x03, 0x42, 0xa0, 0x1f, 0x47, 0x2c,
-	0x30, 0x75, 0x19, 0xe0, 0xaa, 0x35, 0x6c, 0x80, 0xf2, 0x90, 0x62, 0xea, 0x45, 0xe3, 0x50, 0xe0,
-	0x9e, 0x99, 0x6f, 0x68, 0x2d, 0xc3, 0x5d, 0xde, 0xda, 0xd1, 0x3f, 0xfa, 0xdc, 0xca, 0x34, 0xbf,
-	0xce, 0x02, 0xe3, 0x00, 0x13, 0xe4, 0x8d, 0x3b, 0x23, 0xb8, 0x09, 0xf2, 0x94, 0x51, 0x0f, 0xab,
-	0xa4, 0x74, 0x37, 0x31, 0xe0, 0x6d, 0x50, 0x22, 0x48, 0xb6, 0xcf, 0xf7, 0xb0, 0x99, 0x55, 0x49,
-	0x5c, 0xfd, 0x71, 0x62, 0xbd, 0x4e, 0x7c, 0xd1, 0x1f, 0x1e, 0xd9, 0x1e, 0x0b, 0xd2, 0xa6, 0xa6,
-	0x3f, 0x5b, 0xbc, 0xf7, 0x81, 0x23, 0xc6, 0x21, 0xe6, 0xf6, 0x1d, 0x2a, 0x5c, 0x83, 0x20, 0x7e,
-	0x4f, 0x9e, 0x85, 0x75, 0x90, 0x23, 0x88, 0xab, 0x3a, 0xf4, 0x76, 0x65, 0x3a, 0xb1, 0x8c, 0xdb,
-	0x88, 0x1f, 0xf8, 0x81, 0x2f, 0x5c, 0xe9, 0x80, 0x1b, 0x20, 0x2b, 0x58, 0x5a, 0x45, 0x56, 0x30,
-	0x78, 0x17, 0xe4, 0x63, 0x34, 0x18, 0x62, 0x95, 0x7d, 0xa9, 0xfd, 0xd6, 0xdf, 0xff, 0xe8, 0x74,
-	0x62, 0x15, 0x76, 0x03, 0x36, 0xa4, 0xc2, 0x4d, 0x20, 0x64, 0x8f, 0x54, 0xbb, 0x0b, 0x0d, 0xad,
-	0x55, 0x49, 0x1b, 0x5b, 0x01, 0x5a, 0x6c, 0x16, 0xd5, 0x86, 0x16, 0x4b, 0x2b, 0x32, 0x8d, 0xc4,
-	0x8a, 0xa4, 0xc5, 0xcd, 0x52, 0x62, 0xf1, 0x9d, 0x0d, 0xd9, 0xab, 0x6f, 0x1f, 0x6d, 0x15, 0x3a,
-	0xa3, 0x3d, 0x24, 0x50, 0xf3, 0xf7, 0x1c, 0xa8, 0xec, 0x7a, 0x1e, 0xe6, 0xfc, 0xc0, 0xe7, 0xa2,
-	0x33, 0x82, 0x0f, 0x80, 0xe1, 0xf5, 0x91, 0x4f, 0xbb, 0x7e, 0x4f, 0x35, 0xaf, 0xd4, 0xbe, 0xf1,
-	0x8f, 0xb2, 0x2d, 0xde, 0x94, 0xa7, 0xef, 0xec, 0x3d, 0x9d, 0x58, 0x45, 0x2f, 0x59, 0xba, 0xe9,
-	0xa2, 0xb7, 0xa0, 0x25, 0x7b, 0x26, 0x2d, 0xb9, 0x7f, 0x4f, 0x8b, 0xfe, 0x7c, 0x5a, 0xf2, 0xeb,
-	0xb4, 0x14, 0xce, 0x8f, 0x96, 0xe2, 0x12, 0x2d, 0x0f, 0x80, 0x81, 0x54, 0x6f, 0x31, 0x37, 0x8d,
-	0x46, 0xae, 0x55, 0xde, 0xbe, 0x6c, 0x3f, 0x7b, 0xdb, 0xed, 0xa4, 0xfb, 0x9d, 0x61, 0x38, 0xc0,
-	0xed, 0xc6, 0xe3, 0x89, 0x95, 0x79, 0x3a, 0xb1, 0x00, 0x9a, 0x53, 0xf2, 0xe5, 0xcf, 0x16, 0x58,
-	0x10, 0xe4, 0xce, 0x01, 0x13, 0xce, 0x4b, 0x2b, 0x9c, 0x83, 0x15, 0xce, 0xcb, 0x67, 0x71, 0xfe,
-	0x8d, 0x0e, 0x2a, 0x7b, 0x63, 0x8a, 0x02, 0xdf, 0xbb, 0x85, 0xf1, 0xff, 0xc3, 0xf9, 0x5d, 0x50,
-	0x96, 0x9c, 0x0b, 0x3f, 0xec, 0x7a, 0x28, 0x7c, 0x01, 0xd6, 0xa5, 0x64, 0x3a, 0x7e, 0x78, 0x13,
-	0x85, 0x33, 0xac, 0x63, 0x8c, 0x15, 0x96, 0xfe, 0x42, 0x58, 0xb7, 0x30, 0x96, 0x58, 0xa9, 0x84,
-	0xf2, 0xcf, 0x97, 0x50, 0x61, 0x5d, 0x42, 0xc5, 0xf3, 0x93, 0x90, 0x71, 0x86, 0x84, 0x4a, 0xff,
-	0x89, 0x84, 0xc0, 0x8a, 0x84, 0xca, 0x2b, 0x12, 0xaa, 0x9c, 0x25, 0xa1, 0x26, 0xa8, 0xed, 0x8f,
-	0x04, 0xa6, 0xdc, 0x67, 0xf4, 0x9d, 0x50, 0x0d, 0x8e, 0x7d, 0x99, 0x15, 0x1e, 0x06, 0x9d, 0x51,
-	0xfa, 0x20, 0x7f, 0xa1, 0x81, 0x8b, 0x87, 0x9c, 0x2c, 0xf6, 0x5d, 0xcc, 0x43, 0x46, 0xb9, 0x2a,
-	0x54, 0xcd, 0x01, 0x2d, 0x79, 0xe6, 0xd5, 0xd3, 0x7f, 0x05, 0xe8, 0x03, 0x46, 0xb8, 0x99, 0x55,
-	0x45, 0x5e, 0x5c, 0x2f, 0xf2, 0x80, 0x11, 0x57, 0x85, 0xc0, 0x0b, 0x20, 0x17, 0x61, 0xa1, 0x34,
-	0x53, 0x71, 0xe5, 0x12, 0x56, 0x81, 0x11, 0x07, 0x5d, 0x1c, 0x45, 0x2c, 0x4a, 0x5f, 0xdd, 0x62,
-	0x1c, 0xec, 0x4b, 0x53, 0xba, 0xa4, 0x38, 0x86, 0x3c, 0x9d, 0x1d, 0xba, 0x5b, 0x24, 0x88, 0xdf,
-	0xe7, 0xf3, 0xb9, 0xf1, 0x89, 0x06, 0x5e, 0x3a, 0xe4, 0xe4, 0x7e, 0xd8, 0x43, 0x02, 0xdf, 0x43,
-	0x11, 0x0a, 0x38, 0xbc, 0x0e, 0x4a, 0x68, 0x28, 0xfa, 0x2c, 0xf2, 0xc5, 0x38, 0xbd, 0x11, 0xe6,
-	0x77, 0x8f, 0xb6, 0x36, 0xd3, 0x91, 0xbb, 0xdb, 0xeb, 0x45, 0x98, 0xf3, 0x77, 0x45, 0xe4, 0x53,
-	0xe2, 0x2e, 0x42, 0xe1, 0x75, 0x50, 0x08, 0x15, 0x82, 0x12, 0x7b, 0x79, 0xdb, 0x5c, 0x2f, 0x23,
-	0xf9, 0x42, 0x5b, 0x97, 0x34, 0xb9, 0x69, 0xf4, 0xce, 0xc6, 0xc3, 0xdf, 0xbe, 0xba, 0xba, 0xc0,
-	0x69, 0x56, 0xc1, 0xa5, 0x67, 0x52, 0x9a, 0xf5, 0x6e, 0xfb, 0x27, 0x0d, 0xe4, 0x0e, 0x39, 0x81,
-	0x1f, 0x02, 0x63, 0x3e, 0x81, 0x4f, 0x91, 0xc4, 0xd2, 0x80, 0xae, 0xbd, 0x71, 0xaa, 0x7b, 0x9d,
-	0x97, 0xe6, 0x6b, 0x0f, 0xbf, 0xff, 0xf5, 0xd3, 0xec, 0xe5, 0xe6, 0xcb, 0xce, 0xda, 0xbf, 0x89,
-	0xbe, 0x02, 0xeb, 0x8a, 0x11, 0x7c, 0x1f, 0x54, 0x56, 0x7a, 0xf5, 0xea, 0xa9, 0xe8, 0xcb, 0x21,
-	0xb5, 0x2b, 0x7f, 0x19, 0x32, 0x4b, 0xa1, 0x7d, 0xe3, 0xf1, 0xb4, 0xae, 0x3d, 0x99, 0xd6, 0xb5,
-	0x5f, 0xa6, 0x75, 0xed, 0xe3, 0x93, 0x7a, 0xe6, 0xc9, 0x49, 0x3d, 0xf3, 0xc3, 0x49, 0x3d, 0xf3,
-	0xde, 0xf2, 0xb5, 0xc2, 0xb1, 0xbc, 0x55, 0x8b, 0x24, 0x47, 0x2a, 0x4d, 0x75, 0xb5, 0x8e, 0x0a,
-	0xea, 0x8f, 0xc7, 0x9b, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x2e, 0xf8, 0x9d, 0x73, 0x09,
-	0x00, 0x00,
+	// 1129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x6b, 0x7b, 0xfd, 0xec, 0x6f, 0xbe, 0xed, 0x2a, 0x55, 0x36, 0x86, 0xda, 0xc6,
+	0x20, 0x70, 0x2a, 0x65, 0xad, 0x06, 0xe8, 0x21, 0xa7, 0xc6, 0x49, 0x5a, 0x5a, 0x25, 0xa2, 0xda,
+	0xba, 0x17, 0x8a, 0x14, 0x4d, 0xd6, 0x93, 0xf5, 0x0a, 0xef, 0xce, 0x6a, 0x67, 0x6c, 0x6c, 0xc4,
+	0xa9, 0x27, 0x8e, 0x45, 0xf0, 0x07, 0x70, 0xe0, 0xc4, 0x09, 0x89, 0x9e, 0xe0, 0xc2, 0xb1, 0xe2,
+	0x54, 0xe0, 0x82, 0x38, 0x18, 0x94, 0x20, 0x21, 0xf5, 0x06, 0x7f, 0x01, 0x9a, 0x1f, 0xfe, 0x15,
+	0x27, 0x29, 0x84, 0x56, 0x1c, 0xe0, 0x94, 0x79, 0xf3, 0xde, 0x7c, 0xde, 0x9b, 0xf7, 0xf9, 0x78,
+	0xf2, 0x16, 0x96, 0x30, 0x6b, 0xe1, 0x38, 0xf0, 0x43, 0x56, 0xc3, 0xdd, 0xa0, 0xd6, 0xbd, 0x5c,
+	0x63, 0x3d, 0x3b, 0x8a, 0x09, 0x23, 0xe6, 0xb9, 0x91, 0xcb, 0xc6, 0xdd, 0xc0, 0xee, 0x5e, 0x2e,
+	0x2c, 0xba, 0x84, 0x06, 0x84, 0xd6, 0x02, 0xea, 0xf1, 0xc8, 0x80, 0x7a, 0x32, 0xb4, 0xb0, 0x24,
+	0x1d, 0xbb, 0xc2, 0xaa, 0x49, 0x43, 0xb9, 0x0a, 0x33, 0x09, 0x38, 0x98, 0xf4, 0x2d, 0x78, 0xc4,
+	0x23, 0xf2, 0x0c, 0x5f, 0xa9, 0xdd, 0xe7, 0x3d, 0x42, 0xbc, 0x36, 0xae, 0xa1, 0xc8, 0xaf, 0xa1,
+	0x30, 0x24, 0x0c, 0x31, 0x9f, 0x84, 0x43, 0xbc, 0x25, 0xe5, 0x15, 0xd6, 0x5e, 0x67, 0xbf, 0x86,
+	0xc2, 0xbe, 0x74, 0x55, 0x3e, 0xd6, 0x20, 0xb7, 0x43, 0xbd, 0x37, 0x50, 0xd8, 0x6c, 0xe3, 0x46,
+	0xcf, 0xac, 0x82, 0xde, 0x44, 0x0c, 0x59, 0x5a, 0x59, 0xab, 0xe6, 0x56, 0x17, 0x6c, 0x79, 0xd2,
+	0x1e, 0x9e, 0xb4, 0xd7, 0xc3, 0xbe, 0x23, 0x22, 0xcc, 0x12, 0xe8, 0x2d, 0x44, 0x5b, 0x56, 0xb2,
+	0xac, 0x55, 0xb3, 0xf5, 0xdc, 0xef, 0x83, 0x52, 0x26, 0x6e, 0x47, 0x6b, 0x95, 0x95, 0x8a, 0x23,
+	0x1c, 0xa6, 0x09, 0xfa, 0x7e, 0x4c, 0x02, 0x4b, 0xe7, 0x01, 0x8e, 0x58, 0x9b, 0x65, 0xc8, 0x75,
+	0x42, 0x1c, 0xba, 0x71, 0x3f, 0x62, 0xb8, 0x69, 0xa5, 0xca, 0x5a, 0xd5, 0x70, 0x26, 0xb7, 0xd6,
+	0xf4, 0x0f, 0x3e, 0x29, 0xcd, 0x55, 0xbe, 0x48, 0x80, 0xb1, 0x8d, 0x3d, 0xe4, 0xf6, 0x1b, 0x3d,
+	0x73, 0x01, 0x52, 0x21, 0x09, 0x5d, 0x2c, 0x8a, 0xd2, 0x1d, 0x69, 0x98, 0xd7, 0x21, 0xeb, 0x21,
+	0xde, 0x3e, 0xdf, 0xc5, 0x56, 0x42, 0x14, 0x71, 0xe9, 0xc7, 0x41, 0xe9, 0x65, 0xcf, 0x67, 0xad,
+	0xce, 0x9e, 0xed, 0x92, 0x40, 0x35, 0x55, 0xfd, 0x59, 0xa1, 0xcd, 0x77, 0x6a, 0xac, 0x1f, 0x61,
+	0x6a, 0xdf, 0x08, 0x99, 0x63, 0x78, 0x88, 0xde, 0xe2, 0x67, 0xcd, 0x22, 0x24, 0x3d, 0x44, 0xc5,
+	0x3d, 0xf4, 0x7a, 0xfe, 0x60, 0x50, 0x32, 0xae, 0x23, 0xba, 0xed, 0x07, 0x3e, 0x73, 0xb8, 0xc3,
+	0x9c, 0x87, 0x04, 0x23, 0xea, 0x16, 0x09, 0x46, 0xcc, 0x9b, 0x90, 0xea, 0xa2, 0x76, 0x07, 0x8b,
+	0xea, 0xb3, 0xf5, 0xd7, 0xfe, 0x7c, 0xd2, 0x83, 0x41, 0x29, 0xbd, 0x1e, 0x90, 0x4e, 0xc8, 0x1c,
+	0x09, 0xc1, 0x7b, 0x24, 0xda, 0x9d, 0x2e, 0x6b, 0xd5, 0xbc, 0x6a, 0x6c, 0x1e, 0xb4, 0xae, 0x95,
+	0x11, 0x1b, 0x5a, 0x97, 0x5b, 0xb1, 0x65, 0x48, 0x2b, 0xe6, 0x16, 0xb5, 0xb2, 0xd2, 0xa2, 0x6b,
+	0xf3, 0xbc, 0x57, 0xdf, 0x3c, 0x58, 0x49, 0x37, 0x7a, 0x9b, 0x88, 0xa1, 0xca, 0x6f, 0x49, 0xc8,
+	0xaf, 0xbb, 0x2e, 0xa6, 0x74, 0xdb, 0xa7, 0xac, 0xd1, 0x33, 0xef, 0x82, 0xe1, 0xb6, 0x90, 0x1f,
+	0xee, 0xfa, 0x4d, 0xd1, 0xbc, 0x6c, 0xfd, 0xea, 0x5f, 0xaa, 0x36, 0xb3, 0xc1, 0x4f, 0xdf, 0xd8,
+	0x7c, 0x3c, 0x28, 0x65, 0x5c, 0xb9, 0x74, 0xd4, 0xa2, 0x39, 0xa6, 0x25, 0x71, 0x22, 0x2d, 0xc9,
+	0xbf, 0x4f, 0x8b, 0x7e, 0x3a, 0x2d, 0xa9, 0x59, 0x5a, 0xd2, 0x4f, 0x8f, 0x96, 0xcc, 0x04, 0x2d,
+	0x77, 0xc1, 0x40, 0xa2, 0xb7, 0x98, 0x5a, 0x46, 0x39, 0x59, 0xcd, 0xad, 0x5e, 0xb4, 0x8f, 0xfe,
+	0xda, 0x6d, 0xd9, 0xfd, 0x46, 0x27, 0x6a, 0xe3, 0x7a, 0xf9, 0xe1, 0xa0, 0x34, 0xf7, 0x78, 0x50,
+	0x02, 0x34, 0xa2, 0xe4, 0xb3, 0x9f, 0x4a, 0x30, 0x26, 0xc8, 0x19, 0x01, 0x4a, 0xce, 0xb3, 0x53,
+	0x9c, 0xc3, 0x14, 0xe7, 0xb9, 0x93, 0x38, 0xff, 0x5a, 0x87, 0xfc, 0x66, 0x3f, 0x44, 0x81, 0xef,
+	0x5e, 0xc3, 0xf8, 0x9f, 0xe1, 0xfc, 0x26, 0xe4, 0x38, 0xe7, 0xcc, 0x8f, 0x76, 0x5d, 0x14, 0x9d,
+	0x81, 0x75, 0x2e, 0x99, 0x86, 0x1f, 0x6d, 0xa0, 0x68, 0x88, 0xb5, 0x8f, 0xb1, 0xc0, 0xd2, 0xcf,
+	0x84, 0x75, 0x0d, 0x63, 0x8e, 0xa5, 0x24, 0x94, 0x3a, 0x5d, 0x42, 0xe9, 0x59, 0x09, 0x65, 0x9e,
+	0x9e, 0x84, 0x8c, 0x13, 0x24, 0x94, 0x7d, 0x26, 0x12, 0x82, 0x29, 0x09, 0xe5, 0xa6, 0x24, 0x94,
+	0x3f, 0x49, 0x42, 0x5f, 0xa5, 0x20, 0x7b, 0x1b, 0xb3, 0x0d, 0xd2, 0xfc, 0x4f, 0x3f, 0xff, 0x5e,
+	0xfd, 0xbc, 0x0f, 0xf3, 0xa8, 0xc3, 0x5a, 0x24, 0xf6, 0xdf, 0x93, 0xc3, 0x83, 0x05, 0x22, 0xc5,
+	0x4b, 0xc7, 0xa4, 0x98, 0x8c, 0x93, 0x99, 0x6c, 0x95, 0xe9, 0xfc, 0x14, 0x86, 0x4a, 0x78, 0x7e,
+	0xfd, 0xe8, 0xa6, 0x73, 0x24, 0x97, 0x54, 0x6f, 0x6e, 0x4a, 0xbd, 0xf9, 0x29, 0xf5, 0xfe, 0xef,
+	0x24, 0xf5, 0x7e, 0xab, 0x81, 0x39, 0x5b, 0xd0, 0xb3, 0x95, 0xb1, 0x05, 0x19, 0xd4, 0x6c, 0xc6,
+	0x98, 0x52, 0x39, 0x79, 0x38, 0x43, 0x73, 0x2c, 0xf0, 0xe4, 0xa4, 0xc0, 0xc5, 0xed, 0xc4, 0x7f,
+	0xb2, 0xd1, 0xed, 0x52, 0x53, 0xb7, 0x4b, 0x0f, 0x6f, 0x27, 0xc7, 0x9f, 0x0a, 0x14, 0xb6, 0x7a,
+	0x0c, 0x87, 0xd4, 0x27, 0xe1, 0x9b, 0x91, 0xe8, 0xd0, 0x16, 0x27, 0x01, 0x77, 0x82, 0x46, 0x4f,
+	0xc5, 0x7c, 0xaa, 0xc1, 0x85, 0x1d, 0xea, 0x8d, 0xf7, 0x1d, 0x4c, 0x23, 0x12, 0x52, 0x21, 0x1d,
+	0x31, 0x99, 0x69, 0x72, 0xf0, 0xe2, 0x6b, 0x73, 0x19, 0xf4, 0x36, 0xf1, 0x78, 0xb9, 0x9c, 0xd3,
+	0x0b, 0xb3, 0x9c, 0x6e, 0x13, 0xcf, 0x11, 0x21, 0xe6, 0x39, 0x48, 0xc6, 0x98, 0x89, 0x0b, 0xe4,
+	0x1d, 0xbe, 0x34, 0x97, 0xc0, 0xe8, 0x06, 0xbb, 0x38, 0x8e, 0x49, 0xac, 0xe6, 0xa0, 0x4c, 0x37,
+	0xd8, 0xe2, 0x26, 0x77, 0xf1, 0x9f, 0x5b, 0x87, 0xaa, 0x69, 0x4e, 0x77, 0x32, 0x1e, 0xa2, 0x77,
+	0xe8, 0x68, 0x92, 0xfb, 0x50, 0x83, 0xff, 0xef, 0x50, 0xef, 0x4e, 0xd4, 0x44, 0x0c, 0xdf, 0x42,
+	0x31, 0x0a, 0xa8, 0x79, 0x05, 0xb2, 0x8a, 0x7e, 0xd6, 0x57, 0xe4, 0x58, 0xdf, 0x3d, 0x58, 0x59,
+	0x50, 0x43, 0xf0, 0xba, 0xec, 0xe5, 0x6d, 0x16, 0xfb, 0xa1, 0xe7, 0x8c, 0x43, 0xcd, 0x2b, 0x90,
+	0x8e, 0x04, 0x82, 0xe8, 0x7a, 0x6e, 0xd5, 0x9a, 0xbd, 0x86, 0xcc, 0x50, 0xd7, 0xb9, 0x1c, 0x1d,
+	0x15, 0xbd, 0x36, 0x7f, 0xef, 0xd7, 0xcf, 0x2f, 0x8d, 0x71, 0x2a, 0x4b, 0xb0, 0x78, 0xa4, 0xa4,
+	0x61, 0xef, 0x2a, 0xf7, 0x27, 0xcb, 0xdd, 0x8a, 0x88, 0xdb, 0x3a, 0x7b, 0xb9, 0xaf, 0x43, 0x1a,
+	0x0b, 0x04, 0xd5, 0xf5, 0xc5, 0xd9, 0x72, 0x45, 0x86, 0x61, 0xb5, 0x32, 0xf8, 0xd4, 0x6a, 0x65,
+	0x45, 0xc3, 0x6a, 0x57, 0xbf, 0x4c, 0x40, 0x72, 0x87, 0x7a, 0xe6, 0xbb, 0x60, 0x8c, 0x26, 0xf8,
+	0x63, 0x9e, 0x84, 0x89, 0x01, 0xbf, 0xf0, 0xca, 0xb1, 0xee, 0x59, 0x15, 0x55, 0x5e, 0xbc, 0xf7,
+	0xfd, 0x2f, 0x1f, 0x25, 0x2e, 0x56, 0x9e, 0xab, 0xcd, 0x7c, 0x8d, 0xb4, 0x04, 0xd8, 0x2e, 0xeb,
+	0x99, 0x6f, 0x43, 0x7e, 0x8a, 0xd9, 0x17, 0x8e, 0x45, 0x9f, 0x0c, 0x29, 0x2c, 0x3f, 0x31, 0x64,
+	0x24, 0xe4, 0x11, 0xba, 0x22, 0xe2, 0x34, 0x74, 0x19, 0x52, 0x58, 0x7e, 0x62, 0xc8, 0x10, 0xbd,
+	0x7e, 0xf5, 0xe1, 0x41, 0x51, 0x7b, 0x74, 0x50, 0xd4, 0x7e, 0x3e, 0x28, 0x6a, 0xf7, 0x0f, 0x8b,
+	0x73, 0x8f, 0x0e, 0x8b, 0x73, 0x3f, 0x1c, 0x16, 0xe7, 0xde, 0x9a, 0x7c, 0x25, 0x70, 0x97, 0x3f,
+	0x12, 0xe3, 0x16, 0xf4, 0x44, 0x13, 0xc4, 0x4b, 0xb1, 0x97, 0x16, 0x9f, 0x45, 0xaf, 0xfe, 0x31,
+	0x00, 0x89, 0x21, 0xf9, 0x7c, 0x11, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -535,6 +757,10 @@ type MsgClient interface {
 	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
 	// account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateEpochs defines a governance operation for updating the schedule of
+	// epochs. Epochs, which already started, cannot be changed. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	UpdateEpochs(ctx context.Context, in *MsgUpdateEpochs, opts ...grpc.CallOption) (*MsgUpdateEpochsResponse, error)
 }
 
 type msgClient struct {
@@ -563,6 +789,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateEpochs(ctx context.Context, in *MsgUpdateEpochs, opts ...grpc.CallOption) (*MsgUpdateEpochsResponse, error) {
+	out := new(MsgUpdateEpochsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/UpdateEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// HandleTx defines a method submitting Ethereum transactions.
@@ -571,6 +806,10 @@ type MsgServer interface {
 	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
 	// account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateEpochs defines a governance operation for updating the schedule of
+	// epochs. Epochs, which already started, cannot be changed. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	UpdateEpochs(context.Context, *MsgUpdateEpochs) (*MsgUpdateEpochsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -583,6 +822,9 @@ func (*UnimplementedMsgServer) HandleTx(ctx context.Context, req *MsgHandleTx) (
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateEpochs(ctx context.Context, req *MsgUpdateEpochs) (*MsgUpdateEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEpochs not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -624,6 +866,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEpochs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/UpdateEpochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEpochs(ctx, req.(*MsgUpdateEpochs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -636,6 +896,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateEpochs",
+			Handler:    _Msg_UpdateEpochs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1040,30 +1304,7 @@ func (m *DynamicFeeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExtensionOptionsEthereumTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *SetCodeTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1073,39 +1314,41 @@ func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEthereumTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetCodeTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetCodeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GasUsed != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x6a
 	}
-	if len(m.VmError) > 0 {
-		i -= len(m.VmError)
-		copy(dAtA[i:], m.VmError)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x62
 	}
-	if len(m.Ret) > 0 {
-		i -= len(m.Ret)
-		copy(dAtA[i:], m.Ret)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Ret)))
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x5a
 	}
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1113,31 +1356,262 @@ func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x52
 		}
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+	if len(m.Accesses) > 0 {
+		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x42
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasFeeCap != nil {
+		{
+			size := m.GasFeeCap.Size()
+			i -= size
+			if _, err := m.GasFeeCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasTipCap != nil {
+		{
+			size := m.GasTipCap.Size()
+			i -= size
+			if _, err := m.GasTipCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizationTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizationTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.V != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.V))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
@@ -1189,33 +1663,100 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateEpochs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgHandleTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateEpochs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEpochs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.From)
-	if l > 0 {
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgHandleTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.Unencrypted {
@@ -1377,6 +1918,100 @@ func (m *DynamicFeeTx) Size() (n int) {
 	return n
 }
 
+func (m *SetCodeTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasTipCap != nil {
+		l = m.GasTipCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasFeeCap != nil {
+		l = m.GasFeeCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Accesses) > 0 {
+		for _, e := range m.Accesses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthorizationTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.V != 0 {
+		n += 1 + sovTx(uint64(m.V))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *ExtensionOptionsEthereumTx) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1440,6 +2075,34 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateEpochs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1465,19 +2128,729 @@ func (m *MsgHandleTx) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgHandleTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgHandleTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHandleTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHandleTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unencrypted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unencrypted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacyTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1504,18 +2877,16 @@ func (m *MsgHandleTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1525,29 +2896,31 @@ func (m *MsgHandleTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1557,29 +2930,31 @@ func (m *MsgHandleTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unencrypted", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1589,12 +2964,26 @@ func (m *MsgHandleTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Unencrypted = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1616,7 +3005,7 @@ func (m *MsgHandleTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LegacyTx) Unmarshal(dAtA []byte) error {
+func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1639,13 +3028,49 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
@@ -1664,9 +3089,9 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1695,12 +3120,48 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasTipCap = &v
+			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.GasFeeCap = &v
+			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
@@ -1719,7 +3180,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
@@ -1751,7 +3212,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 			}
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1787,7 +3248,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -1821,7 +3282,41 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
@@ -1855,7 +3350,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.V = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
@@ -1889,7 +3384,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.R = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
@@ -1944,7 +3439,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+func (m *SetCodeTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1967,10 +3462,10 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCodeTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCodeTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2028,9 +3523,45 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.GasTipCap = &v
+			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2059,12 +3590,12 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasFeeCap = &v
+			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
@@ -2083,7 +3614,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
@@ -2115,7 +3646,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			}
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2151,7 +3682,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -2185,7 +3716,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
 			}
@@ -2219,7 +3750,41 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, AuthorizationTuple{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
@@ -2253,7 +3818,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				m.V = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
@@ -2287,7 +3852,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				m.R = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
+		case 13:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
@@ -2342,216 +3907,38 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
+func (m *AuthorizationTuple) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.ChainID = &v
-			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.GasTipCap = &v
-			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.GasFeeCap = &v
-			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
-			}
-			m.GasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2580,16 +3967,16 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.Amount = &v
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2599,31 +3986,29 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2633,31 +4018,16 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Accesses = append(m.Accesses, AccessTuple{})
-			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
+		case 4:
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
-			var byteLen int
+			m.V = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2667,27 +4037,12 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.V |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
-			if m.V == nil {
-				m.V = []byte{}
-			}
-			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
@@ -2721,7 +4076,7 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				m.R = []byte{}
 			}
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
@@ -3192,6 +4547,172 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateEpochs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEpochs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEpochs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, Epoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
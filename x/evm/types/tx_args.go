@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -51,6 +52,9 @@ type TransactionArgs struct {
 	// Introduced by AccessListTxType transaction.
 	AccessList *ethtypes.AccessList `json:"accessList,omitempty"`
 	ChainID    *hexutil.Big         `json:"chainId,omitempty"`
+
+	// Introduced by SetCodeTxType transaction.
+	AuthorizationList []SetCodeAuthorization `json:"authorizationList,omitempty"`
 }
 
 // String return the struct in a string format
@@ -111,6 +115,24 @@ func (args *TransactionArgs) ToTransaction() *MsgHandleTx {
 
 	var data TxData
 	switch {
+	case args.AuthorizationList != nil:
+		al := AccessList{}
+		if args.AccessList != nil {
+			al = NewAccessList(args.AccessList)
+		}
+
+		data = &SetCodeTx{
+			To:             to,
+			ChainID:        &chainID,
+			Nonce:          nonce,
+			GasLimit:       gas,
+			GasFeeCap:      &maxFeePerGas,
+			GasTipCap:      &maxPriorityFeePerGas,
+			Amount:         &value,
+			Data:           args.GetData(),
+			Accesses:       al,
+			Authorizations: NewAuthorizationList(args.AuthorizationList),
+		}
 	case args.MaxFeePerGas != nil:
 		al := AccessList{}
 		if args.AccessList != nil {
@@ -163,16 +185,16 @@ func (args *TransactionArgs) ToTransaction() *MsgHandleTx {
 		Data: any,
 		From: from,
 	}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.TxHash().Hex()
 	return &msg
 }
 
 // ToMessage converts the arguments to the Message type used by the core evm.
 // This assumes that setTxDefaults has been called.
-func (args *TransactionArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (ethtypes.Message, error) {
+func (args *TransactionArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (core.Message, error) {
 	// Reject invalid combinations of pre- and post-1559 fee styles
 	if args.GasPrice != nil && (args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil) {
-		return ethtypes.Message{}, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
+		return nil, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
 	}
 
 	// Set sender address or use zero address if none specified.
@@ -240,6 +262,9 @@ func (args *TransactionArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (e
 	}
 
 	msg := ethtypes.NewMessage(addr, args.To, nonce, value, gas, gasPrice, gasFeeCap, gasTipCap, data, accessList, true)
+	if args.AuthorizationList != nil {
+		return NewSetCodeMessage(msg, args.AuthorizationList), nil
+	}
 	return msg, nil
 }
 
@@ -82,7 +82,7 @@ func UnwrapEthereumMsg(tx *sdk.Tx, ethHash common.Hash) (*MsgHandleTx, error) {
 		if !ok {
 			return nil, fmt.Errorf("invalid tx type: %T", tx)
 		}
-		txHash := ethMsg.AsTransaction().Hash()
+		txHash := ethMsg.TxHash()
 		ethMsg.Hash = txHash.Hex()
 		if txHash == ethHash {
 			return ethMsg, nil
@@ -3,12 +3,18 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 	"swisstronik/x/vesting/types"
 )
 
+const FlagFunderAddress = "funder"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group vesting queries under a subcommand
@@ -21,6 +27,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdGetBalances())
+	cmd.AddCommand(CmdGetVestingSchedule())
+	cmd.AddCommand(CmdGetNextUnlock())
+	cmd.AddCommand(CmdGetVestingAccounts())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -59,3 +68,173 @@ func CmdGetBalances() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetVestingSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [vesting-account]",
+		Short: "Gets unlock timeline of a vesting account",
+		Long: `Gets unlock timeline of a vesting account. Each period is printed with its unlock time, amount
+and status at the latest block. Use --output json to get the raw response.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err = types.ParseAddress(args[0]); err != nil {
+				return err
+			}
+			req := &types.QueryVestingScheduleRequest{
+				Address: args[0],
+			}
+
+			resp, err := queryClient.VestingSchedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == "json" {
+				return clientCtx.PrintProto(resp)
+			}
+			return clientCtx.PrintString(formatVestingSchedule(resp))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetNextUnlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-unlock [vesting-account]",
+		Short: "Gets time and amount of the next unlock of a vesting account",
+		Long:  "Gets time and amount of the next unlock of a vesting account. Use --output json to get the raw response.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err = types.ParseAddress(args[0]); err != nil {
+				return err
+			}
+			req := &types.QueryNextUnlockRequest{
+				Address: args[0],
+			}
+
+			resp, err := queryClient.NextUnlock(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == "json" {
+				return clientCtx.PrintProto(resp)
+			}
+			if resp.FullyVested {
+				return clientCtx.PrintString("All coins are vested\n")
+			}
+			return clientCtx.PrintString(fmt.Sprintf("Next unlock: %s at %s (in %s)\n",
+				resp.Amount, formatUnixTime(resp.UnlockTime), time.Until(time.Unix(resp.UnlockTime, 0)).Round(time.Minute)))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetVestingAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Gets all monthly vesting accounts",
+		Long:  "Gets all monthly vesting accounts, optionally filtered by funder address.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			funder, err := cmd.Flags().GetString(FlagFunderAddress)
+			if err != nil {
+				return err
+			}
+			if funder != "" {
+				if _, err = types.ParseAddress(funder); err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryVestingAccountsRequest{
+				FunderAddress: funder,
+				Pagination:    pageReq,
+			}
+
+			resp, err := queryClient.VestingAccounts(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagFunderAddress, "", "Return only vesting accounts funded by provided address")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vesting accounts")
+	return cmd
+}
+
+// formatVestingSchedule returns human-readable timeline of vesting account
+func formatVestingSchedule(resp *types.QueryVestingScheduleResponse) string {
+	var sb strings.Builder
+
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Vesting account:\t%s\n", resp.Address)
+	if resp.FunderAddress != "" {
+		fmt.Fprintf(w, "Funder:\t%s\n", resp.FunderAddress)
+	}
+	fmt.Fprintf(w, "Start:\t%s\n", formatUnixTime(resp.StartTime))
+	fmt.Fprintf(w, "Cliff:\t%s\n", formatUnixTime(resp.CliffTime))
+	fmt.Fprintf(w, "End:\t%s\n", formatUnixTime(resp.EndTime))
+	fmt.Fprintf(w, "Original vesting:\t%s\n", resp.OriginalVesting)
+	_ = w.Flush()
+
+	sb.WriteString("\n")
+
+	w = tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tUNLOCK TIME\tAMOUNT\tSTATUS")
+	for i, period := range resp.Periods {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", i+1, formatUnixTime(period.UnlockTime), period.Amount, formatPeriodStatus(period.Status))
+	}
+	_ = w.Flush()
+
+	return sb.String()
+}
+
+func formatPeriodStatus(status types.PeriodStatus) string {
+	switch status {
+	case types.PeriodStatusVested:
+		return "vested"
+	case types.PeriodStatusLocked:
+		return "locked"
+	default:
+		return "unknown"
+	}
+}
+
+func formatUnixTime(unixTime int64) string {
+	return time.Unix(unixTime, 0).UTC().Format(time.RFC3339)
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
 	"strconv"
 	"swisstronik/x/vesting/types"
 
@@ -10,14 +13,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 const (
 	FlagMonthlyVesting = "monthly-vesting"
 	FlagCliffDays      = "cliff-days"
 	FlagVestingPeriods = "vesting-months"
+	FlagDestAddress    = "dest"
 )
 
+// VestingData defines periods of vesting schedule, which are read from JSON file
+type VestingData struct {
+	Periods []InputPeriod `json:"periods"`
+}
+
+// InputPeriod defines amount of coins, which are unlocked after period length in seconds
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -29,6 +45,9 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdCreateMonthlyVestingAccount())
+	cmd.AddCommand(CmdAddVestingGrant())
+	cmd.AddCommand(CmdCreatePeriodicVestingAccount())
+	cmd.AddCommand(CmdClawback())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -90,3 +109,175 @@ So after another 30 days, 1/12 of vesting amount will be released.
 
 	return cmd
 }
+
+func CmdAddVestingGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-vesting-grant [to-address] [cliff-days] [months] [amount]",
+		Short: "Add a new grant with linear monthly vesting and cliff feature to existing monthly vesting account.",
+		Long: `Add a new grant to existing monthly vesting account. Only funder of the vesting account can add grants.
+Grant starts at committed block's time and its vesting schedule is merged with the existing one.
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			to := args[0]
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			cliffDays, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			months, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddVestingGrant(
+				clientCtx.GetFromAddress().String(),
+				to,
+				cliffDays,
+				months,
+				amount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCreatePeriodicVestingAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to-address] [cliff-days] [periods-json-file]",
+		Short: "Create a new vesting account funded with an allocation of tokens with custom vesting periods and cliff feature.",
+		Long: `Create a new vesting account funded with an allocation of tokens. Periods are sequential and
+start after the cliff days, the duration of a period starts at the end of the previous one.
+e.g. periods.json with 10 tokens unlocked 30 days after the cliff and 20 tokens unlocked 30 days later:
+
+{
+  "periods": [
+    {"coins": "10uswtr", "length_seconds": 2592000},
+    {"coins": "20uswtr", "length_seconds": 2592000}
+  ]
+}
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			to := args[0]
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			cliffDays, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			var vestingData VestingData
+			if err := json.Unmarshal(contents, &vestingData); err != nil {
+				return err
+			}
+
+			periods := make([]sdkvesting.Period, 0, len(vestingData.Periods))
+			for i, p := range vestingData.Periods {
+				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+				if err != nil {
+					return err
+				}
+
+				if p.Length < 1 {
+					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+				}
+				periods = append(periods, sdkvesting.Period{Length: p.Length, Amount: amount})
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(
+				clientCtx.GetFromAddress().String(),
+				to,
+				cliffDays,
+				periods,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [account-address]",
+		Short: "Claw back unvested coins from monthly vesting account.",
+		Long: `Claw back unvested coins from monthly vesting account. Only funder of the vesting account can claw back coins.
+Coins are returned to the funder, unless destination address is provided. Delegated unvested coins
+are transferred as delegations to the same validators.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account := args[0]
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			dest, err := cmd.Flags().GetString(FlagDestAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClawback(
+				clientCtx.GetFromAddress().String(),
+				account,
+				dest,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDestAddress, "", "Address to receive clawed back coins, defaults to funder address")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
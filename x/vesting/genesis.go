@@ -10,6 +10,8 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+	// monthly vesting accounts are stored by auth module, which is initialized before
+	k.IndexVestingAccounts(ctx)
 }
 
 // ExportGenesis returns the module's exported genesis
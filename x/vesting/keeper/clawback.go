@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/x/vesting/types"
+)
+
+// Clawback truncates vesting schedule of the account and transfers unvested coins to the destination
+// address. Unvested coins, which are delegated, are transferred as delegations to the same validators,
+// so they are returned without waiting for unbonding period. Unvested coins, which are unbonding, are
+// transferred as unbonding entries, so the destination address receives them once unbonding completes.
+// Returns clawed back coins.
+func (k Keeper) Clawback(ctx sdk.Context, va *types.MonthlyVestingAccount, dest sdk.AccAddress) (sdk.Coins, error) {
+	addr := va.GetAddress()
+
+	// Delegated vesting coins are tracked at the time of delegation and include unbonding coins,
+	// since they are tracked as undelegated only when unbonding completes. Part of them may be vested already.
+	delegatedVesting := va.GetDelegatedVesting()
+	unvested := va.Clawback(ctx.BlockTime())
+	if unvested.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNoUnvestedCoins, "account %s", addr)
+	}
+
+	delegatedUnvested := unvested.Min(delegatedVesting)
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	toTransfer := delegatedUnvested.AmountOf(bondDenom)
+	transferred, err := k.transferDelegations(ctx, addr, dest, toTransfer)
+	if err != nil {
+		return nil, err
+	}
+	transferredUnbonding, err := k.transferUnbondingDelegations(ctx, addr, dest, toTransfer.Sub(transferred))
+	if err != nil {
+		return nil, err
+	}
+	transferredCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, transferred.Add(transferredUnbonding)))
+
+	// All remaining coins of the account are vested after clawback, so remaining delegations are free.
+	// Delegated unvested coins are not tracked anymore, even if part of them was slashed and not transferred.
+	va.DelegatedFree = va.DelegatedFree.Add(delegatedVesting.Sub(delegatedUnvested...)...)
+	va.DelegatedVesting = sdk.NewCoins()
+
+	// Update account before sending coins, so bank module treats clawed back coins as spendable.
+	// Accounts without remaining vesting coins are converted back to base accounts.
+	if va.OriginalVesting.IsZero() {
+		k.RemoveMonthlyVestingAccount(ctx, va)
+	} else {
+		k.SetMonthlyVestingAccount(ctx, va)
+	}
+
+	// Rest of unvested coins is held by the account. Amount is limited by the balance,
+	// since delegations could be slashed.
+	toSend := unvested.Sub(delegatedUnvested...).Min(k.bankKeeper.GetAllBalances(ctx, addr))
+	if !toSend.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, addr, dest, toSend); err != nil {
+			return nil, err
+		}
+	}
+
+	return toSend.Add(transferredCoins...), nil
+}
+
+// transferDelegations moves up to provided amount of delegated tokens from one delegator to another.
+// Tokens stay bonded to the same validators. Returns amount of transferred tokens.
+func (k Keeper) transferDelegations(ctx sdk.Context, from, to sdk.AccAddress, amount sdkmath.Int) (sdkmath.Int, error) {
+	transferred := sdkmath.ZeroInt()
+	if !amount.IsPositive() {
+		return transferred, nil
+	}
+
+	for _, delegation := range k.stakingKeeper.GetDelegatorDelegations(ctx, from, math.MaxUint16) {
+		if transferred.GTE(amount) {
+			break
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		toTransfer := sdkmath.MinInt(amount.Sub(transferred), validator.TokensFromShares(delegation.Shares).TruncateInt())
+		if !toTransfer.IsPositive() {
+			continue
+		}
+
+		shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, from, valAddr, toTransfer)
+		if err != nil {
+			return transferred, err
+		}
+
+		// Unbonded validator without remaining shares is removed, so tokens could not be delegated again
+		if validator.IsUnbonded() && validator.DelegatorShares.Sub(shares).IsZero() {
+			continue
+		}
+
+		tokens, err := k.stakingKeeper.Unbond(ctx, from, valAddr, shares)
+		if err != nil {
+			return transferred, err
+		}
+
+		// Unbonded tokens stay in the pool, which corresponds to the status of validator
+		validator, found = k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return transferred, errorsmod.Wrapf(types.ErrInvalidParam, "validator %s was removed during clawback", valAddr)
+		}
+		if _, err := k.stakingKeeper.Delegate(ctx, to, tokens, validator.GetStatus(), validator, false); err != nil {
+			return transferred, err
+		}
+
+		transferred = transferred.Add(tokens)
+	}
+
+	return transferred, nil
+}
+
+// transferUnbondingDelegations moves up to provided amount of unbonding tokens from one delegator to another.
+// Entries keep their completion time, so tokens are released to the new delegator when unbonding completes.
+// Returns amount of transferred tokens.
+func (k Keeper) transferUnbondingDelegations(ctx sdk.Context, from, to sdk.AccAddress, amount sdkmath.Int) (sdkmath.Int, error) {
+	transferred := sdkmath.ZeroInt()
+	if !amount.IsPositive() {
+		return transferred, nil
+	}
+
+	for _, ubd := range k.stakingKeeper.GetUnbondingDelegations(ctx, from, math.MaxUint16) {
+		if transferred.GTE(amount) {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return transferred, err
+		}
+
+		for i := 0; i < len(ubd.Entries) && transferred.LT(amount); i++ {
+			entry := ubd.Entries[i]
+			// Entries on hold are managed by other modules, so they are kept unchanged
+			if entry.OnHold() || !entry.Balance.IsPositive() {
+				continue
+			}
+
+			toTransfer := sdkmath.MinInt(amount.Sub(transferred), entry.Balance)
+			if toTransfer.Equal(entry.Balance) {
+				ubd.RemoveEntry(int64(i))
+				i--
+				k.stakingKeeper.DeleteUnbondingIndex(ctx, entry.UnbondingId)
+			} else {
+				ubd.Entries[i].Balance = entry.Balance.Sub(toTransfer)
+				ubd.Entries[i].InitialBalance = sdkmath.MaxInt(entry.InitialBalance.Sub(toTransfer), ubd.Entries[i].Balance)
+			}
+
+			newUbd := k.stakingKeeper.SetUnbondingDelegationEntry(ctx, to, valAddr, entry.CreationHeight, entry.CompletionTime, toTransfer)
+			k.stakingKeeper.InsertUBDQueue(ctx, newUbd, entry.CompletionTime)
+
+			transferred = transferred.Add(toTransfer)
+		}
+
+		// Unbonding delegation without entries is removed, remaining entries are completed as usual
+		if len(ubd.Entries) == 0 {
+			k.stakingKeeper.RemoveUnbondingDelegation(ctx, ubd)
+		} else {
+			k.stakingKeeper.SetUnbondingDelegation(ctx, ubd)
+		}
+	}
+
+	return transferred, nil
+}
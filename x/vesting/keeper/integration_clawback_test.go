@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"swisstronik/tests"
+	"swisstronik/utils"
+	"swisstronik/x/vesting/types"
+)
+
+var _ = Describe("Clawback of Monthly Vesting Account", Ordered, func() {
+	const (
+		cliffDays = 30
+		months    = 3
+	)
+
+	var (
+		s             *VestingTestSuite
+		stakingServer stakingtypes.MsgServer
+
+		delegated  sdk.Coin
+		perMonth   sdk.Coins
+		afterMonth time.Duration
+	)
+
+	coinsOf := func(amount math.Int) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, amount))
+	}
+
+	delegatedTokens := func(delegator sdk.AccAddress, valAddr sdk.ValAddress) math.Int {
+		delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegator, valAddr)
+		if !found {
+			return math.ZeroInt()
+		}
+		validator, found := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+		Expect(found).To(BeTrue())
+		return validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+
+	clawback := func() sdk.Coins {
+		resp, err := s.msgServer.HandleClawback(s.goCtx, types.NewMsgClawback(s.funder.String(), s.va.String(), ""))
+		Expect(err).To(BeNil())
+		return resp.Coins
+	}
+
+	BeforeEach(func() {
+		s = new(VestingTestSuite)
+		Expect(s.SetupTest()).To(BeNil())
+		stakingServer = stakingkeeper.NewMsgServerImpl(&s.app.StakingKeeper)
+
+		s.va = tests.RandomAccAddress()
+		s.funder = tests.RandomAccAddress()
+		perMonth = coinsOf(math.NewIntWithDecimal(400, 18))
+		s.initialVesting = coinsOf(math.NewIntWithDecimal(1200, 18))
+		delegated = sdk.NewCoin(utils.BaseDenom, math.NewIntWithDecimal(1000, 18))
+		afterMonth = time.Duration(types.SecondsOfDay*cliffDays+types.SecondsOfMonth) * time.Second
+
+		s.ExpectFundCoins(s.funder, s.initialVesting)
+		_, err := s.msgServer.HandleCreateMonthlyVestingAccount(s.goCtx, &types.MsgCreateMonthlyVestingAccount{
+			FromAddress: s.funder.String(),
+			ToAddress:   s.va.String(),
+			CliffDays:   cliffDays,
+			Months:      months,
+			Amount:      s.initialVesting,
+		})
+		Expect(err).To(BeNil())
+		Expect(s.Commit()).To(BeNil())
+	})
+
+	It("claws back unvested coins of partially vested account", func() {
+		Expect(s.CommitAfter(afterMonth)).To(BeNil())
+
+		clawedBack := clawback()
+		Expect(clawedBack).To(Equal(s.initialVesting.Sub(perMonth...)))
+		Expect(s.app.BankKeeper.GetAllBalances(s.ctx, s.funder)).To(Equal(clawedBack))
+
+		mva, err := s.app.VestingKeeper.GetMonthlyVestingAccount(s.ctx, s.va)
+		Expect(err).To(BeNil())
+		Expect(mva.OriginalVesting).To(Equal(perMonth))
+		Expect(mva.GetVestingCoins(s.ctx.BlockTime()).IsZero()).To(BeTrue())
+		Expect(s.app.BankKeeper.SpendableCoins(s.ctx, s.va)).To(Equal(perMonth))
+	})
+
+	It("claws back delegated unvested coins and keeps vested delegation", func() {
+		_, err := stakingServer.Delegate(s.goCtx, stakingtypes.NewMsgDelegate(s.va, s.validator.GetOperator(), delegated))
+		Expect(err).To(BeNil())
+		Expect(s.CommitAfter(afterMonth)).To(BeNil())
+
+		clawedBack := clawback()
+		unvested := s.initialVesting.Sub(perMonth...)
+		Expect(clawedBack).To(Equal(unvested))
+
+		// Unvested part of delegation is transferred, vested part becomes free delegation
+		Expect(delegatedTokens(s.funder, s.validator.GetOperator())).To(Equal(unvested.AmountOf(utils.BaseDenom)))
+		remaining := delegated.Amount.Sub(unvested.AmountOf(utils.BaseDenom))
+		Expect(delegatedTokens(s.va, s.validator.GetOperator())).To(Equal(remaining))
+		Expect(s.app.BankKeeper.GetAllBalances(s.ctx, s.funder).IsZero()).To(BeTrue())
+
+		mva, err := s.app.VestingKeeper.GetMonthlyVestingAccount(s.ctx, s.va)
+		Expect(err).To(BeNil())
+		Expect(mva.DelegatedVesting.IsZero()).To(BeTrue())
+		Expect(mva.DelegatedFree).To(Equal(coinsOf(remaining)))
+		Expect(s.app.BankKeeper.SpendableCoins(s.ctx, s.va)).To(Equal(s.initialVesting.Sub(delegated)))
+	})
+
+	It("claws back redelegated coins", func() {
+		_, addrVals, _, _ := s.initializeValidators([]int64{100})
+		dstValidator := addrVals[0]
+
+		_, err := stakingServer.Delegate(s.goCtx, stakingtypes.NewMsgDelegate(s.va, s.validator.GetOperator(), delegated))
+		Expect(err).To(BeNil())
+		_, err = stakingServer.BeginRedelegate(s.goCtx, stakingtypes.NewMsgBeginRedelegate(s.va, s.validator.GetOperator(), dstValidator, delegated))
+		Expect(err).To(BeNil())
+
+		clawedBack := clawback()
+		Expect(clawedBack).To(Equal(s.initialVesting))
+		Expect(delegatedTokens(s.funder, dstValidator)).To(Equal(delegated.Amount))
+		Expect(delegatedTokens(s.va, dstValidator).IsZero()).To(BeTrue())
+		Expect(s.app.BankKeeper.GetAllBalances(s.ctx, s.funder)).To(Equal(s.initialVesting.Sub(delegated)))
+
+		// Account without remaining vesting coins is converted to base account
+		_, ok := s.app.AccountKeeper.GetAccount(s.ctx, s.va).(*authtypes.BaseAccount)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("claws back unbonding coins", func() {
+		unbonding := sdk.NewCoin(utils.BaseDenom, math.NewIntWithDecimal(600, 18))
+		_, err := stakingServer.Delegate(s.goCtx, stakingtypes.NewMsgDelegate(s.va, s.validator.GetOperator(), delegated))
+		Expect(err).To(BeNil())
+		_, err = stakingServer.Undelegate(s.goCtx, stakingtypes.NewMsgUndelegate(s.va, s.validator.GetOperator(), unbonding))
+		Expect(err).To(BeNil())
+
+		clawedBack := clawback()
+		Expect(clawedBack).To(Equal(s.initialVesting))
+		Expect(delegatedTokens(s.funder, s.validator.GetOperator())).To(Equal(delegated.Amount.Sub(unbonding.Amount)))
+
+		// Unbonding entry is transferred to the funder
+		_, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, s.va, s.validator.GetOperator())
+		Expect(found).To(BeFalse())
+		ubd, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, s.funder, s.validator.GetOperator())
+		Expect(found).To(BeTrue())
+		Expect(ubd.Entries).To(HaveLen(1))
+		Expect(ubd.Entries[0].Balance).To(Equal(unbonding.Amount))
+
+		// Funder receives unbonding coins once unbonding completes
+		Expect(s.CommitAfter(s.app.StakingKeeper.UnbondingTime(s.ctx))).To(BeNil())
+		Expect(s.Commit()).To(BeNil())
+		Expect(s.app.BankKeeper.GetAllBalances(s.ctx, s.funder)).To(Equal(s.initialVesting.Sub(delegated).Add(unbonding)))
+		Expect(s.app.BankKeeper.GetAllBalances(s.ctx, s.va).IsZero()).To(BeTrue())
+	})
+})
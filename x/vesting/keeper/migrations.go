@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 builds index of monthly vesting accounts, which were created before the index was introduced
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.IndexVestingAccounts(ctx)
+	return nil
+}
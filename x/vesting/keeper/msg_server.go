@@ -60,8 +60,9 @@ func (k msgServer) HandleCreateMonthlyVestingAccount(goCtx context.Context, msg
 		msg.CliffDays,
 		msg.Months,
 	)
+	vestingAccount.FunderAddress = msg.FromAddress
 
-	k.accountKeeper.SetAccount(ctx, vestingAccount)
+	k.SetMonthlyVestingAccount(ctx, vestingAccount)
 
 	defer func() {
 		telemetry.IncrCounter(1, "new", "account")
@@ -98,3 +99,173 @@ func (k msgServer) HandleCreateMonthlyVestingAccount(goCtx context.Context, msg
 
 	return &types.MsgCreateMonthlyVestingAccountResponse{}, nil
 }
+
+func (k msgServer) HandleAddVestingGrant(goCtx context.Context, msg *types.MsgAddVestingGrant) (*types.MsgAddVestingGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	bk := k.Keeper.bankKeeper
+
+	if err := bk.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	from := sdk.MustAccAddressFromBech32(msg.FromAddress)
+	to := sdk.MustAccAddressFromBech32(msg.ToAddress)
+
+	vestingAccount, err := k.GetMonthlyVestingAccount(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if vestingAccount.FunderAddress != msg.FromAddress {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"only funder of vesting account %s can add vesting grants", msg.ToAddress,
+		)
+	}
+
+	grantCliffTime := ctx.BlockTime().Unix() + types.SecondsOfDay*msg.CliffDays
+	vestingAccount.AddGrant(grantCliffTime, types.MonthlyPeriods(msg.Amount, msg.Months))
+	k.SetMonthlyVestingAccount(ctx, vestingAccount)
+
+	if err := bk.SendCoins(ctx, from, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAddVestingGrant,
+				sdk.NewAttribute(types.AttributeKeyFromAddress, msg.FromAddress),
+				sdk.NewAttribute(types.AttributeKeyToAddress, msg.ToAddress),
+				sdk.NewAttribute(types.AttributeKeyStartTime, fmt.Sprintf("%d", ctx.BlockTime().Unix())),
+				sdk.NewAttribute(types.AttributeKeyCliffDays, fmt.Sprintf("%d", msg.CliffDays)),
+				sdk.NewAttribute(types.AttributeKeyMonths, fmt.Sprintf("%d", msg.Months)),
+				sdk.NewAttribute(types.AttributeKeyCoins, msg.Amount.String()),
+			),
+		},
+	)
+
+	return &types.MsgAddVestingGrantResponse{}, nil
+}
+
+func (k msgServer) HandleCreatePeriodicVestingAccount(goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := k.Keeper.accountKeeper
+	bk := k.Keeper.bankKeeper
+
+	totalCoins := msg.GetTotalAmount()
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	from := sdk.MustAccAddressFromBech32(msg.FromAddress)
+	to := sdk.MustAccAddressFromBech32(msg.ToAddress)
+
+	if bk.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"%s is a blocked address and cannot receive funds", to,
+		)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = k.accountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount := types.NewPeriodicMonthlyVestingAccount(
+		baseAccount,
+		ctx.BlockTime().Unix(),
+		msg.CliffDays,
+		msg.VestingPeriods,
+	)
+	vestingAccount.FunderAddress = msg.FromAddress
+
+	k.SetMonthlyVestingAccount(ctx, vestingAccount)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err := bk.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypePeriodicVestingAccount,
+				sdk.NewAttribute(types.AttributeKeyFromAddress, msg.FromAddress),
+				sdk.NewAttribute(types.AttributeKeyToAddress, msg.ToAddress),
+				sdk.NewAttribute(types.AttributeKeyStartTime, fmt.Sprintf("%d", ctx.BlockTime().Unix())),
+				sdk.NewAttribute(types.AttributeKeyCliffDays, fmt.Sprintf("%d", msg.CliffDays)),
+				sdk.NewAttribute(types.AttributeKeyPeriods, fmt.Sprintf("%d", len(msg.VestingPeriods))),
+				sdk.NewAttribute(types.AttributeKeyCoins, totalCoins.String()),
+			),
+		},
+	)
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+func (k msgServer) HandleClawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr := sdk.MustAccAddressFromBech32(msg.AccountAddress)
+	dest := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	if msg.DestAddress != "" {
+		dest = sdk.MustAccAddressFromBech32(msg.DestAddress)
+	}
+
+	if k.bankKeeper.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"%s is a blocked address and cannot receive funds", dest,
+		)
+	}
+
+	vestingAccount, err := k.GetMonthlyVestingAccount(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if vestingAccount.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"only funder of vesting account %s can claw back coins", msg.AccountAddress,
+		)
+	}
+
+	clawedBack, err := k.Clawback(ctx, vestingAccount, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit events
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClawback,
+				sdk.NewAttribute(types.AttributeKeyFromAddress, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyToAddress, msg.AccountAddress),
+				sdk.NewAttribute(types.AttributeKeyDestAddress, dest.String()),
+				sdk.NewAttribute(types.AttributeKeyCoins, clawedBack.String()),
+			),
+		},
+	)
+
+	return &types.MsgClawbackResponse{Coins: clawedBack}, nil
+}
@@ -16,6 +16,7 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/golang/mock/gomock"
@@ -35,6 +36,7 @@ type KeeperTestSuite struct {
 	goCtx         context.Context
 	accountKeeper *authkeeper.AccountKeeper
 	bankKeeper    *testutil.MockBankKeeper
+	stakingKeeper *testutil.MockStakingKeeper
 	keeper        *vestingkeeper.Keeper
 	msgServer     types.MsgServer
 	querier       vestingkeeper.Querier
@@ -79,6 +81,7 @@ func (suite *KeeperTestSuite) Setup(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	bk := testutil.NewMockBankKeeper(ctrl)
+	sk := testutil.NewMockStakingKeeper(ctrl)
 
 	paramsSubspace := typesparams.NewSubspace(cdc,
 		types.Amino,
@@ -92,6 +95,7 @@ func (suite *KeeperTestSuite) Setup(t *testing.T) {
 		paramsSubspace,
 		ak,
 		bk,
+		sk,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
@@ -102,6 +106,7 @@ func (suite *KeeperTestSuite) Setup(t *testing.T) {
 	suite.keeper = k
 	suite.accountKeeper = &ak
 	suite.bankKeeper = bk
+	suite.stakingKeeper = sk
 	suite.ctx = ctx
 	suite.goCtx = sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer = vestingkeeper.NewMsgServerImpl(*k)
@@ -190,3 +195,254 @@ func (suite *KeeperTestSuite) TestCreateMonthlyVestingAccount() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) createFundedVestingAccount(funder, address sdk.AccAddress, coins sdk.Coins) *types.MonthlyVestingAccount {
+	baseAccount := authtypes.NewBaseAccountWithAddress(address)
+	baseAccount = suite.accountKeeper.NewAccount(suite.ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount := types.NewMonthlyVestingAccount(baseAccount, coins, suite.ctx.BlockTime().Unix(), 30, 3)
+	vestingAccount.FunderAddress = funder.String()
+	suite.keeper.SetMonthlyVestingAccount(suite.ctx, vestingAccount)
+	return vestingAccount
+}
+
+func (suite *KeeperTestSuite) TestAddVestingGrant() {
+	var (
+		fromAddress sdk.AccAddress
+		toAddress   sdk.AccAddress
+		coins       sdk.Coins
+	)
+	testCases := []struct {
+		name     string
+		init     func()
+		expected func(resp *types.MsgAddVestingGrantResponse, error error)
+	}{
+		{
+			name: "add grant to non-vesting account",
+			init: func() {
+				fromAddress = tests.RandomAccAddress()
+				toAddress = tests.RandomAccAddress()
+
+				baseAccount := authtypes.NewBaseAccountWithAddress(toAddress)
+				acc := suite.accountKeeper.NewAccount(suite.ctx, baseAccount)
+				suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+				coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+				suite.bankKeeper.EXPECT().IsSendEnabledCoins(suite.ctx, coins).Return(nil)
+			},
+			expected: func(resp *types.MsgAddVestingGrantResponse, error error) {
+				suite.Require().ErrorIs(error, types.ErrNotFoundVestingAccount)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "add grant by non-funder",
+			init: func() {
+				fromAddress = tests.RandomAccAddress()
+				toAddress = tests.RandomAccAddress()
+
+				coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+				suite.createFundedVestingAccount(tests.RandomAccAddress(), toAddress, coins)
+				suite.bankKeeper.EXPECT().IsSendEnabledCoins(suite.ctx, coins).Return(nil)
+			},
+			expected: func(resp *types.MsgAddVestingGrantResponse, error error) {
+				suite.Require().ErrorIs(error, errortypes.ErrUnauthorized)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "add a valid grant",
+			init: func() {
+				fromAddress = tests.RandomAccAddress()
+				toAddress = tests.RandomAccAddress()
+
+				coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+				suite.createFundedVestingAccount(fromAddress, toAddress, coins)
+				suite.bankKeeper.EXPECT().IsSendEnabledCoins(suite.ctx, coins).Return(nil)
+				suite.bankKeeper.EXPECT().SendCoins(suite.ctx, fromAddress, toAddress, coins).Return(nil)
+			},
+			expected: func(resp *types.MsgAddVestingGrantResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().Equal(resp, &types.MsgAddVestingGrantResponse{})
+
+				vestingAccount, err := suite.keeper.GetMonthlyVestingAccount(suite.ctx, toAddress)
+				suite.Require().NoError(err)
+				suite.Require().Equal(coins.Add(coins...), vestingAccount.OriginalVesting)
+				suite.Require().NoError(vestingAccount.Validate())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if tc.init != nil {
+				tc.init()
+			}
+
+			msg := types.NewMsgAddVestingGrant(
+				fromAddress.String(),
+				toAddress.String(),
+				30,
+				12,
+				coins,
+			)
+			resp, err := suite.msgServer.HandleAddVestingGrant(suite.goCtx, msg)
+			tc.expected(resp, err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreatePeriodicVestingAccount() {
+	var (
+		fromAddress sdk.AccAddress
+		toAddress   sdk.AccAddress
+		periods     []sdkvesting.Period
+	)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	periods = []sdkvesting.Period{
+		{Length: types.SecondsOfMonth, Amount: coins},
+		{Length: types.SecondsOfMonth * 2, Amount: coins},
+	}
+	totalCoins := coins.Add(coins...)
+
+	testCases := []struct {
+		name     string
+		init     func()
+		expected func(resp *types.MsgCreatePeriodicVestingAccountResponse, error error)
+	}{
+		{
+			name: "create for existing account",
+			init: func() {
+				fromAddress = tests.RandomAccAddress()
+				toAddress = tests.RandomAccAddress()
+
+				baseAccount := authtypes.NewBaseAccountWithAddress(toAddress)
+				acc := suite.accountKeeper.NewAccount(suite.ctx, baseAccount)
+				suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+				suite.bankKeeper.EXPECT().IsSendEnabledCoins(suite.ctx, totalCoins).Return(nil)
+				suite.bankKeeper.EXPECT().BlockedAddr(toAddress).Return(false)
+			},
+			expected: func(resp *types.MsgCreatePeriodicVestingAccountResponse, error error) {
+				suite.Require().ErrorIs(error, errortypes.ErrInvalidRequest)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "create a valid periodic vesting account",
+			init: func() {
+				fromAddress = tests.RandomAccAddress()
+				toAddress = tests.RandomAccAddress()
+
+				suite.bankKeeper.EXPECT().IsSendEnabledCoins(suite.ctx, totalCoins).Return(nil)
+				suite.bankKeeper.EXPECT().BlockedAddr(toAddress).Return(false)
+				suite.bankKeeper.EXPECT().SendCoins(suite.ctx, fromAddress, toAddress, totalCoins).Return(nil)
+			},
+			expected: func(resp *types.MsgCreatePeriodicVestingAccountResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().Equal(resp, &types.MsgCreatePeriodicVestingAccountResponse{})
+
+				vestingAccount, err := suite.keeper.GetMonthlyVestingAccount(suite.ctx, toAddress)
+				suite.Require().NoError(err)
+				suite.Require().Equal(fromAddress.String(), vestingAccount.GetFunderAddress())
+				suite.Require().Equal(periods, vestingAccount.VestingPeriods)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if tc.init != nil {
+				tc.init()
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(
+				fromAddress.String(),
+				toAddress.String(),
+				30,
+				periods,
+			)
+			resp, err := suite.msgServer.HandleCreatePeriodicVestingAccount(suite.goCtx, msg)
+			tc.expected(resp, err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClawback() {
+	var (
+		funderAddress  sdk.AccAddress
+		accountAddress sdk.AccAddress
+	)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+
+	testCases := []struct {
+		name     string
+		init     func()
+		expected func(resp *types.MsgClawbackResponse, error error)
+	}{
+		{
+			name: "claw back by non-funder",
+			init: func() {
+				funderAddress = tests.RandomAccAddress()
+				accountAddress = tests.RandomAccAddress()
+
+				suite.createFundedVestingAccount(tests.RandomAccAddress(), accountAddress, coins)
+				suite.bankKeeper.EXPECT().BlockedAddr(funderAddress).Return(false)
+			},
+			expected: func(resp *types.MsgClawbackResponse, error error) {
+				suite.Require().ErrorIs(error, errortypes.ErrUnauthorized)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "claw back to blocked address",
+			init: func() {
+				funderAddress = tests.RandomAccAddress()
+				accountAddress = tests.RandomAccAddress()
+
+				suite.createFundedVestingAccount(funderAddress, accountAddress, coins)
+				suite.bankKeeper.EXPECT().BlockedAddr(funderAddress).Return(true)
+			},
+			expected: func(resp *types.MsgClawbackResponse, error error) {
+				suite.Require().ErrorIs(error, errortypes.ErrUnauthorized)
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "claw back all unvested coins",
+			init: func() {
+				funderAddress = tests.RandomAccAddress()
+				accountAddress = tests.RandomAccAddress()
+
+				suite.createFundedVestingAccount(funderAddress, accountAddress, coins)
+				suite.bankKeeper.EXPECT().BlockedAddr(funderAddress).Return(false)
+				suite.stakingKeeper.EXPECT().BondDenom(suite.ctx).Return(sdk.DefaultBondDenom)
+				suite.bankKeeper.EXPECT().GetAllBalances(suite.ctx, accountAddress).Return(coins)
+				suite.bankKeeper.EXPECT().SendCoins(suite.ctx, accountAddress, funderAddress, coins).Return(nil)
+			},
+			expected: func(resp *types.MsgClawbackResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().Equal(coins, resp.Coins)
+
+				// Account without remaining vesting coins is converted to base account
+				_, err := suite.keeper.GetMonthlyVestingAccount(suite.ctx, accountAddress)
+				suite.Require().ErrorIs(err, types.ErrNotFoundVestingAccount)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if tc.init != nil {
+				tc.init()
+			}
+
+			msg := types.NewMsgClawback(
+				funderAddress.String(),
+				accountAddress.String(),
+				"",
+			)
+			resp, err := suite.msgServer.HandleClawback(suite.goCtx, msg)
+			tc.expected(resp, err)
+		})
+	}
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -43,3 +45,92 @@ func (q Querier) Balances(goCtx context.Context, req *types.QueryBalancesRequest
 		Vested:   vested,
 	}, nil
 }
+
+func (q Querier) VestingSchedule(goCtx context.Context, req *types.QueryVestingScheduleRequest) (*types.QueryVestingScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := q.Keeper.GetMonthlyVestingAccount(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVestingScheduleResponse{
+		Address:         req.Address,
+		FunderAddress:   account.FunderAddress,
+		StartTime:       account.StartTime,
+		CliffTime:       account.CliffTime,
+		EndTime:         account.EndTime,
+		OriginalVesting: account.OriginalVesting,
+		Periods:         account.GetSchedule(ctx.BlockTime()),
+	}, nil
+}
+
+func (q Querier) NextUnlock(goCtx context.Context, req *types.QueryNextUnlockRequest) (*types.QueryNextUnlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := q.Keeper.GetMonthlyVestingAccount(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockTime, amount := account.GetNextUnlock(ctx.BlockTime())
+
+	return &types.QueryNextUnlockResponse{
+		UnlockTime:  unlockTime,
+		Amount:      amount,
+		FullyVested: unlockTime == 0,
+	}, nil
+}
+
+// VestingAccounts returns monthly vesting accounts using index of vesting accounts, which is kept by the module,
+// since accounts are stored by auth module. Accounts are ordered by their addresses.
+func (q Querier) VestingAccounts(goCtx context.Context, req *types.QueryVestingAccountsRequest) (*types.QueryVestingAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	indexPrefix := types.KeyPrefix(types.VestingAccountKeyPrefix)
+	if req.FunderAddress != "" {
+		funder, err := sdk.AccAddressFromBech32(req.FunderAddress)
+		if err != nil {
+			return nil, err
+		}
+		indexPrefix = types.FunderVestingAccountsPrefix(funder)
+	}
+
+	var accounts []*types.MonthlyVestingAccount
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), indexPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		account, err := q.GetMonthlyVestingAccount(ctx, key)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, account)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryVestingAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
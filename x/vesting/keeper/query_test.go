@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"time"
+
 	tmtime "github.com/cometbft/cometbft/types/time"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -138,3 +141,196 @@ func (suite *KeeperTestSuite) TestBalances() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestVestingSchedule() {
+	toAddress := tests.RandomAccAddress()
+	funderAddress := tests.RandomAccAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	vestingAccount := suite.createFundedVestingAccount(funderAddress, toAddress, coins)
+
+	// first month after cliff is over
+	blockTime := time.Unix(vestingAccount.CliffTime+types.SecondsOfMonth, 0)
+	goCtx := sdk.WrapSDKContext(suite.ctx.WithBlockTime(blockTime))
+
+	resp, err := suite.querier.VestingSchedule(goCtx, nil)
+	suite.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+	suite.Require().Nil(resp)
+
+	resp, err = suite.querier.VestingSchedule(goCtx, &types.QueryVestingScheduleRequest{Address: toAddress.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(funderAddress.String(), resp.FunderAddress)
+	suite.Require().Equal(vestingAccount.EndTime, resp.EndTime)
+	suite.Require().Equal(coins, resp.OriginalVesting)
+	suite.Require().Len(resp.Periods, 3)
+
+	expectedStatuses := []types.PeriodStatus{types.PeriodStatusVested, types.PeriodStatusLocked, types.PeriodStatusLocked}
+	for i, period := range resp.Periods {
+		suite.Require().Equal(vestingAccount.CliffTime+types.SecondsOfMonth*int64(i+1), period.UnlockTime)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), period.Amount)
+		suite.Require().Equal(expectedStatuses[i], period.Status)
+	}
+
+	nextUnlock, err := suite.querier.NextUnlock(goCtx, &types.QueryNextUnlockRequest{Address: toAddress.String()})
+	suite.Require().NoError(err)
+	suite.Require().False(nextUnlock.FullyVested)
+	suite.Require().Equal(resp.Periods[1].UnlockTime, nextUnlock.UnlockTime)
+	suite.Require().Equal(resp.Periods[1].Amount, nextUnlock.Amount)
+
+	goCtx = sdk.WrapSDKContext(suite.ctx.WithBlockTime(time.Unix(vestingAccount.EndTime, 0)))
+	nextUnlock, err = suite.querier.NextUnlock(goCtx, &types.QueryNextUnlockRequest{Address: toAddress.String()})
+	suite.Require().NoError(err)
+	suite.Require().True(nextUnlock.FullyVested)
+	suite.Require().Zero(nextUnlock.UnlockTime)
+	suite.Require().True(nextUnlock.Amount.IsZero())
+
+	// base account is not a vesting account
+	baseAddress := tests.RandomAccAddress()
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, baseAddress))
+	_, err = suite.querier.NextUnlock(goCtx, &types.QueryNextUnlockRequest{Address: baseAddress.String()})
+	suite.Require().ErrorIs(err, types.ErrNotFoundVestingAccount)
+}
+
+func (suite *KeeperTestSuite) TestVestingAccounts() {
+	funderAddress := tests.RandomAccAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	for i := 0; i < 3; i++ {
+		suite.createFundedVestingAccount(funderAddress, tests.RandomAccAddress(), coins)
+	}
+	suite.createFundedVestingAccount(tests.RandomAccAddress(), tests.RandomAccAddress(), coins)
+
+	testCases := []struct {
+		name     string
+		req      *types.QueryVestingAccountsRequest
+		expected func(resp *types.QueryVestingAccountsResponse, error error)
+	}{
+		{
+			name: "nil request",
+			expected: func(resp *types.QueryVestingAccountsResponse, error error) {
+				suite.Require().ErrorIs(error, status.Error(codes.InvalidArgument, "invalid request"))
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "invalid funder address",
+			req:  &types.QueryVestingAccountsRequest{FunderAddress: "invalid address"},
+			expected: func(resp *types.QueryVestingAccountsResponse, error error) {
+				suite.Require().ErrorContains(error, "decoding bech32")
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "filter by funder",
+			req:  &types.QueryVestingAccountsRequest{FunderAddress: funderAddress.String()},
+			expected: func(resp *types.QueryVestingAccountsResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().Len(resp.Accounts, 3)
+				suite.Require().Equal(uint64(3), resp.Pagination.Total)
+				suite.Require().Nil(resp.Pagination.NextKey)
+				for _, account := range resp.Accounts {
+					suite.Require().Equal(funderAddress.String(), account.FunderAddress)
+				}
+			},
+		},
+		{
+			name: "paginate by key",
+			req: &types.QueryVestingAccountsRequest{
+				FunderAddress: funderAddress.String(),
+				Pagination:    &query.PageRequest{Limit: 2},
+			},
+			expected: func(resp *types.QueryVestingAccountsResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().Len(resp.Accounts, 2)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+
+				next, err := suite.querier.VestingAccounts(suite.goCtx, &types.QueryVestingAccountsRequest{
+					FunderAddress: funderAddress.String(),
+					Pagination:    &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+				})
+				suite.Require().NoError(err)
+				suite.Require().Len(next.Accounts, 1)
+				suite.Require().Nil(next.Pagination.NextKey)
+				suite.Require().Equal(sdk.AccAddress(resp.Pagination.NextKey), next.Accounts[0].GetAddress())
+			},
+		},
+		{
+			name: "paginate by offset",
+			req: &types.QueryVestingAccountsRequest{
+				FunderAddress: funderAddress.String(),
+				Pagination:    &query.PageRequest{Offset: 1, Limit: 5, CountTotal: true},
+			},
+			expected: func(resp *types.QueryVestingAccountsResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().Len(resp.Accounts, 2)
+				suite.Require().Equal(uint64(3), resp.Pagination.Total)
+			},
+		},
+		{
+			name: "count total with limit",
+			req: &types.QueryVestingAccountsRequest{
+				FunderAddress: funderAddress.String(),
+				Pagination:    &query.PageRequest{Limit: 1, CountTotal: true},
+			},
+			expected: func(resp *types.QueryVestingAccountsResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().Len(resp.Accounts, 1)
+				suite.Require().Equal(uint64(3), resp.Pagination.Total)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			name: "both offset and key",
+			req: &types.QueryVestingAccountsRequest{
+				Pagination: &query.PageRequest{Key: []byte{1}, Offset: 1},
+			},
+			expected: func(resp *types.QueryVestingAccountsResponse, error error) {
+				suite.Require().ErrorContains(error, "either offset or key is expected")
+				suite.Require().Nil(resp)
+			},
+		},
+		{
+			name: "all vesting accounts",
+			req:  &types.QueryVestingAccountsRequest{},
+			expected: func(resp *types.QueryVestingAccountsResponse, error error) {
+				suite.Require().NoError(error)
+				suite.Require().GreaterOrEqual(len(resp.Accounts), 4)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.VestingAccounts(suite.goCtx, tc.req)
+			tc.expected(resp, err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVestingAccountsIndex() {
+	funderAddress := tests.RandomAccAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	req := &types.QueryVestingAccountsRequest{FunderAddress: funderAddress.String()}
+
+	account := suite.createFundedVestingAccount(funderAddress, tests.RandomAccAddress(), coins)
+	resp, err := suite.querier.VestingAccounts(suite.goCtx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Accounts, 1)
+
+	// Account converted back to base account is removed from the index
+	suite.keeper.RemoveMonthlyVestingAccount(suite.ctx, account)
+	resp, err = suite.querier.VestingAccounts(suite.goCtx, req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(resp.Accounts)
+
+	// Accounts stored by auth module directly, for example, in genesis, are indexed on demand
+	baseAccount := authtypes.NewBaseAccountWithAddress(tests.RandomAccAddress())
+	baseAccount = suite.accountKeeper.NewAccount(suite.ctx, baseAccount).(*authtypes.BaseAccount)
+	genesisAccount := types.NewMonthlyVestingAccount(baseAccount, coins, suite.ctx.BlockTime().Unix(), 30, 3)
+	genesisAccount.FunderAddress = funderAddress.String()
+	suite.accountKeeper.SetAccount(suite.ctx, genesisAccount)
+
+	suite.keeper.IndexVestingAccounts(suite.ctx)
+	resp, err = suite.querier.VestingAccounts(suite.goCtx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Accounts, 1)
+	suite.Require().Equal(genesisAccount.GetAddress(), resp.Accounts[0].GetAddress())
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"swisstronik/x/vesting/types"
+)
+
+// SetMonthlyVestingAccount stores monthly vesting account and adds it to the index of vesting accounts
+func (k Keeper) SetMonthlyVestingAccount(ctx sdk.Context, va *types.MonthlyVestingAccount) {
+	k.accountKeeper.SetAccount(ctx, va)
+	k.setVestingAccountIndex(ctx, va)
+}
+
+// RemoveMonthlyVestingAccount converts monthly vesting account back to base account and removes it from the index
+func (k Keeper) RemoveMonthlyVestingAccount(ctx sdk.Context, va *types.MonthlyVestingAccount) {
+	k.accountKeeper.SetAccount(ctx, va.BaseAccount)
+	k.removeVestingAccountIndex(ctx, va.GetAddress())
+}
+
+// IndexVestingAccounts adds all monthly vesting accounts stored by auth module to the index of vesting accounts.
+// It iterates over all accounts, so it should be used only in genesis and migrations.
+func (k Keeper) IndexVestingAccounts(ctx sdk.Context) {
+	k.accountKeeper.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		if va, ok := acc.(*types.MonthlyVestingAccount); ok {
+			k.setVestingAccountIndex(ctx, va)
+		}
+		return false
+	})
+}
+
+// setVestingAccountIndex adds index entries of the account. Funder is stored as a value of account entry,
+// so entry of funder index can be removed later.
+func (k Keeper) setVestingAccountIndex(ctx sdk.Context, va *types.MonthlyVestingAccount) {
+	store := ctx.KVStore(k.storeKey)
+	account := va.GetAddress()
+	k.removeVestingAccountIndex(ctx, account)
+
+	// accounts without valid funder are available only without filtering by funder
+	funder, err := sdk.AccAddressFromBech32(va.FunderAddress)
+	if err != nil {
+		store.Set(types.VestingAccountKey(account), []byte{})
+		return
+	}
+	store.Set(types.VestingAccountKey(account), funder)
+	store.Set(types.FunderVestingAccountKey(funder, account), []byte{})
+}
+
+// removeVestingAccountIndex removes index entries of the account
+func (k Keeper) removeVestingAccountIndex(ctx sdk.Context, account sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	funder := store.Get(types.VestingAccountKey(account))
+	if funder == nil {
+		return
+	}
+	if len(funder) > 0 {
+		store.Delete(types.FunderVestingAccountKey(funder, account))
+	}
+	store.Delete(types.VestingAccountKey(account))
+}
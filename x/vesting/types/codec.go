@@ -15,7 +15,10 @@ var (
 )
 
 const (
-	createMonthlyVestingAccount = "vesting/MsgCreateMonthlyVestingAccount"
+	createMonthlyVestingAccount  = "vesting/MsgCreateMonthlyVestingAccount"
+	addVestingGrant              = "vesting/MsgAddVestingGrant"
+	createPeriodicVestingAccount = "vesting/MsgCreatePeriodicVestingAccount"
+	clawback                     = "vesting/MsgClawback"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -26,6 +29,9 @@ func init() {
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateMonthlyVestingAccount{}, createMonthlyVestingAccount, nil)
+	cdc.RegisterConcrete(&MsgAddVestingGrant{}, addVestingGrant, nil)
+	cdc.RegisterConcrete(&MsgCreatePeriodicVestingAccount{}, createPeriodicVestingAccount, nil)
+	cdc.RegisterConcrete(&MsgClawback{}, clawback, nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -8,6 +8,7 @@ const (
 	codeErrInvalidParam = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
 	codeErrNotFoundVestingAccount
 	codeErrInsufficientUnlockedCoins
+	codeErrNoUnvestedCoins
 )
 
 // x/vesting module sentinel errors
@@ -15,4 +16,5 @@ var (
 	ErrInvalidParam              = errormod.Register(ModuleName, codeErrInvalidParam, "invalid param provided")
 	ErrNotFoundVestingAccount    = errormod.Register(ModuleName, codeErrNotFoundVestingAccount, "not found vesting account")
 	ErrInsufficientUnlockedCoins = errormod.Register(ModuleName, codeErrInsufficientUnlockedCoins, "insufficient unlocked coins")
+	ErrNoUnvestedCoins           = errormod.Register(ModuleName, codeErrNoUnvestedCoins, "no unvested coins")
 )
@@ -1,12 +1,17 @@
 package types
 
 const (
-	EventTypeMonthlyVestingAccount = "monthly_vesting_account"
+	EventTypeMonthlyVestingAccount  = "monthly_vesting_account"
+	EventTypeAddVestingGrant        = "add_vesting_grant"
+	EventTypePeriodicVestingAccount = "periodic_vesting_account"
+	EventTypeClawback               = "clawback"
 
 	AttributeKeyFromAddress = "from_address"
 	AttributeKeyToAddress   = "to_address"
+	AttributeKeyDestAddress = "dest_address"
 	AttributeKeyStartTime   = "start_time"
 	AttributeKeyCliffDays   = "cliff_days"
 	AttributeKeyMonths      = "months"
+	AttributeKeyPeriods     = "periods"
 	AttributeKeyCoins       = "coins"
 )
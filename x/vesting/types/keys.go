@@ -1,6 +1,11 @@
 package types
 
-import "time"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName defines the module name
@@ -24,3 +29,25 @@ var (
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+const (
+	// VestingAccountKeyPrefix is the prefix of index of monthly vesting accounts: `account -> funder`
+	VestingAccountKeyPrefix = "VestingAccount/value/"
+	// FunderVestingAccountKeyPrefix is the prefix of index of monthly vesting accounts by funder: `funder, account -> empty`
+	FunderVestingAccountKeyPrefix = "FunderVestingAccount/value/"
+)
+
+// VestingAccountKey returns the key of index entry of monthly vesting account
+func VestingAccountKey(account sdk.AccAddress) []byte {
+	return append(KeyPrefix(VestingAccountKeyPrefix), account...)
+}
+
+// FunderVestingAccountsPrefix returns the prefix of index entries of monthly vesting accounts created by the funder
+func FunderVestingAccountsPrefix(funder sdk.AccAddress) []byte {
+	return append(KeyPrefix(FunderVestingAccountKeyPrefix), address.MustLengthPrefix(funder)...)
+}
+
+// FunderVestingAccountKey returns the key of index entry of monthly vesting account created by the funder
+func FunderVestingAccountKey(funder, account sdk.AccAddress) []byte {
+	return append(FunderVestingAccountsPrefix(funder), account...)
+}
@@ -6,12 +6,23 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
-const TypeMsgCreateMonthlyVestingAccount = "create_monthly_vesting_account"
+const (
+	TypeMsgCreateMonthlyVestingAccount  = "create_monthly_vesting_account"
+	TypeMsgAddVestingGrant              = "add_vesting_grant"
+	TypeMsgCreatePeriodicVestingAccount = "create_periodic_vesting_account"
+	TypeMsgClawback                     = "clawback"
+)
 
-var _ sdk.Msg = &MsgCreateMonthlyVestingAccount{}
+var (
+	_ sdk.Msg = &MsgCreateMonthlyVestingAccount{}
+	_ sdk.Msg = &MsgAddVestingGrant{}
+	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
+)
 
 func NewMsgCreateMonthlyVestingAccount(fromAddress string, toAddress string, cliffDays, months int64, amount sdk.Coins) *MsgCreateMonthlyVestingAccount {
 	return &MsgCreateMonthlyVestingAccount{
@@ -45,22 +56,59 @@ func (msg *MsgCreateMonthlyVestingAccount) GetSignBytes() []byte {
 }
 
 func (msg *MsgCreateMonthlyVestingAccount) ValidateBasic() error {
-	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
-	if err != nil {
-		return errorsmod.Wrapf(err, "invalid from address")
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
 	}
 
-	if equal := bytes.Compare(from.Bytes(), common.Address{}.Bytes()); equal == 0 {
-		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "from address cannot be the zero address")
+	if msg.CliffDays < 0 {
+		return errorsmod.Wrapf(ErrInvalidParam, "cliff days cannot be negative")
+	}
+
+	if msg.Months < 1 {
+		return errorsmod.Wrapf(ErrInvalidParam, "months should be at least one")
 	}
 
-	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if !msg.Amount.IsAllPositive() {
+		return errorsmod.Wrapf(ErrInvalidParam, "amount should be at least one")
+	}
+
+	return nil
+}
+
+func NewMsgAddVestingGrant(fromAddress string, toAddress string, cliffDays, months int64, amount sdk.Coins) *MsgAddVestingGrant {
+	return &MsgAddVestingGrant{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		CliffDays:   cliffDays,
+		Months:      months,
+		Amount:      amount,
+	}
+}
+
+func (msg *MsgAddVestingGrant) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddVestingGrant) Type() string {
+	return TypeMsgAddVestingGrant
+}
+
+func (msg *MsgAddVestingGrant) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
 	if err != nil {
-		return errorsmod.Wrapf(err, "invalid to address")
+		panic(err)
 	}
+	return []sdk.AccAddress{from}
+}
 
-	if equal := bytes.Compare(to.Bytes(), common.Address{}.Bytes()); equal == 0 {
-		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "to address cannot be the zero address")
+func (msg *MsgAddVestingGrant) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddVestingGrant) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
 	}
 
 	if msg.CliffDays < 0 {
@@ -77,3 +125,136 @@ func (msg *MsgCreateMonthlyVestingAccount) ValidateBasic() error {
 
 	return nil
 }
+
+func NewMsgCreatePeriodicVestingAccount(fromAddress string, toAddress string, cliffDays int64, periods []sdkvesting.Period) *MsgCreatePeriodicVestingAccount {
+	return &MsgCreatePeriodicVestingAccount{
+		FromAddress:    fromAddress,
+		ToAddress:      toAddress,
+		CliffDays:      cliffDays,
+		VestingPeriods: periods,
+	}
+}
+
+func (msg *MsgCreatePeriodicVestingAccount) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreatePeriodicVestingAccount) Type() string {
+	return TypeMsgCreatePeriodicVestingAccount
+}
+
+func (msg *MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg *MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetTotalAmount returns the sum of coins of all vesting periods
+func (msg *MsgCreatePeriodicVestingAccount) GetTotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, p := range msg.VestingPeriods {
+		total = total.Add(p.Amount...)
+	}
+	return total
+}
+
+func (msg *MsgCreatePeriodicVestingAccount) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
+	}
+
+	if msg.CliffDays < 0 {
+		return errorsmod.Wrapf(ErrInvalidParam, "cliff days cannot be negative")
+	}
+
+	if len(msg.VestingPeriods) == 0 {
+		return errorsmod.Wrapf(ErrInvalidParam, "vesting periods cannot be empty")
+	}
+
+	for i, p := range msg.VestingPeriods {
+		if p.Length < 1 {
+			return errorsmod.Wrapf(ErrInvalidParam, "length of period %d should be positive", i)
+		}
+		if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+			return errorsmod.Wrapf(ErrInvalidParam, "amount of period %d should be positive", i)
+		}
+	}
+
+	return nil
+}
+
+func NewMsgClawback(funderAddress, accountAddress, destAddress string) *MsgClawback {
+	return &MsgClawback{
+		FunderAddress:  funderAddress,
+		AccountAddress: accountAddress,
+		DestAddress:    destAddress,
+	}
+}
+
+func (msg *MsgClawback) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClawback) Type() string {
+	return TypeMsgClawback
+}
+
+func (msg *MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{funder}
+}
+
+func (msg *MsgClawback) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClawback) ValidateBasic() error {
+	if err := validateAddresses(msg.FunderAddress, msg.AccountAddress); err != nil {
+		return err
+	}
+
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid destination address")
+		}
+		if msg.DestAddress == msg.AccountAddress {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "destination address cannot be the vesting account")
+		}
+	}
+
+	return nil
+}
+
+// validateAddresses checks that funder and vesting addresses are valid and not zero
+func validateAddresses(fromAddress, toAddress string) error {
+	from, err := sdk.AccAddressFromBech32(fromAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid from address")
+	}
+
+	if equal := bytes.Compare(from.Bytes(), common.Address{}.Bytes()); equal == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "from address cannot be the zero address")
+	}
+
+	to, err := sdk.AccAddressFromBech32(toAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid to address")
+	}
+
+	if equal := bytes.Compare(to.Bytes(), common.Address{}.Bytes()); equal == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "to address cannot be the zero address")
+	}
+
+	return nil
+}
@@ -8,7 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,6 +32,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PeriodStatus defines whether coins of vesting period are already unlocked.
+type PeriodStatus int32
+
+const (
+	// PERIOD_STATUS_UNSPECIFIED defines an unspecified status.
+	PeriodStatusUnspecified PeriodStatus = 0
+	// PERIOD_STATUS_LOCKED defines a period, which coins are not vested yet.
+	PeriodStatusLocked PeriodStatus = 1
+	// PERIOD_STATUS_VESTED defines a period, which coins are vested.
+	PeriodStatusVested PeriodStatus = 2
+)
+
+var PeriodStatus_name = map[int32]string{
+	0: "PERIOD_STATUS_UNSPECIFIED",
+	1: "PERIOD_STATUS_LOCKED",
+	2: "PERIOD_STATUS_VESTED",
+}
+
+var PeriodStatus_value = map[string]int32{
+	"PERIOD_STATUS_UNSPECIFIED": 0,
+	"PERIOD_STATUS_LOCKED":      1,
+	"PERIOD_STATUS_VESTED":      2,
+}
+
+func (x PeriodStatus) String() string {
+	return proto.EnumName(PeriodStatus_name, int32(x))
+}
+
+func (PeriodStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_710996a7cd98c547, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -225,46 +257,518 @@ func (m *QueryBalancesResponse) GetVested() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+// SchedulePeriod defines vesting period with absolute unlock time.
+type SchedulePeriod struct {
+	// unlock_time defines unix timestamp, when coins of the period are vested
+	UnlockTime int64 `protobuf:"varint,1,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	// amount defines coins, which are vested at unlock time
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// status defines whether coins of the period are vested at current block time
+	Status PeriodStatus `protobuf:"varint,3,opt,name=status,proto3,enum=swisstronik.vesting.PeriodStatus" json:"status,omitempty"`
+}
+
+func (m *SchedulePeriod) Reset()         { *m = SchedulePeriod{} }
+func (m *SchedulePeriod) String() string { return proto.CompactTextString(m) }
+func (*SchedulePeriod) ProtoMessage()    {}
+func (*SchedulePeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710996a7cd98c547, []int{4}
+}
+func (m *SchedulePeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePeriod.Merge(m, src)
+}
+func (m *SchedulePeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePeriod proto.InternalMessageInfo
+
+func (m *SchedulePeriod) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+func (m *SchedulePeriod) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SchedulePeriod) GetStatus() PeriodStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PeriodStatusUnspecified
+}
+
+// QueryVestingScheduleRequest is the request type for the Query/VestingSchedule RPC method.
+type QueryVestingScheduleRequest struct {
+	// address of the monthly vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryVestingScheduleRequest) Reset()         { *m = QueryVestingScheduleRequest{} }
+func (m *QueryVestingScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingScheduleRequest) ProtoMessage()    {}
+func (*QueryVestingScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710996a7cd98c547, []int{5}
+}
+func (m *QueryVestingScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingScheduleRequest.Merge(m, src)
+}
+func (m *QueryVestingScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryVestingScheduleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryVestingScheduleResponse is the response type for the Query/VestingSchedule RPC method.
+type QueryVestingScheduleResponse struct {
+	// address of the monthly vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// funder_address defines address of account, which funded vesting account
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// start_time defines unix timestamp, when vesting account was created
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// cliff_time defines unix timestamp, when cliff ends
+	CliffTime int64 `protobuf:"varint,4,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
+	// end_time defines unix timestamp, when all coins are vested
+	EndTime int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// original_vesting defines total amount of vesting coins
+	OriginalVesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=original_vesting,json=originalVesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"original_vesting"`
+	// periods defines unlock timeline of vesting account
+	Periods []SchedulePeriod `protobuf:"bytes,7,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *QueryVestingScheduleResponse) Reset()         { *m = QueryVestingScheduleResponse{} }
+func (m *QueryVestingScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingScheduleResponse) ProtoMessage()    {}
+func (*QueryVestingScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710996a7cd98c547, []int{6}
+}
+func (m *QueryVestingScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingScheduleResponse.Merge(m, src)
+}
+func (m *QueryVestingScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryVestingScheduleResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryVestingScheduleResponse) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *QueryVestingScheduleResponse) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryVestingScheduleResponse) GetCliffTime() int64 {
+	if m != nil {
+		return m.CliffTime
+	}
+	return 0
+}
+
+func (m *QueryVestingScheduleResponse) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *QueryVestingScheduleResponse) GetOriginalVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OriginalVesting
+	}
+	return nil
+}
+
+func (m *QueryVestingScheduleResponse) GetPeriods() []SchedulePeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+// QueryNextUnlockRequest is the request type for the Query/NextUnlock RPC method.
+type QueryNextUnlockRequest struct {
+	// address of the monthly vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryNextUnlockRequest) Reset()         { *m = QueryNextUnlockRequest{} }
+func (m *QueryNextUnlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextUnlockRequest) ProtoMessage()    {}
+func (*QueryNextUnlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710996a7cd98c547, []int{7}
+}
+func (m *QueryNextUnlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextUnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextUnlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextUnlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextUnlockRequest.Merge(m, src)
+}
+func (m *QueryNextUnlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextUnlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextUnlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextUnlockRequest proto.InternalMessageInfo
+
+func (m *QueryNextUnlockRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryNextUnlockResponse is the response type for the Query/NextUnlock RPC method.
+type QueryNextUnlockResponse struct {
+	// unlock_time defines unix timestamp of the next unlock, zero if all coins are vested
+	UnlockTime int64 `protobuf:"varint,1,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	// amount defines coins, which are vested at the next unlock
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// fully_vested defines whether all coins of vesting account are vested
+	FullyVested bool `protobuf:"varint,3,opt,name=fully_vested,json=fullyVested,proto3" json:"fully_vested,omitempty"`
+}
+
+func (m *QueryNextUnlockResponse) Reset()         { *m = QueryNextUnlockResponse{} }
+func (m *QueryNextUnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextUnlockResponse) ProtoMessage()    {}
+func (*QueryNextUnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710996a7cd98c547, []int{8}
+}
+func (m *QueryNextUnlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextUnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextUnlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextUnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextUnlockResponse.Merge(m, src)
+}
+func (m *QueryNextUnlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextUnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextUnlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextUnlockResponse proto.InternalMessageInfo
+
+func (m *QueryNextUnlockResponse) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+func (m *QueryNextUnlockResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *QueryNextUnlockResponse) GetFullyVested() bool {
+	if m != nil {
+		return m.FullyVested
+	}
+	return false
+}
+
+// QueryVestingAccountsRequest is the request type for the Query/VestingAccounts RPC method.
+type QueryVestingAccountsRequest struct {
+	// funder_address filters vesting accounts by funder, all accounts are returned if empty
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingAccountsRequest) Reset()         { *m = QueryVestingAccountsRequest{} }
+func (m *QueryVestingAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountsRequest) ProtoMessage()    {}
+func (*QueryVestingAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710996a7cd98c547, []int{9}
+}
+func (m *QueryVestingAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountsRequest.Merge(m, src)
+}
+func (m *QueryVestingAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryVestingAccountsRequest) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *QueryVestingAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVestingAccountsResponse is the response type for the Query/VestingAccounts RPC method.
+type QueryVestingAccountsResponse struct {
+	// accounts defines monthly vesting accounts
+	Accounts []*MonthlyVestingAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingAccountsResponse) Reset()         { *m = QueryVestingAccountsResponse{} }
+func (m *QueryVestingAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountsResponse) ProtoMessage()    {}
+func (*QueryVestingAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710996a7cd98c547, []int{10}
+}
+func (m *QueryVestingAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountsResponse.Merge(m, src)
+}
+func (m *QueryVestingAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryVestingAccountsResponse) GetAccounts() []*MonthlyVestingAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryVestingAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("swisstronik.vesting.PeriodStatus", PeriodStatus_name, PeriodStatus_value)
 	proto.RegisterType((*QueryParamsRequest)(nil), "swisstronik.vesting.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "swisstronik.vesting.QueryParamsResponse")
 	proto.RegisterType((*QueryBalancesRequest)(nil), "swisstronik.vesting.QueryBalancesRequest")
 	proto.RegisterType((*QueryBalancesResponse)(nil), "swisstronik.vesting.QueryBalancesResponse")
+	proto.RegisterType((*SchedulePeriod)(nil), "swisstronik.vesting.SchedulePeriod")
+	proto.RegisterType((*QueryVestingScheduleRequest)(nil), "swisstronik.vesting.QueryVestingScheduleRequest")
+	proto.RegisterType((*QueryVestingScheduleResponse)(nil), "swisstronik.vesting.QueryVestingScheduleResponse")
+	proto.RegisterType((*QueryNextUnlockRequest)(nil), "swisstronik.vesting.QueryNextUnlockRequest")
+	proto.RegisterType((*QueryNextUnlockResponse)(nil), "swisstronik.vesting.QueryNextUnlockResponse")
+	proto.RegisterType((*QueryVestingAccountsRequest)(nil), "swisstronik.vesting.QueryVestingAccountsRequest")
+	proto.RegisterType((*QueryVestingAccountsResponse)(nil), "swisstronik.vesting.QueryVestingAccountsResponse")
 }
 
 func init() { proto.RegisterFile("swisstronik/vesting/query.proto", fileDescriptor_710996a7cd98c547) }
 
 var fileDescriptor_710996a7cd98c547 = []byte{
-	// 464 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x6e, 0x13, 0x31,
-	0x14, 0x8d, 0x53, 0x08, 0xc5, 0xdd, 0xb9, 0x41, 0x0a, 0x09, 0x4c, 0xa2, 0x61, 0xd1, 0xb4, 0x12,
-	0x76, 0x1b, 0xc4, 0x82, 0x6d, 0xb8, 0x40, 0xc9, 0x92, 0x9d, 0x67, 0xc6, 0x32, 0x56, 0x12, 0xff,
-	0xe9, 0xd8, 0x29, 0x54, 0x08, 0x09, 0x71, 0x02, 0x04, 0x07, 0x60, 0xcf, 0x49, 0x2a, 0x56, 0x95,
-	0xd8, 0xb0, 0x02, 0x94, 0x70, 0x10, 0x34, 0xb6, 0xa7, 0x0a, 0x65, 0x22, 0x58, 0xc0, 0x6a, 0x66,
-	0xec, 0xf7, 0xdf, 0x7b, 0xff, 0xfd, 0x3f, 0xb8, 0x6f, 0x9e, 0x2b, 0x63, 0x6c, 0x01, 0x5a, 0x4d,
-	0xd9, 0xa9, 0x30, 0x56, 0x69, 0xc9, 0x4e, 0x16, 0xa2, 0x38, 0xa3, 0x79, 0x01, 0x16, 0xc8, 0xee,
-	0x1a, 0x80, 0x06, 0x40, 0xb7, 0x2d, 0x41, 0x82, 0xbb, 0x67, 0xe5, 0x9b, 0x87, 0x76, 0xef, 0x48,
-	0x00, 0x39, 0x13, 0x8c, 0xe7, 0x8a, 0x71, 0xad, 0xc1, 0x72, 0xab, 0x40, 0x9b, 0x70, 0x7b, 0x90,
-	0x82, 0x99, 0x83, 0x61, 0x09, 0x37, 0xc2, 0x2b, 0xb0, 0xd3, 0xa3, 0x44, 0x58, 0x7e, 0xc4, 0x72,
-	0x2e, 0x95, 0x76, 0xe0, 0x80, 0x8d, 0xd6, 0xb1, 0x15, 0x2a, 0x05, 0x55, 0xdd, 0x0f, 0xea, 0x5c,
-	0xe7, 0xbc, 0xe0, 0xf3, 0xa0, 0x16, 0xb7, 0x31, 0x79, 0x52, 0x6a, 0x1c, 0xbb, 0xc3, 0x89, 0x38,
-	0x59, 0x08, 0x63, 0xe3, 0x63, 0xbc, 0xfb, 0xcb, 0xa9, 0xc9, 0x41, 0x1b, 0x41, 0x1e, 0xe1, 0x96,
-	0x2f, 0xee, 0xa0, 0x01, 0x1a, 0xee, 0x8c, 0x7a, 0xb4, 0xa6, 0x69, 0xea, 0x8b, 0xc6, 0xd7, 0xce,
-	0xbf, 0xf6, 0x1b, 0x93, 0x50, 0x10, 0x1f, 0xe2, 0xb6, 0x63, 0x1c, 0xf3, 0x19, 0xd7, 0xa9, 0xa8,
-	0x94, 0x48, 0x07, 0xdf, 0xe0, 0x59, 0x56, 0x08, 0xe3, 0x39, 0x6f, 0x4e, 0xaa, 0xcf, 0xf8, 0x53,
-	0x13, 0xdf, 0xba, 0x52, 0x12, 0x6c, 0xa4, 0xb8, 0x35, 0x83, 0x74, 0x2a, 0xb2, 0x0e, 0x1a, 0x6c,
-	0x0d, 0x77, 0x46, 0xb7, 0xa9, 0x8f, 0x81, 0x96, 0x31, 0xd0, 0x10, 0x03, 0x7d, 0x0c, 0x4a, 0x8f,
-	0x0f, 0x4b, 0x13, 0x1f, 0xbf, 0xf5, 0x87, 0x52, 0xd9, 0x67, 0x8b, 0x84, 0xa6, 0x30, 0x67, 0x21,
-	0x33, 0xff, 0xb8, 0x6f, 0xb2, 0x29, 0xb3, 0x67, 0xb9, 0x30, 0xae, 0xc0, 0x4c, 0x02, 0x35, 0x91,
-	0x78, 0x7b, 0xa1, 0xcb, 0x96, 0x44, 0xd6, 0x69, 0xfe, 0x7b, 0x99, 0x4b, 0xf2, 0xb2, 0x9b, 0x20,
-	0xb3, 0xf5, 0x1f, 0xba, 0xf1, 0xd4, 0xa3, 0x0f, 0x4d, 0x7c, 0xdd, 0x85, 0x49, 0x5e, 0x23, 0xdc,
-	0xf2, 0x13, 0x22, 0x7b, 0xb5, 0xe3, 0xfb, 0x7d, 0x1d, 0xba, 0xc3, 0x3f, 0x03, 0xfd, 0x68, 0xe2,
-	0x7b, 0x6f, 0x3e, 0xff, 0x78, 0xdf, 0xbc, 0x4b, 0x7a, 0x6c, 0xf3, 0xe6, 0x91, 0x77, 0x08, 0x6f,
-	0x57, 0x43, 0x25, 0xfb, 0x9b, 0xb9, 0xaf, 0xec, 0x4a, 0xf7, 0xe0, 0x6f, 0xa0, 0xc1, 0x08, 0x73,
-	0x46, 0xf6, 0xc9, 0x5e, 0xad, 0x91, 0x24, 0xc0, 0xd9, 0xcb, 0xb0, 0x6d, 0xaf, 0xc6, 0x0f, 0xcf,
-	0x97, 0x11, 0xba, 0x58, 0x46, 0xe8, 0xfb, 0x32, 0x42, 0x6f, 0x57, 0x51, 0xe3, 0x62, 0x15, 0x35,
-	0xbe, 0xac, 0xa2, 0xc6, 0xd3, 0xde, 0x3a, 0xc3, 0x8b, 0x4b, 0x0e, 0x17, 0x73, 0xd2, 0x72, 0xbf,
-	0xd1, 0x83, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x71, 0xba, 0xa4, 0x20, 0x04, 0x00, 0x00,
+	// 1003 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd8, 0xa9, 0xe3, 0x3e, 0x97, 0x34, 0x9a, 0x86, 0xd6, 0x59, 0x27, 0xb6, 0xe3, 0xaa,
+	0xc4, 0x35, 0xc5, 0x9b, 0x18, 0x21, 0x04, 0xb7, 0xfc, 0x70, 0x50, 0x44, 0x69, 0xcd, 0x3a, 0xc9,
+	0x81, 0x8b, 0xb5, 0xde, 0x1d, 0x3b, 0xab, 0xd8, 0x3b, 0xee, 0xce, 0x6e, 0x48, 0x84, 0x90, 0x10,
+	0x17, 0x50, 0xc5, 0x01, 0xc1, 0x11, 0xe5, 0xc4, 0x01, 0x89, 0x0b, 0x17, 0xfe, 0x02, 0x4e, 0x15,
+	0xa7, 0x22, 0x2e, 0x9c, 0x00, 0x25, 0xfc, 0x0b, 0xdc, 0xd1, 0xce, 0xcc, 0x3a, 0x6b, 0x7b, 0xad,
+	0xa4, 0x52, 0x23, 0x71, 0x72, 0x32, 0xef, 0xfb, 0xde, 0xfb, 0xe6, 0x9b, 0x37, 0x6f, 0x16, 0xf2,
+	0xec, 0x63, 0x8b, 0x31, 0xd7, 0xa1, 0xb6, 0x75, 0xa0, 0x1e, 0x12, 0xe6, 0x5a, 0x76, 0x47, 0x7d,
+	0xe2, 0x11, 0xe7, 0xb8, 0xd2, 0x77, 0xa8, 0x4b, 0xf1, 0xad, 0x10, 0xa0, 0x22, 0x01, 0xca, 0x5c,
+	0x87, 0x76, 0x28, 0x8f, 0xab, 0xfe, 0x5f, 0x02, 0xaa, 0x2c, 0x74, 0x28, 0xed, 0x74, 0x89, 0xaa,
+	0xf7, 0x2d, 0x55, 0xb7, 0x6d, 0xea, 0xea, 0xae, 0x45, 0x6d, 0x26, 0xa3, 0x65, 0x83, 0xb2, 0x1e,
+	0x65, 0x6a, 0x4b, 0x67, 0x44, 0x54, 0x50, 0x0f, 0x57, 0x5b, 0xc4, 0xd5, 0x57, 0xd5, 0xbe, 0xde,
+	0xb1, 0x6c, 0x0e, 0x96, 0xd8, 0x5c, 0x18, 0x1b, 0xa0, 0x0c, 0x6a, 0x05, 0xf1, 0x42, 0x94, 0xea,
+	0xbe, 0xee, 0xe8, 0xbd, 0xa0, 0xda, 0x52, 0x14, 0x42, 0xfe, 0x0a, 0x48, 0x71, 0x0e, 0xf0, 0x87,
+	0xbe, 0x8c, 0x3a, 0xe7, 0x69, 0xe4, 0x89, 0x47, 0x98, 0x5b, 0xac, 0xc3, 0xad, 0xa1, 0x55, 0xd6,
+	0xa7, 0x36, 0x23, 0xf8, 0x1d, 0x48, 0x8a, 0xfc, 0x19, 0x54, 0x40, 0xa5, 0x74, 0x35, 0x5b, 0x89,
+	0xf0, 0xa5, 0x22, 0x48, 0xeb, 0x53, 0xcf, 0xfe, 0xcc, 0xc7, 0x34, 0x49, 0x28, 0xae, 0xc0, 0x1c,
+	0xcf, 0xb8, 0xae, 0x77, 0x75, 0xdb, 0x20, 0x41, 0x25, 0x9c, 0x81, 0x69, 0xdd, 0x34, 0x1d, 0xc2,
+	0x44, 0xce, 0xeb, 0x5a, 0xf0, 0x6f, 0xf1, 0xd7, 0x38, 0xbc, 0x3a, 0x42, 0x91, 0x32, 0x0c, 0x48,
+	0x76, 0xa9, 0x71, 0x40, 0xcc, 0x0c, 0x2a, 0x24, 0x4a, 0xe9, 0xea, 0x7c, 0x45, 0x38, 0x55, 0xf1,
+	0x9d, 0xaa, 0x48, 0xa7, 0x2a, 0x1b, 0xd4, 0xb2, 0xd7, 0x57, 0x7c, 0x11, 0x3f, 0xfe, 0x95, 0x2f,
+	0x75, 0x2c, 0x77, 0xdf, 0x6b, 0x55, 0x0c, 0xda, 0x53, 0xa5, 0xad, 0xe2, 0xe7, 0x0d, 0x66, 0x1e,
+	0xa8, 0xee, 0x71, 0x9f, 0x30, 0x4e, 0x60, 0x9a, 0x4c, 0x8d, 0x3b, 0x90, 0xf2, 0x6c, 0x7f, 0x4b,
+	0xc4, 0xcc, 0xc4, 0x5f, 0x7e, 0x99, 0x41, 0x72, 0x7f, 0x37, 0xb2, 0x4c, 0xe2, 0x0a, 0x76, 0x23,
+	0x52, 0x17, 0x7f, 0x43, 0x30, 0xd3, 0x30, 0xf6, 0x89, 0xe9, 0x75, 0x49, 0x9d, 0x38, 0x16, 0x35,
+	0x71, 0x1e, 0xd2, 0x9e, 0xed, 0x6f, 0xb6, 0xe9, 0x5a, 0x3d, 0xc2, 0xdd, 0x4f, 0x68, 0x20, 0x96,
+	0x76, 0xac, 0x1e, 0xb7, 0x59, 0xef, 0x51, 0xcf, 0x76, 0xaf, 0x62, 0xff, 0x32, 0xb5, 0xdf, 0x52,
+	0xcc, 0xd5, 0x5d, 0x8f, 0x65, 0x12, 0x05, 0x54, 0x9a, 0xa9, 0x2e, 0x45, 0xb7, 0x14, 0x97, 0xdc,
+	0xe0, 0x40, 0x4d, 0x12, 0x8a, 0x6f, 0x43, 0x96, 0xf7, 0xc7, 0x9e, 0x00, 0x05, 0xdb, 0xbb, 0xb8,
+	0xb3, 0xfe, 0x8d, 0xc3, 0x42, 0x34, 0x53, 0x36, 0xd8, 0x44, 0x2a, 0xbe, 0x07, 0x33, 0x6d, 0xcf,
+	0x36, 0x89, 0xd3, 0x0c, 0x00, 0x71, 0x0e, 0x78, 0x45, 0xac, 0xae, 0x49, 0xd8, 0x22, 0x00, 0x73,
+	0x75, 0xc7, 0x15, 0xd6, 0x26, 0xb8, 0xb5, 0xd7, 0xf9, 0x0a, 0x77, 0x76, 0x11, 0xc0, 0xe8, 0x5a,
+	0xed, 0xb6, 0x08, 0x4f, 0x89, 0x30, 0x5f, 0xe1, 0xe1, 0x79, 0x48, 0x11, 0xdb, 0x14, 0xc1, 0x6b,
+	0x3c, 0x38, 0x4d, 0x6c, 0x93, 0x87, 0x0e, 0x61, 0x96, 0x3a, 0x96, 0x3f, 0x28, 0xba, 0x4d, 0x69,
+	0x4e, 0x26, 0xf9, 0xf2, 0x4f, 0xe7, 0x66, 0x50, 0x44, 0x3a, 0x84, 0x37, 0x60, 0xba, 0xcf, 0xcf,
+	0x80, 0x65, 0xa6, 0x79, 0xb9, 0xbb, 0x91, 0xe7, 0x34, 0xdc, 0x62, 0x72, 0x04, 0x04, 0xcc, 0x62,
+	0x15, 0x6e, 0x73, 0xdb, 0x1f, 0x91, 0x23, 0x77, 0x97, 0xf7, 0xd9, 0xc5, 0x67, 0xf5, 0x0b, 0x82,
+	0x3b, 0x63, 0x24, 0x79, 0x4c, 0xff, 0x8f, 0x0e, 0x5e, 0x82, 0x1b, 0x6d, 0xaf, 0xdb, 0x3d, 0x6e,
+	0x0e, 0x6e, 0x31, 0x2a, 0xa5, 0xb4, 0x34, 0x5f, 0xdb, 0x13, 0xb7, 0xef, 0x2b, 0x34, 0xdc, 0xaa,
+	0x6b, 0x86, 0xe1, 0x53, 0x07, 0x43, 0x70, 0xbc, 0xab, 0x50, 0x54, 0x57, 0x6d, 0x01, 0x9c, 0x3f,
+	0x12, 0xbc, 0xf1, 0xd2, 0xd5, 0xd7, 0x86, 0xb6, 0x24, 0xde, 0xac, 0x60, 0x63, 0x75, 0xbd, 0x13,
+	0xdc, 0x06, 0x2d, 0xc4, 0x2c, 0xfe, 0x84, 0x60, 0x21, 0x5a, 0x8e, 0x34, 0x76, 0x0b, 0x52, 0xba,
+	0x5c, 0x93, 0x23, 0xb6, 0x1c, 0x79, 0xdc, 0x1f, 0x50, 0xdb, 0xdd, 0xef, 0x8e, 0xa4, 0xd1, 0x06,
+	0x5c, 0xfc, 0x5e, 0x84, 0xe0, 0xe5, 0x0b, 0x05, 0x0b, 0x11, 0x61, 0xc5, 0xe5, 0x9f, 0x11, 0xdc,
+	0x08, 0xcf, 0x00, 0xfc, 0x2e, 0xcc, 0xd7, 0x6b, 0xda, 0xf6, 0xe3, 0xcd, 0x66, 0x63, 0x67, 0x6d,
+	0x67, 0xb7, 0xd1, 0xdc, 0x7d, 0xd4, 0xa8, 0xd7, 0x36, 0xb6, 0xb7, 0xb6, 0x6b, 0x9b, 0xb3, 0x31,
+	0x25, 0xfb, 0xf4, 0xa4, 0x70, 0x27, 0x4c, 0xd8, 0xb5, 0x59, 0x9f, 0x18, 0x56, 0xdb, 0x22, 0x26,
+	0x5e, 0x81, 0xb9, 0x61, 0xee, 0xc3, 0xc7, 0x1b, 0xef, 0xd7, 0x36, 0x67, 0x91, 0x72, 0xfb, 0xe9,
+	0x49, 0x01, 0x87, 0x69, 0x0f, 0xc5, 0x5b, 0x30, 0xc6, 0xd8, 0xab, 0x35, 0x76, 0x6a, 0x9b, 0xb3,
+	0xf1, 0x71, 0x86, 0x38, 0x71, 0x65, 0xea, 0xcb, 0xef, 0x73, 0xb1, 0xea, 0x17, 0x49, 0xb8, 0xc6,
+	0x8d, 0xc6, 0x9f, 0x21, 0x48, 0x8a, 0x77, 0x11, 0x2f, 0x47, 0x5a, 0x39, 0xfe, 0x08, 0x2b, 0xa5,
+	0x8b, 0x81, 0xc2, 0xaa, 0xe2, 0xdd, 0xcf, 0x7f, 0xff, 0xe7, 0xdb, 0xf8, 0x22, 0xce, 0xaa, 0x93,
+	0x3f, 0x09, 0xf0, 0x37, 0x08, 0x52, 0xc1, 0x53, 0x8a, 0xef, 0x4f, 0xce, 0x3d, 0xf2, 0x42, 0x2b,
+	0xe5, 0xcb, 0x40, 0xa5, 0x10, 0x95, 0x0b, 0xb9, 0x8f, 0x97, 0x23, 0x85, 0xb4, 0x24, 0x5c, 0xfd,
+	0x44, 0xb6, 0xf9, 0xa7, 0xf8, 0x07, 0x04, 0x37, 0x47, 0xa6, 0x30, 0x5e, 0x99, 0x5c, 0x30, 0x7a,
+	0xd4, 0x2b, 0xab, 0x2f, 0xc0, 0xb8, 0x94, 0x52, 0x26, 0xe1, 0x21, 0xa5, 0xdf, 0x21, 0x80, 0xf3,
+	0x19, 0x84, 0x5f, 0x9f, 0x5c, 0x72, 0x6c, 0xbc, 0x29, 0x0f, 0x2e, 0x07, 0x96, 0xd2, 0xaa, 0x5c,
+	0xda, 0x03, 0x5c, 0x8e, 0x94, 0x66, 0x93, 0x23, 0xb7, 0x29, 0x66, 0x5c, 0x48, 0xdd, 0xc9, 0xb9,
+	0x8f, 0xc1, 0x6d, 0xbe, 0x84, 0x8f, 0x23, 0x73, 0x48, 0x59, 0x7d, 0x01, 0x86, 0x14, 0x7b, 0x8f,
+	0x8b, 0xcd, 0xe3, 0xc5, 0x48, 0xb1, 0xc1, 0x24, 0x58, 0x7f, 0xeb, 0xd9, 0x69, 0x0e, 0x3d, 0x3f,
+	0xcd, 0xa1, 0xbf, 0x4f, 0x73, 0xe8, 0xeb, 0xb3, 0x5c, 0xec, 0xf9, 0x59, 0x2e, 0xf6, 0xc7, 0x59,
+	0x2e, 0xf6, 0x51, 0x36, 0xcc, 0x3b, 0x1a, 0x30, 0xf9, 0xa4, 0x6d, 0x25, 0xf9, 0x47, 0xea, 0x9b,
+	0xff, 0x0d, 0x00, 0x6d, 0xf2, 0xc6, 0x48, 0xa1, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -283,6 +787,12 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Balances queries the balances of vesting account.
 	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// VestingSchedule queries the unlock timeline of vesting account.
+	VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error)
+	// NextUnlock queries the next unlock event of vesting account.
+	NextUnlock(ctx context.Context, in *QueryNextUnlockRequest, opts ...grpc.CallOption) (*QueryNextUnlockResponse, error)
+	// VestingAccounts queries all monthly vesting accounts, optionally filtered by funder.
+	VestingAccounts(ctx context.Context, in *QueryVestingAccountsRequest, opts ...grpc.CallOption) (*QueryVestingAccountsResponse, error)
 }
 
 type queryClient struct {
@@ -311,12 +821,45 @@ func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, op
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
+func (c *queryClient) VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error) {
+	out := new(QueryVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.vesting.Query/VestingSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextUnlock(ctx context.Context, in *QueryNextUnlockRequest, opts ...grpc.CallOption) (*QueryNextUnlockResponse, error) {
+	out := new(QueryNextUnlockResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.vesting.Query/NextUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingAccounts(ctx context.Context, in *QueryVestingAccountsRequest, opts ...grpc.CallOption) (*QueryVestingAccountsResponse, error) {
+	out := new(QueryVestingAccountsResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.vesting.Query/VestingAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Balances queries the balances of vesting account.
 	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// VestingSchedule queries the unlock timeline of vesting account.
+	VestingSchedule(context.Context, *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error)
+	// NextUnlock queries the next unlock event of vesting account.
+	NextUnlock(context.Context, *QueryNextUnlockRequest) (*QueryNextUnlockResponse, error)
+	// VestingAccounts queries all monthly vesting accounts, optionally filtered by funder.
+	VestingAccounts(context.Context, *QueryVestingAccountsRequest) (*QueryVestingAccountsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -329,6 +872,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) Balances(ctx context.Context, req *QueryBalancesRequest) (*QueryBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
 }
+func (*UnimplementedQueryServer) VestingSchedule(ctx context.Context, req *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingSchedule not implemented")
+}
+func (*UnimplementedQueryServer) NextUnlock(ctx context.Context, req *QueryNextUnlockRequest) (*QueryNextUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextUnlock not implemented")
+}
+func (*UnimplementedQueryServer) VestingAccounts(ctx context.Context, req *QueryVestingAccountsRequest) (*QueryVestingAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccounts not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -370,6 +922,60 @@ func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.vesting.Query/VestingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingSchedule(ctx, req.(*QueryVestingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.vesting.Query/NextUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextUnlock(ctx, req.(*QueryNextUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.vesting.Query/VestingAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccounts(ctx, req.(*QueryVestingAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "swisstronik.vesting.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -382,6 +988,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Balances",
 			Handler:    _Query_Balances_Handler,
 		},
+		{
+			MethodName: "VestingSchedule",
+			Handler:    _Query_VestingSchedule_Handler,
+		},
+		{
+			MethodName: "NextUnlock",
+			Handler:    _Query_NextUnlock_Handler,
+		},
+		{
+			MethodName: "VestingAccounts",
+			Handler:    _Query_VestingAccounts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "swisstronik/vesting/query.proto",
@@ -517,105 +1135,1387 @@ func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i -= size
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Locked) > 0 {
-		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.UnlockTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OriginalVesting) > 0 {
+		for iNdEx := len(m.OriginalVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CliffTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CliffTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextUnlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextUnlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextUnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextUnlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextUnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FullyVested {
+		i--
+		if m.FullyVested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.UnlockTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SchedulePeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnlockTime))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryVestingScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.CliffTime != 0 {
+		n += 1 + sovQuery(uint64(m.CliffTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if len(m.OriginalVesting) > 0 {
+		for _, e := range m.OriginalVesting {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextUnlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextUnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnlockTime))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FullyVested {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryVestingAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PeriodStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			m.CliffTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalVesting = append(m.OriginalVesting, types.Coin{})
+			if err := m.OriginalVesting[len(m.OriginalVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, SchedulePeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-			i--
-			dAtA[i] = 0xa
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Locked) > 0 {
-		for _, e := range m.Locked {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Unvested) > 0 {
-		for _, e := range m.Unvested {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Vested) > 0 {
-		for _, e := range m.Vested {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextUnlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -638,12 +2538,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextUnlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextUnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -665,7 +2597,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -688,15 +2620,34 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -723,10 +2674,31 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullyVested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullyVested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -748,7 +2720,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -771,15 +2743,15 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -807,7 +2779,43 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -830,7 +2838,7 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -853,15 +2861,15 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -888,14 +2896,14 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locked = append(m.Locked, types.Coin{})
-			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accounts = append(m.Accounts, &MonthlyVestingAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -922,42 +2930,10 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unvested = append(m.Unvested, types.Coin{})
-			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			m.Vested = append(m.Vested, types.Coin{})
-			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
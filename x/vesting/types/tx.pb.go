@@ -9,6 +9,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	types1 "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -152,40 +153,408 @@ func (m *MsgCreateMonthlyVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateMonthlyVestingAccountResponse proto.InternalMessageInfo
 
+// MsgAddVestingGrant defines a message that enables adding a monthly vesting
+// schedule with cliff to an existing monthly vesting account.
+type MsgAddVestingGrant struct {
+	// from_address is a signer address that funds tokens. It should be the
+	// funder of the vesting account.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// to_address defines vesting address that receives funds
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// cliff_days defines the days relative to grant time
+	CliffDays int64 `protobuf:"varint,3,opt,name=cliff_days,json=cliffDays,proto3" json:"cliff_days,omitempty"`
+	// months defines number of months for linear vesting
+	Months int64                                    `protobuf:"varint,4,opt,name=months,proto3" json:"months,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgAddVestingGrant) Reset()         { *m = MsgAddVestingGrant{} }
+func (m *MsgAddVestingGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgAddVestingGrant) ProtoMessage()    {}
+func (*MsgAddVestingGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_899431b613aba5b5, []int{2}
+}
+func (m *MsgAddVestingGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddVestingGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddVestingGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddVestingGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddVestingGrant.Merge(m, src)
+}
+func (m *MsgAddVestingGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddVestingGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddVestingGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddVestingGrant proto.InternalMessageInfo
+
+func (m *MsgAddVestingGrant) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgAddVestingGrant) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgAddVestingGrant) GetCliffDays() int64 {
+	if m != nil {
+		return m.CliffDays
+	}
+	return 0
+}
+
+func (m *MsgAddVestingGrant) GetMonths() int64 {
+	if m != nil {
+		return m.Months
+	}
+	return 0
+}
+
+func (m *MsgAddVestingGrant) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgAddVestingGrantResponse defines MsgAddVestingGrant response type.
+type MsgAddVestingGrantResponse struct {
+}
+
+func (m *MsgAddVestingGrantResponse) Reset()         { *m = MsgAddVestingGrantResponse{} }
+func (m *MsgAddVestingGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddVestingGrantResponse) ProtoMessage()    {}
+func (*MsgAddVestingGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_899431b613aba5b5, []int{3}
+}
+func (m *MsgAddVestingGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddVestingGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddVestingGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddVestingGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddVestingGrantResponse.Merge(m, src)
+}
+func (m *MsgAddVestingGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddVestingGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddVestingGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddVestingGrantResponse proto.InternalMessageInfo
+
+// MsgCreatePeriodicVestingAccount defines a message that enables creating a
+// vesting account with cliff and custom vesting periods.
+type MsgCreatePeriodicVestingAccount struct {
+	// from_address is a signer address that funds tokens
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// to_address defines vesting address that receives funds
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// cliff_days defines the days relative to start time
+	CliffDays int64 `protobuf:"varint,3,opt,name=cliff_days,json=cliffDays,proto3" json:"cliff_days,omitempty"`
+	// vesting_periods defines the vesting periods, which start after cliff.
+	// Amount of each period is unlocked at the end of the period.
+	VestingPeriods []types1.Period `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+}
+
+func (m *MsgCreatePeriodicVestingAccount) Reset()         { *m = MsgCreatePeriodicVestingAccount{} }
+func (m *MsgCreatePeriodicVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePeriodicVestingAccount) ProtoMessage()    {}
+func (*MsgCreatePeriodicVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_899431b613aba5b5, []int{4}
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePeriodicVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccount.Merge(m, src)
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePeriodicVestingAccount proto.InternalMessageInfo
+
+func (m *MsgCreatePeriodicVestingAccount) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetCliffDays() int64 {
+	if m != nil {
+		return m.CliffDays
+	}
+	return 0
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetVestingPeriods() []types1.Period {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgCreatePeriodicVestingAccountResponse defines
+// MsgCreatePeriodicVestingAccount response type.
+type MsgCreatePeriodicVestingAccountResponse struct {
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) Reset() {
+	*m = MsgCreatePeriodicVestingAccountResponse{}
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePeriodicVestingAccountResponse) ProtoMessage()    {}
+func (*MsgCreatePeriodicVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_899431b613aba5b5, []int{5}
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse.Merge(m, src)
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse proto.InternalMessageInfo
+
+// MsgClawback defines a message that enables funder of vesting account to
+// reclaim unvested coins, including delegated ones.
+type MsgClawback struct {
+	// funder_address is the address which funded the vesting account
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// account_address is the address of the vesting account to claw back from
+	AccountAddress string `protobuf:"bytes,2,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	// dest_address is the address which receives unvested coins. If empty,
+	// coins are returned to the funder.
+	DestAddress string `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+}
+
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_899431b613aba5b5, []int{6}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+func (m *MsgClawback) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgClawback) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *MsgClawback) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
+}
+
+// MsgClawbackResponse defines MsgClawback response type.
+type MsgClawbackResponse struct {
+	// coins is the amount of clawed back coins
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
+func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackResponse) ProtoMessage()    {}
+func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_899431b613aba5b5, []int{7}
+}
+func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackResponse.Merge(m, src)
+}
+func (m *MsgClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateMonthlyVestingAccount)(nil), "swisstronik.vesting.MsgCreateMonthlyVestingAccount")
 	proto.RegisterType((*MsgCreateMonthlyVestingAccountResponse)(nil), "swisstronik.vesting.MsgCreateMonthlyVestingAccountResponse")
+	proto.RegisterType((*MsgAddVestingGrant)(nil), "swisstronik.vesting.MsgAddVestingGrant")
+	proto.RegisterType((*MsgAddVestingGrantResponse)(nil), "swisstronik.vesting.MsgAddVestingGrantResponse")
+	proto.RegisterType((*MsgCreatePeriodicVestingAccount)(nil), "swisstronik.vesting.MsgCreatePeriodicVestingAccount")
+	proto.RegisterType((*MsgCreatePeriodicVestingAccountResponse)(nil), "swisstronik.vesting.MsgCreatePeriodicVestingAccountResponse")
+	proto.RegisterType((*MsgClawback)(nil), "swisstronik.vesting.MsgClawback")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "swisstronik.vesting.MsgClawbackResponse")
 }
 
 func init() { proto.RegisterFile("swisstronik/vesting/tx.proto", fileDescriptor_899431b613aba5b5) }
 
 var fileDescriptor_899431b613aba5b5 = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbd, 0x8e, 0xd3, 0x40,
-	0x10, 0xc7, 0xbd, 0x67, 0x88, 0x94, 0x3d, 0x1a, 0x0c, 0x02, 0x13, 0x60, 0x2f, 0x97, 0x02, 0x59,
-	0x48, 0xec, 0x92, 0x44, 0x34, 0x50, 0x25, 0xa1, 0xa0, 0x49, 0xe3, 0x82, 0x82, 0x26, 0x5a, 0xdb,
-	0x1b, 0xc7, 0x4a, 0xbc, 0x13, 0x79, 0x36, 0x21, 0x6e, 0x79, 0x02, 0x28, 0x28, 0x79, 0x01, 0x2a,
-	0x1e, 0x23, 0x65, 0x4a, 0x2a, 0x40, 0x49, 0xc1, 0x6b, 0x20, 0x7f, 0x00, 0x41, 0x42, 0x29, 0xae,
-	0x9a, 0xdd, 0xf9, 0xcd, 0xec, 0xcc, 0x7f, 0x76, 0xe8, 0x03, 0x7c, 0x9b, 0x20, 0x9a, 0x0c, 0x74,
-	0x32, 0x17, 0x6b, 0x85, 0x26, 0xd1, 0xb1, 0x30, 0x1b, 0xbe, 0xcc, 0xc0, 0x80, 0x73, 0xeb, 0x88,
-	0xf2, 0x9a, 0xb6, 0x6e, 0xc7, 0x10, 0x43, 0xc9, 0x45, 0x71, 0xaa, 0x42, 0x5b, 0x2c, 0x04, 0x4c,
-	0x01, 0x45, 0x20, 0x51, 0x89, 0x75, 0x37, 0x50, 0x46, 0x76, 0x45, 0x08, 0x89, 0xae, 0xf9, 0xdd,
-	0x9a, 0xa7, 0x18, 0x8b, 0x75, 0xb7, 0x30, 0x15, 0xe8, 0x7c, 0x38, 0xa3, 0x6c, 0x8c, 0xf1, 0x28,
-	0x53, 0xd2, 0xa8, 0x31, 0x68, 0x33, 0x5b, 0xe4, 0xaf, 0xab, 0x52, 0x83, 0x30, 0x84, 0x95, 0x36,
-	0xce, 0x25, 0xbd, 0x31, 0xcd, 0x20, 0x9d, 0xc8, 0x28, 0xca, 0x14, 0xa2, 0x4b, 0xda, 0xc4, 0x6b,
-	0xfa, 0xe7, 0x85, 0x6f, 0x50, 0xb9, 0x9c, 0x87, 0x94, 0x1a, 0xf8, 0x13, 0x70, 0x56, 0x06, 0x34,
-	0x0d, 0x1c, 0xe1, 0x70, 0x91, 0x4c, 0xa7, 0x93, 0x48, 0xe6, 0xe8, 0xda, 0x6d, 0xe2, 0xd9, 0x7e,
-	0xb3, 0xf4, 0xbc, 0x94, 0x39, 0x3a, 0x77, 0x68, 0x23, 0x2d, 0x2a, 0xa3, 0x7b, 0xad, 0x44, 0xf5,
-	0xcd, 0x09, 0x69, 0x43, 0xa6, 0x45, 0x0b, 0xee, 0xf5, 0xb6, 0xed, 0x9d, 0xf7, 0xee, 0xf1, 0x4a,
-	0x05, 0x2f, 0x54, 0xf2, 0x5a, 0x25, 0x1f, 0x41, 0xa2, 0x87, 0x4f, 0xb7, 0xdf, 0x2e, 0xac, 0xcf,
-	0xdf, 0x2f, 0xbc, 0x38, 0x31, 0xb3, 0x55, 0xc0, 0x43, 0x48, 0x45, 0x2d, 0xb9, 0x32, 0x4f, 0x30,
-	0x9a, 0x0b, 0x93, 0x2f, 0x15, 0x96, 0x09, 0xe8, 0xd7, 0x4f, 0x3f, 0xbf, 0xf9, 0xee, 0xe7, 0x97,
-	0xc7, 0xff, 0x08, 0xec, 0x78, 0xf4, 0xd1, 0xe9, 0x91, 0xf8, 0x0a, 0x97, 0xa0, 0x51, 0xf5, 0x3e,
-	0x11, 0x6a, 0x8f, 0x31, 0x76, 0x3e, 0x12, 0x7a, 0xf9, 0x4a, 0xea, 0x68, 0xa1, 0x4e, 0x0d, 0xb2,
-	0xcf, 0xff, 0xf3, 0xa1, 0xfc, 0x74, 0xa9, 0xd6, 0x8b, 0x2b, 0x24, 0xfd, 0xee, 0x6f, 0xf8, 0x6c,
-	0xbb, 0x67, 0x64, 0xb7, 0x67, 0xe4, 0xc7, 0x9e, 0x91, 0xf7, 0x07, 0x66, 0xed, 0x0e, 0xcc, 0xfa,
-	0x7a, 0x60, 0xd6, 0x9b, 0xfb, 0xc7, 0x9b, 0xb7, 0xf9, 0xbb, 0x7b, 0xc5, 0x84, 0x82, 0x46, 0xb9,
-	0x1b, 0xfd, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x86, 0x6f, 0xbf, 0x9f, 0x02, 0x00, 0x00,
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x9b, 0xb6, 0x52, 0x6f, 0xfb, 0xa5, 0xfa, 0x5c, 0x7e, 0x82, 0x29, 0xce, 0x8f, 0x80,
+	0x18, 0x24, 0x6c, 0xd2, 0xc2, 0xa6, 0xb0, 0x49, 0x8a, 0x04, 0x9b, 0x48, 0x28, 0x0b, 0x16, 0x08,
+	0x29, 0x9a, 0xd8, 0x13, 0xd7, 0x4a, 0xec, 0x09, 0x9e, 0x49, 0x9a, 0x6c, 0x91, 0x58, 0xb0, 0x83,
+	0x45, 0x25, 0x9e, 0x81, 0x15, 0x8f, 0xd1, 0x65, 0x97, 0xac, 0x00, 0x25, 0x48, 0xbc, 0x06, 0x1a,
+	0xcf, 0xd8, 0x0d, 0x21, 0x49, 0x51, 0x85, 0xd8, 0xb0, 0xb2, 0xe7, 0x9e, 0x73, 0xef, 0xdc, 0x73,
+	0x74, 0x67, 0x06, 0xb6, 0xe9, 0xa1, 0x47, 0x29, 0x0b, 0x49, 0xe0, 0xb5, 0xad, 0x3e, 0xa6, 0xcc,
+	0x0b, 0x5c, 0x8b, 0x0d, 0xcc, 0x6e, 0x48, 0x18, 0x51, 0xb7, 0x26, 0x50, 0x53, 0xa2, 0xda, 0x05,
+	0x97, 0xb8, 0x24, 0xc2, 0x2d, 0xfe, 0x27, 0xa8, 0x9a, 0x6e, 0x13, 0xea, 0x13, 0x6a, 0x35, 0x11,
+	0xc5, 0x56, 0xbf, 0xdc, 0xc4, 0x0c, 0x95, 0x2d, 0x9b, 0x78, 0x81, 0xc4, 0x2f, 0x4b, 0xdc, 0xa7,
+	0xae, 0xd5, 0x2f, 0xf3, 0x8f, 0x04, 0xae, 0x4b, 0x20, 0xde, 0x3c, 0xce, 0x95, 0x6b, 0xc1, 0x2a,
+	0xbe, 0x5b, 0x02, 0xbd, 0x46, 0xdd, 0xfd, 0x10, 0x23, 0x86, 0x6b, 0x24, 0x60, 0x07, 0x9d, 0xe1,
+	0x33, 0xc1, 0xa8, 0xd8, 0x36, 0xe9, 0x05, 0x4c, 0x2d, 0xc0, 0x46, 0x2b, 0x24, 0x7e, 0x03, 0x39,
+	0x4e, 0x88, 0x29, 0xcd, 0x2a, 0x79, 0xc5, 0x58, 0xab, 0xaf, 0xf3, 0x58, 0x45, 0x84, 0xd4, 0x6b,
+	0x00, 0x8c, 0x24, 0x84, 0xa5, 0x88, 0xb0, 0xc6, 0xc8, 0x04, 0x6c, 0x77, 0xbc, 0x56, 0xab, 0xe1,
+	0xa0, 0x21, 0xcd, 0xa6, 0xf3, 0x8a, 0x91, 0xae, 0xaf, 0x45, 0x91, 0x47, 0x68, 0x48, 0xd5, 0x4b,
+	0xb0, 0xea, 0xf3, 0x9d, 0x69, 0x76, 0x39, 0x82, 0xe4, 0x4a, 0xb5, 0x61, 0x15, 0xf9, 0xbc, 0x85,
+	0xec, 0x4a, 0x3e, 0x6d, 0xac, 0xef, 0x5c, 0x31, 0x85, 0x24, 0x93, 0x7b, 0x61, 0x4a, 0x3d, 0xe6,
+	0x3e, 0xf1, 0x82, 0xea, 0xdd, 0xe3, 0xcf, 0xb9, 0xd4, 0x87, 0x2f, 0x39, 0xc3, 0xf5, 0xd8, 0x41,
+	0xaf, 0x69, 0xda, 0xc4, 0xb7, 0xa4, 0x7e, 0xf1, 0xb9, 0x43, 0x9d, 0xb6, 0xc5, 0x86, 0x5d, 0x4c,
+	0xa3, 0x04, 0x5a, 0x97, 0xa5, 0xf7, 0xfe, 0x7f, 0xf5, 0xfd, 0xe3, 0xed, 0x9f, 0x04, 0x16, 0x0d,
+	0xb8, 0xb9, 0xd8, 0x92, 0x3a, 0xa6, 0x5d, 0x12, 0x50, 0x5c, 0x7c, 0xbd, 0x04, 0x6a, 0x8d, 0xba,
+	0x15, 0xc7, 0x91, 0x84, 0xc7, 0x21, 0xfa, 0x17, 0x1d, 0xdb, 0x06, 0xed, 0x57, 0x1b, 0x12, 0x97,
+	0xbe, 0x29, 0x90, 0x4b, 0x0c, 0x7d, 0x8a, 0x43, 0x8f, 0x38, 0x9e, 0xfd, 0xb7, 0x87, 0xac, 0x06,
+	0x9b, 0x72, 0xf2, 0x1b, 0xdd, 0xa8, 0x05, 0xee, 0x1d, 0xf7, 0x48, 0x8f, 0x3d, 0x92, 0x70, 0x62,
+	0x93, 0xe8, 0xb4, 0xba, 0xcc, 0x8d, 0xaa, 0x67, 0x24, 0x2a, 0x82, 0x74, 0x96, 0x09, 0xb7, 0xa0,
+	0x74, 0x86, 0xca, 0xc4, 0x91, 0x23, 0x05, 0xd6, 0x39, 0xb7, 0x83, 0x0e, 0x9b, 0xc8, 0x6e, 0xab,
+	0x37, 0x20, 0xd3, 0xea, 0x05, 0x0e, 0x0e, 0xa7, 0xf4, 0xff, 0x27, 0xa2, 0xb1, 0xc4, 0x12, 0x6c,
+	0x22, 0x51, 0x69, 0xca, 0x86, 0x8c, 0x0c, 0xc7, 0xc4, 0x02, 0x6c, 0x38, 0x98, 0x9e, 0xb2, 0xd2,
+	0xc2, 0x4d, 0x1e, 0x93, 0x94, 0xbd, 0x2d, 0x2e, 0x60, 0x6a, 0xd7, 0xe2, 0x00, 0xb6, 0x26, 0xda,
+	0x8a, 0xdb, 0x55, 0x11, 0xac, 0xf0, 0x1b, 0x87, 0x77, 0xf5, 0xc7, 0xa7, 0x4a, 0x54, 0xde, 0x79,
+	0xb3, 0x0c, 0xe9, 0x1a, 0x75, 0xd5, 0x23, 0x05, 0x0a, 0x4f, 0x50, 0xe0, 0x74, 0xf0, 0xa2, 0x2b,
+	0x69, 0xd7, 0x9c, 0x71, 0x81, 0x9a, 0x8b, 0x0f, 0xad, 0xf6, 0xe0, 0x1c, 0x49, 0x89, 0x05, 0x2f,
+	0xe1, 0xa2, 0x68, 0x6b, 0xfa, 0xac, 0x97, 0xe6, 0x55, 0x9d, 0x22, 0x6a, 0xd6, 0x6f, 0x12, 0x93,
+	0x2d, 0xdf, 0x2b, 0x50, 0x9c, 0xb4, 0x62, 0xce, 0xc9, 0xb9, 0xb7, 0x58, 0xd6, 0xec, 0x2c, 0xed,
+	0xe1, 0x79, 0xb2, 0x92, 0xd6, 0x5e, 0x40, 0x46, 0x76, 0x16, 0x4f, 0x70, 0x7e, 0x6e, 0x3d, 0xc9,
+	0xd0, 0x8c, 0xb3, 0x18, 0x71, 0xf5, 0xea, 0xfd, 0xe3, 0x91, 0xae, 0x9c, 0x8c, 0x74, 0xe5, 0xeb,
+	0x48, 0x57, 0xde, 0x8e, 0xf5, 0xd4, 0xc9, 0x58, 0x4f, 0x7d, 0x1a, 0xeb, 0xa9, 0xe7, 0x57, 0x27,
+	0x5f, 0xd5, 0xc1, 0xe9, 0xbb, 0xca, 0xe7, 0xa9, 0xb9, 0x1a, 0xbd, 0x68, 0xbb, 0x3f, 0x06, 0x00,
+	0xbb, 0x94, 0x50, 0xa6, 0x7b, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -203,6 +572,15 @@ type MsgClient interface {
 	// CreateMonthlyVestingAccount defines a method that enables creating a monthly vesting account
 	// with cliff feature.
 	HandleCreateMonthlyVestingAccount(ctx context.Context, in *MsgCreateMonthlyVestingAccount, opts ...grpc.CallOption) (*MsgCreateMonthlyVestingAccountResponse, error)
+	// HandleAddVestingGrant defines a method that enables adding a monthly vesting
+	// schedule to an existing monthly vesting account.
+	HandleAddVestingGrant(ctx context.Context, in *MsgAddVestingGrant, opts ...grpc.CallOption) (*MsgAddVestingGrantResponse, error)
+	// HandleCreatePeriodicVestingAccount defines a method that enables creating
+	// a vesting account with custom vesting periods after cliff.
+	HandleCreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// HandleClawback defines a method that enables funder of vesting account to
+	// reclaim unvested coins.
+	HandleClawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
 }
 
 type msgClient struct {
@@ -222,11 +600,47 @@ func (c *msgClient) HandleCreateMonthlyVestingAccount(ctx context.Context, in *M
 	return out, nil
 }
 
+func (c *msgClient) HandleAddVestingGrant(ctx context.Context, in *MsgAddVestingGrant, opts ...grpc.CallOption) (*MsgAddVestingGrantResponse, error) {
+	out := new(MsgAddVestingGrantResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.vesting.Msg/HandleAddVestingGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleCreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error) {
+	out := new(MsgCreatePeriodicVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.vesting.Msg/HandleCreatePeriodicVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleClawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, "/swisstronik.vesting.Msg/HandleClawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateMonthlyVestingAccount defines a method that enables creating a monthly vesting account
 	// with cliff feature.
 	HandleCreateMonthlyVestingAccount(context.Context, *MsgCreateMonthlyVestingAccount) (*MsgCreateMonthlyVestingAccountResponse, error)
+	// HandleAddVestingGrant defines a method that enables adding a monthly vesting
+	// schedule to an existing monthly vesting account.
+	HandleAddVestingGrant(context.Context, *MsgAddVestingGrant) (*MsgAddVestingGrantResponse, error)
+	// HandleCreatePeriodicVestingAccount defines a method that enables creating
+	// a vesting account with custom vesting periods after cliff.
+	HandleCreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// HandleClawback defines a method that enables funder of vesting account to
+	// reclaim unvested coins.
+	HandleClawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -236,6 +650,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) HandleCreateMonthlyVestingAccount(ctx context.Context, req *MsgCreateMonthlyVestingAccount) (*MsgCreateMonthlyVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleCreateMonthlyVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) HandleAddVestingGrant(ctx context.Context, req *MsgAddVestingGrant) (*MsgAddVestingGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleAddVestingGrant not implemented")
+}
+func (*UnimplementedMsgServer) HandleCreatePeriodicVestingAccount(ctx context.Context, req *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleCreatePeriodicVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) HandleClawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleClawback not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -259,29 +682,95 @@ func _Msg_HandleCreateMonthlyVestingAccount_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "swisstronik.vesting.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "HandleCreateMonthlyVestingAccount",
-			Handler:    _Msg_HandleCreateMonthlyVestingAccount_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "swisstronik/vesting/tx.proto",
-}
-
-func (m *MsgCreateMonthlyVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_HandleAddVestingGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddVestingGrant)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
+	if interceptor == nil {
+		return srv.(MsgServer).HandleAddVestingGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.vesting.Msg/HandleAddVestingGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleAddVestingGrant(ctx, req.(*MsgAddVestingGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleCreatePeriodicVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePeriodicVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleCreatePeriodicVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.vesting.Msg/HandleCreatePeriodicVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleCreatePeriodicVestingAccount(ctx, req.(*MsgCreatePeriodicVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleClawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleClawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swisstronik.vesting.Msg/HandleClawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleClawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "swisstronik.vesting.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HandleCreateMonthlyVestingAccount",
+			Handler:    _Msg_HandleCreateMonthlyVestingAccount_Handler,
+		},
+		{
+			MethodName: "HandleAddVestingGrant",
+			Handler:    _Msg_HandleAddVestingGrant_Handler,
+		},
+		{
+			MethodName: "HandleCreatePeriodicVestingAccount",
+			Handler:    _Msg_HandleCreatePeriodicVestingAccount_Handler,
+		},
+		{
+			MethodName: "HandleClawback",
+			Handler:    _Msg_HandleClawback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "swisstronik/vesting/tx.proto",
+}
+
+func (m *MsgCreateMonthlyVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
 func (m *MsgCreateMonthlyVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -356,62 +845,887 @@ func (m *MsgCreateMonthlyVestingAccountResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAddVestingGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateMonthlyVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgAddVestingGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddVestingGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Months != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Months))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CliffDays != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CliffDays))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddVestingGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddVestingGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddVestingGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePeriodicVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePeriodicVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if m.CliffDays != 0 {
-		n += 1 + sovTx(uint64(m.CliffDays))
+		i = encodeVarintTx(dAtA, i, uint64(m.CliffDays))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Months != 0 {
-		n += 1 + sovTx(uint64(m.Months))
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateMonthlyVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CliffDays != 0 {
+		n += 1 + sovTx(uint64(m.CliffDays))
+	}
+	if m.Months != 0 {
+		n += 1 + sovTx(uint64(m.Months))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateMonthlyVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddVestingGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CliffDays != 0 {
+		n += 1 + sovTx(uint64(m.CliffDays))
+	}
+	if m.Months != 0 {
+		n += 1 + sovTx(uint64(m.Months))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddVestingGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePeriodicVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CliffDays != 0 {
+		n += 1 + sovTx(uint64(m.CliffDays))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateMonthlyVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMonthlyVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMonthlyVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffDays", wireType)
+			}
+			m.CliffDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffDays |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+			}
+			m.Months = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Months |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMonthlyVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMonthlyVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMonthlyVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddVestingGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddVestingGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddVestingGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffDays", wireType)
+			}
+			m.CliffDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffDays |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+			}
+			m.Months = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Months |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddVestingGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddVestingGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddVestingGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgCreateMonthlyVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateMonthlyVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -434,10 +1748,10 @@ func (m *MsgCreateMonthlyVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMonthlyVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMonthlyVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -524,10 +1838,10 @@ func (m *MsgCreateMonthlyVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
 			}
-			m.Months = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -537,16 +1851,131 @@ func (m *MsgCreateMonthlyVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Months |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types1.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -556,25 +1985,87 @@ func (m *MsgCreateMonthlyVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -597,7 +2088,7 @@ func (m *MsgCreateMonthlyVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMonthlyVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -620,12 +2111,46 @@ func (m *MsgCreateMonthlyVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMonthlyVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMonthlyVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -38,6 +38,9 @@ type MonthlyVestingAccount struct {
 	// cliff_time defines the time at which linear monthly vesting starts.
 	CliffTime      int64          `protobuf:"varint,3,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
 	VestingPeriods []types.Period `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+	// funder_address defines the account which funded the vesting account and
+	// is allowed to add vesting grants and to claw back unvested coins.
+	FunderAddress string `protobuf:"bytes,5,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
 }
 
 func (m *MonthlyVestingAccount) Reset()      { *m = MonthlyVestingAccount{} }
@@ -79,28 +82,30 @@ func init() {
 func init() { proto.RegisterFile("swisstronik/vesting/vesting.proto", fileDescriptor_d562c6a66d5a9707) }
 
 var fileDescriptor_d562c6a66d5a9707 = []byte{
-	// 334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x2e, 0xcf, 0x2c,
-	0x2e, 0x2e, 0x29, 0xca, 0xcf, 0xcb, 0xcc, 0xd6, 0x2f, 0x4b, 0x2d, 0x2e, 0xc9, 0xcc, 0x4b, 0x87,
-	0xd1, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xc2, 0x48, 0x4a, 0xf4, 0xa0, 0x52, 0x52, 0x82,
-	0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0xa2, 0x4e, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f,
-	0xcc, 0xd4, 0x07, 0xb1, 0xa0, 0xa2, 0x72, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x49, 0x89,
-	0xc5, 0xa9, 0xfa, 0x65, 0x86, 0x49, 0xa9, 0x25, 0x89, 0x86, 0xfa, 0xc9, 0xf9, 0x99, 0x79, 0x50,
-	0x79, 0x15, 0xa8, 0x3c, 0xdc, 0x6e, 0xa8, 0x12, 0x14, 0x37, 0x28, 0x4d, 0x67, 0xe2, 0x12, 0xf5,
-	0xcd, 0xcf, 0x2b, 0xc9, 0xc8, 0xa9, 0x0c, 0x83, 0x48, 0x38, 0x26, 0x27, 0xe7, 0x97, 0xe6, 0x95,
-	0x08, 0x25, 0x71, 0x89, 0x80, 0x8c, 0x8e, 0x87, 0xaa, 0x8f, 0x4f, 0x84, 0x88, 0x4b, 0x30, 0x2a,
-	0x30, 0x6a, 0x70, 0x1b, 0x69, 0xe9, 0x41, 0x8c, 0x87, 0xb9, 0x5b, 0x0f, 0x6a, 0xbc, 0x9e, 0x53,
-	0x62, 0x71, 0x2a, 0xaa, 0x49, 0x4e, 0x2c, 0x17, 0xee, 0xc9, 0x33, 0x06, 0x09, 0x25, 0x61, 0xc8,
-	0x08, 0xc9, 0x72, 0x71, 0x15, 0x97, 0x24, 0x16, 0x95, 0xc4, 0x97, 0x64, 0xe6, 0xa6, 0x4a, 0x30,
-	0x29, 0x30, 0x6a, 0x30, 0x07, 0x71, 0x82, 0x45, 0x42, 0x32, 0x73, 0x53, 0x41, 0xd2, 0xc9, 0x39,
-	0x99, 0x69, 0x69, 0x10, 0x69, 0x66, 0x88, 0x34, 0x58, 0x04, 0x2c, 0x1d, 0xc4, 0xc5, 0x0f, 0x73,
-	0x5c, 0x41, 0x6a, 0x51, 0x66, 0x7e, 0x4a, 0xb1, 0x04, 0x8b, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x1c,
-	0x2e, 0xc7, 0x05, 0x80, 0x95, 0x39, 0x71, 0x9e, 0xb8, 0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d,
-	0xc6, 0x20, 0x3e, 0xa8, 0x12, 0x88, 0x4c, 0xb1, 0x15, 0x47, 0xc7, 0x02, 0x79, 0x86, 0x19, 0x0b,
-	0xe4, 0x19, 0x9c, 0x4c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x1a,
-	0x39, 0x6a, 0x2b, 0xe0, 0x01, 0x5c, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x57, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xcf, 0x83, 0xb4, 0x00, 0x02, 0x00, 0x00,
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbf, 0x4f, 0xf2, 0x40,
+	0x1c, 0xc6, 0x7b, 0xc0, 0xfb, 0xe6, 0xa5, 0xe4, 0xc5, 0x58, 0x31, 0x69, 0x30, 0x5e, 0xab, 0xd1,
+	0xa4, 0x61, 0x68, 0x03, 0xc6, 0xc5, 0x8d, 0xee, 0x26, 0xa6, 0x31, 0x0e, 0x2e, 0x4d, 0x7f, 0x1c,
+	0x78, 0x91, 0xf6, 0x48, 0xbf, 0x07, 0xca, 0x7f, 0xe0, 0xe8, 0xe8, 0xc8, 0xe8, 0xe8, 0x5f, 0xe0,
+	0xcc, 0xc8, 0xe8, 0x44, 0x0c, 0x0c, 0xfe, 0x1b, 0x86, 0xde, 0x41, 0x20, 0xc6, 0xe5, 0xee, 0x9b,
+	0xe7, 0xf3, 0xe4, 0xb9, 0x27, 0x77, 0xa7, 0x1e, 0xc1, 0x03, 0x05, 0xe0, 0x19, 0x4b, 0xe9, 0xbd,
+	0x33, 0x24, 0xc0, 0x69, 0xda, 0x5d, 0xed, 0x76, 0x3f, 0x63, 0x9c, 0x69, 0x7b, 0x1b, 0x16, 0x5b,
+	0xa2, 0xfa, 0x6e, 0x90, 0xd0, 0x94, 0x39, 0xf9, 0x2a, 0x7c, 0xf5, 0x5a, 0x97, 0x75, 0x59, 0x3e,
+	0x3a, 0xcb, 0x49, 0xaa, 0x38, 0x62, 0x90, 0x30, 0x70, 0xc2, 0x00, 0x88, 0x33, 0x6c, 0x86, 0x84,
+	0x07, 0x4d, 0x27, 0x62, 0x34, 0x95, 0xfc, 0x44, 0xf2, 0xf5, 0xd9, 0xd2, 0xb2, 0xd5, 0xe1, 0xf8,
+	0xbd, 0xa0, 0xee, 0x5f, 0xb2, 0x94, 0xdf, 0xf5, 0x46, 0x37, 0x02, 0xb4, 0xa3, 0x88, 0x0d, 0x52,
+	0xae, 0x85, 0x6a, 0x6d, 0x19, 0xed, 0x4b, 0xbf, 0x1f, 0x08, 0x5d, 0x47, 0x26, 0xb2, 0x2a, 0xad,
+	0x86, 0x2d, 0xe2, 0x57, 0xbd, 0x6d, 0x19, 0x6f, 0xbb, 0x01, 0x90, 0xed, 0x24, 0xb7, 0x34, 0x9d,
+	0x19, 0xc8, 0xd3, 0xc2, 0x1f, 0x44, 0x3b, 0x54, 0x55, 0xe0, 0x41, 0xc6, 0x7d, 0x4e, 0x13, 0xa2,
+	0x17, 0x4c, 0x64, 0x15, 0xbd, 0x72, 0xae, 0x5c, 0xd3, 0x84, 0x2c, 0x71, 0xd4, 0xa3, 0x9d, 0x8e,
+	0xc0, 0x45, 0x81, 0x73, 0x25, 0xc7, 0x9e, 0xba, 0xb3, 0x2a, 0xd7, 0x27, 0x19, 0x65, 0x31, 0xe8,
+	0x25, 0xb3, 0x68, 0x55, 0x5a, 0xf8, 0xb7, 0x72, 0x57, 0xb9, 0xcd, 0x2d, 0x4f, 0x66, 0x86, 0xf2,
+	0xfa, 0xf5, 0xd6, 0x40, 0x5e, 0x55, 0x5a, 0x04, 0x01, 0xed, 0x54, 0xad, 0x76, 0x06, 0x69, 0x4c,
+	0x32, 0x3f, 0x88, 0xe3, 0x8c, 0x00, 0xe8, 0x7f, 0x4c, 0x64, 0x95, 0xbd, 0xff, 0x42, 0x6d, 0x0b,
+	0xf1, 0xe2, 0xdf, 0xd3, 0xd8, 0x50, 0x5e, 0xc6, 0x86, 0xe2, 0x9e, 0x4f, 0xe6, 0x18, 0x4d, 0xe7,
+	0x18, 0x7d, 0xce, 0x31, 0x7a, 0x5e, 0x60, 0x65, 0xba, 0xc0, 0xca, 0xc7, 0x02, 0x2b, 0xb7, 0x07,
+	0x9b, 0x3f, 0xe0, 0x71, 0xfd, 0x0e, 0x7c, 0xd4, 0x27, 0x10, 0xfe, 0xcd, 0xaf, 0xff, 0xec, 0x7b,
+	0x00, 0x82, 0xc0, 0x96, 0x5a, 0x27, 0x02, 0x00, 0x00,
 }
 
 func (m *MonthlyVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -123,6 +128,13 @@ func (m *MonthlyVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.VestingPeriods) > 0 {
 		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -195,6 +207,10 @@ func (m *MonthlyVestingAccount) Size() (n int) {
 			n += 1 + l + sovVesting(uint64(l))
 		}
 	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
 	return n
 }
 
@@ -341,6 +357,38 @@ func (m *MonthlyVestingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
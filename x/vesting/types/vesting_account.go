@@ -1,8 +1,9 @@
 package types
 
 import (
-	"fmt"
 	"errors"
+	"fmt"
+	"sort"
 	"time"
 
 	"sigs.k8s.io/yaml"
@@ -30,36 +31,130 @@ func NewMonthlyVestingAccountRaw(baseAcc *sdkvesting.BaseVestingAccount, startTi
 }
 
 func NewMonthlyVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting sdk.Coins, startTime, cliffDays, months int64) *MonthlyVestingAccount {
+	return NewPeriodicMonthlyVestingAccount(baseAcc, startTime, cliffDays, MonthlyPeriods(originalVesting, months))
+}
+
+// NewPeriodicMonthlyVestingAccount returns vesting account, which unlocks coins of provided periods
+// one by one after the cliff. Original vesting is the sum of coins of all periods.
+func NewPeriodicMonthlyVestingAccount(baseAcc *authtypes.BaseAccount, startTime, cliffDays int64, periods []sdkvesting.Period) *MonthlyVestingAccount {
 	afterCliffDays := startTime + SecondsOfDay*cliffDays
 
+	originalVesting := sdk.NewCoins()
+	endTime := afterCliffDays
+	for _, p := range periods {
+		originalVesting = originalVesting.Add(p.Amount...)
+		endTime += p.Length
+	}
+	baseVestingAcc := sdkvesting.NewBaseVestingAccount(baseAcc, originalVesting, endTime)
+
+	return &MonthlyVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          startTime,
+		CliffTime:          afterCliffDays,
+		VestingPeriods:     periods,
+	}
+}
+
+// MonthlyPeriods splits provided amount into monthly vesting periods
+func MonthlyPeriods(amount sdk.Coins, months int64) []sdkvesting.Period {
 	var periods []sdkvesting.Period
-	amount := originalVesting.QuoInt(sdk.NewInt(months))
+	monthlyAmount := amount.QuoInt(sdk.NewInt(months))
 	accVesting := sdk.NewCoins()
 	// Every month, vests same amount of coins
 	for i := 0; i < int(months)-1; i++ {
-		periods = append(periods, sdkvesting.Period{Length: SecondsOfMonth, Amount: amount})
-		accVesting = accVesting.Add(amount...)
+		periods = append(periods, sdkvesting.Period{Length: SecondsOfMonth, Amount: monthlyAmount})
+		accVesting = accVesting.Add(monthlyAmount...)
 	}
 	if months > 0 {
 		// At the last month, includes rest of vesting amount
 		periods = append(periods, sdkvesting.Period{
 			Length: SecondsOfMonth,
-			Amount: originalVesting.Sub(accVesting...),
+			Amount: amount.Sub(accVesting...),
 		})
 	}
+	return periods
+}
 
-	endTime := afterCliffDays
-	for _, p := range periods {
-		endTime += p.Length
+// AddGrant merges a new vesting schedule into the schedule of the account. Periods of the grant
+// start after provided cliff time, which may differ from the cliff time of the account.
+func (m *MonthlyVestingAccount) AddGrant(grantCliffTime int64, grantPeriods []sdkvesting.Period) {
+	type unlockEvent struct {
+		time   int64
+		amount sdk.Coins
 	}
-	baseVestingAcc := sdkvesting.NewBaseVestingAccount(baseAcc, originalVesting, endTime)
 
-	return &MonthlyVestingAccount{
-		BaseVestingAccount: baseVestingAcc,
-		StartTime:          startTime,
-		CliffTime:          afterCliffDays,
-		VestingPeriods:     periods,
+	// Convert both schedules into absolute unlock times
+	var events []unlockEvent
+	grantVesting := sdk.NewCoins()
+	for _, schedule := range []struct {
+		cliffTime int64
+		periods   []sdkvesting.Period
+	}{
+		{m.CliffTime, m.VestingPeriods},
+		{grantCliffTime, grantPeriods},
+	} {
+		unlockTime := schedule.cliffTime
+		for _, p := range schedule.periods {
+			unlockTime += p.Length
+			events = append(events, unlockEvent{time: unlockTime, amount: p.Amount})
+		}
+	}
+	for _, p := range grantPeriods {
+		grantVesting = grantVesting.Add(p.Amount...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].time < events[j].time
+	})
+
+	// Build merged periods relative to the earliest cliff
+	cliffTime := m.CliffTime
+	if grantCliffTime < cliffTime {
+		cliffTime = grantCliffTime
 	}
+	var periods []sdkvesting.Period
+	prevTime := cliffTime
+	for _, event := range events {
+		if len(periods) > 0 && event.time == prevTime {
+			last := &periods[len(periods)-1]
+			last.Amount = last.Amount.Add(event.amount...)
+			continue
+		}
+		periods = append(periods, sdkvesting.Period{Length: event.time - prevTime, Amount: event.amount})
+		prevTime = event.time
+	}
+
+	m.CliffTime = cliffTime
+	m.VestingPeriods = periods
+	m.EndTime = prevTime
+	m.OriginalVesting = m.OriginalVesting.Add(grantVesting...)
+}
+
+// Clawback removes periods, which were not vested at provided time, from the schedule
+// of the account and returns coins of removed periods. After clawback all remaining coins
+// of the account are vested.
+func (m *MonthlyVestingAccount) Clawback(blockTime time.Time) sdk.Coins {
+	unvested := m.GetVestingCoins(blockTime)
+	if unvested.IsZero() {
+		return unvested
+	}
+
+	var periods []sdkvesting.Period
+	endTime := m.CliffTime
+	if blockTime.Unix() > m.CliffTime {
+		for _, p := range m.VestingPeriods {
+			if blockTime.Unix()-endTime < p.Length {
+				break
+			}
+			periods = append(periods, p)
+			endTime += p.Length
+		}
+	}
+
+	m.VestingPeriods = periods
+	m.EndTime = endTime
+	m.OriginalVesting = m.OriginalVesting.Sub(unvested...)
+
+	return unvested
 }
 
 // GetVestedCoins returns the total number of vested coins. If no coins are vested,
@@ -92,6 +187,53 @@ func (m MonthlyVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
 	return vestedCoins
 }
 
+// GetSchedule returns vesting periods with absolute unlock times and their status
+// at provided block time.
+func (m MonthlyVestingAccount) GetSchedule(blockTime time.Time) []SchedulePeriod {
+	schedule := make([]SchedulePeriod, 0, len(m.VestingPeriods))
+
+	unlockTime := m.CliffTime
+	for _, period := range m.VestingPeriods {
+		unlockTime += period.Length
+
+		status := PeriodStatusLocked
+		if blockTime.Unix() >= unlockTime {
+			status = PeriodStatusVested
+		}
+
+		schedule = append(schedule, SchedulePeriod{
+			UnlockTime: unlockTime,
+			Amount:     period.Amount,
+			Status:     status,
+		})
+	}
+
+	return schedule
+}
+
+// GetNextUnlock returns unlock time and amount of the first period, which is not vested
+// at provided block time. Periods with the same unlock time are combined. If all coins
+// are vested, zero time and nil are returned.
+func (m MonthlyVestingAccount) GetNextUnlock(blockTime time.Time) (int64, sdk.Coins) {
+	var (
+		nextUnlockTime int64
+		amount         sdk.Coins
+	)
+
+	for _, period := range m.GetSchedule(blockTime) {
+		if period.Status == PeriodStatusVested {
+			continue
+		}
+		if nextUnlockTime != 0 && period.UnlockTime != nextUnlockTime {
+			break
+		}
+		nextUnlockTime = period.UnlockTime
+		amount = amount.Add(period.Amount...)
+	}
+
+	return nextUnlockTime, amount
+}
+
 // GetVestingCoins returns the total number of vesting coins. If no coins are
 // vesting, nil is returned.
 func (m MonthlyVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
@@ -125,6 +267,11 @@ func (m MonthlyVestingAccount) GetCliffTime() int64 {
 	return m.CliffTime
 }
 
+// GetFunderAddress returns the address which funded the vesting account
+func (m MonthlyVestingAccount) GetFunderAddress() string {
+	return m.FunderAddress
+}
+
 // Validate checks for errors on the account fields
 func (m MonthlyVestingAccount) Validate() error {
 	if m.GetStartTime() >= m.GetEndTime() {
@@ -177,6 +324,7 @@ func (m MonthlyVestingAccount) MarshalYAML() (interface{}, error) {
 		StartTime:        m.StartTime,
 		CliffTime:        m.CliffTime,
 		VestingPeriods:   m.VestingPeriods,
+		FunderAddress:    m.FunderAddress,
 	}
 	return marshalYaml(out)
 }
@@ -195,6 +343,7 @@ type vestingAccountYAML struct {
 	StartTime      int64              `json:"start_time,omitempty"`
 	CliffTime      int64              `json:"cliff_time,omitempty"`
 	VestingPeriods sdkvesting.Periods `json:"vesting_periods,omitempty"`
+	FunderAddress  string             `json:"funder_address,omitempty"`
 }
 
 type getPK interface {
@@ -488,6 +488,151 @@ func (suite *VestingAccountTestSuite) TestFieldsMonthlyVestingAcc() {
 	}
 }
 
+func (suite *VestingAccountTestSuite) TestAddGrantMonthlyVestingAcc() {
+	now := tmtime.Now()
+	month := time.Hour * 24 * 30
+
+	baseAcc, initialVesting := initBaseAccount(300)
+	mva := types.NewMonthlyVestingAccount(baseAcc, initialVesting, now.Unix(), 10, 3)
+
+	// second grant is added after 20 days without cliff, so it unlocks 10 days after the first one
+	grantTime := now.Add(time.Hour * 24 * 20)
+	grantVesting := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+	mva.AddGrant(grantTime.Unix(), types.MonthlyPeriods(grantVesting, 2))
+	suite.Require().NoError(mva.Validate())
+
+	suite.Require().Equal(initialVesting.Add(grantVesting...), mva.OriginalVesting)
+	suite.Require().Equal(now.Add(time.Hour*24*10).Unix(), mva.CliffTime)
+	suite.Require().Equal(now.Add(time.Hour*24*10+month*3).Unix(), mva.EndTime)
+	suite.Require().Len(mva.VestingPeriods, 5)
+
+	testCases := []struct {
+		name        string
+		blockTime   time.Time
+		vestedCoins sdk.Coins
+	}{
+		{"require no coins vested before the first unlock", now.Add(time.Hour*24*10 + month - time.Second), nil},
+		{"require first month of first grant vested", now.Add(time.Hour*24*10 + month), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+		{"require first month of both grants vested", grantTime.Add(month), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 130))},
+		{"require all coins of second grant vested", grantTime.Add(month * 2), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 260))},
+		{"require all coins vested at the end", time.Unix(mva.EndTime, 0), initialVesting.Add(grantVesting...)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.vestedCoins, mva.GetVestedCoins(tc.blockTime))
+		})
+	}
+}
+
+func (suite *VestingAccountTestSuite) TestAddGrantWithSameUnlockTimes() {
+	now := tmtime.Now()
+
+	baseAcc, initialVesting := initBaseAccount(300)
+	mva := types.NewMonthlyVestingAccount(baseAcc, initialVesting, now.Unix(), 10, 3)
+	mva.AddGrant(mva.CliffTime, types.MonthlyPeriods(initialVesting, 3))
+	suite.Require().NoError(mva.Validate())
+
+	suite.Require().Len(mva.VestingPeriods, 3)
+	for _, period := range mva.VestingPeriods {
+		suite.Require().Equal(types.SecondsOfMonth, period.Length)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)), period.Amount)
+	}
+}
+
+func (suite *VestingAccountTestSuite) TestClawbackMonthlyVestingAcc() {
+	now := tmtime.Now()
+	cliffTime := now.Add(time.Hour * 24 * 10)
+	firstMonthAfterCliff := cliffTime.Add(time.Hour * 24 * 30)
+
+	testCases := []struct {
+		name        string
+		blockTime   time.Time
+		expClawback sdk.Coins
+		expPeriods  int
+	}{
+		{"claw back all coins before cliff", now, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), 0},
+		{"claw back unvested coins after first month", firstMonthAfterCliff.Add(time.Second), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)), 1},
+		{"nothing to claw back after end time", cliffTime.Add(time.Hour * 24 * 30 * 3), sdk.NewCoins(), 3},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			baseAcc, initialVesting := initBaseAccount(300)
+			mva := types.NewMonthlyVestingAccount(baseAcc, initialVesting, now.Unix(), 10, 3)
+
+			clawedBack := mva.Clawback(tc.blockTime)
+			suite.Require().True(tc.expClawback.IsEqual(clawedBack))
+			suite.Require().Len(mva.VestingPeriods, tc.expPeriods)
+			suite.Require().Equal(initialVesting.Sub(tc.expClawback...), mva.OriginalVesting)
+			suite.Require().True(mva.GetVestingCoins(tc.blockTime).IsZero())
+			if tc.expPeriods > 0 {
+				suite.Require().NoError(mva.Validate())
+			}
+		})
+	}
+}
+
+func (suite *VestingAccountTestSuite) TestGetScheduleMonthlyVestingAcc() {
+	now := tmtime.Now()
+
+	baseAcc, initialVesting := initBaseAccount(300)
+	mva := types.NewMonthlyVestingAccount(baseAcc, initialVesting, now.Unix(), 10, 3)
+	grantCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+	mva.AddGrant(now.Add(time.Hour*24*20).Unix(), types.MonthlyPeriods(grantCoins, 1))
+
+	testCases := []struct {
+		name          string
+		blockTime     time.Time
+		expStatuses   []types.PeriodStatus
+		expNextUnlock int64
+		expNextAmount sdk.Coins
+	}{
+		{
+			"all periods are locked before cliff",
+			now,
+			[]types.PeriodStatus{types.PeriodStatusLocked, types.PeriodStatusLocked, types.PeriodStatusLocked, types.PeriodStatusLocked},
+			mva.CliffTime + types.SecondsOfMonth,
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		},
+		{
+			"first period is vested",
+			time.Unix(mva.CliffTime+types.SecondsOfMonth, 0),
+			[]types.PeriodStatus{types.PeriodStatusVested, types.PeriodStatusLocked, types.PeriodStatusLocked, types.PeriodStatusLocked},
+			now.Add(time.Hour*24*20).Unix() + types.SecondsOfMonth,
+			grantCoins,
+		},
+		{
+			"all periods are vested after end time",
+			time.Unix(mva.EndTime, 0),
+			[]types.PeriodStatus{types.PeriodStatusVested, types.PeriodStatusVested, types.PeriodStatusVested, types.PeriodStatusVested},
+			0,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			schedule := mva.GetSchedule(tc.blockTime)
+			suite.Require().Len(schedule, len(tc.expStatuses))
+
+			vested := sdk.NewCoins()
+			for i, period := range schedule {
+				suite.Require().Equal(tc.expStatuses[i], period.Status)
+				if period.Status == types.PeriodStatusVested {
+					vested = vested.Add(period.Amount...)
+				}
+			}
+			suite.Require().True(vested.IsEqual(mva.GetVestedCoins(tc.blockTime)))
+			suite.Require().Equal(mva.EndTime, schedule[len(schedule)-1].UnlockTime)
+
+			nextUnlock, amount := mva.GetNextUnlock(tc.blockTime)
+			suite.Require().Equal(tc.expNextUnlock, nextUnlock)
+			suite.Require().Equal(tc.expNextAmount, amount)
+		})
+	}
+}
+
 func initBaseAccount(amount int64) (*authtypes.BaseAccount, sdk.Coins) {
 	addr := tests.RandomAccAddress()
 	baseAcc := authtypes.NewBaseAccountWithAddress(addr)